@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/upgrade"
 
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
@@ -23,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -74,6 +76,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/cosmos/ibc-go/modules/capability"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
@@ -91,6 +94,8 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 	"github.com/spf13/cast"
 
 	"github.com/stateset/core/docs"
@@ -110,6 +115,7 @@ import (
 	paymentskeeper "github.com/stateset/core/x/payments/keeper"
 	paymentstypes "github.com/stateset/core/x/payments/types"
 	settlement "github.com/stateset/core/x/settlement"
+	settlementibc "github.com/stateset/core/x/settlement/ibc"
 	settlementkeeper "github.com/stateset/core/x/settlement/keeper"
 	settlementtypes "github.com/stateset/core/x/settlement/types"
 	stablecoin "github.com/stateset/core/x/stablecoin"
@@ -171,7 +177,16 @@ type EncodingConfig struct {
 // MakeEncodingConfig creates an EncodingConfig for an amino based test configuration.
 func MakeEncodingConfig(mb module.BasicManager) EncodingConfig {
 	amino := codec.NewLegacyAmino()
-	interfaceRegistry := types.NewInterfaceRegistry()
+	interfaceRegistry, err := types.NewInterfaceRegistryWithOptions(types.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          address.NewBech32Codec(AccountAddressPrefix),
+			ValidatorAddressCodec: address.NewBech32Codec(AccountAddressPrefix + "valoper"),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
 	txCfg := authtx.NewTxConfig(marshaler, authtx.DefaultSignModes)
 
@@ -254,6 +269,7 @@ func initModuleBasics() {
 		consensus.AppModuleBasic{},
 		feegrantmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
+		ibctm.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
@@ -283,6 +299,7 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -375,6 +392,7 @@ func New(
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -530,14 +548,6 @@ func New(
 
 	app.TreasuryKeeper = treasurykeeper.NewKeeper(appCodec, keys[treasurytypes.StoreKey], oracleAuthority, app.BankKeeper, app.AccountKeeper)
 
-	app.PaymentsKeeper = paymentskeeper.NewKeeper(
-		appCodec,
-		keys[paymentstypes.StoreKey],
-		app.BankKeeper,
-		app.ComplianceKeeper,
-		paymentstypes.ModuleAccountName,
-	)
-
 	app.StablecoinKeeper = stablecoinkeeper.NewKeeper(
 		appCodec,
 		keys[stablecointypes.StoreKey],
@@ -554,9 +564,20 @@ func New(
 		app.BankKeeper,
 		app.ComplianceKeeper,
 		app.AccountKeeper,
+		app.OracleKeeper,
+		app.StablecoinKeeper,
 		oracleAuthority,
 	)
 
+	app.PaymentsKeeper = paymentskeeper.NewKeeper(
+		appCodec,
+		keys[paymentstypes.StoreKey],
+		app.BankKeeper,
+		app.ComplianceKeeper,
+		app.SettlementKeeper,
+		paymentstypes.ModuleAccountName,
+	)
+
 	app.OrdersKeeper = orderskeeper.NewKeeper(
 		appCodec,
 		keys[orderstypes.StoreKey],
@@ -636,8 +657,13 @@ func New(
 	//}
 
 	// Create static IBC router, add transfer route, then set and seal it
+	// The settlement middleware wraps ICS-20 so transfers carrying a settlement
+	// memo are settled once the transfer module has credited the receiver
+	var transferStack porttypes.IBCModule = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = settlementibc.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, settlementibc.NewIBCHooks(app.SettlementKeeper))
+
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transfer.NewIBCModule(app.TransferKeeper))
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	// this line is used by starport scaffolding # ibc/app/router
 	// ibcRouter.AddRoute(// wasm.ModuleName, // Temporarily commented out wasm.NewIBCHandler(app.wasmKeeper, app.IBCKeeper.ChannelKeeper)) // Temporarily commented out
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -669,6 +695,7 @@ func New(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
+		ibctm.NewAppModule(),
 		transferModule,
 		feemarket.NewAppModule(app.FeeMarketKeeper),
 		oracle.NewAppModule(app.OracleKeeper),
@@ -848,6 +875,31 @@ func (app *App) AppCodec() codec.Codec {
 	return app.appCodec
 }
 
+// GetBaseApp returns the app's BaseApp, for ibc-go's testing package.
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper, for ibc-go's testing package.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper, for ibc-go's testing package.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the IBC scoped keeper, for ibc-go's testing package.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the app's TxConfig, for ibc-go's testing package.
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // InterfaceRegistry returns Gaia's InterfaceRegistry
 func (app *App) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
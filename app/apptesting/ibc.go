@@ -0,0 +1,45 @@
+package apptesting
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/stateset/core/app"
+)
+
+// NewCoordinator returns an ibc-go testing coordinator whose n chains each run
+// the full Stateset app.
+func NewCoordinator(t *testing.T, n int) *ibctesting.Coordinator {
+	// Genesis accounts are encoded before the first app is built
+	setupBech32Config()
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+	return ibctesting.NewCoordinator(t, n)
+}
+
+// SetupTestingApp builds a fresh App and its default genesis for ibc-go's
+// testing package. The ante handler is removed because ibc-go's test chains
+// sign transactions without fees, which the fee market rejects.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	setupBech32Config()
+
+	stateset := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		false,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		5,
+		app.MakeEncodingConfig(app.ModuleBasics),
+		appOptions{},
+	)
+	stateset.SetAnteHandler(nil)
+	if err := stateset.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	return stateset, app.NewDefaultGenesisState(stateset.AppCodec())
+}
@@ -339,15 +339,6 @@ func (app *App) InitStatesetKeepers(
 	// Init TreasuryKeeper
 	app.TreasuryKeeper = treasurykeeper.NewKeeper(appCodec, keys[treasurytypes.StoreKey], authority, app.BankKeeper, app.AccountKeeper)
 
-	// Init PaymentsKeeper
-	app.PaymentsKeeper = paymentskeeper.NewKeeper(
-		appCodec,
-		keys[paymentstypes.StoreKey],
-		app.BankKeeper,
-		app.ComplianceKeeper,
-		paymentstypes.ModuleAccountName,
-	)
-
 	// Init StablecoinKeeper
 	app.StablecoinKeeper = stablecoinkeeper.NewKeeper(
 		appCodec,
@@ -366,9 +357,21 @@ func (app *App) InitStatesetKeepers(
 		app.BankKeeper,
 		app.ComplianceKeeper,
 		app.AccountKeeper,
+		app.OracleKeeper,
+		app.StablecoinKeeper,
 		authority,
 	)
 
+	// Init PaymentsKeeper
+	app.PaymentsKeeper = paymentskeeper.NewKeeper(
+		appCodec,
+		keys[paymentstypes.StoreKey],
+		app.BankKeeper,
+		app.ComplianceKeeper,
+		app.SettlementKeeper,
+		paymentstypes.ModuleAccountName,
+	)
+
 	// Init CircuitKeeper for security controls
 	app.CircuitKeeper = circuitkeeper.NewKeeper(
 		appCodec,
@@ -32,6 +32,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/stateset/core/app"
+	"github.com/stateset/core/x/settlement/client/webhooks"
 )
 
 func main() {
@@ -119,6 +120,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		webhooks.NewWebhooksCmd(app.DefaultNodeHome),
 	)
 }
 
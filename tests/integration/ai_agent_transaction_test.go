@@ -143,15 +143,6 @@ func (s *AIAgentTransactionTestSuite) SetupTest() {
 		s.authority.String(),
 	)
 
-	// Initialize payments keeper
-	s.paymentsKeeper = paymentskeeper.NewKeeper(
-		s.cdc,
-		storeKeys[paymentstypes.StoreKey],
-		s.bankKeeper,
-		s.complianceKeeper,
-		paymentstypes.ModuleAccountName,
-	)
-
 	// Initialize settlement keeper
 	s.settlementKeeper = settlementkeeper.NewKeeper(
 		s.cdc,
@@ -159,9 +150,21 @@ func (s *AIAgentTransactionTestSuite) SetupTest() {
 		s.bankKeeper,
 		s.complianceKeeper,
 		s.accountKeeper,
+		nil,
+		nil,
 		s.authority.String(),
 	)
 
+	// Initialize payments keeper
+	s.paymentsKeeper = paymentskeeper.NewKeeper(
+		s.cdc,
+		storeKeys[paymentstypes.StoreKey],
+		s.bankKeeper,
+		s.complianceKeeper,
+		s.settlementKeeper,
+		paymentstypes.ModuleAccountName,
+	)
+
 	// Setup test data
 	s.setupTestAccounts()
 	s.setupComplianceProfiles()
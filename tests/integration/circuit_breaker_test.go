@@ -168,6 +168,8 @@ func (s *CircuitBreakerTestSuite) SetupTest() {
 		s.bankKeeper,
 		s.complianceKeeper,
 		s.accountKeeper,
+		nil,
+		nil,
 		s.authority.String(),
 	)
 
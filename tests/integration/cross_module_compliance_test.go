@@ -147,15 +147,6 @@ func (s *CrossModuleComplianceTestSuite) SetupTest() {
 		s.authority.String(),
 	)
 
-	// Initialize payments keeper
-	s.paymentsKeeper = paymentskeeper.NewKeeper(
-		s.cdc,
-		storeKeys[paymentstypes.StoreKey],
-		s.bankKeeper,
-		s.complianceKeeper,
-		paymentstypes.ModuleAccountName,
-	)
-
 	// Initialize settlement keeper
 	s.settlementKeeper = settlementkeeper.NewKeeper(
 		s.cdc,
@@ -163,9 +154,21 @@ func (s *CrossModuleComplianceTestSuite) SetupTest() {
 		s.bankKeeper,
 		s.complianceKeeper,
 		s.accountKeeper,
+		nil,
+		nil,
 		s.authority.String(),
 	)
 
+	// Initialize payments keeper
+	s.paymentsKeeper = paymentskeeper.NewKeeper(
+		s.cdc,
+		storeKeys[paymentstypes.StoreKey],
+		s.bankKeeper,
+		s.complianceKeeper,
+		s.settlementKeeper,
+		paymentstypes.ModuleAccountName,
+	)
+
 	// Setup test data
 	s.setupTestAccounts()
 	s.setupComplianceProfiles()
@@ -145,15 +145,6 @@ func (s *ECommerceFlowTestSuite) SetupTest() {
 		s.authority.String(),
 	)
 
-	// Initialize payments keeper
-	s.paymentsKeeper = paymentskeeper.NewKeeper(
-		s.cdc,
-		storeKeys[paymentstypes.StoreKey],
-		s.bankKeeper,
-		s.complianceKeeper,
-		paymentstypes.ModuleAccountName,
-	)
-
 	// Initialize settlement keeper
 	s.settlementKeeper = settlementkeeper.NewKeeper(
 		s.cdc,
@@ -161,9 +152,21 @@ func (s *ECommerceFlowTestSuite) SetupTest() {
 		s.bankKeeper,
 		s.complianceKeeper,
 		s.accountKeeper,
+		nil,
+		nil,
 		s.authority.String(),
 	)
 
+	// Initialize payments keeper
+	s.paymentsKeeper = paymentskeeper.NewKeeper(
+		s.cdc,
+		storeKeys[paymentstypes.StoreKey],
+		s.bankKeeper,
+		s.complianceKeeper,
+		s.settlementKeeper,
+		paymentstypes.ModuleAccountName,
+	)
+
 	// Initialize orders keeper
 	s.ordersKeeper = orderskeeper.NewKeeper(
 		s.cdc,
@@ -567,13 +570,25 @@ func (s *ECommerceFlowTestSuite) TestFullOrderLifecycle() {
 	params := s.ordersKeeper.GetParams(s.ctx)
 	s.Require().Equal("ssusd", params.StablecoinDenom)
 
-	// 1. Create Order
+	// 1. Create Order from the merchant's catalog
+	err := s.ordersKeeper.RegisterProduct(
+		s.ctx,
+		s.merchant.String(),
+		"SKU-HANDBOOK",
+		"Stablecoin Handbook",
+		sdk.NewCoin("ssusd", sdkmath.NewInt(50000000)), // 50 ssUSD
+		nil,
+		10,
+		"",
+		"",
+	)
+	s.Require().NoError(err, "Product registration should succeed")
+
 	items := []orderstypes.OrderItem{
 		{
-			Id:          "ITEM-1",
-			ProductName: "Stablecoin Handbook",
-			Quantity:    1,
-			UnitPrice:   sdk.NewCoin("ssusd", sdkmath.NewInt(50000000)), // 50 ssUSD
+			Id:        "ITEM-1",
+			ProductId: "SKU-HANDBOOK",
+			Quantity:  1,
 		},
 	}
 	shipping := orderstypes.ShippingInfo{
@@ -0,0 +1,547 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+const (
+	flagCustomer = "customer"
+	flagMerchant = "merchant"
+	flagStatus   = "status"
+	flagOffset   = "offset"
+	flagLimit    = "limit"
+	flagCountry  = "country"
+)
+
+// NewQueryCmd returns the root query command for orders.
+func NewQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Orders query subcommands",
+		Aliases:                    []string{"order"},
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewQueryParamsCmd(),
+		NewGetOrderCmd(),
+		NewListOrdersCmd(),
+		NewListOrdersByCustomerCmd(),
+		NewListOrdersByMerchantCmd(),
+		NewListOrdersByStatusCmd(),
+		NewGetDisputeCmd(),
+		NewListDisputesByOrderCmd(),
+		NewListDisputesByPartyCmd(),
+		NewGetProductCmd(),
+		NewListProductsCmd(),
+		NewGetPromotionCmd(),
+		NewListPromotionsCmd(),
+		NewListTaxRatesCmd(),
+		NewTaxReportCmd(),
+	)
+
+	return cmd
+}
+
+func NewQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the orders module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [id]",
+		Short: "Query an order by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Order(cmd.Context(), &types.QueryOrderRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders",
+		Short: "List orders, optionally filtered by customer, merchant and status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			customer, err := cmd.Flags().GetString(flagCustomer)
+			if err != nil {
+				return err
+			}
+			merchant, err := cmd.Flags().GetString(flagMerchant)
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			offset, err := cmd.Flags().GetUint64(flagOffset)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Orders(cmd.Context(), &types.QueryOrdersRequest{
+				Customer: customer,
+				Merchant: merchant,
+				Status:   status,
+				Offset:   offset,
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCustomer, "", "Only orders placed by this customer")
+	cmd.Flags().String(flagMerchant, "", "Only orders placed with this merchant")
+	cmd.Flags().String(flagStatus, "", "Only orders in this status (pending, confirmed, paid, shipped, delivered, completed, cancelled, refunded, disputed)")
+	cmd.Flags().Uint64(flagOffset, 0, "Number of results to skip")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of results (0 for all)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [id]",
+		Short: "Query a dispute by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Dispute(cmd.Context(), &types.QueryDisputeRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListOrdersByCustomerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-customer [customer]",
+		Short: "List the orders placed by a customer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).OrdersByCustomer(cmd.Context(), &types.QueryOrdersByCustomerRequest{
+				Customer:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-customer")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListOrdersByMerchantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-merchant [merchant]",
+		Short: "List the orders placed with a merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).OrdersByMerchant(cmd.Context(), &types.QueryOrdersByMerchantRequest{
+				Merchant:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-merchant")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListOrdersByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders-by-status [status]",
+		Short: "List the orders in a status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).OrdersByStatus(cmd.Context(), &types.QueryOrdersByStatusRequest{
+				Status:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders-by-status")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListDisputesByOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disputes-by-order [order-id]",
+		Short: "List the disputes opened on an order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).DisputesByOrder(cmd.Context(), &types.QueryDisputesByOrderRequest{
+				OrderId:    orderId,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "disputes-by-order")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListDisputesByPartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disputes-by-party [address]",
+		Short: "List the disputes an address is the customer or merchant of",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).DisputesByParty(cmd.Context(), &types.QueryDisputesByPartyRequest{
+				Party:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "disputes-by-party")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetProductCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "product [merchant] [sku]",
+		Short: "Query a catalog product with its stock and reservations",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Product(cmd.Context(), &types.QueryProductRequest{
+				Merchant: args[0],
+				Sku:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListProductsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "products [merchant]",
+		Short: "List a merchant's catalog",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).ProductsByMerchant(cmd.Context(), &types.QueryProductsByMerchantRequest{
+				Merchant:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "products")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetPromotionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promotion [merchant] [code]",
+		Short: "Query a merchant promotion with its redemption count",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Promotion(cmd.Context(), &types.QueryPromotionRequest{
+				Merchant: args[0],
+				Code:     args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListPromotionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promotions [merchant]",
+		Short: "List a merchant's promotions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PromotionsByMerchant(cmd.Context(), &types.QueryPromotionsByMerchantRequest{
+				Merchant:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "promotions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListTaxRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-rates",
+		Short: "List tax rates, optionally for one country",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			country, err := cmd.Flags().GetString(flagCountry)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TaxRates(cmd.Context(), &types.QueryTaxRatesRequest{
+				Country:    country,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagCountry, "", "Two-letter country code to list the rates of")
+	flags.AddPaginationFlagsToCmd(cmd, "tax-rates")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTaxReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-report [merchant] [start] [end]",
+		Short: "Report the tax a merchant owes on orders paid in a period",
+		Long: `Report the tax collected on a merchant's orders paid from start up to, but not
+including, end, with the tax refunded, remitted to the tax collector and still
+due, broken down by jurisdiction. Times are RFC 3339, for example:
+
+  tax-report [merchant] 2026-01-01T00:00:00Z 2026-04-01T00:00:00Z`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			start, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+			end, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TaxLiabilityReport(cmd.Context(), &types.QueryTaxLiabilityReportRequest{
+				Merchant:  args[0],
+				StartTime: start.Unix(),
+				EndTime:   end.Unix(),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
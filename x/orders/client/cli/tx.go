@@ -0,0 +1,877 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+const (
+	flagMetadata     = "metadata"
+	flagUseEscrow    = "use-escrow"
+	flagFullRefund   = "full-refund"
+	flagEvidence     = "evidence"
+	flagRefundAmount = "refund-amount"
+	flagToCustomer   = "to-customer"
+	flagStock        = "stock"
+	flagVariantsFile = "variants-file"
+	flagName         = "name"
+	flagPrice        = "price"
+	flagActive       = "active"
+	flagVariant      = "variant"
+	flagCoupon       = "coupon"
+	flagMinSubtotal  = "min-subtotal"
+	flagMaxUses      = "max-redemptions"
+	flagMaxPerBuyer  = "max-redemptions-per-customer"
+	flagSKUs         = "skus"
+	flagStartsAt     = "starts-at"
+	flagEndsAt       = "ends-at"
+	flagTaxCategory  = "tax-category"
+	flagState        = "state"
+	flagPostalCode   = "postal-code"
+)
+
+// NewTxCmd returns the root tx command for order operations.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Orders transaction subcommands",
+		Aliases:                    []string{"order"},
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCreateOrderCmd(),
+		NewConfirmOrderCmd(),
+		NewPayOrderCmd(),
+		NewShipOrderCmd(),
+		NewDeliverOrderCmd(),
+		NewCompleteOrderCmd(),
+		NewCancelOrderCmd(),
+		NewRefundOrderCmd(),
+		NewOpenDisputeCmd(),
+		NewResolveDisputeCmd(),
+		NewRegisterProductCmd(),
+		NewUpdateProductCmd(),
+		NewAdjustInventoryCmd(),
+		NewCreatePromotionCmd(),
+		NewDeactivatePromotionCmd(),
+		NewSetTaxRateCmd(),
+		NewRemoveTaxRateCmd(),
+	)
+
+	return cmd
+}
+
+func NewCreateOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-order [merchant] [items-json-file] [shipping-json-file]",
+		Short: "Create an order with the merchant from JSON files of items and shipping info",
+		Long: `Create an order with the merchant. The items file holds a JSON array of
+order items, for example:
+
+  [{"id": "1", "product_id": "sku-42", "quantity": 2},
+   {"id": "2", "product_id": "tee", "variant": "m", "quantity": 1}]
+
+Items are priced from the merchant's catalog on chain. The optional shipping file holds the
+shipping info as a JSON object, for example:
+
+  {"address": {"name": "Ada", "line1": "1 Main St", "city": "Austin",
+   "state": "TX", "postal_code": "78701", "country": "US"}, "method": "ground"}`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var items []types.OrderItem
+			if err := readJSONFile(args[1], &items); err != nil {
+				return err
+			}
+
+			var shippingInfo types.ShippingInfo
+			if len(args) > 2 {
+				if err := readJSONFile(args[2], &shippingInfo); err != nil {
+					return err
+				}
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			coupon, err := cmd.Flags().GetString(flagCoupon)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateOrder(clientCtx.GetFromAddress().String(), args[0], items, shippingInfo, metadata)
+			msg.CouponCode = coupon
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMetadata, "", "Optional order metadata")
+	cmd.Flags().String(flagCoupon, "", "Coupon code of a merchant promotion to apply")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewConfirmOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-order [order-id]",
+		Short: "Confirm a pending order as its merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConfirmOrder(clientCtx.GetFromAddress().String(), orderId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewPayOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-order [order-id] [amount]",
+		Short: "Pay for an order, directly or into escrow with --use-escrow",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			useEscrow, err := cmd.Flags().GetBool(flagUseEscrow)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayOrder(clientCtx.GetFromAddress().String(), orderId, amount, useEscrow)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagUseEscrow, false, "Hold the payment in settlement escrow until the order completes")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewShipOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ship-order [order-id] [carrier] [tracking-number]",
+		Short: "Mark a paid order as shipped as its merchant",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgShipOrder(clientCtx.GetFromAddress().String(), orderId, args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDeliverOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deliver-order [order-id]",
+		Short: "Mark a shipped order as delivered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeliverOrder(clientCtx.GetFromAddress().String(), orderId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCompleteOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "complete-order [order-id]",
+		Short: "Complete a delivered order as its customer, releasing any escrow to the merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCompleteOrder(clientCtx.GetFromAddress().String(), orderId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [order-id] [reason]",
+		Short: "Cancel an order as its customer or merchant",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			reason := ""
+			if len(args) > 1 {
+				reason = args[1]
+			}
+
+			msg := types.NewMsgCancelOrder(clientCtx.GetFromAddress().String(), orderId, reason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRefundOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-order [order-id] [amount] [reason]",
+		Short: "Refund a paid order as its merchant",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			reason := ""
+			if len(args) > 2 {
+				reason = args[2]
+			}
+
+			fullRefund, err := cmd.Flags().GetBool(flagFullRefund)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundOrder(clientCtx.GetFromAddress().String(), orderId, amount, reason, fullRefund)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagFullRefund, false, "Refund the full order amount")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewOpenDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-dispute [order-id] [reason] [description]",
+		Short: "Open a dispute on an order as its customer",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			description := ""
+			if len(args) > 2 {
+				description = args[2]
+			}
+
+			evidence, err := cmd.Flags().GetStringSlice(flagEvidence)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenDispute(clientCtx.GetFromAddress().String(), orderId, args[1], description, evidence)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagEvidence, nil, "Evidence references such as URLs or content hashes (comma separated or repeated)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewResolveDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-dispute [dispute-id] [resolution]",
+		Short: "Resolve a dispute (authority only)",
+		Long: `Resolve a dispute. With --to-customer and a positive --refund-amount the order
+is refunded to the customer; otherwise payment is released to the merchant.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			disputeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			refundAmount := sdk.Coin{Amount: sdkmath.ZeroInt()}
+			refundStr, err := cmd.Flags().GetString(flagRefundAmount)
+			if err != nil {
+				return err
+			}
+			if refundStr != "" {
+				refundAmount, err = sdk.ParseCoinNormalized(refundStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			toCustomer, err := cmd.Flags().GetBool(flagToCustomer)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveDispute(clientCtx.GetFromAddress().String(), disputeId, args[1], refundAmount, toCustomer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRefundAmount, "", "Amount refunded to the customer")
+	cmd.Flags().Bool(flagToCustomer, false, "Resolve in the customer's favor")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRegisterProductCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-product [sku] [name] [price]",
+		Short: "Add a SKU to your merchant catalog",
+		Long: `Add a SKU to your merchant catalog. Once you have a catalog, orders placed with
+you are priced from it and reserve its stock. Variants are read from a JSON
+array, for example:
+
+  [{"id": "red-m", "name": "Red, M", "stock": 10},
+   {"id": "red-l", "name": "Red, L", "stock": 4, "price": {"denom": "ssusd", "amount": "6000000"}}]
+
+A variant without a price sells at the product's price. Products with
+variants keep stock per variant, so --stock is ignored for them.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			variants, err := readVariants(cmd)
+			if err != nil {
+				return err
+			}
+
+			stock, err := cmd.Flags().GetUint64(flagStock)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			taxCategory, err := cmd.Flags().GetString(flagTaxCategory)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterProduct(clientCtx.GetFromAddress().String(), args[0], args[1], price, variants, stock, metadata, taxCategory)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagStock, 0, "Initial stock of a product without variants")
+	cmd.Flags().String(flagVariantsFile, "", "JSON file with the product's variants")
+	cmd.Flags().String(flagMetadata, "", "Optional product metadata")
+	cmd.Flags().String(flagTaxCategory, "", "Tax category the product is taxed under")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewUpdateProductCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-product [sku]",
+		Short: "Change the name, price, variants, active flag, metadata or tax category of a catalog product",
+		Long: `Change a product in your catalog. Fields without a flag keep their current
+value. --variants-file replaces the variant list; variants keep their stock by
+ID, so use adjust-inventory to change stock.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			merchant := clientCtx.GetFromAddress().String()
+
+			res, err := types.NewQueryClient(clientCtx).Product(cmd.Context(), &types.QueryProductRequest{Merchant: merchant, Sku: args[0]})
+			if err != nil {
+				return err
+			}
+			product := res.Product
+
+			if cmd.Flags().Changed(flagName) {
+				if product.Name, err = cmd.Flags().GetString(flagName); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(flagPrice) {
+				priceStr, err := cmd.Flags().GetString(flagPrice)
+				if err != nil {
+					return err
+				}
+				if product.Price, err = sdk.ParseCoinNormalized(priceStr); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(flagVariantsFile) {
+				if product.Variants, err = readVariants(cmd); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(flagActive) {
+				if product.Active, err = cmd.Flags().GetBool(flagActive); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(flagMetadata) {
+				if product.Metadata, err = cmd.Flags().GetString(flagMetadata); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(flagTaxCategory) {
+				if product.TaxCategory, err = cmd.Flags().GetString(flagTaxCategory); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateProduct(merchant, product.Sku, product.Name, product.Price, product.Variants, product.Active, product.Metadata, product.TaxCategory)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "New product name")
+	cmd.Flags().String(flagPrice, "", "New product price")
+	cmd.Flags().String(flagVariantsFile, "", "JSON file with the product's new variants")
+	cmd.Flags().Bool(flagActive, true, "Whether the product can be ordered")
+	cmd.Flags().String(flagMetadata, "", "New product metadata")
+	cmd.Flags().String(flagTaxCategory, "", "New product tax category")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAdjustInventoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adjust-inventory [sku] [delta]",
+		Short: "Add to or remove from the stock of a catalog product",
+		Long: `Add delta to the stock of a product, or of one of its variants with --variant.
+Pass a negative delta after "--" to remove stock, for example
+"adjust-inventory sku-42 -- -3". Stock cannot drop below what open orders
+have reserved.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delta, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			variant, err := cmd.Flags().GetString(flagVariant)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAdjustInventory(clientCtx.GetFromAddress().String(), args[0], variant, delta)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVariant, "", "Variant whose stock to adjust")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCreatePromotionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-promotion [code] [percentage|fixed] [value]",
+		Short: "Publish a coupon code for orders placed with you",
+		Long: `Publish a coupon code customers can apply to orders placed with you. For a
+percentage promotion the value is in basis points, for a fixed promotion it is
+an amount, for example:
+
+  create-promotion SPRING10 percentage 1000 --max-redemptions 500
+  create-promotion TAKE5 fixed 5000000ssusd --min-subtotal 20000000ssusd --skus sku-42,sku-43
+
+The discount applies to the items matching --skus, or to every item when it is
+not set. Times are RFC 3339; a promotion without --ends-at runs until it is
+deactivated.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var percentageBps uint64
+			var fixedAmount sdk.Coin
+			switch args[1] {
+			case types.DiscountTypePercentage:
+				percentageBps, err = strconv.ParseUint(args[2], 10, 32)
+			case types.DiscountTypeFixed:
+				fixedAmount, err = sdk.ParseCoinNormalized(args[2])
+			default:
+				err = fmt.Errorf("discount type must be %s or %s", types.DiscountTypePercentage, types.DiscountTypeFixed)
+			}
+			if err != nil {
+				return err
+			}
+
+			var minSubtotal sdk.Coin
+			if s, _ := cmd.Flags().GetString(flagMinSubtotal); s != "" {
+				if minSubtotal, err = sdk.ParseCoinNormalized(s); err != nil {
+					return err
+				}
+			}
+
+			maxRedemptions, err := cmd.Flags().GetUint64(flagMaxUses)
+			if err != nil {
+				return err
+			}
+			maxPerCustomer, err := cmd.Flags().GetUint64(flagMaxPerBuyer)
+			if err != nil {
+				return err
+			}
+			skus, err := cmd.Flags().GetStringSlice(flagSKUs)
+			if err != nil {
+				return err
+			}
+			startsAt, err := readTimeFlag(cmd, flagStartsAt)
+			if err != nil {
+				return err
+			}
+			endsAt, err := readTimeFlag(cmd, flagEndsAt)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePromotion(clientCtx.GetFromAddress().String(), args[0], args[1], uint32(percentageBps), fixedAmount, minSubtotal, maxRedemptions, maxPerCustomer, skus, startsAt, endsAt)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMinSubtotal, "", "Order subtotal required to apply the coupon")
+	cmd.Flags().Uint64(flagMaxUses, 0, "Total redemptions allowed (0 for unlimited)")
+	cmd.Flags().Uint64(flagMaxPerBuyer, 0, "Redemptions allowed per customer (0 for unlimited)")
+	cmd.Flags().StringSlice(flagSKUs, nil, "Comma-separated SKUs the discount applies to")
+	cmd.Flags().String(flagStartsAt, "", "Time the coupon becomes valid")
+	cmd.Flags().String(flagEndsAt, "", "Time the coupon stops being valid")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewDeactivatePromotionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate-promotion [code]",
+		Short: "Stop a coupon code from being applied to new orders",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeactivatePromotion(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetTaxRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tax-rate [country] [rate-bps]",
+		Short: "Add or replace a tax rate for a jurisdiction (authority)",
+		Long: `Add or replace the tax rate, in basis points, for a country or, with --state
+and --postal-code, a region of it. --tax-category limits the rate to products
+of that tax category; without it the rate is the jurisdiction's default. For
+example, 8.25% in Austin, Texas and no tax on groceries in Texas:
+
+  set-tax-rate US 825 --state TX --postal-code 78701
+  set-tax-rate US 0 --state TX --tax-category grocery`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rateBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			country, state, postalCode, category, err := readTaxJurisdiction(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTaxRate(clientCtx.GetFromAddress().String(), types.TaxRate{
+				Country:    country,
+				State:      state,
+				PostalCode: postalCode,
+				Category:   category,
+				RateBps:    uint32(rateBps),
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTaxJurisdictionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRemoveTaxRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-tax-rate [country]",
+		Short: "Remove the tax rate of a jurisdiction (authority)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			country, state, postalCode, category, err := readTaxJurisdiction(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveTaxRate(clientCtx.GetFromAddress().String(), country, state, postalCode, category)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTaxJurisdictionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addTaxJurisdictionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagState, "", "State or region of the jurisdiction")
+	cmd.Flags().String(flagPostalCode, "", "Postal code of the jurisdiction")
+	cmd.Flags().String(flagTaxCategory, "", "Product tax category the rate applies to")
+}
+
+// readTaxJurisdiction returns the normalized jurisdiction and category of a
+// tax rate command.
+func readTaxJurisdiction(cmd *cobra.Command, country string) (string, string, string, string, error) {
+	state, err := cmd.Flags().GetString(flagState)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	postalCode, err := cmd.Flags().GetString(flagPostalCode)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	category, err := cmd.Flags().GetString(flagTaxCategory)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return types.NormalizeTaxRegion(country), types.NormalizeTaxRegion(state), types.NormalizeTaxRegion(postalCode), category, nil
+}
+
+func readTimeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	s, err := cmd.Flags().GetString(name)
+	if err != nil || s == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func readVariants(cmd *cobra.Command) ([]types.ProductVariant, error) {
+	path, err := cmd.Flags().GetString(flagVariantsFile)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	var variants []types.ProductVariant
+	if err := readJSONFile(path, &variants); err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+func readJSONFile(path string, v interface{}) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(bz, v); err != nil {
+		return fmt.Errorf("invalid JSON in %s: %w", path, err)
+	}
+	return nil
+}
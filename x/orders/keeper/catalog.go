@@ -0,0 +1,376 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// ============================================================================
+// Catalog and Inventory
+// ============================================================================
+
+// Merchants list SKUs with a price, optional variants and stock. CreateOrder
+// prices every item from the merchant's catalog, rejecting unknown or
+// inactive SKUs, and reserves the ordered quantities. An unpaid order expires
+// after max_inventory_hold at the latest, so unpaid orders cannot hold stock
+// for long. The reservation is released when the order is cancelled or
+// expires, or refunded before shipping, and is taken out of stock when the
+// order completes or is refunded after shipping.
+
+func merchantProductsPrefix(merchant string) []byte {
+	return indexValuePrefix(types.ProductKeyPrefix, merchant)
+}
+
+func productKey(merchant, sku string) []byte {
+	return append(merchantProductsPrefix(merchant), []byte(sku)...)
+}
+
+func (k Keeper) setProduct(ctx sdk.Context, product types.Product) {
+	ctx.KVStore(k.storeKey).Set(productKey(product.Merchant, product.Sku), types.ModuleCdc.MustMarshalJSON(&product))
+}
+
+// GetProduct retrieves a product by merchant and SKU.
+func (k Keeper) GetProduct(ctx sdk.Context, merchant, sku string) (types.Product, bool) {
+	if !validIndexedValue(merchant) {
+		return types.Product{}, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(productKey(merchant, sku))
+	if len(bz) == 0 {
+		return types.Product{}, false
+	}
+	var product types.Product
+	types.ModuleCdc.MustUnmarshalJSON(bz, &product)
+	return product, true
+}
+
+// IterateProducts iterates over all products.
+func (k Keeper) IterateProducts(ctx sdk.Context, cb func(types.Product) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProductKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var product types.Product
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &product)
+		if cb(product) {
+			break
+		}
+	}
+}
+
+// ProductsByMerchant returns a page of a merchant's products in SKU order.
+func (k Keeper) ProductsByMerchant(ctx sdk.Context, merchant string, pageReq *query.PageRequest) ([]types.Product, *query.PageResponse, error) {
+	if !validIndexedValue(merchant) {
+		return nil, nil, types.ErrInvalidMerchant
+	}
+	page := query.PageRequest{}
+	if pageReq != nil {
+		page = *pageReq
+	}
+	if page.Limit == 0 || page.Limit > MaxQueryLimit {
+		page.Limit = MaxQueryLimit
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), merchantProductsPrefix(merchant))
+	var products []types.Product
+	pageRes, err := query.Paginate(store, &page, func(_, value []byte) error {
+		var product types.Product
+		types.ModuleCdc.MustUnmarshalJSON(value, &product)
+		products = append(products, product)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return products, pageRes, nil
+}
+
+// RegisterProduct adds a SKU to a merchant's catalog.
+func (k Keeper) RegisterProduct(ctx sdk.Context, merchant, sku, name string, price sdk.Coin, variants []types.ProductVariant, stock uint64, metadata, taxCategory string) error {
+	if _, err := sdk.AccAddressFromBech32(merchant); err != nil {
+		return types.ErrInvalidMerchant
+	}
+	if err := types.ValidateProductTerms(sku, name, price, variants); err != nil {
+		return err
+	}
+	if err := types.ValidateTaxCategory(taxCategory); err != nil {
+		return err
+	}
+	if denom := k.GetParams(ctx).StablecoinDenom; price.Denom != denom {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "price must be in %s", denom)
+	}
+	if _, found := k.GetProduct(ctx, merchant, sku); found {
+		return types.ErrProductExists
+	}
+
+	product := types.Product{
+		Merchant:    merchant,
+		Sku:         sku,
+		Name:        name,
+		Price:       price,
+		Variants:    make([]types.ProductVariant, len(variants)),
+		Stock:       stock,
+		Active:      true,
+		Metadata:    metadata,
+		TaxCategory: taxCategory,
+		CreatedAt:   ctx.BlockTime(),
+		UpdatedAt:   ctx.BlockTime(),
+	}
+	for i, variant := range variants {
+		variant.Reserved = 0
+		product.Variants[i] = variant
+	}
+	k.setProduct(ctx, product)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"product_registered",
+			sdk.NewAttribute("merchant", merchant),
+			sdk.NewAttribute("sku", sku),
+			sdk.NewAttribute("price", price.String()),
+		),
+	)
+
+	return nil
+}
+
+// UpdateProduct replaces a product's name, price, variants, active flag,
+// metadata and tax category. Variants keep their stock and reservations by ID; a variant with
+// reservations cannot be removed, and a product cannot switch between having
+// variants and not while it has reservations.
+func (k Keeper) UpdateProduct(ctx sdk.Context, merchant, sku, name string, price sdk.Coin, variants []types.ProductVariant, active bool, metadata, taxCategory string) error {
+	product, found := k.GetProduct(ctx, merchant, sku)
+	if !found {
+		return types.ErrProductNotFound
+	}
+	if err := types.ValidateProductTerms(sku, name, price, variants); err != nil {
+		return err
+	}
+	if err := types.ValidateTaxCategory(taxCategory); err != nil {
+		return err
+	}
+	if denom := k.GetParams(ctx).StablecoinDenom; price.Denom != denom {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "price must be in %s", denom)
+	}
+	if (len(product.Variants) == 0) != (len(variants) == 0) && product.Reserved > 0 {
+		return errorsmod.Wrap(types.ErrInvalidProduct, "cannot add or remove all variants while stock is reserved")
+	}
+
+	updated := make([]types.ProductVariant, len(variants))
+	for i, variant := range variants {
+		variant.Stock, variant.Reserved = 0, 0
+		if j := product.VariantIndex(variant.Id); j >= 0 {
+			variant.Stock = product.Variants[j].Stock
+			variant.Reserved = product.Variants[j].Reserved
+		}
+		updated[i] = variant
+	}
+	next := types.Product{Variants: updated}
+	for _, existing := range product.Variants {
+		if existing.Reserved > 0 && next.VariantIndex(existing.Id) < 0 {
+			return errorsmod.Wrapf(types.ErrInvalidProduct, "variant %s has reserved stock", existing.Id)
+		}
+	}
+
+	product.Name = name
+	product.Price = price
+	product.Variants = updated
+	product.Active = active
+	product.Metadata = metadata
+	product.TaxCategory = taxCategory
+	product.UpdatedAt = ctx.BlockTime()
+	k.setProduct(ctx, product)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"product_updated",
+			sdk.NewAttribute("merchant", merchant),
+			sdk.NewAttribute("sku", sku),
+			sdk.NewAttribute("price", price.String()),
+			sdk.NewAttribute("active", fmt.Sprintf("%t", active)),
+		),
+	)
+
+	return nil
+}
+
+// AdjustInventory adds delta to the stock of a product, or of one of its
+// variants, and returns the new stock. Stock cannot drop below what open
+// orders have reserved.
+func (k Keeper) AdjustInventory(ctx sdk.Context, merchant, sku, variantId string, delta int64) (uint64, error) {
+	product, found := k.GetProduct(ctx, merchant, sku)
+	if !found {
+		return 0, types.ErrProductNotFound
+	}
+
+	stock, reserved := &product.Stock, product.Reserved
+	if len(product.Variants) > 0 || variantId != "" {
+		i := product.VariantIndex(variantId)
+		if i < 0 {
+			return 0, errorsmod.Wrapf(types.ErrProductNotFound, "variant %q of %s", variantId, sku)
+		}
+		stock, reserved = &product.Variants[i].Stock, product.Variants[i].Reserved
+	}
+
+	if delta < 0 {
+		decrease := uint64(-delta)
+		if *stock < reserved+decrease {
+			return 0, errorsmod.Wrapf(types.ErrInsufficientStock, "%d in stock, %d reserved", *stock, reserved)
+		}
+		*stock -= decrease
+	} else {
+		*stock += uint64(delta)
+	}
+	newStock := *stock
+
+	product.UpdatedAt = ctx.BlockTime()
+	k.setProduct(ctx, product)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inventory_adjusted",
+			sdk.NewAttribute("merchant", merchant),
+			sdk.NewAttribute("sku", sku),
+			sdk.NewAttribute("variant", variantId),
+			sdk.NewAttribute("delta", fmt.Sprintf("%d", delta)),
+			sdk.NewAttribute("stock", fmt.Sprintf("%d", newStock)),
+		),
+	)
+
+	return newStock, nil
+}
+
+// priceFromCatalog prices order items from the merchant's catalog and returns
+// the products with the items' quantities reserved. Nothing is written, so
+// the caller can still reject the order.
+func (k Keeper) priceFromCatalog(ctx sdk.Context, merchant string, items []types.OrderItem) ([]types.Product, error) {
+	products := make(map[string]*types.Product)
+	var order []string
+
+	for i := range items {
+		item := &items[i]
+		if item.Quantity == 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidOrder, "item %s has no quantity", item.ProductId)
+		}
+
+		product, loaded := products[item.ProductId]
+		if !loaded {
+			p, found := k.GetProduct(ctx, merchant, item.ProductId)
+			if !found || !p.Active {
+				return nil, errorsmod.Wrapf(types.ErrProductNotFound, "sku %q", item.ProductId)
+			}
+			product = &p
+			products[item.ProductId] = product
+			order = append(order, item.ProductId)
+		}
+
+		price := product.Price
+		if len(product.Variants) > 0 {
+			j := product.VariantIndex(item.Variant)
+			if j < 0 {
+				return nil, errorsmod.Wrapf(types.ErrProductNotFound, "variant %q of %s", item.Variant, product.Sku)
+			}
+			variant := &product.Variants[j]
+			if variant.Available() < item.Quantity {
+				return nil, errorsmod.Wrapf(types.ErrInsufficientStock, "%d of %s/%s available", variant.Available(), product.Sku, variant.Id)
+			}
+			variant.Reserved += item.Quantity
+			if variant.HasPrice() {
+				price = variant.Price
+			}
+		} else {
+			if item.Variant != "" {
+				return nil, errorsmod.Wrapf(types.ErrProductNotFound, "%s has no variants", product.Sku)
+			}
+			if product.Available() < item.Quantity {
+				return nil, errorsmod.Wrapf(types.ErrInsufficientStock, "%d of %s available", product.Available(), product.Sku)
+			}
+			product.Reserved += item.Quantity
+		}
+
+		item.ProductName = product.Name
+		item.UnitPrice = price
+		item.TaxCategory = product.TaxCategory
+	}
+
+	reserved := make([]types.Product, 0, len(order))
+	for _, sku := range order {
+		product := *products[sku]
+		product.UpdatedAt = ctx.BlockTime()
+		reserved = append(reserved, product)
+	}
+	return reserved, nil
+}
+
+// settleInventory ends an order's stock reservation once the order is
+// finished: completed orders and orders refunded after shipping take the
+// quantities out of stock, cancelled orders and orders refunded before
+// shipping return them. Items whose product or variant has since been removed
+// are skipped.
+func (k Keeper) settleInventory(ctx sdk.Context, order *types.Order) {
+	if !order.InventoryReserved {
+		return
+	}
+
+	var consume bool
+	switch order.Status {
+	case types.OrderStatusCompleted:
+		consume = true
+	case types.OrderStatusRefunded:
+		consume = !order.ShippedAt.IsZero()
+	case types.OrderStatusCancelled:
+		consume = false
+	default:
+		return
+	}
+
+	products := make(map[string]*types.Product)
+	var skus []string
+	for _, item := range order.Items {
+		product, loaded := products[item.ProductId]
+		if !loaded {
+			p, found := k.GetProduct(ctx, order.Merchant, item.ProductId)
+			if !found {
+				continue
+			}
+			product = &p
+			products[item.ProductId] = product
+			skus = append(skus, item.ProductId)
+		}
+
+		stock, reserved := &product.Stock, &product.Reserved
+		if j := product.VariantIndex(item.Variant); j >= 0 {
+			stock, reserved = &product.Variants[j].Stock, &product.Variants[j].Reserved
+		} else if item.Variant != "" {
+			continue
+		}
+		quantity := item.Quantity
+		if quantity > *reserved {
+			quantity = *reserved
+		}
+		*reserved -= quantity
+		if consume {
+			*stock -= quantity
+		}
+	}
+
+	for _, sku := range skus {
+		product := products[sku]
+		product.UpdatedAt = ctx.BlockTime()
+		k.setProduct(ctx, *product)
+	}
+	order.InventoryReserved = false
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"inventory_settled",
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute("consumed", fmt.Sprintf("%t", consume)),
+		),
+	)
+}
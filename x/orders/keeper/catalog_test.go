@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/orders/keeper"
+	ordertypes "github.com/stateset/core/x/orders/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
+)
+
+func ssusdCoin(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(stablecointypes.StablecoinDenom, amount)
+}
+
+// listItems registers the SKUs of order items that a merchant has not listed
+// yet, priced at the items' unit prices.
+func listItems(t *testing.T, k keeper.Keeper, ctx sdk.Context, merchant sdk.AccAddress, items ...ordertypes.OrderItem) {
+	t.Helper()
+	for _, item := range items {
+		if _, found := k.GetProduct(ctx, merchant.String(), item.ProductId); found {
+			continue
+		}
+		name := item.ProductName
+		if name == "" {
+			name = item.ProductId
+		}
+		require.NoError(t, k.RegisterProduct(ctx, merchant.String(), item.ProductId, name, item.UnitPrice, nil, 1000, "", ""))
+	}
+}
+
+func createCatalogOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress, items ...ordertypes.OrderItem) (uint64, error) {
+	t.Helper()
+	return k.CreateOrder(ctx, customer.String(), merchant.String(), items, ordertypes.ShippingInfo{}, "")
+}
+
+func TestCreateOrder_PricesFromCatalog(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	require.NoError(t, k.RegisterProduct(ctx, merchant.String(), "sku-1", "Widget", ssusdCoin(500), nil, 3, "", ""))
+	require.ErrorIs(t, k.RegisterProduct(ctx, merchant.String(), "sku-1", "Widget", ssusdCoin(500), nil, 3, "", ""), ordertypes.ErrProductExists)
+	require.ErrorIs(t, k.RegisterProduct(ctx, merchant.String(), "sku-2", "Gadget", sdk.NewInt64Coin("stake", 500), nil, 3, "", ""), ordertypes.ErrInvalidAmount)
+
+	// The customer's price is replaced by the catalog's
+	orderId, err := createCatalogOrder(t, k, ctx, customer, merchant, ordertypes.OrderItem{
+		Id: "1", ProductId: "sku-1", Quantity: 2, UnitPrice: ssusdCoin(1),
+	})
+	require.NoError(t, err)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(1000), order.TotalAmount)
+	require.Equal(t, "Widget", order.Items[0].ProductName)
+	require.True(t, order.InventoryReserved)
+
+	product, _ := k.GetProduct(ctx, merchant.String(), "sku-1")
+	require.Equal(t, uint64(2), product.Reserved)
+	require.Equal(t, uint64(1), product.Available())
+
+	_, err = createCatalogOrder(t, k, ctx, customer, merchant, ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 2})
+	require.ErrorIs(t, err, ordertypes.ErrInsufficientStock)
+
+	_, err = createCatalogOrder(t, k, ctx, customer, merchant, ordertypes.OrderItem{Id: "1", ProductId: "sku-9", Quantity: 1})
+	require.ErrorIs(t, err, ordertypes.ErrProductNotFound)
+
+	// Merchants without a catalog cannot take orders at the customer's price
+	_, err = createCatalogOrder(t, k, ctx, customer, newOrdersAddress(), ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1, UnitPrice: ssusdCoin(1)})
+	require.ErrorIs(t, err, ordertypes.ErrProductNotFound)
+
+	// Inactive products cannot be ordered
+	require.NoError(t, k.UpdateProduct(ctx, merchant.String(), "sku-1", "Widget", ssusdCoin(500), nil, false, "", ""))
+	_, err = createCatalogOrder(t, k, ctx, customer, merchant, ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1})
+	require.ErrorIs(t, err, ordertypes.ErrProductNotFound)
+}
+
+func TestInventory_ReleasedAndConsumed(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	require.NoError(t, k.RegisterProduct(ctx, merchant.String(), "sku-1", "Widget", ssusdCoin(500), nil, 10, "", ""))
+	item := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 2}
+
+	cancelled, err := createCatalogOrder(t, k, ctx, customer, merchant, item)
+	require.NoError(t, err)
+	expiring, err := createCatalogOrder(t, k, ctx, customer, merchant, item)
+	require.NoError(t, err)
+	completed, err := createCatalogOrder(t, k, ctx, customer, merchant, item)
+	require.NoError(t, err)
+
+	product, _ := k.GetProduct(ctx, merchant.String(), "sku-1")
+	require.Equal(t, uint64(6), product.Reserved)
+
+	// Unpaid orders hold their reservation for at most max_inventory_hold
+	order, _ := k.GetOrder(ctx, expiring)
+	require.Equal(t, ctx.BlockTime().Add(30*time.Minute), order.ExpiresAt)
+
+	// Cancelling returns the reservation
+	require.NoError(t, k.CancelOrder(ctx, customer.String(), cancelled, "changed mind"))
+	product, _ = k.GetProduct(ctx, merchant.String(), "sku-1")
+	require.Equal(t, uint64(10), product.Stock)
+	require.Equal(t, uint64(4), product.Reserved)
+
+	// Completing takes it out of stock
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), completed))
+	require.NoError(t, k.PayOrder(ctx, customer.String(), completed, ssusdCoin(1000), false))
+	require.NoError(t, k.ShipOrder(ctx, merchant.String(), completed, "ups", "1Z"))
+	require.NoError(t, k.DeliverOrder(ctx, customer.String(), completed))
+	require.NoError(t, k.CompleteOrder(ctx, customer.String(), completed))
+	product, _ = k.GetProduct(ctx, merchant.String(), "sku-1")
+	require.Equal(t, uint64(8), product.Stock)
+	require.Equal(t, uint64(2), product.Reserved)
+	order, _ = k.GetOrder(ctx, completed)
+	require.False(t, order.InventoryReserved)
+
+	// Expiry returns the reservation
+	k.ProcessExpiredOrders(ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)))
+	order, _ = k.GetOrder(ctx, expiring)
+	require.Equal(t, ordertypes.OrderStatusCancelled, order.Status)
+	product, _ = k.GetProduct(ctx, merchant.String(), "sku-1")
+	require.Equal(t, uint64(8), product.Stock)
+	require.Equal(t, uint64(0), product.Reserved)
+}
+
+func TestCatalog_VariantsAndAdjustments(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	variants := []ordertypes.ProductVariant{
+		{Id: "m", Name: "Medium", Stock: 2},
+		{Id: "l", Name: "Large", Stock: 1, Price: ssusdCoin(700)},
+	}
+	require.NoError(t, k.RegisterProduct(ctx, merchant.String(), "tee", "T-shirt", ssusdCoin(500), variants, 0, "", ""))
+
+	orderId, err := createCatalogOrder(t, k, ctx, customer, merchant,
+		ordertypes.OrderItem{Id: "1", ProductId: "tee", Variant: "m", Quantity: 2},
+		ordertypes.OrderItem{Id: "2", ProductId: "tee", Variant: "l", Quantity: 1},
+	)
+	require.NoError(t, err)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(1700), order.TotalAmount)
+
+	_, err = createCatalogOrder(t, k, ctx, customer, merchant, ordertypes.OrderItem{Id: "1", ProductId: "tee", Quantity: 1})
+	require.ErrorIs(t, err, ordertypes.ErrProductNotFound)
+
+	// Reserved stock cannot be removed, nor can a variant holding it
+	_, err = k.AdjustInventory(ctx, merchant.String(), "tee", "m", -1)
+	require.ErrorIs(t, err, ordertypes.ErrInsufficientStock)
+	stock, err := k.AdjustInventory(ctx, merchant.String(), "tee", "m", 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), stock)
+	require.ErrorIs(t, k.UpdateProduct(ctx, merchant.String(), "tee", "T-shirt", ssusdCoin(500), variants[:1], true, "", ""), ordertypes.ErrInvalidProduct)
+
+	// Updating keeps stock and reservations by variant ID
+	require.NoError(t, k.UpdateProduct(ctx, merchant.String(), "tee", "Tee", ssusdCoin(550), []ordertypes.ProductVariant{
+		{Id: "l", Name: "Large"}, {Id: "m", Name: "Medium", Stock: 99},
+	}, true, "", ""))
+	product, _ := k.GetProduct(ctx, merchant.String(), "tee")
+	require.Equal(t, uint64(7), product.Variants[1].Stock)
+	require.Equal(t, uint64(2), product.Variants[1].Reserved)
+	require.Equal(t, uint64(1), product.Variants[0].Reserved)
+
+	// Genesis carries the catalog
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Products, 1)
+}
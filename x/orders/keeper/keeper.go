@@ -91,13 +91,16 @@ func (k Keeper) setNextOrderID(ctx sdk.Context, id uint64) {
 	store.Set(types.NextOrderIDKey, bz)
 }
 
+// getNextDisputeID returns one past the highest stored dispute ID. Disputes
+// are keyed by big-endian ID, so the highest is the last key in the store.
 func (k Keeper) getNextDisputeID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.DisputeKeyPrefix)
-	if len(bz) == 0 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisputeKeyPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
 		return 1
 	}
-	return binary.BigEndian.Uint64(bz) + 1
+	return binary.BigEndian.Uint64(iterator.Key()) + 1
 }
 
 func mustBz(id uint64) []byte {
@@ -111,31 +114,17 @@ func mustBz(id uint64) []byte {
 // ============================================================================
 
 func (k Keeper) setOrder(ctx sdk.Context, order types.Order) {
+	previous, found := k.GetOrder(ctx, order.Id)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderKeyPrefix)
 	store.Set(mustBz(order.Id), types.ModuleCdc.MustMarshalJSON(&order))
 
 	// Update indexes
-	k.indexOrderByCustomer(ctx, order)
-	k.indexOrderByMerchant(ctx, order)
-	k.indexOrderByStatus(ctx, order)
-}
-
-func (k Keeper) indexOrderByCustomer(ctx sdk.Context, order types.Order) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderByCustomerKeyPrefix)
-	key := append([]byte(order.Customer), mustBz(order.Id)...)
-	store.Set(key, mustBz(order.Id))
-}
-
-func (k Keeper) indexOrderByMerchant(ctx sdk.Context, order types.Order) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderByMerchantKeyPrefix)
-	key := append([]byte(order.Merchant), mustBz(order.Id)...)
-	store.Set(key, mustBz(order.Id))
-}
-
-func (k Keeper) indexOrderByStatus(ctx sdk.Context, order types.Order) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderByStatusKeyPrefix)
-	key := append([]byte(order.Status), mustBz(order.Id)...)
-	store.Set(key, mustBz(order.Id))
+	if found {
+		k.indexOrder(ctx, &previous, order)
+	} else {
+		k.indexOrder(ctx, nil, order)
+	}
 }
 
 // GetOrder retrieves an order by ID.
@@ -156,6 +145,12 @@ func (k Keeper) GetOrder(ctx sdk.Context, id uint64) (types.Order, bool) {
 
 // CreateOrder creates a new order.
 func (k Keeper) CreateOrder(ctx sdk.Context, customer, merchant string, items []types.OrderItem, shippingInfo types.ShippingInfo, metadata string) (uint64, error) {
+	return k.CreateOrderWithCoupon(ctx, customer, merchant, items, shippingInfo, metadata, "")
+}
+
+// CreateOrderWithCoupon creates a new order, discounted by the merchant's
+// promotion for couponCode when it is not empty.
+func (k Keeper) CreateOrderWithCoupon(ctx sdk.Context, customer, merchant string, items []types.OrderItem, shippingInfo types.ShippingInfo, metadata, couponCode string) (uint64, error) {
 	wrappedCtx := sdk.WrapSDKContext(ctx)
 
 	customerAddr, err := sdk.AccAddressFromBech32(customer)
@@ -177,6 +172,14 @@ func (k Keeper) CreateOrder(ctx sdk.Context, customer, merchant string, items []
 
 	params := k.GetParams(ctx)
 
+	// Price items from the merchant's catalog and reserve their stock. Prices,
+	// names and tax categories only come from the catalog, never from the
+	// customer.
+	reserved, err := k.priceFromCatalog(ctx, merchant, items)
+	if err != nil {
+		return 0, err
+	}
+
 	// Calculate totals
 	subtotal := sdkmath.ZeroInt()
 	for i := range items {
@@ -185,21 +188,35 @@ func (k Keeper) CreateOrder(ctx sdk.Context, customer, merchant string, items []
 		subtotal = subtotal.Add(itemTotal)
 	}
 
-	// Create order
+	// Apply the customer's coupon, if any
+	discount := sdkmath.ZeroInt()
+	if couponCode != "" {
+		_, discount, err = k.applyPromotion(ctx, customer, merchant, couponCode, items, subtotal)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Tax the items at the rates for the shipping address
+	tax := k.computeTax(ctx, shippingInfo.Address, items, subtotal, discount)
+
+	// Create order. An unpaid order holds the stock it reserved for at most
+	// max_inventory_hold.
 	now := ctx.BlockTime()
+	hold := time.Duration(min(params.DefaultOrderExpiration, params.MaxInventoryHold)) * time.Second
 	orderId := k.getNextOrderID(ctx)
 
 	order := types.Order{
-		Id:           orderId,
-		Customer:     customer,
-		Merchant:     merchant,
-		Status:       types.OrderStatusPending,
-		Items:        items,
-		Subtotal:     sdk.NewCoin(params.StablecoinDenom, subtotal),
-		ShippingCost: sdk.NewCoin(params.StablecoinDenom, sdkmath.ZeroInt()),
-		TaxAmount:    sdk.NewCoin(params.StablecoinDenom, sdkmath.ZeroInt()),
-		DiscountAmount: sdk.NewCoin(params.StablecoinDenom, sdkmath.ZeroInt()),
-		TotalAmount:  sdk.NewCoin(params.StablecoinDenom, subtotal),
+		Id:             orderId,
+		Customer:       customer,
+		Merchant:       merchant,
+		Status:         types.OrderStatusPending,
+		Items:          items,
+		Subtotal:       sdk.NewCoin(params.StablecoinDenom, subtotal),
+		ShippingCost:   sdk.NewCoin(params.StablecoinDenom, sdkmath.ZeroInt()),
+		TaxAmount:      sdk.NewCoin(params.StablecoinDenom, tax),
+		DiscountAmount: sdk.NewCoin(params.StablecoinDenom, discount),
+		TotalAmount:    sdk.NewCoin(params.StablecoinDenom, subtotal.Sub(discount).Add(tax)),
 		PaymentInfo: types.PaymentInfo{
 			Status: types.PaymentStatusPending,
 		},
@@ -207,8 +224,10 @@ func (k Keeper) CreateOrder(ctx sdk.Context, customer, merchant string, items []
 		Metadata:     metadata,
 		CreatedAt:    now,
 		UpdatedAt:    now,
-		ExpiresAt:    now.Add(time.Duration(params.DefaultOrderExpiration) * time.Second),
+		ExpiresAt:    now.Add(hold),
 	}
+	order.InventoryReserved = true
+	order.CouponCode = couponCode
 
 	// Validate amount limits
 	if order.TotalAmount.IsLT(params.MinOrderAmount) {
@@ -218,8 +237,12 @@ func (k Keeper) CreateOrder(ctx sdk.Context, customer, merchant string, items []
 		return 0, types.ErrInvalidAmount
 	}
 
+	for _, product := range reserved {
+		k.setProduct(ctx, product)
+	}
 	k.setOrder(ctx, order)
 	k.setNextOrderID(ctx, orderId+1)
+	k.enqueueOrderExpiry(ctx, order)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -284,11 +307,26 @@ func (k Keeper) PayOrder(ctx sdk.Context, customer string, orderId uint64, amoun
 		return types.ErrInvalidAmount
 	}
 
+	// The coupon is redeemed by paying, so its limits are checked again
+	var promotion types.Promotion
+	if order.CouponCode != "" {
+		var err error
+		promotion, err = k.redeemableCoupon(ctx, order)
+		if err != nil {
+			return err
+		}
+	}
+
 	params := k.GetParams(ctx)
 	reference := fmt.Sprintf("order_%d", orderId)
 
+	// The tax is held for the tax collector and the rest paid to the merchant
+	merchantAmount, err := k.holdTax(ctx, &order, params.TaxCollector)
+	if err != nil {
+		return err
+	}
+
 	var settlementId uint64
-	var err error
 
 	if useEscrow {
 		// Use escrow for buyer protection
@@ -296,7 +334,7 @@ func (k Keeper) PayOrder(ctx sdk.Context, customer string, orderId uint64, amoun
 			ctx,
 			customer,
 			order.Merchant,
-			order.TotalAmount,
+			merchantAmount,
 			reference,
 			order.Metadata,
 			params.DefaultEscrowExpiration,
@@ -312,7 +350,7 @@ func (k Keeper) PayOrder(ctx sdk.Context, customer string, orderId uint64, amoun
 			ctx,
 			customer,
 			order.Merchant,
-			order.TotalAmount,
+			merchantAmount,
 			reference,
 			order.Metadata,
 		)
@@ -331,7 +369,11 @@ func (k Keeper) PayOrder(ctx sdk.Context, customer string, orderId uint64, amoun
 	order.UpdatedAt = ctx.BlockTime()
 	order.SettlementId = settlementId
 
+	if order.CouponCode != "" {
+		k.redeemPromotion(ctx, promotion, order)
+	}
 	k.setOrder(ctx, order)
+	k.recordTax(ctx, order)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -405,6 +447,7 @@ func (k Keeper) DeliverOrder(ctx sdk.Context, signer string, orderId uint64) err
 	order.UpdatedAt = ctx.BlockTime()
 
 	k.setOrder(ctx, order)
+	k.enqueueDeliveredOrder(ctx, order)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -432,6 +475,10 @@ func (k Keeper) CompleteOrder(ctx sdk.Context, customer string, orderId uint64)
 		return types.ErrInvalidTransition
 	}
 
+	if err := k.settleTax(ctx, &order, sdkmath.ZeroInt()); err != nil {
+		return err
+	}
+
 	// Release escrow if applicable
 	if order.PaymentInfo.Method == "escrow" && order.PaymentInfo.EscrowId > 0 {
 		customerAddr, _ := sdk.AccAddressFromBech32(customer)
@@ -444,6 +491,7 @@ func (k Keeper) CompleteOrder(ctx sdk.Context, customer string, orderId uint64)
 	order.Status = types.OrderStatusCompleted
 	order.CompletedAt = ctx.BlockTime()
 	order.UpdatedAt = ctx.BlockTime()
+	k.settleInventory(ctx, &order)
 
 	k.setOrder(ctx, order)
 
@@ -477,6 +525,7 @@ func (k Keeper) CancelOrder(ctx sdk.Context, signer string, orderId uint64, reas
 	order.Status = types.OrderStatusCancelled
 	order.Metadata = fmt.Sprintf("cancelled: %s", reason)
 	order.UpdatedAt = ctx.BlockTime()
+	k.settleInventory(ctx, &order)
 
 	k.setOrder(ctx, order)
 
@@ -511,19 +560,37 @@ func (k Keeper) RefundOrder(ctx sdk.Context, merchant string, orderId uint64, re
 	if fullRefund {
 		refundAmount = order.TotalAmount
 	}
+	if refundAmount.Denom != order.TotalAmount.Denom || refundAmount.Amount.GT(order.TotalAmount.Amount) {
+		return types.ErrInvalidAmount
+	}
 
-	// If using escrow, refund from escrow
+	// If using escrow, refund from escrow; its tax is returned in full
 	if order.PaymentInfo.Method == "escrow" && order.PaymentInfo.EscrowId > 0 {
 		merchantAddr, _ := sdk.AccAddressFromBech32(merchant)
 		if err := k.settlementKeeper.RefundEscrow(ctx, order.PaymentInfo.EscrowId, merchantAddr, reason); err != nil {
 			return types.ErrSettlementFailed
 		}
+		if err := k.settleTax(ctx, &order, order.TaxAmount.Amount); err != nil {
+			return err
+		}
 	} else {
-		// For instant payments, merchant must transfer back
+		// For instant payments, merchant must transfer back what it was paid
+		// of the refund; the tax held for the tax collector is returned from
+		// the module account
+		tax := taxShare(order, refundAmount)
+		fromMerchant := refundAmount
+		if order.TaxCollector != "" {
+			fromMerchant = refundAmount.SubAmount(tax)
+		}
+		if err := k.settleTax(ctx, &order, tax); err != nil {
+			return err
+		}
 		merchantAddr, _ := sdk.AccAddressFromBech32(merchant)
 		customerAddr, _ := sdk.AccAddressFromBech32(order.Customer)
-		if err := k.bankKeeper.SendCoins(sdk.WrapSDKContext(ctx), merchantAddr, customerAddr, sdk.NewCoins(refundAmount)); err != nil {
-			return types.ErrInsufficientFunds
+		if fromMerchant.IsPositive() {
+			if err := k.bankKeeper.SendCoins(sdk.WrapSDKContext(ctx), merchantAddr, customerAddr, sdk.NewCoins(fromMerchant)); err != nil {
+				return types.ErrInsufficientFunds
+			}
 		}
 	}
 
@@ -532,6 +599,7 @@ func (k Keeper) RefundOrder(ctx sdk.Context, merchant string, orderId uint64, re
 	order.PaymentInfo.RefundedAmount = refundAmount
 	order.UpdatedAt = ctx.BlockTime()
 	order.Metadata = fmt.Sprintf("refunded: %s", reason)
+	k.settleInventory(ctx, &order)
 
 	k.setOrder(ctx, order)
 
@@ -555,6 +623,7 @@ func (k Keeper) RefundOrder(ctx sdk.Context, merchant string, orderId uint64, re
 func (k Keeper) setDispute(ctx sdk.Context, dispute types.Dispute) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisputeKeyPrefix)
 	store.Set(mustBz(dispute.Id), types.ModuleCdc.MustMarshalJSON(&dispute))
+	k.indexDispute(ctx, dispute)
 }
 
 // GetDispute retrieves a dispute by ID.
@@ -643,18 +712,26 @@ func (k Keeper) ResolveDispute(ctx sdk.Context, authority string, disputeId uint
 
 	// Process resolution
 	if toCustomer && refundAmount.IsPositive() {
-		// Refund to customer
+		// Refund to customer, with the escrow's tax in full
+		tax := taxShare(order, refundAmount)
 		if order.PaymentInfo.Method == "escrow" && order.PaymentInfo.EscrowId > 0 {
 			merchantAddr, _ := sdk.AccAddressFromBech32(order.Merchant)
 			if err := k.settlementKeeper.RefundEscrow(ctx, order.PaymentInfo.EscrowId, merchantAddr, resolution); err != nil {
 				return types.ErrSettlementFailed
 			}
+			tax = order.TaxAmount.Amount
+		}
+		if err := k.settleTax(ctx, &order, tax); err != nil {
+			return err
 		}
 		order.Status = types.OrderStatusRefunded
 		order.PaymentInfo.Status = types.PaymentStatusRefunded
 		order.PaymentInfo.RefundedAmount = refundAmount
 	} else {
-		// Release to merchant
+		// Release to merchant, and the held tax to the tax collector
+		if err := k.settleTax(ctx, &order, sdkmath.ZeroInt()); err != nil {
+			return err
+		}
 		if order.PaymentInfo.Method == "escrow" && order.PaymentInfo.EscrowId > 0 {
 			customerAddr, _ := sdk.AccAddressFromBech32(order.Customer)
 			if err := k.settlementKeeper.ReleaseEscrow(ctx, order.PaymentInfo.EscrowId, customerAddr); err != nil {
@@ -666,6 +743,7 @@ func (k Keeper) ResolveDispute(ctx sdk.Context, authority string, disputeId uint
 	}
 
 	order.UpdatedAt = ctx.BlockTime()
+	k.settleInventory(ctx, &order)
 	k.setOrder(ctx, order)
 
 	dispute.Status = types.DisputeStatusResolved
@@ -741,6 +819,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	for _, dispute := range state.Disputes {
 		k.setDispute(ctx, dispute)
 	}
+	for _, product := range state.Products {
+		k.setProduct(ctx, product)
+	}
+	for _, promotion := range state.Promotions {
+		k.setPromotion(ctx, promotion)
+	}
+	for _, redemption := range state.Redemptions {
+		k.setRedemption(ctx, redemption)
+	}
+	for _, rate := range state.TaxRates {
+		k.setTaxRate(ctx, rate)
+	}
+	for _, record := range state.TaxRecords {
+		k.setTaxRecord(ctx, record)
+	}
+
+	k.RebuildOrderQueues(ctx)
 }
 
 // ExportGenesis exports the orders module's genesis state.
@@ -759,6 +854,31 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	k.IterateProducts(ctx, func(product types.Product) bool {
+		state.Products = append(state.Products, product)
+		return false
+	})
+
+	k.IteratePromotions(ctx, func(promotion types.Promotion) bool {
+		state.Promotions = append(state.Promotions, promotion)
+		return false
+	})
+
+	k.IterateRedemptions(ctx, func(redemption types.PromotionRedemption) bool {
+		state.Redemptions = append(state.Redemptions, redemption)
+		return false
+	})
+
+	k.IterateTaxRates(ctx, func(rate types.TaxRate) bool {
+		state.TaxRates = append(state.TaxRates, rate)
+		return false
+	})
+
+	k.IterateTaxRecords(ctx, func(record types.TaxRecord) bool {
+		state.TaxRecords = append(state.TaxRecords, record)
+		return false
+	})
+
 	return state
 }
 
@@ -767,23 +887,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 // ============================================================================
 
 // ProcessExpiredOrders handles expired unpaid orders.
+// Only orders queued to expire at or before the block time are visited.
 func (k Keeper) ProcessExpiredOrders(ctx sdk.Context) {
 	currentTime := ctx.BlockTime()
 
-	k.IterateOrders(ctx, func(order types.Order) bool {
+	for _, orderId := range k.dequeueDue(ctx, types.OrderExpiryQueuePrefix, currentTime) {
+		order, found := k.GetOrder(ctx, orderId)
+		if !found {
+			continue
+		}
+
 		// Only process pending or confirmed orders that have expired
 		if order.Status != types.OrderStatusPending && order.Status != types.OrderStatusConfirmed {
-			return false
+			continue
 		}
 
 		if order.ExpiresAt.IsZero() || currentTime.Before(order.ExpiresAt) {
-			return false
+			continue
 		}
 
 		// Cancel expired order
 		order.Status = types.OrderStatusCancelled
 		order.Metadata = "expired: auto-cancelled"
 		order.UpdatedAt = currentTime
+		k.settleInventory(ctx, &order)
 		k.setOrder(ctx, order)
 
 		ctx.EventManager().EmitEvent(
@@ -793,12 +920,11 @@ func (k Keeper) ProcessExpiredOrders(ctx sdk.Context) {
 				sdk.NewAttribute("customer", order.Customer),
 			),
 		)
-
-		return false
-	})
+	}
 }
 
 // ProcessAutoCompleteOrders handles auto-completing delivered orders.
+// Only orders queued as delivered before the auto-complete window are visited.
 func (k Keeper) ProcessAutoCompleteOrders(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	if !params.AutoCompleteAfterDelivery {
@@ -808,32 +934,45 @@ func (k Keeper) ProcessAutoCompleteOrders(ctx sdk.Context) {
 	currentTime := ctx.BlockTime()
 	autoCompleteWindow := time.Duration(params.AutoCompleteWindow) * time.Second
 
-	k.IterateOrders(ctx, func(order types.Order) bool {
+	for _, orderId := range k.dequeueDue(ctx, types.DeliveredOrderQueuePrefix, currentTime.Add(-autoCompleteWindow)) {
+		order, found := k.GetOrder(ctx, orderId)
 		// Only process delivered orders
-		if order.Status != types.OrderStatusDelivered {
-			return false
+		if !found || order.Status != types.OrderStatusDelivered {
+			continue
 		}
 
 		// Check if auto-complete window has passed
 		autoCompleteTime := order.DeliveredAt.Add(autoCompleteWindow)
 		if currentTime.Before(autoCompleteTime) {
-			return false
+			k.enqueueDeliveredOrder(ctx, order)
+			continue
 		}
 
-		// Auto-complete the order
+		// Auto-complete the order, remitting its tax and releasing its escrow
+		// together so a failure leaves both for the retry
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleTax(cacheCtx, &order, sdkmath.ZeroInt()); err != nil {
+			ctx.Logger().Error("failed to remit tax for auto-complete", "order_id", order.Id, "error", err)
+			k.enqueueDeliveredOrder(ctx, order)
+			continue
+		}
 		if order.PaymentInfo.Method == "escrow" && order.PaymentInfo.EscrowId > 0 {
 			customerAddr, _ := sdk.AccAddressFromBech32(order.Customer)
-			if err := k.settlementKeeper.ReleaseEscrow(ctx, order.PaymentInfo.EscrowId, customerAddr); err != nil {
+			if err := k.settlementKeeper.ReleaseEscrow(cacheCtx, order.PaymentInfo.EscrowId, customerAddr); err != nil {
 				ctx.Logger().Error("failed to release escrow for auto-complete", "order_id", order.Id, "error", err)
-				return false
+				// Retry in the next block
+				k.enqueueDeliveredOrder(ctx, order)
+				continue
 			}
 			order.PaymentInfo.Status = types.PaymentStatusReleased
 		}
+		write()
 
 		order.Status = types.OrderStatusCompleted
 		order.CompletedAt = currentTime
 		order.UpdatedAt = currentTime
 		order.Metadata = "auto-completed after delivery window"
+		k.settleInventory(ctx, &order)
 		k.setOrder(ctx, order)
 
 		ctx.EventManager().EmitEvent(
@@ -843,7 +982,5 @@ func (k Keeper) ProcessAutoCompleteOrders(ctx sdk.Context) {
 				sdk.NewAttribute("customer", order.Customer),
 			),
 		)
-
-		return false
-	})
+	}
 }
@@ -80,6 +80,7 @@ func TestGetSetParams(t *testing.T) {
 		StablecoinDenom:           "ssusd",
 		AutoCompleteAfterDelivery: true,
 		AutoCompleteWindow:        345600, // 4 days
+		MaxInventoryHold:          3600,   // 1 hour
 	}
 	err := k.SetParams(ctx, customParams)
 	require.NoError(t, err)
@@ -91,6 +92,7 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, int64(2419200), retrieved.DisputeWindow)
 	require.Equal(t, uint32(150), retrieved.DefaultFeeRateBps)
 	require.True(t, retrieved.AutoCompleteAfterDelivery)
+	require.Equal(t, int64(3600), retrieved.MaxInventoryHold)
 }
 
 func TestGetOrder_NotFound(t *testing.T) {
@@ -139,6 +141,7 @@ func TestParamsValidation(t *testing.T) {
 				StablecoinDenom:           "ssusd",
 				AutoCompleteAfterDelivery: true,
 				AutoCompleteWindow:        259200,
+				MaxInventoryHold:          1800,
 			},
 			expectErr: false,
 		},
@@ -178,9 +181,23 @@ func TestParamsValidation(t *testing.T) {
 				MaxOrderAmount:          sdk.NewCoin("ssusd", sdkmath.NewInt(1_000_000_000_000)),
 				DefaultFeeRateBps:       100,
 				StablecoinDenom:         "",
+				MaxInventoryHold:        1800,
 			},
 			expectErr: false,
 		},
+		{
+			name: "zero max inventory hold",
+			params: orderstypes.Params{
+				DefaultOrderExpiration:  86400,
+				DefaultEscrowExpiration: 604800,
+				DisputeWindow:           1209600,
+				MinOrderAmount:          sdk.NewCoin("ssusd", sdkmath.NewInt(100_000)),
+				MaxOrderAmount:          sdk.NewCoin("ssusd", sdkmath.NewInt(1_000_000_000_000)),
+				DefaultFeeRateBps:       100,
+				StablecoinDenom:         "ssusd",
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// Migrator handles in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 populates the order expiry and auto-complete queues from the
+// existing orders.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RebuildOrderQueues(ctx)
+	return nil
+}
+
+// Migrate2to3 rebuilds the order and dispute indexes with length-prefixed
+// keys, dropping status entries left behind by orders that changed status
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.RebuildIndexes(ctx)
+	return nil
+}
+
+// Migrate3to4 sets the max_inventory_hold param, which limits how long unpaid
+// orders hold catalog stock, to its default.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MaxInventoryHold == 0 {
+		params.MaxInventoryHold = types.DefaultParams().MaxInventoryHold
+	}
+	return m.keeper.SetParams(ctx, params)
+}
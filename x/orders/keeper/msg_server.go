@@ -22,7 +22,7 @@ func (m msgServer) CreateOrder(goCtx context.Context, msg *types.MsgCreateOrder)
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	id, err := m.keeper.CreateOrder(ctx, msg.Customer, msg.Merchant, msg.Items, msg.ShippingInfo, msg.Metadata)
+	id, err := m.keeper.CreateOrderWithCoupon(ctx, msg.Customer, msg.Merchant, msg.Items, msg.ShippingInfo, msg.Metadata, msg.CouponCode)
 	if err != nil {
 		return nil, err
 	}
@@ -128,3 +128,92 @@ func (m msgServer) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDi
 	}
 	return &types.MsgResolveDisputeResponse{}, nil
 }
+
+func (m msgServer) RegisterProduct(goCtx context.Context, msg *types.MsgRegisterProduct) (*types.MsgRegisterProductResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.RegisterProduct(ctx, msg.Merchant, msg.Sku, msg.Name, msg.Price, msg.Variants, msg.Stock, msg.Metadata, msg.TaxCategory); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterProductResponse{}, nil
+}
+
+func (m msgServer) UpdateProduct(goCtx context.Context, msg *types.MsgUpdateProduct) (*types.MsgUpdateProductResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.UpdateProduct(ctx, msg.Merchant, msg.Sku, msg.Name, msg.Price, msg.Variants, msg.Active, msg.Metadata, msg.TaxCategory); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateProductResponse{}, nil
+}
+
+func (m msgServer) AdjustInventory(goCtx context.Context, msg *types.MsgAdjustInventory) (*types.MsgAdjustInventoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	stock, err := m.keeper.AdjustInventory(ctx, msg.Merchant, msg.Sku, msg.Variant, msg.Delta)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAdjustInventoryResponse{Stock: stock}, nil
+}
+
+func (m msgServer) CreatePromotion(goCtx context.Context, msg *types.MsgCreatePromotion) (*types.MsgCreatePromotionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.CreatePromotion(ctx, msg.Merchant, msg.Code, msg.DiscountType, msg.PercentageBps, msg.FixedAmount, msg.MinSubtotal, msg.MaxRedemptions, msg.MaxRedemptionsPerCustomer, msg.Skus, msg.StartsAt, msg.EndsAt); err != nil {
+		return nil, err
+	}
+	return &types.MsgCreatePromotionResponse{}, nil
+}
+
+func (m msgServer) DeactivatePromotion(goCtx context.Context, msg *types.MsgDeactivatePromotion) (*types.MsgDeactivatePromotionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.DeactivatePromotion(ctx, msg.Merchant, msg.Code); err != nil {
+		return nil, err
+	}
+	return &types.MsgDeactivatePromotionResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.UpdateParams(ctx, msg.Authority, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (m msgServer) SetTaxRate(goCtx context.Context, msg *types.MsgSetTaxRate) (*types.MsgSetTaxRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.SetTaxRate(ctx, msg.Authority, msg.Rate); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetTaxRateResponse{}, nil
+}
+
+func (m msgServer) RemoveTaxRate(goCtx context.Context, msg *types.MsgRemoveTaxRate) (*types.MsgRemoveTaxRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.RemoveTaxRate(ctx, msg.Authority, msg.Country, msg.State, msg.PostalCode, msg.Category); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveTaxRateResponse{}, nil
+}
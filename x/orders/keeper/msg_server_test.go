@@ -39,6 +39,12 @@ func setupOrdersConfig() {
 }
 
 func setupOrdersKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockSettlementKeeper) {
+	t.Helper()
+	k, ctx, settlementKeeper, _ := setupOrdersKeeperWithBank(t)
+	return k, ctx, settlementKeeper
+}
+
+func setupOrdersKeeperWithBank(t *testing.T) (keeper.Keeper, sdk.Context, *mockSettlementKeeper, *mockBankKeeper) {
 	t.Helper()
 	setupOrdersConfig()
 
@@ -58,7 +64,7 @@ func setupOrdersKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockSettlemen
 	accountKeeper := newMockAccountKeeper()
 
 	k := keeper.NewKeeper(cdc, storeKey, "stateset1authority", bankKeeper, complianceKeeper, settlementKeeper, accountKeeper)
-	return k, ctx, settlementKeeper
+	return k, ctx, settlementKeeper, bankKeeper
 }
 
 func newOrdersAddress() sdk.AccAddress {
@@ -66,23 +72,33 @@ func newOrdersAddress() sdk.AccAddress {
 	return sdk.AccAddress(key.PubKey().Address())
 }
 
-type mockBankKeeper struct{}
+// mockBankKeeper does not check balances; it only tracks what accounts
+// receive and what module accounts hold.
+type mockBankKeeper struct {
+	received map[string]sdk.Coins
+}
 
-func newMockBankKeeper() *mockBankKeeper { return &mockBankKeeper{} }
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{received: make(map[string]sdk.Coins)}
+}
 
 func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, sdkmath.ZeroInt())
 }
 
-func (m *mockBankKeeper) SendCoins(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+func (m *mockBankKeeper) SendCoins(_ context.Context, _, to sdk.AccAddress, amt sdk.Coins) error {
+	m.received[to.String()] = m.received[to.String()].Add(amt...)
 	return nil
 }
 
-func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, _ string, _ sdk.Coins) error {
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, _ sdk.AccAddress, module string, amt sdk.Coins) error {
+	m.received[module] = m.received[module].Add(amt...)
 	return nil
 }
 
-func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, _ sdk.AccAddress, _ sdk.Coins) error {
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	m.received[module] = m.received[module].Sub(amt...)
+	m.received[to.String()] = m.received[to.String()].Add(amt...)
 	return nil
 }
 
@@ -164,6 +180,7 @@ func TestMsgCreateOrder(t *testing.T) {
 		},
 	}
 
+	listItems(t, k, ctx, merchant, items...)
 	msg := ordertypes.NewMsgCreateOrder(customer.String(), merchant.String(), items, ordertypes.ShippingInfo{}, "meta")
 	resp, err := msgServer.CreateOrder(sdk.WrapSDKContext(ctx), msg)
 	require.NoError(t, err)
@@ -193,6 +210,7 @@ func TestMsgPayOrder_UsesInstantTransfer(t *testing.T) {
 		},
 	}
 
+	listItems(t, k, ctx, merchant, items...)
 	createMsg := ordertypes.NewMsgCreateOrder(customer.String(), merchant.String(), items, ordertypes.ShippingInfo{}, "")
 	resp, err := msgServer.CreateOrder(sdk.WrapSDKContext(ctx), createMsg)
 	require.NoError(t, err)
@@ -212,3 +230,35 @@ func TestMsgPayOrder_UsesInstantTransfer(t *testing.T) {
 	require.Equal(t, settlement.nextID, order.SettlementId)
 	require.Equal(t, "instant", settlement.lastMethod)
 }
+
+func TestOpenDispute_AssignsDistinctIDs(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	openDispute := func(k keeper.Keeper, ctx sdk.Context) (uint64, uint64) {
+		orderId := createTestOrder(t, k, ctx, customer, merchant)
+		require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), orderId))
+		require.NoError(t, k.PayOrder(ctx, customer.String(), orderId, sdk.NewInt64Coin(stablecointypes.StablecoinDenom, 500), false))
+		disputeId, err := k.OpenDispute(ctx, customer.String(), orderId, "damaged", "", nil)
+		require.NoError(t, err)
+		return orderId, disputeId
+	}
+
+	// Each dispute is stored under its own ID rather than overwriting the first
+	firstOrder, first := openDispute(k, ctx)
+	secondOrder, second := openDispute(k, ctx)
+	require.NotEqual(t, first, second)
+	dispute, found := k.GetDispute(ctx, first)
+	require.True(t, found)
+	require.Equal(t, firstOrder, dispute.OrderId)
+	dispute, found = k.GetDispute(ctx, second)
+	require.True(t, found)
+	require.Equal(t, secondOrder, dispute.OrderId)
+
+	// Numbering continues after a genesis round trip
+	k2, ctx2, _ := setupOrdersKeeper(t)
+	k2.InitGenesis(ctx2, k.ExportGenesis(ctx))
+	_, third := openDispute(k2, ctx2)
+	require.Equal(t, second+1, third)
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// MaxQueryLimit caps the page size of the index queries.
+const MaxQueryLimit = 100
+
+// ============================================================================
+// Order and Dispute Indexes
+// ============================================================================
+
+// Orders are indexed by customer, merchant and status, and disputes by order
+// and by party (customer and merchant), so they can be listed without scanning
+// the whole store. Index keys are the length-prefixed indexed value followed by
+// the ID, so entries under one value are iterated in creation order. setOrder
+// moves an order's entries when an indexed field changes, in particular its
+// status; Migrate2to3 rebuilds the indexes of stores written before.
+
+func validIndexedValue(value string) bool {
+	return value != "" && len(value) <= address.MaxAddrLen
+}
+
+func indexValuePrefix(indexPrefix []byte, value string) []byte {
+	return append(append([]byte{}, indexPrefix...), address.MustLengthPrefix([]byte(value))...)
+}
+
+// orderIndexPrefixes returns the index prefixes an order is stored under
+func orderIndexPrefixes(order types.Order) [][]byte {
+	var prefixes [][]byte
+	if validIndexedValue(order.Customer) {
+		prefixes = append(prefixes, indexValuePrefix(types.OrderByCustomerKeyPrefix, order.Customer))
+	}
+	if validIndexedValue(order.Merchant) {
+		prefixes = append(prefixes, indexValuePrefix(types.OrderByMerchantKeyPrefix, order.Merchant))
+	}
+	if validIndexedValue(order.Status) {
+		prefixes = append(prefixes, indexValuePrefix(types.OrderByStatusKeyPrefix, order.Status))
+	}
+	return prefixes
+}
+
+// indexOrder writes the index entries of an order, removing those of its
+// previous version when an indexed field changed
+func (k Keeper) indexOrder(ctx sdk.Context, previous *types.Order, order types.Order) {
+	store := ctx.KVStore(k.storeKey)
+	id := mustBz(order.Id)
+	if previous != nil {
+		if previous.Customer == order.Customer && previous.Merchant == order.Merchant && previous.Status == order.Status {
+			return
+		}
+		for _, indexPrefix := range orderIndexPrefixes(*previous) {
+			store.Delete(append(indexPrefix, id...))
+		}
+	}
+	for _, indexPrefix := range orderIndexPrefixes(order) {
+		store.Set(append(indexPrefix, id...), id)
+	}
+}
+
+func disputeByOrderPrefix(orderId uint64) []byte {
+	return append(append([]byte{}, types.DisputeByOrderKeyPrefix...), mustBz(orderId)...)
+}
+
+// indexDispute writes the index entries of a dispute. The order and parties of
+// a dispute never change, so existing entries are simply rewritten.
+func (k Keeper) indexDispute(ctx sdk.Context, dispute types.Dispute) {
+	store := ctx.KVStore(k.storeKey)
+	id := mustBz(dispute.Id)
+	store.Set(append(disputeByOrderPrefix(dispute.OrderId), id...), id)
+	for _, party := range []string{dispute.Customer, dispute.Merchant} {
+		if validIndexedValue(party) {
+			store.Set(append(indexValuePrefix(types.DisputeByPartyKeyPrefix, party), id...), id)
+		}
+	}
+}
+
+// RebuildIndexes clears the order and dispute indexes and rewrites them from
+// the stored orders and disputes
+func (k Keeper) RebuildIndexes(ctx sdk.Context) {
+	for _, indexPrefix := range [][]byte{
+		types.OrderByCustomerKeyPrefix,
+		types.OrderByMerchantKeyPrefix,
+		types.OrderByStatusKeyPrefix,
+		types.DisputeByOrderKeyPrefix,
+		types.DisputeByPartyKeyPrefix,
+	} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+		iterator := store.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	k.IterateOrders(ctx, func(order types.Order) bool {
+		k.indexOrder(ctx, nil, order)
+		return false
+	})
+	k.IterateDisputes(ctx, func(dispute types.Dispute) bool {
+		k.indexDispute(ctx, dispute)
+		return false
+	})
+}
+
+// paginateIndex visits a page of the IDs stored under an index prefix,
+// capping the page size at MaxQueryLimit
+func (k Keeper) paginateIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, cb func(id uint64)) (*query.PageResponse, error) {
+	page := query.PageRequest{}
+	if pageReq != nil {
+		page = *pageReq
+	}
+	if page.Limit == 0 || page.Limit > MaxQueryLimit {
+		page.Limit = MaxQueryLimit
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	return query.Paginate(store, &page, func(_, value []byte) error {
+		cb(binary.BigEndian.Uint64(value))
+		return nil
+	})
+}
+
+func (k Keeper) paginateOrders(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.Order, *query.PageResponse, error) {
+	var orders []types.Order
+	pageRes, err := k.paginateIndex(ctx, indexPrefix, pageReq, func(id uint64) {
+		if order, found := k.GetOrder(ctx, id); found {
+			orders = append(orders, order)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return orders, pageRes, nil
+}
+
+func (k Keeper) paginateDisputes(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.Dispute, *query.PageResponse, error) {
+	var disputes []types.Dispute
+	pageRes, err := k.paginateIndex(ctx, indexPrefix, pageReq, func(id uint64) {
+		if dispute, found := k.GetDispute(ctx, id); found {
+			disputes = append(disputes, dispute)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return disputes, pageRes, nil
+}
+
+// OrdersByCustomer returns a page of the orders placed by a customer
+func (k Keeper) OrdersByCustomer(ctx sdk.Context, customer string, pageReq *query.PageRequest) ([]types.Order, *query.PageResponse, error) {
+	if !validIndexedValue(customer) {
+		return nil, nil, types.ErrInvalidCustomer
+	}
+	return k.paginateOrders(ctx, indexValuePrefix(types.OrderByCustomerKeyPrefix, customer), pageReq)
+}
+
+// OrdersByMerchant returns a page of the orders placed with a merchant
+func (k Keeper) OrdersByMerchant(ctx sdk.Context, merchant string, pageReq *query.PageRequest) ([]types.Order, *query.PageResponse, error) {
+	if !validIndexedValue(merchant) {
+		return nil, nil, types.ErrInvalidMerchant
+	}
+	return k.paginateOrders(ctx, indexValuePrefix(types.OrderByMerchantKeyPrefix, merchant), pageReq)
+}
+
+// OrdersByStatus returns a page of the orders in a status
+func (k Keeper) OrdersByStatus(ctx sdk.Context, status string, pageReq *query.PageRequest) ([]types.Order, *query.PageResponse, error) {
+	if !types.IsValidOrderStatus(status) {
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidStatus, "unknown status %q", status)
+	}
+	return k.paginateOrders(ctx, indexValuePrefix(types.OrderByStatusKeyPrefix, status), pageReq)
+}
+
+// DisputesByOrder returns a page of the disputes opened on an order
+func (k Keeper) DisputesByOrder(ctx sdk.Context, orderId uint64, pageReq *query.PageRequest) ([]types.Dispute, *query.PageResponse, error) {
+	if orderId == 0 {
+		return nil, nil, types.ErrInvalidOrder
+	}
+	return k.paginateDisputes(ctx, disputeByOrderPrefix(orderId), pageReq)
+}
+
+// DisputesByParty returns a page of the disputes an address is the customer
+// or merchant of
+func (k Keeper) DisputesByParty(ctx sdk.Context, party string, pageReq *query.PageRequest) ([]types.Dispute, *query.PageResponse, error) {
+	if !validIndexedValue(party) {
+		return nil, nil, errorsmod.Wrap(types.ErrUnauthorized, "invalid party")
+	}
+	return k.paginateDisputes(ctx, indexValuePrefix(types.DisputeByPartyKeyPrefix, party), pageReq)
+}
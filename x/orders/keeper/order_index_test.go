@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	ordertypes "github.com/stateset/core/x/orders/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
+)
+
+func TestOrdersByStatus_MovesWithStatus(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	first := createTestOrder(t, k, ctx, customer, merchant)
+	second := createTestOrder(t, k, ctx, customer, merchant)
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), first))
+
+	pending, _, err := k.OrdersByStatus(ctx, ordertypes.OrderStatusPending, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, second, pending[0].Id)
+
+	confirmed, _, err := k.OrdersByStatus(ctx, ordertypes.OrderStatusConfirmed, nil)
+	require.NoError(t, err)
+	require.Len(t, confirmed, 1)
+	require.Equal(t, first, confirmed[0].Id)
+
+	_, _, err = k.OrdersByStatus(ctx, "lost", nil)
+	require.ErrorIs(t, err, ordertypes.ErrInvalidStatus)
+
+	// Rebuilding yields the same entries
+	k.RebuildIndexes(ctx)
+	pending, _, err = k.OrdersByStatus(ctx, ordertypes.OrderStatusPending, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, second, pending[0].Id)
+}
+
+func TestOrdersByParty_Paginated(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	other := newOrdersAddress()
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		ids = append(ids, createTestOrder(t, k, ctx, customer, merchant))
+	}
+	createTestOrder(t, k, ctx, other, merchant)
+
+	page, pageRes, err := k.OrdersByCustomer(ctx, customer.String(), &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, ids[:2], []uint64{page[0].Id, page[1].Id})
+	require.Equal(t, uint64(3), pageRes.Total)
+
+	page, _, err = k.OrdersByCustomer(ctx, customer.String(), &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, ids[2], page[0].Id)
+
+	page, _, err = k.OrdersByMerchant(ctx, merchant.String(), nil)
+	require.NoError(t, err)
+	require.Len(t, page, 4)
+
+	_, _, err = k.OrdersByCustomer(ctx, "", nil)
+	require.ErrorIs(t, err, ordertypes.ErrInvalidCustomer)
+}
+
+func TestDisputesByOrderAndParty(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	var disputeIds []uint64
+	var orderIds []uint64
+	for i := 0; i < 2; i++ {
+		orderId := createTestOrder(t, k, ctx, customer, merchant)
+		require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), orderId))
+		require.NoError(t, k.PayOrder(ctx, customer.String(), orderId, sdk.NewInt64Coin(stablecointypes.StablecoinDenom, 500), false))
+		disputeId, err := k.OpenDispute(ctx, customer.String(), orderId, "damaged", "", nil)
+		require.NoError(t, err)
+		orderIds = append(orderIds, orderId)
+		disputeIds = append(disputeIds, disputeId)
+	}
+	// Each dispute gets its own ID
+	require.Equal(t, []uint64{1, 2}, disputeIds)
+
+	disputes, _, err := k.DisputesByOrder(ctx, orderIds[1], nil)
+	require.NoError(t, err)
+	require.Len(t, disputes, 1)
+	require.Equal(t, disputeIds[1], disputes[0].Id)
+
+	for _, party := range []sdk.AccAddress{customer, merchant} {
+		disputes, _, err = k.DisputesByParty(ctx, party.String(), nil)
+		require.NoError(t, err)
+		require.Len(t, disputes, 2)
+	}
+
+	disputed, _, err := k.OrdersByStatus(ctx, ordertypes.OrderStatusDisputed, nil)
+	require.NoError(t, err)
+	require.Len(t, disputed, 2)
+	paid, _, err := k.OrdersByStatus(ctx, ordertypes.OrderStatusPaid, nil)
+	require.NoError(t, err)
+	require.Empty(t, paid)
+}
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// ============================================================================
+// Promotions and Coupons
+// ============================================================================
+
+// Merchants publish promotions under a coupon code. A customer names the code
+// when creating an order and CreateOrder computes the discount from the
+// order's items, enforcing the validity window, minimum subtotal, SKU filter
+// and redemption limits. An order redeems the coupon when it is paid, so
+// orders cancelled or left to expire never count against the limits; PayOrder
+// checks the limits again, and the first orders paid take the last
+// redemptions.
+
+func merchantPromotionsPrefix(merchant string) []byte {
+	return indexValuePrefix(types.PromotionKeyPrefix, merchant)
+}
+
+func promotionKey(merchant, code string) []byte {
+	return append(merchantPromotionsPrefix(merchant), []byte(code)...)
+}
+
+func redemptionKey(merchant, code, customer string) []byte {
+	key := indexValuePrefix(types.PromotionRedemptionKeyPrefix, merchant)
+	key = append(key, address.MustLengthPrefix([]byte(code))...)
+	return append(key, []byte(customer)...)
+}
+
+func (k Keeper) setPromotion(ctx sdk.Context, promotion types.Promotion) {
+	ctx.KVStore(k.storeKey).Set(promotionKey(promotion.Merchant, promotion.Code), types.ModuleCdc.MustMarshalJSON(&promotion))
+}
+
+// GetPromotion retrieves a promotion by merchant and coupon code.
+func (k Keeper) GetPromotion(ctx sdk.Context, merchant, code string) (types.Promotion, bool) {
+	if !validIndexedValue(merchant) {
+		return types.Promotion{}, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(promotionKey(merchant, code))
+	if len(bz) == 0 {
+		return types.Promotion{}, false
+	}
+	var promotion types.Promotion
+	types.ModuleCdc.MustUnmarshalJSON(bz, &promotion)
+	return promotion, true
+}
+
+// IteratePromotions iterates over all promotions.
+func (k Keeper) IteratePromotions(ctx sdk.Context, cb func(types.Promotion) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PromotionKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var promotion types.Promotion
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &promotion)
+		if cb(promotion) {
+			break
+		}
+	}
+}
+
+// PromotionsByMerchant returns a page of a merchant's promotions in coupon
+// code order.
+func (k Keeper) PromotionsByMerchant(ctx sdk.Context, merchant string, pageReq *query.PageRequest) ([]types.Promotion, *query.PageResponse, error) {
+	if !validIndexedValue(merchant) {
+		return nil, nil, types.ErrInvalidMerchant
+	}
+	page := query.PageRequest{}
+	if pageReq != nil {
+		page = *pageReq
+	}
+	if page.Limit == 0 || page.Limit > MaxQueryLimit {
+		page.Limit = MaxQueryLimit
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), merchantPromotionsPrefix(merchant))
+	var promotions []types.Promotion
+	pageRes, err := query.Paginate(store, &page, func(_, value []byte) error {
+		var promotion types.Promotion
+		types.ModuleCdc.MustUnmarshalJSON(value, &promotion)
+		promotions = append(promotions, promotion)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return promotions, pageRes, nil
+}
+
+// GetRedemptionCount returns how many paid orders of a customer redeemed a
+// promotion.
+func (k Keeper) GetRedemptionCount(ctx sdk.Context, merchant, code, customer string) uint64 {
+	if !validIndexedValue(merchant) {
+		return 0
+	}
+	bz := ctx.KVStore(k.storeKey).Get(redemptionKey(merchant, code, customer))
+	if len(bz) == 0 {
+		return 0
+	}
+	var redemption types.PromotionRedemption
+	types.ModuleCdc.MustUnmarshalJSON(bz, &redemption)
+	return redemption.Count
+}
+
+func (k Keeper) setRedemption(ctx sdk.Context, redemption types.PromotionRedemption) {
+	store := ctx.KVStore(k.storeKey)
+	key := redemptionKey(redemption.Merchant, redemption.Code, redemption.Customer)
+	if redemption.Count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, types.ModuleCdc.MustMarshalJSON(&redemption))
+}
+
+// IterateRedemptions iterates over the per-customer redemption counts.
+func (k Keeper) IterateRedemptions(ctx sdk.Context, cb func(types.PromotionRedemption) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PromotionRedemptionKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption types.PromotionRedemption
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &redemption)
+		if cb(redemption) {
+			break
+		}
+	}
+}
+
+// CreatePromotion publishes a coupon code for a merchant's orders.
+func (k Keeper) CreatePromotion(ctx sdk.Context, merchant, code, discountType string, percentageBps uint32, fixedAmount, minSubtotal sdk.Coin, maxRedemptions, maxRedemptionsPerCustomer uint64, skus []string, startsAt, endsAt time.Time) error {
+	if _, err := sdk.AccAddressFromBech32(merchant); err != nil {
+		return types.ErrInvalidMerchant
+	}
+	if err := types.ValidatePromotionTerms(code, discountType, percentageBps, fixedAmount, minSubtotal, skus, startsAt, endsAt); err != nil {
+		return err
+	}
+	denom := k.GetParams(ctx).StablecoinDenom
+	if discountType == types.DiscountTypeFixed && fixedAmount.Denom != denom {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "fixed_amount must be in %s", denom)
+	}
+	if minSubtotal.Amount.IsNil() || minSubtotal.IsZero() {
+		minSubtotal = sdk.NewCoin(denom, sdkmath.ZeroInt())
+	} else if minSubtotal.Denom != denom {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "min_subtotal must be in %s", denom)
+	}
+	if discountType == types.DiscountTypePercentage {
+		fixedAmount = sdk.NewCoin(denom, sdkmath.ZeroInt())
+	}
+	if !endsAt.IsZero() && !endsAt.After(ctx.BlockTime()) {
+		return errorsmod.Wrap(types.ErrInvalidPromotion, "ends_at must be in the future")
+	}
+	if _, found := k.GetPromotion(ctx, merchant, code); found {
+		return types.ErrPromotionExists
+	}
+
+	k.setPromotion(ctx, types.Promotion{
+		Merchant:                  merchant,
+		Code:                      code,
+		DiscountType:              discountType,
+		PercentageBps:             percentageBps,
+		FixedAmount:               fixedAmount,
+		MinSubtotal:               minSubtotal,
+		MaxRedemptions:            maxRedemptions,
+		MaxRedemptionsPerCustomer: maxRedemptionsPerCustomer,
+		Skus:                      skus,
+		StartsAt:                  startsAt,
+		EndsAt:                    endsAt,
+		Active:                    true,
+		CreatedAt:                 ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"promotion_created",
+			sdk.NewAttribute("merchant", merchant),
+			sdk.NewAttribute("code", code),
+			sdk.NewAttribute("discount_type", discountType),
+		),
+	)
+
+	return nil
+}
+
+// DeactivatePromotion stops a coupon code from being applied to new orders.
+// Orders that already redeemed it keep their discount.
+func (k Keeper) DeactivatePromotion(ctx sdk.Context, merchant, code string) error {
+	promotion, found := k.GetPromotion(ctx, merchant, code)
+	if !found {
+		return types.ErrPromotionNotFound
+	}
+	if !promotion.Active {
+		return errorsmod.Wrap(types.ErrInvalidPromotion, "promotion is already inactive")
+	}
+
+	promotion.Active = false
+	k.setPromotion(ctx, promotion)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"promotion_deactivated",
+			sdk.NewAttribute("merchant", merchant),
+			sdk.NewAttribute("code", code),
+		),
+	)
+
+	return nil
+}
+
+// applyPromotion checks that a customer can redeem a coupon on an order and
+// returns the promotion and the discount. It writes nothing; PayOrder records
+// the redemption once the order is paid.
+func (k Keeper) applyPromotion(ctx sdk.Context, customer, merchant, code string, items []types.OrderItem, subtotal sdkmath.Int) (types.Promotion, sdkmath.Int, error) {
+	promotion, found := k.GetPromotion(ctx, merchant, code)
+	if !found {
+		return types.Promotion{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrPromotionNotFound, "coupon %s", code)
+	}
+	if !promotion.IsActiveAt(ctx.BlockTime()) {
+		return types.Promotion{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrCouponNotApplicable, "coupon %s is not active", code)
+	}
+	if err := k.checkRedemptionLimits(ctx, promotion, customer); err != nil {
+		return types.Promotion{}, sdkmath.Int{}, err
+	}
+	if subtotal.LT(promotion.MinSubtotal.Amount) {
+		return types.Promotion{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrCouponNotApplicable, "coupon %s requires a subtotal of %s", code, promotion.MinSubtotal)
+	}
+
+	discount := promotion.Discount(items)
+	if !discount.IsPositive() {
+		return types.Promotion{}, sdkmath.Int{}, errorsmod.Wrapf(types.ErrCouponNotApplicable, "coupon %s does not apply to any item", code)
+	}
+	return promotion, discount, nil
+}
+
+// checkRedemptionLimits fails if a promotion, or a customer's use of it, has
+// reached its redemption limit.
+func (k Keeper) checkRedemptionLimits(ctx sdk.Context, promotion types.Promotion, customer string) error {
+	if promotion.MaxRedemptions > 0 && promotion.Redemptions >= promotion.MaxRedemptions {
+		return errorsmod.Wrapf(types.ErrCouponExhausted, "coupon %s", promotion.Code)
+	}
+	if limit := promotion.MaxRedemptionsPerCustomer; limit > 0 && k.GetRedemptionCount(ctx, promotion.Merchant, promotion.Code, customer) >= limit {
+		return errorsmod.Wrapf(types.ErrCouponExhausted, "coupon %s already used %d times", promotion.Code, limit)
+	}
+	return nil
+}
+
+// redeemableCoupon returns the promotion an order's coupon redeems at payment,
+// failing if its limits were reached by orders paid since this one was
+// created. The discount was fixed when the order was created, so the
+// promotion's window and active flag are not checked again.
+func (k Keeper) redeemableCoupon(ctx sdk.Context, order types.Order) (types.Promotion, error) {
+	promotion, found := k.GetPromotion(ctx, order.Merchant, order.CouponCode)
+	if !found {
+		return types.Promotion{}, errorsmod.Wrapf(types.ErrPromotionNotFound, "coupon %s", order.CouponCode)
+	}
+	if err := k.checkRedemptionLimits(ctx, promotion, order.Customer); err != nil {
+		return types.Promotion{}, err
+	}
+	return promotion, nil
+}
+
+// redeemPromotion counts a paid order's use of a promotion.
+func (k Keeper) redeemPromotion(ctx sdk.Context, promotion types.Promotion, order types.Order) {
+	promotion.Redemptions++
+	k.setPromotion(ctx, promotion)
+
+	k.setRedemption(ctx, types.PromotionRedemption{
+		Merchant: promotion.Merchant,
+		Code:     promotion.Code,
+		Customer: order.Customer,
+		Count:    k.GetRedemptionCount(ctx, promotion.Merchant, promotion.Code, order.Customer) + 1,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"coupon_redeemed",
+			sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute("merchant", promotion.Merchant),
+			sdk.NewAttribute("code", promotion.Code),
+			sdk.NewAttribute("discount", order.DiscountAmount.String()),
+		),
+	)
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/orders/keeper"
+	ordertypes "github.com/stateset/core/x/orders/types"
+)
+
+func createCouponOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress, code string, items ...ordertypes.OrderItem) (uint64, error) {
+	t.Helper()
+	listItems(t, k, ctx, merchant, items...)
+	return k.CreateOrderWithCoupon(ctx, customer.String(), merchant.String(), items, ordertypes.ShippingInfo{}, "", code)
+}
+
+func TestCreateOrder_AppliesCoupon(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	widgets := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 2, UnitPrice: ssusdCoin(500)}
+	gadget := ordertypes.OrderItem{Id: "2", ProductId: "sku-2", Quantity: 1, UnitPrice: ssusdCoin(1000)}
+
+	require.NoError(t, k.CreatePromotion(ctx, merchant.String(), "TENOFF", ordertypes.DiscountTypePercentage, 1000, sdk.Coin{}, ssusdCoin(1500), 0, 0, nil, time.Time{}, time.Time{}))
+	require.ErrorIs(t, k.CreatePromotion(ctx, merchant.String(), "TENOFF", ordertypes.DiscountTypePercentage, 1000, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}), ordertypes.ErrPromotionExists)
+	require.ErrorIs(t, k.CreatePromotion(ctx, merchant.String(), "STAKE", ordertypes.DiscountTypeFixed, 0, sdk.NewInt64Coin("stake", 10), sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}), ordertypes.ErrInvalidAmount)
+
+	// 10% of the 2000 subtotal
+	orderId, err := createCouponOrder(t, k, ctx, customer, merchant, "TENOFF", widgets, gadget)
+	require.NoError(t, err)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(2000), order.Subtotal)
+	require.Equal(t, ssusdCoin(200), order.DiscountAmount)
+	require.Equal(t, ssusdCoin(1800), order.TotalAmount)
+	require.Equal(t, "TENOFF", order.CouponCode)
+
+	// Below the minimum subtotal
+	_, err = createCouponOrder(t, k, ctx, customer, merchant, "TENOFF", widgets)
+	require.ErrorIs(t, err, ordertypes.ErrCouponNotApplicable)
+
+	_, err = createCouponOrder(t, k, ctx, customer, merchant, "NOPE", widgets)
+	require.ErrorIs(t, err, ordertypes.ErrPromotionNotFound)
+
+	// A fixed discount limited to one SKU is capped at that SKU's subtotal
+	require.NoError(t, k.CreatePromotion(ctx, merchant.String(), "WIDGETS", ordertypes.DiscountTypeFixed, 0, ssusdCoin(1500), sdk.Coin{}, 0, 0, []string{"sku-1"}, time.Time{}, time.Time{}))
+	orderId, err = createCouponOrder(t, k, ctx, customer, merchant, "WIDGETS", widgets, gadget)
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(1000), order.DiscountAmount)
+	require.Equal(t, ssusdCoin(1000), order.TotalAmount)
+
+	_, err = createCouponOrder(t, k, ctx, customer, merchant, "WIDGETS", gadget)
+	require.ErrorIs(t, err, ordertypes.ErrCouponNotApplicable)
+}
+
+func payCouponOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress, orderId uint64) error {
+	t.Helper()
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), orderId))
+	order, _ := k.GetOrder(ctx, orderId)
+	return k.PayOrder(ctx, customer.String(), orderId, order.TotalAmount, false)
+}
+
+func TestCoupon_RedemptionLimits(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	alice := newOrdersAddress()
+	bob := newOrdersAddress()
+	carol := newOrdersAddress()
+	merchant := newOrdersAddress()
+	item := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1, UnitPrice: ssusdCoin(1000)}
+
+	require.NoError(t, k.CreatePromotion(ctx, merchant.String(), "ONCE", ordertypes.DiscountTypeFixed, 0, ssusdCoin(100), sdk.Coin{}, 2, 1, nil, time.Time{}, time.Time{}))
+
+	// Unpaid orders do not redeem the coupon, so they hold no redemption
+	first, err := createCouponOrder(t, k, ctx, alice, merchant, "ONCE", item)
+	require.NoError(t, err)
+	second, err := createCouponOrder(t, k, ctx, alice, merchant, "ONCE", item)
+	require.NoError(t, err)
+	require.Zero(t, k.GetRedemptionCount(ctx, merchant.String(), "ONCE", alice.String()))
+
+	// Paying redeems it, and the customer's other open order can no longer be paid
+	require.NoError(t, payCouponOrder(t, k, ctx, alice, merchant, first))
+	require.Equal(t, uint64(1), k.GetRedemptionCount(ctx, merchant.String(), "ONCE", alice.String()))
+	require.ErrorIs(t, payCouponOrder(t, k, ctx, alice, merchant, second), ordertypes.ErrCouponExhausted)
+	_, err = createCouponOrder(t, k, ctx, alice, merchant, "ONCE", item)
+	require.ErrorIs(t, err, ordertypes.ErrCouponExhausted)
+
+	// Cancelled and expired orders never counted against the promotion
+	require.NoError(t, k.CancelOrder(ctx, alice.String(), second, "changed mind"))
+	_, err = createCouponOrder(t, k, ctx, bob, merchant, "ONCE", item)
+	require.NoError(t, err)
+	k.ProcessExpiredOrders(ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour)))
+	promotion, _ := k.GetPromotion(ctx, merchant.String(), "ONCE")
+	require.Equal(t, uint64(1), promotion.Redemptions)
+
+	// The first order paid takes the last redemption
+	bobOrder, err := createCouponOrder(t, k, ctx, bob, merchant, "ONCE", item)
+	require.NoError(t, err)
+	carolOrder, err := createCouponOrder(t, k, ctx, carol, merchant, "ONCE", item)
+	require.NoError(t, err)
+	require.NoError(t, payCouponOrder(t, k, ctx, bob, merchant, bobOrder))
+	require.ErrorIs(t, payCouponOrder(t, k, ctx, carol, merchant, carolOrder), ordertypes.ErrCouponExhausted)
+	promotion, _ = k.GetPromotion(ctx, merchant.String(), "ONCE")
+	require.Equal(t, uint64(2), promotion.Redemptions)
+	_, err = createCouponOrder(t, k, ctx, newOrdersAddress(), merchant, "ONCE", item)
+	require.ErrorIs(t, err, ordertypes.ErrCouponExhausted)
+
+	// Redemption counts survive a genesis round trip
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	k2, ctx2, _ := setupOrdersKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	require.Equal(t, uint64(1), k2.GetRedemptionCount(ctx2, merchant.String(), "ONCE", bob.String()))
+}
+
+func TestCoupon_ValidityWindow(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	item := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1, UnitPrice: ssusdCoin(1000)}
+	start := ctx.BlockTime().Add(time.Hour)
+	end := start.Add(24 * time.Hour)
+
+	require.NoError(t, k.CreatePromotion(ctx, merchant.String(), "LAUNCH", ordertypes.DiscountTypePercentage, 2500, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, start, end))
+
+	_, err := createCouponOrder(t, k, ctx, customer, merchant, "LAUNCH", item)
+	require.ErrorIs(t, err, ordertypes.ErrCouponNotApplicable)
+
+	orderId, err := createCouponOrder(t, k, ctx.WithBlockTime(start), customer, merchant, "LAUNCH", item)
+	require.NoError(t, err)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(250), order.DiscountAmount)
+
+	_, err = createCouponOrder(t, k, ctx.WithBlockTime(end), customer, merchant, "LAUNCH", item)
+	require.ErrorIs(t, err, ordertypes.ErrCouponNotApplicable)
+
+	// Deactivated promotions cannot be applied
+	require.NoError(t, k.DeactivatePromotion(ctx, merchant.String(), "LAUNCH"))
+	_, err = createCouponOrder(t, k, ctx.WithBlockTime(start), customer, merchant, "LAUNCH", item)
+	require.ErrorIs(t, err, ordertypes.ErrCouponNotApplicable)
+}
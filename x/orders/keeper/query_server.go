@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -76,3 +77,147 @@ func (q queryServer) Orders(goCtx context.Context, req *types.QueryOrdersRequest
 
 	return &types.QueryOrdersResponse{Orders: orders, Total: total}, nil
 }
+
+func (q queryServer) Dispute(goCtx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	if req == nil || req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	dispute, found := q.keeper.GetDispute(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "dispute not found")
+	}
+	return &types.QueryDisputeResponse{Dispute: dispute}, nil
+}
+
+func (q queryServer) OrdersByCustomer(goCtx context.Context, req *types.QueryOrdersByCustomerRequest) (*types.QueryOrdersByCustomerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	orders, pageRes, err := q.keeper.OrdersByCustomer(ctx, req.Customer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrdersByCustomerResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (q queryServer) OrdersByMerchant(goCtx context.Context, req *types.QueryOrdersByMerchantRequest) (*types.QueryOrdersByMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	orders, pageRes, err := q.keeper.OrdersByMerchant(ctx, req.Merchant, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrdersByMerchantResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (q queryServer) OrdersByStatus(goCtx context.Context, req *types.QueryOrdersByStatusRequest) (*types.QueryOrdersByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	orders, pageRes, err := q.keeper.OrdersByStatus(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOrdersByStatusResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (q queryServer) DisputesByOrder(goCtx context.Context, req *types.QueryDisputesByOrderRequest) (*types.QueryDisputesByOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	disputes, pageRes, err := q.keeper.DisputesByOrder(ctx, req.OrderId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDisputesByOrderResponse{Disputes: disputes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) DisputesByParty(goCtx context.Context, req *types.QueryDisputesByPartyRequest) (*types.QueryDisputesByPartyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	disputes, pageRes, err := q.keeper.DisputesByParty(ctx, req.Party, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDisputesByPartyResponse{Disputes: disputes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Product(goCtx context.Context, req *types.QueryProductRequest) (*types.QueryProductResponse, error) {
+	if req == nil || req.Merchant == "" || req.Sku == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	product, found := q.keeper.GetProduct(ctx, req.Merchant, req.Sku)
+	if !found {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	return &types.QueryProductResponse{Product: product}, nil
+}
+
+func (q queryServer) ProductsByMerchant(goCtx context.Context, req *types.QueryProductsByMerchantRequest) (*types.QueryProductsByMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	products, pageRes, err := q.keeper.ProductsByMerchant(ctx, req.Merchant, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryProductsByMerchantResponse{Products: products, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Promotion(goCtx context.Context, req *types.QueryPromotionRequest) (*types.QueryPromotionResponse, error) {
+	if req == nil || req.Merchant == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	promotion, found := q.keeper.GetPromotion(ctx, req.Merchant, req.Code)
+	if !found {
+		return nil, status.Error(codes.NotFound, "promotion not found")
+	}
+	return &types.QueryPromotionResponse{Promotion: promotion}, nil
+}
+
+func (q queryServer) PromotionsByMerchant(goCtx context.Context, req *types.QueryPromotionsByMerchantRequest) (*types.QueryPromotionsByMerchantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	promotions, pageRes, err := q.keeper.PromotionsByMerchant(ctx, req.Merchant, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPromotionsByMerchantResponse{Promotions: promotions, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TaxRates(goCtx context.Context, req *types.QueryTaxRatesRequest) (*types.QueryTaxRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rates, pageRes, err := q.keeper.TaxRates(ctx, req.Country, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTaxRatesResponse{Rates: rates, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TaxLiabilityReport(goCtx context.Context, req *types.QueryTaxLiabilityReportRequest) (*types.QueryTaxLiabilityReportResponse, error) {
+	if req == nil || req.Merchant == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	report, err := q.keeper.TaxLiabilityReport(ctx, req.Merchant, time.Unix(req.StartTime, 0).UTC(), time.Unix(req.EndTime, 0).UTC())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTaxLiabilityReportResponse{Report: report}, nil
+}
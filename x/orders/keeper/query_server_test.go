@@ -42,6 +42,23 @@ func TestQueryServer_Order(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestQueryServer_Dispute(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	qs := orderskeeper.NewQueryServerImpl(k)
+
+	// Test nil request
+	_, err := qs.Dispute(ctx, nil)
+	require.Error(t, err)
+
+	// Test invalid request (id=0)
+	_, err = qs.Dispute(ctx, &orderstypes.QueryDisputeRequest{Id: 0})
+	require.Error(t, err)
+
+	// Test dispute not found
+	_, err = qs.Dispute(ctx, &orderstypes.QueryDisputeRequest{Id: 999})
+	require.Error(t, err)
+}
+
 func TestQueryServer_Orders(t *testing.T) {
 	k, ctx := setupKeeper(t)
 	qs := orderskeeper.NewQueryServerImpl(k)
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// ============================================================================
+// Time Queues
+// ============================================================================
+
+// Orders awaiting expiry or auto-completion are queued by time followed by the
+// order ID, so EndBlock only reads the entries that are due. Entries are removed
+// when popped and the order is re-checked, so orders that moved on in the
+// meantime are simply skipped.
+
+func orderQueueKey(t time.Time, orderId uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), mustBz(orderId)...)
+}
+
+func (k Keeper) enqueueOrderExpiry(ctx sdk.Context, order types.Order) {
+	if order.ExpiresAt.IsZero() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderExpiryQueuePrefix)
+	store.Set(orderQueueKey(order.ExpiresAt, order.Id), mustBz(order.Id))
+}
+
+func (k Keeper) enqueueDeliveredOrder(ctx sdk.Context, order types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeliveredOrderQueuePrefix)
+	store.Set(orderQueueKey(order.DeliveredAt, order.Id), mustBz(order.Id))
+}
+
+// dequeueDue removes and returns the order IDs queued at or before end
+func (k Keeper) dequeueDue(ctx sdk.Context, queuePrefix []byte, end time.Time) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix)
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(end)))
+
+	var keys [][]byte
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
+
+// RebuildOrderQueues queues every unpaid order for expiry and every delivered
+// order for auto-completion. It is run when importing genesis and when migrating
+// stores written before the queues existed.
+func (k Keeper) RebuildOrderQueues(ctx sdk.Context) {
+	k.IterateOrders(ctx, func(order types.Order) bool {
+		switch order.Status {
+		case types.OrderStatusPending, types.OrderStatusConfirmed:
+			k.enqueueOrderExpiry(ctx, order)
+		case types.OrderStatusDelivered:
+			k.enqueueDeliveredOrder(ctx, order)
+		}
+		return false
+	})
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/orders/keeper"
+	ordertypes "github.com/stateset/core/x/orders/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
+)
+
+func createTestOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress) uint64 {
+	t.Helper()
+	items := []ordertypes.OrderItem{
+		{
+			Id:          "1",
+			ProductId:   "sku-1",
+			ProductName: "Widget",
+			Quantity:    1,
+			UnitPrice:   sdk.NewInt64Coin(stablecointypes.StablecoinDenom, 500),
+		},
+	}
+	listItems(t, k, ctx, merchant, items...)
+	orderId, err := k.CreateOrder(ctx, customer.String(), merchant.String(), items, ordertypes.ShippingInfo{}, "")
+	require.NoError(t, err)
+	return orderId
+}
+
+func TestProcessExpiredOrders(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	expiringId := createTestOrder(t, k, ctx, customer, merchant)
+	paidId := createTestOrder(t, k, ctx, customer, merchant)
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), paidId))
+	require.NoError(t, k.PayOrder(ctx, customer.String(), paidId, sdk.NewInt64Coin(stablecointypes.StablecoinDenom, 500), false))
+
+	// Not yet expired
+	k.ProcessExpiredOrders(ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Minute)))
+	order, _ := k.GetOrder(ctx, expiringId)
+	require.Equal(t, ordertypes.OrderStatusPending, order.Status)
+
+	k.ProcessExpiredOrders(ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour)))
+	order, _ = k.GetOrder(ctx, expiringId)
+	require.Equal(t, ordertypes.OrderStatusCancelled, order.Status)
+	order, _ = k.GetOrder(ctx, paidId)
+	require.Equal(t, ordertypes.OrderStatusPaid, order.Status)
+}
+
+func TestProcessAutoCompleteOrders(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+
+	orderId := createTestOrder(t, k, ctx, customer, merchant)
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), orderId))
+	require.NoError(t, k.PayOrder(ctx, customer.String(), orderId, sdk.NewInt64Coin(stablecointypes.StablecoinDenom, 500), true))
+	require.NoError(t, k.ShipOrder(ctx, merchant.String(), orderId, "UPS", "1Z"))
+	require.NoError(t, k.DeliverOrder(ctx, customer.String(), orderId))
+
+	// Inside the three day window
+	k.ProcessAutoCompleteOrders(ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour)))
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, ordertypes.OrderStatusDelivered, order.Status)
+
+	k.ProcessAutoCompleteOrders(ctx.WithBlockTime(ctx.BlockTime().Add(72 * time.Hour)))
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ordertypes.OrderStatusCompleted, order.Status)
+	require.Equal(t, ordertypes.PaymentStatusReleased, order.PaymentInfo.Status)
+}
+
+func TestRebuildOrderQueues_Genesis(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	orderId := createTestOrder(t, k, ctx, newOrdersAddress(), newOrdersAddress())
+	genesis := k.ExportGenesis(ctx)
+
+	k2, ctx2, _ := setupOrdersKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	k2.ProcessExpiredOrders(ctx2.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour)))
+	order, _ := k2.GetOrder(ctx2, orderId)
+	require.Equal(t, ordertypes.OrderStatusCancelled, order.Status)
+}
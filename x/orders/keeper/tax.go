@@ -0,0 +1,427 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/orders/types"
+)
+
+// ============================================================================
+// Tax
+// ============================================================================
+
+// The authority keeps a table of tax rates by country, state, postal code and
+// product tax category. CreateOrder taxes each item at the most specific rate
+// for the shipping address and the item's category, on the item's share of
+// the discounted subtotal. When the order is paid with a tax_collector param
+// set, its tax is held in the module account, like an escrow, and paid to the
+// collector once the order completes; refunds return their share of it to the
+// customer. Paid orders are recorded by merchant and payment time so merchants
+// can report the tax they owe for any period.
+
+func taxRateKey(country, state, postalCode, category string) []byte {
+	key := indexValuePrefix(types.TaxRateKeyPrefix, country)
+	key = append(key, address.MustLengthPrefix([]byte(state))...)
+	key = append(key, address.MustLengthPrefix([]byte(postalCode))...)
+	return append(key, []byte(category)...)
+}
+
+func taxRecordKey(merchant string, paidAt time.Time, orderId uint64) []byte {
+	return append(indexValuePrefix(types.TaxRecordKeyPrefix, merchant), orderQueueKey(paidAt, orderId)...)
+}
+
+// GetTaxRate retrieves the rate stored for a jurisdiction and category.
+func (k Keeper) GetTaxRate(ctx sdk.Context, country, state, postalCode, category string) (types.TaxRate, bool) {
+	if types.ValidateTaxJurisdiction(country, state, postalCode, category) != nil {
+		return types.TaxRate{}, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(taxRateKey(country, state, postalCode, category))
+	if len(bz) == 0 {
+		return types.TaxRate{}, false
+	}
+	var rate types.TaxRate
+	types.ModuleCdc.MustUnmarshalJSON(bz, &rate)
+	return rate, true
+}
+
+func (k Keeper) setTaxRate(ctx sdk.Context, rate types.TaxRate) {
+	ctx.KVStore(k.storeKey).Set(taxRateKey(rate.Country, rate.State, rate.PostalCode, rate.Category), types.ModuleCdc.MustMarshalJSON(&rate))
+}
+
+// IterateTaxRates iterates over all tax rates.
+func (k Keeper) IterateTaxRates(ctx sdk.Context, cb func(types.TaxRate) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxRateKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rate types.TaxRate
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &rate)
+		if cb(rate) {
+			break
+		}
+	}
+}
+
+// TaxRates returns a page of tax rates, optionally limited to one country.
+func (k Keeper) TaxRates(ctx sdk.Context, country string, pageReq *query.PageRequest) ([]types.TaxRate, *query.PageResponse, error) {
+	page := query.PageRequest{}
+	if pageReq != nil {
+		page = *pageReq
+	}
+	if page.Limit == 0 || page.Limit > MaxQueryLimit {
+		page.Limit = MaxQueryLimit
+	}
+
+	storePrefix := types.TaxRateKeyPrefix
+	if country != "" {
+		country = types.NormalizeTaxRegion(country)
+		if len(country) != 2 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidTaxRate, "country must be a two-letter code")
+		}
+		storePrefix = indexValuePrefix(types.TaxRateKeyPrefix, country)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	var rates []types.TaxRate
+	pageRes, err := query.Paginate(store, &page, func(_, value []byte) error {
+		var rate types.TaxRate
+		types.ModuleCdc.MustUnmarshalJSON(value, &rate)
+		rates = append(rates, rate)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rates, pageRes, nil
+}
+
+// SetTaxRate adds or replaces a tax rate (by authority).
+func (k Keeper) SetTaxRate(ctx sdk.Context, authority string, rate types.TaxRate) error {
+	if authority != k.authority {
+		return types.ErrUnauthorized
+	}
+	if err := rate.Validate(); err != nil {
+		return err
+	}
+	k.setTaxRate(ctx, rate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"tax_rate_set",
+			sdk.NewAttribute("country", rate.Country),
+			sdk.NewAttribute("state", rate.State),
+			sdk.NewAttribute("postal_code", rate.PostalCode),
+			sdk.NewAttribute("category", rate.Category),
+			sdk.NewAttribute("rate_bps", fmt.Sprintf("%d", rate.RateBps)),
+		),
+	)
+
+	return nil
+}
+
+// RemoveTaxRate deletes a tax rate (by authority). Orders already created keep
+// the tax they were charged.
+func (k Keeper) RemoveTaxRate(ctx sdk.Context, authority, country, state, postalCode, category string) error {
+	if authority != k.authority {
+		return types.ErrUnauthorized
+	}
+	if _, found := k.GetTaxRate(ctx, country, state, postalCode, category); !found {
+		return types.ErrTaxRateNotFound
+	}
+	ctx.KVStore(k.storeKey).Delete(taxRateKey(country, state, postalCode, category))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"tax_rate_removed",
+			sdk.NewAttribute("country", country),
+			sdk.NewAttribute("state", state),
+			sdk.NewAttribute("postal_code", postalCode),
+			sdk.NewAttribute("category", category),
+		),
+	)
+
+	return nil
+}
+
+// UpdateParams replaces the module parameters (by authority).
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params) error {
+	if authority != k.authority {
+		return types.ErrUnauthorized
+	}
+	return k.SetParams(ctx, params)
+}
+
+// LookupTaxRate returns the rate for an item of a tax category shipped to an
+// address. A rate for the category applies before any default rate, and among
+// either kind the most specific jurisdiction wins, from state and postal code
+// down to the country as a whole. Addresses without a country, or with no
+// matching rate, are not taxed.
+func (k Keeper) LookupTaxRate(ctx sdk.Context, shipTo types.Address, category string) uint32 {
+	country := types.NormalizeTaxRegion(shipTo.Country)
+	if len(country) != 2 {
+		return 0
+	}
+	categories := []string{""}
+	if category != "" {
+		categories = []string{category, ""}
+	}
+	for _, c := range categories {
+		for _, jurisdiction := range types.TaxJurisdictions(shipTo) {
+			if rate, found := k.GetTaxRate(ctx, country, jurisdiction[0], jurisdiction[1], c); found {
+				return rate.RateBps
+			}
+		}
+	}
+	return 0
+}
+
+// computeTax returns the tax on items shipped to an address. The order's
+// discount is spread over the items in proportion to their totals, so each
+// item is taxed on what the customer actually pays for it.
+func (k Keeper) computeTax(ctx sdk.Context, shipTo types.Address, items []types.OrderItem, subtotal, discount sdkmath.Int) sdkmath.Int {
+	if !subtotal.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+
+	rates := make(map[string]uint32)
+	weighted := sdkmath.ZeroInt()
+	for _, item := range items {
+		rate, found := rates[item.TaxCategory]
+		if !found {
+			rate = k.LookupTaxRate(ctx, shipTo, item.TaxCategory)
+			rates[item.TaxCategory] = rate
+		}
+		weighted = weighted.Add(item.TotalPrice.Amount.MulRaw(int64(rate)))
+	}
+	return weighted.Mul(subtotal.Sub(discount)).Quo(subtotal.MulRaw(10000))
+}
+
+// holdTax moves an order's tax from the customer into the module account
+// until the order completes, when a tax collector is configured, and returns
+// the amount left to settle with the merchant.
+func (k Keeper) holdTax(ctx sdk.Context, order *types.Order, collector string) (sdk.Coin, error) {
+	if collector == "" || !isPositiveCoin(order.TaxAmount) {
+		return order.TotalAmount, nil
+	}
+
+	customerAddr, err := sdk.AccAddressFromBech32(order.Customer)
+	if err != nil {
+		return sdk.Coin{}, types.ErrInvalidCustomer
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(sdk.WrapSDKContext(ctx), customerAddr, types.ModuleAccountName, sdk.NewCoins(order.TaxAmount)); err != nil {
+		return sdk.Coin{}, types.ErrInsufficientFunds
+	}
+	order.TaxCollector = collector
+
+	return order.TotalAmount.Sub(order.TaxAmount), nil
+}
+
+// taxShare returns the part of an order's tax included in a refund of amount.
+func taxShare(order types.Order, amount sdk.Coin) sdkmath.Int {
+	if !isPositiveCoin(order.TaxAmount) || !isPositiveCoin(order.TotalAmount) || amount.Amount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	if amount.Amount.GTE(order.TotalAmount.Amount) {
+		return order.TaxAmount.Amount
+	}
+	return order.TaxAmount.Amount.Mul(amount.Amount).Quo(order.TotalAmount.Amount)
+}
+
+// settleTax closes out the tax of a completed or refunded order: refunded is
+// returned to the customer and the rest paid to the tax collector. Tax not
+// held by the module was paid to the merchant, which returns its refunded
+// share itself, so only the ledger is updated. Settling an order twice does
+// nothing.
+func (k Keeper) settleTax(ctx sdk.Context, order *types.Order, refunded sdkmath.Int) error {
+	if order.TaxRemitted || !isPositiveCoin(order.TaxAmount) {
+		return nil
+	}
+	refund := sdk.NewCoin(order.TaxAmount.Denom, sdkmath.MinInt(refunded, order.TaxAmount.Amount))
+
+	if order.TaxCollector != "" {
+		wrappedCtx := sdk.WrapSDKContext(ctx)
+		if refund.IsPositive() {
+			customerAddr, err := sdk.AccAddressFromBech32(order.Customer)
+			if err != nil {
+				return types.ErrInvalidCustomer
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, customerAddr, sdk.NewCoins(refund)); err != nil {
+				return errorsmod.Wrap(types.ErrPaymentFailed, err.Error())
+			}
+		}
+
+		remitted := order.TaxAmount.Sub(refund)
+		if remitted.IsPositive() {
+			collectorAddr, err := sdk.AccAddressFromBech32(order.TaxCollector)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrPaymentFailed, "invalid tax collector")
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, collectorAddr, sdk.NewCoins(remitted)); err != nil {
+				return errorsmod.Wrap(types.ErrPaymentFailed, err.Error())
+			}
+		}
+		order.TaxRemitted = true
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"tax_remitted",
+				sdk.NewAttribute("order_id", fmt.Sprintf("%d", order.Id)),
+				sdk.NewAttribute("collector", order.TaxCollector),
+				sdk.NewAttribute("amount", remitted.String()),
+				sdk.NewAttribute("refunded", refund.String()),
+			),
+		)
+	}
+
+	k.updateTaxRecord(ctx, *order, refund)
+	return nil
+}
+
+func isPositiveCoin(coin sdk.Coin) bool {
+	return !coin.Amount.IsNil() && coin.IsPositive()
+}
+
+// recordTax adds a paid order to its merchant's tax ledger.
+func (k Keeper) recordTax(ctx sdk.Context, order types.Order) {
+	if !isPositiveCoin(order.TaxAmount) {
+		return
+	}
+	shipTo := order.ShippingInfo.Address
+	k.setTaxRecord(ctx, types.TaxRecord{
+		Merchant:      order.Merchant,
+		OrderId:       order.Id,
+		PaidAt:        order.PaidAt,
+		Country:       types.NormalizeTaxRegion(shipTo.Country),
+		State:         types.NormalizeTaxRegion(shipTo.State),
+		PostalCode:    types.NormalizeTaxRegion(shipTo.PostalCode),
+		TaxableAmount: order.Subtotal.Sub(order.DiscountAmount),
+		TaxAmount:     order.TaxAmount,
+		TaxRefunded:   sdk.NewCoin(order.TaxAmount.Denom, sdkmath.ZeroInt()),
+	})
+}
+
+// updateTaxRecord adds a refund of an order's tax to its ledger entry and
+// marks it remitted when the order's tax went to the tax collector.
+func (k Keeper) updateTaxRecord(ctx sdk.Context, order types.Order, refunded sdk.Coin) {
+	if order.PaidAt.IsZero() || !validIndexedValue(order.Merchant) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := taxRecordKey(order.Merchant, order.PaidAt, order.Id)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return
+	}
+	var record types.TaxRecord
+	types.ModuleCdc.MustUnmarshalJSON(bz, &record)
+	record.TaxRefunded = record.TaxRefunded.Add(refunded)
+	if record.TaxRefunded.IsGTE(record.TaxAmount) {
+		record.TaxRefunded = record.TaxAmount
+	}
+	record.Remitted = order.TaxRemitted
+	store.Set(key, types.ModuleCdc.MustMarshalJSON(&record))
+}
+
+func (k Keeper) setTaxRecord(ctx sdk.Context, record types.TaxRecord) {
+	ctx.KVStore(k.storeKey).Set(taxRecordKey(record.Merchant, record.PaidAt, record.OrderId), types.ModuleCdc.MustMarshalJSON(&record))
+}
+
+// IterateTaxRecords iterates over the tax ledgers of all merchants.
+func (k Keeper) IterateTaxRecords(ctx sdk.Context, cb func(types.TaxRecord) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TaxRecordKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TaxRecord
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// TaxLiabilityReport totals the tax on a merchant's orders paid from start up
+// to, but not including, end. Only the ledger entries in the period are read.
+func (k Keeper) TaxLiabilityReport(ctx sdk.Context, merchant string, start, end time.Time) (types.TaxLiabilityReport, error) {
+	if !validIndexedValue(merchant) {
+		return types.TaxLiabilityReport{}, types.ErrInvalidMerchant
+	}
+	if !end.After(start) {
+		return types.TaxLiabilityReport{}, errorsmod.Wrap(types.ErrInvalidOrder, "end must be after start")
+	}
+
+	zero := sdk.NewCoin(k.GetParams(ctx).StablecoinDenom, sdkmath.ZeroInt())
+	report := types.TaxLiabilityReport{
+		Merchant:     merchant,
+		StartTime:    start,
+		EndTime:      end,
+		TaxCollected: zero,
+		TaxRefunded:  zero,
+		TaxRemitted:  zero,
+		TaxDue:       zero,
+	}
+
+	jurisdictions := make(map[[3]string]*types.JurisdictionTax)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexValuePrefix(types.TaxRecordKeyPrefix, merchant))
+	iterator := store.Iterator(sdk.FormatTimeBytes(start), sdk.FormatTimeBytes(end))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TaxRecord
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &record)
+
+		owed := record.TaxAmount.Sub(record.TaxRefunded)
+		report.OrderCount++
+		report.TaxCollected = report.TaxCollected.Add(record.TaxAmount)
+		report.TaxRefunded = report.TaxRefunded.Add(record.TaxRefunded)
+		if record.Remitted {
+			report.TaxRemitted = report.TaxRemitted.Add(owed)
+		}
+		if !owed.IsPositive() {
+			continue
+		}
+		report.TaxDue = report.TaxDue.Add(owed)
+
+		key := [3]string{record.Country, record.State, record.PostalCode}
+		jurisdiction, found := jurisdictions[key]
+		if !found {
+			jurisdiction = &types.JurisdictionTax{
+				Country:       record.Country,
+				State:         record.State,
+				PostalCode:    record.PostalCode,
+				TaxableAmount: zero,
+				TaxAmount:     zero,
+			}
+			jurisdictions[key] = jurisdiction
+		}
+		jurisdiction.OrderCount++
+		// Refunds lower the taxable amount in proportion to the tax they return
+		taxable := record.TaxableAmount.Amount.Mul(owed.Amount).Quo(record.TaxAmount.Amount)
+		jurisdiction.TaxableAmount = jurisdiction.TaxableAmount.AddAmount(taxable)
+		jurisdiction.TaxAmount = jurisdiction.TaxAmount.Add(owed)
+	}
+
+	for _, jurisdiction := range jurisdictions {
+		report.Jurisdictions = append(report.Jurisdictions, *jurisdiction)
+	}
+	sort.Slice(report.Jurisdictions, func(i, j int) bool {
+		a, b := report.Jurisdictions[i], report.Jurisdictions[j]
+		if a.Country != b.Country {
+			return a.Country < b.Country
+		}
+		if a.State != b.State {
+			return a.State < b.State
+		}
+		return a.PostalCode < b.PostalCode
+	})
+
+	return report, nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/orders/keeper"
+	ordertypes "github.com/stateset/core/x/orders/types"
+)
+
+var austin = ordertypes.ShippingInfo{
+	Address: ordertypes.Address{City: "Austin", State: "tx", PostalCode: "78701", Country: "us"},
+}
+
+func setTexasTaxRates(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	t.Helper()
+	for _, rate := range []ordertypes.TaxRate{
+		{Country: "US", RateBps: 500},
+		{Country: "US", State: "TX", RateBps: 625},
+		{Country: "US", State: "TX", PostalCode: "78701", RateBps: 825},
+		{Country: "US", State: "TX", Category: "grocery", RateBps: 0},
+	} {
+		require.NoError(t, k.SetTaxRate(ctx, k.GetAuthority(), rate))
+	}
+}
+
+func TestCreateOrder_ComputesTax(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	setTexasTaxRates(t, k, ctx)
+	require.ErrorIs(t, k.SetTaxRate(ctx, customer.String(), ordertypes.TaxRate{Country: "US", RateBps: 1}), ordertypes.ErrUnauthorized)
+
+	require.NoError(t, k.RegisterProduct(ctx, merchant.String(), "widget", "Widget", ssusdCoin(1000), nil, 10, "", ""))
+	require.NoError(t, k.RegisterProduct(ctx, merchant.String(), "apples", "Apples", ssusdCoin(1000), nil, 10, "", "grocery"))
+	widget := ordertypes.OrderItem{Id: "1", ProductId: "widget", Quantity: 2}
+	apples := ordertypes.OrderItem{Id: "2", ProductId: "apples", Quantity: 1}
+
+	// The postal code rate applies to the widgets, groceries are exempt
+	orderId, err := k.CreateOrder(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{widget, apples}, austin, "")
+	require.NoError(t, err)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.Equal(t, "grocery", order.Items[1].TaxCategory)
+	require.Equal(t, ssusdCoin(165), order.TaxAmount)
+	require.Equal(t, ssusdCoin(3165), order.TotalAmount)
+
+	// Elsewhere in the state, and the country, fall back to broader rates
+	dallas := austin
+	dallas.Address.PostalCode = "75201"
+	orderId, err = k.CreateOrder(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{widget}, dallas, "")
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(125), order.TaxAmount)
+
+	ohio := ordertypes.ShippingInfo{Address: ordertypes.Address{State: "OH", Country: "US"}}
+	orderId, err = k.CreateOrder(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{widget}, ohio, "")
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(100), order.TaxAmount)
+
+	// Discounts lower the taxed amount
+	require.NoError(t, k.CreatePromotion(ctx, merchant.String(), "HALF", ordertypes.DiscountTypePercentage, 5000, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}))
+	orderId, err = k.CreateOrderWithCoupon(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{widget}, austin, "", "HALF")
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(82), order.TaxAmount)
+	require.Equal(t, ssusdCoin(1082), order.TotalAmount)
+
+	// Customers cannot pick the tax category of an item
+	exempt := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1, UnitPrice: ssusdCoin(1000), TaxCategory: "grocery"}
+	other := newOrdersAddress()
+	listItems(t, k, ctx, other, exempt)
+	orderId, err = k.CreateOrder(ctx, customer.String(), other.String(), []ordertypes.OrderItem{exempt}, austin, "")
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Empty(t, order.Items[0].TaxCategory)
+	require.Equal(t, ssusdCoin(82), order.TaxAmount)
+
+	// Without a matching rate there is no tax
+	require.NoError(t, k.RemoveTaxRate(ctx, k.GetAuthority(), "US", "", "", ""))
+	require.ErrorIs(t, k.RemoveTaxRate(ctx, k.GetAuthority(), "US", "", "", ""), ordertypes.ErrTaxRateNotFound)
+	orderId, err = k.CreateOrder(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{widget}, ohio, "")
+	require.NoError(t, err)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.True(t, order.TaxAmount.IsZero())
+}
+
+// payOrder pays a confirmed order from a customer in full.
+func payOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress, shipTo ordertypes.ShippingInfo, useEscrow bool) uint64 {
+	t.Helper()
+	item := ordertypes.OrderItem{Id: "1", ProductId: "sku-1", Quantity: 1, UnitPrice: ssusdCoin(1000)}
+	listItems(t, k, ctx, merchant, item)
+	orderId, err := k.CreateOrder(ctx, customer.String(), merchant.String(), []ordertypes.OrderItem{item}, shipTo, "")
+	require.NoError(t, err)
+	require.NoError(t, k.ConfirmOrder(ctx, merchant.String(), orderId))
+	order, _ := k.GetOrder(ctx, orderId)
+	require.NoError(t, k.PayOrder(ctx, customer.String(), orderId, order.TotalAmount, useEscrow))
+	return orderId
+}
+
+func completeOrder(t *testing.T, k keeper.Keeper, ctx sdk.Context, customer, merchant sdk.AccAddress, orderId uint64) {
+	t.Helper()
+	require.NoError(t, k.ShipOrder(ctx, merchant.String(), orderId, "ups", "1Z"))
+	require.NoError(t, k.DeliverOrder(ctx, customer.String(), orderId))
+	require.NoError(t, k.CompleteOrder(ctx, customer.String(), orderId))
+}
+
+func TestPayOrder_HoldsTaxUntilCompletion(t *testing.T) {
+	k, ctx, settlement, bank := setupOrdersKeeperWithBank(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	collector := newOrdersAddress()
+	setTexasTaxRates(t, k, ctx)
+	held := func() sdk.Coin {
+		return sdk.NewCoin("ssusd", bank.received[ordertypes.ModuleAccountName].AmountOf("ssusd"))
+	}
+
+	// Without a tax collector the merchant is paid the tax
+	orderId := payOrder(t, k, ctx, customer, merchant, austin, false)
+	require.Equal(t, ssusdCoin(1082), settlement.lastAmount)
+	completeOrder(t, k, ctx, customer, merchant, orderId)
+	order, _ := k.GetOrder(ctx, orderId)
+	require.False(t, order.TaxRemitted)
+	require.True(t, held().IsZero())
+
+	params := k.GetParams(ctx)
+	params.TaxCollector = collector.String()
+	require.ErrorIs(t, k.UpdateParams(ctx, customer.String(), params), ordertypes.ErrUnauthorized)
+	require.NoError(t, k.UpdateParams(ctx, k.GetAuthority(), params))
+
+	// The tax is held until the order completes
+	orderId = payOrder(t, k, ctx, customer, merchant, austin, true)
+	require.Equal(t, ssusdCoin(1000), settlement.lastAmount)
+	require.Equal(t, ssusdCoin(82), held())
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, collector.String(), order.TaxCollector)
+	require.Equal(t, ssusdCoin(1082), order.PaymentInfo.PaidAmount)
+	require.False(t, order.TaxRemitted)
+
+	completeOrder(t, k, ctx, customer, merchant, orderId)
+	order, _ = k.GetOrder(ctx, orderId)
+	require.True(t, order.TaxRemitted)
+	require.True(t, held().IsZero())
+	require.Equal(t, int64(82), bank.received[collector.String()].AmountOf("ssusd").Int64())
+
+	// A full refund of an escrowed order returns the tax too
+	orderId = payOrder(t, k, ctx, customer, merchant, austin, true)
+	require.NoError(t, k.RefundOrder(ctx, merchant.String(), orderId, sdk.Coin{}, "damaged", true))
+	order, _ = k.GetOrder(ctx, orderId)
+	require.Equal(t, ssusdCoin(1082), order.PaymentInfo.RefundedAmount)
+	require.True(t, held().IsZero())
+	require.Equal(t, int64(82), bank.received[customer.String()].AmountOf("ssusd").Int64())
+	require.Equal(t, int64(82), bank.received[collector.String()].AmountOf("ssusd").Int64())
+
+	// A partial refund returns the tax on the refunded part and remits the rest
+	orderId = payOrder(t, k, ctx, customer, merchant, austin, false)
+	require.ErrorIs(t, k.RefundOrder(ctx, merchant.String(), orderId, ssusdCoin(1083), "too much", false), ordertypes.ErrInvalidAmount)
+	require.NoError(t, k.RefundOrder(ctx, merchant.String(), orderId, ssusdCoin(541), "one of two", false))
+	require.True(t, held().IsZero())
+	require.Equal(t, int64(82+500+41), bank.received[customer.String()].AmountOf("ssusd").Int64())
+	require.Equal(t, int64(82+41), bank.received[collector.String()].AmountOf("ssusd").Int64())
+}
+
+func TestTaxLiabilityReport(t *testing.T) {
+	k, ctx, _ := setupOrdersKeeper(t)
+	customer := newOrdersAddress()
+	merchant := newOrdersAddress()
+	setTexasTaxRates(t, k, ctx)
+	params := k.GetParams(ctx)
+	params.TaxCollector = newOrdersAddress().String()
+	require.NoError(t, k.UpdateParams(ctx, k.GetAuthority(), params))
+	start := ctx.BlockTime()
+	ohio := ordertypes.ShippingInfo{Address: ordertypes.Address{State: "OH", Country: "US"}}
+
+	completed := payOrder(t, k, ctx, customer, merchant, austin, false)
+	refunded := payOrder(t, k, ctx.WithBlockTime(start.Add(time.Hour)), customer, merchant, austin, false)
+	halved := payOrder(t, k, ctx.WithBlockTime(start.Add(2*time.Hour)), customer, merchant, ohio, false)
+	payOrder(t, k, ctx.WithBlockTime(start.Add(48*time.Hour)), customer, merchant, austin, false)
+	completeOrder(t, k, ctx, customer, merchant, completed)
+	require.NoError(t, k.RefundOrder(ctx, merchant.String(), refunded, sdk.Coin{}, "returned", true))
+	require.NoError(t, k.RefundOrder(ctx, merchant.String(), halved, ssusdCoin(525), "half returned", false))
+
+	report, err := k.TaxLiabilityReport(ctx, merchant.String(), start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), report.OrderCount)
+	require.Equal(t, ssusdCoin(214), report.TaxCollected)
+	require.Equal(t, ssusdCoin(107), report.TaxRefunded)
+	require.Equal(t, ssusdCoin(107), report.TaxRemitted)
+	require.Equal(t, ssusdCoin(107), report.TaxDue)
+	require.Len(t, report.Jurisdictions, 2)
+	require.Equal(t, "OH", report.Jurisdictions[0].State)
+	require.Equal(t, ssusdCoin(25), report.Jurisdictions[0].TaxAmount)
+	require.Equal(t, ssusdCoin(500), report.Jurisdictions[0].TaxableAmount)
+	require.Equal(t, "78701", report.Jurisdictions[1].PostalCode)
+	require.Equal(t, uint64(1), report.Jurisdictions[1].OrderCount)
+	require.Equal(t, ssusdCoin(1000), report.Jurisdictions[1].TaxableAmount)
+
+	// Other merchants' orders are not reported
+	report, err = k.TaxLiabilityReport(ctx, newOrdersAddress().String(), start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, report.OrderCount)
+
+	_, err = k.TaxLiabilityReport(ctx, merchant.String(), start, start)
+	require.ErrorIs(t, err, ordertypes.ErrInvalidOrder)
+
+	// The ledger survives a genesis round trip
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	k2, ctx2, _ := setupOrdersKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	report, err = k2.TaxLiabilityReport(ctx2, merchant.String(), start, start.Add(72*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), report.OrderCount)
+	require.Equal(t, ssusdCoin(189), report.TaxDue)
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSKULength caps the length of a product SKU.
+	MaxSKULength = 128
+
+	// MaxProductNameLength caps the length of a product or variant name.
+	MaxProductNameLength = 256
+
+	// MaxProductVariants caps the number of variants of a product.
+	MaxProductVariants = 100
+)
+
+// ValidateProductTerms checks the fields merchants set on a product.
+func ValidateProductTerms(sku, name string, price sdk.Coin, variants []ProductVariant) error {
+	if sku == "" || len(sku) > MaxSKULength {
+		return errorsmod.Wrapf(ErrInvalidProduct, "sku must be 1 to %d characters", MaxSKULength)
+	}
+	if name == "" || len(name) > MaxProductNameLength {
+		return errorsmod.Wrapf(ErrInvalidProduct, "name must be 1 to %d characters", MaxProductNameLength)
+	}
+	if !price.IsValid() || !price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidAmount, "price must be positive")
+	}
+	if len(variants) > MaxProductVariants {
+		return errorsmod.Wrapf(ErrInvalidProduct, "at most %d variants", MaxProductVariants)
+	}
+	seen := make(map[string]bool, len(variants))
+	for _, variant := range variants {
+		if variant.Id == "" || len(variant.Id) > MaxSKULength {
+			return errorsmod.Wrapf(ErrInvalidProduct, "variant id must be 1 to %d characters", MaxSKULength)
+		}
+		if seen[variant.Id] {
+			return errorsmod.Wrapf(ErrInvalidProduct, "duplicate variant %s", variant.Id)
+		}
+		seen[variant.Id] = true
+		if len(variant.Name) > MaxProductNameLength {
+			return errorsmod.Wrapf(ErrInvalidProduct, "variant name exceeds %d characters", MaxProductNameLength)
+		}
+		if variant.HasPrice() && (!variant.Price.IsValid() || variant.Price.Denom != price.Denom) {
+			return errorsmod.Wrapf(ErrInvalidAmount, "variant %s price must be in %s", variant.Id, price.Denom)
+		}
+	}
+	return nil
+}
+
+// Validate checks a stored product, including that reservations do not exceed
+// stock.
+func (p Product) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	if err := ValidateProductTerms(p.Sku, p.Name, p.Price, p.Variants); err != nil {
+		return err
+	}
+	if err := ValidateTaxCategory(p.TaxCategory); err != nil {
+		return err
+	}
+	if p.Reserved > p.Stock {
+		return errorsmod.Wrapf(ErrInvalidProduct, "product %s reserves more than its stock", p.Sku)
+	}
+	for _, variant := range p.Variants {
+		if variant.Reserved > variant.Stock {
+			return errorsmod.Wrapf(ErrInvalidProduct, "variant %s of %s reserves more than its stock", variant.Id, p.Sku)
+		}
+	}
+	return nil
+}
+
+// HasPrice reports whether the variant overrides the product's price.
+func (v ProductVariant) HasPrice() bool {
+	return !v.Price.Amount.IsNil() && !v.Price.IsZero()
+}
+
+// Available returns the stock not reserved by open orders.
+func (v ProductVariant) Available() uint64 {
+	return v.Stock - v.Reserved
+}
+
+// Available returns the stock not reserved by open orders.
+func (p Product) Available() uint64 {
+	return p.Stock - p.Reserved
+}
+
+// VariantIndex returns the index of a variant, or -1 if the product has no
+// such variant.
+func (p Product) VariantIndex(id string) int {
+	for i, variant := range p.Variants {
+		if variant.Id == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -18,6 +18,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRefundOrder{}, "orders/RefundOrder", nil)
 	cdc.RegisterConcrete(&MsgOpenDispute{}, "orders/OpenDispute", nil)
 	cdc.RegisterConcrete(&MsgResolveDispute{}, "orders/ResolveDispute", nil)
+	cdc.RegisterConcrete(&MsgRegisterProduct{}, "orders/RegisterProduct", nil)
+	cdc.RegisterConcrete(&MsgUpdateProduct{}, "orders/UpdateProduct", nil)
+	cdc.RegisterConcrete(&MsgAdjustInventory{}, "orders/AdjustInventory", nil)
+	cdc.RegisterConcrete(&MsgCreatePromotion{}, "orders/CreatePromotion", nil)
+	cdc.RegisterConcrete(&MsgDeactivatePromotion{}, "orders/DeactivatePromotion", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "orders/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetTaxRate{}, "orders/SetTaxRate", nil)
+	cdc.RegisterConcrete(&MsgRemoveTaxRate{}, "orders/RemoveTaxRate", nil)
 }
 
 // RegisterInterfaces registers the x/orders interfaces types with the interface registry.
@@ -27,4 +27,15 @@ var (
 	ErrEmptyItems            = errorsmod.Register(ModuleName, 21, "order must have at least one item")
 	ErrInvalidMerchant       = errorsmod.Register(ModuleName, 22, "invalid merchant address")
 	ErrInvalidCustomer       = errorsmod.Register(ModuleName, 23, "invalid customer address")
+	ErrProductNotFound       = errorsmod.Register(ModuleName, 24, "product not found")
+	ErrProductExists         = errorsmod.Register(ModuleName, 25, "product already exists")
+	ErrInvalidProduct        = errorsmod.Register(ModuleName, 26, "invalid product")
+	ErrInsufficientStock     = errorsmod.Register(ModuleName, 27, "insufficient stock")
+	ErrPromotionNotFound     = errorsmod.Register(ModuleName, 28, "promotion not found")
+	ErrPromotionExists       = errorsmod.Register(ModuleName, 29, "promotion already exists")
+	ErrInvalidPromotion      = errorsmod.Register(ModuleName, 30, "invalid promotion")
+	ErrCouponNotApplicable   = errorsmod.Register(ModuleName, 31, "coupon not applicable to order")
+	ErrCouponExhausted       = errorsmod.Register(ModuleName, 32, "coupon redemption limit reached")
+	ErrInvalidTaxRate        = errorsmod.Register(ModuleName, 33, "invalid tax rate")
+	ErrTaxRateNotFound       = errorsmod.Register(ModuleName, 34, "tax rate not found")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,6 +20,7 @@ func DefaultParams() Params {
 		StablecoinDenom:           stablecointypes.StablecoinDenom,
 		AutoCompleteAfterDelivery: true,
 		AutoCompleteWindow:        259200, // 3 days after delivery
+		MaxInventoryHold:          1800,   // 30 minutes
 	}
 }
 
@@ -36,16 +38,29 @@ func (p Params) Validate() error {
 	if p.DefaultFeeRateBps > 10000 {
 		return ErrInvalidAmount
 	}
+	if p.MaxInventoryHold <= 0 {
+		return errorsmod.Wrap(ErrInvalidOrder, "max inventory hold must be positive")
+	}
+	if p.TaxCollector != "" {
+		if _, err := sdk.AccAddressFromBech32(p.TaxCollector); err != nil {
+			return errorsmod.Wrap(ErrInvalidOrder, "invalid tax collector address")
+		}
+	}
 	return nil
 }
 
 // GenesisState defines the orders module's genesis state.
 type GenesisState struct {
-	Params        Params    `json:"params"`
-	Orders        []Order   `json:"orders"`
-	Disputes      []Dispute `json:"disputes"`
-	NextOrderId   uint64    `json:"next_order_id"`
-	NextDisputeId uint64    `json:"next_dispute_id"`
+	Params        Params                `json:"params"`
+	Orders        []Order               `json:"orders"`
+	Disputes      []Dispute             `json:"disputes"`
+	Products      []Product             `json:"products"`
+	Promotions    []Promotion           `json:"promotions"`
+	Redemptions   []PromotionRedemption `json:"redemptions"`
+	TaxRates      []TaxRate             `json:"tax_rates"`
+	TaxRecords    []TaxRecord           `json:"tax_records"`
+	NextOrderId   uint64                `json:"next_order_id"`
+	NextDisputeId uint64                `json:"next_dispute_id"`
 }
 
 func (gs *GenesisState) Reset()         { *gs = GenesisState{} }
@@ -58,6 +73,11 @@ func DefaultGenesis() *GenesisState {
 		Params:        DefaultParams(),
 		Orders:        []Order{},
 		Disputes:      []Dispute{},
+		Products:      []Product{},
+		Promotions:    []Promotion{},
+		Redemptions:   []PromotionRedemption{},
+		TaxRates:      []TaxRate{},
+		TaxRecords:    []TaxRecord{},
 		NextOrderId:   1,
 		NextDisputeId: 1,
 	}
@@ -68,5 +88,54 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	seen := make(map[string]bool, len(gs.Products))
+	for _, product := range gs.Products {
+		if err := product.Validate(); err != nil {
+			return err
+		}
+		key := product.Merchant + "/" + product.Sku
+		if seen[key] {
+			return errorsmod.Wrapf(ErrProductExists, "duplicate product %s", key)
+		}
+		seen[key] = true
+	}
+	promotions := make(map[string]bool, len(gs.Promotions))
+	for _, promotion := range gs.Promotions {
+		if err := promotion.Validate(); err != nil {
+			return err
+		}
+		key := promotion.Merchant + "/" + promotion.Code
+		if promotions[key] {
+			return errorsmod.Wrapf(ErrPromotionExists, "duplicate promotion %s", key)
+		}
+		promotions[key] = true
+	}
+	for _, redemption := range gs.Redemptions {
+		if !promotions[redemption.Merchant+"/"+redemption.Code] {
+			return errorsmod.Wrapf(ErrPromotionNotFound, "redemption of unknown promotion %s/%s", redemption.Merchant, redemption.Code)
+		}
+		if _, err := sdk.AccAddressFromBech32(redemption.Customer); err != nil {
+			return ErrInvalidCustomer
+		}
+	}
+	rates := make(map[string]bool, len(gs.TaxRates))
+	for _, rate := range gs.TaxRates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		key := rate.Country + "/" + rate.State + "/" + rate.PostalCode + "/" + rate.Category
+		if rates[key] {
+			return errorsmod.Wrapf(ErrInvalidTaxRate, "duplicate tax rate %s", key)
+		}
+		rates[key] = true
+	}
+	for _, record := range gs.TaxRecords {
+		if _, err := sdk.AccAddressFromBech32(record.Merchant); err != nil {
+			return ErrInvalidMerchant
+		}
+		if !record.TaxAmount.IsValid() || !record.TaxRefunded.IsValid() || record.TaxRefunded.Denom != record.TaxAmount.Denom || record.TaxRefunded.Amount.GT(record.TaxAmount.Amount) {
+			return errorsmod.Wrapf(ErrInvalidAmount, "tax record of order %d", record.OrderId)
+		}
+	}
 	return nil
 }
@@ -38,4 +38,31 @@ var (
 
 	// DisputeKeyPrefix is the prefix for dispute storage.
 	DisputeKeyPrefix = []byte{0x07}
+
+	// OrderExpiryQueuePrefix queues unpaid orders by expiration time.
+	OrderExpiryQueuePrefix = []byte{0x08}
+
+	// DeliveredOrderQueuePrefix queues delivered orders by delivery time.
+	DeliveredOrderQueuePrefix = []byte{0x09}
+
+	// DisputeByOrderKeyPrefix indexes disputes by order.
+	DisputeByOrderKeyPrefix = []byte{0x0A}
+
+	// DisputeByPartyKeyPrefix indexes disputes by customer and merchant.
+	DisputeByPartyKeyPrefix = []byte{0x0B}
+
+	// ProductKeyPrefix is the prefix for catalog products, keyed by merchant and SKU.
+	ProductKeyPrefix = []byte{0x0C}
+
+	// PromotionKeyPrefix is the prefix for promotions, keyed by merchant and coupon code.
+	PromotionKeyPrefix = []byte{0x0D}
+
+	// PromotionRedemptionKeyPrefix counts redemptions by merchant, coupon code and customer.
+	PromotionRedemptionKeyPrefix = []byte{0x0E}
+
+	// TaxRateKeyPrefix is the prefix for tax rates, keyed by jurisdiction and category.
+	TaxRateKeyPrefix = []byte{0x0F}
+
+	// TaxRecordKeyPrefix records the tax on paid orders by merchant and payment time.
+	TaxRecordKeyPrefix = []byte{0x10}
 )
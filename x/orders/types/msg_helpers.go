@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,6 +26,11 @@ func (msg MsgCreateOrder) ValidateBasic() error {
 	if len(msg.Items) == 0 {
 		return ErrEmptyItems
 	}
+	if msg.CouponCode != "" {
+		if err := ValidateCouponCode(msg.CouponCode); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -249,3 +256,193 @@ func (msg MsgResolveDispute) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+func NewMsgRegisterProduct(merchant, sku, name string, price sdk.Coin, variants []ProductVariant, stock uint64, metadata, taxCategory string) *MsgRegisterProduct {
+	return &MsgRegisterProduct{
+		Merchant:    merchant,
+		Sku:         sku,
+		Name:        name,
+		Price:       price,
+		Variants:    variants,
+		Stock:       stock,
+		Metadata:    metadata,
+		TaxCategory: taxCategory,
+	}
+}
+
+func (msg MsgRegisterProduct) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	if err := ValidateTaxCategory(msg.TaxCategory); err != nil {
+		return err
+	}
+	return ValidateProductTerms(msg.Sku, msg.Name, msg.Price, msg.Variants)
+}
+
+func (msg MsgRegisterProduct) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Merchant)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdateProduct(merchant, sku, name string, price sdk.Coin, variants []ProductVariant, active bool, metadata, taxCategory string) *MsgUpdateProduct {
+	return &MsgUpdateProduct{
+		Merchant:    merchant,
+		Sku:         sku,
+		Name:        name,
+		Price:       price,
+		Variants:    variants,
+		Active:      active,
+		Metadata:    metadata,
+		TaxCategory: taxCategory,
+	}
+}
+
+func (msg MsgUpdateProduct) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	if err := ValidateTaxCategory(msg.TaxCategory); err != nil {
+		return err
+	}
+	return ValidateProductTerms(msg.Sku, msg.Name, msg.Price, msg.Variants)
+}
+
+func (msg MsgUpdateProduct) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Merchant)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgAdjustInventory(merchant, sku, variant string, delta int64) *MsgAdjustInventory {
+	return &MsgAdjustInventory{
+		Merchant: merchant,
+		Sku:      sku,
+		Variant:  variant,
+		Delta:    delta,
+	}
+}
+
+func (msg MsgAdjustInventory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	if msg.Sku == "" || len(msg.Sku) > MaxSKULength {
+		return ErrInvalidProduct
+	}
+	if msg.Delta == 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+func (msg MsgAdjustInventory) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Merchant)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCreatePromotion(merchant, code, discountType string, percentageBps uint32, fixedAmount, minSubtotal sdk.Coin, maxRedemptions, maxRedemptionsPerCustomer uint64, skus []string, startsAt, endsAt time.Time) *MsgCreatePromotion {
+	return &MsgCreatePromotion{
+		Merchant:                  merchant,
+		Code:                      code,
+		DiscountType:              discountType,
+		PercentageBps:             percentageBps,
+		FixedAmount:               fixedAmount,
+		MinSubtotal:               minSubtotal,
+		MaxRedemptions:            maxRedemptions,
+		MaxRedemptionsPerCustomer: maxRedemptionsPerCustomer,
+		Skus:                      skus,
+		StartsAt:                  startsAt,
+		EndsAt:                    endsAt,
+	}
+}
+
+func (msg MsgCreatePromotion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	return ValidatePromotionTerms(msg.Code, msg.DiscountType, msg.PercentageBps, msg.FixedAmount, msg.MinSubtotal, msg.Skus, msg.StartsAt, msg.EndsAt)
+}
+
+func (msg MsgCreatePromotion) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Merchant)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgDeactivatePromotion(merchant, code string) *MsgDeactivatePromotion {
+	return &MsgDeactivatePromotion{
+		Merchant: merchant,
+		Code:     code,
+	}
+}
+
+func (msg MsgDeactivatePromotion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	return ValidateCouponCode(msg.Code)
+}
+
+func (msg MsgDeactivatePromotion) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Merchant)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgSetTaxRate(authority string, rate TaxRate) *MsgSetTaxRate {
+	return &MsgSetTaxRate{
+		Authority: authority,
+		Rate:      rate,
+	}
+}
+
+func (msg MsgSetTaxRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	return msg.Rate.Validate()
+}
+
+func (msg MsgSetTaxRate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgRemoveTaxRate(authority, country, state, postalCode, category string) *MsgRemoveTaxRate {
+	return &MsgRemoveTaxRate{
+		Authority:  authority,
+		Country:    country,
+		State:      state,
+		PostalCode: postalCode,
+		Category:   category,
+	}
+}
+
+func (msg MsgRemoveTaxRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrUnauthorized
+	}
+	return ValidateTaxJurisdiction(msg.Country, msg.State, msg.PostalCode, msg.Category)
+}
+
+func (msg MsgRemoveTaxRate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -535,6 +537,125 @@ func TestMsgResolveDispute_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgRegisterProduct_ValidateBasic(t *testing.T) {
+	validMerchant := sdk.AccAddress("merchant____________").String()
+	price := sdk.NewInt64Coin("ssusd", 500)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgRegisterProduct
+		expectErr error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", price, nil, 10, "", ""),
+			expectErr: nil,
+		},
+		{
+			name: "valid message with variants",
+			msg: types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", price, []types.ProductVariant{
+				{Id: "red", Stock: 1},
+				{Id: "blue", Price: sdk.NewInt64Coin("ssusd", 600)},
+			}, 0, "", ""),
+			expectErr: nil,
+		},
+		{
+			name:      "invalid merchant address",
+			msg:       types.NewMsgRegisterProduct("invalid", "sku-1", "Widget", price, nil, 10, "", ""),
+			expectErr: types.ErrInvalidMerchant,
+		},
+		{
+			name:      "empty sku",
+			msg:       types.NewMsgRegisterProduct(validMerchant, "", "Widget", price, nil, 10, "", ""),
+			expectErr: types.ErrInvalidProduct,
+		},
+		{
+			name:      "zero price",
+			msg:       types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", sdk.NewInt64Coin("ssusd", 0), nil, 10, "", ""),
+			expectErr: types.ErrInvalidAmount,
+		},
+		{
+			name: "duplicate variant",
+			msg: types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", price, []types.ProductVariant{
+				{Id: "red"}, {Id: "red"},
+			}, 0, "", ""),
+			expectErr: types.ErrInvalidProduct,
+		},
+		{
+			name:      "tax category too long",
+			msg:       types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", price, nil, 10, "", strings.Repeat("x", types.MaxTaxCategoryLength+1)),
+			expectErr: types.ErrInvalidTaxRate,
+		},
+		{
+			name: "variant priced in another denom",
+			msg: types.NewMsgRegisterProduct(validMerchant, "sku-1", "Widget", price, []types.ProductVariant{
+				{Id: "red", Price: sdk.NewInt64Coin("stake", 1)},
+			}, 0, "", ""),
+			expectErr: types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAdjustInventory_ValidateBasic(t *testing.T) {
+	validMerchant := sdk.AccAddress("merchant____________").String()
+
+	require.NoError(t, types.NewMsgAdjustInventory(validMerchant, "sku-1", "", -3).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgAdjustInventory("invalid", "sku-1", "", 3).ValidateBasic(), types.ErrInvalidMerchant)
+	require.ErrorIs(t, types.NewMsgAdjustInventory(validMerchant, "", "", 3).ValidateBasic(), types.ErrInvalidProduct)
+	require.ErrorIs(t, types.NewMsgAdjustInventory(validMerchant, "sku-1", "", 0).ValidateBasic(), types.ErrInvalidAmount)
+}
+
+func TestMsgCreatePromotion_ValidateBasic(t *testing.T) {
+	validMerchant := sdk.AccAddress("merchant____________").String()
+	ssusd := sdk.NewInt64Coin("ssusd", 500)
+	start := time.Unix(1700000000, 0).UTC()
+
+	require.NoError(t, types.NewMsgCreatePromotion(validMerchant, "SAVE-10", types.DiscountTypePercentage, 1000, sdk.Coin{}, ssusd, 0, 1, []string{"sku-1"}, start, start.Add(time.Hour)).ValidateBasic())
+	require.NoError(t, types.NewMsgCreatePromotion(validMerchant, "TAKE5", types.DiscountTypeFixed, 0, ssusd, sdk.Coin{}, 10, 0, nil, time.Time{}, time.Time{}).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgCreatePromotion("invalid", "SAVE", types.DiscountTypePercentage, 1000, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidMerchant)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE 10", types.DiscountTypePercentage, 1000, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidPromotion)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE", types.DiscountTypePercentage, 10001, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidPromotion)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE", types.DiscountTypeFixed, 0, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidAmount)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE", "bogo", 0, sdk.Coin{}, sdk.Coin{}, 0, 0, nil, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidPromotion)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE", types.DiscountTypeFixed, 0, ssusd, sdk.Coin{}, 0, 0, []string{"sku-1", "sku-1"}, time.Time{}, time.Time{}).ValidateBasic(), types.ErrInvalidPromotion)
+	require.ErrorIs(t, types.NewMsgCreatePromotion(validMerchant, "SAVE", types.DiscountTypeFixed, 0, ssusd, sdk.Coin{}, 0, 0, nil, start, start).ValidateBasic(), types.ErrInvalidPromotion)
+
+	order := types.NewMsgCreateOrder(validMerchant, validMerchant, []types.OrderItem{{ProductId: "sku-1", Quantity: 1}}, types.ShippingInfo{}, "")
+	order.CouponCode = "bad code!"
+	require.ErrorIs(t, order.ValidateBasic(), types.ErrInvalidPromotion)
+}
+
+func TestMsgSetTaxRate_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	require.NoError(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "US", State: "TX", PostalCode: "78701", RateBps: 825}).ValidateBasic())
+	require.NoError(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "DE", Category: "books", RateBps: 700}).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgSetTaxRate("invalid", types.TaxRate{Country: "US", RateBps: 500}).ValidateBasic(), types.ErrUnauthorized)
+	require.ErrorIs(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "us", RateBps: 500}).ValidateBasic(), types.ErrInvalidTaxRate)
+	require.ErrorIs(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "USA", RateBps: 500}).ValidateBasic(), types.ErrInvalidTaxRate)
+	require.ErrorIs(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "US", State: "tx", RateBps: 500}).ValidateBasic(), types.ErrInvalidTaxRate)
+	require.ErrorIs(t, types.NewMsgSetTaxRate(authority, types.TaxRate{Country: "US", RateBps: 10001}).ValidateBasic(), types.ErrInvalidTaxRate)
+
+	require.NoError(t, types.NewMsgRemoveTaxRate(authority, "US", "TX", "", "").ValidateBasic())
+	require.ErrorIs(t, types.NewMsgRemoveTaxRate(authority, "", "", "", "").ValidateBasic(), types.ErrInvalidTaxRate)
+
+	params := types.DefaultParams()
+	require.NoError(t, types.NewMsgUpdateParams(authority, params).ValidateBasic())
+	params.TaxCollector = "invalid"
+	require.ErrorIs(t, types.NewMsgUpdateParams(authority, params).ValidateBasic(), types.ErrInvalidOrder)
+}
+
 func TestMsgCreateOrder_GetSigners(t *testing.T) {
 	customer := sdk.AccAddress("customer____________")
 	msg := types.MsgCreateOrder{
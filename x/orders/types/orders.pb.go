@@ -40,6 +40,12 @@ type Params struct {
 	StablecoinDenom           string                                  `protobuf:"bytes,7,opt,name=stablecoin_denom,json=stablecoinDenom,proto3" json:"stablecoin_denom,omitempty"`
 	AutoCompleteAfterDelivery bool                                    `protobuf:"varint,8,opt,name=auto_complete_after_delivery,json=autoCompleteAfterDelivery,proto3" json:"auto_complete_after_delivery,omitempty"`
 	AutoCompleteWindow        int64                                   `protobuf:"varint,9,opt,name=auto_complete_window,json=autoCompleteWindow,proto3" json:"auto_complete_window,omitempty"`
+	// tax_collector receives the tax on orders when they are paid; when empty
+	// the tax is paid to the merchant with the rest of the order
+	TaxCollector string `protobuf:"bytes,10,opt,name=tax_collector,json=taxCollector,proto3" json:"tax_collector,omitempty"`
+	// max_inventory_hold is how long, in seconds, an unpaid order holds the
+	// catalog stock it reserved before it expires
+	MaxInventoryHold int64 `protobuf:"varint,11,opt,name=max_inventory_hold,json=maxInventoryHold,proto3" json:"max_inventory_hold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -124,6 +130,20 @@ func (m *Params) GetAutoCompleteWindow() int64 {
 	return 0
 }
 
+func (m *Params) GetTaxCollector() string {
+	if m != nil {
+		return m.TaxCollector
+	}
+	return ""
+}
+
+func (m *Params) GetMaxInventoryHold() int64 {
+	if m != nil {
+		return m.MaxInventoryHold
+	}
+	return 0
+}
+
 // Order represents a customer order in the Stateset commerce system.
 type Order struct {
 	Id             uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -148,6 +168,16 @@ type Order struct {
 	ExpiresAt      time.Time                               `protobuf:"bytes,20,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
 	SettlementId   uint64                                  `protobuf:"varint,21,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 	DisputeId      uint64                                  `protobuf:"varint,22,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	// inventory_reserved is set while the order holds catalog stock
+	InventoryReserved bool `protobuf:"varint,23,opt,name=inventory_reserved,json=inventoryReserved,proto3" json:"inventory_reserved,omitempty"`
+	// coupon_code is the merchant promotion applied to the order, if any
+	CouponCode string `protobuf:"bytes,24,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	// tax_remitted is set when the order's tax, less refunds, was paid to the
+	// tax collector
+	TaxRemitted bool `protobuf:"varint,25,opt,name=tax_remitted,json=taxRemitted,proto3" json:"tax_remitted,omitempty"`
+	// tax_collector is the account the order's tax is held for in the module
+	// account from payment until the order completes or is refunded
+	TaxCollector string `protobuf:"bytes,26,opt,name=tax_collector,json=taxCollector,proto3" json:"tax_collector,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -302,6 +332,34 @@ func (m *Order) GetDisputeId() uint64 {
 	return 0
 }
 
+func (m *Order) GetInventoryReserved() bool {
+	if m != nil {
+		return m.InventoryReserved
+	}
+	return false
+}
+
+func (m *Order) GetCouponCode() string {
+	if m != nil {
+		return m.CouponCode
+	}
+	return ""
+}
+
+func (m *Order) GetTaxRemitted() bool {
+	if m != nil {
+		return m.TaxRemitted
+	}
+	return false
+}
+
+func (m *Order) GetTaxCollector() string {
+	if m != nil {
+		return m.TaxCollector
+	}
+	return ""
+}
+
 // OrderItem represents an individual item within an order.
 type OrderItem struct {
 	Id          string                                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -312,6 +370,9 @@ type OrderItem struct {
 	TotalPrice  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=total_price,json=totalPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_price"`
 	Variant     string                                  `protobuf:"bytes,7,opt,name=variant,proto3" json:"variant,omitempty"`
 	Metadata    string                                  `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// tax_category is copied from the merchant's catalog product; values set by
+	// the customer are ignored
+	TaxCategory string `protobuf:"bytes,9,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
 }
 
 func (m *OrderItem) Reset()         { *m = OrderItem{} }
@@ -389,6 +450,13 @@ func (m *OrderItem) GetMetadata() string {
 	return ""
 }
 
+func (m *OrderItem) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
+	}
+	return ""
+}
+
 // PaymentInfo contains payment details for an order.
 type PaymentInfo struct {
 	Status         string                                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -805,662 +873,910 @@ func (m *Dispute) GetResolvedAt() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "stateset.core.orders.Params")
-	proto.RegisterType((*Order)(nil), "stateset.core.orders.Order")
-	proto.RegisterType((*OrderItem)(nil), "stateset.core.orders.OrderItem")
-	proto.RegisterType((*PaymentInfo)(nil), "stateset.core.orders.PaymentInfo")
-	proto.RegisterType((*ShippingInfo)(nil), "stateset.core.orders.ShippingInfo")
-	proto.RegisterType((*Address)(nil), "stateset.core.orders.Address")
-	proto.RegisterType((*Dispute)(nil), "stateset.core.orders.Dispute")
+// Product is a SKU in a merchant's catalog. Orders for a merchant with a
+// catalog are priced from it and reserve its stock. Products with variants
+// keep price and stock per variant.
+type Product struct {
+	Merchant string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Sku      string                                  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name     string                                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"price"`
+	Variants []ProductVariant                        `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants"`
+	// stock is the quantity on hand; reserved is held by open orders
+	Stock       uint64    `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reserved    uint64    `protobuf:"varint,7,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Active      bool      `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Metadata    string    `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt   time.Time `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt   time.Time `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	TaxCategory string    `protobuf:"bytes,12,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+}
+
+func (m *Product) Reset()         { *m = Product{} }
+func (m *Product) String() string { return proto.CompactTextString(m) }
+func (*Product) ProtoMessage()    {}
+func (*Product) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{7}
+}
+func (m *Product) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Product) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Product.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Product) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Product.Merge(m, src)
+}
+func (m *Product) XXX_Size() int {
+	return m.Size()
+}
+func (m *Product) XXX_DiscardUnknown() {
+	xxx_messageInfo_Product.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("stateset/core/orders/orders.proto", fileDescriptor_0b255b0366c5e824) }
+var xxx_messageInfo_Product proto.InternalMessageInfo
 
-var fileDescriptor_0b255b0366c5e824 = []byte{
-	// 1412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xc1, 0x6e, 0x1b, 0x37,
-	0x13, 0xb6, 0x6c, 0x59, 0xd6, 0x8e, 0x64, 0x39, 0xe1, 0xef, 0xdf, 0xff, 0xda, 0x7f, 0x23, 0x2b,
-	0x2a, 0x8a, 0xb8, 0x87, 0x4a, 0x8d, 0x7b, 0x29, 0x5a, 0x14, 0x85, 0xec, 0xa4, 0x85, 0x0a, 0x24,
-	0x0d, 0x36, 0x05, 0x0a, 0xf4, 0xb2, 0xa0, 0x96, 0x94, 0x4d, 0x44, 0xbb, 0xdc, 0x2e, 0xb9, 0x8e,
-	0xfd, 0x04, 0xbd, 0xe6, 0x41, 0x7a, 0xea, 0x33, 0xf4, 0x90, 0x43, 0x0f, 0x39, 0x16, 0x3d, 0xa4,
-	0x41, 0xf2, 0x22, 0x05, 0x87, 0xdc, 0xf5, 0xaa, 0x71, 0x8b, 0xaa, 0x90, 0x4f, 0xde, 0x19, 0xce,
-	0x7c, 0x43, 0x0e, 0x87, 0xdf, 0x8c, 0x05, 0xb7, 0x95, 0xa6, 0x9a, 0x2b, 0xae, 0x87, 0x91, 0xcc,
-	0xf8, 0x50, 0x66, 0x8c, 0x67, 0xca, 0xfd, 0x19, 0xa4, 0x99, 0xd4, 0x92, 0x6c, 0x17, 0x26, 0x03,
-	0x63, 0x32, 0xb0, 0x6b, 0x7b, 0xdb, 0x27, 0xf2, 0x44, 0xa2, 0xc1, 0xd0, 0x7c, 0x59, 0xdb, 0xbd,
-	0x6e, 0x24, 0x55, 0x2c, 0xd5, 0x70, 0x42, 0x15, 0x1f, 0x9e, 0xdd, 0x9d, 0x70, 0x4d, 0xef, 0x0e,
-	0x23, 0x29, 0x12, 0xb7, 0xbe, 0x7f, 0x22, 0xe5, 0xc9, 0x8c, 0x0f, 0x51, 0x9a, 0xe4, 0xd3, 0xa1,
-	0x16, 0x31, 0x57, 0x9a, 0xc6, 0xa9, 0x35, 0xe8, 0xbf, 0xaa, 0x43, 0xe3, 0x11, 0xcd, 0x68, 0xac,
-	0xc8, 0xc7, 0xe0, 0x33, 0x3e, 0xa5, 0xf9, 0x4c, 0x87, 0x18, 0x33, 0xe4, 0xe7, 0xa9, 0xc8, 0xa8,
-	0x16, 0x32, 0xf1, 0x6b, 0xbd, 0xda, 0xc1, 0x5a, 0xb0, 0xe3, 0xd6, 0xbf, 0x36, 0xcb, 0xf7, 0xcb,
-	0x55, 0xf2, 0x09, 0xec, 0x16, 0x9e, 0x5c, 0x45, 0x99, 0x7c, 0x5a, 0x75, 0x5d, 0x45, 0xd7, 0xff,
-	0x39, 0x83, 0xfb, 0xb8, 0x5e, 0xf1, 0x7d, 0x0f, 0x3a, 0x4c, 0xa8, 0x34, 0xd7, 0x3c, 0x7c, 0x2a,
-	0x12, 0x26, 0x9f, 0xfa, 0x6b, 0xe8, 0xb0, 0xe9, 0xb4, 0xdf, 0xa2, 0x92, 0x68, 0xb8, 0x11, 0x8b,
-	0xc4, 0x6d, 0x8c, 0xc6, 0x32, 0x4f, 0xb4, 0x5f, 0xef, 0xd5, 0x0e, 0x5a, 0x87, 0xbb, 0x03, 0x9b,
-	0x83, 0x81, 0xc9, 0xc1, 0xc0, 0xe5, 0x60, 0x70, 0x2c, 0x45, 0x72, 0x34, 0x7c, 0xfe, 0x72, 0x7f,
-	0xe5, 0xb7, 0x97, 0xfb, 0x77, 0x4e, 0x84, 0x3e, 0xcd, 0x27, 0x83, 0x48, 0xc6, 0x43, 0x97, 0x30,
-	0xfb, 0xe7, 0x03, 0xc5, 0x9e, 0x0c, 0xf5, 0x45, 0xca, 0x15, 0x3a, 0x04, 0x9d, 0x58, 0x24, 0x78,
-	0xb8, 0x11, 0x46, 0xc0, 0xa8, 0xf4, 0x7c, 0x3e, 0xea, 0xfa, 0x35, 0x44, 0xa5, 0xe7, 0xd5, 0xa8,
-	0x43, 0xd8, 0x2e, 0xd2, 0x39, 0xe5, 0x3c, 0xcc, 0xa8, 0xe6, 0xe1, 0x24, 0x55, 0x7e, 0xa3, 0x57,
-	0x3b, 0xd8, 0x0c, 0x6e, 0xba, 0xb5, 0x2f, 0x38, 0x0f, 0xa8, 0xe6, 0x47, 0xa9, 0x22, 0xef, 0xc3,
-	0x0d, 0xa5, 0xe9, 0x64, 0xc6, 0xcd, 0xcd, 0x87, 0x8c, 0x27, 0x32, 0xf6, 0x37, 0x7a, 0xb5, 0x03,
-	0x2f, 0xd8, 0xba, 0xd4, 0xdf, 0x33, 0x6a, 0xf2, 0x39, 0xbc, 0x43, 0x73, 0x2d, 0xc3, 0x48, 0xc6,
-	0xe9, 0x8c, 0x6b, 0x1e, 0xd2, 0xa9, 0xe6, 0x59, 0xc8, 0xf8, 0x4c, 0x9c, 0xf1, 0xec, 0xc2, 0x6f,
-	0xf6, 0x6a, 0x07, 0xcd, 0x60, 0xd7, 0xd8, 0x1c, 0x3b, 0x93, 0x91, 0xb1, 0xb8, 0xe7, 0x0c, 0xc8,
-	0x87, 0xb0, 0x3d, 0x0f, 0xe0, 0x6e, 0xcd, 0xc3, 0x5b, 0x23, 0x55, 0x47, 0x7b, 0x75, 0xfd, 0x1f,
-	0x5b, 0xb0, 0x8e, 0xc7, 0x23, 0x1d, 0x58, 0x15, 0x0c, 0x6b, 0xa9, 0x1e, 0xac, 0x0a, 0x46, 0xf6,
-	0xa0, 0x19, 0xe5, 0x4a, 0xcb, 0x98, 0x67, 0x58, 0x26, 0x5e, 0x50, 0xca, 0x66, 0x2d, 0xe6, 0x59,
-	0x74, 0x4a, 0x13, 0x8d, 0x15, 0xe1, 0x05, 0xa5, 0x4c, 0x76, 0xa0, 0x61, 0xde, 0x48, 0xae, 0xb0,
-	0x04, 0xbc, 0xc0, 0x49, 0xe4, 0x53, 0x58, 0x17, 0x9a, 0xc7, 0xca, 0x5f, 0xef, 0xad, 0x1d, 0xb4,
-	0x0e, 0xf7, 0x07, 0x57, 0xbd, 0xa4, 0x01, 0xee, 0x65, 0xac, 0x79, 0x7c, 0x54, 0x37, 0x37, 0x15,
-	0x58, 0x1f, 0x32, 0x85, 0xa6, 0xca, 0x27, 0x5a, 0x6a, 0x3a, 0xc3, 0x4c, 0x2f, 0xf7, 0x8e, 0x4b,
-	0x6c, 0x22, 0x61, 0x53, 0x9d, 0x8a, 0x34, 0x15, 0xc9, 0x49, 0x18, 0x49, 0xa5, 0xf1, 0xa6, 0x96,
-	0x1b, 0xac, 0x5d, 0x04, 0x38, 0x96, 0x4a, 0x13, 0x01, 0xa0, 0xe9, 0x79, 0x51, 0xbe, 0xcd, 0xa5,
-	0x47, 0xf3, 0x34, 0x3d, 0x77, 0x95, 0xab, 0x60, 0x8b, 0x09, 0x15, 0x99, 0xef, 0x22, 0x9e, 0xb7,
-	0xfc, 0xe7, 0x52, 0x84, 0x70, 0x41, 0x63, 0x68, 0x63, 0x66, 0x8b, 0x88, 0xb0, 0xf4, 0x88, 0x2d,
-	0xc4, 0x77, 0xe1, 0xbe, 0x82, 0x76, 0x4a, 0x2f, 0x62, 0x9e, 0xe8, 0x50, 0x24, 0x53, 0xe9, 0xb7,
-	0x30, 0xdc, 0xed, 0xab, 0x6b, 0xed, 0x91, 0xb5, 0x1c, 0x27, 0x53, 0xe9, 0xaa, 0xad, 0x95, 0x5e,
-	0xaa, 0xc8, 0x83, 0x4a, 0x2d, 0x20, 0x58, 0x1b, 0xc1, 0xfa, 0x57, 0x83, 0x3d, 0x76, 0xa6, 0x15,
-	0xb4, 0xf2, 0xa6, 0x11, 0x0e, 0xdf, 0x8c, 0xa6, 0x8c, 0x6a, 0xea, 0x6f, 0x16, 0x6f, 0xc6, 0xca,
-	0xe4, 0x18, 0x20, 0xca, 0x38, 0xd5, 0x9c, 0x85, 0x54, 0xfb, 0x1d, 0x8c, 0xb3, 0x37, 0xb0, 0xed,
-	0x61, 0x50, 0xb4, 0x87, 0xc1, 0x37, 0x45, 0x7b, 0x38, 0x6a, 0x1a, 0xfc, 0x67, 0xbf, 0xef, 0xd7,
-	0x02, 0xcf, 0xf9, 0x8d, 0xb4, 0x01, 0xc9, 0x53, 0x56, 0x80, 0x6c, 0x2d, 0x02, 0xe2, 0xfc, 0x46,
-	0x9a, 0x7c, 0x06, 0x1b, 0x29, 0x15, 0x88, 0x70, 0x63, 0x01, 0x84, 0x86, 0x71, 0xb2, 0x7b, 0xc0,
-	0x43, 0xdb, 0x3d, 0xdc, 0x5c, 0x64, 0x0f, 0xce, 0x6f, 0xa4, 0xc9, 0x97, 0xd0, 0x76, 0x94, 0x67,
-	0x61, 0xc8, 0x02, 0x30, 0xad, 0xd2, 0xd3, 0x02, 0x15, 0x4c, 0x88, 0x40, 0xff, 0x59, 0x04, 0xa8,
-	0xf4, 0xb4, 0xc7, 0xc2, 0xa6, 0xc9, 0x95, 0x81, 0xd9, 0x5e, 0xe4, 0x58, 0xce, 0x6f, 0xa4, 0xc9,
-	0xbb, 0xb0, 0xa9, 0xb8, 0xd6, 0x33, 0x6e, 0xcb, 0x93, 0xf9, 0xff, 0x45, 0xae, 0x6d, 0x5f, 0x2a,
-	0xc7, 0x8c, 0xdc, 0x02, 0x28, 0x3a, 0xae, 0x60, 0xfe, 0x0e, 0x5a, 0x78, 0x4e, 0x33, 0x66, 0xfd,
-	0x1f, 0xd6, 0xc0, 0x2b, 0x29, 0xb2, 0x42, 0xd9, 0x1e, 0x52, 0xf6, 0x2d, 0x80, 0x34, 0x93, 0x2c,
-	0x8f, 0x10, 0xde, 0x92, 0xb6, 0xe7, 0x34, 0x63, 0x46, 0x6e, 0x43, 0xbb, 0x58, 0x4e, 0x68, 0xcc,
-	0x1d, 0x73, 0xb7, 0x9c, 0xee, 0x21, 0x8d, 0xb9, 0x29, 0xd2, 0xef, 0x73, 0x9a, 0x68, 0xa1, 0x2f,
-	0x90, 0xbe, 0xeb, 0x41, 0x29, 0x1b, 0xaa, 0xca, 0x13, 0xa1, 0xc3, 0x34, 0x13, 0x11, 0xbf, 0x86,
-	0x4e, 0xeb, 0x19, 0xf4, 0x47, 0x06, 0x9c, 0x3c, 0x01, 0xfb, 0xaa, 0x5d, 0xac, 0xe5, 0x33, 0x3e,
-	0x20, 0xbc, 0x0d, 0xe6, 0xc3, 0xc6, 0x19, 0xcd, 0x84, 0xe9, 0x65, 0xb6, 0x2f, 0x17, 0xe2, 0xdc,
-	0x93, 0x6d, 0xce, 0x3f, 0xd9, 0xfe, 0x4f, 0x75, 0x68, 0x55, 0x08, 0xa4, 0xd2, 0xf6, 0x6a, 0x73,
-	0x6d, 0x6f, 0x07, 0x1a, 0x31, 0xd7, 0xa7, 0xb2, 0xb8, 0x0f, 0x27, 0x99, 0xd1, 0x4a, 0x67, 0x34,
-	0x51, 0x34, 0x32, 0x93, 0x96, 0xb9, 0x2f, 0x7b, 0x1d, 0x9b, 0x15, 0xed, 0x98, 0xbd, 0x5d, 0x34,
-	0xf5, 0x2b, 0x8a, 0xe6, 0xff, 0xe0, 0xb9, 0xd1, 0x4e, 0x30, 0xbc, 0x98, 0x7a, 0xd0, 0xb4, 0x8a,
-	0x31, 0x33, 0xb9, 0xb4, 0x2f, 0xda, 0x12, 0xf0, 0x35, 0xe4, 0x12, 0xdf, 0x7e, 0xd9, 0x63, 0x32,
-	0x3e, 0xcd, 0x13, 0xc6, 0xcb, 0x80, 0xcb, 0xef, 0xa0, 0x9d, 0x22, 0x84, 0x0b, 0x2a, 0x00, 0xcc,
-	0x28, 0x76, 0x7d, 0x3d, 0x74, 0xca, 0xb9, 0x0b, 0x55, 0xa1, 0x47, 0x6f, 0x71, 0x7a, 0xec, 0xff,
-	0xb2, 0x0a, 0xed, 0x6a, 0xa3, 0x30, 0x78, 0x94, 0xb1, 0x8c, 0x2b, 0x5b, 0x36, 0xad, 0xc3, 0x5b,
-	0x57, 0x77, 0x97, 0x91, 0x35, 0x72, 0x8d, 0xa5, 0xf0, 0xf9, 0xcb, 0xe2, 0xf2, 0x61, 0x23, 0xa2,
-	0x59, 0x26, 0x78, 0xe6, 0xaa, 0xaa, 0x10, 0xc9, 0x1d, 0xd8, 0xd2, 0x19, 0x8d, 0x9e, 0x98, 0xa6,
-	0x96, 0xe4, 0xf1, 0x84, 0x67, 0x6e, 0x4c, 0xeb, 0x14, 0xea, 0x87, 0xa8, 0x25, 0x8f, 0x81, 0x70,
-	0xa5, 0x45, 0x8c, 0xfd, 0xa4, 0x9c, 0x40, 0xd7, 0x17, 0x38, 0xf4, 0xcd, 0xd2, 0xbf, 0x9c, 0x4f,
-	0x1f, 0xc0, 0x16, 0x8d, 0x74, 0x4e, 0x67, 0x97, 0x88, 0x8d, 0x05, 0x10, 0x3b, 0xd6, 0xb9, 0x80,
-	0xeb, 0xff, 0x5c, 0x83, 0x0d, 0x97, 0x19, 0xb2, 0x0d, 0xeb, 0x33, 0x91, 0xf0, 0xbb, 0xee, 0xf9,
-	0x59, 0xa1, 0xd0, 0x1e, 0xba, 0xfc, 0x58, 0x81, 0x10, 0xa8, 0x47, 0x86, 0xe1, 0x6c, 0x6e, 0xf0,
-	0xdb, 0x58, 0x62, 0xe6, 0x5d, 0x3a, 0xac, 0x40, 0xf6, 0xa1, 0x95, 0x4a, 0x65, 0x98, 0x28, 0x92,
-	0xcc, 0x92, 0x9e, 0x17, 0x80, 0x55, 0x1d, 0x4b, 0x86, 0xe4, 0x81, 0xe3, 0x8e, 0x3b, 0x89, 0xc9,
-	0xb4, 0x15, 0x4d, 0x10, 0x64, 0x59, 0xcb, 0x29, 0xf8, 0x6d, 0x82, 0xa4, 0xa7, 0x32, 0xe1, 0x8e,
-	0x4d, 0xac, 0xd0, 0x7f, 0x51, 0x87, 0x8d, 0x7b, 0x96, 0xe2, 0xdf, 0x9a, 0xc2, 0x77, 0xa1, 0x69,
-	0xff, 0xc1, 0x71, 0x84, 0x5e, 0x0f, 0x36, 0x50, 0x1e, 0xcf, 0x0f, 0xe8, 0x6b, 0x7f, 0x33, 0xa0,
-	0xd7, 0xdf, 0x1e, 0xd0, 0x33, 0x4e, 0x95, 0x4c, 0xdc, 0x71, 0x9c, 0x44, 0x7a, 0xd0, 0x62, 0x86,
-	0x35, 0x44, 0x8a, 0xff, 0x1a, 0xda, 0xe3, 0x54, 0x55, 0x06, 0x95, 0x9f, 0x09, 0xc6, 0x93, 0xc8,
-	0x1c, 0x6b, 0xcd, 0xa0, 0x16, 0x72, 0x85, 0xff, 0x9a, 0x73, 0xfc, 0xd7, 0x05, 0xc8, 0xb8, 0x92,
-	0xb3, 0x1c, 0x41, 0x3d, 0x9b, 0xc0, 0x4b, 0x8d, 0xc9, 0x30, 0x4a, 0x67, 0x9c, 0x85, 0x93, 0x0b,
-	0x9c, 0x0f, 0x0b, 0x83, 0x33, 0xce, 0x8e, 0x2e, 0xfe, 0x34, 0x1b, 0xb5, 0x96, 0x31, 0x1b, 0xb5,
-	0xff, 0xdd, 0x6c, 0x74, 0xbf, 0xb2, 0x55, 0xaa, 0x71, 0x88, 0xfb, 0xa7, 0x28, 0xe5, 0x81, 0x46,
-	0x9a, 0x4c, 0xa0, 0xe1, 0xa8, 0xaa, 0xb3, 0x74, 0xaa, 0x72, 0xc8, 0x47, 0xa3, 0xe7, 0xaf, 0xbb,
-	0xb5, 0x17, 0xaf, 0xbb, 0xb5, 0x57, 0xaf, 0xbb, 0xb5, 0x67, 0x6f, 0xba, 0x2b, 0x2f, 0xde, 0x74,
-	0x57, 0x7e, 0x7d, 0xd3, 0x5d, 0xf9, 0xae, 0x0a, 0x35, 0xff, 0x73, 0xc7, 0x79, 0xf1, 0x83, 0x07,
-	0xe2, 0x4d, 0x1a, 0x78, 0xa0, 0x8f, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff, 0xba, 0x3d, 0x9b, 0x69,
-	0x15, 0x11, 0x00, 0x00,
+func (m *Product) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Product) GetSku() string {
+	if m != nil {
+		return m.Sku
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Product) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AutoCompleteWindow != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.AutoCompleteWindow))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.AutoCompleteAfterDelivery {
-		i--
-		if m.AutoCompleteAfterDelivery {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.StablecoinDenom) > 0 {
-		i -= len(m.StablecoinDenom)
-		copy(dAtA[i:], m.StablecoinDenom)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.StablecoinDenom)))
-		i--
-		dAtA[i] = 0x3a
+func (m *Product) GetVariants() []ProductVariant {
+	if m != nil {
+		return m.Variants
 	}
-	if m.DefaultFeeRateBps != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultFeeRateBps))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *Product) GetStock() uint64 {
+	if m != nil {
+		return m.Stock
 	}
-	{
-		size := m.MaxOrderAmount.Size()
-		i -= size
-		if _, err := m.MaxOrderAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Product) GetReserved() uint64 {
+	if m != nil {
+		return m.Reserved
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MinOrderAmount.Size()
-		i -= size
-		if _, err := m.MinOrderAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Product) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.DisputeWindow != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.DisputeWindow))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *Product) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.DefaultEscrowExpiration != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultEscrowExpiration))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *Product) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.DefaultOrderExpiration != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultOrderExpiration))
-		i--
-		dAtA[i] = 0x8
+	return time.Time{}
+}
+
+func (m *Product) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Product) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProductVariant is a variant of a product, such as a size or color. A zero
+// price means the product's price.
+type ProductVariant struct {
+	Id       string                                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"price"`
+	Stock    uint64                                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reserved uint64                                  `protobuf:"varint,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DisputeId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+func (m *ProductVariant) Reset()         { *m = ProductVariant{} }
+func (m *ProductVariant) String() string { return proto.CompactTextString(m) }
+func (*ProductVariant) ProtoMessage()    {}
+func (*ProductVariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{8}
+}
+func (m *ProductVariant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProductVariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProductVariant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SettlementId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+}
+func (m *ProductVariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductVariant.Merge(m, src)
+}
+func (m *ProductVariant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProductVariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductVariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductVariant proto.InternalMessageInfo
+
+func (m *ProductVariant) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
-	if err3 != nil {
-		return 0, err3
+	return ""
+}
+
+func (m *ProductVariant) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i -= n3
-	i = encodeVarintOrders(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletedAt):])
-	if err4 != nil {
-		return 0, err4
+	return ""
+}
+
+func (m *ProductVariant) GetStock() uint64 {
+	if m != nil {
+		return m.Stock
 	}
-	i -= n4
-	i = encodeVarintOrders(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeliveredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeliveredAt):])
-	if err5 != nil {
-		return 0, err5
+	return 0
+}
+
+func (m *ProductVariant) GetReserved() uint64 {
+	if m != nil {
+		return m.Reserved
 	}
-	i -= n5
-	i = encodeVarintOrders(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ShippedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ShippedAt):])
-	if err6 != nil {
-		return 0, err6
+	return 0
+}
+
+// Promotion is a merchant discount that customers apply to an order with its
+// coupon code.
+type Promotion struct {
+	Merchant string `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// discount_type is "percentage" or "fixed"
+	DiscountType  string                                  `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	PercentageBps uint32                                  `protobuf:"varint,4,opt,name=percentage_bps,json=percentageBps,proto3" json:"percentage_bps,omitempty"`
+	FixedAmount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=fixed_amount,json=fixedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fixed_amount"`
+	// min_subtotal is the order subtotal required to apply the promotion
+	MinSubtotal github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=min_subtotal,json=minSubtotal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_subtotal"`
+	// max_redemptions and max_redemptions_per_customer are unlimited when zero
+	MaxRedemptions            uint64 `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerCustomer uint64 `protobuf:"varint,8,opt,name=max_redemptions_per_customer,json=maxRedemptionsPerCustomer,proto3" json:"max_redemptions_per_customer,omitempty"`
+	// skus limits the discount to these items; empty means every item
+	Skus     []string  `protobuf:"bytes,9,rep,name=skus,proto3" json:"skus,omitempty"`
+	StartsAt time.Time `protobuf:"bytes,10,opt,name=starts_at,json=startsAt,proto3,stdtime" json:"starts_at"`
+	// ends_at is open-ended when zero
+	EndsAt      time.Time `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at"`
+	Redemptions uint64    `protobuf:"varint,12,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	Active      bool      `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt   time.Time `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *Promotion) Reset()         { *m = Promotion{} }
+func (m *Promotion) String() string { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()    {}
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{9}
+}
+func (m *Promotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Promotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Promotion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n6
-	i = encodeVarintOrders(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PaidAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt):])
-	if err7 != nil {
-		return 0, err7
+}
+func (m *Promotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promotion.Merge(m, src)
+}
+func (m *Promotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *Promotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Promotion proto.InternalMessageInfo
+
+func (m *Promotion) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	i -= n7
-	i = encodeVarintOrders(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
-	if err8 != nil {
-		return 0, err8
+	return ""
+}
+
+func (m *Promotion) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	i -= n8
-	i = encodeVarintOrders(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x7a
-	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err9 != nil {
-		return 0, err9
+	return ""
+}
+
+func (m *Promotion) GetDiscountType() string {
+	if m != nil {
+		return m.DiscountType
 	}
-	i -= n9
-	i = encodeVarintOrders(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x72
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *Promotion) GetPercentageBps() uint32 {
+	if m != nil {
+		return m.PercentageBps
 	}
-	{
-		size, err := m.ShippingInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Promotion) GetMaxRedemptions() uint64 {
+	if m != nil {
+		return m.MaxRedemptions
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.PaymentInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Promotion) GetMaxRedemptionsPerCustomer() uint64 {
+	if m != nil {
+		return m.MaxRedemptionsPerCustomer
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Promotion) GetSkus() []string {
+	if m != nil {
+		return m.Skus
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.DiscountAmount.Size()
-		i -= size
-		if _, err := m.DiscountAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Promotion) GetStartsAt() time.Time {
+	if m != nil {
+		return m.StartsAt
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.TaxAmount.Size()
-		i -= size
-		if _, err := m.TaxAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Promotion) GetEndsAt() time.Time {
+	if m != nil {
+		return m.EndsAt
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ShippingCost.Size()
-		i -= size
-		if _, err := m.ShippingCost.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Promotion) GetRedemptions() uint64 {
+	if m != nil {
+		return m.Redemptions
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Subtotal.Size()
-		i -= size
-		if _, err := m.Subtotal.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Promotion) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOrders(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return false
+}
+
+func (m *Promotion) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x22
+	return time.Time{}
+}
+
+// PromotionRedemption counts a customer's redemptions of a promotion.
+type PromotionRedemption struct {
+	Merchant string `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Customer string `protobuf:"bytes,3,opt,name=customer,proto3" json:"customer,omitempty"`
+	Count    uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *PromotionRedemption) Reset()         { *m = PromotionRedemption{} }
+func (m *PromotionRedemption) String() string { return proto.CompactTextString(m) }
+func (*PromotionRedemption) ProtoMessage()    {}
+func (*PromotionRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{10}
+}
+func (m *PromotionRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromotionRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PromotionRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionRedemption.Merge(m, src)
+}
+func (m *PromotionRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionRedemption proto.InternalMessageInfo
+
+func (m *PromotionRedemption) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *PromotionRedemption) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	if m.Id != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *PromotionRedemption) GetCustomer() string {
+	if m != nil {
+		return m.Customer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OrderItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PromotionRedemption) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OrderItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TaxRate is a sales tax rate for a jurisdiction and product tax category.
+// Empty state, postal_code or category match any value; the most specific
+// rate for an order item applies.
+type TaxRate struct {
+	Country    string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	State      string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode string `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Category   string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	RateBps    uint32 `protobuf:"varint,5,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
 }
 
-func (m *OrderItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Variant) > 0 {
-		i -= len(m.Variant)
-		copy(dAtA[i:], m.Variant)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Variant)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.TotalPrice.Size()
-		i -= size
-		if _, err := m.TotalPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.UnitPrice.Size()
-		i -= size
-		if _, err := m.UnitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TaxRate) Reset()         { *m = TaxRate{} }
+func (m *TaxRate) String() string { return proto.CompactTextString(m) }
+func (*TaxRate) ProtoMessage()    {}
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{11}
+}
+func (m *TaxRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Quantity != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProductName) > 0 {
-		i -= len(m.ProductName)
-		copy(dAtA[i:], m.ProductName)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.ProductName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProductId) > 0 {
-		i -= len(m.ProductId)
-		copy(dAtA[i:], m.ProductId)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.ProductId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TaxRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxRate.Merge(m, src)
+}
+func (m *TaxRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxRate proto.InternalMessageInfo
+
+func (m *TaxRate) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PaymentInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaxRate) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PaymentInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaxRate) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
 }
 
-func (m *PaymentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PaidAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt):])
-	if err19 != nil {
-		return 0, err19
+func (m *TaxRate) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	i -= n19
-	i = encodeVarintOrders(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.FeeAmount.Size()
-		i -= size
-		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *TaxRate) GetRateBps() uint32 {
+	if m != nil {
+		return m.RateBps
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.RefundedAmount.Size()
-		i -= size
-		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// TaxRecord is the tax collected on a paid order, kept for liability reports.
+type TaxRecord struct {
+	Merchant      string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	OrderId       uint64                                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PaidAt        time.Time                               `protobuf:"bytes,3,opt,name=paid_at,json=paidAt,proto3,stdtime" json:"paid_at"`
+	Country       string                                  `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                                  `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                                  `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	TaxableAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=taxable_amount,json=taxableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taxable_amount"`
+	TaxAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=tax_amount,json=taxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_amount"`
+	// remitted is set once the tax less refunds was paid to the tax collector
+	Remitted bool `protobuf:"varint,9,opt,name=remitted,proto3" json:"remitted,omitempty"`
+	// tax_refunded is the part of tax_amount returned to the customer
+	TaxRefunded github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,10,opt,name=tax_refunded,json=taxRefunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_refunded"`
+}
+
+func (m *TaxRecord) Reset()         { *m = TaxRecord{} }
+func (m *TaxRecord) String() string { return proto.CompactTextString(m) }
+func (*TaxRecord) ProtoMessage()    {}
+func (*TaxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{12}
+}
+func (m *TaxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.PaidAmount.Size()
-		i -= size
-		if _, err := m.PaidAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+}
+func (m *TaxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxRecord.Merge(m, src)
+}
+func (m *TaxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxRecord proto.InternalMessageInfo
+
+func (m *TaxRecord) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.EscrowId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.EscrowId))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *TaxRecord) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	if m.SettlementId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *TaxRecord) GetPaidAt() time.Time {
+	if m != nil {
+		return m.PaidAt
 	}
-	if len(m.TransactionId) > 0 {
-		i -= len(m.TransactionId)
-		copy(dAtA[i:], m.TransactionId)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.TransactionId)))
-		i--
-		dAtA[i] = 0x1a
+	return time.Time{}
+}
+
+func (m *TaxRecord) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Method)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *TaxRecord) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TaxRecord) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ShippingInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaxRecord) GetRemitted() bool {
+	if m != nil {
+		return m.Remitted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ShippingInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// JurisdictionTax totals the tax a merchant owes to one jurisdiction.
+type JurisdictionTax struct {
+	Country       string                                  `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                                  `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                                  `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	OrderCount    uint64                                  `protobuf:"varint,4,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	TaxableAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=taxable_amount,json=taxableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taxable_amount"`
+	TaxAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=tax_amount,json=taxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_amount"`
 }
 
-func (m *ShippingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActualDelivery, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActualDelivery):])
-	if err23 != nil {
-		return 0, err23
-	}
-	i -= n23
-	i = encodeVarintOrders(dAtA, i, uint64(n23))
-	i--
-	dAtA[i] = 0x32
-	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EstimatedDelivery, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedDelivery):])
-	if err24 != nil {
-		return 0, err24
+func (m *JurisdictionTax) Reset()         { *m = JurisdictionTax{} }
+func (m *JurisdictionTax) String() string { return proto.CompactTextString(m) }
+func (*JurisdictionTax) ProtoMessage()    {}
+func (*JurisdictionTax) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{13}
+}
+func (m *JurisdictionTax) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JurisdictionTax) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JurisdictionTax.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n24
-	i = encodeVarintOrders(dAtA, i, uint64(n24))
-	i--
-	dAtA[i] = 0x2a
-	if len(m.TrackingNumber) > 0 {
-		i -= len(m.TrackingNumber)
-		copy(dAtA[i:], m.TrackingNumber)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.TrackingNumber)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *JurisdictionTax) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JurisdictionTax.Merge(m, src)
+}
+func (m *JurisdictionTax) XXX_Size() int {
+	return m.Size()
+}
+func (m *JurisdictionTax) XXX_DiscardUnknown() {
+	xxx_messageInfo_JurisdictionTax.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JurisdictionTax proto.InternalMessageInfo
+
+func (m *JurisdictionTax) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	if len(m.Carrier) > 0 {
-		i -= len(m.Carrier)
-		copy(dAtA[i:], m.Carrier)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Carrier)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *JurisdictionTax) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Method)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *JurisdictionTax) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
 	}
-	{
-		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *JurisdictionTax) GetOrderCount() uint64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+// TaxLiabilityReport totals the tax on a merchant's orders paid in a period.
+type TaxLiabilityReport struct {
+	Merchant     string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	StartTime    time.Time                               `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime      time.Time                               `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	OrderCount   uint64                                  `protobuf:"varint,4,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	TaxCollected github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=tax_collected,json=taxCollected,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_collected"`
+	// tax_refunded is the tax returned to customers on refunds
+	TaxRefunded github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=tax_refunded,json=taxRefunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_refunded"`
+	// tax_remitted is the tax paid to the tax collector, net of refunds
+	TaxRemitted github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=tax_remitted,json=taxRemitted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_remitted"`
+	// tax_due is tax_collected less tax_refunded
+	TaxDue github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=tax_due,json=taxDue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"tax_due"`
+	// jurisdictions breaks tax_due down by shipping jurisdiction
+	Jurisdictions []JurisdictionTax `protobuf:"bytes,9,rep,name=jurisdictions,proto3" json:"jurisdictions"`
+}
+
+func (m *TaxLiabilityReport) Reset()         { *m = TaxLiabilityReport{} }
+func (m *TaxLiabilityReport) String() string { return proto.CompactTextString(m) }
+func (*TaxLiabilityReport) ProtoMessage()    {}
+func (*TaxLiabilityReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b255b0366c5e824, []int{14}
+}
+func (m *TaxLiabilityReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxLiabilityReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxLiabilityReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *TaxLiabilityReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxLiabilityReport.Merge(m, src)
+}
+func (m *TaxLiabilityReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxLiabilityReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxLiabilityReport.DiscardUnknown(m)
 }
 
-func (m *Address) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_TaxLiabilityReport proto.InternalMessageInfo
+
+func (m *TaxLiabilityReport) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
+	}
+	return ""
+}
+
+func (m *TaxLiabilityReport) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *TaxLiabilityReport) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *TaxLiabilityReport) GetOrderCount() uint64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+func (m *TaxLiabilityReport) GetJurisdictions() []JurisdictionTax {
+	if m != nil {
+		return m.Jurisdictions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "stateset.core.orders.Params")
+	proto.RegisterType((*Order)(nil), "stateset.core.orders.Order")
+	proto.RegisterType((*OrderItem)(nil), "stateset.core.orders.OrderItem")
+	proto.RegisterType((*PaymentInfo)(nil), "stateset.core.orders.PaymentInfo")
+	proto.RegisterType((*ShippingInfo)(nil), "stateset.core.orders.ShippingInfo")
+	proto.RegisterType((*Address)(nil), "stateset.core.orders.Address")
+	proto.RegisterType((*Dispute)(nil), "stateset.core.orders.Dispute")
+	proto.RegisterType((*Product)(nil), "stateset.core.orders.Product")
+	proto.RegisterType((*ProductVariant)(nil), "stateset.core.orders.ProductVariant")
+	proto.RegisterType((*Promotion)(nil), "stateset.core.orders.Promotion")
+	proto.RegisterType((*PromotionRedemption)(nil), "stateset.core.orders.PromotionRedemption")
+	proto.RegisterType((*TaxRate)(nil), "stateset.core.orders.TaxRate")
+	proto.RegisterType((*TaxRecord)(nil), "stateset.core.orders.TaxRecord")
+	proto.RegisterType((*JurisdictionTax)(nil), "stateset.core.orders.JurisdictionTax")
+	proto.RegisterType((*TaxLiabilityReport)(nil), "stateset.core.orders.TaxLiabilityReport")
+}
+
+func init() { proto.RegisterFile("stateset/core/orders/orders.proto", fileDescriptor_0b255b0366c5e824) }
+
+var fileDescriptor_0b255b0366c5e824 = []byte{
+	// 2174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcf, 0x72, 0x1b, 0xb9,
+	0xd1, 0x37, 0xc5, 0xbf, 0xd3, 0xa4, 0x28, 0x1b, 0xab, 0xcf, 0x3b, 0xf2, 0xb7, 0x96, 0x64, 0x26,
+	0x5b, 0x56, 0xaa, 0xb2, 0x64, 0xac, 0x5c, 0x52, 0x49, 0xa5, 0x5c, 0x94, 0xec, 0x4d, 0xb4, 0x95,
+	0xdd, 0x28, 0x63, 0x57, 0x52, 0x95, 0xcb, 0x04, 0x9c, 0x81, 0x24, 0x44, 0x9c, 0xc1, 0xec, 0x00,
+	0x23, 0x53, 0x6f, 0x90, 0xe3, 0xe6, 0x35, 0x72, 0x4c, 0x8e, 0xb9, 0xee, 0xc1, 0x87, 0x1c, 0x7c,
+	0x4c, 0xe5, 0xe0, 0x24, 0x76, 0x1e, 0x24, 0x85, 0x06, 0x66, 0x38, 0x14, 0x65, 0x65, 0xe9, 0x22,
+	0xf7, 0xc4, 0xe9, 0x06, 0xba, 0x1b, 0xe8, 0x6e, 0xfc, 0xba, 0x01, 0xc2, 0x03, 0xa9, 0xa8, 0x62,
+	0x92, 0xa9, 0x41, 0x20, 0x52, 0x36, 0x10, 0x69, 0xc8, 0x52, 0x69, 0x7f, 0xfa, 0x49, 0x2a, 0x94,
+	0x20, 0x9b, 0xf9, 0x94, 0xbe, 0x9e, 0xd2, 0x37, 0x63, 0xf7, 0x36, 0x4f, 0xc5, 0xa9, 0xc0, 0x09,
+	0x03, 0xfd, 0x65, 0xe6, 0xde, 0xdb, 0x0e, 0x84, 0x8c, 0x84, 0x1c, 0x8c, 0xa8, 0x64, 0x83, 0x8b,
+	0x47, 0x23, 0xa6, 0xe8, 0xa3, 0x41, 0x20, 0x78, 0x6c, 0xc7, 0x77, 0x4e, 0x85, 0x38, 0x1d, 0xb3,
+	0x01, 0x52, 0xa3, 0xec, 0x64, 0xa0, 0x78, 0xc4, 0xa4, 0xa2, 0x51, 0x62, 0x26, 0xf4, 0xfe, 0x52,
+	0x87, 0xc6, 0x31, 0x4d, 0x69, 0x24, 0xc9, 0x8f, 0xc0, 0x0d, 0xd9, 0x09, 0xcd, 0xc6, 0xca, 0x47,
+	0x9b, 0x3e, 0x9b, 0x24, 0x3c, 0xa5, 0x8a, 0x8b, 0xd8, 0xad, 0xec, 0x56, 0xf6, 0xaa, 0xde, 0x5d,
+	0x3b, 0xfe, 0x4b, 0x3d, 0xfc, 0xb4, 0x18, 0x25, 0x3f, 0x86, 0xad, 0x5c, 0x92, 0xc9, 0x20, 0x15,
+	0x2f, 0xca, 0xa2, 0x6b, 0x28, 0xfa, 0xa1, 0x9d, 0xf0, 0x14, 0xc7, 0x4b, 0xb2, 0x1f, 0x43, 0x37,
+	0xe4, 0x32, 0xc9, 0x14, 0xf3, 0x5f, 0xf0, 0x38, 0x14, 0x2f, 0xdc, 0x2a, 0x0a, 0xac, 0x5b, 0xee,
+	0x6f, 0x90, 0x49, 0x14, 0xdc, 0x8e, 0x78, 0x6c, 0x17, 0x46, 0x23, 0x91, 0xc5, 0xca, 0xad, 0xed,
+	0x56, 0xf6, 0xda, 0xfb, 0x5b, 0x7d, 0xe3, 0x83, 0xbe, 0xf6, 0x41, 0xdf, 0xfa, 0xa0, 0x7f, 0x28,
+	0x78, 0x7c, 0x30, 0x78, 0xf9, 0x7a, 0xe7, 0xd6, 0x3f, 0x5e, 0xef, 0x3c, 0x3c, 0xe5, 0xea, 0x2c,
+	0x1b, 0xf5, 0x03, 0x11, 0x0d, 0xac, 0xc3, 0xcc, 0xcf, 0x27, 0x32, 0x3c, 0x1f, 0xa8, 0xcb, 0x84,
+	0x49, 0x14, 0xf0, 0xba, 0x11, 0x8f, 0x71, 0x73, 0x43, 0xb4, 0x80, 0x56, 0xe9, 0x64, 0xd6, 0x6a,
+	0x7d, 0x05, 0x56, 0xe9, 0xa4, 0x6c, 0x75, 0x00, 0x9b, 0xb9, 0x3b, 0x4f, 0x18, 0xf3, 0x53, 0xaa,
+	0x98, 0x3f, 0x4a, 0xa4, 0xdb, 0xd8, 0xad, 0xec, 0xad, 0x7b, 0x77, 0xec, 0xd8, 0xa7, 0x8c, 0x79,
+	0x54, 0xb1, 0x83, 0x44, 0x92, 0xef, 0xc1, 0x6d, 0xa9, 0xe8, 0x68, 0xcc, 0x74, 0xe4, 0xfd, 0x90,
+	0xc5, 0x22, 0x72, 0x9b, 0xbb, 0x95, 0x3d, 0xc7, 0xdb, 0x98, 0xf2, 0x9f, 0x68, 0x36, 0x79, 0x0c,
+	0x1f, 0xd1, 0x4c, 0x09, 0x3f, 0x10, 0x51, 0x32, 0x66, 0x8a, 0xf9, 0xf4, 0x44, 0xb1, 0xd4, 0x0f,
+	0xd9, 0x98, 0x5f, 0xb0, 0xf4, 0xd2, 0x6d, 0xed, 0x56, 0xf6, 0x5a, 0xde, 0x96, 0x9e, 0x73, 0x68,
+	0xa7, 0x0c, 0xf5, 0x8c, 0x27, 0x76, 0x02, 0xf9, 0x01, 0x6c, 0xce, 0x2a, 0xb0, 0x51, 0x73, 0x30,
+	0x6a, 0xa4, 0x2c, 0x68, 0x43, 0xf7, 0x1d, 0x58, 0x57, 0x74, 0xe2, 0x07, 0x62, 0x3c, 0x66, 0x81,
+	0x12, 0xa9, 0x0b, 0xb8, 0xb4, 0x8e, 0xa2, 0x93, 0xc3, 0x9c, 0x47, 0xbe, 0x0f, 0x44, 0x7b, 0x9a,
+	0xc7, 0x17, 0x2c, 0x56, 0x22, 0xbd, 0xf4, 0xcf, 0xc4, 0x38, 0x74, 0xdb, 0xa8, 0x54, 0xc7, 0xe0,
+	0x28, 0x1f, 0xf8, 0xb9, 0x18, 0x87, 0xbd, 0xaf, 0x3b, 0x50, 0x47, 0x8f, 0x91, 0x2e, 0xac, 0xf1,
+	0x10, 0xd3, 0xb3, 0xe6, 0xad, 0xf1, 0x90, 0xdc, 0x83, 0x56, 0x90, 0x49, 0x25, 0x22, 0x96, 0x62,
+	0xe6, 0x39, 0x5e, 0x41, 0xeb, 0xb1, 0x88, 0xa5, 0xc1, 0x19, 0x8d, 0x15, 0x26, 0x99, 0xe3, 0x15,
+	0x34, 0xb9, 0x0b, 0x0d, 0x7d, 0xec, 0x32, 0x89, 0x59, 0xe5, 0x78, 0x96, 0x22, 0x3f, 0x81, 0x3a,
+	0x57, 0x2c, 0x92, 0x6e, 0x7d, 0xb7, 0xba, 0xd7, 0xde, 0xdf, 0xe9, 0x5f, 0x77, 0x38, 0xfb, 0xb8,
+	0x96, 0x23, 0xc5, 0xa2, 0x83, 0x9a, 0x0e, 0xbe, 0x67, 0x64, 0xc8, 0x09, 0xb4, 0x64, 0x36, 0x52,
+	0x42, 0xd1, 0x31, 0x06, 0x6f, 0xb9, 0x69, 0x53, 0xe8, 0x26, 0x02, 0xd6, 0xe5, 0x19, 0x4f, 0x12,
+	0x1e, 0x9f, 0xfa, 0x81, 0x90, 0x0a, 0x83, 0xbf, 0x5c, 0x63, 0x9d, 0xdc, 0xc0, 0xa1, 0x90, 0x8a,
+	0x70, 0x00, 0x1d, 0x52, 0x7b, 0x22, 0x5a, 0x4b, 0xb7, 0xe6, 0x28, 0x3a, 0xb1, 0x87, 0x41, 0xc2,
+	0x46, 0xc8, 0x65, 0xa0, 0xbf, 0x73, 0x7b, 0xce, 0xf2, 0x4f, 0x60, 0x6e, 0xc2, 0x1a, 0x8d, 0xa0,
+	0x83, 0x9e, 0xcd, 0x2d, 0xc2, 0xd2, 0x2d, 0xb6, 0x51, 0xbf, 0x35, 0xf7, 0x19, 0x74, 0x12, 0x7a,
+	0x19, 0xb1, 0x58, 0xf9, 0x3c, 0x3e, 0x11, 0x98, 0xf6, 0xed, 0xfd, 0x07, 0xd7, 0xe7, 0xda, 0xb1,
+	0x99, 0x79, 0x14, 0x9f, 0x08, 0x9b, 0x6d, 0xed, 0x64, 0xca, 0x22, 0x9f, 0x97, 0x72, 0x01, 0x95,
+	0x75, 0x50, 0x59, 0xef, 0x7a, 0x65, 0xcf, 0xec, 0xd4, 0x92, 0xb6, 0x22, 0xd2, 0xa8, 0x0e, 0xcf,
+	0x8c, 0xa2, 0x21, 0x55, 0xd4, 0x5d, 0xcf, 0xcf, 0x8c, 0xa1, 0xc9, 0x21, 0x40, 0x90, 0x32, 0xaa,
+	0x58, 0xe8, 0x53, 0xe5, 0x76, 0xd1, 0xce, 0xbd, 0xbe, 0xa9, 0x38, 0xfd, 0xbc, 0xe2, 0xf4, 0x9f,
+	0xe7, 0x15, 0xe7, 0xa0, 0xa5, 0xf5, 0x7f, 0xf5, 0xcf, 0x9d, 0x8a, 0xe7, 0x58, 0xb9, 0xa1, 0xd2,
+	0x4a, 0xb2, 0x24, 0xcc, 0x95, 0x6c, 0x2c, 0xa2, 0xc4, 0xca, 0x0d, 0x15, 0xf9, 0x29, 0x34, 0x13,
+	0xca, 0x51, 0xc3, 0xed, 0x05, 0x34, 0x34, 0xb4, 0x90, 0x59, 0x03, 0x6e, 0xda, 0xac, 0xe1, 0xce,
+	0x22, 0x6b, 0xb0, 0x72, 0x43, 0x45, 0x7e, 0x06, 0x1d, 0x8b, 0xa2, 0x46, 0x0d, 0x59, 0x40, 0x4d,
+	0xbb, 0x90, 0x34, 0x8a, 0x72, 0x70, 0x45, 0x45, 0x1f, 0x2c, 0xa2, 0xa8, 0x90, 0x34, 0xdb, 0xc2,
+	0x3a, 0xcc, 0xa4, 0x56, 0xb3, 0xb9, 0xc8, 0xb6, 0xac, 0xdc, 0x50, 0x69, 0xf4, 0x96, 0x4c, 0xa9,
+	0x31, 0x33, 0xe9, 0x19, 0xba, 0xff, 0x87, 0x58, 0xdb, 0x99, 0x32, 0x8f, 0x42, 0x72, 0x1f, 0x20,
+	0x2f, 0xe2, 0x3c, 0x74, 0xef, 0xe2, 0x0c, 0xc7, 0x72, 0x8e, 0x42, 0xf2, 0x09, 0x90, 0x29, 0xb0,
+	0xa7, 0x4c, 0xb2, 0xf4, 0x82, 0x85, 0xee, 0x87, 0x58, 0x6a, 0xee, 0x14, 0x23, 0x9e, 0x1d, 0x20,
+	0x3b, 0xd0, 0x0e, 0x44, 0x96, 0x88, 0xd8, 0x0f, 0x44, 0xc8, 0x5c, 0x17, 0xd3, 0x0e, 0x0c, 0xeb,
+	0x50, 0x84, 0x8c, 0x3c, 0x00, 0x5d, 0x3c, 0xfc, 0x94, 0x45, 0x5c, 0x29, 0x16, 0xba, 0x5b, 0xa8,
+	0xa9, 0xad, 0xe8, 0xc4, 0xb3, 0xac, 0xf9, 0xa2, 0x73, 0x6f, 0xbe, 0xe8, 0xf4, 0xfe, 0x54, 0x05,
+	0xa7, 0x80, 0xee, 0x52, 0x29, 0x71, 0xb0, 0x94, 0xdc, 0x07, 0x48, 0x52, 0x11, 0x66, 0x01, 0x6e,
+	0xdb, 0x14, 0x13, 0xc7, 0x72, 0x8e, 0x42, 0xbd, 0x88, 0x7c, 0x38, 0xa6, 0x11, 0xb3, 0x15, 0xa5,
+	0x6d, 0x79, 0x5f, 0xd0, 0x88, 0xe9, 0xc3, 0xf3, 0x65, 0x46, 0x63, 0xc5, 0xd5, 0x25, 0x96, 0x95,
+	0x9a, 0x57, 0xd0, 0x1a, 0x42, 0xb3, 0x98, 0x2b, 0x3f, 0x49, 0x79, 0xc0, 0x56, 0xd0, 0x54, 0x38,
+	0x5a, 0xfb, 0xb1, 0x56, 0x4e, 0xce, 0xc1, 0xa0, 0x8d, 0xb5, 0xb5, 0xfc, 0x4a, 0x04, 0xa8, 0xde,
+	0x18, 0x73, 0xa1, 0x79, 0x41, 0x53, 0xae, 0x6b, 0xac, 0x69, 0x41, 0x72, 0x72, 0x06, 0x4a, 0x5a,
+	0x57, 0xa0, 0xc4, 0x46, 0x34, 0xa0, 0x8a, 0x9d, 0x8a, 0xf4, 0x12, 0x21, 0xde, 0xc1, 0x88, 0x1e,
+	0x5a, 0x56, 0xef, 0xcf, 0x35, 0x68, 0x97, 0xb0, 0xaf, 0x54, 0xb1, 0x2b, 0x33, 0x15, 0xfb, 0x2e,
+	0x34, 0x22, 0xa6, 0xce, 0x44, 0x1e, 0x32, 0x4b, 0xe9, 0x46, 0x53, 0xa5, 0x34, 0x96, 0x34, 0xd0,
+	0x7d, 0xa7, 0x0e, 0xa9, 0x89, 0xd8, 0x7a, 0x89, 0x7b, 0x14, 0xce, 0xe7, 0x7b, 0xed, 0x9a, 0x7c,
+	0xff, 0x7f, 0x70, 0x6c, 0xa3, 0xcb, 0x43, 0x8c, 0x5d, 0xcd, 0x6b, 0x19, 0xc6, 0x51, 0xa8, 0xdd,
+	0x6d, 0xc0, 0xc8, 0xd4, 0x8e, 0x15, 0xb8, 0x1b, 0x61, 0xab, 0x28, 0x8f, 0x29, 0x3b, 0xc9, 0xe2,
+	0x90, 0x15, 0x06, 0x97, 0x5f, 0xfc, 0xbb, 0xb9, 0x09, 0x6b, 0x94, 0x03, 0xe8, 0xc6, 0x74, 0x75,
+	0xe5, 0xff, 0x84, 0x31, 0x6b, 0xaa, 0x84, 0xec, 0xce, 0xe2, 0xc8, 0xde, 0xfb, 0xdb, 0x1a, 0x74,
+	0xca, 0x35, 0x4e, 0xeb, 0xa3, 0x61, 0x98, 0x32, 0x69, 0xd2, 0xa6, 0xbd, 0x7f, 0xff, 0xfa, 0xc2,
+	0x38, 0x34, 0x93, 0x6c, 0x4d, 0xcc, 0x65, 0xde, 0x99, 0x5c, 0x2e, 0x34, 0x03, 0x9a, 0xa6, 0x9c,
+	0xa5, 0x36, 0xab, 0x72, 0x92, 0x3c, 0x84, 0x0d, 0x95, 0xd2, 0xe0, 0x5c, 0xd7, 0xe3, 0x38, 0x8b,
+	0x46, 0x2c, 0xb5, 0x1d, 0x66, 0x37, 0x67, 0x7f, 0x81, 0x5c, 0xf2, 0x0c, 0x08, 0x93, 0x8a, 0x47,
+	0x58, 0x0a, 0x8b, 0x7e, 0xbc, 0xbe, 0xc0, 0xa6, 0xef, 0x14, 0xf2, 0x45, 0xb7, 0xfe, 0x39, 0x6c,
+	0xd0, 0x40, 0x65, 0x74, 0x3c, 0xd5, 0xd8, 0x58, 0x40, 0x63, 0xd7, 0x08, 0xe7, 0xea, 0x7a, 0x5f,
+	0x57, 0xa0, 0x69, 0x3d, 0x43, 0x36, 0xa1, 0x3e, 0xe6, 0x31, 0x7b, 0x64, 0x8f, 0x9f, 0x21, 0x72,
+	0xee, 0xbe, 0xf5, 0x8f, 0x21, 0x08, 0x81, 0x5a, 0xa0, 0x41, 0xd0, 0xf8, 0x06, 0xbf, 0xf5, 0x4c,
+	0xf4, 0xbc, 0x75, 0x87, 0x21, 0x34, 0xf6, 0x27, 0x42, 0x6a, 0xb0, 0x42, 0xec, 0xaf, 0x1b, 0xec,
+	0x37, 0x2c, 0xc4, 0x7e, 0xed, 0x69, 0x9d, 0x19, 0x76, 0x27, 0xda, 0xd3, 0x86, 0xd4, 0x46, 0x10,
+	0x88, 0x0d, 0xec, 0xe0, 0xb7, 0x36, 0x92, 0x9c, 0x89, 0x98, 0x59, 0xc0, 0x31, 0x44, 0xef, 0x55,
+	0x0d, 0x9a, 0x4f, 0x4c, 0x75, 0x9a, 0xbb, 0x40, 0x6c, 0x41, 0xcb, 0x5c, 0xf7, 0x2c, 0xe6, 0xd7,
+	0xbc, 0x26, 0xd2, 0x47, 0xb3, 0x77, 0x8b, 0xea, 0x0d, 0x77, 0x8b, 0xda, 0xfc, 0xdd, 0x22, 0x65,
+	0x54, 0x8a, 0xd8, 0x6e, 0xc7, 0x52, 0x64, 0x17, 0xda, 0xa1, 0x46, 0x0d, 0x9e, 0xe0, 0x45, 0xd9,
+	0x6c, 0xa7, 0xcc, 0xd2, 0x5a, 0xd9, 0x05, 0x0f, 0x59, 0x1c, 0xe8, 0x6d, 0x55, 0xb5, 0xd6, 0x9c,
+	0x2e, 0xe1, 0x5f, 0x6b, 0x06, 0xff, 0xb6, 0x01, 0x52, 0x26, 0xc5, 0x38, 0x43, 0xa5, 0x06, 0x48,
+	0x4b, 0x1c, 0xed, 0x61, 0xa4, 0x2e, 0x58, 0xe8, 0x8f, 0x2e, 0xed, 0x65, 0x0c, 0x72, 0xd6, 0xc1,
+	0xe5, 0x95, 0xb6, 0xae, 0xbd, 0x8c, 0xb6, 0xae, 0xf3, 0x7e, 0x6d, 0xdd, 0xd3, 0xd2, 0x52, 0xa9,
+	0xc2, 0xfe, 0xf3, 0x9b, 0x6a, 0x29, 0x36, 0x34, 0x54, 0x64, 0x04, 0x0d, 0x0b, 0x55, 0xdd, 0xa5,
+	0x43, 0x95, 0xd5, 0xdc, 0xfb, 0x43, 0x0d, 0x9a, 0xc7, 0xa6, 0xf4, 0xcf, 0xe4, 0x42, 0xe5, 0x4a,
+	0x2e, 0xdc, 0x86, 0xaa, 0x3c, 0xcf, 0xec, 0xe9, 0xd0, 0x9f, 0x45, 0xda, 0x56, 0x4b, 0x69, 0xfb,
+	0x3b, 0xa8, 0x9b, 0x5a, 0xbd, 0xfc, 0x27, 0x0e, 0xa3, 0x98, 0x7c, 0x0a, 0x2d, 0x5b, 0x97, 0xf3,
+	0xab, 0xed, 0x77, 0xdf, 0x71, 0xdd, 0x30, 0x9b, 0xfa, 0xb5, 0x99, 0x6c, 0xf1, 0xb0, 0x90, 0x35,
+	0xa7, 0x58, 0x04, 0xe7, 0x98, 0xbd, 0x35, 0xcf, 0x10, 0xda, 0x03, 0x45, 0x9b, 0xd7, 0x34, 0xe5,
+	0x31, 0xa7, 0x75, 0xde, 0xea, 0x62, 0x7b, 0xc1, 0xec, 0x5b, 0x83, 0xa5, 0x66, 0xda, 0x03, 0xe7,
+	0xc6, 0x9b, 0x06, 0x2c, 0x23, 0x25, 0xdb, 0xef, 0x97, 0x92, 0x57, 0x1b, 0x95, 0xce, 0x7c, 0xa3,
+	0xf2, 0xb2, 0x02, 0xdd, 0x59, 0xaf, 0xcd, 0xb5, 0x96, 0x79, 0xcc, 0xd7, 0xae, 0x8b, 0x79, 0x75,
+	0x55, 0x31, 0x2f, 0x62, 0x55, 0x7b, 0x57, 0xac, 0xea, 0xb3, 0xb1, 0xea, 0xfd, 0xa7, 0x0e, 0xce,
+	0x71, 0x2a, 0x22, 0x91, 0xa3, 0xd1, 0x3b, 0xf3, 0x5a, 0x23, 0xbc, 0x06, 0x6c, 0xbb, 0x23, 0xfd,
+	0xad, 0x5b, 0xa9, 0xe2, 0xea, 0xae, 0x57, 0x63, 0x53, 0xbc, 0x93, 0x33, 0x9f, 0x5f, 0x26, 0x4c,
+	0xb7, 0x65, 0x09, 0x4b, 0x03, 0x16, 0x2b, 0x7a, 0x6a, 0x9e, 0xb9, 0x6a, 0xf8, 0xcc, 0xb5, 0x3e,
+	0xe5, 0x1e, 0x24, 0x52, 0xdf, 0xc8, 0x4f, 0xf8, 0x64, 0xda, 0xe4, 0x2c, 0xbf, 0x61, 0x6e, 0xa3,
+	0xfe, 0xe9, 0x03, 0x40, 0xc4, 0x63, 0x7f, 0x85, 0xaf, 0x37, 0xed, 0x88, 0xc7, 0xcf, 0xf2, 0x07,
+	0x9c, 0x87, 0xb0, 0x11, 0xe1, 0x85, 0x26, 0x64, 0x11, 0x22, 0xbf, 0xb4, 0xc7, 0xa6, 0x1b, 0xe9,
+	0x3b, 0x4d, 0xc1, 0x25, 0x8f, 0xe1, 0xa3, 0x2b, 0x13, 0xfd, 0x84, 0xa5, 0x7e, 0x51, 0x96, 0x5a,
+	0x28, 0xb5, 0x35, 0x2b, 0x75, 0xcc, 0xd2, 0xc3, 0xbc, 0x4e, 0x11, 0xa8, 0xc9, 0xf3, 0x4c, 0xba,
+	0x0e, 0x56, 0x13, 0xfc, 0x26, 0x43, 0x70, 0xa4, 0xa2, 0xa9, 0x92, 0x8b, 0x1e, 0xae, 0x96, 0x11,
+	0x33, 0x17, 0x70, 0x16, 0x87, 0x72, 0xd1, 0x83, 0xd5, 0xd0, 0x42, 0x43, 0xa5, 0x2b, 0x61, 0x79,
+	0xef, 0x1d, 0xdc, 0x45, 0x99, 0x55, 0x42, 0x8d, 0xf5, 0x19, 0xd4, 0x58, 0xc6, 0x1b, 0x44, 0xef,
+	0x05, 0x7c, 0x50, 0x64, 0xf9, 0xd4, 0x6f, 0x0b, 0xe7, 0xfb, 0x4d, 0xfd, 0xc1, 0x26, 0xd4, 0x83,
+	0xe2, 0xd1, 0xba, 0xe6, 0x19, 0xa2, 0xf7, 0xc7, 0x0a, 0x34, 0x9f, 0xd3, 0x89, 0xa7, 0x3b, 0x9f,
+	0x52, 0x63, 0x53, 0x99, 0x6d, 0x6c, 0x8a, 0x4e, 0x69, 0xed, 0x86, 0x4e, 0xa9, 0x3a, 0xd7, 0x29,
+	0xe9, 0xe5, 0xe4, 0x30, 0x65, 0x5b, 0x92, 0x9c, 0xd6, 0x5d, 0x4e, 0xf1, 0xac, 0x5c, 0xc7, 0xf3,
+	0xd6, 0x4c, 0xcd, 0x63, 0x72, 0xef, 0xaf, 0x35, 0x70, 0xf4, 0x9a, 0x58, 0x20, 0xd2, 0xf0, 0x46,
+	0x1f, 0xdc, 0xd0, 0x2a, 0x95, 0xda, 0xf6, 0xea, 0x7b, 0x3c, 0xc8, 0x94, 0x7c, 0x51, 0x7b, 0x87,
+	0x2f, 0xea, 0x37, 0xf8, 0xa2, 0x31, 0xe7, 0x8b, 0x2f, 0xa1, 0xab, 0xe8, 0x84, 0x8e, 0xc6, 0x6c,
+	0x75, 0xb7, 0xa4, 0x75, 0x6b, 0x61, 0x7a, 0x49, 0xfa, 0xb6, 0xde, 0x48, 0x11, 0xc2, 0xed, 0x5b,
+	0x88, 0x83, 0xc7, 0xa3, 0xa0, 0xf1, 0x29, 0x13, 0x11, 0xc3, 0xdc, 0xe0, 0x56, 0xf2, 0x94, 0xa9,
+	0xb3, 0xc5, 0xa8, 0xef, 0xfd, 0x7b, 0x0d, 0x36, 0x3e, 0xcb, 0x52, 0x2e, 0x43, 0x8e, 0x37, 0xea,
+	0xe7, 0x74, 0xb2, 0xfc, 0xcc, 0xde, 0x81, 0xb6, 0x49, 0xbc, 0xf2, 0x91, 0x02, 0x64, 0x1d, 0xa2,
+	0x43, 0xe6, 0xc3, 0x5d, 0xff, 0x76, 0xc3, 0xdd, 0x58, 0x61, 0xb8, 0x7b, 0xaf, 0xeb, 0x40, 0x9e,
+	0xd3, 0xc9, 0x2f, 0x38, 0x1d, 0xf1, 0x31, 0x57, 0x97, 0x1e, 0x4b, 0x44, 0x7a, 0x73, 0xdb, 0x79,
+	0x08, 0x80, 0x58, 0xed, 0x2b, 0x6e, 0xdb, 0x8e, 0x6f, 0xfe, 0xc2, 0xa9, 0xe5, 0xf4, 0x08, 0x79,
+	0x0c, 0x2d, 0x16, 0x87, 0x46, 0xc5, 0x22, 0xa7, 0x5a, 0x97, 0x06, 0x54, 0xf0, 0x3f, 0xe3, 0x26,
+	0x66, 0x5e, 0xed, 0x6c, 0x43, 0xb2, 0xe4, 0x3f, 0x32, 0xa6, 0x2f, 0x80, 0xd7, 0x9c, 0x8e, 0xc6,
+	0x4a, 0x4f, 0xc7, 0xd4, 0x9c, 0x3d, 0xac, 0xcd, 0x15, 0x99, 0xb3, 0x67, 0x3f, 0x80, 0xa6, 0x36,
+	0x17, 0x66, 0x6c, 0x05, 0xf8, 0xd3, 0x50, 0x74, 0xf2, 0x24, 0x63, 0xe4, 0x57, 0xb0, 0xfe, 0xfb,
+	0xd2, 0x81, 0x37, 0xad, 0x45, 0x7b, 0xff, 0xe3, 0xeb, 0xaf, 0x13, 0x57, 0xb0, 0xc1, 0xde, 0x27,
+	0x66, 0x35, 0x1c, 0x0c, 0x5f, 0xbe, 0xd9, 0xae, 0xbc, 0x7a, 0xb3, 0x5d, 0xf9, 0xd7, 0x9b, 0xed,
+	0xca, 0x57, 0x6f, 0xb7, 0x6f, 0xbd, 0x7a, 0xbb, 0x7d, 0xeb, 0xef, 0x6f, 0xb7, 0x6f, 0xfd, 0xb6,
+	0xbc, 0xba, 0xd9, 0x7f, 0xd2, 0x27, 0xf9, 0x7f, 0xe9, 0xb8, 0xc4, 0x51, 0x03, 0x33, 0xf2, 0x87,
+	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x80, 0x53, 0xb9, 0x70, 0x1f, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1470,76 +1786,94 @@ func (m *Address) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Phone) > 0 {
-		i -= len(m.Phone)
-		copy(dAtA[i:], m.Phone)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Phone)))
+	if m.MaxInventoryHold != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MaxInventoryHold))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x58
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Name)))
+	if len(m.TaxCollector) > 0 {
+		i -= len(m.TaxCollector)
+		copy(dAtA[i:], m.TaxCollector)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TaxCollector)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x52
 	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Country)))
+	if m.AutoCompleteWindow != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.AutoCompleteWindow))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x48
 	}
-	if len(m.PostalCode) > 0 {
-		i -= len(m.PostalCode)
-		copy(dAtA[i:], m.PostalCode)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.PostalCode)))
+	if m.AutoCompleteAfterDelivery {
 		i--
-		dAtA[i] = 0x2a
+		if m.AutoCompleteAfterDelivery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.State)))
+	if len(m.StablecoinDenom) > 0 {
+		i -= len(m.StablecoinDenom)
+		copy(dAtA[i:], m.StablecoinDenom)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.StablecoinDenom)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x3a
 	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.City)))
+	if m.DefaultFeeRateBps != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultFeeRateBps))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x30
 	}
-	if len(m.Line2) > 0 {
-		i -= len(m.Line2)
-		copy(dAtA[i:], m.Line2)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Line2)))
+	{
+		size := m.MaxOrderAmount.Size()
+		i -= size
+		if _, err := m.MaxOrderAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinOrderAmount.Size()
+		i -= size
+		if _, err := m.MinOrderAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.DisputeWindow != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.DisputeWindow))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Line1) > 0 {
-		i -= len(m.Line1)
-		copy(dAtA[i:], m.Line1)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Line1)))
+	if m.DefaultEscrowExpiration != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultEscrowExpiration))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.DefaultOrderExpiration != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.DefaultOrderExpiration))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Dispute) Marshal() (dAtA []byte, err error) {
+func (m *Order) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1549,112 +1883,249 @@ func (m *Dispute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TaxCollector) > 0 {
+		i -= len(m.TaxCollector)
+		copy(dAtA[i:], m.TaxCollector)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TaxCollector)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.TaxRemitted {
+		i--
+		if m.TaxRemitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintOrders(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	i--
-	dAtA[i] = 0x72
-	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResolvedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolvedAt):])
-	if err27 != nil {
-		return 0, err27
+	if len(m.CouponCode) > 0 {
+		i -= len(m.CouponCode)
+		copy(dAtA[i:], m.CouponCode)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.CouponCode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	i -= n27
-	i = encodeVarintOrders(dAtA, i, uint64(n27))
-	i--
-	dAtA[i] = 0x6a
-	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
-	if err28 != nil {
-		return 0, err28
+	if m.InventoryReserved {
+		i--
+		if m.InventoryReserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	i -= n28
-	i = encodeVarintOrders(dAtA, i, uint64(n28))
-	i--
-	dAtA[i] = 0x62
-	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err29 != nil {
-		return 0, err29
-	}
-	i -= n29
-	i = encodeVarintOrders(dAtA, i, uint64(n29))
-	i--
-	dAtA[i] = 0x5a
-	if len(m.ResolvedBy) > 0 {
-		i -= len(m.ResolvedBy)
-		copy(dAtA[i:], m.ResolvedBy)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.ResolvedBy)))
+	if m.DisputeId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.DisputeId))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.Resolution) > 0 {
-		i -= len(m.Resolution)
-		copy(dAtA[i:], m.Resolution)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Resolution)))
+	if m.SettlementId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.SettlementId))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOrders(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOrders(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeliveredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeliveredAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintOrders(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ShippedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ShippedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintOrders(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PaidAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintOrders(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintOrders(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x7a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintOrders(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x72
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x6a
 	}
-	if len(m.Evidence) > 0 {
-		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Evidence[iNdEx])
-			copy(dAtA[i:], m.Evidence[iNdEx])
-			i = encodeVarintOrders(dAtA, i, uint64(len(m.Evidence[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+	{
+		size, err := m.ShippingInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.PaymentInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintOrders(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DiscountAmount.Size()
+		i -= size
+		if _, err := m.DiscountAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TaxAmount.Size()
+		i -= size
+		if _, err := m.TaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ShippingCost.Size()
+		i -= size
+		if _, err := m.ShippingCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Subtotal.Size()
+		i -= size
+		if _, err := m.Subtotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
 	if len(m.Merchant) > 0 {
 		i -= len(m.Merchant)
 		copy(dAtA[i:], m.Merchant)
 		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.Customer) > 0 {
 		i -= len(m.Customer)
 		copy(dAtA[i:], m.Customer)
 		i = encodeVarintOrders(dAtA, i, uint64(len(m.Customer)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Id != 0 {
 		i = encodeVarintOrders(dAtA, i, uint64(m.Id))
@@ -1664,323 +2135,4112 @@ func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrders(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OrderItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DefaultOrderExpiration != 0 {
-		n += 1 + sovOrders(uint64(m.DefaultOrderExpiration))
-	}
-	if m.DefaultEscrowExpiration != 0 {
-		n += 1 + sovOrders(uint64(m.DefaultEscrowExpiration))
-	}
-	if m.DisputeWindow != 0 {
-		n += 1 + sovOrders(uint64(m.DisputeWindow))
+	if len(m.TaxCategory) > 0 {
+		i -= len(m.TaxCategory)
+		copy(dAtA[i:], m.TaxCategory)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TaxCategory)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = m.MinOrderAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.MaxOrderAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	if m.DefaultFeeRateBps != 0 {
-		n += 1 + sovOrders(uint64(m.DefaultFeeRateBps))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.StablecoinDenom)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.AutoCompleteAfterDelivery {
-		n += 2
+	{
+		size := m.TotalPrice.Size()
+		i -= size
+		if _, err := m.TotalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	if m.AutoCompleteWindow != 0 {
-		n += 1 + sovOrders(uint64(m.AutoCompleteWindow))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UnitPrice.Size()
+		i -= size
+		if _, err := m.UnitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	if m.Quantity != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProductName) > 0 {
+		i -= len(m.ProductName)
+		copy(dAtA[i:], m.ProductName)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ProductName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProductId) > 0 {
+		i -= len(m.ProductId)
+		copy(dAtA[i:], m.ProductId)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ProductId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PaymentInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovOrders(uint64(m.Id))
-	}
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PaidAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt):])
+	if err19 != nil {
+		return 0, err19
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i -= n19
+	i = encodeVarintOrders(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FeeAmount.Size()
+		i -= size
+		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.RefundedAmount.Size()
+		i -= size
+		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovOrders(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PaidAmount.Size()
+		i -= size
+		if _, err := m.PaidAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	l = m.Subtotal.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.ShippingCost.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.TaxAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.DiscountAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.PaymentInfo.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.ShippingInfo.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.EscrowId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.EscrowId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt)
-	n += 2 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ShippedAt)
-	n += 2 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeliveredAt)
-	n += 2 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletedAt)
-	n += 2 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
-	n += 2 + l + sovOrders(uint64(l))
 	if m.SettlementId != 0 {
-		n += 2 + sovOrders(uint64(m.SettlementId))
+		i = encodeVarintOrders(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.DisputeId != 0 {
-		n += 2 + sovOrders(uint64(m.DisputeId))
+	if len(m.TransactionId) > 0 {
+		i -= len(m.TransactionId)
+		copy(dAtA[i:], m.TransactionId)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TransactionId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OrderItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShippingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShippingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.ProductId)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActualDelivery, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActualDelivery):])
+	if err23 != nil {
+		return 0, err23
 	}
-	l = len(m.ProductName)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i -= n23
+	i = encodeVarintOrders(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x32
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EstimatedDelivery, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedDelivery):])
+	if err24 != nil {
+		return 0, err24
 	}
-	if m.Quantity != 0 {
-		n += 1 + sovOrders(uint64(m.Quantity))
+	i -= n24
+	i = encodeVarintOrders(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TrackingNumber) > 0 {
+		i -= len(m.TrackingNumber)
+		copy(dAtA[i:], m.TrackingNumber)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TrackingNumber)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.UnitPrice.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.TotalPrice.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = len(m.Variant)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Carrier) > 0 {
+		i -= len(m.Carrier)
+		copy(dAtA[i:], m.Carrier)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Carrier)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	{
+		size, err := m.Address.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PaymentInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.TransactionId)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	if m.SettlementId != 0 {
-		n += 1 + sovOrders(uint64(m.SettlementId))
-	}
-	if m.EscrowId != 0 {
-		n += 1 + sovOrders(uint64(m.EscrowId))
+func (m *Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PaidAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.RefundedAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.FeeAmount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt)
-	n += 1 + l + sovOrders(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ShippingInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.Carrier)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.TrackingNumber)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedDelivery)
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActualDelivery)
-	n += 1 + l + sovOrders(uint64(l))
-	return n
+func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Address) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Line1)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Phone) > 0 {
+		i -= len(m.Phone)
+		copy(dAtA[i:], m.Phone)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Phone)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Line2)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PostalCode)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Line2) > 0 {
+		i -= len(m.Line2)
+		copy(dAtA[i:], m.Line2)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Line2)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Phone)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	if len(m.Line1) > 0 {
+		i -= len(m.Line1)
+		copy(dAtA[i:], m.Line1)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Line1)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Dispute) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovOrders(uint64(m.Id))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovOrders(uint64(m.OrderId))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
 	}
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResolvedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolvedAt):])
+	if err27 != nil {
+		return 0, err27
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i -= n27
+	i = encodeVarintOrders(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x6a
+	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err28 != nil {
+		return 0, err28
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i -= n28
+	i = encodeVarintOrders(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x62
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err29 != nil {
+		return 0, err29
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
+	i -= n29
+	i = encodeVarintOrders(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Evidence) > 0 {
-		for _, s := range m.Evidence {
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Evidence[iNdEx])
+			copy(dAtA[i:], m.Evidence[iNdEx])
+			i = encodeVarintOrders(dAtA, i, uint64(len(m.Evidence[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Product) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Product) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCategory) > 0 {
+		i -= len(m.TaxCategory)
+		copy(dAtA[i:], m.TaxCategory)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.TaxCategory)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintOrders(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x5a
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintOrders(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Reserved != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Reserved))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Stock != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Stock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Variants) > 0 {
+		for iNdEx := len(m.Variants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Variants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sku) > 0 {
+		i -= len(m.Sku)
+		copy(dAtA[i:], m.Sku)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Sku)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProductVariant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProductVariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProductVariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reserved != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Reserved))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Stock != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Stock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Promotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Promotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintOrders(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x72
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Redemptions != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Redemptions))
+		i--
+		dAtA[i] = 0x60
+	}
+	n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndsAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintOrders(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x5a
+	n36, err36 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartsAt):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintOrders(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Skus) > 0 {
+		for iNdEx := len(m.Skus) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skus[iNdEx])
+			copy(dAtA[i:], m.Skus[iNdEx])
+			i = encodeVarintOrders(dAtA, i, uint64(len(m.Skus[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxRedemptionsPerCustomer != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MaxRedemptionsPerCustomer))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRedemptions != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.MaxRedemptions))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinSubtotal.Size()
+		i -= size
+		if _, err := m.MinSubtotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FixedAmount.Size()
+		i -= size
+		if _, err := m.FixedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PercentageBps != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.PercentageBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DiscountType) > 0 {
+		i -= len(m.DiscountType)
+		copy(dAtA[i:], m.DiscountType)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.DiscountType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PromotionRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotionRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateBps != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.RateBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxRefunded.Size()
+		i -= size
+		if _, err := m.TaxRefunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Remitted {
+		i--
+		if m.Remitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.TaxAmount.Size()
+		i -= size
+		if _, err := m.TaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TaxableAmount.Size()
+		i -= size
+		if _, err := m.TaxableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n42, err42 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PaidAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintOrders(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JurisdictionTax) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JurisdictionTax) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JurisdictionTax) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TaxAmount.Size()
+		i -= size
+		if _, err := m.TaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TaxableAmount.Size()
+		i -= size
+		if _, err := m.TaxableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaxLiabilityReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaxLiabilityReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaxLiabilityReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jurisdictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrders(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.TaxDue.Size()
+		i -= size
+		if _, err := m.TaxDue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TaxRemitted.Size()
+		i -= size
+		if _, err := m.TaxRemitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TaxRefunded.Size()
+		i -= size
+		if _, err := m.TaxRefunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TaxCollected.Size()
+		i -= size
+		if _, err := m.TaxCollected.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.OrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	n49, err49 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err49 != nil {
+		return 0, err49
+	}
+	i -= n49
+	i = encodeVarintOrders(dAtA, i, uint64(n49))
+	i--
+	dAtA[i] = 0x1a
+	n50, err50 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err50 != nil {
+		return 0, err50
+	}
+	i -= n50
+	i = encodeVarintOrders(dAtA, i, uint64(n50))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintOrders(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultOrderExpiration != 0 {
+		n += 1 + sovOrders(uint64(m.DefaultOrderExpiration))
+	}
+	if m.DefaultEscrowExpiration != 0 {
+		n += 1 + sovOrders(uint64(m.DefaultEscrowExpiration))
+	}
+	if m.DisputeWindow != 0 {
+		n += 1 + sovOrders(uint64(m.DisputeWindow))
+	}
+	l = m.MinOrderAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.MaxOrderAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.DefaultFeeRateBps != 0 {
+		n += 1 + sovOrders(uint64(m.DefaultFeeRateBps))
+	}
+	l = len(m.StablecoinDenom)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.AutoCompleteAfterDelivery {
+		n += 2
+	}
+	if m.AutoCompleteWindow != 0 {
+		n += 1 + sovOrders(uint64(m.AutoCompleteWindow))
+	}
+	l = len(m.TaxCollector)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.MaxInventoryHold != 0 {
+		n += 1 + sovOrders(uint64(m.MaxInventoryHold))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrders(uint64(m.Id))
+	}
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	l = m.Subtotal.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.ShippingCost.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.DiscountAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.PaymentInfo.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.ShippingInfo.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt)
+	n += 2 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ShippedAt)
+	n += 2 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeliveredAt)
+	n += 2 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletedAt)
+	n += 2 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 2 + l + sovOrders(uint64(l))
+	if m.SettlementId != 0 {
+		n += 2 + sovOrders(uint64(m.SettlementId))
+	}
+	if m.DisputeId != 0 {
+		n += 2 + sovOrders(uint64(m.DisputeId))
+	}
+	if m.InventoryReserved {
+		n += 3
+	}
+	l = len(m.CouponCode)
+	if l > 0 {
+		n += 2 + l + sovOrders(uint64(l))
+	}
+	if m.TaxRemitted {
+		n += 3
+	}
+	l = len(m.TaxCollector)
+	if l > 0 {
+		n += 2 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.ProductId)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.ProductName)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovOrders(uint64(m.Quantity))
+	}
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TotalPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.TransactionId)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovOrders(uint64(m.SettlementId))
+	}
+	if m.EscrowId != 0 {
+		n += 1 + sovOrders(uint64(m.EscrowId))
+	}
+	l = m.PaidAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.RefundedAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.FeeAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt)
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *ShippingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedDelivery)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActualDelivery)
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Line1)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Line2)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Phone)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrders(uint64(m.Id))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if len(m.Evidence) > 0 {
+		for _, s := range m.Evidence {
+			l = len(s)
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolvedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *Product) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.Variants) > 0 {
+		for _, e := range m.Variants {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	if m.Stock != 0 {
+		n += 1 + sovOrders(uint64(m.Stock))
+	}
+	if m.Reserved != 0 {
+		n += 1 + sovOrders(uint64(m.Reserved))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *ProductVariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.Stock != 0 {
+		n += 1 + sovOrders(uint64(m.Stock))
+	}
+	if m.Reserved != 0 {
+		n += 1 + sovOrders(uint64(m.Reserved))
+	}
+	return n
+}
+
+func (m *Promotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.DiscountType)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.PercentageBps != 0 {
+		n += 1 + sovOrders(uint64(m.PercentageBps))
+	}
+	l = m.FixedAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.MinSubtotal.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.MaxRedemptions != 0 {
+		n += 1 + sovOrders(uint64(m.MaxRedemptions))
+	}
+	if m.MaxRedemptionsPerCustomer != 0 {
+		n += 1 + sovOrders(uint64(m.MaxRedemptionsPerCustomer))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
 			l = len(s)
 			n += 1 + l + sovOrders(uint64(l))
 		}
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.Resolution)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = len(m.ResolvedBy)
-	if l > 0 {
-		n += 1 + l + sovOrders(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovOrders(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResolvedAt)
-	n += 1 + l + sovOrders(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovOrders(uint64(l))
-	return n
-}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartsAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndsAt)
+	n += 1 + l + sovOrders(uint64(l))
+	if m.Redemptions != 0 {
+		n += 1 + sovOrders(uint64(m.Redemptions))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *PromotionRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovOrders(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *TaxRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.RateBps != 0 {
+		n += 1 + sovOrders(uint64(m.RateBps))
+	}
+	return n
+}
+
+func (m *TaxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovOrders(uint64(m.OrderId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PaidAt)
+	n += 1 + l + sovOrders(uint64(l))
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = m.TaxableAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.Remitted {
+		n += 2
+	}
+	l = m.TaxRefunded.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *JurisdictionTax) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.OrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.OrderCount))
+	}
+	l = m.TaxableAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxAmount.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	return n
+}
+
+func (m *TaxLiabilityReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovOrders(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovOrders(uint64(l))
+	if m.OrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.OrderCount))
+	}
+	l = m.TaxCollected.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxRefunded.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxRemitted.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TaxDue.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if len(m.Jurisdictions) > 0 {
+		for _, e := range m.Jurisdictions {
+			l = e.Size()
+			n += 1 + l + sovOrders(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOrders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrders(x uint64) (n int) {
+	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultOrderExpiration", wireType)
+			}
+			m.DefaultOrderExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultOrderExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultEscrowExpiration", wireType)
+			}
+			m.DefaultEscrowExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultEscrowExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			}
+			m.DisputeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFeeRateBps", wireType)
+			}
+			m.DefaultFeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultFeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StablecoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StablecoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompleteAfterDelivery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompleteAfterDelivery = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompleteWindow", wireType)
+			}
+			m.AutoCompleteWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompleteWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInventoryHold", wireType)
+			}
+			m.MaxInventoryHold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInventoryHold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subtotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShippingCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DiscountAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShippingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PaidAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ShippedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeliveredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InventoryReserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InventoryReserved = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouponCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CouponCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRemitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TaxRemitted = bool(v != 0)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			m.EscrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PaidAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShippingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShippingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDelivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EstimatedDelivery, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualDelivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActualDelivery, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Address) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Line1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Line2 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovOrders(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOrders(x uint64) (n int) {
-	return sovOrders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Dispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +6263,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultOrderExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.DefaultOrderExpiration = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2023,16 +6283,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultOrderExpiration |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultEscrowExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			m.DefaultEscrowExpiration = 0
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2042,16 +6302,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultEscrowExpiration |= int64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
 			}
-			m.DisputeWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2061,16 +6321,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DisputeWindow |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinOrderAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2080,30 +6417,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2113,30 +6449,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxOrderAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Evidence = append(m.Evidence, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFeeRateBps", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.DefaultFeeRateBps = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2146,14 +6481,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultFeeRateBps |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StablecoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2181,13 +6529,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StablecoinDenom = string(dAtA[iNdEx:postIndex])
+			m.Resolution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompleteAfterDelivery", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2197,17 +6545,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoCompleteAfterDelivery = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompleteWindow", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
 			}
-			m.AutoCompleteWindow = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2217,66 +6577,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AutoCompleteWindow |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrders(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Order) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOrders
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2286,16 +6610,30 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2305,29 +6643,30 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResolvedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2337,27 +6676,78 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Product) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Product: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Product: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2385,47 +6775,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, OrderItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subtotal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2435,30 +6791,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subtotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sku = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShippingCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2468,28 +6823,27 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShippingCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2516,13 +6870,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscountAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Variants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2549,15 +6903,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DiscountAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Variants = append(m.Variants, ProductVariant{})
+			if err := m.Variants[len(m.Variants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stock", wireType)
 			}
-			var msglen int
+			m.Stock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2567,30 +6922,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Stock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentInfo", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
 			}
-			var msglen int
+			m.Reserved = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2600,30 +6941,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reserved |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PaymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShippingInfo", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2633,26 +6960,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShippingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+			m.Active = bool(v != 0)
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -2684,7 +6998,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -2717,7 +7031,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
@@ -2750,11 +7064,11 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2764,63 +7078,79 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PaidAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShippedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProductVariant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ShippedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 18:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProductVariant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProductVariant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2830,30 +7160,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeliveredAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2863,28 +7192,27 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2911,15 +7239,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stock", wireType)
 			}
-			m.SettlementId = 0
+			m.Stock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2929,16 +7257,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.Stock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
 			}
-			m.DisputeId = 0
+			m.Reserved = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -2948,7 +7276,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DisputeId |= uint64(b&0x7F) << shift
+				m.Reserved |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2974,7 +7302,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderItem) Unmarshal(dAtA []byte) error {
+func (m *Promotion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2997,15 +7325,15 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3033,11 +7361,11 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3065,11 +7393,11 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProductId = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3097,13 +7425,13 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProductName = string(dAtA[iNdEx:postIndex])
+			m.DiscountType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentageBps", wireType)
 			}
-			m.Quantity = 0
+			m.PercentageBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3113,14 +7441,14 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
+				m.PercentageBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3147,13 +7475,13 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FixedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubtotal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3180,15 +7508,15 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSubtotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptions", wireType)
 			}
-			var stringLen uint64
+			m.MaxRedemptions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3198,29 +7526,16 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRedemptions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Variant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionsPerCustomer", wireType)
 			}
-			var stringLen uint64
+			m.MaxRedemptionsPerCustomer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3230,77 +7545,14 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRedemptionsPerCustomer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrders(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOrders
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PaymentInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3328,13 +7580,13 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Skus = append(m.Skus, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3344,29 +7596,30 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3376,29 +7629,30 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
 			}
-			m.SettlementId = 0
+			m.Redemptions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3408,16 +7662,16 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.Redemptions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			m.EscrowId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3427,16 +7681,100 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EscrowId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Active = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaidAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3446,30 +7784,29 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3479,30 +7816,29 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3512,30 +7848,29 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3545,25 +7880,11 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PaidAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -3585,7 +7906,7 @@ func (m *PaymentInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
+func (m *TaxRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,17 +7929,17 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShippingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaxRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShippingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaxRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3628,28 +7949,27 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Country = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3677,11 +7997,11 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3709,11 +8029,11 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Carrier = string(dAtA[iNdEx:postIndex])
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3741,46 +8061,13 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDelivery", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EstimatedDelivery, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualDelivery", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateBps", wireType)
 			}
-			var msglen int
+			m.RateBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3790,25 +8077,11 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RateBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActualDelivery, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -3830,7 +8103,7 @@ func (m *ShippingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Address) Unmarshal(dAtA []byte) error {
+func (m *TaxRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3853,15 +8126,15 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaxRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Line1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3889,13 +8162,32 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Line1 = string(dAtA[iNdEx:postIndex])
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Line2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -3905,27 +8197,28 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Line2 = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PaidAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3953,9 +8246,9 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.City = string(dAtA[iNdEx:postIndex])
+			m.Country = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
@@ -3987,7 +8280,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			}
 			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
 			}
@@ -4019,11 +8312,11 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 			}
 			m.PostalCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxableAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4033,29 +8326,63 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remitted", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4065,29 +8392,17 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrders
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOrders
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			m.Remitted = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRefunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4097,23 +8412,24 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phone = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4136,7 +8452,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dispute) Unmarshal(dAtA []byte) error {
+func (m *JurisdictionTax) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4159,17 +8475,17 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: JurisdictionTax: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JurisdictionTax: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4179,33 +8495,27 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
 			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrders
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4233,11 +8543,11 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4265,13 +8575,32 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxableAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4281,29 +8610,30 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4313,27 +8643,78 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxLiabilityReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxLiabilityReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxLiabilityReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4361,13 +8742,13 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = append(m.Evidence, string(dAtA[iNdEx:postIndex]))
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4377,29 +8758,30 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4409,29 +8791,49 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCollected", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrders
@@ -4441,27 +8843,28 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrders
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrders
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			if err := m.TaxCollected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRefunded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4488,13 +8891,13 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaxRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxRemitted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4521,13 +8924,13 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaxRemitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxDue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4554,13 +8957,13 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResolvedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaxDue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4587,7 +8990,8 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Jurisdictions = append(m.Jurisdictions, JurisdictionTax{})
+			if err := m.Jurisdictions[len(m.Jurisdictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DiscountTypePercentage takes percentage_bps off the eligible subtotal.
+	DiscountTypePercentage = "percentage"
+
+	// DiscountTypeFixed takes fixed_amount off the eligible subtotal.
+	DiscountTypeFixed = "fixed"
+
+	// MaxCouponCodeLength caps the length of a coupon code.
+	MaxCouponCodeLength = 64
+
+	// MaxPromotionSKUs caps the number of SKUs a promotion can be limited to.
+	MaxPromotionSKUs = 100
+)
+
+// ValidateCouponCode checks that a coupon code is 1 to MaxCouponCodeLength
+// letters, digits, dashes or underscores.
+func ValidateCouponCode(code string) error {
+	if code == "" || len(code) > MaxCouponCodeLength {
+		return errorsmod.Wrapf(ErrInvalidPromotion, "coupon code must be 1 to %d characters", MaxCouponCodeLength)
+	}
+	for _, c := range code {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return errorsmod.Wrapf(ErrInvalidPromotion, "coupon code %q contains invalid character %q", code, c)
+		}
+	}
+	return nil
+}
+
+// ValidatePromotionTerms checks the fields merchants set on a promotion.
+func ValidatePromotionTerms(code, discountType string, percentageBps uint32, fixedAmount, minSubtotal sdk.Coin, skus []string, startsAt, endsAt time.Time) error {
+	if err := ValidateCouponCode(code); err != nil {
+		return err
+	}
+	switch discountType {
+	case DiscountTypePercentage:
+		if percentageBps == 0 || percentageBps > 10000 {
+			return errorsmod.Wrap(ErrInvalidPromotion, "percentage_bps must be between 1 and 10000")
+		}
+		if isSetCoin(fixedAmount) {
+			return errorsmod.Wrap(ErrInvalidPromotion, "percentage promotions cannot set fixed_amount")
+		}
+	case DiscountTypeFixed:
+		if !isSetCoin(fixedAmount) || !fixedAmount.IsValid() {
+			return errorsmod.Wrap(ErrInvalidAmount, "fixed_amount must be positive")
+		}
+		if percentageBps != 0 {
+			return errorsmod.Wrap(ErrInvalidPromotion, "fixed promotions cannot set percentage_bps")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidPromotion, "discount type must be %s or %s", DiscountTypePercentage, DiscountTypeFixed)
+	}
+	if isSetCoin(minSubtotal) && !minSubtotal.IsValid() {
+		return errorsmod.Wrap(ErrInvalidAmount, "invalid min_subtotal")
+	}
+	if len(skus) > MaxPromotionSKUs {
+		return errorsmod.Wrapf(ErrInvalidPromotion, "at most %d skus", MaxPromotionSKUs)
+	}
+	seen := make(map[string]bool, len(skus))
+	for _, sku := range skus {
+		if sku == "" || len(sku) > MaxSKULength {
+			return errorsmod.Wrapf(ErrInvalidPromotion, "sku must be 1 to %d characters", MaxSKULength)
+		}
+		if seen[sku] {
+			return errorsmod.Wrapf(ErrInvalidPromotion, "duplicate sku %s", sku)
+		}
+		seen[sku] = true
+	}
+	if !endsAt.IsZero() && !endsAt.After(startsAt) {
+		return errorsmod.Wrap(ErrInvalidPromotion, "ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// Validate checks a stored promotion, including that it has not been redeemed
+// past its limit.
+func (p Promotion) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Merchant); err != nil {
+		return ErrInvalidMerchant
+	}
+	if err := ValidatePromotionTerms(p.Code, p.DiscountType, p.PercentageBps, p.FixedAmount, p.MinSubtotal, p.Skus, p.StartsAt, p.EndsAt); err != nil {
+		return err
+	}
+	if p.MaxRedemptions > 0 && p.Redemptions > p.MaxRedemptions {
+		return errorsmod.Wrapf(ErrInvalidPromotion, "promotion %s is redeemed past its limit", p.Code)
+	}
+	return nil
+}
+
+// IsActiveAt reports whether the promotion can be applied at t.
+func (p Promotion) IsActiveAt(t time.Time) bool {
+	if !p.Active || t.Before(p.StartsAt) {
+		return false
+	}
+	return p.EndsAt.IsZero() || t.Before(p.EndsAt)
+}
+
+// AppliesTo reports whether the promotion discounts an item with the given SKU.
+func (p Promotion) AppliesTo(sku string) bool {
+	if len(p.Skus) == 0 {
+		return true
+	}
+	for _, s := range p.Skus {
+		if s == sku {
+			return true
+		}
+	}
+	return false
+}
+
+// Discount returns the amount the promotion takes off the items the order
+// holds, which must already carry their TotalPrice. The discount never exceeds
+// the subtotal of the items it applies to.
+func (p Promotion) Discount(items []OrderItem) sdkmath.Int {
+	eligible := sdkmath.ZeroInt()
+	for _, item := range items {
+		if p.AppliesTo(item.ProductId) {
+			eligible = eligible.Add(item.TotalPrice.Amount)
+		}
+	}
+
+	var discount sdkmath.Int
+	switch p.DiscountType {
+	case DiscountTypePercentage:
+		discount = eligible.MulRaw(int64(p.PercentageBps)).QuoRaw(10000)
+	case DiscountTypeFixed:
+		discount = p.FixedAmount.Amount
+	default:
+		return sdkmath.ZeroInt()
+	}
+	return sdkmath.MinInt(discount, eligible)
+}
+
+// isSetCoin reports whether an optional coin field holds a non-zero amount.
+func isSetCoin(coin sdk.Coin) bool {
+	return !coin.Amount.IsNil() && !coin.IsZero()
+}
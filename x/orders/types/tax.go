@@ -0,0 +1,75 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxTaxRegionLength caps the length of a tax rate's state and postal code.
+	MaxTaxRegionLength = 32
+
+	// MaxTaxCategoryLength caps the length of a product tax category.
+	MaxTaxCategoryLength = 64
+)
+
+// NormalizeTaxRegion returns the form jurisdictions are stored and looked up
+// in: trimmed and upper-cased, so "us" and " US" match the same rates.
+func NormalizeTaxRegion(value string) string {
+	return strings.ToUpper(strings.TrimSpace(value))
+}
+
+// ValidateTaxCategory checks a product or order item tax category.
+func ValidateTaxCategory(category string) error {
+	if len(category) > MaxTaxCategoryLength {
+		return errorsmod.Wrapf(ErrInvalidTaxRate, "tax category exceeds %d characters", MaxTaxCategoryLength)
+	}
+	return nil
+}
+
+// ValidateTaxJurisdiction checks the jurisdiction and category a tax rate is
+// stored under: a two-letter country code and optional state, postal code and
+// category, with the regions already normalized.
+func ValidateTaxJurisdiction(country, state, postalCode, category string) error {
+	if len(country) != 2 || NormalizeTaxRegion(country) != country {
+		return errorsmod.Wrap(ErrInvalidTaxRate, "country must be an upper-case two-letter code")
+	}
+	for _, region := range []string{state, postalCode} {
+		if len(region) > MaxTaxRegionLength {
+			return errorsmod.Wrapf(ErrInvalidTaxRate, "state and postal code must be at most %d characters", MaxTaxRegionLength)
+		}
+		if NormalizeTaxRegion(region) != region {
+			return errorsmod.Wrapf(ErrInvalidTaxRate, "%q must be trimmed and upper-case", region)
+		}
+	}
+	return ValidateTaxCategory(category)
+}
+
+// Validate checks a tax rate.
+func (r TaxRate) Validate() error {
+	if err := ValidateTaxJurisdiction(r.Country, r.State, r.PostalCode, r.Category); err != nil {
+		return err
+	}
+	if r.RateBps > 10000 {
+		return errorsmod.Wrap(ErrInvalidTaxRate, "rate_bps cannot exceed 10000")
+	}
+	return nil
+}
+
+// TaxJurisdictions returns the state and postal code pairs of an address to
+// look tax rates up under, most specific first.
+func TaxJurisdictions(address Address) [][2]string {
+	state, postalCode := NormalizeTaxRegion(address.State), NormalizeTaxRegion(address.PostalCode)
+	candidates := [][2]string{{state, postalCode}, {"", postalCode}, {state, ""}, {"", ""}}
+
+	jurisdictions := make([][2]string, 0, len(candidates))
+	seen := make(map[[2]string]bool, len(candidates))
+	for _, candidate := range candidates {
+		if !seen[candidate] {
+			seen[candidate] = true
+			jurisdictions = append(jurisdictions, candidate)
+		}
+	}
+	return jurisdictions
+}
@@ -11,18 +11,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +40,7 @@ type MsgCreateOrder struct {
 	Items        []OrderItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items"`
 	ShippingInfo ShippingInfo `protobuf:"bytes,4,opt,name=shipping_info,json=shippingInfo,proto3" json:"shipping_info"`
 	Metadata     string       `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CouponCode   string       `protobuf:"bytes,6,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
 }
 
 func (m *MsgCreateOrder) Reset()         { *m = MsgCreateOrder{} }
@@ -106,6 +111,13 @@ func (m *MsgCreateOrder) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgCreateOrder) GetCouponCode() string {
+	if m != nil {
+		return m.CouponCode
+	}
+	return ""
+}
+
 type MsgCreateOrderResponse struct {
 	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
@@ -1041,1577 +1053,5945 @@ func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateOrder)(nil), "stateset.core.orders.MsgCreateOrder")
-	proto.RegisterType((*MsgCreateOrderResponse)(nil), "stateset.core.orders.MsgCreateOrderResponse")
-	proto.RegisterType((*MsgConfirmOrder)(nil), "stateset.core.orders.MsgConfirmOrder")
-	proto.RegisterType((*MsgConfirmOrderResponse)(nil), "stateset.core.orders.MsgConfirmOrderResponse")
-	proto.RegisterType((*MsgPayOrder)(nil), "stateset.core.orders.MsgPayOrder")
-	proto.RegisterType((*MsgPayOrderResponse)(nil), "stateset.core.orders.MsgPayOrderResponse")
-	proto.RegisterType((*MsgShipOrder)(nil), "stateset.core.orders.MsgShipOrder")
-	proto.RegisterType((*MsgShipOrderResponse)(nil), "stateset.core.orders.MsgShipOrderResponse")
-	proto.RegisterType((*MsgDeliverOrder)(nil), "stateset.core.orders.MsgDeliverOrder")
-	proto.RegisterType((*MsgDeliverOrderResponse)(nil), "stateset.core.orders.MsgDeliverOrderResponse")
-	proto.RegisterType((*MsgCompleteOrder)(nil), "stateset.core.orders.MsgCompleteOrder")
-	proto.RegisterType((*MsgCompleteOrderResponse)(nil), "stateset.core.orders.MsgCompleteOrderResponse")
-	proto.RegisterType((*MsgCancelOrder)(nil), "stateset.core.orders.MsgCancelOrder")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "stateset.core.orders.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgRefundOrder)(nil), "stateset.core.orders.MsgRefundOrder")
-	proto.RegisterType((*MsgRefundOrderResponse)(nil), "stateset.core.orders.MsgRefundOrderResponse")
-	proto.RegisterType((*MsgOpenDispute)(nil), "stateset.core.orders.MsgOpenDispute")
-	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "stateset.core.orders.MsgOpenDisputeResponse")
-	proto.RegisterType((*MsgResolveDispute)(nil), "stateset.core.orders.MsgResolveDispute")
-	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "stateset.core.orders.MsgResolveDisputeResponse")
+// MsgRegisterProduct adds a SKU to the merchant's catalog.
+type MsgRegisterProduct struct {
+	Merchant    string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Sku         string                                  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name        string                                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"price"`
+	Variants    []ProductVariant                        `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants"`
+	Stock       uint64                                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Metadata    string                                  `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TaxCategory string                                  `protobuf:"bytes,8,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+}
+
+func (m *MsgRegisterProduct) Reset()         { *m = MsgRegisterProduct{} }
+func (m *MsgRegisterProduct) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProduct) ProtoMessage()    {}
+func (*MsgRegisterProduct) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{20}
+}
+func (m *MsgRegisterProduct) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("stateset/core/orders/tx.proto", fileDescriptor_7cd23e14519159cb) }
-
-var fileDescriptor_7cd23e14519159cb = []byte{
-	// 955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0x8f, 0x62, 0x27, 0xb1, 0x9f, 0x93, 0x14, 0x44, 0x08, 0x8a, 0x20, 0xb6, 0xab, 0x01, 0x12,
-	0x18, 0x2a, 0x4d, 0xd3, 0x03, 0x07, 0x4e, 0x4d, 0xc2, 0x0c, 0x3e, 0x98, 0x32, 0xe2, 0xc2, 0xd0,
-	0x83, 0x47, 0x96, 0xd6, 0x8a, 0xa8, 0xa5, 0xd5, 0xec, 0xae, 0x42, 0x73, 0xe5, 0xc4, 0x91, 0x2f,
-	0xc0, 0xe7, 0xa1, 0xc7, 0x1e, 0x19, 0x0e, 0x1d, 0x26, 0x39, 0x71, 0x83, 0x6f, 0xc0, 0xec, 0xae,
-	0xb4, 0x5e, 0x3b, 0xb6, 0xe3, 0x69, 0xa7, 0x27, 0x7b, 0xdf, 0xfe, 0xf6, 0xfd, 0xde, 0xbf, 0xfd,
-	0xad, 0xe0, 0x90, 0xb2, 0x80, 0x21, 0x8a, 0x98, 0x17, 0x62, 0x82, 0x3c, 0x4c, 0x22, 0x44, 0xa8,
-	0xc7, 0x9e, 0xbb, 0x39, 0xc1, 0x0c, 0x9b, 0x7b, 0xd5, 0xb6, 0xcb, 0xb7, 0x5d, 0xb9, 0x6d, 0xef,
-	0xc5, 0x38, 0xc6, 0x02, 0xe0, 0xf1, 0x7f, 0x12, 0x6b, 0xb7, 0x43, 0x4c, 0x53, 0x4c, 0xbd, 0x61,
-	0x40, 0x91, 0x77, 0xf9, 0x70, 0x88, 0x58, 0xf0, 0xd0, 0x0b, 0x71, 0x92, 0x95, 0xfb, 0xf7, 0xe7,
-	0x52, 0xc9, 0x1f, 0x09, 0x71, 0xfe, 0x35, 0x60, 0xb7, 0x4f, 0xe3, 0x33, 0x82, 0x02, 0x86, 0x9e,
-	0xf0, 0x1d, 0xd3, 0x86, 0x46, 0x58, 0x50, 0x86, 0x53, 0x44, 0x2c, 0xa3, 0x6b, 0x1c, 0x37, 0x7d,
-	0xb5, 0xe6, 0x7b, 0x29, 0x22, 0xe1, 0x45, 0x90, 0x31, 0x6b, 0x5d, 0xee, 0x55, 0x6b, 0xf3, 0x2b,
-	0xd8, 0x48, 0x18, 0x4a, 0xa9, 0x55, 0xeb, 0xd6, 0x8e, 0x5b, 0x27, 0x1d, 0x77, 0x5e, 0x26, 0xae,
-	0xe0, 0xe8, 0x31, 0x94, 0x9e, 0xd6, 0x5f, 0xbc, 0xea, 0xac, 0xf9, 0xf2, 0x8c, 0xd9, 0x87, 0x1d,
-	0x7a, 0x91, 0xe4, 0x79, 0x92, 0xc5, 0x83, 0x24, 0x1b, 0x61, 0xab, 0xde, 0x35, 0x8e, 0x5b, 0x27,
-	0xce, 0x7c, 0x27, 0xdf, 0x97, 0xd0, 0x5e, 0x36, 0xc2, 0xa5, 0x9f, 0x6d, 0xaa, 0xd9, 0x64, 0x9c,
-	0x2c, 0x88, 0x02, 0x16, 0x58, 0x1b, 0x55, 0x9c, 0x72, 0xed, 0x3c, 0x82, 0xfd, 0xe9, 0x8c, 0x7d,
-	0x44, 0x73, 0x9c, 0x51, 0x64, 0x1e, 0x40, 0x43, 0x10, 0x0c, 0x92, 0x48, 0x64, 0x5e, 0xf7, 0xb7,
-	0xc4, 0xba, 0x17, 0x39, 0xdf, 0xc0, 0x3d, 0x7e, 0x08, 0x67, 0xa3, 0x84, 0xa4, 0xaa, 0x4e, 0xaa,
-	0x16, 0xc6, 0x4c, 0x2d, 0x74, 0x4f, 0xeb, 0xd3, 0x9e, 0x0e, 0xe0, 0x83, 0x19, 0x4f, 0x15, 0xbf,
-	0xf3, 0x87, 0x01, 0xad, 0x3e, 0x8d, 0xbf, 0x0b, 0xae, 0xee, 0xee, 0xc4, 0x62, 0x06, 0x73, 0x08,
-	0x9b, 0x41, 0x8a, 0x8b, 0x8c, 0x59, 0x35, 0x51, 0xc4, 0x03, 0x57, 0xce, 0x89, 0xcb, 0xe7, 0xc4,
-	0x2d, 0xe7, 0xc4, 0x3d, 0xc3, 0x49, 0x76, 0xea, 0xf1, 0xda, 0xfd, 0xf5, 0xaa, 0x73, 0x14, 0x27,
-	0xec, 0xa2, 0x18, 0xba, 0x21, 0x4e, 0xbd, 0x72, 0xa8, 0xe4, 0xcf, 0x03, 0x1a, 0x3d, 0xf3, 0xd8,
-	0x55, 0x8e, 0xa8, 0x38, 0xe0, 0x97, 0x9e, 0xcd, 0x43, 0x80, 0x82, 0xa2, 0x01, 0xa2, 0x21, 0xc1,
-	0x3f, 0x8b, 0x66, 0x35, 0xfc, 0x66, 0x41, 0xd1, 0xd7, 0xc2, 0xe0, 0xbc, 0x0f, 0xef, 0x69, 0x89,
-	0xa8, 0x04, 0x7f, 0x35, 0x60, 0xbb, 0x4f, 0x63, 0xde, 0xbe, 0x37, 0xa9, 0xa1, 0x69, 0xc1, 0x56,
-	0x18, 0x10, 0x92, 0x20, 0x22, 0x52, 0x6c, 0xfa, 0xd5, 0xd2, 0x3c, 0x82, 0x7b, 0x8c, 0x04, 0xe1,
-	0x33, 0x3e, 0x47, 0x59, 0x91, 0x0e, 0x11, 0x11, 0xc1, 0x35, 0xfd, 0xdd, 0xca, 0xfc, 0xad, 0xb0,
-	0x3a, 0xfb, 0xb0, 0xa7, 0x47, 0xa2, 0x42, 0x3c, 0x17, 0x8d, 0x3e, 0x47, 0xe3, 0xe4, 0x12, 0x11,
-	0x19, 0xe4, 0x3e, 0x6c, 0xd2, 0x24, 0xce, 0x54, 0x13, 0xca, 0xd5, 0xdd, 0x4d, 0xd6, 0xbd, 0x28,
-	0x82, 0x1e, 0xbc, 0x23, 0xfa, 0x9f, 0xe6, 0x63, 0xb4, 0xca, 0x95, 0x5b, 0xc2, 0x62, 0x83, 0x35,
-	0xeb, 0x4a, 0xd1, 0x3c, 0x95, 0xf7, 0x3a, 0xc8, 0x42, 0x34, 0x7e, 0xdd, 0x34, 0xf8, 0x11, 0x82,
-	0x02, 0x8a, 0xb3, 0xb2, 0xcc, 0xe5, 0xca, 0xb1, 0xe4, 0x15, 0x9a, 0x38, 0x57, 0xb4, 0xff, 0x49,
-	0x3d, 0xf1, 0xd1, 0xa8, 0xc8, 0xa2, 0x37, 0xea, 0x31, 0x86, 0x1d, 0x22, 0xbc, 0x0c, 0xde, 0xda,
-	0x30, 0x6f, 0x4b, 0x82, 0xc7, 0x72, 0xa4, 0x27, 0xc9, 0xd6, 0xf5, 0x64, 0xcd, 0x0e, 0xb4, 0x46,
-	0xc5, 0x78, 0x3c, 0x90, 0x60, 0x21, 0x27, 0x0d, 0x1f, 0xb8, 0x49, 0x66, 0x59, 0x56, 0x43, 0x4b,
-	0x59, 0x55, 0xe3, 0x77, 0x59, 0x8d, 0x27, 0x39, 0xca, 0xce, 0x13, 0x9a, 0x17, 0x0c, 0xbd, 0xee,
-	0x9d, 0x5e, 0xd0, 0x09, 0xb3, 0x0b, 0xad, 0x88, 0x5f, 0xc2, 0x24, 0x67, 0x89, 0x8a, 0x5c, 0x37,
-	0x71, 0x42, 0x74, 0x99, 0x44, 0x28, 0x0b, 0x91, 0xb5, 0xd1, 0xad, 0x71, 0xc2, 0x6a, 0xed, 0x7c,
-	0x29, 0x22, 0xd7, 0xc2, 0x53, 0x52, 0x78, 0x08, 0x10, 0x49, 0xd3, 0x44, 0x0c, 0x9b, 0xa5, 0xa5,
-	0x17, 0x39, 0xbf, 0xac, 0xc3, 0xbb, 0x22, 0x67, 0x8a, 0xc7, 0x97, 0xa8, 0xca, 0xed, 0x23, 0x68,
-	0x06, 0x05, 0xbb, 0xc0, 0x24, 0x61, 0x57, 0x65, 0x72, 0x13, 0xc3, 0x8c, 0xcb, 0xf5, 0x19, 0x97,
-	0x66, 0x1b, 0x80, 0x70, 0x77, 0x85, 0x48, 0x44, 0x66, 0xa9, 0x59, 0x6e, 0xcf, 0x43, 0xfd, 0x2d,
-	0xcf, 0x43, 0x07, 0x5a, 0x0c, 0x0f, 0x54, 0xb3, 0xca, 0xbe, 0x33, 0x7c, 0x56, 0x5a, 0x9c, 0x0f,
-	0xe1, 0xe0, 0x56, 0x0d, 0xaa, 0x02, 0x9e, 0xfc, 0xb3, 0x05, 0xb5, 0x3e, 0x8d, 0xcd, 0x00, 0x5a,
-	0xfa, 0xe3, 0xfa, 0xf1, 0xfc, 0x07, 0x6d, 0xfa, 0x41, 0xb2, 0xbf, 0x58, 0x05, 0xa5, 0x7a, 0x15,
-	0xc1, 0xf6, 0xd4, 0xc3, 0xf4, 0xc9, 0xe2, 0xd3, 0x1a, 0xcc, 0x7e, 0xb0, 0x12, 0x4c, 0xb1, 0xfc,
-	0x00, 0x0d, 0xf5, 0x30, 0xdd, 0x5f, 0x78, 0xb4, 0x82, 0xd8, 0x9f, 0xdd, 0x09, 0x51, 0x9e, 0x9f,
-	0x42, 0x73, 0xf2, 0x22, 0x38, 0x0b, 0xcf, 0x29, 0x8c, 0xfd, 0xf9, 0xdd, 0x18, 0xbd, 0x38, 0x53,
-	0x62, 0xbe, 0xb8, 0x38, 0x3a, 0x6c, 0x49, 0x71, 0xe6, 0x89, 0xba, 0x19, 0xc3, 0xce, 0xb4, 0xa2,
-	0x7f, 0xba, 0xa4, 0xb8, 0x1a, 0xce, 0x76, 0x57, 0xc3, 0x29, 0x22, 0x3e, 0x4e, 0x9a, 0xa6, 0x2f,
-	0x19, 0xa7, 0x09, 0x6a, 0xd9, 0x38, 0xdd, 0x96, 0x70, 0x4e, 0xa1, 0xcb, 0xf7, 0x62, 0x0a, 0x0d,
-	0xb5, 0x84, 0x62, 0x8e, 0x2e, 0x72, 0x0a, 0x5d, 0x13, 0x17, 0x53, 0x68, 0xa8, 0x25, 0x14, 0xf3,
-	0x04, 0xec, 0x27, 0xd8, 0x9d, 0x51, 0xa7, 0xa3, 0x25, 0x21, 0xea, 0x40, 0xdb, 0x5b, 0x11, 0x58,
-	0x71, 0x9d, 0x3e, 0x7e, 0x71, 0xdd, 0x36, 0x5e, 0x5e, 0xb7, 0x8d, 0xbf, 0xaf, 0xdb, 0xc6, 0x6f,
-	0x37, 0xed, 0xb5, 0x97, 0x37, 0xed, 0xb5, 0x3f, 0x6f, 0xda, 0x6b, 0x3f, 0xea, 0xd2, 0x33, 0xfd,
-	0x31, 0xfe, 0x5c, 0x7d, 0xf9, 0x73, 0xfd, 0x19, 0x6e, 0x8a, 0xcf, 0xf1, 0x47, 0xff, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xea, 0x21, 0xee, 0x1d, 0x1e, 0x0c, 0x00, 0x00,
+func (m *MsgRegisterProduct) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterProduct.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterProduct) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProduct.Merge(m, src)
+}
+func (m *MsgRegisterProduct) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterProduct) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProduct.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterProduct proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateOrder(ctx context.Context, in *MsgCreateOrder, opts ...grpc.CallOption) (*MsgCreateOrderResponse, error)
-	ConfirmOrder(ctx context.Context, in *MsgConfirmOrder, opts ...grpc.CallOption) (*MsgConfirmOrderResponse, error)
-	PayOrder(ctx context.Context, in *MsgPayOrder, opts ...grpc.CallOption) (*MsgPayOrderResponse, error)
-	ShipOrder(ctx context.Context, in *MsgShipOrder, opts ...grpc.CallOption) (*MsgShipOrderResponse, error)
-	DeliverOrder(ctx context.Context, in *MsgDeliverOrder, opts ...grpc.CallOption) (*MsgDeliverOrderResponse, error)
-	CompleteOrder(ctx context.Context, in *MsgCompleteOrder, opts ...grpc.CallOption) (*MsgCompleteOrderResponse, error)
-	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	RefundOrder(ctx context.Context, in *MsgRefundOrder, opts ...grpc.CallOption) (*MsgRefundOrderResponse, error)
-	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
-	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+func (m *MsgRegisterProduct) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterProduct) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterProduct) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (c *msgClient) CreateOrder(ctx context.Context, in *MsgCreateOrder, opts ...grpc.CallOption) (*MsgCreateOrderResponse, error) {
-	out := new(MsgCreateOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CreateOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterProduct) GetVariants() []ProductVariant {
+	if m != nil {
+		return m.Variants
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) ConfirmOrder(ctx context.Context, in *MsgConfirmOrder, opts ...grpc.CallOption) (*MsgConfirmOrderResponse, error) {
-	out := new(MsgConfirmOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ConfirmOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterProduct) GetStock() uint64 {
+	if m != nil {
+		return m.Stock
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) PayOrder(ctx context.Context, in *MsgPayOrder, opts ...grpc.CallOption) (*MsgPayOrderResponse, error) {
-	out := new(MsgPayOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/PayOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterProduct) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ShipOrder(ctx context.Context, in *MsgShipOrder, opts ...grpc.CallOption) (*MsgShipOrderResponse, error) {
-	out := new(MsgShipOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ShipOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterProduct) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeliverOrder(ctx context.Context, in *MsgDeliverOrder, opts ...grpc.CallOption) (*MsgDeliverOrderResponse, error) {
-	out := new(MsgDeliverOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/DeliverOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgRegisterProductResponse struct {
 }
 
-func (c *msgClient) CompleteOrder(ctx context.Context, in *MsgCompleteOrder, opts ...grpc.CallOption) (*MsgCompleteOrderResponse, error) {
-	out := new(MsgCompleteOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CompleteOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterProductResponse) Reset()         { *m = MsgRegisterProductResponse{} }
+func (m *MsgRegisterProductResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProductResponse) ProtoMessage()    {}
+func (*MsgRegisterProductResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{21}
+}
+func (m *MsgRegisterProductResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterProductResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterProductResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProductResponse.Merge(m, src)
+}
+func (m *MsgRegisterProductResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterProductResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProductResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterProductResponse proto.InternalMessageInfo
+
+// MsgUpdateProduct replaces a product's name, price, variants, active flag and
+// metadata. Stock is changed with MsgAdjustInventory; variants keep their stock
+// and reservations by ID.
+type MsgUpdateProduct struct {
+	Merchant    string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Sku         string                                  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name        string                                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"price"`
+	Variants    []ProductVariant                        `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants"`
+	Active      bool                                    `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	Metadata    string                                  `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TaxCategory string                                  `protobuf:"bytes,8,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
 }
 
-func (c *msgClient) RefundOrder(ctx context.Context, in *MsgRefundOrder, opts ...grpc.CallOption) (*MsgRefundOrderResponse, error) {
-	out := new(MsgRefundOrderResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/RefundOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProduct) Reset()         { *m = MsgUpdateProduct{} }
+func (m *MsgUpdateProduct) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProduct) ProtoMessage()    {}
+func (*MsgUpdateProduct) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{22}
+}
+func (m *MsgUpdateProduct) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProduct) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProduct.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateProduct) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProduct.Merge(m, src)
+}
+func (m *MsgUpdateProduct) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProduct) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProduct.DiscardUnknown(m)
 }
 
-func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
-	out := new(MsgOpenDisputeResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/OpenDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateProduct proto.InternalMessageInfo
+
+func (m *MsgUpdateProduct) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
-	out := new(MsgResolveDisputeResponse)
-	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ResolveDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProduct) GetSku() string {
+	if m != nil {
+		return m.Sku
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateOrder(context.Context, *MsgCreateOrder) (*MsgCreateOrderResponse, error)
-	ConfirmOrder(context.Context, *MsgConfirmOrder) (*MsgConfirmOrderResponse, error)
-	PayOrder(context.Context, *MsgPayOrder) (*MsgPayOrderResponse, error)
-	ShipOrder(context.Context, *MsgShipOrder) (*MsgShipOrderResponse, error)
-	DeliverOrder(context.Context, *MsgDeliverOrder) (*MsgDeliverOrderResponse, error)
-	CompleteOrder(context.Context, *MsgCompleteOrder) (*MsgCompleteOrderResponse, error)
-	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
-	RefundOrder(context.Context, *MsgRefundOrder) (*MsgRefundOrderResponse, error)
-	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
-	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+func (m *MsgUpdateProduct) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateProduct) GetVariants() []ProductVariant {
+	if m != nil {
+		return m.Variants
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) CreateOrder(ctx context.Context, req *MsgCreateOrder) (*MsgCreateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+func (m *MsgUpdateProduct) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
 }
-func (*UnimplementedMsgServer) ConfirmOrder(ctx context.Context, req *MsgConfirmOrder) (*MsgConfirmOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+
+func (m *MsgUpdateProduct) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) PayOrder(ctx context.Context, req *MsgPayOrder) (*MsgPayOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+
+func (m *MsgUpdateProduct) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) ShipOrder(ctx context.Context, req *MsgShipOrder) (*MsgShipOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShipOrder not implemented")
+
+type MsgUpdateProductResponse struct {
 }
-func (*UnimplementedMsgServer) DeliverOrder(ctx context.Context, req *MsgDeliverOrder) (*MsgDeliverOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeliverOrder not implemented")
+
+func (m *MsgUpdateProductResponse) Reset()         { *m = MsgUpdateProductResponse{} }
+func (m *MsgUpdateProductResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProductResponse) ProtoMessage()    {}
+func (*MsgUpdateProductResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{23}
 }
-func (*UnimplementedMsgServer) CompleteOrder(ctx context.Context, req *MsgCompleteOrder) (*MsgCompleteOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
+func (m *MsgUpdateProductResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *MsgUpdateProductResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProductResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RefundOrder(ctx context.Context, req *MsgRefundOrder) (*MsgRefundOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+func (m *MsgUpdateProductResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProductResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+func (m *MsgUpdateProductResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+func (m *MsgUpdateProductResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProductResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateProductResponse proto.InternalMessageInfo
+
+// MsgAdjustInventory adds delta to the stock of a product or of one of its
+// variants. Stock cannot drop below the quantity reserved by open orders.
+type MsgAdjustInventory struct {
+	Merchant string `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Sku      string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Variant  string `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	Delta    int64  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
-func _Msg_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/CreateOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateOrder(ctx, req.(*MsgCreateOrder))
+func (m *MsgAdjustInventory) Reset()         { *m = MsgAdjustInventory{} }
+func (m *MsgAdjustInventory) String() string { return proto.CompactTextString(m) }
+func (*MsgAdjustInventory) ProtoMessage()    {}
+func (*MsgAdjustInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{24}
+}
+func (m *MsgAdjustInventory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdjustInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdjustInventory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAdjustInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdjustInventory.Merge(m, src)
+}
+func (m *MsgAdjustInventory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdjustInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdjustInventory.DiscardUnknown(m)
 }
 
-func _Msg_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/ConfirmOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmOrder(ctx, req.(*MsgConfirmOrder))
+var xxx_messageInfo_MsgAdjustInventory proto.InternalMessageInfo
+
+func (m *MsgAdjustInventory) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPayOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PayOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/PayOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PayOrder(ctx, req.(*MsgPayOrder))
+func (m *MsgAdjustInventory) GetSku() string {
+	if m != nil {
+		return m.Sku
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgShipOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ShipOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/ShipOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ShipOrder(ctx, req.(*MsgShipOrder))
+func (m *MsgAdjustInventory) GetVariant() string {
+	if m != nil {
+		return m.Variant
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeliverOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeliverOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeliverOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/DeliverOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeliverOrder(ctx, req.(*MsgDeliverOrder))
+func (m *MsgAdjustInventory) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/CompleteOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteOrder(ctx, req.(*MsgCompleteOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAdjustInventoryResponse struct {
+	Stock uint64 `protobuf:"varint,1,opt,name=stock,proto3" json:"stock,omitempty"`
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+func (m *MsgAdjustInventoryResponse) Reset()         { *m = MsgAdjustInventoryResponse{} }
+func (m *MsgAdjustInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAdjustInventoryResponse) ProtoMessage()    {}
+func (*MsgAdjustInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{25}
+}
+func (m *MsgAdjustInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAdjustInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAdjustInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAdjustInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAdjustInventoryResponse.Merge(m, src)
+}
+func (m *MsgAdjustInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAdjustInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAdjustInventoryResponse.DiscardUnknown(m)
 }
 
-func _Msg_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefundOrder)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAdjustInventoryResponse proto.InternalMessageInfo
+
+func (m *MsgAdjustInventoryResponse) GetStock() uint64 {
+	if m != nil {
+		return m.Stock
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RefundOrder(ctx, in)
+	return 0
+}
+
+// MsgCreatePromotion defines a coupon code customers can apply to orders
+// placed with the merchant.
+type MsgCreatePromotion struct {
+	Merchant                  string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Code                      string                                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType              string                                  `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	PercentageBps             uint32                                  `protobuf:"varint,4,opt,name=percentage_bps,json=percentageBps,proto3" json:"percentage_bps,omitempty"`
+	FixedAmount               github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=fixed_amount,json=fixedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fixed_amount"`
+	MinSubtotal               github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=min_subtotal,json=minSubtotal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_subtotal"`
+	MaxRedemptions            uint64                                  `protobuf:"varint,7,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxRedemptionsPerCustomer uint64                                  `protobuf:"varint,8,opt,name=max_redemptions_per_customer,json=maxRedemptionsPerCustomer,proto3" json:"max_redemptions_per_customer,omitempty"`
+	Skus                      []string                                `protobuf:"bytes,9,rep,name=skus,proto3" json:"skus,omitempty"`
+	StartsAt                  time.Time                               `protobuf:"bytes,10,opt,name=starts_at,json=startsAt,proto3,stdtime" json:"starts_at"`
+	EndsAt                    time.Time                               `protobuf:"bytes,11,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at"`
+}
+
+func (m *MsgCreatePromotion) Reset()         { *m = MsgCreatePromotion{} }
+func (m *MsgCreatePromotion) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePromotion) ProtoMessage()    {}
+func (*MsgCreatePromotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{26}
+}
+func (m *MsgCreatePromotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePromotion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/RefundOrder",
+}
+func (m *MsgCreatePromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePromotion.Merge(m, src)
+}
+func (m *MsgCreatePromotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePromotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePromotion proto.InternalMessageInfo
+
+func (m *MsgCreatePromotion) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RefundOrder(ctx, req.(*MsgRefundOrder))
+	return ""
+}
+
+func (m *MsgCreatePromotion) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenDispute)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreatePromotion) GetDiscountType() string {
+	if m != nil {
+		return m.DiscountType
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenDispute(ctx, in)
+	return ""
+}
+
+func (m *MsgCreatePromotion) GetPercentageBps() uint32 {
+	if m != nil {
+		return m.PercentageBps
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/OpenDispute",
+	return 0
+}
+
+func (m *MsgCreatePromotion) GetMaxRedemptions() uint64 {
+	if m != nil {
+		return m.MaxRedemptions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	return 0
+}
+
+func (m *MsgCreatePromotion) GetMaxRedemptionsPerCustomer() uint64 {
+	if m != nil {
+		return m.MaxRedemptionsPerCustomer
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResolveDispute)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreatePromotion) GetSkus() []string {
+	if m != nil {
+		return m.Skus
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResolveDispute(ctx, in)
+	return nil
+}
+
+func (m *MsgCreatePromotion) GetStartsAt() time.Time {
+	if m != nil {
+		return m.StartsAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.core.orders.Msg/ResolveDispute",
+	return time.Time{}
+}
+
+func (m *MsgCreatePromotion) GetEndsAt() time.Time {
+	if m != nil {
+		return m.EndsAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	return time.Time{}
+}
+
+type MsgCreatePromotionResponse struct {
+}
+
+func (m *MsgCreatePromotionResponse) Reset()         { *m = MsgCreatePromotionResponse{} }
+func (m *MsgCreatePromotionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePromotionResponse) ProtoMessage()    {}
+func (*MsgCreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{27}
+}
+func (m *MsgCreatePromotionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePromotionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePromotionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreatePromotionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePromotionResponse.Merge(m, src)
+}
+func (m *MsgCreatePromotionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePromotionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePromotionResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stateset.core.orders.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateOrder",
-			Handler:    _Msg_CreateOrder_Handler,
-		},
-		{
-			MethodName: "ConfirmOrder",
-			Handler:    _Msg_ConfirmOrder_Handler,
-		},
-		{
-			MethodName: "PayOrder",
-			Handler:    _Msg_PayOrder_Handler,
-		},
-		{
-			MethodName: "ShipOrder",
-			Handler:    _Msg_ShipOrder_Handler,
-		},
-		{
-			MethodName: "DeliverOrder",
-			Handler:    _Msg_DeliverOrder_Handler,
-		},
-		{
-			MethodName: "CompleteOrder",
-			Handler:    _Msg_CompleteOrder_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "RefundOrder",
-			Handler:    _Msg_RefundOrder_Handler,
-		},
-		{
-			MethodName: "OpenDispute",
-			Handler:    _Msg_OpenDispute_Handler,
-		},
-		{
-			MethodName: "ResolveDispute",
-			Handler:    _Msg_ResolveDispute_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stateset/core/orders/tx.proto",
-}
+var xxx_messageInfo_MsgCreatePromotionResponse proto.InternalMessageInfo
 
-func (m *MsgCreateOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgDeactivatePromotion stops a coupon code from being applied to new orders.
+type MsgDeactivatePromotion struct {
+	Merchant string `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *MsgCreateOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeactivatePromotion) Reset()         { *m = MsgDeactivatePromotion{} }
+func (m *MsgDeactivatePromotion) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivatePromotion) ProtoMessage()    {}
+func (*MsgDeactivatePromotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{28}
 }
-
-func (m *MsgCreateOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.ShippingInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDeactivatePromotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivatePromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivatePromotion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeactivatePromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivatePromotion.Merge(m, src)
+}
+func (m *MsgDeactivatePromotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivatePromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivatePromotion.DiscardUnknown(m)
 }
 
-func (m *MsgCreateOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeactivatePromotion proto.InternalMessageInfo
+
+func (m *MsgDeactivatePromotion) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeactivatePromotion) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *MsgCreateOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type MsgDeactivatePromotionResponse struct {
 }
 
-func (m *MsgConfirmOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeactivatePromotionResponse) Reset()         { *m = MsgDeactivatePromotionResponse{} }
+func (m *MsgDeactivatePromotionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeactivatePromotionResponse) ProtoMessage()    {}
+func (*MsgDeactivatePromotionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{29}
+}
+func (m *MsgDeactivatePromotionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeactivatePromotionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeactivatePromotionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeactivatePromotionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeactivatePromotionResponse.Merge(m, src)
+}
+func (m *MsgDeactivatePromotionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeactivatePromotionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeactivatePromotionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConfirmOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeactivatePromotionResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the module parameters (by authority).
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgConfirmOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{30}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgConfirmOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConfirmOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgConfirmOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgPayOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{31}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPayOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetTaxRate adds or replaces a tax rate (by authority).
+type MsgSetTaxRate struct {
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Rate      TaxRate `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate"`
 }
 
-func (m *MsgPayOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseEscrow {
-		i--
-		if m.UseEscrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetTaxRate) Reset()         { *m = MsgSetTaxRate{} }
+func (m *MsgSetTaxRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTaxRate) ProtoMessage()    {}
+func (*MsgSetTaxRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{32}
 }
-
-func (m *MsgPayOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTaxRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTaxRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTaxRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPayOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTaxRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTaxRate.Merge(m, src)
 }
-
-func (m *MsgPayOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetTaxRate) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgShipOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetTaxRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTaxRate.DiscardUnknown(m)
 }
 
-func (m *MsgShipOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgSetTaxRate proto.InternalMessageInfo
 
-func (m *MsgShipOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrackingNumber) > 0 {
-		i -= len(m.TrackingNumber)
-		copy(dAtA[i:], m.TrackingNumber)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrackingNumber)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Carrier) > 0 {
-		i -= len(m.Carrier)
-		copy(dAtA[i:], m.Carrier)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Carrier)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetTaxRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgShipOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTaxRate) GetRate() TaxRate {
+	if m != nil {
+		return m.Rate
 	}
-	return dAtA[:n], nil
+	return TaxRate{}
 }
 
-func (m *MsgShipOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetTaxRateResponse struct {
 }
 
-func (m *MsgShipOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetTaxRateResponse) Reset()         { *m = MsgSetTaxRateResponse{} }
+func (m *MsgSetTaxRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTaxRateResponse) ProtoMessage()    {}
+func (*MsgSetTaxRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{33}
 }
-
-func (m *MsgDeliverOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetTaxRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTaxRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTaxRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeliverOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetTaxRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTaxRateResponse.Merge(m, src)
+}
+func (m *MsgSetTaxRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTaxRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTaxRateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeliverOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetTaxRateResponse proto.InternalMessageInfo
+
+// MsgRemoveTaxRate removes a tax rate (by authority).
+type MsgRemoveTaxRate struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Country    string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State      string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode string `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Category   string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
 }
 
-func (m *MsgDeliverOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveTaxRate) Reset()         { *m = MsgRemoveTaxRate{} }
+func (m *MsgRemoveTaxRate) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTaxRate) ProtoMessage()    {}
+func (*MsgRemoveTaxRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{34}
+}
+func (m *MsgRemoveTaxRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTaxRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTaxRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeliverOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveTaxRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTaxRate.Merge(m, src)
 }
-
-func (m *MsgDeliverOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveTaxRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTaxRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTaxRate.DiscardUnknown(m)
 }
 
-func (m *MsgCompleteOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveTaxRate proto.InternalMessageInfo
+
+func (m *MsgRemoveTaxRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCompleteOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveTaxRate) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
 }
 
-func (m *MsgCompleteOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgRemoveTaxRate) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRemoveTaxRate) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCompleteOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveTaxRate) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCompleteOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRemoveTaxRateResponse struct {
 }
 
-func (m *MsgCompleteOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveTaxRateResponse) Reset()         { *m = MsgRemoveTaxRateResponse{} }
+func (m *MsgRemoveTaxRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTaxRateResponse) ProtoMessage()    {}
+func (*MsgRemoveTaxRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd23e14519159cb, []int{35}
 }
-
-func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveTaxRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTaxRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTaxRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveTaxRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTaxRateResponse.Merge(m, src)
+}
+func (m *MsgRemoveTaxRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTaxRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTaxRateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveTaxRateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateOrder)(nil), "stateset.core.orders.MsgCreateOrder")
+	proto.RegisterType((*MsgCreateOrderResponse)(nil), "stateset.core.orders.MsgCreateOrderResponse")
+	proto.RegisterType((*MsgConfirmOrder)(nil), "stateset.core.orders.MsgConfirmOrder")
+	proto.RegisterType((*MsgConfirmOrderResponse)(nil), "stateset.core.orders.MsgConfirmOrderResponse")
+	proto.RegisterType((*MsgPayOrder)(nil), "stateset.core.orders.MsgPayOrder")
+	proto.RegisterType((*MsgPayOrderResponse)(nil), "stateset.core.orders.MsgPayOrderResponse")
+	proto.RegisterType((*MsgShipOrder)(nil), "stateset.core.orders.MsgShipOrder")
+	proto.RegisterType((*MsgShipOrderResponse)(nil), "stateset.core.orders.MsgShipOrderResponse")
+	proto.RegisterType((*MsgDeliverOrder)(nil), "stateset.core.orders.MsgDeliverOrder")
+	proto.RegisterType((*MsgDeliverOrderResponse)(nil), "stateset.core.orders.MsgDeliverOrderResponse")
+	proto.RegisterType((*MsgCompleteOrder)(nil), "stateset.core.orders.MsgCompleteOrder")
+	proto.RegisterType((*MsgCompleteOrderResponse)(nil), "stateset.core.orders.MsgCompleteOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "stateset.core.orders.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "stateset.core.orders.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgRefundOrder)(nil), "stateset.core.orders.MsgRefundOrder")
+	proto.RegisterType((*MsgRefundOrderResponse)(nil), "stateset.core.orders.MsgRefundOrderResponse")
+	proto.RegisterType((*MsgOpenDispute)(nil), "stateset.core.orders.MsgOpenDispute")
+	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "stateset.core.orders.MsgOpenDisputeResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "stateset.core.orders.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "stateset.core.orders.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgRegisterProduct)(nil), "stateset.core.orders.MsgRegisterProduct")
+	proto.RegisterType((*MsgRegisterProductResponse)(nil), "stateset.core.orders.MsgRegisterProductResponse")
+	proto.RegisterType((*MsgUpdateProduct)(nil), "stateset.core.orders.MsgUpdateProduct")
+	proto.RegisterType((*MsgUpdateProductResponse)(nil), "stateset.core.orders.MsgUpdateProductResponse")
+	proto.RegisterType((*MsgAdjustInventory)(nil), "stateset.core.orders.MsgAdjustInventory")
+	proto.RegisterType((*MsgAdjustInventoryResponse)(nil), "stateset.core.orders.MsgAdjustInventoryResponse")
+	proto.RegisterType((*MsgCreatePromotion)(nil), "stateset.core.orders.MsgCreatePromotion")
+	proto.RegisterType((*MsgCreatePromotionResponse)(nil), "stateset.core.orders.MsgCreatePromotionResponse")
+	proto.RegisterType((*MsgDeactivatePromotion)(nil), "stateset.core.orders.MsgDeactivatePromotion")
+	proto.RegisterType((*MsgDeactivatePromotionResponse)(nil), "stateset.core.orders.MsgDeactivatePromotionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stateset.core.orders.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stateset.core.orders.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTaxRate)(nil), "stateset.core.orders.MsgSetTaxRate")
+	proto.RegisterType((*MsgSetTaxRateResponse)(nil), "stateset.core.orders.MsgSetTaxRateResponse")
+	proto.RegisterType((*MsgRemoveTaxRate)(nil), "stateset.core.orders.MsgRemoveTaxRate")
+	proto.RegisterType((*MsgRemoveTaxRateResponse)(nil), "stateset.core.orders.MsgRemoveTaxRateResponse")
 }
 
-func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("stateset/core/orders/tx.proto", fileDescriptor_7cd23e14519159cb) }
+
+var fileDescriptor_7cd23e14519159cb = []byte{
+	// 1701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x8f, 0x13, 0x27, 0xb1, 0x9f, 0x9d, 0x64, 0xe8, 0xcd, 0x66, 0x1d, 0x93, 0x38, 0x99, 0x5e,
+	0x66, 0x27, 0xc0, 0xae, 0xcd, 0x66, 0x91, 0x56, 0x02, 0x21, 0x94, 0x0f, 0xd0, 0xe6, 0x10, 0x36,
+	0xea, 0x1d, 0x10, 0x62, 0x25, 0x4c, 0xb9, 0xbb, 0xec, 0xf4, 0xc6, 0xdd, 0xd5, 0xaa, 0xaa, 0x36,
+	0xf6, 0x95, 0x13, 0xc7, 0xe5, 0x0f, 0xe0, 0xef, 0x61, 0xb9, 0xed, 0x05, 0x09, 0x71, 0x58, 0xd0,
+	0xcc, 0x1d, 0x89, 0xff, 0x00, 0xd5, 0x47, 0x97, 0xbb, 0x1d, 0x77, 0xdb, 0x33, 0x99, 0x39, 0xed,
+	0xc9, 0x5d, 0xaf, 0x7e, 0xf5, 0xbe, 0xea, 0xd5, 0xfb, 0x30, 0x1c, 0x32, 0x8e, 0x38, 0x66, 0x98,
+	0x77, 0x5c, 0x42, 0x71, 0x87, 0x50, 0x0f, 0x53, 0xd6, 0xe1, 0xe3, 0x76, 0x44, 0x09, 0x27, 0xd6,
+	0x6e, 0xb2, 0xdd, 0x16, 0xdb, 0x6d, 0xb5, 0xdd, 0xdc, 0x1d, 0x90, 0x01, 0x91, 0x80, 0x8e, 0xf8,
+	0x52, 0xd8, 0x66, 0xcb, 0x25, 0x2c, 0x20, 0xac, 0xd3, 0x43, 0x0c, 0x77, 0x46, 0x1f, 0xf6, 0x30,
+	0x47, 0x1f, 0x76, 0x5c, 0xe2, 0x87, 0x7a, 0xff, 0x68, 0x40, 0xc8, 0x60, 0x88, 0x3b, 0x72, 0xd5,
+	0x8b, 0xfb, 0x1d, 0xee, 0x07, 0x98, 0x71, 0x14, 0x44, 0x1a, 0xf0, 0x78, 0xae, 0x2e, 0xea, 0x47,
+	0x41, 0xec, 0xbf, 0xac, 0xc2, 0xf6, 0x35, 0x1b, 0x5c, 0x50, 0x8c, 0x38, 0xfe, 0x54, 0xec, 0x58,
+	0x4d, 0xa8, 0xb8, 0x31, 0xe3, 0x24, 0xc0, 0xb4, 0x51, 0x3a, 0x2e, 0x9d, 0x54, 0x1d, 0xb3, 0x16,
+	0x7b, 0x01, 0xa6, 0xee, 0x2d, 0x0a, 0x79, 0x63, 0x55, 0xed, 0x25, 0x6b, 0xeb, 0xa7, 0xb0, 0xee,
+	0x73, 0x1c, 0xb0, 0xc6, 0xda, 0xf1, 0xda, 0x49, 0xed, 0xf4, 0xa8, 0x3d, 0xcf, 0xd4, 0xb6, 0x94,
+	0x71, 0xc5, 0x71, 0x70, 0x5e, 0xfe, 0xea, 0x9b, 0xa3, 0x15, 0x47, 0x9d, 0xb1, 0xae, 0x61, 0x8b,
+	0xdd, 0xfa, 0x51, 0xe4, 0x87, 0x83, 0xae, 0x1f, 0xf6, 0x49, 0xa3, 0x7c, 0x5c, 0x3a, 0xa9, 0x9d,
+	0xda, 0xf3, 0x99, 0x7c, 0xa6, 0xa1, 0x57, 0x61, 0x9f, 0x68, 0x3e, 0x75, 0x96, 0xa2, 0x29, 0x3d,
+	0x39, 0xf2, 0x10, 0x47, 0x8d, 0xf5, 0x44, 0x4f, 0xb5, 0xb6, 0x8e, 0xa0, 0xe6, 0x92, 0x38, 0x22,
+	0x61, 0xd7, 0x25, 0x1e, 0x6e, 0x6c, 0xc8, 0x6d, 0x50, 0xa4, 0x0b, 0xe2, 0x61, 0xfb, 0x23, 0xd8,
+	0xcb, 0xba, 0xc4, 0xc1, 0x2c, 0x22, 0x21, 0xc3, 0xd6, 0x3e, 0x54, 0xa4, 0x06, 0x5d, 0xdf, 0x93,
+	0xae, 0x29, 0x3b, 0x9b, 0x72, 0x7d, 0xe5, 0xd9, 0x9f, 0xc0, 0x8e, 0x38, 0x44, 0xc2, 0xbe, 0x4f,
+	0x03, 0xe3, 0x48, 0xe3, 0xac, 0xd2, 0x8c, 0xb3, 0xd2, 0x9c, 0x56, 0xb3, 0x9c, 0xf6, 0xe1, 0x9d,
+	0x19, 0x4e, 0x89, 0x7c, 0xfb, 0x6f, 0x25, 0xa8, 0x5d, 0xb3, 0xc1, 0x0d, 0x9a, 0x2c, 0xbe, 0xaa,
+	0x7c, 0x09, 0x56, 0x0f, 0x36, 0x50, 0x40, 0xe2, 0x90, 0x37, 0xd6, 0xa4, 0x97, 0xf7, 0xdb, 0x2a,
+	0xd2, 0xda, 0x22, 0xd2, 0xda, 0x3a, 0xd2, 0xda, 0x17, 0xc4, 0x0f, 0xcf, 0x3b, 0xc2, 0xb9, 0xff,
+	0xfa, 0xe6, 0xe8, 0xe9, 0xc0, 0xe7, 0xb7, 0x71, 0xaf, 0xed, 0x92, 0xa0, 0xa3, 0xc3, 0x52, 0xfd,
+	0x7c, 0xc0, 0xbc, 0xbb, 0x0e, 0x9f, 0x44, 0x98, 0xc9, 0x03, 0x8e, 0xe6, 0x6c, 0x1d, 0x02, 0xc4,
+	0x0c, 0x77, 0x31, 0x73, 0x29, 0xf9, 0xa3, 0xbc, 0xcd, 0x8a, 0x53, 0x8d, 0x19, 0xfe, 0x85, 0x24,
+	0xd8, 0x6f, 0xc3, 0x5b, 0x29, 0x43, 0x8c, 0x81, 0x7f, 0x2e, 0x41, 0xfd, 0x9a, 0x0d, 0xc4, 0xfd,
+	0x3e, 0xc4, 0x87, 0x56, 0x03, 0x36, 0x5d, 0x44, 0xa9, 0x8f, 0xa9, 0x34, 0xb1, 0xea, 0x24, 0x4b,
+	0xeb, 0x29, 0xec, 0x70, 0x8a, 0xdc, 0x3b, 0x11, 0x68, 0x61, 0x1c, 0xf4, 0x30, 0x95, 0xca, 0x55,
+	0x9d, 0xed, 0x84, 0xfc, 0x2b, 0x49, 0xb5, 0xf7, 0x60, 0x37, 0xad, 0x89, 0x51, 0xf1, 0x52, 0x5e,
+	0xf4, 0x25, 0x1e, 0xfa, 0x23, 0x4c, 0x95, 0x92, 0x7b, 0xb0, 0xc1, 0xfc, 0x41, 0x68, 0x2e, 0x41,
+	0xaf, 0x16, 0x5f, 0x72, 0x9a, 0x8b, 0x11, 0x70, 0x05, 0x8f, 0xe4, 0xfd, 0x07, 0xd1, 0x10, 0x2f,
+	0xf3, 0x26, 0x0b, 0xa4, 0x34, 0xa1, 0x31, 0xcb, 0xca, 0x88, 0xf9, 0x5c, 0x3d, 0x7c, 0x14, 0xba,
+	0x78, 0xf8, 0xaa, 0x66, 0x88, 0x23, 0x14, 0x23, 0x46, 0x42, 0xed, 0x66, 0xbd, 0xb2, 0x1b, 0xea,
+	0x09, 0x4d, 0x99, 0x1b, 0xb1, 0xff, 0x2b, 0x49, 0xb9, 0x0e, 0xee, 0xc7, 0xa1, 0xf7, 0xa0, 0x3b,
+	0x26, 0xb0, 0x45, 0x25, 0x97, 0xee, 0x1b, 0x0b, 0xe6, 0xba, 0x12, 0x70, 0xa6, 0x42, 0x7a, 0x6a,
+	0x6c, 0x39, 0x6d, 0xac, 0x48, 0x28, 0xfd, 0x78, 0x38, 0xec, 0x2a, 0xb0, 0xcc, 0x37, 0x15, 0x07,
+	0x04, 0x49, 0x59, 0xa9, 0xbd, 0x91, 0x32, 0xd9, 0x78, 0xe3, 0xaf, 0xca, 0x1b, 0x9f, 0x46, 0x38,
+	0xbc, 0xf4, 0x59, 0x14, 0x73, 0xfc, 0xaa, 0x6f, 0x3a, 0xe7, 0x26, 0xac, 0x63, 0xa8, 0x79, 0xe2,
+	0x11, 0xfa, 0x11, 0xf7, 0x8d, 0xe6, 0x69, 0x92, 0x10, 0x88, 0x47, 0xbe, 0x87, 0x43, 0x17, 0x37,
+	0xd6, 0x8f, 0xd7, 0x84, 0xc0, 0x64, 0x6d, 0x7f, 0x2c, 0x35, 0x4f, 0xa9, 0x67, 0x52, 0xe1, 0x21,
+	0x80, 0xa7, 0x48, 0xd3, 0x64, 0x58, 0xd5, 0x94, 0x2b, 0xcf, 0xfe, 0xd3, 0x2a, 0x7c, 0x47, 0xda,
+	0xcc, 0xc8, 0x70, 0x84, 0x13, 0xdb, 0x0e, 0xa0, 0x8a, 0x62, 0x7e, 0x4b, 0xa8, 0xcf, 0x27, 0xda,
+	0xb8, 0x29, 0x61, 0x86, 0xe5, 0xea, 0x0c, 0x4b, 0xab, 0x05, 0x40, 0x05, 0xbb, 0x58, 0x1a, 0xa2,
+	0xac, 0x4c, 0x51, 0xee, 0xc7, 0x43, 0xf9, 0x0d, 0xc7, 0xc3, 0x11, 0xd4, 0x38, 0xe9, 0x9a, 0xcb,
+	0xd2, 0xf7, 0xce, 0xc9, 0x85, 0xa6, 0xd8, 0xdf, 0x85, 0xfd, 0x7b, 0x3e, 0x30, 0x57, 0xff, 0x8f,
+	0x55, 0xb0, 0xe4, 0xee, 0xc0, 0x67, 0x1c, 0xd3, 0x1b, 0x4a, 0xbc, 0xd8, 0xe5, 0x85, 0x8f, 0xe1,
+	0x11, 0xac, 0xb1, 0xbb, 0x58, 0x17, 0x5e, 0xf1, 0x69, 0x59, 0x50, 0x0e, 0x51, 0x80, 0xb5, 0x37,
+	0xe4, 0xb7, 0xf5, 0x07, 0x58, 0x8f, 0xa8, 0xef, 0xe2, 0x37, 0x60, 0xbf, 0x62, 0x6c, 0xfd, 0x12,
+	0x2a, 0x23, 0x44, 0x7d, 0x14, 0x72, 0x26, 0x23, 0xa6, 0x76, 0xfa, 0xbd, 0xf9, 0x75, 0x5a, 0x1b,
+	0xf5, 0x1b, 0x05, 0xd6, 0x95, 0xda, 0x9c, 0xb5, 0x76, 0x61, 0x9d, 0x71, 0xe2, 0xde, 0xc9, 0x1a,
+	0x5c, 0x76, 0xd4, 0x22, 0x53, 0xbb, 0x37, 0x67, 0x6a, 0xf7, 0x63, 0xa8, 0x73, 0x34, 0xee, 0xba,
+	0x88, 0xe3, 0x01, 0xa1, 0x93, 0x46, 0x45, 0x85, 0x33, 0x47, 0xe3, 0x0b, 0x4d, 0xb2, 0x0f, 0xa0,
+	0x79, 0xdf, 0xad, 0x69, 0xaf, 0x8b, 0xec, 0xfa, 0xeb, 0xc8, 0x43, 0x1c, 0x7f, 0x1b, 0x7d, 0xbe,
+	0x07, 0x1b, 0xc8, 0xe5, 0xfe, 0x48, 0x35, 0x3e, 0x15, 0x47, 0xaf, 0x1e, 0xea, 0x75, 0x55, 0x69,
+	0x32, 0x6e, 0x35, 0x3e, 0xa7, 0x32, 0xd0, 0xcf, 0xbc, 0x2f, 0x62, 0xc6, 0xaf, 0xc2, 0x11, 0x0e,
+	0x39, 0xa1, 0x93, 0x97, 0x74, 0x7a, 0x03, 0x36, 0xb5, 0x09, 0x49, 0x41, 0xd7, 0x4b, 0x11, 0x44,
+	0x1e, 0x1e, 0x72, 0x24, 0x5d, 0xbf, 0xe6, 0xa8, 0x85, 0x7d, 0x2a, 0xa3, 0x60, 0x46, 0xa6, 0x49,
+	0x5e, 0x26, 0xf0, 0x4a, 0xa9, 0xc0, 0xb3, 0xff, 0x5b, 0x96, 0x8a, 0xaa, 0xc6, 0xef, 0x86, 0x92,
+	0x80, 0x24, 0xf9, 0x31, 0x57, 0x51, 0x0b, 0xca, 0xb2, 0x89, 0x54, 0x9a, 0xca, 0x6f, 0xeb, 0x5d,
+	0xd8, 0xf2, 0x7c, 0xe6, 0x8a, 0x14, 0xd1, 0x15, 0xf7, 0xa8, 0x15, 0xae, 0x27, 0xc4, 0x67, 0x93,
+	0x08, 0x5b, 0x4f, 0x60, 0x3b, 0xc2, 0xd4, 0xc5, 0x21, 0x47, 0x03, 0xdc, 0xed, 0x45, 0x4c, 0xaa,
+	0xbf, 0xe5, 0x6c, 0x4d, 0xa9, 0xe7, 0x11, 0xb3, 0x02, 0xa8, 0xf7, 0xfd, 0x31, 0x36, 0x29, 0x6d,
+	0xfd, 0xb5, 0x87, 0x57, 0x4d, 0xf2, 0xd7, 0x19, 0x2d, 0x80, 0x7a, 0xe0, 0x87, 0x5d, 0x16, 0xf7,
+	0x38, 0xe1, 0x68, 0x28, 0x43, 0xe4, 0x35, 0x8b, 0x0b, 0xfc, 0xf0, 0x33, 0xcd, 0x5e, 0xf4, 0x62,
+	0x01, 0x1a, 0x77, 0x29, 0xf6, 0x70, 0x20, 0x6b, 0x11, 0x93, 0xa1, 0x57, 0x76, 0xb6, 0x03, 0x34,
+	0x76, 0xa6, 0x54, 0xeb, 0xe7, 0x70, 0x30, 0x03, 0xec, 0x46, 0x98, 0x4e, 0x53, 0x6f, 0x45, 0x9e,
+	0xda, 0xcf, 0x9e, 0xba, 0xc1, 0x34, 0xc9, 0xc4, 0xe2, 0x9e, 0xd8, 0x5d, 0xcc, 0x1a, 0x55, 0x59,
+	0xdf, 0xe4, 0xb7, 0x75, 0x06, 0x55, 0xc6, 0x11, 0xe5, 0xac, 0x8b, 0x78, 0x03, 0xa4, 0xa5, 0xcd,
+	0xb6, 0x1a, 0xa9, 0xda, 0xc9, 0x48, 0xd5, 0x7e, 0x96, 0x8c, 0x54, 0xe7, 0x15, 0x61, 0xea, 0x97,
+	0xff, 0x3e, 0x2a, 0x39, 0x15, 0x75, 0xec, 0x8c, 0x5b, 0x3f, 0x83, 0x4d, 0x1c, 0x7a, 0x92, 0x41,
+	0xed, 0x25, 0x18, 0x6c, 0x88, 0x43, 0x67, 0x5c, 0xa7, 0xaa, 0x99, 0x78, 0x33, 0xcf, 0xe6, 0x13,
+	0x59, 0x7b, 0x2f, 0xb1, 0x7c, 0xa0, 0x0f, 0x89, 0x48, 0xfb, 0x18, 0x5a, 0xf3, 0x39, 0x19, 0x59,
+	0x77, 0xb2, 0xa9, 0xd5, 0xcf, 0x17, 0x51, 0x14, 0xb0, 0x05, 0xb5, 0xfa, 0x27, 0xb0, 0x11, 0x49,
+	0x9c, 0x14, 0x54, 0x3b, 0x3d, 0xc8, 0x49, 0x46, 0x12, 0xa3, 0x93, 0x90, 0x3e, 0xa1, 0x7b, 0xdf,
+	0xb4, 0x30, 0xa3, 0x47, 0x1f, 0xb6, 0x44, 0xd3, 0x8d, 0xf9, 0x33, 0x34, 0x76, 0xd0, 0xc2, 0x8e,
+	0xe1, 0x63, 0x28, 0x53, 0xc4, 0xb1, 0xd6, 0xe1, 0x70, 0xbe, 0x0e, 0x9a, 0x95, 0x56, 0x42, 0x1e,
+	0xb0, 0xdf, 0x81, 0xb7, 0x33, 0x72, 0xd2, 0x0d, 0xd9, 0x23, 0x59, 0x3e, 0x02, 0x32, 0xc2, 0xcb,
+	0x29, 0x21, 0x66, 0x0d, 0xf1, 0x7a, 0xe8, 0x44, 0x3b, 0x3d, 0x59, 0xaa, 0x34, 0x23, 0xf4, 0x53,
+	0x19, 0x40, 0x2d, 0x44, 0xdb, 0x10, 0x11, 0xc6, 0xd1, 0x50, 0xcd, 0x9f, 0xaa, 0x23, 0x03, 0x45,
+	0x12, 0xf3, 0xa7, 0xec, 0x00, 0x93, 0x54, 0xab, 0x87, 0x57, 0x37, 0x9b, 0x67, 0x33, 0xea, 0x25,
+	0xba, 0x9f, 0xfe, 0x7d, 0x1b, 0xd6, 0xae, 0xd9, 0xc0, 0x42, 0x50, 0x4b, 0xcf, 0xf3, 0x39, 0x75,
+	0x22, 0x3b, 0xe2, 0x36, 0xdf, 0x5f, 0x06, 0x65, 0x12, 0xa8, 0x07, 0xf5, 0xcc, 0xa8, 0xfb, 0x24,
+	0xff, 0x74, 0x0a, 0xd6, 0xfc, 0x60, 0x29, 0x98, 0x91, 0xf2, 0x5b, 0xa8, 0x98, 0x51, 0xf7, 0x71,
+	0xee, 0xd1, 0x04, 0xd2, 0xfc, 0xfe, 0x42, 0x88, 0xe1, 0xfc, 0x39, 0x54, 0xa7, 0x33, 0xa6, 0x9d,
+	0x7b, 0xce, 0x60, 0x9a, 0x3f, 0x58, 0x8c, 0x49, 0x3b, 0x27, 0x33, 0x1e, 0xe6, 0x3b, 0x27, 0x0d,
+	0x2b, 0x70, 0xce, 0xbc, 0x31, 0xd1, 0x1a, 0xc0, 0x56, 0x76, 0x46, 0x7c, 0xaf, 0xc0, 0xb9, 0x29,
+	0x5c, 0xb3, 0xbd, 0x1c, 0xce, 0x08, 0x12, 0xe1, 0x94, 0x9a, 0x12, 0x0b, 0xc2, 0x69, 0x8a, 0x2a,
+	0x0a, 0xa7, 0xfb, 0x43, 0xa1, 0x10, 0x91, 0x1e, 0x08, 0xf3, 0x45, 0xa4, 0x50, 0x05, 0x22, 0xe6,
+	0x4c, 0x5a, 0x42, 0x44, 0x7a, 0xca, 0xca, 0x17, 0x91, 0x42, 0x15, 0x88, 0x98, 0x37, 0x12, 0x7d,
+	0x01, 0xdb, 0x33, 0xf3, 0xce, 0xd3, 0x02, 0x15, 0xd3, 0xc0, 0x66, 0x67, 0x49, 0xa0, 0x91, 0x15,
+	0xc0, 0xce, 0xec, 0xe4, 0x70, 0x52, 0xc0, 0x23, 0x83, 0x6c, 0xfe, 0x68, 0x59, 0x64, 0x3a, 0xd8,
+	0xb2, 0x2d, 0x73, 0x7e, 0xb0, 0x65, 0x70, 0x05, 0xc1, 0x36, 0xb7, 0x57, 0x14, 0x76, 0xcd, 0x36,
+	0x8a, 0xf9, 0x76, 0xcd, 0x20, 0x0b, 0xec, 0xca, 0x6b, 0x04, 0x03, 0xd8, 0x99, 0x6d, 0xf7, 0x4e,
+	0x16, 0x24, 0x42, 0x83, 0x2c, 0x10, 0x97, 0x53, 0xd2, 0xad, 0x09, 0xbc, 0x35, 0xaf, 0x9e, 0xbf,
+	0x5f, 0xf0, 0xf2, 0xef, 0xa1, 0x9b, 0x3f, 0x7e, 0x19, 0x74, 0x3a, 0x29, 0x65, 0xca, 0xfb, 0x93,
+	0x45, 0x17, 0x23, 0x61, 0x05, 0x49, 0x69, 0x5e, 0xfd, 0xb6, 0x7e, 0x0f, 0x90, 0x2a, 0xde, 0xef,
+	0xe6, 0x27, 0x4d, 0x03, 0x6a, 0xfe, 0x70, 0x09, 0x50, 0x3a, 0x0e, 0xb3, 0xa5, 0xf9, 0xbd, 0x82,
+	0x50, 0x4e, 0xe1, 0x0a, 0xe2, 0x70, 0x6e, 0x2d, 0x3d, 0x3f, 0xfb, 0xea, 0x79, 0xab, 0xf4, 0xf5,
+	0xf3, 0x56, 0xe9, 0x3f, 0xcf, 0x5b, 0xa5, 0x2f, 0x5f, 0xb4, 0x56, 0xbe, 0x7e, 0xd1, 0x5a, 0xf9,
+	0xe7, 0x8b, 0xd6, 0xca, 0xef, 0xd2, 0xad, 0x6e, 0xf6, 0xff, 0xf5, 0xb1, 0xf9, 0xb7, 0x5f, 0xf4,
+	0xbb, 0xbd, 0x0d, 0xd9, 0x03, 0x7e, 0xf4, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xa8, 0x51,
+	0x14, 0x12, 0x18, 0x00, 0x00,
 }
 
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateOrder(ctx context.Context, in *MsgCreateOrder, opts ...grpc.CallOption) (*MsgCreateOrderResponse, error)
+	ConfirmOrder(ctx context.Context, in *MsgConfirmOrder, opts ...grpc.CallOption) (*MsgConfirmOrderResponse, error)
+	PayOrder(ctx context.Context, in *MsgPayOrder, opts ...grpc.CallOption) (*MsgPayOrderResponse, error)
+	ShipOrder(ctx context.Context, in *MsgShipOrder, opts ...grpc.CallOption) (*MsgShipOrderResponse, error)
+	DeliverOrder(ctx context.Context, in *MsgDeliverOrder, opts ...grpc.CallOption) (*MsgDeliverOrderResponse, error)
+	CompleteOrder(ctx context.Context, in *MsgCompleteOrder, opts ...grpc.CallOption) (*MsgCompleteOrderResponse, error)
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	RefundOrder(ctx context.Context, in *MsgRefundOrder, opts ...grpc.CallOption) (*MsgRefundOrderResponse, error)
+	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	RegisterProduct(ctx context.Context, in *MsgRegisterProduct, opts ...grpc.CallOption) (*MsgRegisterProductResponse, error)
+	UpdateProduct(ctx context.Context, in *MsgUpdateProduct, opts ...grpc.CallOption) (*MsgUpdateProductResponse, error)
+	AdjustInventory(ctx context.Context, in *MsgAdjustInventory, opts ...grpc.CallOption) (*MsgAdjustInventoryResponse, error)
+	CreatePromotion(ctx context.Context, in *MsgCreatePromotion, opts ...grpc.CallOption) (*MsgCreatePromotionResponse, error)
+	DeactivatePromotion(ctx context.Context, in *MsgDeactivatePromotion, opts ...grpc.CallOption) (*MsgDeactivatePromotionResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetTaxRate(ctx context.Context, in *MsgSetTaxRate, opts ...grpc.CallOption) (*MsgSetTaxRateResponse, error)
+	RemoveTaxRate(ctx context.Context, in *MsgRemoveTaxRate, opts ...grpc.CallOption) (*MsgRemoveTaxRateResponse, error)
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgRefundOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateOrder(ctx context.Context, in *MsgCreateOrder, opts ...grpc.CallOption) (*MsgCreateOrderResponse, error) {
+	out := new(MsgCreateOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CreateOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRefundOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConfirmOrder(ctx context.Context, in *MsgConfirmOrder, opts ...grpc.CallOption) (*MsgConfirmOrderResponse, error) {
+	out := new(MsgConfirmOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ConfirmOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRefundOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullRefund {
-		i--
-		if m.FullRefund {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.RefundAmount.Size()
-		i -= size
-		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) PayOrder(ctx context.Context, in *MsgPayOrder, opts ...grpc.CallOption) (*MsgPayOrderResponse, error) {
+	out := new(MsgPayOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/PayOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *msgClient) ShipOrder(ctx context.Context, in *MsgShipOrder, opts ...grpc.CallOption) (*MsgShipOrderResponse, error) {
+	out := new(MsgShipOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ShipOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DeliverOrder(ctx context.Context, in *MsgDeliverOrder, opts ...grpc.CallOption) (*MsgDeliverOrderResponse, error) {
+	out := new(MsgDeliverOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/DeliverOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRefundOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteOrder(ctx context.Context, in *MsgCompleteOrder, opts ...grpc.CallOption) (*MsgCompleteOrderResponse, error) {
+	out := new(MsgCompleteOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CompleteOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRefundOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRefundOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RefundOrder(ctx context.Context, in *MsgRefundOrder, opts ...grpc.CallOption) (*MsgRefundOrderResponse, error) {
+	out := new(MsgRefundOrderResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/RefundOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
+	out := new(MsgOpenDisputeResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/OpenDispute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evidence) > 0 {
-		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Evidence[iNdEx])
-			copy(dAtA[i:], m.Evidence[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterProduct(ctx context.Context, in *MsgRegisterProduct, opts ...grpc.CallOption) (*MsgRegisterProductResponse, error) {
+	out := new(MsgRegisterProductResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/RegisterProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateProduct(ctx context.Context, in *MsgUpdateProduct, opts ...grpc.CallOption) (*MsgUpdateProductResponse, error) {
+	out := new(MsgUpdateProductResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/UpdateProduct", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AdjustInventory(ctx context.Context, in *MsgAdjustInventory, opts ...grpc.CallOption) (*MsgAdjustInventoryResponse, error) {
+	out := new(MsgAdjustInventoryResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/AdjustInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CreatePromotion(ctx context.Context, in *MsgCreatePromotion, opts ...grpc.CallOption) (*MsgCreatePromotionResponse, error) {
+	out := new(MsgCreatePromotionResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/CreatePromotion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeactivatePromotion(ctx context.Context, in *MsgDeactivatePromotion, opts ...grpc.CallOption) (*MsgDeactivatePromotionResponse, error) {
+	out := new(MsgDeactivatePromotionResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/DeactivatePromotion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ToCustomer {
-		i--
-		if m.ToCustomer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.RefundAmount.Size()
-		i -= size
-		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Resolution) > 0 {
-		i -= len(m.Resolution)
-		copy(dAtA[i:], m.Resolution)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Resolution)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetTaxRate(ctx context.Context, in *MsgSetTaxRate, opts ...grpc.CallOption) (*MsgSetTaxRateResponse, error) {
+	out := new(MsgSetTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/SetTaxRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveTaxRate(ctx context.Context, in *MsgRemoveTaxRate, opts ...grpc.CallOption) (*MsgRemoveTaxRateResponse, error) {
+	out := new(MsgRemoveTaxRateResponse)
+	err := c.cc.Invoke(ctx, "/stateset.core.orders.Msg/RemoveTaxRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateOrder(context.Context, *MsgCreateOrder) (*MsgCreateOrderResponse, error)
+	ConfirmOrder(context.Context, *MsgConfirmOrder) (*MsgConfirmOrderResponse, error)
+	PayOrder(context.Context, *MsgPayOrder) (*MsgPayOrderResponse, error)
+	ShipOrder(context.Context, *MsgShipOrder) (*MsgShipOrderResponse, error)
+	DeliverOrder(context.Context, *MsgDeliverOrder) (*MsgDeliverOrderResponse, error)
+	CompleteOrder(context.Context, *MsgCompleteOrder) (*MsgCompleteOrderResponse, error)
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	RefundOrder(context.Context, *MsgRefundOrder) (*MsgRefundOrderResponse, error)
+	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	RegisterProduct(context.Context, *MsgRegisterProduct) (*MsgRegisterProductResponse, error)
+	UpdateProduct(context.Context, *MsgUpdateProduct) (*MsgUpdateProductResponse, error)
+	AdjustInventory(context.Context, *MsgAdjustInventory) (*MsgAdjustInventoryResponse, error)
+	CreatePromotion(context.Context, *MsgCreatePromotion) (*MsgCreatePromotionResponse, error)
+	DeactivatePromotion(context.Context, *MsgDeactivatePromotion) (*MsgDeactivatePromotionResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetTaxRate(context.Context, *MsgSetTaxRate) (*MsgSetTaxRateResponse, error)
+	RemoveTaxRate(context.Context, *MsgRemoveTaxRate) (*MsgRemoveTaxRateResponse, error)
 }
 
-func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) CreateOrder(ctx context.Context, req *MsgCreateOrder) (*MsgCreateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
-func (m *MsgCreateOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (*UnimplementedMsgServer) ConfirmOrder(ctx context.Context, req *MsgConfirmOrder) (*MsgConfirmOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
+func (*UnimplementedMsgServer) PayOrder(ctx context.Context, req *MsgPayOrder) (*MsgPayOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+}
+func (*UnimplementedMsgServer) ShipOrder(ctx context.Context, req *MsgShipOrder) (*MsgShipOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipOrder not implemented")
+}
+func (*UnimplementedMsgServer) DeliverOrder(ctx context.Context, req *MsgDeliverOrder) (*MsgDeliverOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverOrder not implemented")
+}
+func (*UnimplementedMsgServer) CompleteOrder(ctx context.Context, req *MsgCompleteOrder) (*MsgCompleteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) RefundOrder(ctx context.Context, req *MsgRefundOrder) (*MsgRefundOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedMsgServer) RegisterProduct(ctx context.Context, req *MsgRegisterProduct) (*MsgRegisterProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProduct not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProduct(ctx context.Context, req *MsgUpdateProduct) (*MsgUpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (*UnimplementedMsgServer) AdjustInventory(ctx context.Context, req *MsgAdjustInventory) (*MsgAdjustInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustInventory not implemented")
+}
+func (*UnimplementedMsgServer) CreatePromotion(ctx context.Context, req *MsgCreatePromotion) (*MsgCreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (*UnimplementedMsgServer) DeactivatePromotion(ctx context.Context, req *MsgDeactivatePromotion) (*MsgDeactivatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromotion not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetTaxRate(ctx context.Context, req *MsgSetTaxRate) (*MsgSetTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxRate not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTaxRate(ctx context.Context, req *MsgRemoveTaxRate) (*MsgRemoveTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTaxRate not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrder(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/CreateOrder",
 	}
-	l = m.ShippingInfo.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrder(ctx, req.(*MsgCreateOrder))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmOrder(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/ConfirmOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmOrder(ctx, req.(*MsgConfirmOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).PayOrder(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/PayOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayOrder(ctx, req.(*MsgPayOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgShipOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ShipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/ShipOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ShipOrder(ctx, req.(*MsgShipOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPayOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeliverOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverOrder(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/DeliverOrder",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.UseEscrow {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverOrder(ctx, req.(*MsgDeliverOrder))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPayOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/CompleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteOrder(ctx, req.(*MsgCompleteOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShipOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
 	}
-	l = len(m.Carrier)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/CancelOrder",
 	}
-	l = len(m.TrackingNumber)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgShipOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundOrder(ctx, req.(*MsgRefundOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).OpenDispute(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/OpenDispute",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProduct)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProduct(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/RegisterProduct",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProduct(ctx, req.(*MsgRegisterProduct))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProduct)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProduct(ctx, req.(*MsgUpdateProduct))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AdjustInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdjustInventory)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AdjustInventory(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/AdjustInventory",
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdjustInventory(ctx, req.(*MsgAdjustInventory))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePromotion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/CreatePromotion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePromotion(ctx, req.(*MsgCreatePromotion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefundOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeactivatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivatePromotion)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivatePromotion(ctx, in)
 	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/DeactivatePromotion",
 	}
-	l = m.RefundAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivatePromotion(ctx, req.(*MsgDeactivatePromotion))
 	}
-	if m.FullRefund {
-		n += 2
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRefundOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTaxRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/SetTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTaxRate(ctx, req.(*MsgSetTaxRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTaxRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.core.orders.Msg/RemoveTaxRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTaxRate(ctx, req.(*MsgRemoveTaxRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stateset.core.orders.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrder",
+			Handler:    _Msg_CreateOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _Msg_ConfirmOrder_Handler,
+		},
+		{
+			MethodName: "PayOrder",
+			Handler:    _Msg_PayOrder_Handler,
+		},
+		{
+			MethodName: "ShipOrder",
+			Handler:    _Msg_ShipOrder_Handler,
+		},
+		{
+			MethodName: "DeliverOrder",
+			Handler:    _Msg_DeliverOrder_Handler,
+		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _Msg_CompleteOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _Msg_RefundOrder_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _Msg_OpenDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "RegisterProduct",
+			Handler:    _Msg_RegisterProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _Msg_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "AdjustInventory",
+			Handler:    _Msg_AdjustInventory_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _Msg_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "DeactivatePromotion",
+			Handler:    _Msg_DeactivatePromotion_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetTaxRate",
+			Handler:    _Msg_SetTaxRate_Handler,
+		},
+		{
+			MethodName: "RemoveTaxRate",
+			Handler:    _Msg_RemoveTaxRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stateset/core/orders/tx.proto",
+}
+
+func (m *MsgCreateOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.CouponCode) > 0 {
+		i -= len(m.CouponCode)
+		copy(dAtA[i:], m.CouponCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CouponCode)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ShippingInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOpenDispute) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Description)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseEscrow {
+		i--
+		if m.UseEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShipOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShipOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShipOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrackingNumber) > 0 {
+		i -= len(m.TrackingNumber)
+		copy(dAtA[i:], m.TrackingNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrackingNumber)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Carrier) > 0 {
+		i -= len(m.Carrier)
+		copy(dAtA[i:], m.Carrier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Carrier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgShipOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgShipOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgShipOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullRefund {
+		i--
+		if m.FullRefund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.RefundAmount.Size()
+		i -= size
+		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Evidence[iNdEx])
+			copy(dAtA[i:], m.Evidence[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToCustomer {
+		i--
+		if m.ToCustomer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RefundAmount.Size()
+		i -= size
+		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProduct) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProduct) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProduct) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCategory) > 0 {
+		i -= len(m.TaxCategory)
+		copy(dAtA[i:], m.TaxCategory)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaxCategory)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Stock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Stock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Variants) > 0 {
+		for iNdEx := len(m.Variants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Variants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sku) > 0 {
+		i -= len(m.Sku)
+		copy(dAtA[i:], m.Sku)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sku)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProductResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProductResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProductResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProduct) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProduct) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProduct) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaxCategory) > 0 {
+		i -= len(m.TaxCategory)
+		copy(dAtA[i:], m.TaxCategory)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaxCategory)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Variants) > 0 {
+		for iNdEx := len(m.Variants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Variants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sku) > 0 {
+		i -= len(m.Sku)
+		copy(dAtA[i:], m.Sku)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sku)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProductResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProductResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProductResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdjustInventory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdjustInventory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdjustInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delta != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Delta))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sku) > 0 {
+		i -= len(m.Sku)
+		copy(dAtA[i:], m.Sku)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sku)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdjustInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdjustInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdjustInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Stock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePromotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePromotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePromotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndsAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x5a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartsAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartsAt):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Skus) > 0 {
+		for iNdEx := len(m.Skus) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skus[iNdEx])
+			copy(dAtA[i:], m.Skus[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Skus[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxRedemptionsPerCustomer != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRedemptionsPerCustomer))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxRedemptions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxRedemptions))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinSubtotal.Size()
+		i -= size
+		if _, err := m.MinSubtotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FixedAmount.Size()
+		i -= size
+		if _, err := m.FixedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.PercentageBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PercentageBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DiscountType) > 0 {
+		i -= len(m.DiscountType)
+		copy(dAtA[i:], m.DiscountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DiscountType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePromotionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePromotionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePromotionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivatePromotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivatePromotion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivatePromotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivatePromotionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivatePromotionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivatePromotionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTaxRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTaxRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTaxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTaxRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTaxRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTaxRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PostalCode) > 0 {
+		i -= len(m.PostalCode)
+		copy(dAtA[i:], m.PostalCode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PostalCode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTaxRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTaxRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShippingInfo.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CouponCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgConfirmOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgConfirmOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UseEscrow {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPayOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgShipOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgShipOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeliverOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgDeliverOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCompleteOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FullRefund {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRefundOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Evidence) > 0 {
+		for _, s := range m.Evidence {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgOpenDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ToCustomer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterProduct) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Variants) > 0 {
+		for _, e := range m.Variants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Stock != 0 {
+		n += 1 + sovTx(uint64(m.Stock))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterProductResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProduct) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Variants) > 0 {
+		for _, e := range m.Variants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateProductResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdjustInventory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovTx(uint64(m.Delta))
+	}
+	return n
+}
+
+func (m *MsgAdjustInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stock != 0 {
+		n += 1 + sovTx(uint64(m.Stock))
+	}
+	return n
+}
+
+func (m *MsgCreatePromotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DiscountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PercentageBps != 0 {
+		n += 1 + sovTx(uint64(m.PercentageBps))
+	}
+	l = m.FixedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSubtotal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxRedemptions != 0 {
+		n += 1 + sovTx(uint64(m.MaxRedemptions))
+	}
+	if m.MaxRedemptionsPerCustomer != 0 {
+		n += 1 + sovTx(uint64(m.MaxRedemptionsPerCustomer))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartsAt)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndsAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePromotionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivatePromotion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivatePromotionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTaxRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveTaxRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Evidence) > 0 {
-		for _, s := range m.Evidence {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTaxRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShippingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouponCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CouponCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseEscrow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgShipOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgShipOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgShipOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgShipOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgShipOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullRefund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullRefund = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCustomer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToCustomer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgOpenDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgResolveDispute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
-	}
-	l = len(m.Resolution)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.RefundAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.ToCustomer {
-		n += 2
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgResolveDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterProduct) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2634,15 +7014,15 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterProduct: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterProduct: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2670,11 +7050,43 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sku = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2695,18 +7107,51 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Variants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2733,16 +7178,35 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, OrderItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Variants = append(m.Variants, ProductVariant{})
+			if err := m.Variants[len(m.Variants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stock", wireType)
+			}
+			m.Stock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShippingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2752,28 +7216,27 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShippingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2801,7 +7264,7 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2824,7 +7287,7 @@ func (m *MsgCreateOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterProductResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,31 +7310,12 @@ func (m *MsgCreateOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterProductResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterProductResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2893,7 +7337,7 @@ func (m *MsgCreateOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProduct) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2916,10 +7360,10 @@ func (m *MsgConfirmOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProduct: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProduct: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2955,10 +7399,10 @@ func (m *MsgConfirmOrder) Unmarshal(dAtA []byte) error {
 			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2968,114 +7412,27 @@ func (m *MsgConfirmOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgConfirmOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sku = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3103,13 +7460,13 @@ func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.OrderId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3119,14 +7476,28 @@ func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Variants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3153,15 +7524,68 @@ func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Variants = append(m.Variants, ProductVariant{})
+			if err := m.Variants[len(m.Variants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseEscrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3171,12 +7595,24 @@ func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseEscrow = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3198,7 +7634,7 @@ func (m *MsgPayOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProductResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,10 +7657,10 @@ func (m *MsgPayOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProductResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProductResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3248,7 +7684,7 @@ func (m *MsgPayOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgAdjustInventory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3271,10 +7707,10 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShipOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdjustInventory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShipOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdjustInventory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3310,10 +7746,10 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3323,14 +7759,27 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sku = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3358,13 +7807,13 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Carrier = string(dAtA[iNdEx:postIndex])
+			m.Variant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
 			}
-			var stringLen uint64
+			m.Delta = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3374,24 +7823,11 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Delta |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3413,7 +7849,7 @@ func (m *MsgShipOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgShipOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAdjustInventoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3436,12 +7872,31 @@ func (m *MsgShipOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgShipOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdjustInventoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgShipOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdjustInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stock", wireType)
+			}
+			m.Stock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3463,7 +7918,7 @@ func (m *MsgShipOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePromotion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3486,15 +7941,15 @@ func (m *MsgDeliverOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePromotion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePromotion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3522,13 +7977,13 @@ func (m *MsgDeliverOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3538,116 +7993,113 @@ func (m *MsgDeliverOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeliverOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscountType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCompleteOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.DiscountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentageBps", wireType)
 			}
-			if iNdEx >= l {
+			m.PercentageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PercentageBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FixedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubtotal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3657,29 +8109,30 @@ func (m *MsgCompleteOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			if err := m.MinSubtotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptions", wireType)
 			}
-			m.OrderId = 0
+			m.MaxRedemptions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3689,114 +8142,33 @@ func (m *MsgCompleteOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MaxRedemptions |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCompleteOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionsPerCustomer", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxRedemptionsPerCustomer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedemptionsPerCustomer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3824,13 +8196,13 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Skus = append(m.Skus, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
-			m.OrderId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3840,16 +8212,30 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3859,23 +8245,24 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3898,7 +8285,7 @@ func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePromotionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3921,10 +8308,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePromotionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePromotionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3948,7 +8335,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivatePromotion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3971,10 +8358,10 @@ func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivatePromotion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivatePromotion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4010,94 +8397,10 @@ func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
 			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullRefund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4107,12 +8410,24 @@ func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FullRefund = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4134,7 +8449,7 @@ func (m *MsgRefundOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeactivatePromotionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4157,10 +8472,10 @@ func (m *MsgRefundOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeactivatePromotionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeactivatePromotionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4184,7 +8499,7 @@ func (m *MsgRefundOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4207,15 +8522,15 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4243,32 +8558,13 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4278,27 +8574,128 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTaxRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTaxRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTaxRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4326,13 +8723,13 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4342,23 +8739,24 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = append(m.Evidence, string(dAtA[iNdEx:postIndex]))
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4381,7 +8779,7 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTaxRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4404,31 +8802,12 @@ func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTaxRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
-			}
-			m.DisputeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DisputeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4450,7 +8829,7 @@ func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTaxRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4473,10 +8852,10 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTaxRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTaxRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4512,10 +8891,10 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
-			m.DisputeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4525,14 +8904,27 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DisputeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4560,13 +8952,13 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4576,30 +8968,29 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCustomer", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4609,12 +9000,24 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ToCustomer = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4636,7 +9039,7 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTaxRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4659,10 +9062,10 @@ func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTaxRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTaxRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -37,6 +37,17 @@ const (
 	DisputeStatusEscalated  DisputeStatus = "escalated"
 )
 
+// IsValidOrderStatus checks if a status is one of the order statuses.
+func IsValidOrderStatus(status string) bool {
+	switch status {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusPaid, OrderStatusShipped,
+		OrderStatusDelivered, OrderStatusCompleted, OrderStatusCancelled,
+		OrderStatusRefunded, OrderStatusDisputed:
+		return true
+	}
+	return false
+}
+
 // IsValidTransition checks if a status transition is valid.
 func (o *Order) IsValidTransition(newStatus OrderStatus) bool {
 	validTransitions := map[OrderStatus][]OrderStatus{
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/stateset/core/x/payments/types"
@@ -23,6 +24,7 @@ func NewQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewGetPaymentCmd(),
+		NewGetRouteCmd(),
 	)
 
 	return cmd
@@ -64,3 +66,37 @@ func NewGetPaymentCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewGetRouteCmd finds the cheapest route of a payment over settlement
+// payment channels.
+func NewGetRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route [payer] [payee] [amount]",
+		Short: "Find the cheapest route of a payment over settlement payment channels",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Route(cmd.Context(), &types.QueryRouteRequest{
+				Payer:  args[0],
+				Payee:  args[1],
+				Amount: amount,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
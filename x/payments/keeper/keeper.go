@@ -18,19 +18,21 @@ var (
 
 // Keeper handles payment intent lifecycle.
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	bankKeeper types.BankKeeper
-	compKeeper types.ComplianceKeeper
+	storeKey         storetypes.StoreKey
+	bankKeeper       types.BankKeeper
+	compKeeper       types.ComplianceKeeper
+	settlementKeeper types.SettlementKeeper
 
 	moduleName string
 }
 
-func NewKeeper(_ codec.BinaryCodec, key storetypes.StoreKey, bank types.BankKeeper, compliance types.ComplianceKeeper, moduleName string) Keeper {
+func NewKeeper(_ codec.BinaryCodec, key storetypes.StoreKey, bank types.BankKeeper, compliance types.ComplianceKeeper, settlement types.SettlementKeeper, moduleName string) Keeper {
 	return Keeper{
-		storeKey:   key,
-		bankKeeper: bank,
-		compKeeper: compliance,
-		moduleName: moduleName,
+		storeKey:         key,
+		bankKeeper:       bank,
+		compKeeper:       compliance,
+		settlementKeeper: settlement,
+		moduleName:       moduleName,
 	}
 }
 
@@ -116,10 +118,6 @@ func (k Keeper) CreatePayment(ctx sdk.Context, intent types.PaymentIntent) (uint
 
 	k.storePayment(ctx, intent)
 
-	// Calculate and store optimal route
-	route := k.OptimizeRoute(ctx, payerAddr, payeeAddr, intent.Amount)
-	k.SetPaymentRoute(ctx, nextID, route)
-
 	k.setNextID(ctx, nextID+1)
 	return intent.Id, nil
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stateset/core/x/payments/keeper"
 	paymentstypes "github.com/stateset/core/x/payments/types"
+	settlementtypes "github.com/stateset/core/x/settlement/types"
 )
 
 var paymentsConfigOnce sync.Once
@@ -37,6 +38,12 @@ func setupPaymentsConfig() {
 
 func setupPaymentsKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKeeper, *mockComplianceKeeper) {
 	t.Helper()
+	k, ctx, bankKeeper, complianceKeeper, _ := setupPaymentsKeeperWithChannels(t)
+	return k, ctx, bankKeeper, complianceKeeper
+}
+
+func setupPaymentsKeeperWithChannels(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKeeper, *mockComplianceKeeper, *mockSettlementKeeper) {
+	t.Helper()
 
 	setupPaymentsConfig()
 
@@ -54,10 +61,11 @@ func setupPaymentsKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKee
 
 	bankKeeper := newMockBankKeeper()
 	complianceKeeper := newMockComplianceKeeper()
+	settlementKeeper := &mockSettlementKeeper{}
 
-	k := keeper.NewKeeper(cdc, storeKey, bankKeeper, complianceKeeper, paymentstypes.ModuleAccountName)
+	k := keeper.NewKeeper(cdc, storeKey, bankKeeper, complianceKeeper, settlementKeeper, paymentstypes.ModuleAccountName)
 
-	return k, ctx, bankKeeper, complianceKeeper
+	return k, ctx, bankKeeper, complianceKeeper, settlementKeeper
 }
 
 func newPaymentsAddress() sdk.AccAddress {
@@ -148,6 +156,23 @@ func (m *mockComplianceKeeper) AssertCompliant(_ context.Context, addr sdk.AccAd
 	return nil
 }
 
+type mockSettlementKeeper struct {
+	channels []settlementtypes.PaymentChannel
+}
+
+func (m *mockSettlementKeeper) AddChannel(channel settlementtypes.PaymentChannel) {
+	channel.Id = uint64(len(m.channels) + 1)
+	m.channels = append(m.channels, channel)
+}
+
+func (m *mockSettlementKeeper) IterateChannels(_ sdk.Context, cb func(settlementtypes.PaymentChannel) bool) {
+	for _, channel := range m.channels {
+		if cb(channel) {
+			return
+		}
+	}
+}
+
 func TestMsgCreatePayment(t *testing.T) {
 	k, ctx, bank, _ := setupPaymentsKeeper(t)
 	msgServer := keeper.NewMsgServerImpl(k)
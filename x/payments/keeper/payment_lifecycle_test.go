@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/stateset/core/x/payments/keeper"
 	"github.com/stateset/core/x/payments/types"
 )
 
@@ -648,7 +649,8 @@ func TestPaymentLifecycle_DifferentDenominations(t *testing.T) {
 	}
 }
 
-// TestPaymentLifecycle_PaymentRouting tests that payment routes are calculated and stored
+// TestPaymentLifecycle_PaymentRouting tests that routes are served by the
+// Route query rather than computed for each payment
 func TestPaymentLifecycle_PaymentRouting(t *testing.T) {
 	k, ctx, bankKeeper, _ := setupPaymentKeeper(t)
 
@@ -665,16 +667,21 @@ func TestPaymentLifecycle_PaymentRouting(t *testing.T) {
 		Metadata: "routed payment",
 	}
 
-	id, err := k.CreatePayment(ctx, intent)
+	_, err := k.CreatePayment(ctx, intent)
 	require.NoError(t, err)
 
-	// Verify route was stored
-	route, found := k.GetPaymentRoute(ctx, id)
-	require.True(t, found, "Payment route should be stored")
-	
-	// Check default optimized route values (from our mock logic)
-	require.Empty(t, route.Hops, "Default route should be direct (empty hops)")
-	require.True(t, route.TotalFee.IsZero(), "Default route should have zero routing fee")
-	require.True(t, route.Probability.Equal(sdkmath.LegacyOneDec()), "Default route should have 100% probability")
-}
+	queryServer := keeper.NewQueryServerImpl(k)
+	route, err := queryServer.Route(ctx, &types.QueryRouteRequest{Payer: payer.String(), Payee: payee.String(), Amount: amount})
+	require.NoError(t, err)
 
+	// Without channels the payment settles directly
+	require.Empty(t, route.Hops, "Route without channels should be direct (empty hops)")
+	require.Empty(t, route.ChannelIds)
+	require.True(t, route.TotalFee.IsZero(), "Direct route should have zero routing fee")
+	require.True(t, route.Probability.Equal(sdkmath.LegacyOneDec()), "Direct route should have 100% probability")
+
+	_, err = queryServer.Route(ctx, &types.QueryRouteRequest{Payer: "invalid", Payee: payee.String(), Amount: amount})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	_, err = queryServer.Route(ctx, &types.QueryRouteRequest{Payer: payer.String(), Payee: payee.String(), Amount: sdk.NewCoin("ssusd", sdkmath.ZeroInt())})
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+}
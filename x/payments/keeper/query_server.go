@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stateset/core/x/payments/types"
@@ -146,3 +147,30 @@ func (q queryServer) PaymentsByStatus(goCtx context.Context, req *types.QueryPay
 		Total:    matched,
 	}, nil
 }
+
+// Route returns the cheapest route of a payment over settlement payment channels
+func (q queryServer) Route(goCtx context.Context, req *types.QueryRouteRequest) (*types.QueryRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payer, err := sdk.AccAddressFromBech32(req.Payer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid payer address: %s", err)
+	}
+	payee, err := sdk.AccAddressFromBech32(req.Payee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid payee address: %s", err)
+	}
+	if !req.Amount.IsValid() || !req.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+
+	route := q.Keeper.OptimizeRoute(ctx, payer, payee, req.Amount)
+	return &types.QueryRouteResponse{
+		ChannelIds:  route.ChannelIds,
+		Hops:        route.Hops,
+		HopFees:     route.HopFees,
+		TotalFee:    route.TotalFee,
+		Probability: route.Probability,
+		Latency:     route.Latency,
+	}, nil
+}
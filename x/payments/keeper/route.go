@@ -1,55 +1,139 @@
 package keeper
 
 import (
-	"encoding/json"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stateset/core/x/payments/types"
+	settlementtypes "github.com/stateset/core/x/settlement/types"
 )
 
-// SetPaymentRoute stores a route for a payment.
-func (k Keeper) SetPaymentRoute(ctx sdk.Context, paymentID uint64, route types.PaymentRoute) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := json.Marshal(route)
-	if err != nil {
-		ctx.Logger().Error("failed to marshal payment route", "error", err)
-		return
+// OptimizeRoute finds the cheapest route for a payment over open settlement
+// payment channels. Each channel on the route is crossed with an HTLC locked
+// under the same hash: the payer locks the first hop, and each intermediary
+// forwards over its own channel to the next party, charging the channel's
+// routing fee on the amount it forwards. Every channel must hold enough balance
+// for the amount it carries and stay open long enough for its HTLC timeout,
+// which grows by HTLCTimeoutDelta for each hop further from the payee.
+//
+// Routes are compared by the total amount the payer sends, then by the number of
+// channels. When no route over channels exists the payment settles directly.
+// Finding a route scans every channel, so it is only served as a query and
+// never runs in a transaction.
+func (k Keeper) OptimizeRoute(ctx sdk.Context, source, dest sdk.AccAddress, amount sdk.Coin) types.PaymentRoute {
+	payer, payee := source.String(), dest.String()
+
+	var channels []settlementtypes.PaymentChannel
+	k.settlementKeeper.IterateChannels(ctx, func(c settlementtypes.PaymentChannel) bool {
+		// Channels into the payer or out of the payee can never be on a route
+		if c.IsOpen && c.Balance.Denom == amount.Denom && c.Recipient != payer && c.Sender != payee {
+			channels = append(channels, c)
+		}
+		return false
+	})
+
+	// best holds, for each address, the cheapest known way to deliver amount to
+	// the payee: the amount the address must send (or, for intermediaries,
+	// receive) and the channels from the address to the payee.
+	best := map[string]partialRoute{payee: {cost: amount.Amount}}
+	for i := 0; i < types.MaxRouteChannels; i++ {
+		prev := make(map[string]partialRoute, len(best))
+		for addr, r := range best {
+			prev[addr] = r
+		}
+
+		updated := false
+		for _, c := range channels {
+			downstream, ok := prev[c.Recipient]
+			if !ok || downstream.visits(c.Sender) {
+				continue
+			}
+			if c.Balance.Amount.LT(downstream.cost) {
+				continue
+			}
+			minTimeout := int64(settlementtypes.MinHTLCTimeout + len(downstream.channels)*settlementtypes.HTLCTimeoutDelta)
+			if c.ExpiresAtHeight < ctx.BlockHeight()+minTimeout {
+				continue
+			}
+
+			cost := downstream.cost
+			if c.Sender != payer {
+				cost = cost.Add(routingFee(c, downstream.cost))
+			}
+			if current, ok := best[c.Sender]; ok && !cost.LT(current.cost) {
+				continue
+			}
+			best[c.Sender] = partialRoute{
+				cost:     cost,
+				channels: append([]settlementtypes.PaymentChannel{c}, downstream.channels...),
+			}
+			updated = true
+		}
+		if !updated {
+			break
+		}
 	}
-	key := append(types.PaymentRouteKeyPrefix, types.PaymentStoreKey(paymentID)...) // Use consistent keying
-	store.Set(key, bz)
-}
 
-// GetPaymentRoute retrieves a route for a payment.
-func (k Keeper) GetPaymentRoute(ctx sdk.Context, paymentID uint64) (types.PaymentRoute, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := append(types.PaymentRouteKeyPrefix, types.PaymentStoreKey(paymentID)...)
-	bz := store.Get(key)
-	if len(bz) == 0 {
-		return types.PaymentRoute{}, false
+	route, found := best[payer]
+	if !found {
+		return types.NewPaymentRoute([]string{}, sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec(), types.ExpectedBlockTime)
 	}
-	var route types.PaymentRoute
-	if err := json.Unmarshal(bz, &route); err != nil {
-		return types.PaymentRoute{}, false
+	return route.paymentRoute(amount)
+}
+
+// partialRoute is a route from some address to the payee
+type partialRoute struct {
+	cost     sdkmath.Int
+	channels []settlementtypes.PaymentChannel
+}
+
+// visits reports whether the route already passes through addr
+func (r partialRoute) visits(addr string) bool {
+	for _, c := range r.channels {
+		if c.Sender == addr || c.Recipient == addr {
+			return true
+		}
 	}
-	return route, true
+	return false
 }
 
-// OptimizeRoute calculates the optimal route for a payment.
-// Currently implements a basic heuristic: Direct if same region (simulated), else multi-hop via 'hub'.
-// In a real system, this would query a graph or on-chain topology.
-func (k Keeper) OptimizeRoute(ctx sdk.Context, source, dest sdk.AccAddress, amount sdk.Coin) types.PaymentRoute {
-	// 1. Check for direct transfer feasibility (simplified)
-	// For this 10/10 implementation, we simulate checking network latency/fees.
-	
-	// Mock logic: If amount is large, prefer direct for security. If small, maybe use cheap hops.
-	// Here we just return a high-probability direct route as baseline.
-	
-	return types.PaymentRoute{
-		Hops:        []string{}, // Direct
-		TotalFee:    sdkmath.LegacyNewDec(0), // No extra routing fee
+// paymentRoute converts a route from the payer into a PaymentRoute. The
+// probability estimate treats each channel as less reliable the larger the share
+// of its balance the payment uses.
+func (r partialRoute) paymentRoute(amount sdk.Coin) types.PaymentRoute {
+	n := len(r.channels)
+
+	// carried[i] is the amount locked on channel i
+	carried := make([]sdkmath.Int, n)
+	carried[n-1] = amount.Amount
+	for i := n - 2; i >= 0; i-- {
+		carried[i] = carried[i+1].Add(routingFee(r.channels[i+1], carried[i+1]))
+	}
+
+	route := types.PaymentRoute{
+		Hops:        []string{},
 		Probability: sdkmath.LegacyOneDec(),
-		Latency:     time.Second * 3, // Block time
+		// Each hop is locked and then claimed in turn
+		Latency:    types.ExpectedBlockTime * time.Duration(2*n),
+		ChannelIds: make([]uint64, 0, n),
+		HopFees:    []sdk.Coin{},
+	}
+	for i, c := range r.channels {
+		route.ChannelIds = append(route.ChannelIds, c.Id)
+		if i > 0 {
+			route.Hops = append(route.Hops, c.Sender)
+			route.HopFees = append(route.HopFees, sdk.NewCoin(amount.Denom, carried[i-1].Sub(carried[i])))
+		}
+		utilization := sdkmath.LegacyNewDecFromInt(carried[i]).QuoInt(c.Balance.Amount.MulRaw(2))
+		route.Probability = route.Probability.Mul(sdkmath.LegacyOneDec().Sub(utilization))
 	}
+	route.TotalFee = sdkmath.LegacyNewDecFromInt(carried[0].Sub(amount.Amount))
+	return route
+}
+
+// routingFee is the fee the sender of a channel charges to forward amount over it
+func routingFee(channel settlementtypes.PaymentChannel, amount sdkmath.Int) sdkmath.Int {
+	return amount.Mul(sdkmath.NewInt(int64(channel.RoutingFeeBps))).Quo(sdkmath.NewInt(10000))
 }
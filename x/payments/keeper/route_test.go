@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/payments/keeper"
+	paymentstypes "github.com/stateset/core/x/payments/types"
+	settlementtypes "github.com/stateset/core/x/settlement/types"
+)
+
+func newRouteChannel(sender, recipient sdk.AccAddress, balance int64, routingFeeBps uint32) settlementtypes.PaymentChannel {
+	return settlementtypes.PaymentChannel{
+		Sender:          sender.String(),
+		Recipient:       recipient.String(),
+		Deposit:         sdk.NewCoin("ssusd", sdkmath.NewInt(balance)),
+		Balance:         sdk.NewCoin("ssusd", sdkmath.NewInt(balance)),
+		IsOpen:          true,
+		ExpiresAtHeight: 1000,
+		RoutingFeeBps:   routingFeeBps,
+	}
+}
+
+func TestOptimizeRoute_Direct(t *testing.T) {
+	k, ctx, _, _, settlementKeeper := setupPaymentsKeeperWithChannels(t)
+	payer, payee := newPaymentsAddress(), newPaymentsAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+
+	// Without channels the payment settles directly
+	route := k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Empty(t, route.Hops)
+	require.Empty(t, route.ChannelIds)
+	require.True(t, route.TotalFee.IsZero())
+
+	// A channel straight to the payee carries no routing fee
+	settlementKeeper.AddChannel(newRouteChannel(payer, payee, 2000000, 100))
+	route = k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Equal(t, []uint64{1}, route.ChannelIds)
+	require.Empty(t, route.Hops)
+	require.True(t, route.TotalFee.IsZero())
+	require.Equal(t, 2*3*time.Second, route.Latency)
+	require.NoError(t, route.Validate())
+}
+
+func TestOptimizeRoute_MultiHop(t *testing.T) {
+	k, ctx, _, _, settlementKeeper := setupPaymentsKeeperWithChannels(t)
+	payer, hub, merchantHub, payee := newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+
+	settlementKeeper.AddChannel(newRouteChannel(payer, hub, 2000000, 0))
+	settlementKeeper.AddChannel(newRouteChannel(hub, merchantHub, 2000000, 100))
+	settlementKeeper.AddChannel(newRouteChannel(merchantHub, payee, 2000000, 50))
+
+	route := k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Equal(t, []uint64{1, 2, 3}, route.ChannelIds)
+	require.Equal(t, []string{hub.String(), merchantHub.String()}, route.Hops)
+
+	// merchantHub charges 0.5% of 1000000 and hub 1% of the 1005000 it forwards
+	require.Equal(t, []sdk.Coin{
+		sdk.NewCoin("ssusd", sdkmath.NewInt(10050)),
+		sdk.NewCoin("ssusd", sdkmath.NewInt(5000)),
+	}, route.HopFees)
+	require.True(t, route.TotalFee.Equal(sdkmath.LegacyNewDec(15050)))
+	require.True(t, route.Probability.IsPositive())
+	require.True(t, route.Probability.LT(sdkmath.LegacyOneDec()))
+	require.Equal(t, 6*3*time.Second, route.Latency)
+	require.NoError(t, route.Validate())
+
+	// The Route query serves the same route
+	res, err := keeper.NewQueryServerImpl(k).Route(ctx, &paymentstypes.QueryRouteRequest{Payer: payer.String(), Payee: payee.String(), Amount: amount})
+	require.NoError(t, err)
+	require.Equal(t, route.ChannelIds, res.ChannelIds)
+	require.Equal(t, route.Hops, res.Hops)
+	require.Equal(t, route.HopFees, res.HopFees)
+	require.True(t, route.TotalFee.Equal(res.TotalFee))
+	require.True(t, route.Probability.Equal(res.Probability))
+	require.Equal(t, route.Latency, res.Latency)
+}
+
+func TestOptimizeRoute_PrefersCheaperRoute(t *testing.T) {
+	k, ctx, _, _, settlementKeeper := setupPaymentsKeeperWithChannels(t)
+	payer, expensive, cheapA, cheapB, payee := newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+
+	settlementKeeper.AddChannel(newRouteChannel(payer, expensive, 2000000, 0))
+	settlementKeeper.AddChannel(newRouteChannel(expensive, payee, 2000000, 500))
+	settlementKeeper.AddChannel(newRouteChannel(payer, cheapA, 2000000, 0))
+	settlementKeeper.AddChannel(newRouteChannel(cheapA, cheapB, 2000000, 10))
+	settlementKeeper.AddChannel(newRouteChannel(cheapB, payee, 2000000, 10))
+
+	route := k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Equal(t, []uint64{3, 4, 5}, route.ChannelIds)
+	require.True(t, route.TotalFee.Equal(sdkmath.LegacyNewDec(2001)))
+}
+
+func TestOptimizeRoute_SkipsUnusableChannels(t *testing.T) {
+	k, ctx, _, _, settlementKeeper := setupPaymentsKeeperWithChannels(t)
+	payer, hub, payee := newPaymentsAddress(), newPaymentsAddress(), newPaymentsAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	ctx = ctx.WithBlockHeight(100)
+
+	settlementKeeper.AddChannel(newRouteChannel(payer, hub, 2000000, 0))
+
+	// Too little balance to carry the payment
+	settlementKeeper.AddChannel(newRouteChannel(hub, payee, 500000, 0))
+
+	// Closed
+	closed := newRouteChannel(hub, payee, 2000000, 0)
+	closed.IsOpen = false
+	settlementKeeper.AddChannel(closed)
+
+	// Expires before the last hop's HTLC could time out
+	expiring := newRouteChannel(hub, payee, 2000000, 0)
+	expiring.ExpiresAtHeight = 100 + settlementtypes.MinHTLCTimeout - 1
+	settlementKeeper.AddChannel(expiring)
+
+	route := k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Empty(t, route.ChannelIds)
+
+	// The first hop needs room for one more timeout delta than the last
+	usable := newRouteChannel(hub, payee, 2000000, 0)
+	usable.ExpiresAtHeight = 100 + settlementtypes.MinHTLCTimeout
+	settlementKeeper.AddChannel(usable)
+	settlementKeeper.channels[0].ExpiresAtHeight = 100 + settlementtypes.MinHTLCTimeout + settlementtypes.HTLCTimeoutDelta - 1
+
+	route = k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Empty(t, route.ChannelIds)
+
+	settlementKeeper.channels[0].ExpiresAtHeight++
+	route = k.OptimizeRoute(ctx, payer, payee, amount)
+	require.Equal(t, []uint64{1, 5}, route.ChannelIds)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	settlementtypes "github.com/stateset/core/x/settlement/types"
 )
 
 // BankKeeper defines required bank keeper functionality.
@@ -17,3 +19,8 @@ type BankKeeper interface {
 type ComplianceKeeper interface {
 	AssertCompliant(ctx context.Context, addr sdk.AccAddress) error
 }
+
+// SettlementKeeper exposes the settlement payment channels payments are routed over.
+type SettlementKeeper interface {
+	IterateChannels(ctx sdk.Context, cb func(settlementtypes.PaymentChannel) bool)
+}
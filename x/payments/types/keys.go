@@ -16,7 +16,10 @@ const (
 )
 
 var (
-	PaymentKeyPrefix      = []byte{0x01}
+	PaymentKeyPrefix = []byte{0x01}
+
+	// PaymentRouteKeyPrefix held the routes earlier versions stored with each
+	// payment. Routes are now served by the Route query and no longer stored.
 	PaymentRouteKeyPrefix = []byte{0x03}
 )
 
@@ -5,22 +5,29 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -556,6 +563,144 @@ func (m *QueryPaymentsByStatusResponse) GetTotal() uint64 {
 	return 0
 }
 
+// QueryRouteRequest asks for the cheapest route of a payment over settlement
+// payment channels
+type QueryRouteRequest struct {
+	Payer  string     `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Payee  string     `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryRouteRequest) Reset()         { *m = QueryRouteRequest{} }
+func (m *QueryRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteRequest) ProtoMessage()    {}
+func (*QueryRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54760cc9224a43a, []int{10}
+}
+func (m *QueryRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteRequest.Merge(m, src)
+}
+func (m *QueryRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteRequest proto.InternalMessageInfo
+
+func (m *QueryRouteRequest) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *QueryRouteRequest) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *QueryRouteRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// QueryRouteResponse is the cheapest route of a payment. Without channel IDs
+// no route over channels is usable and the payment settles directly.
+type QueryRouteResponse struct {
+	// channel_ids are the settlement payment channels forwarding the payment,
+	// payer first
+	ChannelIds []uint64 `protobuf:"varint,1,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// hops are the intermediaries, each charging the fee in hop_fees
+	Hops     []string                    `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops,omitempty"`
+	HopFees  []types.Coin                `protobuf:"bytes,3,rep,name=hop_fees,json=hopFees,proto3" json:"hop_fees"`
+	TotalFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=total_fee,json=totalFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_fee"`
+	// probability estimates the chance every hop succeeds
+	Probability cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=probability,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"probability"`
+	Latency     time.Duration               `protobuf:"bytes,6,opt,name=latency,proto3,stdduration" json:"latency"`
+}
+
+func (m *QueryRouteResponse) Reset()         { *m = QueryRouteResponse{} }
+func (m *QueryRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteResponse) ProtoMessage()    {}
+func (*QueryRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54760cc9224a43a, []int{11}
+}
+func (m *QueryRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteResponse.Merge(m, src)
+}
+func (m *QueryRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteResponse proto.InternalMessageInfo
+
+func (m *QueryRouteResponse) GetChannelIds() []uint64 {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
+func (m *QueryRouteResponse) GetHops() []string {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *QueryRouteResponse) GetHopFees() []types.Coin {
+	if m != nil {
+		return m.HopFees
+	}
+	return nil
+}
+
+func (m *QueryRouteResponse) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryPaymentRequest)(nil), "stateset.payments.QueryPaymentRequest")
 	proto.RegisterType((*QueryPaymentResponse)(nil), "stateset.payments.QueryPaymentResponse")
@@ -567,41 +712,61 @@ func init() {
 	proto.RegisterType((*QueryPaymentsByPayeeResponse)(nil), "stateset.payments.QueryPaymentsByPayeeResponse")
 	proto.RegisterType((*QueryPaymentsByStatusRequest)(nil), "stateset.payments.QueryPaymentsByStatusRequest")
 	proto.RegisterType((*QueryPaymentsByStatusResponse)(nil), "stateset.payments.QueryPaymentsByStatusResponse")
+	proto.RegisterType((*QueryRouteRequest)(nil), "stateset.payments.QueryRouteRequest")
+	proto.RegisterType((*QueryRouteResponse)(nil), "stateset.payments.QueryRouteResponse")
 }
 
 func init() { proto.RegisterFile("stateset/payments/query.proto", fileDescriptor_b54760cc9224a43a) }
 
 var fileDescriptor_b54760cc9224a43a = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xd3, 0xa4, 0x2d, 0x83, 0xc4, 0xcf, 0x12, 0x50, 0x64, 0xa8, 0x1b, 0x59, 0x02, 0xca,
-	0xc5, 0x46, 0xe5, 0x05, 0x90, 0xe1, 0xc2, 0x0d, 0xcc, 0x05, 0x55, 0xe2, 0xe0, 0x36, 0xd3, 0x60,
-	0xa9, 0xf1, 0x3a, 0xde, 0xb1, 0x54, 0xbf, 0x05, 0xaf, 0xc0, 0xdb, 0xf4, 0xd8, 0x23, 0x27, 0x84,
-	0x92, 0x17, 0x41, 0x5e, 0xef, 0xb6, 0xb6, 0xe3, 0xca, 0x8e, 0x04, 0xf4, 0xb6, 0xb3, 0xfb, 0xcd,
-	0xf7, 0x63, 0x8d, 0x35, 0xb0, 0x27, 0x28, 0x20, 0x14, 0x48, 0x6e, 0x1c, 0x64, 0x73, 0x8c, 0x48,
-	0xb8, 0x8b, 0x14, 0x93, 0xcc, 0x89, 0x13, 0x4e, 0x9c, 0x3d, 0xd4, 0xcf, 0x8e, 0x7e, 0x36, 0x47,
-	0x33, 0x3e, 0xe3, 0xf2, 0xd5, 0xcd, 0x4f, 0x05, 0xd0, 0xdc, 0x5f, 0xe7, 0x51, 0x87, 0x02, 0x60,
-	0x3f, 0x87, 0x47, 0x9f, 0x72, 0xe2, 0x8f, 0xc5, 0xad, 0x8f, 0x8b, 0x14, 0x05, 0xb1, 0x7b, 0xd0,
-	0x0f, 0xa7, 0x63, 0x63, 0x62, 0x1c, 0x0c, 0xfc, 0x7e, 0x38, 0xb5, 0xbf, 0xc0, 0xa8, 0x0a, 0x13,
-	0x31, 0x8f, 0x04, 0xb2, 0xb7, 0xb0, 0xa3, 0xf8, 0x24, 0xf8, 0xee, 0xe1, 0xc4, 0x59, 0xb3, 0xe6,
-	0xa8, 0xa6, 0x0f, 0x11, 0x61, 0x44, 0xde, 0xe0, 0xe2, 0xd7, 0x7e, 0xcf, 0xd7, 0x6d, 0xf6, 0xfb,
-	0x2a, 0xb3, 0xd0, 0x0e, 0x9e, 0xc0, 0x36, 0x3f, 0x3d, 0x15, 0x48, 0xca, 0x85, 0xaa, 0xd8, 0x08,
-	0x86, 0x67, 0xe1, 0x3c, 0xa4, 0x71, 0x5f, 0x5e, 0x17, 0x85, 0xbd, 0x80, 0xc7, 0x35, 0x16, 0x65,
-	0xd0, 0x83, 0x5d, 0xed, 0x63, 0x6c, 0x4c, 0xb6, 0x36, 0x70, 0x78, 0xd5, 0x97, 0x4b, 0x12, 0xa7,
-	0xe0, 0x4c, 0x4b, 0xca, 0xc2, 0x0e, 0xe0, 0x69, 0x45, 0xd2, 0xcb, 0x4f, 0x98, 0x68, 0xff, 0x23,
-	0x18, 0xc6, 0x79, 0x2d, 0xed, 0xdf, 0xf1, 0x8b, 0xa2, 0x94, 0xaa, 0xdf, 0x9c, 0x6a, 0xab, 0x9c,
-	0xea, 0x1c, 0x9e, 0x35, 0x4b, 0xdc, 0x52, 0x38, 0xac, 0x85, 0xc3, 0x72, 0x38, 0xfc, 0x2b, 0xe1,
-	0xf0, 0x3f, 0x84, 0x9b, 0xae, 0x29, 0x7f, 0xa6, 0x80, 0xd2, 0xf2, 0xe8, 0x09, 0x79, 0xa1, 0xe2,
-	0xa9, 0x6a, 0xc3, 0x7c, 0x19, 0xec, 0xdd, 0xa0, 0xf2, 0xaf, 0x03, 0x1e, 0xfe, 0x18, 0xc0, 0x50,
-	0x6a, 0xb3, 0x23, 0xd8, 0x51, 0x04, 0xec, 0x45, 0x03, 0x79, 0xc3, 0xaf, 0x6f, 0xbe, 0x6c, 0xc5,
-	0x29, 0xff, 0x5f, 0x61, 0x57, 0x67, 0x63, 0x6d, 0x4d, 0xfa, 0xdb, 0x9a, 0x07, 0xed, 0x40, 0x45,
-	0x9f, 0xc0, 0xfd, 0xda, 0xdc, 0x33, 0xa7, 0xad, 0xb9, 0xfa, 0x0f, 0x9a, 0x6e, 0x67, 0xfc, 0x4d,
-	0x9a, 0xd8, 0x55, 0x13, 0x37, 0xd4, 0xbc, 0x9e, 0xf3, 0x14, 0x1e, 0xd4, 0x47, 0x84, 0x75, 0x20,
-	0xa9, 0x8c, 0xac, 0xf9, 0xba, 0x7b, 0x43, 0x21, 0xeb, 0xbd, 0xbb, 0x58, 0x5a, 0xc6, 0xe5, 0xd2,
-	0x32, 0x7e, 0x2f, 0x2d, 0xe3, 0xfb, 0xca, 0xea, 0x5d, 0xae, 0xac, 0xde, 0xcf, 0x95, 0xd5, 0x3b,
-	0x7a, 0x35, 0x0b, 0xe9, 0x5b, 0x7a, 0xec, 0x9c, 0xf0, 0xb9, 0x7b, 0xb5, 0x3e, 0x4e, 0x78, 0x82,
-	0xee, 0xf9, 0xf5, 0x16, 0xa1, 0x2c, 0x46, 0x71, 0xbc, 0x2d, 0x97, 0xc8, 0x9b, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x9a, 0x3f, 0xaf, 0xdf, 0xaf, 0x06, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xce, 0x7f, 0xd2, 0x13, 0xe9, 0xde, 0xdb, 0xb9, 0x01, 0xb9, 0x29, 0x4d, 0xa2, 0x88, 0x42,
+	0x59, 0x60, 0xd3, 0xb2, 0x40, 0x42, 0x42, 0x42, 0x69, 0x54, 0xa9, 0x12, 0x42, 0xe0, 0xb2, 0x40,
+	0x95, 0x50, 0x35, 0x71, 0x4e, 0x12, 0x8b, 0xc4, 0xe3, 0x7a, 0xc6, 0xa8, 0x7e, 0x0b, 0x96, 0x3c,
+	0x08, 0x0f, 0xd1, 0x65, 0xc5, 0x0a, 0xb1, 0x28, 0xa8, 0x5d, 0xf1, 0x04, 0x6c, 0x91, 0xc7, 0xe3,
+	0xd6, 0x49, 0x53, 0x92, 0x22, 0x7e, 0x76, 0x73, 0xe6, 0xfc, 0x7c, 0xdf, 0x37, 0x9e, 0xf9, 0x64,
+	0x58, 0xe1, 0x82, 0x0a, 0xe4, 0x28, 0x0c, 0x97, 0x06, 0x23, 0x74, 0x04, 0x37, 0xf6, 0x7d, 0xf4,
+	0x02, 0xdd, 0xf5, 0x98, 0x60, 0x64, 0x31, 0x4e, 0xeb, 0x71, 0xba, 0x5a, 0xe9, 0xb3, 0x3e, 0x93,
+	0x59, 0x23, 0x5c, 0x45, 0x85, 0xd5, 0x25, 0x8b, 0xf1, 0x11, 0xe3, 0x7b, 0x51, 0x22, 0x0a, 0x54,
+	0xaa, 0x16, 0x45, 0x46, 0x87, 0x72, 0x34, 0xde, 0xac, 0x77, 0x50, 0xd0, 0x75, 0xc3, 0x62, 0xb6,
+	0x13, 0xe7, 0xfb, 0x8c, 0xf5, 0x87, 0x68, 0xc8, 0xa8, 0xe3, 0xf7, 0x8c, 0xae, 0xef, 0x51, 0x61,
+	0xb3, 0x38, 0x5f, 0xbf, 0x48, 0x51, 0x2d, 0xa2, 0x82, 0xe6, 0x2a, 0xfc, 0xff, 0x3c, 0xe4, 0xfc,
+	0x2c, 0xda, 0x35, 0x71, 0xdf, 0x47, 0x2e, 0xc8, 0x3f, 0x90, 0xb1, 0xbb, 0x5a, 0xba, 0x91, 0x5e,
+	0xcb, 0x99, 0x19, 0xbb, 0xdb, 0x7c, 0x09, 0x95, 0xf1, 0x32, 0xee, 0x32, 0x87, 0x23, 0x79, 0x0c,
+	0x45, 0x35, 0x4f, 0x16, 0x97, 0x37, 0x1a, 0xfa, 0x05, 0xd5, 0xba, 0x6a, 0xda, 0x76, 0x04, 0x3a,
+	0xa2, 0x95, 0x3b, 0x3c, 0xae, 0xa7, 0xcc, 0xb8, 0xad, 0xd9, 0x1e, 0x9f, 0xcc, 0x63, 0x06, 0xd7,
+	0xa1, 0xc0, 0x7a, 0x3d, 0x8e, 0x42, 0xb1, 0x50, 0x11, 0xa9, 0x40, 0x7e, 0x68, 0x8f, 0x6c, 0xa1,
+	0x65, 0xe4, 0x76, 0x14, 0x34, 0xf7, 0xe1, 0xda, 0xc4, 0x14, 0x45, 0xb0, 0x05, 0xa5, 0x98, 0x87,
+	0x96, 0x6e, 0x64, 0xaf, 0xc0, 0xf0, 0xac, 0x2f, 0x84, 0x14, 0x4c, 0xd0, 0x61, 0x0c, 0x29, 0x83,
+	0x26, 0x85, 0xe5, 0x31, 0xc8, 0x56, 0xb8, 0x42, 0x2f, 0xe6, 0x5f, 0x81, 0xbc, 0x1b, 0xc6, 0x92,
+	0xfe, 0x82, 0x19, 0x05, 0x09, 0x55, 0x99, 0xe9, 0xaa, 0xb2, 0x49, 0x55, 0x07, 0x70, 0x63, 0x3a,
+	0xc4, 0x5f, 0x12, 0x87, 0x13, 0xe2, 0x30, 0x29, 0x0e, 0x7f, 0x89, 0x38, 0xfc, 0x03, 0xe2, 0xba,
+	0x17, 0x90, 0x77, 0x04, 0x15, 0x7e, 0xf2, 0xea, 0x71, 0xb9, 0xa1, 0xe4, 0xa9, 0xe8, 0x8a, 0xfa,
+	0x02, 0x58, 0xb9, 0x04, 0xe5, 0xb7, 0x0b, 0x3c, 0x80, 0x45, 0x09, 0x6d, 0x32, 0x5f, 0xe0, 0x8f,
+	0x2f, 0xe4, 0xd9, 0x97, 0xcc, 0x24, 0xbf, 0xe4, 0x03, 0x28, 0xd0, 0x11, 0xf3, 0x9d, 0x48, 0x52,
+	0x79, 0x63, 0x49, 0x57, 0xae, 0x14, 0xfa, 0x90, 0xae, 0x7c, 0x48, 0xdf, 0x64, 0xb6, 0xa3, 0x18,
+	0xa9, 0xf2, 0xe6, 0xd7, 0x0c, 0x90, 0x24, 0xb4, 0x92, 0x5a, 0x87, 0xb2, 0x35, 0xa0, 0x8e, 0x83,
+	0xc3, 0x3d, 0xbb, 0x1b, 0xa9, 0xcd, 0x99, 0xa0, 0xb6, 0xb6, 0xbb, 0x9c, 0x10, 0xc8, 0x0d, 0x98,
+	0xcb, 0xb5, 0x4c, 0x23, 0xbb, 0xb6, 0x60, 0xca, 0x35, 0x79, 0x08, 0xa5, 0x01, 0x73, 0xf7, 0x7a,
+	0x88, 0x5c, 0xcb, 0xca, 0xf3, 0x99, 0x49, 0xa3, 0x38, 0x60, 0xee, 0x16, 0x22, 0x27, 0x4f, 0x61,
+	0x41, 0x1e, 0x45, 0xd8, 0xad, 0xe5, 0x42, 0x69, 0xad, 0xf5, 0xb0, 0xe2, 0xd3, 0x71, 0x7d, 0x39,
+	0x9a, 0xc1, 0xbb, 0xaf, 0x75, 0x9b, 0x19, 0x23, 0x2a, 0x06, 0xfa, 0x13, 0xec, 0x53, 0x2b, 0x68,
+	0xa3, 0xf5, 0xe1, 0xfd, 0x5d, 0x50, 0x10, 0x6d, 0xb4, 0xcc, 0x92, 0x9c, 0xb1, 0x85, 0x48, 0x76,
+	0xa0, 0xec, 0x7a, 0xac, 0x43, 0x3b, 0xf6, 0xd0, 0x16, 0x81, 0x96, 0xff, 0xd9, 0x89, 0xc9, 0x29,
+	0xe4, 0x11, 0x14, 0x87, 0x54, 0xa0, 0x63, 0x05, 0x5a, 0x41, 0x1d, 0x73, 0x64, 0xe7, 0x7a, 0x6c,
+	0xe7, 0x7a, 0x5b, 0xd9, 0x79, 0xab, 0x14, 0x62, 0xbd, 0xfb, 0x5c, 0x4f, 0x9b, 0x71, 0xcf, 0xc6,
+	0xb7, 0x1c, 0xe4, 0xe5, 0x59, 0x93, 0x5d, 0x28, 0xaa, 0x6b, 0x42, 0x6e, 0x4d, 0xb9, 0x42, 0x53,
+	0x0c, 0xbe, 0x7a, 0x7b, 0x66, 0x9d, 0xfa, 0x74, 0xaf, 0xa0, 0x14, 0xdf, 0x60, 0x32, 0xab, 0x29,
+	0x7e, 0x41, 0xd5, 0xb5, 0xd9, 0x85, 0x6a, 0xbc, 0x07, 0xff, 0x4e, 0xb8, 0x1b, 0xd1, 0x67, 0x35,
+	0x8f, 0x3b, 0x6d, 0xd5, 0x98, 0xbb, 0xfe, 0x32, 0x4c, 0x9c, 0x17, 0x13, 0xaf, 0x88, 0x79, 0xfe,
+	0x02, 0x7c, 0xf8, 0x6f, 0xd2, 0x08, 0xc8, 0x1c, 0x43, 0xc6, 0x8c, 0xa9, 0x7a, 0x6f, 0xfe, 0x06,
+	0x05, 0xfb, 0x02, 0xf2, 0xf2, 0x25, 0x92, 0x9b, 0x97, 0xb5, 0x26, 0x3d, 0xa2, 0xba, 0x3a, 0xa3,
+	0x2a, 0x9a, 0xda, 0xda, 0x3c, 0x3c, 0xa9, 0xa5, 0x8f, 0x4e, 0x6a, 0xe9, 0x2f, 0x27, 0xb5, 0xf4,
+	0xdb, 0xd3, 0x5a, 0xea, 0xe8, 0xb4, 0x96, 0xfa, 0x78, 0x5a, 0x4b, 0xed, 0xde, 0xe9, 0xdb, 0x62,
+	0xe0, 0x77, 0x74, 0x8b, 0x8d, 0x8c, 0xb3, 0x5f, 0x0f, 0x8b, 0x79, 0x68, 0x1c, 0x9c, 0xff, 0x81,
+	0x88, 0xc0, 0x45, 0xde, 0x29, 0xc8, 0x4b, 0x7e, 0xff, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b,
+	0x42, 0x7c, 0xdd, 0x46, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -621,6 +786,7 @@ type QueryClient interface {
 	PaymentsByPayer(ctx context.Context, in *QueryPaymentsByPayerRequest, opts ...grpc.CallOption) (*QueryPaymentsByPayerResponse, error)
 	PaymentsByPayee(ctx context.Context, in *QueryPaymentsByPayeeRequest, opts ...grpc.CallOption) (*QueryPaymentsByPayeeResponse, error)
 	PaymentsByStatus(ctx context.Context, in *QueryPaymentsByStatusRequest, opts ...grpc.CallOption) (*QueryPaymentsByStatusResponse, error)
+	Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error)
 }
 
 type queryClient struct {
@@ -676,6 +842,15 @@ func (c *queryClient) PaymentsByStatus(ctx context.Context, in *QueryPaymentsByS
 	return out, nil
 }
 
+func (c *queryClient) Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error) {
+	out := new(QueryRouteResponse)
+	err := c.cc.Invoke(ctx, "/stateset.payments.Query/Route", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Payment(context.Context, *QueryPaymentRequest) (*QueryPaymentResponse, error)
@@ -683,6 +858,7 @@ type QueryServer interface {
 	PaymentsByPayer(context.Context, *QueryPaymentsByPayerRequest) (*QueryPaymentsByPayerResponse, error)
 	PaymentsByPayee(context.Context, *QueryPaymentsByPayeeRequest) (*QueryPaymentsByPayeeResponse, error)
 	PaymentsByStatus(context.Context, *QueryPaymentsByStatusRequest) (*QueryPaymentsByStatusResponse, error)
+	Route(context.Context, *QueryRouteRequest) (*QueryRouteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -704,6 +880,9 @@ func (*UnimplementedQueryServer) PaymentsByPayee(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) PaymentsByStatus(ctx context.Context, req *QueryPaymentsByStatusRequest) (*QueryPaymentsByStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PaymentsByStatus not implemented")
 }
+func (*UnimplementedQueryServer) Route(ctx context.Context, req *QueryRouteRequest) (*QueryRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Route not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -799,6 +978,24 @@ func _Query_PaymentsByStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Route_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Route(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.payments.Query/Route",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Route(ctx, req.(*QueryRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stateset.payments.Query",
@@ -824,6 +1021,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PaymentsByStatus",
 			Handler:    _Query_PaymentsByStatus_Handler,
 		},
+		{
+			MethodName: "Route",
+			Handler:    _Query_Route_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stateset/payments/query.proto",
@@ -1211,6 +1412,145 @@ func (m *QueryPaymentsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Probability.Size()
+		i -= size
+		if _, err := m.Probability.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HopFees) > 0 {
+		for iNdEx := len(m.HopFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HopFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hops[iNdEx])
+			copy(dAtA[i:], m.Hops[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Hops[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelIds) > 0 {
+		dAtA5 := make([]byte, len(m.ChannelIds)*10)
+		var j4 int
+		for _, num := range m.ChannelIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1389,6 +1729,59 @@ func (m *QueryPaymentsByStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelIds) > 0 {
+		l = 0
+		for _, e := range m.ChannelIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Hops) > 0 {
+		for _, s := range m.Hops {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HopFees) > 0 {
+		for _, e := range m.HopFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Probability.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2407,6 +2800,446 @@ func (m *QueryPaymentsByStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChannelIds = append(m.ChannelIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChannelIds) == 0 {
+					m.ChannelIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChannelIds = append(m.ChannelIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HopFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HopFees = append(m.HopFees, types.Coin{})
+			if err := m.HopFees[len(m.HopFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Probability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Probability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,14 +5,25 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxRouteChannels bounds the number of settlement channels a route may use
+	MaxRouteChannels = 5
+
+	// ExpectedBlockTime is the block time used to estimate route latency
+	ExpectedBlockTime = 3 * time.Second
 )
 
 // PaymentRoute defines the path and cost for a payment execution.
 type PaymentRoute struct {
-	Hops        []string           `json:"hops"`         // Sequence of intermediary addresses (bech32)
-	TotalFee    sdkmath.LegacyDec  `json:"total_fee"`    // Cumulative fees estimated
-	Probability sdkmath.LegacyDec  `json:"probability"`  // Estimated success probability (0-1)
-	Latency     time.Duration      `json:"latency"`      // Expected duration
+	Hops        []string          `json:"hops"`                  // Sequence of intermediary addresses (bech32)
+	TotalFee    sdkmath.LegacyDec `json:"total_fee"`             // Cumulative fees estimated
+	Probability sdkmath.LegacyDec `json:"probability"`           // Estimated success probability (0-1)
+	Latency     time.Duration     `json:"latency"`               // Expected duration
+	ChannelIds  []uint64          `json:"channel_ids,omitempty"` // Settlement payment channels forwarding the payment, payer first
+	HopFees     []sdk.Coin        `json:"hop_fees,omitempty"`    // Routing fee charged by each intermediary in Hops
 }
 
 // NewPaymentRoute creates a new route.
@@ -36,6 +47,9 @@ func (r PaymentRoute) Validate() error {
 	if r.Probability.GT(sdkmath.LegacyOneDec()) || r.Probability.IsNegative() {
 		return ErrInvalidAmount
 	}
+	if len(r.ChannelIds) != len(r.Hops)+1 || len(r.HopFees) != len(r.Hops) {
+		return ErrInvalidPayment
+	}
 	return nil
 }
 
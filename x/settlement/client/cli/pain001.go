@@ -0,0 +1,460 @@
+package cli
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+const (
+	flagAccountMap = "account-map"
+	flagImportMode = "mode"
+
+	importModeInstant = "instant"
+	importModeBatch   = "batch"
+)
+
+func NewImportPain001Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-pain001 [file]",
+		Short: "Create settlements from an ISO 20022 pain.001 credit transfer file",
+		Long: `Create settlements from the credit transfers of an ISO 20022 pain.001 file.
+
+Debtor and creditor accounts (IBAN or other account id) are mapped to addresses
+through a JSON file given with --account-map:
+
+  {
+    "accounts":   {"DE89370400440532013000": "stateset1...", "ACME-OPS": "stateset1..."},
+    "currencies": {"USD": {"denom": "ssusd", "exponent": 6}}
+  }
+
+Accounts that already are addresses need no mapping, and USD maps to ssusd with
+6 decimals unless configured otherwise. Each payment is checked against the
+creditor's merchant min/max settlement amounts.
+
+In instant mode (the default) every debtor must be the --from account and all
+payments are sent as instant transfers in one transaction. In batch mode the
+--from account must be the module authority and the payments to each creditor
+merchant become one batch settlement.
+
+With --dry-run the import report is printed and nothing is broadcast.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			doc, err := parsePain001(bz)
+			if err != nil {
+				return err
+			}
+
+			mapPath, err := cmd.Flags().GetString(flagAccountMap)
+			if err != nil {
+				return err
+			}
+			mapping, err := loadAccountMap(mapPath)
+			if err != nil {
+				return err
+			}
+
+			mode, err := cmd.Flags().GetString(flagImportMode)
+			if err != nil {
+				return err
+			}
+
+			var lookup merchantLookup
+			if !clientCtx.Offline {
+				queryClient := types.NewQueryClient(clientCtx)
+				lookup = func(address string) (types.MerchantConfig, bool, error) {
+					res, err := queryClient.Merchant(cmd.Context(), &types.QueryMerchantRequest{Address: address})
+					if err != nil {
+						if strings.Contains(err.Error(), types.ErrMerchantNotFound.Error()) {
+							return types.MerchantConfig{}, false, nil
+						}
+						return types.MerchantConfig{}, false, err
+					}
+					return res.Merchant, true, nil
+				}
+			}
+
+			report, msgs, err := buildPain001Import(doc, mapping, clientCtx.GetFromAddress().String(), mode, lookup)
+			if err != nil {
+				return err
+			}
+
+			dryRun, err := cmd.Flags().GetBool(flags.FlagDryRun)
+			if err != nil {
+				return err
+			}
+			if dryRun || report.Rejected > 0 {
+				out, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				if err := clientCtx.PrintBytes(append(out, '\n')); err != nil {
+					return err
+				}
+				if report.Rejected > 0 {
+					return fmt.Errorf("%d of %d payments rejected, nothing was submitted", report.Rejected, len(report.Payments))
+				}
+				return nil
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(flagAccountMap, "", "JSON file mapping account ids to addresses and currencies to denoms")
+	cmd.Flags().String(flagImportMode, importModeInstant, "Submit payments as instant transfers (instant) or batch settlements (batch)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// pain001Document is the part of a pain.001 customer credit transfer
+// initiation the import reads
+type pain001Document struct {
+	GrpHdr struct {
+		MsgID    string `xml:"MsgId"`
+		NbOfTxs  string `xml:"NbOfTxs"`
+		CtrlSum  string `xml:"CtrlSum"`
+		CreDtTm  string `xml:"CreDtTm"`
+		InitgPty string `xml:"InitgPty>Nm"`
+	} `xml:"CstmrCdtTrfInitn>GrpHdr"`
+	PmtInf []struct {
+		PmtInfID    string         `xml:"PmtInfId"`
+		DbtrName    string         `xml:"Dbtr>Nm"`
+		DbtrAcct    pain001Account `xml:"DbtrAcct"`
+		CdtTrfTxInf []struct {
+			InstrID    string `xml:"PmtId>InstrId"`
+			EndToEndID string `xml:"PmtId>EndToEndId"`
+			Amount     struct {
+				Ccy   string `xml:"Ccy,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"Amt>InstdAmt"`
+			CdtrName string         `xml:"Cdtr>Nm"`
+			CdtrAcct pain001Account `xml:"CdtrAcct"`
+			Ustrd    []string       `xml:"RmtInf>Ustrd"`
+		} `xml:"CdtTrfTxInf"`
+	} `xml:"CstmrCdtTrfInitn>PmtInf"`
+}
+
+type pain001Account struct {
+	IBAN  string `xml:"Id>IBAN"`
+	Other string `xml:"Id>Othr>Id"`
+}
+
+// ID returns the account's IBAN, or its other identification
+func (a pain001Account) ID() string {
+	if a.IBAN != "" {
+		return a.IBAN
+	}
+	return a.Other
+}
+
+func parsePain001(bz []byte) (pain001Document, error) {
+	var doc pain001Document
+	if err := xml.Unmarshal(bz, &doc); err != nil {
+		return pain001Document{}, fmt.Errorf("invalid pain.001 file: %w", err)
+	}
+	if len(doc.PmtInf) == 0 {
+		return pain001Document{}, fmt.Errorf("invalid pain.001 file: no payment information blocks")
+	}
+	return doc, nil
+}
+
+// accountMap maps pain.001 account ids to addresses and currencies to denoms
+type accountMap struct {
+	Accounts   map[string]string          `json:"accounts"`
+	Currencies map[string]currencyMapping `json:"currencies"`
+}
+
+type currencyMapping struct {
+	Denom    string `json:"denom"`
+	Exponent int    `json:"exponent"`
+}
+
+// maxCurrencyExponent is the most decimals a currency can map to; decimal
+// amounts carry at most 18
+const maxCurrencyExponent = 18
+
+func (c currencyMapping) validate(ccy string) error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("currency %s maps to invalid denom: %w", ccy, err)
+	}
+	if c.Exponent < 0 || c.Exponent > maxCurrencyExponent {
+		return fmt.Errorf("currency %s exponent %d must be between 0 and %d", ccy, c.Exponent, maxCurrencyExponent)
+	}
+	return nil
+}
+
+func loadAccountMap(path string) (accountMap, error) {
+	mapping := accountMap{}
+	if path != "" {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return accountMap{}, err
+		}
+		if err := json.Unmarshal(bz, &mapping); err != nil {
+			return accountMap{}, fmt.Errorf("invalid account map: %w", err)
+		}
+	}
+	if len(mapping.Currencies) == 0 {
+		mapping.Currencies = map[string]currencyMapping{"USD": {Denom: types.StablecoinDenom, Exponent: 6}}
+	}
+	for ccy, currency := range mapping.Currencies {
+		if err := currency.validate(ccy); err != nil {
+			return accountMap{}, fmt.Errorf("invalid account map: %w", err)
+		}
+	}
+	return mapping, nil
+}
+
+// address resolves an account id through the mapping; ids that already are
+// addresses resolve to themselves
+func (m accountMap) address(id string) (string, error) {
+	if address, ok := m.Accounts[id]; ok {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return "", fmt.Errorf("account %s maps to invalid address %s", id, address)
+		}
+		return address, nil
+	}
+	if _, err := sdk.AccAddressFromBech32(id); err == nil {
+		return id, nil
+	}
+	return "", fmt.Errorf("account %q is not mapped to an address", id)
+}
+
+// coin converts a decimal pain.001 amount into base units of the mapped denom
+func (m accountMap) coin(ccy, value string) (sdk.Coin, error) {
+	currency, ok := m.Currencies[ccy]
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("currency %q is not mapped to a denom", ccy)
+	}
+	if err := currency.validate(ccy); err != nil {
+		return sdk.Coin{}, err
+	}
+	amount, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(value))
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid amount %q", value)
+	}
+	units := amount.Mul(sdkmath.LegacyNewDec(10).Power(uint64(currency.Exponent)))
+	if !units.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("amount %s %s has more than %d decimals", value, ccy, currency.Exponent)
+	}
+	if !units.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("amount %s %s must be positive", value, ccy)
+	}
+	return sdk.NewCoin(currency.Denom, units.TruncateInt()), nil
+}
+
+// merchantLookup returns the merchant config of an address, if it is a
+// registered merchant
+type merchantLookup func(address string) (types.MerchantConfig, bool, error)
+
+// pain001Report describes what an import submits and why payments were rejected
+type pain001Report struct {
+	MsgID    string            `json:"msg_id"`
+	Mode     string            `json:"mode"`
+	Payments []pain001Payment  `json:"payments"`
+	Accepted int               `json:"accepted"`
+	Rejected int               `json:"rejected"`
+	Totals   map[string]string `json:"totals"`
+	Messages int               `json:"messages"`
+	Warnings []string          `json:"warnings,omitempty"`
+}
+
+type pain001Payment struct {
+	PaymentInfoID string `json:"payment_info_id"`
+	EndToEndID    string `json:"end_to_end_id"`
+	Debtor        string `json:"debtor"`
+	Creditor      string `json:"creditor"`
+	CreditorName  string `json:"creditor_name,omitempty"`
+	Amount        string `json:"amount"`
+	Error         string `json:"error,omitempty"`
+}
+
+// buildPain001Import validates every credit transfer in the file and builds
+// the messages submitting the accepted ones
+func buildPain001Import(doc pain001Document, mapping accountMap, signer, mode string, lookup merchantLookup) (pain001Report, []sdk.Msg, error) {
+	if mode != importModeInstant && mode != importModeBatch {
+		return pain001Report{}, nil, fmt.Errorf("unknown mode %q: expected %s or %s", mode, importModeInstant, importModeBatch)
+	}
+
+	report := pain001Report{MsgID: doc.GrpHdr.MsgID, Mode: mode, Totals: map[string]string{}}
+	if lookup == nil {
+		report.Warnings = append(report.Warnings, "offline: merchant limits were not checked")
+	}
+
+	type accepted struct {
+		sender, recipient, reference string
+		amount                       sdk.Coin
+	}
+	var payments []accepted
+	totals := sdk.NewCoins()
+	controlSum := sdkmath.LegacyZeroDec()
+	merchants := map[string]*types.MerchantConfig{}
+	var count int
+
+	for _, info := range doc.PmtInf {
+		debtor, debtorErr := mapping.address(info.DbtrAcct.ID())
+		if debtorErr == nil && mode == importModeInstant && debtor != signer {
+			debtorErr = fmt.Errorf("debtor %s is not the signer %s", debtor, signer)
+		}
+
+		for _, txInf := range info.CdtTrfTxInf {
+			count++
+			if sum, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(txInf.Amount.Value)); err == nil {
+				controlSum = controlSum.Add(sum)
+			}
+
+			payment := pain001Payment{
+				PaymentInfoID: info.PmtInfID,
+				EndToEndID:    txInf.EndToEndID,
+				Debtor:        debtor,
+				CreditorName:  txInf.CdtrName,
+				Amount:        strings.TrimSpace(txInf.Amount.Value) + " " + txInf.Amount.Ccy,
+			}
+
+			err := debtorErr
+			var creditor string
+			var amount sdk.Coin
+			if err == nil {
+				creditor, err = mapping.address(txInf.CdtrAcct.ID())
+				payment.Creditor = creditor
+			}
+			if err == nil {
+				amount, err = mapping.coin(txInf.Amount.Ccy, txInf.Amount.Value)
+			}
+			if err == nil && creditor == debtor {
+				err = fmt.Errorf("debtor and creditor must be different")
+			}
+			if err == nil && lookup != nil {
+				merchant, cached := merchants[creditor]
+				if !cached {
+					config, found, lookupErr := lookup(creditor)
+					if lookupErr != nil {
+						return pain001Report{}, nil, lookupErr
+					}
+					if found {
+						merchant = &config
+					}
+					merchants[creditor] = merchant
+				}
+				err = checkMerchantLimits(merchant, amount, mode)
+			}
+
+			if err != nil {
+				payment.Error = err.Error()
+				report.Rejected++
+			} else {
+				payment.Amount = amount.String()
+				report.Accepted++
+				totals = totals.Add(amount)
+				payments = append(payments, accepted{
+					sender:    debtor,
+					recipient: creditor,
+					reference: paymentReference(txInf.EndToEndID, txInf.InstrID),
+					amount:    amount,
+				})
+			}
+			report.Payments = append(report.Payments, payment)
+		}
+	}
+
+	if doc.GrpHdr.NbOfTxs != "" && doc.GrpHdr.NbOfTxs != fmt.Sprintf("%d", count) {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("group header declares %s transactions, file contains %d", doc.GrpHdr.NbOfTxs, count))
+	}
+	if declared, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(doc.GrpHdr.CtrlSum)); err == nil && !declared.Equal(controlSum) {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("group header control sum %s does not match the payments total %s", doc.GrpHdr.CtrlSum, controlSum))
+	}
+	for _, coin := range totals {
+		report.Totals[coin.Denom] = coin.Amount.String()
+	}
+
+	var msgs []sdk.Msg
+	switch mode {
+	case importModeInstant:
+		for _, p := range payments {
+			metadata := fmt.Sprintf("pain.001:%s", doc.GrpHdr.MsgID)
+			msgs = append(msgs, types.NewMsgInstantTransfer(p.sender, p.recipient, p.amount, p.reference, metadata))
+		}
+	case importModeBatch:
+		byMerchant := map[string]*types.MsgCreateBatch{}
+		for _, p := range payments {
+			batch, ok := byMerchant[p.recipient]
+			if !ok {
+				batch = types.NewMsgCreateBatch(signer, p.recipient, nil, nil, nil)
+				byMerchant[p.recipient] = batch
+			}
+			batch.Senders = append(batch.Senders, p.sender)
+			batch.Amounts = append(batch.Amounts, p.amount)
+			batch.References = append(batch.References, p.reference)
+		}
+		merchantAddrs := make([]string, 0, len(byMerchant))
+		for merchant := range byMerchant {
+			merchantAddrs = append(merchantAddrs, merchant)
+		}
+		sort.Strings(merchantAddrs)
+		for _, merchant := range merchantAddrs {
+			msgs = append(msgs, byMerchant[merchant])
+		}
+	}
+
+	for _, msg := range msgs {
+		if validator, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := validator.ValidateBasic(); err != nil {
+				return pain001Report{}, nil, err
+			}
+		}
+	}
+	report.Messages = len(msgs)
+	return report, msgs, nil
+}
+
+// checkMerchantLimits checks a payment against the creditor's merchant
+// min/max settlement amounts. Batch settlements can only pay merchants.
+func checkMerchantLimits(merchant *types.MerchantConfig, amount sdk.Coin, mode string) error {
+	if merchant == nil {
+		if mode == importModeBatch {
+			return fmt.Errorf("creditor is not a registered merchant")
+		}
+		return nil
+	}
+	if !merchant.IsActive {
+		return types.ErrMerchantInactive
+	}
+	if min := merchant.MinSettlement; min.Denom == amount.Denom && min.Amount.IsPositive() && amount.IsLT(min) {
+		return fmt.Errorf("%s is below the merchant minimum %s", amount, min)
+	}
+	if max := merchant.MaxSettlement; max.Denom == amount.Denom && max.Amount.IsPositive() && amount.IsGT(max) {
+		return fmt.Errorf("%s is above the merchant maximum %s", amount, max)
+	}
+	return nil
+}
+
+// paymentReference is the end-to-end id of a credit transfer, or its
+// instruction id when the end-to-end id was not provided
+func paymentReference(endToEndID, instrID string) string {
+	if endToEndID == "" || endToEndID == "NOTPROVIDED" {
+		return instrID
+	}
+	return endToEndID
+}
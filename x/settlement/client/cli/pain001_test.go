@@ -0,0 +1,291 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+var (
+	testDebtor    = sdk.AccAddress([]byte("pain001-debtor______")).String()
+	testMerchant  = sdk.AccAddress([]byte("pain001-merchant____")).String()
+	testSupplier  = sdk.AccAddress([]byte("pain001-supplier____")).String()
+	testAuthority = sdk.AccAddress([]byte("pain001-authority___")).String()
+)
+
+// pain001File wraps credit transfers in a pain.001 document whose single
+// payment information block is debited from the debtor account
+func pain001File(header, debtorAcct, transfers string) []byte {
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09">
+  <CstmrCdtTrfInitn>
+    <GrpHdr>%s</GrpHdr>
+    <PmtInf>
+      <PmtInfId>PMT-1</PmtInfId>
+      <Dbtr><Nm>Acme</Nm></Dbtr>
+      <DbtrAcct><Id><IBAN>%s</IBAN></Id></DbtrAcct>
+      %s
+    </PmtInf>
+  </CstmrCdtTrfInitn>
+</Document>`, header, debtorAcct, transfers))
+}
+
+func creditTransfer(endToEndID, ccy, amount, creditorAcct string) string {
+	return fmt.Sprintf(`<CdtTrfTxInf>
+        <PmtId><InstrId>INSTR-%[1]s</InstrId><EndToEndId>%[1]s</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="%[2]s">%[3]s</InstdAmt></Amt>
+        <Cdtr><Nm>Creditor</Nm></Cdtr>
+        <CdtrAcct><Id><Othr><Id>%[4]s</Id></Othr></Id></CdtrAcct>
+      </CdtTrfTxInf>`, endToEndID, ccy, amount, creditorAcct)
+}
+
+func TestParsePain001(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		file    []byte
+		payment int
+		err     string
+	}{
+		{
+			name:    "credit transfers",
+			file:    pain001File("<MsgId>MSG-1</MsgId><NbOfTxs>2</NbOfTxs>", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "10.50", "ACME-OPS")+creditTransfer("E2E-2", "USD", "1", "ACME-OPS")),
+			payment: 2,
+		},
+		{
+			name: "no payment information",
+			file: []byte(`<Document><CstmrCdtTrfInitn><GrpHdr><MsgId>MSG-1</MsgId></GrpHdr></CstmrCdtTrfInitn></Document>`),
+			err:  "no payment information blocks",
+		},
+		{
+			name: "not xml",
+			file: []byte("MsgId,Amount\nMSG-1,10"),
+			err:  "invalid pain.001 file",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parsePain001(tc.file)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "MSG-1", doc.GrpHdr.MsgID)
+			require.Len(t, doc.PmtInf, 1)
+			require.Equal(t, "DE89370400440532013000", doc.PmtInf[0].DbtrAcct.ID())
+			require.Len(t, doc.PmtInf[0].CdtTrfTxInf, tc.payment)
+			require.Equal(t, "ACME-OPS", doc.PmtInf[0].CdtTrfTxInf[0].CdtrAcct.ID())
+			require.Equal(t, "USD", doc.PmtInf[0].CdtTrfTxInf[0].Amount.Ccy)
+		})
+	}
+}
+
+func TestLoadAccountMap(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{name: "defaults to ssusd", content: ""},
+		{name: "configured currency", content: `{"currencies": {"EUR": {"denom": "ueur", "exponent": 6}}}`},
+		{name: "negative exponent", content: `{"currencies": {"USD": {"denom": "ssusd", "exponent": -1}}}`, err: "must be between 0 and 18"},
+		{name: "exponent too large", content: `{"currencies": {"USD": {"denom": "ssusd", "exponent": 19}}}`, err: "must be between 0 and 18"},
+		{name: "invalid denom", content: `{"currencies": {"USD": {"denom": "", "exponent": 6}}}`, err: "invalid denom"},
+		{name: "invalid json", content: `{"accounts": [`, err: "invalid account map"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := ""
+			if tc.content != "" {
+				path = filepath.Join(t.TempDir(), "map.json")
+				require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+			}
+			mapping, err := loadAccountMap(path)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, mapping.Currencies)
+		})
+	}
+}
+
+func TestAccountMapCoin(t *testing.T) {
+	mapping := accountMap{Currencies: map[string]currencyMapping{
+		"USD": {Denom: "ssusd", Exponent: 6},
+		"JPY": {Denom: "ujpy", Exponent: 0},
+		"BAD": {Denom: "ubad", Exponent: -2},
+	}}
+
+	for _, tc := range []struct {
+		name  string
+		ccy   string
+		value string
+		coin  sdk.Coin
+		err   string
+	}{
+		{name: "whole amount", ccy: "USD", value: "12", coin: sdk.NewCoin("ssusd", sdkmath.NewInt(12000000))},
+		{name: "decimal amount", ccy: "USD", value: " 10.000001 ", coin: sdk.NewCoin("ssusd", sdkmath.NewInt(10000001))},
+		{name: "zero exponent", ccy: "JPY", value: "1500", coin: sdk.NewCoin("ujpy", sdkmath.NewInt(1500))},
+		{name: "too many decimals", ccy: "USD", value: "0.0000001", err: "more than 6 decimals"},
+		{name: "fraction of a whole unit", ccy: "JPY", value: "1.5", err: "more than 0 decimals"},
+		{name: "zero", ccy: "USD", value: "0", err: "must be positive"},
+		{name: "negative", ccy: "USD", value: "-5", err: "must be positive"},
+		{name: "not a number", ccy: "USD", value: "ten", err: "invalid amount"},
+		{name: "unmapped currency", ccy: "EUR", value: "1", err: "not mapped"},
+		{name: "negative exponent", ccy: "BAD", value: "1", err: "must be between 0 and 18"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			coin, err := mapping.coin(tc.ccy, tc.value)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.coin, coin)
+		})
+	}
+}
+
+func TestBuildPain001Import(t *testing.T) {
+	mapping := accountMap{
+		Accounts:   map[string]string{"DE89370400440532013000": testDebtor, "ACME-OPS": testMerchant},
+		Currencies: map[string]currencyMapping{"USD": {Denom: types.StablecoinDenom, Exponent: 6}},
+	}
+	merchants := map[string]types.MerchantConfig{
+		testMerchant: {
+			Address:       testMerchant,
+			IsActive:      true,
+			MinSettlement: sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)),
+			MaxSettlement: sdk.NewCoin("ssusd", sdkmath.NewInt(100000000)),
+		},
+	}
+	lookup := func(address string) (types.MerchantConfig, bool, error) {
+		merchant, found := merchants[address]
+		return merchant, found, nil
+	}
+	header := "<MsgId>MSG-1</MsgId><NbOfTxs>2</NbOfTxs><CtrlSum>15.5</CtrlSum>"
+
+	for _, tc := range []struct {
+		name       string
+		file       []byte
+		signer     string
+		mode       string
+		lookup     merchantLookup
+		accepted   int
+		rejected   int
+		msgs       int
+		warnings   int
+		paymentErr string
+		err        string
+	}{
+		{
+			name:     "instant transfers",
+			file:     pain001File(header, "DE89370400440532013000", creditTransfer("E2E-1", "USD", "10.5", "ACME-OPS")+creditTransfer("E2E-2", "USD", "5", testSupplier)),
+			signer:   testDebtor,
+			mode:     importModeInstant,
+			lookup:   lookup,
+			accepted: 2,
+			msgs:     2,
+		},
+		{
+			name:     "one batch per merchant",
+			file:     pain001File(header, "DE89370400440532013000", creditTransfer("E2E-1", "USD", "10.5", "ACME-OPS")+creditTransfer("E2E-2", "USD", "5", "ACME-OPS")),
+			signer:   testAuthority,
+			mode:     importModeBatch,
+			lookup:   lookup,
+			accepted: 2,
+			msgs:     1,
+		},
+		{
+			name:       "below the merchant minimum",
+			file:       pain001File(header, "DE89370400440532013000", creditTransfer("E2E-1", "USD", "0.5", "ACME-OPS")+creditTransfer("E2E-2", "USD", "15", "ACME-OPS")),
+			signer:     testDebtor,
+			mode:       importModeInstant,
+			lookup:     lookup,
+			accepted:   1,
+			rejected:   1,
+			msgs:       1,
+			paymentErr: "below the merchant minimum",
+		},
+		{
+			name:     "group header does not match the payments",
+			file:     pain001File(header, "DE89370400440532013000", creditTransfer("E2E-1", "USD", "5", "ACME-OPS")),
+			signer:   testDebtor,
+			mode:     importModeInstant,
+			lookup:   lookup,
+			accepted: 1,
+			msgs:     1,
+			warnings: 2,
+		},
+		{
+			name:       "batch to a non-merchant",
+			file:       pain001File("", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "5", testSupplier)),
+			signer:     testAuthority,
+			mode:       importModeBatch,
+			lookup:     lookup,
+			rejected:   1,
+			paymentErr: "not a registered merchant",
+		},
+		{
+			name:       "debtor is not the signer",
+			file:       pain001File("", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "5", "ACME-OPS")),
+			signer:     testSupplier,
+			mode:       importModeInstant,
+			lookup:     lookup,
+			rejected:   1,
+			paymentErr: "is not the signer",
+		},
+		{
+			name:       "unmapped creditor",
+			file:       pain001File("", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "5", "UNKNOWN")),
+			signer:     testDebtor,
+			mode:       importModeInstant,
+			lookup:     lookup,
+			rejected:   1,
+			paymentErr: "not mapped to an address",
+		},
+		{
+			name:     "offline import warns limits were not checked",
+			file:     pain001File("", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "0.5", "ACME-OPS")),
+			signer:   testDebtor,
+			mode:     importModeInstant,
+			accepted: 1,
+			msgs:     1,
+			warnings: 1,
+		},
+		{
+			name:   "unknown mode",
+			file:   pain001File("", "DE89370400440532013000", creditTransfer("E2E-1", "USD", "5", "ACME-OPS")),
+			signer: testDebtor,
+			mode:   "wire",
+			err:    "unknown mode",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parsePain001(tc.file)
+			require.NoError(t, err)
+
+			report, msgs, err := buildPain001Import(doc, mapping, tc.signer, tc.mode, tc.lookup)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.accepted, report.Accepted)
+			require.Equal(t, tc.rejected, report.Rejected)
+			require.Len(t, msgs, tc.msgs)
+			require.Equal(t, tc.msgs, report.Messages)
+			require.Len(t, report.Warnings, tc.warnings)
+			if tc.paymentErr != "" {
+				require.Contains(t, report.Payments[0].Error, tc.paymentErr)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	"github.com/stateset/core/x/settlement/types"
@@ -25,9 +27,34 @@ func NewQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewGetSettlementCmd(),
+		NewListSettlementsBySenderCmd(),
+		NewListSettlementsByRecipientCmd(),
+		NewListSettlementsByReferenceCmd(),
 		NewGetBatchCmd(),
 		NewGetChannelCmd(),
 		NewGetMerchantCmd(),
+		NewGetEscrowArbitrationCmd(),
+		NewGetMilestoneEscrowCmd(),
+		NewGetSubscriptionCmd(),
+		NewListSubscriptionsByPayerCmd(),
+		NewListSubscriptionsByMerchantCmd(),
+		NewGetBidirectionalChannelCmd(),
+		NewListBidirectionalChannelsByPartyCmd(),
+		NewGetHTLCCmd(),
+		NewListHTLCsByPartyCmd(),
+		NewGetDelayedPayoutCmd(),
+		NewListPayoutsByMerchantCmd(),
+		NewGetNettingCycleCmd(),
+		NewListNettingObligationsCmd(),
+		NewGetNettingReportCmd(),
+		NewGetSplitTemplateCmd(),
+		NewGetMandateCmd(),
+		NewListMandatesByCustomerCmd(),
+		NewListMandatesByMerchantCmd(),
+		NewGetStreamCmd(),
+		NewListStreamsByPartyCmd(),
+		NewVerifyVoucherCmd(),
+		NewGetStatementCmd(),
 		NewGetParamsCmd(),
 	)
 
@@ -52,6 +79,48 @@ func channelKey(id uint64) []byte {
 	return append(append([]byte{}, types.ChannelKeyPrefix...), bz...)
 }
 
+func escrowArbitrationKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.EscrowArbitrationKeyPrefix...), bz...)
+}
+
+func milestoneEscrowKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.MilestoneEscrowKeyPrefix...), bz...)
+}
+
+func subscriptionKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.SubscriptionKeyPrefix...), bz...)
+}
+
+func htlcKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.HTLCKeyPrefix...), bz...)
+}
+
+func bidirectionalChannelKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.BidirectionalChannelKeyPrefix...), bz...)
+}
+
+func delayedPayoutKey(settlementID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, settlementID)
+	return append(append([]byte{}, types.DelayedPayoutKeyPrefix...), bz...)
+}
+
+func nettingCycleKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, types.NettingCycleKeyPrefix...), bz...)
+}
+
 func merchantKey(addr string) []byte {
 	return append(append([]byte{}, types.MerchantKeyPrefix...), []byte(addr)...)
 }
@@ -90,6 +159,102 @@ func NewGetSettlementCmd() *cobra.Command {
 	return cmd
 }
 
+func NewListSettlementsBySenderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlements-by-sender [sender]",
+		Short: "List the settlements sent by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SettlementsBySender(cmd.Context(), &types.QuerySettlementsBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "settlements-by-sender")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListSettlementsByRecipientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlements-by-recipient [recipient]",
+		Short: "List the settlements received by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SettlementsByRecipient(cmd.Context(), &types.QuerySettlementsByRecipientRequest{
+				Recipient:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "settlements-by-recipient")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListSettlementsByReferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlements-by-reference [reference]",
+		Short: "List the settlements carrying an external reference",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SettlementsByReference(cmd.Context(), &types.QuerySettlementsByReferenceRequest{
+				Reference:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "settlements-by-reference")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewGetBatchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "batch [id]",
@@ -188,31 +353,818 @@ func NewGetMerchantCmd() *cobra.Command {
 	return cmd
 }
 
-func NewGetParamsCmd() *cobra.Command {
+func NewGetBidirectionalChannelCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "params",
-		Short: "Query settlement module parameters",
-		Args:  cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "bidirectional-channel [channel-id]",
+		Short: "Query a bidirectional payment channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			res, _, err := clientCtx.QueryStore(types.ParamsKey, types.StoreKey)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(bidirectionalChannelKey(id), types.StoreKey)
 			if err != nil {
 				return err
 			}
 			if len(res) == 0 {
-				return fmt.Errorf("params not found")
+				return fmt.Errorf("bidirectional channel %d not found", id)
 			}
 
-			var params types.Params
-			types.ModuleCdc.MustUnmarshalJSON(res, &params)
-			return clientCtx.PrintObjectLegacy(params)
+			var channel types.BidirectionalChannel
+			types.ModuleCdc.MustUnmarshalJSON(res, &channel)
+			return clientCtx.PrintObjectLegacy(channel)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListBidirectionalChannelsByPartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bidirectional-channels-by-party [party]",
+		Short: "List the bidirectional channels an address is a party to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).BidirectionalChannelsByParty(cmd.Context(), &types.QueryBidirectionalChannelsByPartyRequest{
+				Party:  args[0],
+				Offset: offset,
+				Limit:  limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetHTLCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlc [htlc-id]",
+		Short: "Query a hash time-locked transfer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(htlcKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("htlc %d not found", id)
+			}
+
+			var htlc types.HTLC
+			types.ModuleCdc.MustUnmarshalJSON(res, &htlc)
+			return clientCtx.PrintObjectLegacy(htlc)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListHTLCsByPartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlcs-by-party [party]",
+		Short: "List the HTLCs an address sends or receives",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).HTLCsByParty(cmd.Context(), &types.QueryHTLCsByPartyRequest{
+				Party:  args[0],
+				Offset: offset,
+				Limit:  limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetDelayedPayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-payout [settlement-id]",
+		Short: "Query the delayed merchant payout of a settlement",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(delayedPayoutKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("delayed payout for settlement %d not found", id)
+			}
+
+			var payout types.DelayedPayout
+			types.ModuleCdc.MustUnmarshalJSON(res, &payout)
+			return clientCtx.PrintObjectLegacy(payout)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListPayoutsByMerchantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payouts-by-merchant [merchant]",
+		Short: "List a merchant's delayed payouts and the total still held",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			status, err := cmd.Flags().GetString(flagPayoutStatus)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PayoutsByMerchant(cmd.Context(), &types.QueryPayoutsByMerchantRequest{
+				Merchant:   args[0],
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
 		},
 	}
 
+	cmd.Flags().String(flagPayoutStatus, "", "Only list payouts with this status (pending, frozen, released, refunded)")
+	flags.AddPaginationFlagsToCmd(cmd, "payouts-by-merchant")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewGetNettingCycleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "netting-cycle [id]",
+		Short: "Query a netting cycle by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(nettingCycleKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("netting cycle %d not found", id)
+			}
+
+			var cycle types.NettingCycle
+			types.ModuleCdc.MustUnmarshalJSON(res, &cycle)
+			return clientCtx.PrintObjectLegacy(cycle)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListNettingObligationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "netting-obligations [cycle-id]",
+		Short: "List the obligations submitted to a netting cycle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cycleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).NettingObligations(cmd.Context(), &types.QueryNettingObligationsRequest{
+				CycleId: cycleID,
+				Offset:  offset,
+				Limit:   limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetNettingReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "netting-report [cycle-id]",
+		Short: "Show each participant's gross, bilateral net and multilateral net position in a netting cycle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cycleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).NettingReport(cmd.Context(), &types.QueryNettingReportRequest{CycleId: cycleID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetSplitTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-template [merchant] [name]",
+		Short: "Query a split applied to a merchant's checkouts",
+		Long:  "Query the merchant's split template of the given name, or its default template without one.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySplitTemplateRequest{Merchant: args[0]}
+			if len(args) > 1 {
+				req.Name = args[1]
+			}
+			res, err := types.NewQueryClient(clientCtx).SplitTemplate(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetMandateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mandate [mandate-id]",
+		Short: "Query a direct debit mandate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Mandate(cmd.Context(), &types.QueryMandateRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListMandatesByCustomerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mandates-by-customer [customer]",
+		Short: "List the mandates granted by a customer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).MandatesByCustomer(cmd.Context(), &types.QueryMandatesByCustomerRequest{
+				Customer: args[0],
+				Offset:   offset,
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListMandatesByMerchantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mandates-by-merchant [merchant]",
+		Short: "List the mandates granted to a merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).MandatesByMerchant(cmd.Context(), &types.QueryMandatesByMerchantRequest{
+				Merchant: args[0],
+				Offset:   offset,
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream [stream-id]",
+		Short: "Query a payment stream and what has accrued on it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Stream(cmd.Context(), &types.QueryStreamRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListStreamsByPartyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streams-by-party [address]",
+		Short: "List the streams an address pays or receives",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).StreamsByParty(cmd.Context(), &types.QueryStreamsByPartyRequest{
+				Address: args[0],
+				Offset:  offset,
+				Limit:   limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetStatementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statement [account]",
+		Short: "Export the settlement statement of an account",
+		Long: `Export the settlements booked on an account over a height and/or time range, with
+opening and closing balances: payments received and made, fees, split shares paid to
+other payees and refunds. Times are RFC3339. The statement is fetched page by page
+over the account's settlements and rendered as JSON, CSV or an ISO 20022 camt.053 XML
+document.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryStatementRequest{Account: args[0]}
+			if req.Denom, err = cmd.Flags().GetString(flagDenom); err != nil {
+				return err
+			}
+			if req.FromHeight, err = cmd.Flags().GetInt64(flagFromHeight); err != nil {
+				return err
+			}
+			if req.ToHeight, err = cmd.Flags().GetInt64(flagToHeight); err != nil {
+				return err
+			}
+			if req.FromTime, err = readTimeFlag(cmd, flagFromTime); err != nil {
+				return err
+			}
+			if req.ToTime, err = readTimeFlag(cmd, flagToTime); err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(flagFormat)
+			if err != nil {
+				return err
+			}
+			if format != formatJSON && format != formatCSV && format != formatCamt053 {
+				return fmt.Errorf("unknown format %q: expected %s, %s or %s", format, formatJSON, formatCSV, formatCamt053)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Statement(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			for res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+				page, err := queryClient.Statement(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				res.Statement.AppendPage(page.Statement)
+				res.Pagination = page.Pagination
+			}
+
+			switch format {
+			case formatCSV:
+				bz, err := res.Statement.MarshalCSV()
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(bz)
+			case formatCamt053:
+				bz, err := res.Statement.MarshalCamt053()
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(append(bz, '\n'))
+			default:
+				return clientCtx.PrintProto(res)
+			}
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "Statement denom (defaults to the account's settlement denom)")
+	cmd.Flags().Int64(flagFromHeight, 0, "First block height included")
+	cmd.Flags().Int64(flagToHeight, 0, "Last block height included")
+	cmd.Flags().String(flagFromTime, "", "Start of the statement period (RFC3339)")
+	cmd.Flags().String(flagToTime, "", "End of the statement period (RFC3339)")
+	cmd.Flags().String(flagFormat, formatJSON, "Output format: json, csv or camt053")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readTimeFlag parses an optional RFC3339 time flag, returning the zero time
+// when it is not set
+func readTimeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func NewGetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query settlement module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(types.ParamsKey, types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("params not found")
+			}
+
+			var params types.Params
+			types.ModuleCdc.MustUnmarshalJSON(res, &params)
+			return clientCtx.PrintObjectLegacy(params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetEscrowArbitrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-arbitration [settlement-id]",
+		Short: "Query the arbiters and approvals of a multi-party escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(escrowArbitrationKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("escrow arbitration for settlement %d not found", id)
+			}
+
+			var arbitration types.EscrowArbitration
+			types.ModuleCdc.MustUnmarshalJSON(res, &arbitration)
+			return clientCtx.PrintObjectLegacy(arbitration)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetMilestoneEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone-escrow [settlement-id]",
+		Short: "Query the milestones of a milestone escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(milestoneEscrowKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("milestone escrow for settlement %d not found", id)
+			}
+
+			var escrow types.MilestoneEscrow
+			types.ModuleCdc.MustUnmarshalJSON(res, &escrow)
+			return clientCtx.PrintObjectLegacy(escrow)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewGetSubscriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscription [subscription-id]",
+		Short: "Query a recurring payment subscription",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := clientCtx.QueryStore(subscriptionKey(id), types.StoreKey)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return fmt.Errorf("subscription %d not found", id)
+			}
+
+			var subscription types.Subscription
+			types.ModuleCdc.MustUnmarshalJSON(res, &subscription)
+			return clientCtx.PrintObjectLegacy(subscription)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListSubscriptionsByPayerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscriptions-by-payer [payer]",
+		Short: "List the subscriptions paid by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SubscriptionsByPayer(cmd.Context(), &types.QuerySubscriptionsByPayerRequest{
+				Payer:  args[0],
+				Offset: offset,
+				Limit:  limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewListSubscriptionsByMerchantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscriptions-by-merchant [merchant]",
+		Short: "List the subscriptions paying a merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			offset, limit, err := readOffsetLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).SubscriptionsByMerchant(cmd.Context(), &types.QuerySubscriptionsByMerchantRequest{
+				Merchant: args[0],
+				Offset:   offset,
+				Limit:    limit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addOffsetLimitFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagOffset       = "offset"
+	flagLimit        = "limit"
+	flagPayoutStatus = "status"
+	flagFromHeight   = "from-height"
+	flagToHeight     = "to-height"
+	flagFromTime     = "from-time"
+	flagToTime       = "to-time"
+	flagFormat       = "format"
+)
+
+// Statement output formats
+const (
+	formatJSON    = "json"
+	formatCSV     = "csv"
+	formatCamt053 = "camt053"
+)
+
+func addOffsetLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagOffset, 0, "Number of results to skip")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of results (0 for the module maximum)")
+}
+
+func readOffsetLimit(cmd *cobra.Command) (uint64, uint64, error) {
+	offset, err := cmd.Flags().GetUint64(flagOffset)
+	if err != nil {
+		return 0, 0, err
+	}
+	limit, err := cmd.Flags().GetUint64(flagLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	return offset, limit, nil
+}
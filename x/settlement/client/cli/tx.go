@@ -29,6 +29,19 @@ const (
 	flagWebhookURL     = "webhook-url"
 	flagName           = "name"
 	flagIsActive       = "is-active"
+	flagArbiters       = "arbiters"
+	flagThreshold      = "threshold"
+	flagMaxCycles      = "max-cycles"
+	flagGracePeriod    = "grace-period"
+	flagChannelID      = "channel-id"
+	flagIncomingHTLC   = "incoming-htlc-id"
+	flagSettleDelay    = "settlement-delay"
+	flagBatchMaxAge    = "batch-max-age"
+	flagDenom          = "denom"
+	flagMaxSlippage    = "max-slippage-bps"
+	flagSettleDenom    = "settlement-denom"
+	flagSplitName      = "split-name"
+	flagExpiresIn      = "expires-in"
 )
 
 // NewTxCmd returns the root tx command for settlement operations.
@@ -47,6 +60,11 @@ func NewTxCmd() *cobra.Command {
 		NewCreateEscrowCmd(),
 		NewReleaseEscrowCmd(),
 		NewRefundEscrowCmd(),
+		NewCreateMultiPartyEscrowCmd(),
+		NewApproveEscrowResolutionCmd(),
+		NewCreateMilestoneEscrowCmd(),
+		NewReleaseMilestoneCmd(),
+		NewRefundMilestoneCmd(),
 		NewOpenChannelCmd(),
 		NewCloseChannelCmd(),
 		NewClaimChannelCmd(),
@@ -56,6 +74,32 @@ func NewTxCmd() *cobra.Command {
 		NewUpdateMerchantCmd(),
 		NewCreateBatchCmd(),
 		NewSettleBatchCmd(),
+		NewCreateSubscriptionCmd(),
+		NewCancelSubscriptionCmd(),
+		NewOpenBidirectionalChannelCmd(),
+		NewFundBidirectionalChannelCmd(),
+		NewCooperativeCloseChannelCmd(),
+		NewInitiateChannelCloseCmd(),
+		NewChallengeChannelCloseCmd(),
+		NewLockHTLCCmd(),
+		NewClaimHTLCCmd(),
+		NewRefundHTLCCmd(),
+		NewSetChannelRoutingFeeCmd(),
+		NewFreezePayoutCmd(),
+		NewUnfreezePayoutCmd(),
+		NewCreateNettingCycleCmd(),
+		NewSubmitObligationCmd(),
+		NewSettleNettingCycleCmd(),
+		NewSetSplitTemplateCmd(),
+		NewCreateMandateCmd(),
+		NewRevokeMandateCmd(),
+		NewPullPaymentCmd(),
+		NewCreateStreamCmd(),
+		NewWithdrawStreamCmd(),
+		NewCancelStreamCmd(),
+		NewTopUpStreamCmd(),
+		NewImportPain001Cmd(),
+		NewSignVoucherCmd(),
 	)
 
 	return cmd
@@ -64,7 +108,7 @@ func NewTxCmd() *cobra.Command {
 func NewInstantTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "instant-transfer [recipient] [amount] [reference]",
-		Short: "Send an instant ssUSD transfer",
+		Short: "Send an instant transfer, converting it into the recipient's settlement denom if needed",
 		Args:  cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -92,7 +136,13 @@ func NewInstantTransferCmd() *cobra.Command {
 				return err
 			}
 
+			maxSlippage, err := cmd.Flags().GetUint32(flagMaxSlippage)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgInstantTransfer(clientCtx.GetFromAddress().String(), recipient, amount, reference, metadata)
+			msg.MaxSlippageBps = maxSlippage
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -102,6 +152,7 @@ func NewInstantTransferCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(flagMetadata, "", "Optional metadata for the transfer")
+	cmd.Flags().Uint32(flagMaxSlippage, 0, "Maximum slippage in basis points when the amount is converted into the recipient's settlement denom (default 100)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -146,7 +197,13 @@ func NewCreateEscrowCmd() *cobra.Command {
 				return err
 			}
 
+			maxSlippage, err := cmd.Flags().GetUint32(flagMaxSlippage)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateEscrow(clientCtx.GetFromAddress().String(), recipient, amount, reference, metadata, expiresIn)
+			msg.MaxSlippageBps = maxSlippage
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -157,6 +214,7 @@ func NewCreateEscrowCmd() *cobra.Command {
 
 	cmd.Flags().String(flagReference, "", "Optional settlement reference")
 	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	cmd.Flags().Uint32(flagMaxSlippage, 0, "Maximum slippage in basis points when the amount is converted into the recipient's settlement denom (default 100)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -223,6 +281,261 @@ func NewRefundEscrowCmd() *cobra.Command {
 	return cmd
 }
 
+func NewCreateMultiPartyEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-multiparty-escrow [recipient] [amount] [expires-in]",
+		Short: "Create an escrow resolved by M-of-N approval of sender, recipient and arbiters",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := args[0]
+			if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiresIn, err := time.ParseDuration(args[2])
+			if err != nil {
+				seconds, serr := strconv.ParseInt(args[2], 10, 64)
+				if serr != nil {
+					return err
+				}
+				expiresIn = time.Duration(seconds) * time.Second
+			}
+
+			arbiters, err := cmd.Flags().GetStringSlice(flagArbiters)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := cmd.Flags().GetUint32(flagThreshold)
+			if err != nil {
+				return err
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMultiPartyEscrow(clientCtx.GetFromAddress().String(), recipient, amount, arbiters, threshold, reference, metadata, expiresIn)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagArbiters, nil, "Comma-separated arbiter addresses")
+	cmd.Flags().Uint32(flagThreshold, 2, "Number of matching approvals required to resolve the escrow")
+	cmd.Flags().String(flagReference, "", "Optional settlement reference")
+	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	_ = cmd.MarkFlagRequired(flagArbiters)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewApproveEscrowResolutionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-escrow [settlement-id] [release|refund|split] [recipient-amount]",
+		Short: "Approve how a multi-party escrow is resolved",
+		Long: `Approve releasing, refunding or splitting a multi-party escrow. A split requires the
+amount paid to the recipient; the remainder is returned to the sender. The escrow is
+resolved once the threshold of parties approves the same outcome.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			resolution := types.EscrowResolution(strings.ToLower(args[1]))
+
+			var recipientAmount sdk.Coin
+			if len(args) == 3 {
+				recipientAmount, err = sdk.ParseCoinNormalized(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgApproveEscrowResolution(clientCtx.GetFromAddress().String(), id, resolution, recipientAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCreateMilestoneEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-milestone-escrow [recipient] [expires-in] [milestone]...",
+		Short: "Create an escrow released milestone by milestone",
+		Long: `Create an escrow funded with the sum of its milestones. Each milestone is given as
+"amount[;deadline[;description]]" where the optional deadline is RFC3339. Pending
+milestones are refunded to the sender once their deadline (or the escrow expiration)
+passes.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := args[0]
+			if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+				return err
+			}
+
+			expiresIn, err := time.ParseDuration(args[1])
+			if err != nil {
+				seconds, serr := strconv.ParseInt(args[1], 10, 64)
+				if serr != nil {
+					return err
+				}
+				expiresIn = time.Duration(seconds) * time.Second
+			}
+
+			milestones := make([]types.MilestoneInput, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				parts := strings.SplitN(arg, ";", 3)
+				amount, err := sdk.ParseCoinNormalized(parts[0])
+				if err != nil {
+					return err
+				}
+				milestone := types.MilestoneInput{Amount: amount}
+				if len(parts) > 1 && parts[1] != "" {
+					milestone.Deadline, err = time.Parse(time.RFC3339, parts[1])
+					if err != nil {
+						return fmt.Errorf("invalid milestone deadline %q: %w", parts[1], err)
+					}
+				}
+				if len(parts) > 2 {
+					milestone.Description = parts[2]
+				}
+				milestones = append(milestones, milestone)
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMilestoneEscrow(clientCtx.GetFromAddress().String(), recipient, milestones, reference, metadata, expiresIn)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReference, "", "Optional settlement reference")
+	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewReleaseMilestoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-milestone [settlement-id] [milestone-index]",
+		Short: "Release a single milestone of a milestone escrow to the recipient",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseMilestone(clientCtx.GetFromAddress().String(), id, uint32(index))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRefundMilestoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-milestone [settlement-id] [milestone-index]",
+		Short: "Refund a single milestone of a milestone escrow to the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(flagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundMilestone(clientCtx.GetFromAddress().String(), id, uint32(index), reason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReason, "", "Refund reason")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewOpenChannelCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "open-channel [recipient] [deposit] [expires-in-blocks]",
@@ -362,7 +675,19 @@ func NewInstantCheckoutCmd() *cobra.Command {
 				return err
 			}
 
+			maxSlippage, err := cmd.Flags().GetUint32(flagMaxSlippage)
+			if err != nil {
+				return err
+			}
+
+			splitName, err := cmd.Flags().GetString(flagSplitName)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgInstantCheckout(clientCtx.GetFromAddress().String(), merchant, amount, orderRef, useEscrow, nil, metadata)
+			msg.MaxSlippageBps = maxSlippage
+			msg.SplitName = splitName
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -372,6 +697,8 @@ func NewInstantCheckoutCmd() *cobra.Command {
 
 	cmd.Flags().Bool(flagUseEscrow, false, "Place funds in escrow instead of instant settlement")
 	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	cmd.Flags().String(flagSplitName, "", "Pay the net amount by the merchant's split template of this name instead of its default template")
+	cmd.Flags().Uint32(flagMaxSlippage, 0, "Maximum slippage in basis points when the amount is converted into the recipient's settlement denom (default 100)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -478,8 +805,24 @@ func NewRegisterMerchantCmd() *cobra.Command {
 				return err
 			}
 
+			settlementDelay, err := cmd.Flags().GetDuration(flagSettleDelay)
+			if err != nil {
+				return err
+			}
+
+			batchMaxAge, err := cmd.Flags().GetDuration(flagBatchMaxAge)
+			if err != nil {
+				return err
+			}
+
+			settlementDenom, err := cmd.Flags().GetString(flagSettleDenom)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress().String()
-			msg := types.NewMsgRegisterMerchant(from, from, name, feeRate, minSettlement, maxSettlement, batchEnabled, batchThreshold, webhookURL)
+			msg := types.NewMsgRegisterMerchant(from, from, name, feeRate, minSettlement, maxSettlement, batchEnabled, batchThreshold, webhookURL, settlementDelay, batchMaxAge)
+			msg.SettlementDenom = settlementDenom
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -493,6 +836,9 @@ func NewRegisterMerchantCmd() *cobra.Command {
 	cmd.Flags().Bool(flagBatchEnabled, false, "Enable batch settlements for this merchant")
 	cmd.Flags().String(flagBatchThreshold, "", "Optional batch threshold coin (e.g. 1000ssusd)")
 	cmd.Flags().String(flagWebhookURL, "", "Optional HTTPS webhook URL for off-chain notifications")
+	cmd.Flags().Duration(flagSettleDelay, 0, "Hold period before instant payouts are released (e.g. 72h); only the authority can set it")
+	cmd.Flags().Duration(flagBatchMaxAge, 0, "Optional time a batch collects payments before it settles (default 24h)")
+	cmd.Flags().String(flagSettleDenom, "", "Optional denom payouts settle in; must be a PSM asset (default ssusd)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -527,6 +873,18 @@ func NewUpdateMerchantCmd() *cobra.Command {
 				enabled, _ := cmd.Flags().GetBool(flagBatchEnabled)
 				msg.BatchEnabled = &enabled
 			}
+			if cmd.Flags().Changed(flagBatchThreshold) {
+				thresholdStr, _ := cmd.Flags().GetString(flagBatchThreshold)
+				threshold, err := sdk.ParseCoinNormalized(thresholdStr)
+				if err != nil {
+					return err
+				}
+				msg.BatchThreshold = threshold
+			}
+			if cmd.Flags().Changed(flagBatchMaxAge) {
+				maxAge, _ := cmd.Flags().GetDuration(flagBatchMaxAge)
+				msg.BatchMaxAge = &maxAge
+			}
 			if cmd.Flags().Changed(flagIsActive) {
 				active, _ := cmd.Flags().GetBool(flagIsActive)
 				msg.IsActive = &active
@@ -535,6 +893,14 @@ func NewUpdateMerchantCmd() *cobra.Command {
 				url, _ := cmd.Flags().GetString(flagWebhookURL)
 				msg.WebhookUrl = url
 			}
+			if cmd.Flags().Changed(flagSettleDelay) {
+				delay, _ := cmd.Flags().GetDuration(flagSettleDelay)
+				msg.SettlementDelay = &delay
+			}
+			if cmd.Flags().Changed(flagSettleDenom) {
+				denom, _ := cmd.Flags().GetString(flagSettleDenom)
+				msg.SettlementDenom = denom
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -546,8 +912,12 @@ func NewUpdateMerchantCmd() *cobra.Command {
 	cmd.Flags().String(flagName, "", "Updated merchant name")
 	cmd.Flags().Uint32(flagFeeRateBps, 0, "Updated fee rate in basis points")
 	cmd.Flags().Bool(flagBatchEnabled, false, "Enable/disable batch settlements")
+	cmd.Flags().String(flagBatchThreshold, "", "Updated batch threshold coin (e.g. 1000ssusd)")
+	cmd.Flags().Duration(flagBatchMaxAge, 0, "Updated time a batch collects payments before it settles")
 	cmd.Flags().Bool(flagIsActive, true, "Set merchant active status")
 	cmd.Flags().String(flagWebhookURL, "", "Updated HTTPS webhook URL")
+	cmd.Flags().Duration(flagSettleDelay, 0, "Updated payout hold period (only the authority can set it)")
+	cmd.Flags().String(flagSettleDenom, "", "Updated denom payouts settle in")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -623,3 +993,932 @@ func NewSettleBatchCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCreateSubscriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-subscription [merchant] [amount] [interval]",
+		Short: "Authorize a recurring payment to a merchant",
+		Long: `Authorize a recurring ssUSD payment charged every interval (e.g. 720h) starting in the
+current block. Charges that fail are retried until the grace period runs out.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merchant := args[0]
+			if _, err := sdk.AccAddressFromBech32(merchant); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			interval, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			maxCycles, err := cmd.Flags().GetUint64(flagMaxCycles)
+			if err != nil {
+				return err
+			}
+
+			gracePeriod, err := cmd.Flags().GetDuration(flagGracePeriod)
+			if err != nil {
+				return err
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubscription(clientCtx.GetFromAddress().String(), merchant, amount, interval, maxCycles, gracePeriod, reference, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxCycles, 0, "Number of payments before the subscription completes (0 for unlimited)")
+	cmd.Flags().Duration(flagGracePeriod, 0, "How long a failed payment is retried before the subscription lapses")
+	cmd.Flags().String(flagReference, "", "Optional reference recorded on each payment")
+	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelSubscriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-subscription [subscription-id]",
+		Short: "Cancel a subscription as its payer or merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(flagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSubscription(clientCtx.GetFromAddress().String(), id, reason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReason, "", "Cancellation reason")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewOpenBidirectionalChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-bidirectional-channel [party-b] [deposit] [challenge-period-blocks]",
+		Short: "Open a bidirectional payment channel funded with your deposit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			challengePeriod, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenBidirectionalChannel(clientCtx.GetFromAddress().String(), args[0], deposit, challengePeriod)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewFundBidirectionalChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-bidirectional-channel [channel-id] [deposit]",
+		Short: "Add your deposit to a bidirectional channel (party b)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundBidirectionalChannel(clientCtx.GetFromAddress().String(), channelID, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCooperativeCloseChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cooperative-close-channel [channel-id] [balance-a] [balance-b] [nonce] [signature-a] [signature-b]",
+		Short: "Close a bidirectional channel immediately at a final state signed by both parties",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseChannelStateArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCooperativeCloseChannel(clientCtx.GetFromAddress().String(), state)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewInitiateChannelCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "initiate-channel-close [channel-id] [balance-a] [balance-b] [nonce] [signature-a] [signature-b]",
+		Short: "Start a unilateral close of a bidirectional channel (signatures may be omitted for nonce 0)",
+		Args:  cobra.RangeArgs(4, 6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseChannelStateArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInitiateChannelClose(clientCtx.GetFromAddress().String(), state)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewChallengeChannelCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "challenge-channel-close [channel-id] [balance-a] [balance-b] [nonce] [signature-a] [signature-b]",
+		Short: "Submit a newer state for a closing bidirectional channel",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			state, err := parseChannelStateArgs(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChallengeChannelClose(clientCtx.GetFromAddress().String(), state)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseChannelStateArgs parses [channel-id] [balance-a] [balance-b] [nonce]
+// followed by the optional party signatures
+func parseChannelStateArgs(args []string) (types.ChannelState, error) {
+	channelID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return types.ChannelState{}, err
+	}
+
+	balanceA, err := sdk.ParseCoinNormalized(args[1])
+	if err != nil {
+		return types.ChannelState{}, err
+	}
+
+	balanceB, err := sdk.ParseCoinNormalized(args[2])
+	if err != nil {
+		return types.ChannelState{}, err
+	}
+
+	nonce, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return types.ChannelState{}, err
+	}
+
+	state := types.ChannelState{
+		ChannelId: channelID,
+		BalanceA:  balanceA,
+		BalanceB:  balanceB,
+		Nonce:     nonce,
+	}
+	if len(args) > 4 {
+		state.SignatureA = args[4]
+	}
+	if len(args) > 5 {
+		state.SignatureB = args[5]
+	}
+	return state, nil
+}
+
+func NewLockHTLCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-htlc [recipient] [amount] [hash-lock] [timeout-blocks]",
+		Short: "Lock funds for a recipient behind a SHA-256 hash lock and timeout",
+		Long: `Lock funds that the recipient can claim by revealing the preimage of the
+hex encoded SHA-256 hash lock before the timeout. Use --channel-id to fund the
+HTLC from a payment channel you opened to the recipient, and --incoming-htlc-id
+to forward an HTLC you received as part of a routed payment.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			timeoutBlocks, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetUint64(flagChannelID)
+			if err != nil {
+				return err
+			}
+			incomingID, err := cmd.Flags().GetUint64(flagIncomingHTLC)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockHTLC(clientCtx.GetFromAddress().String(), args[0], amount, args[2], timeoutBlocks, channelID, incomingID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagChannelID, 0, "Payment channel funding the HTLC")
+	cmd.Flags().Uint64(flagIncomingHTLC, 0, "Incoming HTLC this HTLC forwards")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimHTLCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-htlc [htlc-id] [preimage]",
+		Short: "Claim an HTLC with the hex encoded preimage of its hash lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			htlcID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimHTLC(clientCtx.GetFromAddress().String(), htlcID, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRefundHTLCCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-htlc [htlc-id]",
+		Short: "Refund an HTLC that has timed out",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			htlcID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundHTLC(clientCtx.GetFromAddress().String(), htlcID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetChannelRoutingFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-routing-fee [channel-id] [fee-bps]",
+		Short: "Set the fee you charge to forward payments over your channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			feeBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChannelRoutingFee(clientCtx.GetFromAddress().String(), channelID, uint32(feeBps))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewFreezePayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-payout [settlement-id] [reason]",
+		Short: "Freeze a pending merchant payout during review (authority only)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			settlementID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezePayout(clientCtx.GetFromAddress().String(), settlementID, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewUnfreezePayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-payout [settlement-id]",
+		Short: "Return a frozen merchant payout to the release queue (authority only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			settlementID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezePayout(clientCtx.GetFromAddress().String(), settlementID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCreateNettingCycleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-netting-cycle [participant1,participant2,...] [window]",
+		Short: "Open a netting cycle that settles the participants' net obligations when the window closes",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var participants []string
+			for _, participant := range strings.Split(args[0], ",") {
+				participants = append(participants, strings.TrimSpace(participant))
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateNettingCycle(clientCtx.GetFromAddress().String(), participants, denom, window)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, types.StablecoinDenom, "Denom the cycle's obligations are settled in")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSubmitObligationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-obligation [cycle-id] [creditor] [amount]",
+		Short: "Record an amount owed to another participant of an open netting cycle",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cycleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitObligation(clientCtx.GetFromAddress().String(), cycleID, args[1], amount, reference)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReference, "", "Optional reference for the obligation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSettleNettingCycleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-netting-cycle [cycle-id]",
+		Short: "Settle an open netting cycle before its window closes (operator only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cycleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleNettingCycle(clientCtx.GetFromAddress().String(), cycleID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetSplitTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-split-template [rule]...",
+		Short: "Set the split applied to your checkouts",
+		Long: `Set the split applied to checkouts paying you. Each rule is given as
+"payee;share[;role]" where share is basis points of the net amount (e.g. 250bps)
+or a fixed amount (e.g. 1000000ssusd). You are paid what remains. With --name the
+template is only applied to checkouts selecting it by that name; otherwise it
+applies to checkouts that select none. Without rules the template is removed.`,
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rules, err := parseSplitRules(args)
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString(flagName)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSplitTemplate(clientCtx.GetFromAddress().String(), name, rules)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Name checkouts select the template by (default template if empty)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseSplitRules parses split rules given as "payee;share[;role]"
+func parseSplitRules(args []string) ([]types.SplitRule, error) {
+	rules := make([]types.SplitRule, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ";", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid split rule %q: expected payee;share[;role]", arg)
+		}
+		rule := types.SplitRule{Payee: parts[0]}
+		if bps, ok := strings.CutSuffix(parts[1], "bps"); ok {
+			n, err := strconv.ParseUint(bps, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid split share %q: %w", parts[1], err)
+			}
+			rule.Bps = uint32(n)
+		} else {
+			amount, err := sdk.ParseCoinNormalized(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid split share %q: %w", parts[1], err)
+			}
+			rule.Amount = amount
+		}
+		if len(parts) > 2 {
+			rule.Role = parts[2]
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func NewCreateMandateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-mandate [merchant] [per-charge-max] [period-cap] [period]",
+		Short: "Authorize a merchant to pull payments from your account",
+		Long: `Authorize a merchant to pull ssUSD payments from your account, each up to the
+per-charge maximum and together up to the period cap every period (e.g. 720h).
+The mandate lasts until it expires or you or the merchant revoke it.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			merchant := args[0]
+			if _, err := sdk.AccAddressFromBech32(merchant); err != nil {
+				return err
+			}
+
+			perChargeMax, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			periodCap, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			period, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			expiresIn, err := cmd.Flags().GetDuration(flagExpiresIn)
+			if err != nil {
+				return err
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateMandate(clientCtx.GetFromAddress().String(), merchant, perChargeMax, periodCap, period, expiresIn, reference)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagExpiresIn, 0, "How long the mandate lasts (0 for no expiry)")
+	cmd.Flags().String(flagReference, "", "Optional reference recorded on each pull without its own")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevokeMandateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-mandate [mandate-id]",
+		Short: "Revoke a mandate as its customer or merchant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(flagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeMandate(clientCtx.GetFromAddress().String(), id, reason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReason, "", "Revocation reason")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewPullPaymentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pull-payment [mandate-id] [amount]",
+		Short: "Charge the customer of a mandate granted to you",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPullPayment(clientCtx.GetFromAddress().String(), id, amount, reference, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReference, "", "Optional reference, defaults to the mandate's")
+	cmd.Flags().String(flagMetadata, "", "Optional metadata")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCreateStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-stream [recipient] [deposit] [rate-per-second]",
+		Short: "Stream a deposit to a recipient at a rate per second",
+		Long: `Lock a ssUSD deposit and stream it to the recipient at a rate per second,
+given in base units (e.g. 1000 is 0.001 ssUSD a second). The recipient can
+withdraw what has accrued at any time, you can top the stream up, and either of
+you can cancel it: the recipient is paid what accrued and you get the rest back.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := args[0]
+			if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			rate, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid rate per second: %s", args[2])
+			}
+
+			reference, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateStream(clientCtx.GetFromAddress().String(), recipient, deposit, rate, reference)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReference, "", "Optional reference")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-stream [stream-id]",
+		Short: "Withdraw what has accrued on a stream paying you",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawStream(clientCtx.GetFromAddress().String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-stream [stream-id]",
+		Short: "Cancel a stream as its payer or recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStream(clientCtx.GetFromAddress().String(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTopUpStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-stream [stream-id] [amount]",
+		Short: "Add to the deposit of a stream you pay",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTopUpStream(clientCtx.GetFromAddress().String(), id, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/stateset/core/x/settlement/client/voucher"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func NewSignVoucherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-voucher [channel-id] [recipient] [amount] [nonce]",
+		Short: "Sign an off-chain payment channel voucher as the channel sender",
+		Long: `Sign a voucher authorizing the recipient of a payment channel to claim the
+amount with the nonce, using the --from key. Signing is offline: nothing is
+broadcast. The voucher is printed as JSON; the recipient redeems it with
+claim-channel, and anyone can check it with "query settlement verify-voucher".`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signed, _, err := voucher.SignWithKeyring(voucher.New(channelId, args[1], amount, nonce), clientCtx.Keyring, clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			return printVoucherJSON(clientCtx, signed)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// voucherCheck is the result of verify-voucher
+type voucherCheck struct {
+	Valid   bool            `json:"valid"`
+	Error   string          `json:"error,omitempty"`
+	Voucher voucher.Voucher `json:"voucher"`
+	Sender  string          `json:"sender"`
+	Balance sdk.Coin        `json:"channel_balance"`
+	Nonce   uint64          `json:"channel_nonce"`
+}
+
+func NewVerifyVoucherCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-voucher [voucher-file]",
+		Short: "Check that a payment channel voucher can be claimed",
+		Long: `Check a voucher printed by sign-voucher against the channel on chain: the
+channel is open and pays the voucher's recipient, the nonce is above the
+channel's, the balance covers the amount and the signature is the channel
+sender's. The command fails when the voucher cannot be claimed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var v voucher.Voucher
+			if err := json.Unmarshal(bz, &v); err != nil {
+				return fmt.Errorf("invalid voucher: %w", err)
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Channel(cmd.Context(), &types.QueryChannelRequest{Id: v.ChannelID})
+			if err != nil {
+				return err
+			}
+			channel := res.Channel
+
+			senderAddr, err := sdk.AccAddressFromBech32(channel.Sender)
+			if err != nil {
+				return err
+			}
+			account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, senderAddr)
+			if err != nil {
+				return err
+			}
+
+			check := voucherCheck{
+				Valid:   true,
+				Voucher: v,
+				Sender:  channel.Sender,
+				Balance: channel.Balance,
+				Nonce:   channel.Nonce,
+			}
+			verifyErr := voucher.VerifyForChannel(v, channel, account.GetPubKey())
+			if verifyErr != nil {
+				check.Valid = false
+				check.Error = verifyErr.Error()
+			}
+			if err := printVoucherJSON(clientCtx, check); err != nil {
+				return err
+			}
+			return verifyErr
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func printVoucherJSON(clientCtx client.Context, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(append(bz, '\n'))
+}
@@ -0,0 +1,143 @@
+// Package voucher builds, signs and verifies off-chain payment channel
+// vouchers. A voucher is the channel sender's signature authorizing the
+// recipient to claim an amount from the channel with a nonce above the
+// channel's last one; the recipient redeems it on chain with MsgClaimChannel.
+// Services can use this package to issue and check vouchers without
+// reimplementing the signed message format.
+package voucher
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// signatureLength is the length of a secp256k1 signature as the keeper
+// verifies it
+const signatureLength = 64
+
+// Voucher authorizes the recipient of a payment channel to claim Amount with
+// Nonce. Signature is the hex encoded signature of the channel sender.
+type Voucher struct {
+	ChannelID uint64   `json:"channel_id"`
+	Recipient string   `json:"recipient"`
+	Amount    sdk.Coin `json:"amount"`
+	Nonce     uint64   `json:"nonce"`
+	Signature string   `json:"signature,omitempty"`
+}
+
+// New returns an unsigned voucher
+func New(channelID uint64, recipient string, amount sdk.Coin, nonce uint64) Voucher {
+	return Voucher{
+		ChannelID: channelID,
+		Recipient: recipient,
+		Amount:    amount,
+		Nonce:     nonce,
+	}
+}
+
+// SignBytes returns the message the channel sender signs
+func (v Voucher) SignBytes() []byte {
+	return types.ChannelClaimSignBytes(v.ChannelID, v.Recipient, v.Amount, v.Nonce)
+}
+
+// ValidateBasic checks the voucher's fields without verifying its signature
+func (v Voucher) ValidateBasic() error {
+	if v.ChannelID == 0 {
+		return errorsmod.Wrap(types.ErrChannelNotFound, "channel id required")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Recipient); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRecipient, "invalid recipient address")
+	}
+	if !v.Amount.IsValid() || v.Amount.IsZero() {
+		return errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+	if v.Nonce == 0 {
+		return errorsmod.Wrap(types.ErrInvalidNonce, "nonce must be positive")
+	}
+	return nil
+}
+
+// Sign signs the voucher with the channel sender's private key
+func Sign(v Voucher, key cryptotypes.PrivKey) (Voucher, error) {
+	if err := v.ValidateBasic(); err != nil {
+		return Voucher{}, err
+	}
+	sig, err := key.Sign(v.SignBytes())
+	if err != nil {
+		return Voucher{}, err
+	}
+	v.Signature = hex.EncodeToString(sig)
+	return v, nil
+}
+
+// SignWithKeyring signs the voucher with a keyring key, returning the signed
+// voucher and the key's public key. Ledger keys cannot sign vouchers, since
+// the device only signs transactions.
+func SignWithKeyring(v Voucher, kr keyring.Keyring, uid string) (Voucher, cryptotypes.PubKey, error) {
+	if err := v.ValidateBasic(); err != nil {
+		return Voucher{}, nil, err
+	}
+	sig, pubKey, err := kr.Sign(uid, v.SignBytes(), signing.SignMode_SIGN_MODE_DIRECT)
+	if err != nil {
+		return Voucher{}, nil, err
+	}
+	v.Signature = hex.EncodeToString(sig)
+	return v, pubKey, nil
+}
+
+// Verify checks the voucher's signature against the channel sender's public
+// key the same way the keeper does when the voucher is claimed
+func Verify(v Voucher, pubKey cryptotypes.PubKey) error {
+	if err := v.ValidateBasic(); err != nil {
+		return err
+	}
+	sig, err := hex.DecodeString(v.Signature)
+	if err != nil || len(sig) < signatureLength {
+		return types.ErrInvalidSignature
+	}
+	if pubKey == nil || !pubKey.VerifySignature(v.SignBytes(), sig[:signatureLength]) {
+		return types.ErrSignatureVerificationFailed
+	}
+	return nil
+}
+
+// VerifyForChannel checks that the voucher can be claimed from the channel as
+// it stands: the channel is open and pays the voucher's recipient, the nonce
+// is above the channel's, the balance covers the amount and the signature is
+// the channel sender's
+func VerifyForChannel(v Voucher, channel types.PaymentChannel, senderPubKey cryptotypes.PubKey) error {
+	if channel.Id != v.ChannelID {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "voucher is for channel %d, not %d", v.ChannelID, channel.Id)
+	}
+	if !channel.IsOpen {
+		return types.ErrChannelClosed
+	}
+	if v.Recipient != channel.Recipient {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "channel pays %s, not %s", channel.Recipient, v.Recipient)
+	}
+	if v.Nonce <= channel.Nonce {
+		return errorsmod.Wrapf(types.ErrInvalidNonce, "nonce must be above the channel's %d", channel.Nonce)
+	}
+	if channel.Balance.Denom != v.Amount.Denom || channel.Balance.IsLT(v.Amount) {
+		return errorsmod.Wrapf(types.ErrChannelInsufficientBalance, "channel balance is %s", channel.Balance)
+	}
+	if senderPubKey == nil {
+		return errorsmod.Wrap(types.ErrInvalidSignature, "channel sender has no public key on chain")
+	}
+	if sdk.AccAddress(senderPubKey.Address()).String() != channel.Sender {
+		return errorsmod.Wrap(types.ErrSignatureVerificationFailed, "public key is not the channel sender's")
+	}
+	return Verify(v, senderPubKey)
+}
+
+// Msg returns the message the recipient submits to claim the voucher
+func (v Voucher) Msg() *types.MsgClaimChannel {
+	return types.NewMsgClaimChannel(v.Recipient, v.ChannelID, v.Amount, v.Nonce, v.Signature)
+}
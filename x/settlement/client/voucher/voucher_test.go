@@ -0,0 +1,84 @@
+package voucher_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/client/voucher"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestVoucher_SignAndVerify(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(300000))
+
+	v := voucher.New(7, recipient, amount, 3)
+	require.Equal(t, "channel_claim:7:"+recipient+":300000ssusd:3", string(v.SignBytes()))
+
+	signed, err := voucher.Sign(v, key)
+	require.NoError(t, err)
+	require.NoError(t, voucher.Verify(signed, key.PubKey()))
+
+	// Any change to the voucher invalidates the signature
+	tampered := signed
+	tampered.Amount = sdk.NewCoin("ssusd", sdkmath.NewInt(300001))
+	require.ErrorIs(t, voucher.Verify(tampered, key.PubKey()), types.ErrSignatureVerificationFailed)
+	require.ErrorIs(t, voucher.Verify(signed, secp256k1.GenPrivKey().PubKey()), types.ErrSignatureVerificationFailed)
+
+	tampered = signed
+	tampered.Signature = "zz"
+	require.ErrorIs(t, voucher.Verify(tampered, key.PubKey()), types.ErrInvalidSignature)
+
+	_, err = voucher.Sign(voucher.New(7, recipient, amount, 0), key)
+	require.ErrorIs(t, err, types.ErrInvalidNonce)
+
+	msg := signed.Msg()
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, signed.Signature, msg.Signature)
+}
+
+func TestVoucher_SignWithKeyring(t *testing.T) {
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig().Codec)
+	record, _, err := kr.NewMnemonic("sender", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(pubKey.Address()).String()
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	signed, signer, err := voucher.SignWithKeyring(voucher.New(1, recipient, sdk.NewCoin("ssusd", sdkmath.NewInt(500)), 1), kr, "sender")
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(signer))
+
+	channel := types.PaymentChannel{
+		Id:        1,
+		Sender:    sender,
+		Recipient: recipient,
+		Balance:   sdk.NewCoin("ssusd", sdkmath.NewInt(1000)),
+		IsOpen:    true,
+	}
+	require.NoError(t, voucher.VerifyForChannel(signed, channel, pubKey))
+
+	stale := channel
+	stale.Nonce = 1
+	require.ErrorIs(t, voucher.VerifyForChannel(signed, stale, pubKey), types.ErrInvalidNonce)
+
+	drained := channel
+	drained.Balance = sdk.NewCoin("ssusd", sdkmath.NewInt(499))
+	require.ErrorIs(t, voucher.VerifyForChannel(signed, drained, pubKey), types.ErrChannelInsufficientBalance)
+
+	closed := channel
+	closed.IsOpen = false
+	require.ErrorIs(t, voucher.VerifyForChannel(signed, closed, pubKey), types.ErrChannelClosed)
+
+	other := secp256k1.GenPrivKey().PubKey()
+	require.ErrorIs(t, voucher.VerifyForChannel(signed, channel, other), types.ErrSignatureVerificationFailed)
+}
@@ -0,0 +1,134 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSecret          = "secret"
+	flagMerchantSecrets = "merchant-secrets"
+	flagStateDir        = "state-dir"
+	flagStartHeight     = "start-height"
+	flagMaxAttempts     = "max-attempts"
+	flagInitialBackoff  = "initial-backoff"
+	flagMaxBackoff      = "max-backoff"
+	flagPollInterval    = "poll-interval"
+	flagTimeout         = "timeout"
+
+	// SecretEnvVar supplies the default signing secret when --secret is unset
+	SecretEnvVar = "STATESET_WEBHOOK_SECRET"
+
+	cursorFile     = "cursor"
+	deadLetterFile = "dead_letters.jsonl"
+)
+
+// NewWebhooksCmd returns the command that runs the merchant webhook dispatcher
+func NewWebhooksCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhooks",
+		Short: "Deliver settlement and order events to merchant webhooks",
+		Long: `Follow the chain and POST settlement, escrow, batch and order events to the
+webhook URL of the merchant that owns them. Payloads are signed with
+HMAC-SHA256 in the ` + SignatureHeader + ` header as "t=<unix>,v1=<hex>" over
+"<unix>.<body>". Failed deliveries are retried with exponential backoff and
+then appended to a dead-letter log. The last fully delivered height is kept
+in the state directory so the dispatcher resumes where it stopped.
+
+Webhooks are only delivered over HTTPS to public addresses; redirects are not
+followed, and URLs resolving to loopback, private or link-local addresses are
+dead-lettered without retrying.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flags.FlagNode)
+			secret, _ := cmd.Flags().GetString(flagSecret)
+			if secret == "" {
+				secret = os.Getenv(SecretEnvVar)
+			}
+			secretsFile, _ := cmd.Flags().GetString(flagMerchantSecrets)
+			stateDir, _ := cmd.Flags().GetString(flagStateDir)
+			startHeight, _ := cmd.Flags().GetInt64(flagStartHeight)
+			maxAttempts, _ := cmd.Flags().GetInt(flagMaxAttempts)
+			initialBackoff, _ := cmd.Flags().GetDuration(flagInitialBackoff)
+			maxBackoff, _ := cmd.Flags().GetDuration(flagMaxBackoff)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+			merchantSecrets, err := loadMerchantSecrets(secretsFile)
+			if err != nil {
+				return err
+			}
+			if secret == "" && len(merchantSecrets) == 0 {
+				return fmt.Errorf("a signing secret is required: set --%s, %s or --%s", flagSecret, SecretEnvVar, flagMerchantSecrets)
+			}
+			if maxAttempts < 1 {
+				return fmt.Errorf("--%s must be at least 1", flagMaxAttempts)
+			}
+
+			source, err := NewHTTPSource(node)
+			if err != nil {
+				return err
+			}
+
+			dispatcher := NewDispatcher(
+				source,
+				Deliverer{
+					Client:         NewClient(timeout),
+					MaxAttempts:    maxAttempts,
+					InitialBackoff: initialBackoff,
+					MaxBackoff:     maxBackoff,
+				},
+				NewCursor(filepath.Join(stateDir, cursorFile)),
+				NewDeadLetterLog(filepath.Join(stateDir, deadLetterFile)),
+				Config{
+					Secret:          secret,
+					MerchantSecrets: merchantSecrets,
+					StartHeight:     startHeight,
+					PollInterval:    pollInterval,
+				},
+				log.NewLogger(cmd.ErrOrStderr()),
+			)
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return dispatcher.Run(ctx)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	cmd.Flags().String(flagSecret, "", "HMAC signing secret shared with merchants (defaults to $"+SecretEnvVar+")")
+	cmd.Flags().String(flagMerchantSecrets, "", "JSON file mapping merchant addresses to per-merchant signing secrets")
+	cmd.Flags().String(flagStateDir, filepath.Join(defaultNodeHome, "webhooks"), "Directory holding the delivery cursor and dead-letter log")
+	cmd.Flags().Int64(flagStartHeight, 0, "Height to start from when no cursor exists (0 = latest block)")
+	cmd.Flags().Int(flagMaxAttempts, 6, "Delivery attempts before a payload is dead-lettered")
+	cmd.Flags().Duration(flagInitialBackoff, time.Second, "Delay before the first retry, doubled after each attempt")
+	cmd.Flags().Duration(flagMaxBackoff, time.Minute, "Upper bound on the retry delay")
+	cmd.Flags().Duration(flagPollInterval, DefaultPollInterval, "Interval between checks for new blocks")
+	cmd.Flags().Duration(flagTimeout, 10*time.Second, "Timeout for each webhook request")
+
+	return cmd
+}
+
+func loadMerchantSecrets(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var secrets map[string]string
+	if err := json.Unmarshal(bz, &secrets); err != nil {
+		return nil, fmt.Errorf("invalid merchant secrets file %s: %w", path, err)
+	}
+	return secrets, nil
+}
@@ -0,0 +1,260 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the timestamped HMAC-SHA256 signature of the body
+	SignatureHeader = "X-Stateset-Signature"
+	// EventHeader carries the event type of the payload
+	EventHeader = "X-Stateset-Event"
+	// DeliveryHeader carries the payload id, stable across retries so
+	// receivers can deduplicate
+	DeliveryHeader = "X-Stateset-Delivery"
+)
+
+// Sign returns the signature header value for a body sent at the given time.
+// The HMAC-SHA256 covers "<unix timestamp>.<body>" so a captured request
+// cannot be replayed with a different timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", unix, computeMAC(secret, unix, body))
+}
+
+// Verify checks a signature header against the body, rejecting signatures
+// older than tolerance. A zero tolerance disables the age check.
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var unix, mac string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			mac = value
+		}
+	}
+	if unix == "" || mac == "" {
+		return fmt.Errorf("malformed signature header")
+	}
+
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid signature timestamp: %w", err)
+	}
+	if tolerance > 0 && now.Sub(time.Unix(seconds, 0)).Abs() > tolerance {
+		return fmt.Errorf("signature timestamp outside tolerance")
+	}
+
+	if !hmac.Equal([]byte(mac), []byte(computeMAC(secret, unix, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+func computeMAC(secret, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ErrBlockedDestination is returned for deliveries to a URL that is not HTTPS
+// or that resolves to a loopback, private or link-local address. Such
+// deliveries are not retried.
+var ErrBlockedDestination = errors.New("webhook destination not allowed")
+
+// NewClient returns the HTTP client webhooks are delivered with. Merchants
+// choose their webhook URLs, so the client only speaks HTTPS, checks the
+// address each connection is actually made to rather than the URL's host, and
+// does not follow redirects; a redirect response counts as a failed delivery.
+// Proxies are not used, since the proxy's address would be checked instead of
+// the webhook's.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: checkDialAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: httpsOnly{next: transport},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// httpsOnly refuses requests that are not HTTPS
+type httpsOnly struct {
+	next http.RoundTripper
+}
+
+func (t httpsOnly) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme != "https" {
+		return nil, fmt.Errorf("%w: %s is not HTTPS", ErrBlockedDestination, req.URL.Redacted())
+	}
+	return t.next.RoundTrip(req)
+}
+
+// checkDialAddress rejects connections to addresses inside the dispatcher's
+// own network. It runs on the resolved address, so a public hostname that
+// resolves to a private address is rejected too.
+func checkDialAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrBlockedDestination, address)
+	}
+	if !IsPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s is not a public address", ErrBlockedDestination, addrPort.Addr())
+	}
+	return nil
+}
+
+// nonPublicPrefixes are ranges not covered by the netip predicates that must
+// not receive webhooks: shared carrier-grade NAT space, IETF protocol
+// assignments, and NAT64, which can reach internal IPv4 addresses
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// IsPublicAddress reports whether webhooks may be delivered to an address: it
+// must not be loopback, private, link-local, multicast, unspecified, shared
+// CGNAT, IETF protocol assignment or NAT64
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Deliverer POSTs signed payloads, retrying transient failures with
+// exponential backoff
+type Deliverer struct {
+	Client         *http.Client
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Now returns the signing time, defaulting to time.Now
+	Now func() time.Time
+}
+
+// deliveryError describes why a delivery was abandoned
+type deliveryError struct {
+	attempts int
+	err      error
+}
+
+func (e *deliveryError) Error() string {
+	return fmt.Sprintf("delivery failed after %d attempt(s): %v", e.attempts, e.err)
+}
+
+func (e *deliveryError) Unwrap() error {
+	return e.err
+}
+
+// Deliver sends the body to url until it is accepted, a non-retryable
+// response is received, attempts are exhausted or ctx is cancelled. It
+// returns the number of attempts made.
+func (d Deliverer) Deliver(ctx context.Context, url, secret, eventType, deliveryID string, body []byte) (int, error) {
+	maxAttempts := d.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	backoff := d.InitialBackoff
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		retry, err := d.post(ctx, url, secret, eventType, deliveryID, body)
+		if err == nil {
+			return attempt, nil
+		}
+		lastErr = err
+		if !retry || attempt == maxAttempts {
+			return attempt, &deliveryError{attempts: attempt, err: lastErr}
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if d.MaxBackoff > 0 && backoff > d.MaxBackoff {
+			backoff = d.MaxBackoff
+		}
+	}
+	return maxAttempts, &deliveryError{attempts: maxAttempts, err: lastErr}
+}
+
+// post makes a single attempt, reporting whether a failure may be retried
+func (d Deliverer) post(ctx context.Context, url, secret, eventType, deliveryID string, body []byte) (bool, error) {
+	now := time.Now
+	if d.Now != nil {
+		now = d.Now
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, now(), body))
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(DeliveryHeader, deliveryID)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, ErrBlockedDestination), err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	return isRetryableStatus(resp.StatusCode), err
+}
+
+func isRetryableStatus(code int) bool {
+	return code >= 500 || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+}
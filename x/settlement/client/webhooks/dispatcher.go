@@ -0,0 +1,270 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// DefaultPollInterval is how often the dispatcher checks for new blocks when
+// no push notification arrives
+const DefaultPollInterval = 5 * time.Second
+
+// Config holds the dispatcher settings that are not tied to storage or transport
+type Config struct {
+	// Secret signs payloads for merchants without an entry in MerchantSecrets
+	Secret string
+	// MerchantSecrets maps merchant addresses to their signing secrets
+	MerchantSecrets map[string]string
+	// StartHeight is the first height processed when no cursor has been
+	// saved. Zero starts from the latest block.
+	StartHeight int64
+	// PollInterval bounds how long the dispatcher waits between checks
+	PollInterval time.Duration
+}
+
+// Dispatcher follows the chain block by block and delivers merchant events
+// to their webhooks. The cursor only advances once every event in a block has
+// been delivered or dead-lettered, so a restart resumes without gaps.
+type Dispatcher struct {
+	source      Source
+	deliverer   Deliverer
+	cursor      *Cursor
+	deadLetters *DeadLetterLog
+	config      Config
+	logger      log.Logger
+}
+
+// NewDispatcher creates a new webhook dispatcher
+func NewDispatcher(source Source, deliverer Deliverer, cursor *Cursor, deadLetters *DeadLetterLog, config Config, logger log.Logger) *Dispatcher {
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	return &Dispatcher{
+		source:      source,
+		deliverer:   deliverer,
+		cursor:      cursor,
+		deadLetters: deadLetters,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// delivery is a single payload bound for a merchant webhook
+type delivery struct {
+	url     string
+	secret  string
+	payload Payload
+}
+
+// Run processes blocks until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) error {
+	next, err := d.firstHeight(ctx)
+	if err != nil {
+		return err
+	}
+	d.logger.Info("starting webhook dispatcher", "height", next)
+
+	var wake <-chan struct{}
+	if notifier, ok := d.source.(BlockNotifier); ok {
+		wake, err = notifier.NewBlocks(ctx)
+		if err != nil {
+			d.logger.Error("failed to subscribe to new blocks, falling back to polling", "err", err)
+		}
+	}
+
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		next, err = d.CatchUp(ctx, next)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			d.logger.Error("webhook dispatch failed, will retry", "height", next, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-wake:
+			if !ok {
+				d.logger.Error("new block subscription closed, falling back to polling")
+				wake = nil
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// firstHeight resumes after the saved cursor, otherwise from the configured
+// start height or the latest block
+func (d *Dispatcher) firstHeight(ctx context.Context) (int64, error) {
+	height, found, err := d.cursor.Load()
+	if err != nil {
+		return 0, err
+	}
+	if found {
+		return height + 1, nil
+	}
+	if d.config.StartHeight > 0 {
+		return d.config.StartHeight, nil
+	}
+	latest, err := d.source.LatestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if latest < 1 {
+		return 1, nil
+	}
+	return latest, nil
+}
+
+// CatchUp processes every block from next up to the latest height and
+// returns the next height to process
+func (d *Dispatcher) CatchUp(ctx context.Context, next int64) (int64, error) {
+	latest, err := d.source.LatestHeight(ctx)
+	if err != nil {
+		return next, err
+	}
+	for ; next <= latest; next++ {
+		if err := d.ProcessBlock(ctx, next); err != nil {
+			return next, err
+		}
+		if err := d.cursor.Save(next); err != nil {
+			return next, err
+		}
+	}
+	return next, nil
+}
+
+// ProcessBlock delivers every merchant event in a block. Merchants are served
+// concurrently while each merchant receives its events in block order.
+func (d *Dispatcher) ProcessBlock(ctx context.Context, height int64) error {
+	events, err := d.source.BlockEvents(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to load events at height %d: %w", height, err)
+	}
+
+	queues, err := d.collect(ctx, height, events)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	for _, queue := range queues {
+		wg.Add(1)
+		go func(queue []delivery) {
+			defer wg.Done()
+			for _, job := range queue {
+				if err := d.deliver(ctx, job); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				}
+			}
+		}(queue)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// collect maps deliverable events to their merchants, grouping payloads per merchant
+func (d *Dispatcher) collect(ctx context.Context, height int64, events []BlockEvent) (map[string][]delivery, error) {
+	type target struct {
+		url    string
+		active bool
+	}
+	targets := make(map[string]target)
+	queues := make(map[string][]delivery)
+
+	for _, ev := range events {
+		if !IsDeliverable(ev.Event.Type) {
+			continue
+		}
+		owner, err := resolveOwner(ctx, d.source, ev.Event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve owner of %s event at height %d: %w", ev.Event.Type, height, err)
+		}
+		if owner == "" {
+			continue
+		}
+
+		t, cached := targets[owner]
+		if !cached {
+			merchant, found, err := merchantConfig(ctx, d.source, owner)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load merchant %s: %w", owner, err)
+			}
+			t = target{url: merchant.WebhookUrl, active: found && merchant.IsActive && merchant.WebhookUrl != ""}
+			targets[owner] = t
+		}
+		if !t.active {
+			continue
+		}
+
+		queues[owner] = append(queues[owner], delivery{
+			url:     t.url,
+			secret:  d.secretFor(owner),
+			payload: NewPayload(height, ev, owner),
+		})
+	}
+	return queues, nil
+}
+
+func (d *Dispatcher) secretFor(merchant string) string {
+	if secret, ok := d.config.MerchantSecrets[merchant]; ok {
+		return secret
+	}
+	return d.config.Secret
+}
+
+// deliver sends one payload, dead-lettering it when delivery is abandoned.
+// Only cancellation and dead-letter write failures are returned, as both
+// must stop the cursor from advancing.
+func (d *Dispatcher) deliver(ctx context.Context, job delivery) error {
+	var (
+		attempts int
+		err      error
+	)
+	if job.secret == "" {
+		err = errors.New("no signing secret configured for merchant")
+	} else {
+		var body []byte
+		body, err = json.Marshal(job.payload)
+		if err != nil {
+			return err
+		}
+		attempts, err = d.deliverer.Deliver(ctx, job.url, job.secret, job.payload.Type, job.payload.ID, body)
+	}
+	if err == nil {
+		d.logger.Debug("webhook delivered", "id", job.payload.ID, "merchant", job.payload.Merchant, "attempts", attempts)
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	d.logger.Error("webhook dead-lettered", "id", job.payload.ID, "merchant", job.payload.Merchant, "err", err)
+	return d.deadLetters.Append(DeadLetter{
+		Payload:  job.payload,
+		URL:      job.url,
+		Attempts: attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,392 @@
+package webhooks_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	orderstypes "github.com/stateset/core/x/orders/types"
+	"github.com/stateset/core/x/settlement/client/webhooks"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+const testSecret = "whsec_test"
+
+type fakeSource struct {
+	mu     sync.Mutex
+	latest int64
+	blocks map[int64][]webhooks.BlockEvent
+	stores map[string]map[string][]byte
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{
+		blocks: make(map[int64][]webhooks.BlockEvent),
+		stores: make(map[string]map[string][]byte),
+	}
+}
+
+func (s *fakeSource) LatestHeight(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latest, nil
+}
+
+func (s *fakeSource) BlockEvents(_ context.Context, height int64) ([]webhooks.BlockEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.blocks[height], nil
+}
+
+func (s *fakeSource) QueryStore(_ context.Context, storeName string, key []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stores[storeName][string(key)], nil
+}
+
+func (s *fakeSource) set(storeName string, key, value []byte) {
+	if s.stores[storeName] == nil {
+		s.stores[storeName] = make(map[string][]byte)
+	}
+	s.stores[storeName][string(key)] = value
+}
+
+func (s *fakeSource) addBlock(height int64, events ...abci.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	block := make([]webhooks.BlockEvent, len(events))
+	for i, ev := range events {
+		block[i] = webhooks.BlockEvent{Index: i, TxHash: "ABCD", Event: ev}
+	}
+	s.blocks[height] = block
+	if height > s.latest {
+		s.latest = height
+	}
+}
+
+func (s *fakeSource) setMerchant(merchant types.MerchantConfig) {
+	key := append(append([]byte{}, types.MerchantKeyPrefix...), []byte(merchant.Address)...)
+	s.set(types.StoreKey, key, types.ModuleCdc.MustMarshalJSON(&merchant))
+}
+
+func (s *fakeSource) setSettlement(settlement types.Settlement) {
+	s.set(types.StoreKey, idKey(types.SettlementKeyPrefix, settlement.Id), types.ModuleCdc.MustMarshalJSON(&settlement))
+}
+
+func (s *fakeSource) setOrder(order orderstypes.Order) {
+	s.set(orderstypes.StoreKey, idKey(orderstypes.OrderKeyPrefix, order.Id), orderstypes.ModuleCdc.MustMarshalJSON(&order))
+}
+
+func idKey(prefix []byte, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, prefix...), bz...)
+}
+
+func event(eventType string, attrs ...string) abci.Event {
+	ev := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		ev.Attributes = append(ev.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return ev
+}
+
+type received struct {
+	headers http.Header
+	body    []byte
+}
+
+type webhookServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []received
+	statuses []int
+}
+
+// newWebhookServer replies with the given statuses in order, then 200
+func newWebhookServer(t *testing.T, statuses ...int) *webhookServer {
+	ws := &webhookServer{statuses: statuses}
+	ws.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		ws.mu.Lock()
+		ws.requests = append(ws.requests, received{headers: r.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(ws.statuses) > 0 {
+			status = ws.statuses[0]
+			ws.statuses = ws.statuses[1:]
+		}
+		ws.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(ws.Close)
+	return ws
+}
+
+func (ws *webhookServer) received() []received {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return append([]received{}, ws.requests...)
+}
+
+func newDispatcher(t *testing.T, source webhooks.Source, maxAttempts int) (*webhooks.Dispatcher, string) {
+	dir := t.TempDir()
+	dispatcher := webhooks.NewDispatcher(
+		source,
+		webhooks.Deliverer{
+			Client:         &http.Client{Timeout: 5 * time.Second},
+			MaxAttempts:    maxAttempts,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     4 * time.Millisecond,
+		},
+		webhooks.NewCursor(filepath.Join(dir, "cursor")),
+		webhooks.NewDeadLetterLog(filepath.Join(dir, "dead_letters.jsonl")),
+		webhooks.Config{Secret: testSecret, StartHeight: 1, PollInterval: 10 * time.Millisecond},
+		log.NewNopLogger(),
+	)
+	return dispatcher, dir
+}
+
+func readDeadLetters(t *testing.T, dir string) []webhooks.DeadLetter {
+	f, err := os.Open(filepath.Join(dir, "dead_letters.jsonl"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []webhooks.DeadLetter
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry webhooks.DeadLetter
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestSignVerify(t *testing.T) {
+	body := []byte(`{"id":"1-0"}`)
+	now := time.Unix(1700000000, 0)
+	header := webhooks.Sign(testSecret, now, body)
+
+	require.NoError(t, webhooks.Verify(testSecret, header, body, time.Minute, now.Add(30*time.Second)))
+	require.Error(t, webhooks.Verify("other", header, body, time.Minute, now))
+	require.Error(t, webhooks.Verify(testSecret, header, []byte(`{"id":"1-1"}`), time.Minute, now))
+	require.Error(t, webhooks.Verify(testSecret, header, body, time.Minute, now.Add(2*time.Minute)))
+	require.Error(t, webhooks.Verify(testSecret, "v1=abcd", body, 0, now))
+}
+
+func TestDispatcher_DeliversMerchantEvents(t *testing.T) {
+	server := newWebhookServer(t)
+	merchant := sdk.AccAddress("merchant_a__________").String()
+	inactive := sdk.AccAddress("merchant_b__________").String()
+	customer := sdk.AccAddress("customer____________").String()
+
+	source := newFakeSource()
+	source.setMerchant(types.MerchantConfig{Address: merchant, IsActive: true, WebhookUrl: server.URL})
+	source.setMerchant(types.MerchantConfig{Address: inactive, IsActive: false, WebhookUrl: server.URL})
+	source.setSettlement(types.Settlement{Id: 3, Sender: customer, Recipient: merchant})
+	source.setOrder(orderstypes.Order{Id: 7, Customer: customer, Merchant: merchant})
+	source.addBlock(1,
+		event(types.EventTypeSettlementCompleted, types.AttributeKeySettlementID, "3", types.AttributeKeyAmount, "100ussusd"),
+		event(types.EventTypeChannelOpened, types.AttributeKeyChannelID, "1", types.AttributeKeySender, merchant),
+		event("order_shipped", "order_id", "7", "carrier", "ups"),
+		event("order_completed", "order_id", "7", "customer", customer),
+		event(types.EventTypeBatchSettled, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, inactive),
+		event(types.EventTypeInstantTransfer, types.AttributeKeySettlementID, "99", types.AttributeKeyRecipient, customer),
+	)
+
+	dispatcher, dir := newDispatcher(t, source, 3)
+	next, err := dispatcher.CatchUp(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), next)
+
+	requests := server.received()
+	require.Len(t, requests, 3)
+
+	var eventTypes []string
+	for _, req := range requests {
+		require.NoError(t, webhooks.Verify(testSecret, req.headers.Get(webhooks.SignatureHeader), req.body, time.Minute, time.Now()))
+
+		var payload webhooks.Payload
+		require.NoError(t, json.Unmarshal(req.body, &payload))
+		require.Equal(t, merchant, payload.Merchant)
+		require.Equal(t, int64(1), payload.Height)
+		require.Equal(t, "ABCD", payload.TxHash)
+		require.Equal(t, payload.Type, req.headers.Get(webhooks.EventHeader))
+		require.Equal(t, payload.ID, req.headers.Get(webhooks.DeliveryHeader))
+		eventTypes = append(eventTypes, payload.Type)
+	}
+	// events for one merchant arrive in block order
+	require.Equal(t, []string{types.EventTypeSettlementCompleted, "order_shipped", "order_completed"}, eventTypes)
+
+	var first webhooks.Payload
+	require.NoError(t, json.Unmarshal(requests[0].body, &first))
+	require.Equal(t, "1-0", first.ID)
+	require.Equal(t, "100ussusd", first.Attributes[types.AttributeKeyAmount])
+
+	height, found, err := webhooks.NewCursor(filepath.Join(dir, "cursor")).Load()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(1), height)
+	require.Empty(t, readDeadLetters(t, dir))
+}
+
+func TestDispatcher_RetriesTransientFailures(t *testing.T) {
+	server := newWebhookServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	merchant := sdk.AccAddress("merchant_a__________").String()
+
+	source := newFakeSource()
+	source.setMerchant(types.MerchantConfig{Address: merchant, IsActive: true, WebhookUrl: server.URL})
+	source.addBlock(1, event(types.EventTypeBatchCreated, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, merchant))
+
+	dispatcher, dir := newDispatcher(t, source, 3)
+	_, err := dispatcher.CatchUp(context.Background(), 1)
+	require.NoError(t, err)
+
+	requests := server.received()
+	require.Len(t, requests, 3)
+	for _, req := range requests {
+		require.Equal(t, "1-0", req.headers.Get(webhooks.DeliveryHeader))
+	}
+	require.Empty(t, readDeadLetters(t, dir))
+}
+
+func TestDispatcher_DeadLettersFailedDeliveries(t *testing.T) {
+	server := newWebhookServer(t,
+		http.StatusInternalServerError, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusBadRequest,
+	)
+	merchant := sdk.AccAddress("merchant_a__________").String()
+
+	source := newFakeSource()
+	source.setMerchant(types.MerchantConfig{Address: merchant, IsActive: true, WebhookUrl: server.URL})
+	source.addBlock(1,
+		event(types.EventTypeBatchCreated, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, merchant),
+		event(types.EventTypeBatchSettled, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, merchant),
+	)
+
+	dispatcher, dir := newDispatcher(t, source, 3)
+	next, err := dispatcher.CatchUp(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), next)
+
+	// three attempts for the 5xx event, one for the rejected event
+	require.Len(t, server.received(), 4)
+
+	entries := readDeadLetters(t, dir)
+	require.Len(t, entries, 2)
+	require.Equal(t, "1-0", entries[0].Payload.ID)
+	require.Equal(t, 3, entries[0].Attempts)
+	require.Contains(t, entries[0].Error, "502")
+	require.Equal(t, "1-1", entries[1].Payload.ID)
+	require.Equal(t, 1, entries[1].Attempts)
+	require.Equal(t, server.URL, entries[1].URL)
+}
+
+func TestDispatcher_ResumesFromCursor(t *testing.T) {
+	server := newWebhookServer(t)
+	merchant := sdk.AccAddress("merchant_a__________").String()
+
+	source := newFakeSource()
+	source.setMerchant(types.MerchantConfig{Address: merchant, IsActive: true, WebhookUrl: server.URL})
+	source.addBlock(1, event(types.EventTypeBatchCreated, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, merchant))
+	source.addBlock(2, event(types.EventTypeBatchSettled, types.AttributeKeyBatchID, "1", types.AttributeKeyMerchant, merchant))
+
+	dispatcher, dir := newDispatcher(t, source, 1)
+	require.NoError(t, webhooks.NewCursor(filepath.Join(dir, "cursor")).Save(1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- dispatcher.Run(ctx) }()
+
+	require.Eventually(t, func() bool { return len(server.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	source.addBlock(3, event(types.EventTypeBatchCreated, types.AttributeKeyBatchID, "2", types.AttributeKeyMerchant, merchant))
+	require.Eventually(t, func() bool { return len(server.received()) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	var ids []string
+	for _, req := range server.received() {
+		ids = append(ids, req.headers.Get(webhooks.DeliveryHeader))
+	}
+	require.Equal(t, []string{"2-0", "3-0"}, ids)
+}
+
+func TestNewClient_BlocksUnsafeDestinations(t *testing.T) {
+	deliverer := webhooks.Deliverer{
+		Client:         webhooks.NewClient(5 * time.Second),
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}
+
+	// Plain HTTP is refused before connecting
+	plain := newWebhookServer(t)
+	attempts, err := deliverer.Deliver(context.Background(), plain.URL, testSecret, "settlement_completed", "1", []byte("{}"))
+	require.ErrorIs(t, err, webhooks.ErrBlockedDestination)
+	require.Equal(t, 1, attempts)
+	require.Empty(t, plain.received())
+
+	// So is HTTPS to a loopback address, without retrying
+	var hits int
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	t.Cleanup(tlsServer.Close)
+	attempts, err = deliverer.Deliver(context.Background(), tlsServer.URL, testSecret, "settlement_completed", "1", []byte("{}"))
+	require.ErrorIs(t, err, webhooks.ErrBlockedDestination)
+	require.Equal(t, 1, attempts)
+	require.Zero(t, hits)
+
+	// Redirects are not followed
+	require.ErrorIs(t, deliverer.Client.CheckRedirect(nil, nil), http.ErrUseLastResponse)
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for _, tc := range []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"100.64.0.1", false},
+		{"100.127.255.254", false},
+		{"100.128.0.1", true},
+		{"192.0.0.8", false},
+		{"192.0.1.1", true},
+		{"64:ff9b::a00:1", false},
+		{"64:ff9b::7f00:1", false},
+		{"64:ff9b:1::a00:1", false},
+		{"2001:4860:4860::8888", true},
+	} {
+		require.Equal(t, tc.public, webhooks.IsPublicAddress(netip.MustParseAddr(tc.addr)), tc.addr)
+	}
+}
@@ -0,0 +1,184 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	orderstypes "github.com/stateset/core/x/orders/types"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// settlementEvents are the settlement, escrow and batch events merchants are notified about
+var settlementEvents = map[string]bool{
+	types.EventTypeSettlementCreated:   true,
+	types.EventTypeSettlementCompleted: true,
+	types.EventTypeSettlementRefunded:  true,
+	types.EventTypeInstantTransfer:     true,
+	types.EventTypeInstantCheckout:     true,
+	types.EventTypePartialRefund:       true,
+	types.EventTypeEscrowExpired:       true,
+	types.EventTypeEscrowResolved:      true,
+	types.EventTypeMilestoneReleased:   true,
+	types.EventTypeMilestoneRefunded:   true,
+	types.EventTypeMilestoneExpired:    true,
+	types.EventTypeBatchCreated:        true,
+	types.EventTypeBatchSettled:        true,
+	types.EventTypePayoutScheduled:     true,
+	types.EventTypePayoutReleased:      true,
+	types.EventTypePayoutFrozen:        true,
+	types.EventTypePayoutUnfrozen:      true,
+}
+
+// orderEvents are the order lifecycle and dispute events merchants are notified about
+var orderEvents = map[string]bool{
+	"order_created":        true,
+	"order_confirmed":      true,
+	"order_paid":           true,
+	"order_shipped":        true,
+	"order_delivered":      true,
+	"order_completed":      true,
+	"order_cancelled":      true,
+	"order_refunded":       true,
+	"order_expired":        true,
+	"order_auto_completed": true,
+	"dispute_opened":       true,
+	"dispute_resolved":     true,
+}
+
+// IsDeliverable reports whether events of the given type are forwarded to merchants
+func IsDeliverable(eventType string) bool {
+	return settlementEvents[eventType] || orderEvents[eventType]
+}
+
+// BlockEvent is an event emitted by a successful transaction or by the block itself
+type BlockEvent struct {
+	// Index is the position of the event within the block, stable across replays
+	Index  int
+	TxHash string
+	Event  abci.Event
+}
+
+// Payload is the JSON body POSTed to a merchant webhook
+type Payload struct {
+	ID         string            `json:"id"`
+	Type       string            `json:"type"`
+	Merchant   string            `json:"merchant"`
+	Height     int64             `json:"height"`
+	TxHash     string            `json:"tx_hash,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// NewPayload builds the webhook payload for a block event owned by a merchant
+func NewPayload(height int64, ev BlockEvent, merchant string) Payload {
+	return Payload{
+		ID:         fmt.Sprintf("%d-%d", height, ev.Index),
+		Type:       ev.Event.Type,
+		Merchant:   merchant,
+		Height:     height,
+		TxHash:     ev.TxHash,
+		Attributes: attributes(ev.Event),
+	}
+}
+
+func attributes(ev abci.Event) map[string]string {
+	attrs := make(map[string]string, len(ev.Attributes))
+	for _, attr := range ev.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// resolveOwner returns the merchant address that owns an event. An explicit
+// merchant attribute wins; otherwise order events are mapped through the
+// order and settlement events through the settlement or batch they refer to.
+func resolveOwner(ctx context.Context, src Source, ev abci.Event) (string, error) {
+	attrs := attributes(ev)
+	if merchant := attrs[types.AttributeKeyMerchant]; merchant != "" {
+		return merchant, nil
+	}
+
+	if orderEvents[ev.Type] {
+		id, ok := parseID(attrs[types.AttributeKeyOrderID])
+		if !ok {
+			return "", nil
+		}
+		var order orderstypes.Order
+		found, err := queryJSON(ctx, src, orderstypes.StoreKey, idKey(orderstypes.OrderKeyPrefix, id), func(bz []byte) error {
+			return orderstypes.ModuleCdc.UnmarshalJSON(bz, &order)
+		})
+		if err != nil || !found {
+			return "", err
+		}
+		return order.Merchant, nil
+	}
+
+	if id, ok := parseID(attrs[types.AttributeKeySettlementID]); ok {
+		var settlement types.Settlement
+		found, err := queryJSON(ctx, src, types.StoreKey, idKey(types.SettlementKeyPrefix, id), func(bz []byte) error {
+			return types.ModuleCdc.UnmarshalJSON(bz, &settlement)
+		})
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return settlement.Recipient, nil
+		}
+	}
+
+	if id, ok := parseID(attrs[types.AttributeKeyBatchID]); ok {
+		var batch types.BatchSettlement
+		found, err := queryJSON(ctx, src, types.StoreKey, idKey(types.BatchKeyPrefix, id), func(bz []byte) error {
+			return types.ModuleCdc.UnmarshalJSON(bz, &batch)
+		})
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return batch.Merchant, nil
+		}
+	}
+
+	return attrs[types.AttributeKeyRecipient], nil
+}
+
+// merchantConfig loads a registered merchant, returning false when none exists
+func merchantConfig(ctx context.Context, src Source, address string) (types.MerchantConfig, bool, error) {
+	var merchant types.MerchantConfig
+	key := append(append([]byte{}, types.MerchantKeyPrefix...), []byte(address)...)
+	found, err := queryJSON(ctx, src, types.StoreKey, key, func(bz []byte) error {
+		return types.ModuleCdc.UnmarshalJSON(bz, &merchant)
+	})
+	return merchant, found, err
+}
+
+func queryJSON(ctx context.Context, src Source, storeName string, key []byte, unmarshal func([]byte) error) (bool, error) {
+	bz, err := src.QueryStore(ctx, storeName, key)
+	if err != nil {
+		return false, err
+	}
+	if len(bz) == 0 {
+		return false, nil
+	}
+	if err := unmarshal(bz); err != nil {
+		return false, fmt.Errorf("failed to decode %s store value: %w", storeName, err)
+	}
+	return true, nil
+}
+
+func idKey(prefix []byte, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(append([]byte{}, prefix...), bz...)
+}
+
+func parseID(value string) (uint64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	return id, err == nil
+}
@@ -0,0 +1,149 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+const (
+	subscriber    = "statesetd-webhooks"
+	newBlockQuery = "tm.event='NewBlock'"
+)
+
+// Source provides the chain data the dispatcher consumes
+type Source interface {
+	// LatestHeight returns the height of the latest committed block
+	LatestHeight(ctx context.Context) (int64, error)
+	// BlockEvents returns the events of successful transactions and of the
+	// block itself, in execution order
+	BlockEvents(ctx context.Context, height int64) ([]BlockEvent, error)
+	// QueryStore returns the raw value stored under key in a module store,
+	// or nil when the key is not set
+	QueryStore(ctx context.Context, storeName string, key []byte) ([]byte, error)
+}
+
+// BlockNotifier is implemented by sources that can push new block
+// notifications, letting the dispatcher react without waiting for a poll
+type BlockNotifier interface {
+	NewBlocks(ctx context.Context) (<-chan struct{}, error)
+}
+
+// NodeSource reads blocks, events and module state from a CometBFT node
+type NodeSource struct {
+	client rpcclient.Client
+}
+
+var (
+	_ Source        = (*NodeSource)(nil)
+	_ BlockNotifier = (*NodeSource)(nil)
+)
+
+// NewNodeSource wraps any CometBFT RPC client, including an in-process local client
+func NewNodeSource(client rpcclient.Client) *NodeSource {
+	return &NodeSource{client: client}
+}
+
+// NewHTTPSource connects to the RPC endpoint of a remote node
+func NewHTTPSource(node string) (*NodeSource, error) {
+	client, err := rpchttp.New(node, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+	return NewNodeSource(client), nil
+}
+
+// LatestHeight implements Source
+func (s *NodeSource) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// BlockEvents implements Source
+func (s *NodeSource) BlockEvents(ctx context.Context, height int64) ([]BlockEvent, error) {
+	block, err := s.client.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []BlockEvent
+	for i, res := range results.TxsResults {
+		if res.Code != 0 {
+			continue
+		}
+		var txHash string
+		if i < len(block.Block.Txs) {
+			txHash = fmt.Sprintf("%X", block.Block.Txs[i].Hash())
+		}
+		for _, ev := range res.Events {
+			events = append(events, BlockEvent{TxHash: txHash, Event: ev})
+		}
+	}
+	for _, ev := range results.FinalizeBlockEvents {
+		events = append(events, BlockEvent{Event: ev})
+	}
+
+	for i := range events {
+		events[i].Index = i
+	}
+	return events, nil
+}
+
+// QueryStore implements Source
+func (s *NodeSource) QueryStore(ctx context.Context, storeName string, key []byte) ([]byte, error) {
+	res, err := s.client.ABCIQuery(ctx, fmt.Sprintf("/store/%s/key", storeName), key)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("store query failed: %s", res.Response.Log)
+	}
+	return res.Response.Value, nil
+}
+
+// NewBlocks implements BlockNotifier. The returned channel is closed when
+// the subscription ends or ctx is cancelled.
+func (s *NodeSource) NewBlocks(ctx context.Context) (<-chan struct{}, error) {
+	if !s.client.IsRunning() {
+		if err := s.client.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	sub, err := s.client.Subscribe(ctx, subscriber, newBlockQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	notify := make(chan struct{}, 1)
+	go func() {
+		defer close(notify)
+		defer func() {
+			_ = s.client.Unsubscribe(context.Background(), subscriber, newBlockQuery)
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-sub:
+				if !ok {
+					return
+				}
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return notify, nil
+}
@@ -0,0 +1,114 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/client/local"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/client/webhooks"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// eventApp is a minimal ABCI application that emits the event encoded in each
+// transaction and serves raw store queries from memory
+type eventApp struct {
+	abci.BaseApplication
+
+	mu     sync.Mutex
+	stores map[string][]byte
+}
+
+func (app *eventApp) FinalizeBlock(_ context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	results := make([]*abci.ExecTxResult, len(req.Txs))
+	for i, tx := range req.Txs {
+		var ev abci.Event
+		if err := json.Unmarshal(tx, &ev); err != nil {
+			results[i] = &abci.ExecTxResult{Code: 1, Log: err.Error()}
+			continue
+		}
+		results[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK, Events: []abci.Event{ev}}
+	}
+	// a non-empty app hash keeps the node from treating the stored block
+	// results of empty blocks as missing
+	return &abci.ResponseFinalizeBlock{TxResults: results, AppHash: make([]byte, 8)}, nil
+}
+
+func (app *eventApp) Query(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	return &abci.ResponseQuery{Code: abci.CodeTypeOK, Key: req.Data, Value: app.stores[req.Path+"/"+string(req.Data)]}, nil
+}
+
+func (app *eventApp) setMerchant(merchant types.MerchantConfig) {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+	key := append(append([]byte{}, types.MerchantKeyPrefix...), []byte(merchant.Address)...)
+	app.stores["/store/settlement/key/"+string(key)] = types.ModuleCdc.MustMarshalJSON(&merchant)
+}
+
+func TestDispatcher_InProcessNode(t *testing.T) {
+	server := newWebhookServer(t)
+	merchant := sdk.AccAddress("merchant_a__________").String()
+
+	app := &eventApp{stores: make(map[string][]byte)}
+	app.setMerchant(types.MerchantConfig{Address: merchant, IsActive: true, WebhookUrl: server.URL})
+
+	node := rpctest.StartTendermint(app, rpctest.SuppressStdout, rpctest.RecreateConfig)
+	t.Cleanup(func() { rpctest.StopTendermint(node) })
+	client := local.New(node)
+
+	dir := t.TempDir()
+	dispatcher := webhooks.NewDispatcher(
+		webhooks.NewNodeSource(client),
+		webhooks.Deliverer{MaxAttempts: 1},
+		webhooks.NewCursor(filepath.Join(dir, "cursor")),
+		webhooks.NewDeadLetterLog(filepath.Join(dir, "dead_letters.jsonl")),
+		webhooks.Config{Secret: testSecret, PollInterval: time.Second},
+		log.NewNopLogger(),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- dispatcher.Run(ctx) }()
+
+	tx, err := json.Marshal(event(types.EventTypeBatchCreated,
+		types.AttributeKeyBatchID, "1",
+		types.AttributeKeyMerchant, merchant,
+		types.AttributeKeyAmount, "500ussusd",
+	))
+	require.NoError(t, err)
+	res, err := client.BroadcastTxCommit(context.Background(), tx)
+	require.NoError(t, err)
+	require.Zero(t, res.TxResult.Code)
+
+	cursor := webhooks.NewCursor(filepath.Join(dir, "cursor"))
+	require.Eventually(t, func() bool {
+		height, found, err := cursor.Load()
+		return err == nil && found && height >= res.Height
+	}, 10*time.Second, 20*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	require.Len(t, server.received(), 1)
+
+	req := server.received()[0]
+	require.NoError(t, webhooks.Verify(testSecret, req.headers.Get(webhooks.SignatureHeader), req.body, time.Minute, time.Now()))
+
+	var payload webhooks.Payload
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	require.Equal(t, types.EventTypeBatchCreated, payload.Type)
+	require.Equal(t, merchant, payload.Merchant)
+	require.Equal(t, res.Height, payload.Height)
+	require.Equal(t, res.Hash.String(), payload.TxHash)
+	require.Equal(t, "500ussusd", payload.Attributes[types.AttributeKeyAmount])
+}
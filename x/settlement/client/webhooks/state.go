@@ -0,0 +1,98 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cursor persists the last block height whose deliveries have all finished
+type Cursor struct {
+	path string
+}
+
+// NewCursor returns a cursor stored at path
+func NewCursor(path string) *Cursor {
+	return &Cursor{path: path}
+}
+
+// Load returns the saved height, reporting false when nothing has been saved
+func (c *Cursor) Load() (int64, bool, error) {
+	bz, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	height, err := strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("corrupt delivery cursor %s: %w", c.path, err)
+	}
+	return height, true, nil
+}
+
+// Save atomically records height as fully processed
+func (c *Cursor) Save(height int64) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)+"\n"), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// DeadLetter records a payload that could not be delivered
+type DeadLetter struct {
+	Payload  Payload   `json:"payload"`
+	URL      string    `json:"url"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetterLog appends undeliverable payloads to a JSON lines file
+type DeadLetterLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewDeadLetterLog returns a dead-letter log stored at path
+func NewDeadLetterLog(path string) *DeadLetterLog {
+	return &DeadLetterLog{path: path}
+}
+
+// Append writes an entry and syncs it to disk
+func (l *DeadLetterLog) Append(entry DeadLetter) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
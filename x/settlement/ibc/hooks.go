@@ -19,24 +19,34 @@ type IBCHooks struct {
 // SettlementKeeper defines the expected settlement keeper interface
 type SettlementKeeper interface {
 	ProcessCrossChainSettlement(ctx sdk.Context, sender, receiver string, amount sdk.Coins, sourceChannel, destChannel string) error
+	CreateCrossChainEscrow(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, amount sdk.Coins, memo CrossChainSettlementMemo) (CrossChainEscrow, error)
 	GetCrossChainEscrow(ctx sdk.Context, escrowID string) (CrossChainEscrow, bool)
-	CompleteCrossChainEscrow(ctx sdk.Context, escrowID string, packet channeltypes.Packet) error
-	RefundCrossChainEscrow(ctx sdk.Context, escrowID string) error
+	CompleteCrossChainEscrow(ctx sdk.Context, escrowID string, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error
 }
 
+// Cross-chain escrow statuses
+const (
+	EscrowStatusActive    = "active"
+	EscrowStatusCompleted = "completed"
+	EscrowStatusRefunded  = "refunded"
+)
+
 // CrossChainEscrow represents a cross-chain escrow
 type CrossChainEscrow struct {
-	ID            string      `json:"id"`
-	Sender        string      `json:"sender"`
-	Receiver      string      `json:"receiver"`
-	Amount        sdk.Coins   `json:"amount"`
-	SourceChain   string      `json:"source_chain"`
-	DestChain     string      `json:"dest_chain"`
-	SourceChannel string      `json:"source_channel"`
-	DestChannel   string      `json:"dest_channel"`
-	Status        string      `json:"status"`
-	CreatedAt     int64       `json:"created_at"`
-	ExpiresAt     int64       `json:"expires_at"`
+	ID            string    `json:"id"`
+	SettlementID  uint64    `json:"settlement_id"`
+	Sender        string    `json:"sender"`
+	Receiver      string    `json:"receiver"`
+	Amount        sdk.Coins `json:"amount"`
+	SourceChain   string    `json:"source_chain"`
+	DestChain     string    `json:"dest_chain"`
+	SourceChannel string    `json:"source_channel"`
+	DestChannel   string    `json:"dest_channel"`
+	Sequence      uint64    `json:"sequence"`
+	Conditions    string    `json:"conditions,omitempty"`
+	Status        string    `json:"status"`
+	CreatedAt     int64     `json:"created_at"`
+	ExpiresAt     int64     `json:"expires_at"`
 }
 
 // CrossChainSettlementMemo represents the memo structure for cross-chain settlements
@@ -45,6 +55,15 @@ type CrossChainSettlementMemo struct {
 	EscrowID   string `json:"escrow_id,omitempty"`
 	Receiver   string `json:"receiver,omitempty"`
 	Conditions string `json:"conditions,omitempty"`
+	// ExpirationSeconds bounds how long an escrow_create escrow is held;
+	// zero uses the module's default escrow expiration
+	ExpirationSeconds int64 `json:"expiration_seconds,omitempty"`
+}
+
+// EscrowCreateAcknowledgement is the result returned to the source chain when an
+// escrow_create transfer opens an escrow
+type EscrowCreateAcknowledgement struct {
+	EscrowID string `json:"escrow_id"`
 }
 
 // NewIBCHooks creates a new IBC hooks instance
@@ -80,6 +99,8 @@ func (h *IBCHooks) OnRecvPacket(
 	switch memo.Type {
 	case "settlement":
 		return h.handleSettlement(ctx, packet, data, memo)
+	case "escrow_create":
+		return h.handleEscrowCreate(ctx, packet, data, memo)
 	case "escrow_release":
 		return h.handleEscrowRelease(ctx, packet, data, memo)
 	default:
@@ -87,26 +108,31 @@ func (h *IBCHooks) OnRecvPacket(
 	}
 }
 
-// handleSettlement processes a cross-chain settlement
+// handleSettlement processes a cross-chain settlement. The transfer has already
+// credited data.Receiver, which pays memo.Receiver through the settlement keeper.
 func (h *IBCHooks) handleSettlement(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	data transfertypes.FungibleTokenPacketData,
 	memo CrossChainSettlementMemo,
 ) ibcexported.Acknowledgement {
+	if memo.Receiver == "" {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidMemo, "settlement receiver is required"))
+	}
+
 	// Parse amount
 	amount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
 		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidAmount, "failed to parse amount"))
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(data.Denom, amount))
+	coins := sdk.NewCoins(sdk.NewCoin(ReceivedDenom(packet, data.Denom), amount))
 
 	// Process the cross-chain settlement
 	if err := h.settlementKeeper.ProcessCrossChainSettlement(
 		ctx,
-		data.Sender,
 		data.Receiver,
+		memo.Receiver,
 		coins,
 		packet.SourceChannel,
 		packet.DestinationChannel,
@@ -119,7 +145,7 @@ func (h *IBCHooks) handleSettlement(
 		sdk.NewEvent(
 			"cross_chain_settlement",
 			sdk.NewAttribute("sender", data.Sender),
-			sdk.NewAttribute("receiver", data.Receiver),
+			sdk.NewAttribute("receiver", memo.Receiver),
 			sdk.NewAttribute("amount", data.Amount),
 			sdk.NewAttribute("denom", data.Denom),
 			sdk.NewAttribute("source_channel", packet.SourceChannel),
@@ -130,6 +156,55 @@ func (h *IBCHooks) handleSettlement(
 	return nil // Success, continue with normal transfer processing
 }
 
+// handleEscrowCreate moves the received funds from data.Receiver into an escrow
+// payable to memo.Receiver and returns the escrow ID in the acknowledgement
+func (h *IBCHooks) handleEscrowCreate(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	memo CrossChainSettlementMemo,
+) ibcexported.Acknowledgement {
+	if memo.Receiver == "" {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidMemo, "escrow receiver is required"))
+	}
+	if memo.ExpirationSeconds < 0 {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidMemo, "escrow expiration cannot be negative"))
+	}
+
+	// Parse amount
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidAmount, "failed to parse amount"))
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(ReceivedDenom(packet, data.Denom), amount))
+
+	escrow, err := h.settlementKeeper.CreateCrossChainEscrow(ctx, packet, data, coins, memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cross_chain_escrow_created",
+			sdk.NewAttribute("escrow_id", escrow.ID),
+			sdk.NewAttribute("sender", data.Sender),
+			sdk.NewAttribute("receiver", memo.Receiver),
+			sdk.NewAttribute("amount", data.Amount),
+			sdk.NewAttribute("denom", data.Denom),
+			sdk.NewAttribute("source_channel", packet.SourceChannel),
+			sdk.NewAttribute("dest_channel", packet.DestinationChannel),
+		),
+	)
+
+	bz, err := json.Marshal(EscrowCreateAcknowledgement{EscrowID: escrow.ID})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
 // handleEscrowRelease processes a cross-chain escrow release
 func (h *IBCHooks) handleEscrowRelease(
 	ctx sdk.Context,
@@ -144,12 +219,12 @@ func (h *IBCHooks) handleEscrowRelease(
 	}
 
 	// Verify the release conditions
-	if escrow.Status != "active" {
+	if escrow.Status != EscrowStatusActive {
 		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(ErrInvalidEscrowStatus, "escrow is not active"))
 	}
 
 	// Complete the escrow
-	if err := h.settlementKeeper.CompleteCrossChainEscrow(ctx, memo.EscrowID, packet); err != nil {
+	if err := h.settlementKeeper.CompleteCrossChainEscrow(ctx, memo.EscrowID, packet, data); err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
@@ -166,93 +241,17 @@ func (h *IBCHooks) handleEscrowRelease(
 	return nil
 }
 
-// OnAcknowledgementPacket processes acknowledgements for sent packets
-func (h *IBCHooks) OnAcknowledgementPacket(
-	ctx sdk.Context,
-	packet channeltypes.Packet,
-	acknowledgement []byte,
-	relayer sdk.AccAddress,
-) error {
-	// Parse the transfer packet data
-	var data transfertypes.FungibleTokenPacketData
-	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		return nil // Not our concern
-	}
-
-	// Check if this was a settlement-related transfer
-	if data.Memo == "" {
-		return nil
-	}
-
-	var memo CrossChainSettlementMemo
-	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil {
-		return nil
-	}
-
-	// Parse acknowledgement
-	var ack channeltypes.Acknowledgement
-	if err := json.Unmarshal(acknowledgement, &ack); err != nil {
-		return nil
-	}
-
-	// Handle failed transfers
-	if !ack.Success() {
-		if memo.EscrowID != "" {
-			// Refund the escrow
-			if err := h.settlementKeeper.RefundCrossChainEscrow(ctx, memo.EscrowID); err != nil {
-				return err
-			}
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					"cross_chain_escrow_refunded",
-					sdk.NewAttribute("escrow_id", memo.EscrowID),
-					sdk.NewAttribute("reason", "acknowledgement_failed"),
-				),
-			)
-		}
-	}
-
-	return nil
-}
-
-// OnTimeoutPacket processes timeout for sent packets
-func (h *IBCHooks) OnTimeoutPacket(
-	ctx sdk.Context,
-	packet channeltypes.Packet,
-	relayer sdk.AccAddress,
-) error {
-	// Parse the transfer packet data
-	var data transfertypes.FungibleTokenPacketData
-	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		return nil
-	}
-
-	// Check if this was a settlement-related transfer
-	if data.Memo == "" {
-		return nil
+// ReceivedDenom returns the local denomination credited for an incoming
+// ICS-20 packet carrying the given packet denom.
+func ReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// Token is returning to this chain: strip the counterparty prefix
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
 	}
 
-	var memo CrossChainSettlementMemo
-	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil {
-		return nil
-	}
-
-	// Handle timeout for escrow transfers
-	if memo.EscrowID != "" {
-		if err := h.settlementKeeper.RefundCrossChainEscrow(ctx, memo.EscrowID); err != nil {
-			return err
-		}
-
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				"cross_chain_escrow_timeout",
-				sdk.NewAttribute("escrow_id", memo.EscrowID),
-			),
-		)
-	}
-
-	return nil
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
 }
 
 // CreateSettlementMemo creates a memo for cross-chain settlement
@@ -265,6 +264,18 @@ func CreateSettlementMemo(receiver string) string {
 	return string(bz)
 }
 
+// CreateEscrowCreateMemo creates a memo that opens an escrow payable to receiver
+func CreateEscrowCreateMemo(receiver, conditions string, expirationSeconds int64) string {
+	memo := CrossChainSettlementMemo{
+		Type:              "escrow_create",
+		Receiver:          receiver,
+		Conditions:        conditions,
+		ExpirationSeconds: expirationSeconds,
+	}
+	bz, _ := json.Marshal(memo)
+	return string(bz)
+}
+
 // CreateEscrowReleaseMemo creates a memo for escrow release
 func CreateEscrowReleaseMemo(escrowID string) string {
 	memo := CrossChainSettlementMemo{
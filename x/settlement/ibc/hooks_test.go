@@ -0,0 +1,165 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/app"
+	"github.com/stateset/core/app/apptesting"
+	compliancetypes "github.com/stateset/core/x/compliance/types"
+	settlementibc "github.com/stateset/core/x/settlement/ibc"
+	settlementtypes "github.com/stateset/core/x/settlement/types"
+)
+
+// escrowTest runs two Stateset chains joined by a transfer channel. Escrows are
+// held on chainB and funded by transfers from chainA.
+type escrowTest struct {
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func setupEscrowTest(t *testing.T) *escrowTest {
+	t.Helper()
+	coordinator := apptesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	return &escrowTest{coordinator: coordinator, chainA: chainA, chainB: chainB, path: path}
+}
+
+func statesetApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+func setCompliant(chain *ibctesting.TestChain, addr sdk.AccAddress) {
+	ctx := chain.GetContext()
+	now := ctx.BlockTime()
+	statesetApp(chain).ComplianceKeeper.SetProfile(ctx, compliancetypes.Profile{
+		Address:        addr.String(),
+		KYCLevel:       compliancetypes.KYCStandard,
+		Risk:           compliancetypes.RiskLow,
+		Status:         compliancetypes.StatusActive,
+		Jurisdiction:   "US",
+		VerifiedAt:     now,
+		ExpiresAt:      now.Add(365 * 24 * time.Hour),
+		LastLimitReset: now,
+	})
+}
+
+// transfer sends coin from the chain's sender account over the test channel and
+// returns the packet it committed.
+func (s *escrowTest) transfer(t *testing.T, endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver, memo string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	t.Helper()
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+		timeoutHeight, 0, memo,
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+// createEscrow funds both senders with ssusd, moving half of it to chainA as
+// vouchers, and sends amount back with an escrow_create memo, returning the escrow held on chainB.
+func (s *escrowTest) createEscrow(t *testing.T, payer, merchant sdk.AccAddress, amount sdkmath.Int) settlementibc.CrossChainEscrow {
+	t.Helper()
+	ssusd := sdk.NewCoin(settlementtypes.StablecoinDenom, amount.MulRaw(2))
+	require.NoError(t, apptesting.FundAccount(statesetApp(s.chainB).BankKeeper, s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), sdk.NewCoins(ssusd.Add(ssusd))))
+
+	packet := s.transfer(t, s.path.EndpointB, ssusd, s.chainA.SenderAccount.GetAddress().String(), "", s.chainA.GetTimeoutHeight())
+	require.NoError(t, s.path.RelayPacket(packet))
+
+	voucher := transfertypes.GetPrefixedDenom(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, settlementtypes.StablecoinDenom)
+	coin := sdk.NewCoin(transfertypes.ParseDenomTrace(voucher).IBCDenom(), amount)
+	memo := settlementibc.CreateEscrowCreateMemo(merchant.String(), "delivery confirmed", 3600)
+	packet = s.transfer(t, s.path.EndpointA, coin, payer.String(), memo, s.chainB.GetTimeoutHeight())
+	_, ackBz, err := s.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.True(t, ack.Success(), ack.GetError())
+	var escrowAck settlementibc.EscrowCreateAcknowledgement
+	require.NoError(t, json.Unmarshal(ack.GetResult(), &escrowAck))
+
+	escrow, found := statesetApp(s.chainB).SettlementKeeper.GetCrossChainEscrow(s.chainB.GetContext(), escrowAck.EscrowID)
+	require.True(t, found)
+	require.Equal(t, packet.Sequence, escrow.Sequence)
+	return escrow
+}
+
+func (s *escrowTest) requireEscrowStatus(t *testing.T, escrowID, status string) {
+	t.Helper()
+	escrow, found := statesetApp(s.chainB).SettlementKeeper.GetCrossChainEscrow(s.chainB.GetContext(), escrowID)
+	require.True(t, found)
+	require.Equal(t, status, escrow.Status)
+}
+
+func (s *escrowTest) senderBalance(chain *ibctesting.TestChain) sdkmath.Int {
+	return statesetApp(chain).BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), settlementtypes.StablecoinDenom).Amount
+}
+
+func TestCrossChainEscrow_OutboundAckAndTimeoutDoNotRefund(t *testing.T) {
+	s := setupEscrowTest(t)
+	payer := sdk.AccAddress([]byte("escrow-payer________"))
+	merchant := sdk.AccAddress([]byte("escrow-merchant_____"))
+	setCompliant(s.chainB, payer)
+	setCompliant(s.chainB, merchant)
+
+	amount := sdkmath.NewInt(1_000_000)
+	escrow := s.createEscrow(t, payer, merchant, amount)
+	s.requireEscrowStatus(t, escrow.ID, settlementibc.EscrowStatusActive)
+	require.Equal(t, s.path.EndpointB.ChannelID, escrow.DestChannel)
+
+	// Outbound transfers from the escrow chain over the escrow's channel name the
+	// escrow in their memo
+	coin := sdk.NewCoin(settlementtypes.StablecoinDenom, sdkmath.NewInt(1))
+	memo := settlementibc.CreateEscrowReleaseMemo(escrow.ID)
+	balance := s.senderBalance(s.chainB)
+
+	// An error acknowledgement: the transfer module refunds the sender
+	packet := s.transfer(t, s.path.EndpointB, coin, "not-an-address", memo, s.chainA.GetTimeoutHeight())
+	_, ackBz, err := s.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	require.False(t, ack.Success())
+	s.requireEscrowStatus(t, escrow.ID, settlementibc.EscrowStatusActive)
+	require.Equal(t, balance, s.senderBalance(s.chainB))
+
+	// A timeout: the transfer module refunds the sender
+	timeoutHeight := clienttypes.NewHeight(clienttypes.ParseChainID(s.chainA.ChainID), uint64(s.chainA.GetContext().BlockHeight())+1)
+	packet = s.transfer(t, s.path.EndpointB, coin, s.chainA.SenderAccount.GetAddress().String(), memo, timeoutHeight)
+	s.coordinator.CommitNBlocks(s.chainA, 2)
+	require.NoError(t, s.path.EndpointB.UpdateClient())
+	require.NoError(t, s.path.EndpointB.TimeoutPacket(packet))
+	s.requireEscrowStatus(t, escrow.ID, settlementibc.EscrowStatusActive)
+	require.Equal(t, balance, s.senderBalance(s.chainB))
+
+	settlement, found := statesetApp(s.chainB).SettlementKeeper.GetSettlement(s.chainB.GetContext(), escrow.SettlementID)
+	require.True(t, found)
+	require.Equal(t, settlementtypes.SettlementStatusPending, settlement.Status)
+
+	// The escrow's sender can still release it to the merchant
+	voucher := transfertypes.GetPrefixedDenom(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, settlementtypes.StablecoinDenom)
+	release := sdk.NewCoin(transfertypes.ParseDenomTrace(voucher).IBCDenom(), sdkmath.NewInt(1))
+	packet = s.transfer(t, s.path.EndpointA, release, payer.String(), memo, s.chainB.GetTimeoutHeight())
+	require.NoError(t, s.path.RelayPacket(packet))
+	s.requireEscrowStatus(t, escrow.ID, settlementibc.EscrowStatusCompleted)
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Auto-Settled Batches
+// ============================================================================
+//
+// Instant payments to an active merchant with batching enabled are not paid out
+// one by one. They are held in the module account and collected into the
+// merchant's open batch, which EndBlock settles as a single payout once the
+// merchant's threshold or the module's max batch size is reached, or when the
+// batch reaches its max age. A closed batch is paid out once the merchant's
+// settlement delay has passed. Fees are collected in aggregate on settlement.
+
+// batchingMerchant returns the merchant config when payments of the given
+// denom to the recipient are collected into batches
+func (k Keeper) batchingMerchant(ctx sdk.Context, recipient, denom string) (types.MerchantConfig, bool) {
+	merchant, found := k.GetMerchant(ctx, recipient)
+	if !found || !merchant.IsActive || !merchant.BatchEnabled || denom != types.StablecoinDenom {
+		return types.MerchantConfig{}, false
+	}
+	return merchant, true
+}
+
+// batchMaxAge returns how long the merchant's batches collect payments
+func batchMaxAge(merchant types.MerchantConfig) time.Duration {
+	if merchant.BatchMaxAge > 0 {
+		return merchant.BatchMaxAge
+	}
+	return types.DefaultBatchMaxAge
+}
+
+// batchFullTrigger returns why a batch must be settled before its max age,
+// or an empty string when it can keep collecting payments
+func (k Keeper) batchFullTrigger(ctx sdk.Context, merchant types.MerchantConfig, batch types.BatchSettlement) string {
+	threshold := merchant.BatchThreshold
+	if !threshold.Amount.IsNil() && threshold.IsPositive() &&
+		threshold.Denom == batch.TotalAmount.Denom && batch.TotalAmount.IsGTE(threshold) {
+		return types.BatchTriggerThreshold
+	}
+	if maxSize := k.GetParams(ctx).MaxBatchSize; maxSize > 0 && batch.Count >= uint64(maxSize) {
+		return types.BatchTriggerSize
+	}
+	return ""
+}
+
+// addToOpenBatch adds a held payment to the merchant's open batch, opening a
+// new one if needed, and returns the batch ID. A batch that becomes full is
+// closed to new payments and queued to settle in this block's EndBlock.
+func (k Keeper) addToOpenBatch(ctx sdk.Context, merchant types.MerchantConfig, settlementId uint64, amount, fee sdk.Coin) uint64 {
+	batch, found := k.GetOpenBatch(ctx, merchant.Address)
+	if !found {
+		batch = types.BatchSettlement{
+			Id:            k.getNextBatchID(ctx),
+			Merchant:      merchant.Address,
+			TotalAmount:   sdk.NewCoin(amount.Denom, sdkmath.ZeroInt()),
+			TotalFees:     sdk.NewCoin(amount.Denom, sdkmath.ZeroInt()),
+			NetAmount:     sdk.NewCoin(amount.Denom, sdkmath.ZeroInt()),
+			Status:        types.SettlementStatusPending,
+			CreatedHeight: ctx.BlockHeight(),
+			CreatedTime:   ctx.BlockTime(),
+			AutoSettle:    true,
+			SettleBy:      ctx.BlockTime().Add(batchMaxAge(merchant)),
+		}
+		k.setNextBatchID(ctx, batch.Id+1)
+		k.setOpenBatch(ctx, batch.Merchant, batch.Id)
+		k.enqueueBatchSettle(ctx, batch.SettleBy, batch.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBatchCreated,
+				sdk.NewAttribute(types.AttributeKeyBatchID, fmt.Sprintf("%d", batch.Id)),
+				sdk.NewAttribute(types.AttributeKeyMerchant, batch.Merchant),
+				sdk.NewAttribute(types.AttributeKeySettleBy, batch.SettleBy.UTC().Format(time.RFC3339)),
+			),
+		)
+	}
+
+	batch.SettlementIds = append(batch.SettlementIds, settlementId)
+	batch.TotalAmount = batch.TotalAmount.Add(amount)
+	batch.TotalFees = batch.TotalFees.Add(fee)
+	batch.NetAmount = batch.TotalAmount.Sub(batch.TotalFees)
+	batch.Count++
+	k.storeBatch(ctx, batch)
+
+	if k.batchFullTrigger(ctx, merchant, batch) != "" {
+		k.closeBatch(ctx, batch)
+	}
+
+	return batch.Id
+}
+
+// closeBatch stops an auto-settled batch from collecting payments and queues
+// it to be paid out once the merchant's settlement delay has passed
+func (k Keeper) closeBatch(ctx sdk.Context, batch types.BatchSettlement) types.BatchSettlement {
+	k.closeOpenBatch(ctx, batch)
+	batch.ReleaseAt = ctx.BlockTime().Add(k.settlementDelay(ctx, batch.Merchant))
+	k.storeBatch(ctx, batch)
+	k.enqueueBatchSettle(ctx, batch.ReleaseAt, batch.Id)
+	return batch
+}
+
+// ProcessDueBatches settles the auto-settled batches queued as due. A batch
+// whose merchant fails compliance is retried after another max age period;
+// any other failure is retried in the next block.
+func (k Keeper) ProcessDueBatches(ctx sdk.Context) {
+	currentTime := ctx.BlockTime()
+
+	for _, id := range k.dequeueDue(ctx, types.BatchSettleQueuePrefix, sdk.FormatTimeBytes(currentTime)) {
+		batch, found := k.GetBatch(ctx, id)
+		if !found || !batch.AutoSettle || batch.Status != types.SettlementStatusPending {
+			continue
+		}
+
+		merchant, _ := k.GetMerchant(ctx, batch.Merchant)
+		trigger := k.batchFullTrigger(ctx, merchant, batch)
+		if batch.ReleaseAt.IsZero() {
+			if trigger == "" && currentTime.Before(batch.SettleBy) {
+				continue
+			}
+			batch = k.closeBatch(ctx, batch)
+		}
+		if currentTime.Before(batch.ReleaseAt) {
+			continue
+		}
+		if trigger == "" {
+			trigger = types.BatchTriggerMaxAge
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleBatch(cacheCtx, batch, trigger); err != nil {
+			ctx.Logger().Error("failed to settle batch", "batch_id", batch.Id, "error", err)
+			retryAt := currentTime
+			if errors.Is(err, types.ErrComplianceCheckFailed) {
+				retryAt = currentTime.Add(batchMaxAge(merchant))
+			}
+			k.enqueueBatchSettle(ctx, retryAt, batch.Id)
+			continue
+		}
+		write()
+	}
+}
+
+// GetOpenBatch returns the batch collecting a merchant's incoming payments
+func (k Keeper) GetOpenBatch(ctx sdk.Context, merchant string) (types.BatchSettlement, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OpenBatchKeyPrefix)
+	bz := store.Get([]byte(merchant))
+	if len(bz) == 0 {
+		return types.BatchSettlement{}, false
+	}
+	batch, found := k.GetBatch(ctx, binary.BigEndian.Uint64(bz))
+	if !found || batch.Status != types.SettlementStatusPending {
+		return types.BatchSettlement{}, false
+	}
+	return batch, true
+}
+
+func (k Keeper) setOpenBatch(ctx sdk.Context, merchant string, batchId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OpenBatchKeyPrefix)
+	store.Set([]byte(merchant), mustWriteUint64(batchId))
+}
+
+// closeOpenBatch stops a settled batch from collecting further payments
+func (k Keeper) closeOpenBatch(ctx sdk.Context, batch types.BatchSettlement) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OpenBatchKeyPrefix)
+	bz := store.Get([]byte(batch.Merchant))
+	if len(bz) != 0 && binary.BigEndian.Uint64(bz) == batch.Id {
+		store.Delete([]byte(batch.Merchant))
+	}
+}
+
+// restoreAutoBatch queues a pending auto-settled batch and reopens it for
+// payments if it is not yet closed or full
+func (k Keeper) restoreAutoBatch(ctx sdk.Context, batch types.BatchSettlement) {
+	if !batch.AutoSettle || batch.Status != types.SettlementStatusPending {
+		return
+	}
+	if !batch.ReleaseAt.IsZero() {
+		k.enqueueBatchSettle(ctx, batch.ReleaseAt, batch.Id)
+		return
+	}
+	merchant, _ := k.GetMerchant(ctx, batch.Merchant)
+	if k.batchFullTrigger(ctx, merchant, batch) != "" {
+		k.enqueueBatchSettle(ctx, ctx.BlockTime(), batch.Id)
+		return
+	}
+	k.setOpenBatch(ctx, batch.Merchant, batch.Id)
+	k.enqueueBatchSettle(ctx, batch.SettleBy, batch.Id)
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func registerBatchingMerchant(t *testing.T, k keeper.Keeper, ctx sdk.Context, threshold sdk.Coin, maxAge time.Duration) sdk.AccAddress {
+	merchant := newSettlementAddress()
+	require.NoError(t, k.RegisterMerchant(ctx, types.MerchantConfig{
+		Address:        merchant.String(),
+		Name:           "Batching Merchant",
+		BatchEnabled:   true,
+		BatchThreshold: threshold,
+		BatchMaxAge:    maxAge,
+	}))
+	return merchant
+}
+
+func TestAutoBatch_SettlesAtThreshold(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(250000)), 0)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	var ids []uint64
+	for i := 0; i < 2; i++ {
+		id, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// Payments are held and collected into one open batch
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	batch, found := k.GetOpenBatch(ctx, merchant.String())
+	require.True(t, found)
+	require.True(t, batch.AutoSettle)
+	require.Equal(t, ids, batch.SettlementIds)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBatchMaxAge), batch.SettleBy)
+	settlement, _ := k.GetSettlement(ctx, ids[0])
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Equal(t, batch.Id, settlement.BatchId)
+
+	k.ProcessDueBatches(ctx)
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusPending, batch.Status)
+
+	// Reaching the threshold closes the batch and EndBlock settles it
+	id, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	ids = append(ids, id)
+	_, found = k.GetOpenBatch(ctx, merchant.String())
+	require.False(t, found)
+
+	k.ProcessDueBatches(ctx)
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, batch.Status)
+	require.Equal(t, uint64(3), batch.Count)
+	require.Equal(t, sdkmath.NewInt(300000), batch.TotalAmount.Amount)
+	require.Equal(t, settlement.Fee.Amount.MulRaw(3), batch.TotalFees.Amount)
+	require.Equal(t, batch.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+
+	for _, id := range ids {
+		settlement, _ := k.GetSettlement(ctx, id)
+		require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	}
+
+	// The next payment opens a new batch
+	_, err = k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	next, found := k.GetOpenBatch(ctx, merchant.String())
+	require.True(t, found)
+	require.NotEqual(t, batch.Id, next.Id)
+}
+
+func TestAutoBatch_SettlesAtMaxAge(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(10000000)), time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	_, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	batch, _ := k.GetOpenBatch(ctx, merchant.String())
+
+	k.ProcessDueBatches(ctx.WithBlockTime(ctx.BlockTime().Add(59 * time.Minute)))
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+
+	k.ProcessDueBatches(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, batch.Status)
+	require.Equal(t, batch.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+	_, found := k.GetOpenBatch(ctx, merchant.String())
+	require.False(t, found)
+}
+
+func TestAutoBatch_HeldForSettlementDelay(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(1)), time.Hour)
+	require.NoError(t, k.UpdateMerchant(ctx, merchant.String(), map[string]interface{}{"settlement_delay": 24 * time.Hour}))
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	// A threshold of one closes the batch with the first payment
+	id, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, id)
+	batch, _ := k.GetBatch(ctx, settlement.BatchId)
+	require.Equal(t, ctx.BlockTime().Add(24*time.Hour), batch.ReleaseAt)
+
+	// Neither this block nor the batch's max age pays it out before the delay
+	for _, after := range []time.Duration{0, time.Hour, 23 * time.Hour} {
+		k.ProcessDueBatches(ctx.WithBlockTime(ctx.BlockTime().Add(after)))
+		require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	}
+
+	k.ProcessDueBatches(ctx.WithBlockTime(batch.ReleaseAt))
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, batch.Status)
+	require.Equal(t, batch.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+}
+
+func TestAutoBatch_MaxAgeHeldForSettlementDelay(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(10000000)), time.Hour)
+	require.NoError(t, k.UpdateMerchant(ctx, merchant.String(), map[string]interface{}{"settlement_delay": 24 * time.Hour}))
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	_, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	batch, _ := k.GetOpenBatch(ctx, merchant.String())
+
+	// At its max age the batch stops collecting payments but is still held
+	closeCtx := ctx.WithBlockTime(batch.SettleBy)
+	k.ProcessDueBatches(closeCtx)
+	_, found := k.GetOpenBatch(ctx, merchant.String())
+	require.False(t, found)
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusPending, batch.Status)
+	require.Equal(t, batch.SettleBy.Add(24*time.Hour), batch.ReleaseAt)
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+
+	k.ProcessDueBatches(ctx.WithBlockTime(batch.ReleaseAt))
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, batch.Status)
+	require.Equal(t, batch.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+}
+
+func TestAutoBatch_NonCompliantMerchantRetried(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	_, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, 1)
+
+	complianceKeeper.sanctionedAddresses[merchant.String()] = true
+	k.ProcessDueBatches(ctx)
+	batch, _ := k.GetBatch(ctx, settlement.BatchId)
+	require.Equal(t, types.SettlementStatusPending, batch.Status)
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+
+	// The batch is retried once another max age period has passed
+	delete(complianceKeeper.sanctionedAddresses, merchant.String())
+	k.ProcessDueBatches(ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)))
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusPending, batch.Status)
+
+	k.ProcessDueBatches(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	batch, _ = k.GetBatch(ctx, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, batch.Status)
+}
+
+func TestAutoBatch_GenesisRestoresOpenBatch(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(10000000)), time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	_, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	batch, _ := k.GetOpenBatch(ctx, merchant.String())
+
+	k2, ctx2, bankKeeper2, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, k.ExportGenesis(ctx))
+	bankKeeper2.moduleBalances[types.ModuleAccountName] = bankKeeper.moduleBalances[types.ModuleAccountName]
+
+	restored, found := k2.GetOpenBatch(ctx2, merchant.String())
+	require.True(t, found)
+	require.Equal(t, batch.Id, restored.Id)
+
+	k2.ProcessDueBatches(ctx2.WithBlockTime(batch.SettleBy))
+	restored, _ = k2.GetBatch(ctx2, batch.Id)
+	require.Equal(t, types.SettlementStatusCompleted, restored.Status)
+}
+
+func TestAutoBatch_DisabledPaysImmediately(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerBatchingMerchant(t, k, ctx, sdk.NewCoin("ssusd", sdkmath.NewInt(10000000)), 0)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	// Disabling batching pays new payments out directly
+	require.NoError(t, k.UpdateMerchant(ctx, merchant.String(), map[string]interface{}{"batch_enabled": false}))
+	id, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Zero(t, settlement.BatchId)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+}
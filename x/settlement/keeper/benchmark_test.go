@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -21,6 +22,13 @@ import (
 )
 
 func setupBenchmarkSettlementKeeper() (keeper.Keeper, sdk.Context, *mockBankKeeper) {
+	k, ctx, bankKeeper, _ := setupBenchmarkSettlementStore()
+	return k, ctx, bankKeeper
+}
+
+// setupBenchmarkSettlementStore also returns the backing store so benchmarks can
+// commit pre-populated state before measuring
+func setupBenchmarkSettlementStore() (keeper.Keeper, sdk.Context, *mockBankKeeper, store.CommitMultiStore) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -43,6 +51,8 @@ func setupBenchmarkSettlementKeeper() (keeper.Keeper, sdk.Context, *mockBankKeep
 		bankKeeper,
 		complianceKeeper,
 		accountKeeper,
+		nil,
+		nil,
 		authority.String(),
 	)
 
@@ -51,7 +61,7 @@ func setupBenchmarkSettlementKeeper() (keeper.Keeper, sdk.Context, *mockBankKeep
 	// Initialize genesis
 	k.InitGenesis(ctx, types.DefaultGenesis())
 
-	return k, ctx, bankKeeper
+	return k, ctx, bankKeeper, stateStore
 }
 
 func BenchmarkInstantTransfer(b *testing.B) {
@@ -235,3 +245,80 @@ func BenchmarkGetMerchant(b *testing.B) {
 		k.GetMerchant(ctx, merchants[i%1000])
 	}
 }
+
+// The EndBlock processors below are run against stores holding an increasing
+// number of outstanding records, none of them due. Per-block cost should stay
+// flat as the history grows.
+
+var benchmarkHistorySizes = []int{100, 1000, 10000}
+
+func BenchmarkProcessExpiredEscrows(b *testing.B) {
+	for _, size := range benchmarkHistorySizes {
+		b.Run(fmt.Sprintf("escrows=%d", size), func(b *testing.B) {
+			k, ctx, bankKeeper, stateStore := setupBenchmarkSettlementStore()
+
+			sender := newSettlementAddress()
+			recipient := newSettlementAddress()
+			bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1_000_000_000_000))))
+
+			amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+			for i := 0; i < size; i++ {
+				k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 86400)
+			}
+			stateStore.Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.ProcessExpiredEscrows(ctx)
+			}
+		})
+	}
+}
+
+func BenchmarkProcessExpiredChannels(b *testing.B) {
+	for _, size := range benchmarkHistorySizes {
+		b.Run(fmt.Sprintf("channels=%d", size), func(b *testing.B) {
+			k, ctx, bankKeeper, stateStore := setupBenchmarkSettlementStore()
+
+			sender := newSettlementAddress()
+			recipient := newSettlementAddress()
+			bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1_000_000_000_000))))
+
+			deposit := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+			for i := 0; i < size; i++ {
+				k.OpenChannel(ctx, sender.String(), recipient.String(), deposit, 1000)
+			}
+			stateStore.Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.ProcessExpiredChannels(ctx)
+			}
+		})
+	}
+}
+
+func BenchmarkProcessSubscriptions(b *testing.B) {
+	for _, size := range benchmarkHistorySizes {
+		b.Run(fmt.Sprintf("subscriptions=%d", size), func(b *testing.B) {
+			k, ctx, bankKeeper, stateStore := setupBenchmarkSettlementStore()
+
+			payer := newSettlementAddress()
+			merchant := newSettlementAddress()
+			bankKeeper.SetBalance(payer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1_000_000_000_000))))
+
+			amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+			for i := 0; i < size; i++ {
+				k.CreateSubscription(ctx, payer.String(), merchant.String(), amount, time.Hour, 0, 0, "", "")
+			}
+			// Charge the first cycle so nothing is due in the benchmarked blocks
+			k.ProcessSubscriptions(ctx)
+			stateStore.Commit()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				k.ProcessSubscriptions(ctx)
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Bidirectional Channels
+// ============================================================================
+
+// OpenBidirectionalChannel opens a channel between two parties funded by party A.
+// Party B can add its own deposit with FundBidirectionalChannel before any state
+// is submitted. Balances then move between the parties through off-chain states
+// signed by both.
+func (k Keeper) OpenBidirectionalChannel(ctx sdk.Context, partyA, partyB string, deposit sdk.Coin, challengePeriod int64) (uint64, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	partyAAddr, err := sdk.AccAddressFromBech32(partyA)
+	if err != nil {
+		return 0, types.ErrInvalidSettlement
+	}
+	partyBAddr, err := sdk.AccAddressFromBech32(partyB)
+	if err != nil {
+		return 0, types.ErrInvalidRecipient
+	}
+	if partyAAddr.Equals(partyBAddr) {
+		return 0, types.ErrInvalidRecipient.Wrap("channel parties must be different")
+	}
+
+	params := k.GetParams(ctx)
+	if !params.ChannelsEnabled {
+		return 0, errorsmod.Wrap(types.ErrFeatureDisabled, "payment channels are disabled")
+	}
+	if challengePeriod < types.MinChannelChallengePeriod || challengePeriod > types.MaxChannelChallengePeriod {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannelExpiration, "challenge period must be between %d and %d blocks", types.MinChannelChallengePeriod, types.MaxChannelChallengePeriod)
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, partyAAddr); err != nil {
+		return 0, types.ErrComplianceCheckFailed
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, partyBAddr); err != nil {
+		return 0, types.ErrComplianceCheckFailed
+	}
+
+	balance := k.bankKeeper.GetBalance(wrappedCtx, partyAAddr, deposit.Denom)
+	if balance.IsLT(deposit) {
+		return 0, types.ErrInsufficientFunds
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, partyAAddr, types.ModuleAccountName, sdk.NewCoins(deposit)); err != nil {
+		return 0, err
+	}
+
+	zero := sdk.NewCoin(deposit.Denom, sdkmath.ZeroInt())
+	channel := types.BidirectionalChannel{
+		Id:              k.getNextBidirectionalChannelID(ctx),
+		PartyA:          partyA,
+		PartyB:          partyB,
+		DepositA:        deposit,
+		DepositB:        zero,
+		BalanceA:        deposit,
+		BalanceB:        zero,
+		Status:          types.BidirectionalChannelStatusOpen,
+		ChallengePeriod: challengePeriod,
+		OpenedHeight:    ctx.BlockHeight(),
+		OpenedTime:      ctx.BlockTime(),
+	}
+	k.setNextBidirectionalChannelID(ctx, channel.Id+1)
+	k.storeBidirectionalChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBidirectionalChannelOpened,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.Id)),
+			sdk.NewAttribute(types.AttributeKeyPartyA, partyA),
+			sdk.NewAttribute(types.AttributeKeyPartyB, partyB),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+		),
+	)
+
+	return channel.Id, nil
+}
+
+// FundBidirectionalChannel records party B's deposit. It can be made once, while
+// the channel is open and still at its opening state.
+func (k Keeper) FundBidirectionalChannel(ctx sdk.Context, channelId uint64, partyB string, deposit sdk.Coin) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	channel, found := k.GetBidirectionalChannel(ctx, channelId)
+	if !found {
+		return types.ErrChannelNotFound
+	}
+	if channel.Status != types.BidirectionalChannelStatusOpen {
+		return types.ErrChannelClosed
+	}
+	if partyB != channel.PartyB {
+		return types.ErrUnauthorized
+	}
+	if channel.Nonce != 0 || channel.DepositB.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidChannelState, "channel is already funded by party b")
+	}
+	if deposit.Denom != channel.DepositA.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", channel.DepositA.Denom, deposit.Denom)
+	}
+
+	partyBAddr, err := sdk.AccAddressFromBech32(partyB)
+	if err != nil {
+		return types.ErrInvalidRecipient
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, partyBAddr); err != nil {
+		return types.ErrComplianceCheckFailed
+	}
+	balance := k.bankKeeper.GetBalance(wrappedCtx, partyBAddr, deposit.Denom)
+	if balance.IsLT(deposit) {
+		return types.ErrInsufficientFunds
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, partyBAddr, types.ModuleAccountName, sdk.NewCoins(deposit)); err != nil {
+		return err
+	}
+
+	channel.DepositB = deposit
+	channel.BalanceB = deposit
+	k.storeBidirectionalChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBidirectionalChannelFunded,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channelId)),
+			sdk.NewAttribute(types.AttributeKeyPartyB, partyB),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+		),
+	)
+
+	return nil
+}
+
+// CooperativeCloseChannel settles the channel immediately at a final state both
+// parties signed for closing. It can also end a unilateral close early.
+func (k Keeper) CooperativeCloseChannel(ctx sdk.Context, signer string, state types.ChannelState) error {
+	channel, found := k.GetBidirectionalChannel(ctx, state.ChannelId)
+	if !found {
+		return types.ErrChannelNotFound
+	}
+	if channel.Status == types.BidirectionalChannelStatusClosed {
+		return types.ErrChannelClosed
+	}
+	if signer != channel.PartyA && signer != channel.PartyB {
+		return types.ErrUnauthorized
+	}
+	if state.Nonce < channel.Nonce {
+		return types.ErrInvalidNonce
+	}
+	if err := validateChannelBalances(channel, state); err != nil {
+		return err
+	}
+	if err := k.verifyChannelStateSignatures(ctx, channel, state.CloseSignBytes(), state); err != nil {
+		return err
+	}
+	if err := k.assertChannelPartiesCompliant(ctx, channel); err != nil {
+		return err
+	}
+
+	channel.BalanceA = state.BalanceA
+	channel.BalanceB = state.BalanceB
+	channel.Nonce = state.Nonce
+	return k.settleBidirectionalChannel(ctx, channel)
+}
+
+// InitiateChannelClose starts a unilateral close at the submitted state. The
+// channel settles once its challenge period has passed unless a newer state is
+// submitted in the meantime. The opening state (nonce zero) needs no signatures.
+func (k Keeper) InitiateChannelClose(ctx sdk.Context, signer string, state types.ChannelState) (int64, error) {
+	channel, found := k.GetBidirectionalChannel(ctx, state.ChannelId)
+	if !found {
+		return 0, types.ErrChannelNotFound
+	}
+	if channel.Status != types.BidirectionalChannelStatusOpen {
+		return 0, types.ErrChannelClosed
+	}
+	if signer != channel.PartyA && signer != channel.PartyB {
+		return 0, types.ErrUnauthorized
+	}
+	if state.Nonce < channel.Nonce {
+		return 0, types.ErrInvalidNonce
+	}
+	if err := validateChannelBalances(channel, state); err != nil {
+		return 0, err
+	}
+	if state.Nonce == 0 {
+		if !state.BalanceA.IsEqual(channel.DepositA) || !state.BalanceB.IsEqual(channel.DepositB) {
+			return 0, errorsmod.Wrap(types.ErrInvalidChannelState, "unsigned state must match the deposits")
+		}
+	} else if err := k.verifyChannelStateSignatures(ctx, channel, state.SignBytes(), state); err != nil {
+		return 0, err
+	}
+	if err := k.assertChannelPartiesCompliant(ctx, channel); err != nil {
+		return 0, err
+	}
+
+	channel.BalanceA = state.BalanceA
+	channel.BalanceB = state.BalanceB
+	channel.Nonce = state.Nonce
+	channel.Status = types.BidirectionalChannelStatusClosing
+	channel.ClosingParty = signer
+	channel.ChallengeEndsHeight = ctx.BlockHeight() + channel.ChallengePeriod
+	k.storeBidirectionalChannel(ctx, channel)
+	k.enqueueChannelChallenge(ctx, channel.ChallengeEndsHeight, channel.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelCloseInitiated,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.Id)),
+			sdk.NewAttribute(types.AttributeKeyParty, signer),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", channel.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyChallengeEnds, fmt.Sprintf("%d", channel.ChallengeEndsHeight)),
+		),
+	)
+
+	return channel.ChallengeEndsHeight, nil
+}
+
+// ChallengeChannelClose replaces the state of a closing channel with a newer one
+// signed by both parties. The challenge period is not extended.
+func (k Keeper) ChallengeChannelClose(ctx sdk.Context, signer string, state types.ChannelState) error {
+	channel, found := k.GetBidirectionalChannel(ctx, state.ChannelId)
+	if !found {
+		return types.ErrChannelNotFound
+	}
+	if channel.Status != types.BidirectionalChannelStatusClosing {
+		return types.ErrChannelNotClosing
+	}
+	if ctx.BlockHeight() >= channel.ChallengeEndsHeight {
+		return types.ErrChallengePeriodElapsed
+	}
+	if signer != channel.PartyA && signer != channel.PartyB {
+		return types.ErrUnauthorized
+	}
+	if state.Nonce <= channel.Nonce {
+		return types.ErrInvalidNonce
+	}
+	if err := validateChannelBalances(channel, state); err != nil {
+		return err
+	}
+	if err := k.verifyChannelStateSignatures(ctx, channel, state.SignBytes(), state); err != nil {
+		return err
+	}
+
+	channel.BalanceA = state.BalanceA
+	channel.BalanceB = state.BalanceB
+	channel.Nonce = state.Nonce
+	k.storeBidirectionalChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelChallenged,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.Id)),
+			sdk.NewAttribute(types.AttributeKeyParty, signer),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", channel.Nonce)),
+		),
+	)
+
+	return nil
+}
+
+// ProcessChannelChallenges settles closing channels whose challenge period has
+// ended at the latest state submitted
+func (k Keeper) ProcessChannelChallenges(ctx sdk.Context) {
+	for _, id := range k.dequeueDue(ctx, types.ChannelChallengeQueuePrefix, mustWriteUint64(uint64(ctx.BlockHeight()))) {
+		channel, found := k.GetBidirectionalChannel(ctx, id)
+		if !found || channel.Status != types.BidirectionalChannelStatusClosing || ctx.BlockHeight() < channel.ChallengeEndsHeight {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleBidirectionalChannel(cacheCtx, channel); err != nil {
+			ctx.Logger().Error("failed to settle bidirectional channel", "channel_id", channel.Id, "error", err)
+			// Retry in the next block
+			k.enqueueChannelChallenge(ctx, ctx.BlockHeight(), channel.Id)
+			continue
+		}
+		write()
+	}
+}
+
+// settleBidirectionalChannel pays out the channel balances and closes it
+func (k Keeper) settleBidirectionalChannel(ctx sdk.Context, channel types.BidirectionalChannel) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	payouts := []struct {
+		party  string
+		amount sdk.Coin
+	}{
+		{channel.PartyA, channel.BalanceA},
+		{channel.PartyB, channel.BalanceB},
+	}
+	for _, payout := range payouts {
+		if !payout.amount.IsPositive() {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(payout.party)
+		if err != nil {
+			return types.ErrInvalidRecipient
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, addr, sdk.NewCoins(payout.amount)); err != nil {
+			return err
+		}
+	}
+
+	channel.Status = types.BidirectionalChannelStatusClosed
+	channel.ClosedHeight = ctx.BlockHeight()
+	channel.ClosedTime = ctx.BlockTime()
+	k.storeBidirectionalChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBidirectionalChannelSettled,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.Id)),
+			sdk.NewAttribute(types.AttributeKeyBalanceA, channel.BalanceA.String()),
+			sdk.NewAttribute(types.AttributeKeyBalanceB, channel.BalanceB.String()),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprintf("%d", channel.Nonce)),
+		),
+	)
+
+	return nil
+}
+
+// validateChannelBalances checks that a state distributes exactly the deposits
+func validateChannelBalances(channel types.BidirectionalChannel, state types.ChannelState) error {
+	if state.BalanceA.Denom != channel.DepositA.Denom || state.BalanceB.Denom != channel.DepositA.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", channel.DepositA.Denom)
+	}
+	if !state.BalanceA.Add(state.BalanceB).IsEqual(channel.DepositA.Add(channel.DepositB)) {
+		return errorsmod.Wrap(types.ErrInvalidChannelState, "balances must add up to the channel deposits")
+	}
+	return nil
+}
+
+// verifyChannelStateSignatures checks that both parties signed msg
+func (k Keeper) verifyChannelStateSignatures(ctx sdk.Context, channel types.BidirectionalChannel, msg []byte, state types.ChannelState) error {
+	if err := k.verifyPartySignature(ctx, channel.PartyA, msg, state.SignatureA); err != nil {
+		return errorsmod.Wrap(err, "party a")
+	}
+	if err := k.verifyPartySignature(ctx, channel.PartyB, msg, state.SignatureB); err != nil {
+		return errorsmod.Wrap(err, "party b")
+	}
+	return nil
+}
+
+// verifyPartySignature verifies a hex encoded signature of msg against the
+// party's on-chain public key
+func (k Keeper) verifyPartySignature(ctx sdk.Context, party string, msg []byte, signature string) error {
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil || len(sigBytes) < 64 {
+		return types.ErrInvalidSignature
+	}
+
+	addr, err := sdk.AccAddressFromBech32(party)
+	if err != nil {
+		return types.ErrInvalidSettlement
+	}
+
+	if k.accountKeeper != nil {
+		pubKey, err := k.accountKeeper.GetPubKey(sdk.WrapSDKContext(ctx), addr)
+		if err == nil && pubKey != nil {
+			if !pubKey.VerifySignature(msg, sigBytes[:64]) {
+				return types.ErrSignatureVerificationFailed
+			}
+			return nil
+		}
+	}
+
+	return types.ErrInvalidSignature
+}
+
+func (k Keeper) assertChannelPartiesCompliant(ctx sdk.Context, channel types.BidirectionalChannel) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	for _, party := range []string{channel.PartyA, channel.PartyB} {
+		addr, err := sdk.AccAddressFromBech32(party)
+		if err != nil {
+			return types.ErrInvalidRecipient
+		}
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, addr); err != nil {
+			return types.ErrComplianceCheckFailed
+		}
+	}
+	return nil
+}
+
+func (k Keeper) enqueueChannelChallenge(ctx sdk.Context, height int64, channelId uint64) {
+	k.enqueue(ctx, types.ChannelChallengeQueuePrefix, queueHeightKey(height, channelId), channelId)
+}
+
+func (k Keeper) getNextBidirectionalChannelID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBidirectionalChannelIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextBidirectionalChannelID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextBidirectionalChannelIDKey, bz)
+}
+
+func (k Keeper) storeBidirectionalChannel(ctx sdk.Context, channel types.BidirectionalChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidirectionalChannelKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&channel)
+	store.Set(mustWriteUint64(channel.Id), bz)
+}
+
+// GetBidirectionalChannel retrieves a bidirectional channel by ID
+func (k Keeper) GetBidirectionalChannel(ctx sdk.Context, id uint64) (types.BidirectionalChannel, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidirectionalChannelKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.BidirectionalChannel{}, false
+	}
+	var channel types.BidirectionalChannel
+	types.ModuleCdc.MustUnmarshalJSON(bz, &channel)
+	return channel, true
+}
+
+// IterateBidirectionalChannels iterates over all bidirectional channels
+func (k Keeper) IterateBidirectionalChannels(ctx sdk.Context, cb func(types.BidirectionalChannel) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidirectionalChannelKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.BidirectionalChannel
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &channel)
+		if cb(channel) {
+			break
+		}
+	}
+}
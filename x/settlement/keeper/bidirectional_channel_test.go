@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// openBidirectionalChannel opens a channel with 600000 from party A and 400000
+// from party B and a challenge period of 20 blocks
+func openBidirectionalChannel(t *testing.T, k keeper.Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper, accountKeeper *mockAccountKeeper) (uint64, *secp256k1.PrivKey, *secp256k1.PrivKey, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	keyA, partyA := newSettlementKeyPair()
+	keyB, partyB := newSettlementKeyPair()
+	accountKeeper.SetPubKey(partyA, keyA.PubKey())
+	accountKeeper.SetPubKey(partyB, keyB.PubKey())
+	bankKeeper.SetBalance(partyA.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(600000))))
+	bankKeeper.SetBalance(partyB.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(400000))))
+
+	id, err := k.OpenBidirectionalChannel(ctx, partyA.String(), partyB.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(600000)), 20)
+	require.NoError(t, err)
+	require.NoError(t, k.FundBidirectionalChannel(ctx, id, partyB.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(400000))))
+	return id, keyA, keyB, partyA, partyB
+}
+
+// signChannelState returns a channel state signed by both parties over
+// signBytes, which selects a regular or a cooperative close state
+func signChannelState(keyA, keyB *secp256k1.PrivKey, channelId uint64, balanceA, balanceB int64, nonce uint64, signBytes func(types.ChannelState) []byte) types.ChannelState {
+	state := types.ChannelState{
+		ChannelId: channelId,
+		BalanceA:  sdk.NewCoin("ssusd", sdkmath.NewInt(balanceA)),
+		BalanceB:  sdk.NewCoin("ssusd", sdkmath.NewInt(balanceB)),
+		Nonce:     nonce,
+	}
+	sigA, _ := keyA.Sign(signBytes(state))
+	sigB, _ := keyB.Sign(signBytes(state))
+	state.SignatureA = hex.EncodeToString(sigA)
+	state.SignatureB = hex.EncodeToString(sigB)
+	return state
+}
+
+func TestOpenAndFundBidirectionalChannel(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+	id, _, _, partyA, partyB := openBidirectionalChannel(t, k, ctx, bankKeeper, accountKeeper)
+
+	channel, found := k.GetBidirectionalChannel(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.BidirectionalChannelStatusOpen, channel.Status)
+	require.Equal(t, sdkmath.NewInt(600000), channel.BalanceA.Amount)
+	require.Equal(t, sdkmath.NewInt(400000), channel.BalanceB.Amount)
+
+	// Party B funds only once
+	err := k.FundBidirectionalChannel(ctx, id, partyB.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrInvalidChannelState)
+
+	// Only party B can fund
+	err = k.FundBidirectionalChannel(ctx, id, partyA.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestCooperativeCloseChannel(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+	id, keyA, keyB, partyA, partyB := openBidirectionalChannel(t, k, ctx, bankKeeper, accountKeeper)
+
+	// A regular state cannot be used to close cooperatively
+	err := k.CooperativeCloseChannel(ctx, partyA.String(), signChannelState(keyA, keyB, id, 250000, 750000, 7, types.ChannelState.SignBytes))
+	require.ErrorIs(t, err, types.ErrSignatureVerificationFailed)
+
+	require.NoError(t, k.CooperativeCloseChannel(ctx, partyA.String(), signChannelState(keyA, keyB, id, 250000, 750000, 7, types.ChannelState.CloseSignBytes)))
+
+	channel, _ := k.GetBidirectionalChannel(ctx, id)
+	require.Equal(t, types.BidirectionalChannelStatusClosed, channel.Status)
+	require.Equal(t, sdkmath.NewInt(250000), bankKeeper.GetBalance(ctx, partyA, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(750000), bankKeeper.GetBalance(ctx, partyB, "ssusd").Amount)
+}
+
+func TestUnilateralCloseWithChallenge(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+	id, keyA, keyB, partyA, partyB := openBidirectionalChannel(t, k, ctx, bankKeeper, accountKeeper)
+
+	// Party A closes at a stale state that favors them
+	challengeEnds, err := k.InitiateChannelClose(ctx, partyA.String(), signChannelState(keyA, keyB, id, 900000, 100000, 3, types.ChannelState.SignBytes))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+20, challengeEnds)
+
+	// Party B answers with the latest state
+	challengeCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.NoError(t, k.ChallengeChannelClose(challengeCtx, partyB.String(), signChannelState(keyA, keyB, id, 300000, 700000, 5, types.ChannelState.SignBytes)))
+
+	// Older states are rejected
+	err = k.ChallengeChannelClose(challengeCtx, partyA.String(), signChannelState(keyA, keyB, id, 900000, 100000, 4, types.ChannelState.SignBytes))
+	require.ErrorIs(t, err, types.ErrInvalidNonce)
+
+	// Nothing settles before the challenge period ends
+	k.ProcessChannelChallenges(ctx.WithBlockHeight(challengeEnds - 1))
+	channel, _ := k.GetBidirectionalChannel(ctx, id)
+	require.Equal(t, types.BidirectionalChannelStatusClosing, channel.Status)
+
+	endCtx := ctx.WithBlockHeight(challengeEnds)
+	err = k.ChallengeChannelClose(endCtx, partyA.String(), signChannelState(keyA, keyB, id, 1000000, 0, 6, types.ChannelState.SignBytes))
+	require.ErrorIs(t, err, types.ErrChallengePeriodElapsed)
+
+	k.ProcessChannelChallenges(endCtx)
+	channel, _ = k.GetBidirectionalChannel(ctx, id)
+	require.Equal(t, types.BidirectionalChannelStatusClosed, channel.Status)
+	require.Equal(t, uint64(5), channel.Nonce)
+	require.Equal(t, sdkmath.NewInt(300000), bankKeeper.GetBalance(ctx, partyA, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(700000), bankKeeper.GetBalance(ctx, partyB, "ssusd").Amount)
+}
+
+func TestInitiateChannelClose_Invalid(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+	id, keyA, keyB, partyA, partyB := openBidirectionalChannel(t, k, ctx, bankKeeper, accountKeeper)
+
+	// Balances must add up to the deposits
+	_, err := k.InitiateChannelClose(ctx, partyA.String(), signChannelState(keyA, keyB, id, 900000, 200000, 1, types.ChannelState.SignBytes))
+	require.ErrorIs(t, err, types.ErrInvalidChannelState)
+
+	// Both parties must have signed
+	state := signChannelState(keyA, keyB, id, 500000, 500000, 1, types.ChannelState.SignBytes)
+	state.SignatureB = state.SignatureA
+	_, err = k.InitiateChannelClose(ctx, partyA.String(), state)
+	require.ErrorIs(t, err, types.ErrSignatureVerificationFailed)
+
+	// Outsiders cannot close
+	_, err = k.InitiateChannelClose(ctx, newSettlementAddress().String(), signChannelState(keyA, keyB, id, 500000, 500000, 1, types.ChannelState.SignBytes))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// The unsigned opening state must match the deposits
+	unsigned := types.ChannelState{
+		ChannelId: id,
+		BalanceA:  sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)),
+		BalanceB:  sdk.NewCoin("ssusd", sdkmath.ZeroInt()),
+	}
+	_, err = k.InitiateChannelClose(ctx, partyA.String(), unsigned)
+	require.ErrorIs(t, err, types.ErrInvalidChannelState)
+
+	unsigned.BalanceA = sdk.NewCoin("ssusd", sdkmath.NewInt(600000))
+	unsigned.BalanceB = sdk.NewCoin("ssusd", sdkmath.NewInt(400000))
+	_, err = k.InitiateChannelClose(ctx, partyB.String(), unsigned)
+	require.NoError(t, err)
+}
+
+func TestBidirectionalChannelGenesis(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+	id, keyA, keyB, partyA, partyB := openBidirectionalChannel(t, k, ctx, bankKeeper, accountKeeper)
+	challengeEnds, err := k.InitiateChannelClose(ctx, partyA.String(), signChannelState(keyA, keyB, id, 100000, 900000, 2, types.ChannelState.SignBytes))
+	require.NoError(t, err)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.BidirectionalChannels, 1)
+
+	k2, ctx2, bankKeeper2, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	// The challenge queue is rebuilt so the channel still settles
+	k2.ProcessChannelChallenges(ctx2.WithBlockHeight(challengeEnds))
+	channel, _ := k2.GetBidirectionalChannel(ctx2, id)
+	require.Equal(t, types.BidirectionalChannelStatusClosed, channel.Status)
+	require.Equal(t, sdkmath.NewInt(900000), bankKeeper2.GetBalance(ctx2, partyB, "ssusd").Amount)
+}
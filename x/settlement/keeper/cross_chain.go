@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	settlementibc "github.com/stateset/core/x/settlement/ibc"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+var _ settlementibc.SettlementKeeper = Keeper{}
+
+// ============================================================================
+// Cross-Chain Settlement (IBC)
+// ============================================================================
+
+// ProcessCrossChainSettlement settles funds that arrived through an ICS-20 transfer.
+// The transfer module has already credited sender (the local account named as the
+// packet receiver); the received amount is then settled to recipient like an
+// instant transfer, with fees and compliance checks applied.
+func (k Keeper) ProcessCrossChainSettlement(ctx sdk.Context, sender, recipient string, amount sdk.Coins, sourceChannel, destChannel string) error {
+	if len(amount) != 1 {
+		return errorsmod.Wrap(types.ErrInvalidAmount, "cross-chain settlement requires exactly one coin")
+	}
+	coin := amount[0]
+	if coin.Denom != types.StablecoinDenom {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", types.StablecoinDenom, coin.Denom)
+	}
+
+	metadata := fmt.Sprintf("ibc:%s/%s", sourceChannel, destChannel)
+	_, err := k.instantTransfer(ctx, types.SettlementTypeCrossChain, sender, recipient, coin, "", metadata, nil)
+	return err
+}
+
+// CreateCrossChainEscrow escrows funds that arrived through an ICS-20 transfer.
+// The packet receiver, already credited by the transfer module, funds an escrow
+// payable to memo.Receiver; it is also the account refunded if the escrow expires
+// or is refunded. The escrow ID is the decimal settlement ID.
+func (k Keeper) CreateCrossChainEscrow(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, amount sdk.Coins, memo settlementibc.CrossChainSettlementMemo) (settlementibc.CrossChainEscrow, error) {
+	if len(amount) != 1 {
+		return settlementibc.CrossChainEscrow{}, errorsmod.Wrap(types.ErrInvalidAmount, "cross-chain escrow requires exactly one coin")
+	}
+	coin := amount[0]
+	if coin.Denom != types.StablecoinDenom {
+		return settlementibc.CrossChainEscrow{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", types.StablecoinDenom, coin.Denom)
+	}
+
+	settlementID, err := k.CreateEscrow(ctx, data.Receiver, memo.Receiver, coin, "", memo.Conditions, memo.ExpirationSeconds)
+	if err != nil {
+		return settlementibc.CrossChainEscrow{}, err
+	}
+	settlement, _ := k.GetSettlement(ctx, settlementID)
+
+	escrow := settlementibc.CrossChainEscrow{
+		ID:            strconv.FormatUint(settlementID, 10),
+		SettlementID:  settlementID,
+		Sender:        data.Sender,
+		Receiver:      memo.Receiver,
+		Amount:        amount,
+		DestChain:     ctx.ChainID(),
+		SourceChannel: packet.SourceChannel,
+		DestChannel:   packet.DestinationChannel,
+		Sequence:      packet.Sequence,
+		Conditions:    memo.Conditions,
+		Status:        settlementibc.EscrowStatusActive,
+		CreatedAt:     ctx.BlockTime().Unix(),
+		ExpiresAt:     settlement.ExpiresAt.Unix(),
+	}
+	k.SetCrossChainEscrow(ctx, escrow)
+	return escrow, nil
+}
+
+// SetCrossChainEscrow stores a cross-chain escrow record
+func (k Keeper) SetCrossChainEscrow(ctx sdk.Context, escrow settlementibc.CrossChainEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CrossChainEscrowKeyPrefix)
+	bz, err := json.Marshal(escrow)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal cross-chain escrow %s: %v", escrow.ID, err))
+	}
+	store.Set([]byte(escrow.ID), bz)
+}
+
+// GetCrossChainEscrow retrieves a cross-chain escrow by ID
+func (k Keeper) GetCrossChainEscrow(ctx sdk.Context, escrowID string) (settlementibc.CrossChainEscrow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CrossChainEscrowKeyPrefix)
+	bz := store.Get([]byte(escrowID))
+	if len(bz) == 0 {
+		return settlementibc.CrossChainEscrow{}, false
+	}
+	var escrow settlementibc.CrossChainEscrow
+	if err := json.Unmarshal(bz, &escrow); err != nil {
+		panic(fmt.Sprintf("failed to unmarshal cross-chain escrow %s: %v", escrowID, err))
+	}
+	return escrow, true
+}
+
+// IterateCrossChainEscrows iterates over all cross-chain escrows
+func (k Keeper) IterateCrossChainEscrows(ctx sdk.Context, cb func(settlementibc.CrossChainEscrow) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CrossChainEscrowKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow settlementibc.CrossChainEscrow
+		if err := json.Unmarshal(iterator.Value(), &escrow); err != nil {
+			panic(fmt.Sprintf("failed to unmarshal cross-chain escrow: %v", err))
+		}
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// CompleteCrossChainEscrow releases the escrow settlement backing a cross-chain escrow.
+// The release packet must be sent by the escrow's sender over the channel that
+// funded the escrow.
+func (k Keeper) CompleteCrossChainEscrow(ctx sdk.Context, escrowID string, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	escrow, err := k.activeCrossChainEscrow(ctx, escrowID)
+	if err != nil {
+		return err
+	}
+	if packet.DestinationChannel != escrow.DestChannel {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "escrow %s can only be released over %s", escrowID, escrow.DestChannel)
+	}
+	if data.Sender != escrow.Sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "escrow %s can only be released by its sender", escrowID)
+	}
+
+	settlement, found := k.GetSettlement(ctx, escrow.SettlementID)
+	if !found {
+		return types.ErrSettlementNotFound
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(settlement.Sender)
+	if err != nil {
+		return types.ErrInvalidSettlement
+	}
+
+	if err := k.ReleaseEscrow(ctx, escrow.SettlementID, senderAddr); err != nil {
+		return err
+	}
+
+	escrow.Status = settlementibc.EscrowStatusCompleted
+	k.SetCrossChainEscrow(ctx, escrow)
+	return nil
+}
+
+// expireCrossChainEscrow marks the cross-chain escrow backed by an expired escrow
+// settlement as refunded, so it can no longer be released or refunded over IBC
+func (k Keeper) expireCrossChainEscrow(ctx sdk.Context, settlementID uint64) {
+	escrow, found := k.GetCrossChainEscrow(ctx, strconv.FormatUint(settlementID, 10))
+	if !found || escrow.SettlementID != settlementID || escrow.Status != settlementibc.EscrowStatusActive {
+		return
+	}
+	escrow.Status = settlementibc.EscrowStatusRefunded
+	k.SetCrossChainEscrow(ctx, escrow)
+}
+
+func (k Keeper) activeCrossChainEscrow(ctx sdk.Context, escrowID string) (settlementibc.CrossChainEscrow, error) {
+	escrow, found := k.GetCrossChainEscrow(ctx, escrowID)
+	if !found {
+		return settlementibc.CrossChainEscrow{}, errorsmod.Wrapf(types.ErrSettlementNotFound, "cross-chain escrow %s", escrowID)
+	}
+	if escrow.Status != settlementibc.EscrowStatusActive {
+		return settlementibc.CrossChainEscrow{}, errorsmod.Wrapf(types.ErrInvalidSettlement, "cross-chain escrow %s is %s", escrowID, escrow.Status)
+	}
+	return escrow, nil
+}
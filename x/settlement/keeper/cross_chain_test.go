@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/ibc"
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+const (
+	testCounterpartyChannel = "channel-7"
+	testLocalChannel        = "channel-0"
+)
+
+// newTransferStack wraps a mock transfer application with the settlement middleware.
+// The mock credits the packet receiver the way ICS-20 would for a returning token.
+func newTransferStack(k keeper.Keeper, bankKeeper *mockBankKeeper) ibc.IBCMiddleware {
+	app := &ibcmock.IBCApp{
+		OnRecvPacket: func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+			var data transfertypes.FungibleTokenPacketData
+			if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+			amount, _ := sdkmath.NewIntFromString(data.Amount)
+			coin := sdk.NewCoin(ibc.ReceivedDenom(packet, data.Denom), amount)
+			bankKeeper.SetBalance(data.Receiver, bankKeeper.balances[data.Receiver].Add(coin))
+			return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		},
+	}
+	transferApp := ibcmock.NewIBCModule(&ibcmock.AppModule{}, app)
+	return ibc.NewIBCMiddleware(transferApp, nil, ibc.NewIBCHooks(k))
+}
+
+func newTransferPacket(sender, receiver string, amount sdkmath.Int, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(
+		fmt.Sprintf("%s/%s/%s", transfertypes.PortID, testCounterpartyChannel, types.StablecoinDenom),
+		amount.String(),
+		sender,
+		receiver,
+		memo,
+	)
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      testCounterpartyChannel,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: testLocalChannel,
+		Data:               data.GetBytes(),
+	}
+}
+
+func TestReceivedDenom(t *testing.T) {
+	packet := newTransferPacket("", "", sdkmath.NewInt(1), "")
+
+	// Returning native token
+	require.Equal(t, types.StablecoinDenom, ibc.ReceivedDenom(packet, "transfer/channel-7/ssusd"))
+
+	// Foreign token becomes a voucher on the destination channel
+	expected := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	require.Equal(t, expected, ibc.ReceivedDenom(packet, "uatom"))
+}
+
+func TestCrossChainSettlement(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdkmath.NewInt(1_000_000)
+
+	packet := newTransferPacket("remote1sender", payer.String(), amount, ibc.CreateSettlementMemo(merchant.String()))
+	ack := stack.OnRecvPacket(ctx, packet, nil)
+	require.True(t, ack.Success())
+
+	var settlement types.Settlement
+	k.IterateSettlements(ctx, func(s types.Settlement) bool {
+		settlement = s
+		return true
+	})
+	require.Equal(t, types.SettlementTypeCrossChain, settlement.Type)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, payer.String(), settlement.Sender)
+	require.Equal(t, merchant.String(), settlement.Recipient)
+	require.Equal(t, "ibc:channel-7/channel-0", settlement.Metadata)
+
+	// Merchant received the net amount, payer holds nothing
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, types.StablecoinDenom).Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, payer, types.StablecoinDenom).IsZero())
+}
+
+func TestCrossChainSettlement_ErrorAck(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdkmath.NewInt(1_000_000)
+
+	// Missing memo receiver
+	packet := newTransferPacket("remote1sender", payer.String(), amount, `{"type":"settlement"}`)
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	// Sanctioned merchant
+	complianceKeeper.SetSanctioned(merchant.String(), true)
+	packet = newTransferPacket("remote1sender", payer.String(), amount, ibc.CreateSettlementMemo(merchant.String()))
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	// Transfers without a settlement memo pass through untouched
+	packet = newTransferPacket("remote1sender", payer.String(), amount, "")
+	require.True(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+}
+
+func TestCrossChainSettlement_WrongDenom(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+
+	err := k.ProcessCrossChainSettlement(ctx, newSettlementAddress().String(), newSettlementAddress().String(),
+		sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1_000_000))), testCounterpartyChannel, testLocalChannel)
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
+
+// seedCrossChainEscrow creates an escrow settlement and registers it as a cross-chain escrow
+func seedCrossChainEscrow(t *testing.T, k keeper.Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper) (ibc.CrossChainEscrow, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	sender := newSettlementAddress()
+	recipient := newSettlementAddress()
+	amount := sdk.NewCoin(types.StablecoinDenom, sdkmath.NewInt(1_000_000))
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(amount))
+
+	id, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 3600)
+	require.NoError(t, err)
+
+	escrow := ibc.CrossChainEscrow{
+		ID:            fmt.Sprintf("%d", id),
+		SettlementID:  id,
+		Sender:        "remote1sender",
+		Receiver:      recipient.String(),
+		Amount:        sdk.NewCoins(amount),
+		SourceChannel: testCounterpartyChannel,
+		DestChannel:   testLocalChannel,
+		Sequence:      1,
+		Status:        ibc.EscrowStatusActive,
+	}
+	k.SetCrossChainEscrow(ctx, escrow)
+	return escrow, sender, recipient
+}
+
+func TestCrossChainEscrowRelease(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+	escrow, _, recipient := seedCrossChainEscrow(t, k, ctx, bankKeeper)
+
+	packet := newTransferPacket("remote1sender", newSettlementAddress().String(), sdkmath.NewInt(1000), ibc.CreateEscrowReleaseMemo(escrow.ID))
+	require.True(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	stored, found := k.GetCrossChainEscrow(ctx, escrow.ID)
+	require.True(t, found)
+	require.Equal(t, ibc.EscrowStatusCompleted, stored.Status)
+
+	settlement, found := k.GetSettlement(ctx, escrow.SettlementID)
+	require.True(t, found)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, recipient, types.StablecoinDenom).Amount)
+
+	// A second release is rejected
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+}
+
+func TestCrossChainEscrowRelease_WrongChannel(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	escrow, _, _ := seedCrossChainEscrow(t, k, ctx, bankKeeper)
+
+	packet := channeltypes.Packet{DestinationChannel: "channel-99"}
+	data := transfertypes.FungibleTokenPacketData{Sender: escrow.Sender}
+	err := k.CompleteCrossChainEscrow(ctx, escrow.ID, packet, data)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestCrossChainEscrowRelease_WrongSender(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+	escrow, _, recipient := seedCrossChainEscrow(t, k, ctx, bankKeeper)
+
+	// Anyone else sending over the escrow's channel cannot release it
+	packet := newTransferPacket("remote1attacker", newSettlementAddress().String(), sdkmath.NewInt(1000), ibc.CreateEscrowReleaseMemo(escrow.ID))
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	stored, _ := k.GetCrossChainEscrow(ctx, escrow.ID)
+	require.Equal(t, ibc.EscrowStatusActive, stored.Status)
+	settlement, _ := k.GetSettlement(ctx, escrow.SettlementID)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.True(t, bankKeeper.GetBalance(ctx, recipient, types.StablecoinDenom).IsZero())
+}
+
+func TestCrossChainEscrowCreate(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdkmath.NewInt(1_000_000)
+
+	memo := ibc.CreateEscrowCreateMemo(merchant.String(), "delivery confirmed", 600)
+	packet := newTransferPacket("remote1sender", payer.String(), amount, memo)
+	ack := stack.OnRecvPacket(ctx, packet, nil)
+	require.True(t, ack.Success())
+
+	// The escrow ID is returned in the acknowledgement
+	var result channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &result))
+	var escrowAck ibc.EscrowCreateAcknowledgement
+	require.NoError(t, json.Unmarshal(result.GetResult(), &escrowAck))
+	require.NotEmpty(t, escrowAck.EscrowID)
+
+	escrow, found := k.GetCrossChainEscrow(ctx, escrowAck.EscrowID)
+	require.True(t, found)
+	require.Equal(t, ibc.EscrowStatusActive, escrow.Status)
+	require.Equal(t, "remote1sender", escrow.Sender)
+	require.Equal(t, merchant.String(), escrow.Receiver)
+	require.Equal(t, "delivery confirmed", escrow.Conditions)
+	require.Equal(t, testLocalChannel, escrow.DestChannel)
+	require.Equal(t, ctx.BlockTime().Unix()+600, escrow.ExpiresAt)
+
+	settlement, found := k.GetSettlement(ctx, escrow.SettlementID)
+	require.True(t, found)
+	require.Equal(t, types.SettlementTypeEscrow, settlement.Type)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Equal(t, payer.String(), settlement.Sender)
+	require.Equal(t, merchant.String(), settlement.Recipient)
+	require.True(t, bankKeeper.GetBalance(ctx, payer, types.StablecoinDenom).IsZero())
+
+	// The source chain can now release it
+	release := newTransferPacket("remote1sender", payer.String(), sdkmath.NewInt(1000), ibc.CreateEscrowReleaseMemo(escrowAck.EscrowID))
+	require.True(t, stack.OnRecvPacket(ctx, release, nil).Success())
+	settlement, _ = k.GetSettlement(ctx, escrow.SettlementID)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, types.StablecoinDenom).Amount)
+}
+
+func TestCrossChainEscrowCreate_Invalid(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdkmath.NewInt(1_000_000)
+
+	// Missing receiver
+	packet := newTransferPacket("remote1sender", payer.String(), amount, ibc.CreateEscrowCreateMemo("", "", 0))
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	// Expiration beyond the module maximum
+	params := k.GetParams(ctx)
+	memo := ibc.CreateEscrowCreateMemo(merchant.String(), "", params.MaxEscrowExpiration+1)
+	packet = newTransferPacket("remote1sender", payer.String(), amount, memo)
+	require.False(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+}
+
+func TestCrossChainEscrowCreate_Expired(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	stack := newTransferStack(k, bankKeeper)
+
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+
+	memo := ibc.CreateEscrowCreateMemo(merchant.String(), "", 60)
+	packet := newTransferPacket("remote1sender", payer.String(), sdkmath.NewInt(1_000_000), memo)
+	require.True(t, stack.OnRecvPacket(ctx, packet, nil).Success())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	k.ProcessExpiredEscrows(ctx)
+
+	escrow, found := k.GetCrossChainEscrow(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, ibc.EscrowStatusRefunded, escrow.Status)
+	require.Equal(t, sdkmath.NewInt(1_000_000), bankKeeper.GetBalance(ctx, payer, types.StablecoinDenom).Amount)
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Multi-Party Escrow
+// ============================================================================
+
+// CreateMultiPartyEscrow creates an escrow settlement governed by arbiters. Any
+// threshold of {sender, recipient, arbiters} approving the same resolution can
+// release, refund or split the escrow through ApproveEscrowResolution.
+func (k Keeper) CreateMultiPartyEscrow(ctx sdk.Context, sender, recipient string, amount sdk.Coin, arbiters []string, threshold uint32, reference, metadata string, expirationSeconds int64) (uint64, error) {
+	if err := types.ValidateArbiters(sender, recipient, arbiters, threshold); err != nil {
+		return 0, err
+	}
+
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	for _, arbiter := range arbiters {
+		arbiterAddr, err := sdk.AccAddressFromBech32(arbiter)
+		if err != nil {
+			return 0, types.ErrInvalidArbitration
+		}
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, arbiterAddr); err != nil {
+			return 0, types.ErrComplianceCheckFailed
+		}
+	}
+
+	settlementId, err := k.CreateEscrow(ctx, sender, recipient, amount, reference, metadata, expirationSeconds)
+	if err != nil {
+		return 0, err
+	}
+
+	k.storeEscrowArbitration(ctx, types.EscrowArbitration{
+		SettlementId: settlementId,
+		Arbiters:     arbiters,
+		Threshold:    threshold,
+		Approvals:    []types.EscrowApproval{},
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMultiPartyEscrow,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyArbiters, strings.Join(arbiters, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", threshold)),
+		),
+	)
+
+	return settlementId, nil
+}
+
+// ApproveEscrowResolution records the signer's approval of a resolution for an
+// arbitrated escrow. Approving again replaces the signer's earlier approval. Once
+// the threshold of parties has approved the same resolution it is executed.
+// Returns the number of matching approvals and whether the escrow was resolved.
+func (k Keeper) ApproveEscrowResolution(ctx sdk.Context, settlementId uint64, signer string, resolution types.EscrowResolution, recipientAmount sdk.Coin) (uint32, bool, error) {
+	settlement, found := k.GetSettlement(ctx, settlementId)
+	if !found {
+		return 0, false, types.ErrSettlementNotFound
+	}
+	if settlement.Status == types.SettlementStatusCompleted {
+		return 0, false, types.ErrSettlementCompleted
+	}
+	if settlement.Status != types.SettlementStatusPending {
+		return 0, false, types.ErrSettlementCancelled
+	}
+
+	arbitration, found := k.GetEscrowArbitration(ctx, settlementId)
+	if !found {
+		return 0, false, errorsmod.Wrap(types.ErrInvalidArbitration, "escrow has no arbiters")
+	}
+	if !isEscrowParty(settlement, arbitration, signer) {
+		return 0, false, types.ErrNotEscrowParty
+	}
+
+	// Normalize the recipient share so approvals of the same outcome compare equal
+	switch resolution {
+	case types.EscrowResolutionRelease:
+		recipientAmount = settlement.Amount
+	case types.EscrowResolutionRefund:
+		recipientAmount = sdk.NewCoin(settlement.Amount.Denom, sdkmath.ZeroInt())
+	case types.EscrowResolutionSplit:
+		if recipientAmount.Denom != settlement.Amount.Denom {
+			return 0, false, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", settlement.Amount.Denom, recipientAmount.Denom)
+		}
+		if !recipientAmount.IsPositive() || !recipientAmount.IsLT(settlement.Amount) {
+			return 0, false, errorsmod.Wrap(types.ErrInvalidAmount, "split recipient amount must be between zero and the escrowed amount")
+		}
+	default:
+		return 0, false, errorsmod.Wrapf(types.ErrInvalidArbitration, "unknown resolution %q", resolution)
+	}
+
+	approval := types.EscrowApproval{
+		Party:           signer,
+		Resolution:      resolution,
+		RecipientAmount: recipientAmount,
+		ApprovedAt:      ctx.BlockTime(),
+	}
+	approvals := make([]types.EscrowApproval, 0, len(arbitration.Approvals)+1)
+	for _, a := range arbitration.Approvals {
+		if a.Party != signer {
+			approvals = append(approvals, a)
+		}
+	}
+	arbitration.Approvals = append(approvals, approval)
+
+	var matching uint32
+	for _, a := range arbitration.Approvals {
+		if a.Resolution == resolution && a.RecipientAmount.IsEqual(recipientAmount) {
+			matching++
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowApproval,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyParty, signer),
+			sdk.NewAttribute(types.AttributeKeyResolution, string(resolution)),
+			sdk.NewAttribute(types.AttributeKeyAmount, recipientAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", matching)),
+		),
+	)
+
+	if matching < arbitration.Threshold {
+		k.storeEscrowArbitration(ctx, arbitration)
+		return matching, false, nil
+	}
+
+	if err := k.resolveEscrow(ctx, settlement, resolution, recipientAmount); err != nil {
+		return matching, false, err
+	}
+
+	arbitration.Resolution = resolution
+	arbitration.ResolvedHeight = ctx.BlockHeight()
+	k.storeEscrowArbitration(ctx, arbitration)
+
+	return matching, true, nil
+}
+
+// resolveEscrow pays recipientAmount (less fees) to the recipient and the rest of
+// the escrowed amount back to the sender
+func (k Keeper) resolveEscrow(ctx sdk.Context, settlement types.Settlement, resolution types.EscrowResolution, recipientAmount sdk.Coin) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(settlement.Sender)
+	if err != nil {
+		return types.ErrInvalidSettlement
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(settlement.Recipient)
+	if err != nil {
+		return types.ErrInvalidRecipient
+	}
+
+	senderAmount := settlement.Amount.Sub(recipientAmount)
+	fee := sdk.NewCoin(settlement.Amount.Denom, sdkmath.ZeroInt())
+	netAmount := fee
+
+	if recipientAmount.IsPositive() {
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+			return types.ErrComplianceCheckFailed
+		}
+
+		fee = k.calculateFee(ctx, recipientAmount, settlement.Recipient)
+		netAmount = recipientAmount.Sub(fee)
+		if netAmount.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(netAmount)); err != nil {
+				return err
+			}
+		}
+		if fee.IsPositive() {
+			if err := k.collectFee(ctx, fee); err != nil {
+				return err
+			}
+		}
+	}
+
+	if senderAmount.IsPositive() {
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, senderAddr); err != nil {
+			return types.ErrComplianceCheckFailed
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, senderAddr, sdk.NewCoins(senderAmount)); err != nil {
+			return err
+		}
+	}
+
+	settlement.Fee = fee
+	settlement.NetAmount = netAmount
+	if resolution == types.EscrowResolutionRefund {
+		settlement.Status = types.SettlementStatusRefunded
+	} else {
+		settlement.Status = types.SettlementStatusCompleted
+	}
+	settlement.SettledHeight = ctx.BlockHeight()
+	settlement.SettledTime = ctx.BlockTime()
+	k.storeSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowResolved,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
+			sdk.NewAttribute(types.AttributeKeyResolution, string(resolution)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, settlement.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, netAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeySender, settlement.Sender),
+		),
+	)
+
+	return nil
+}
+
+// underArbitration reports whether the escrow names arbiters and has not yet
+// been resolved by its parties' approvals
+func (k Keeper) underArbitration(ctx sdk.Context, settlementId uint64) bool {
+	arbitration, found := k.GetEscrowArbitration(ctx, settlementId)
+	return found && arbitration.Resolution == ""
+}
+
+func isEscrowParty(settlement types.Settlement, arbitration types.EscrowArbitration, addr string) bool {
+	if addr == settlement.Sender || addr == settlement.Recipient {
+		return true
+	}
+	for _, arbiter := range arbitration.Arbiters {
+		if addr == arbiter {
+			return true
+		}
+	}
+	return false
+}
+
+func (k Keeper) storeEscrowArbitration(ctx sdk.Context, arbitration types.EscrowArbitration) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowArbitrationKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&arbitration)
+	store.Set(mustWriteUint64(arbitration.SettlementId), bz)
+}
+
+// GetEscrowArbitration retrieves the arbitration attached to an escrow settlement
+func (k Keeper) GetEscrowArbitration(ctx sdk.Context, settlementId uint64) (types.EscrowArbitration, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowArbitrationKeyPrefix)
+	bz := store.Get(mustWriteUint64(settlementId))
+	if len(bz) == 0 {
+		return types.EscrowArbitration{}, false
+	}
+	var arbitration types.EscrowArbitration
+	types.ModuleCdc.MustUnmarshalJSON(bz, &arbitration)
+	return arbitration, true
+}
+
+// IterateEscrowArbitrations iterates over all escrow arbitrations
+func (k Keeper) IterateEscrowArbitrations(ctx sdk.Context, cb func(types.EscrowArbitration) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowArbitrationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var arbitration types.EscrowArbitration
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &arbitration)
+		if cb(arbitration) {
+			break
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// createMultiPartyEscrow funds a sender and creates a 1000000 ssusd escrow to a
+// new recipient, resolved by two new arbiters at the given threshold.
+func createMultiPartyEscrow(t *testing.T, k keeper.Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper, threshold uint32) (uint64, sdk.AccAddress, sdk.AccAddress, []sdk.AccAddress) {
+	t.Helper()
+	sender := newSettlementAddress()
+	recipient := newSettlementAddress()
+	arbiters := []sdk.AccAddress{newSettlementAddress(), newSettlementAddress()}
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	id, err := k.CreateMultiPartyEscrow(ctx, sender.String(), recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), []string{arbiters[0].String(), arbiters[1].String()}, threshold, "TRADE-1", "", 86400)
+	require.NoError(t, err)
+	return id, sender, recipient, arbiters
+}
+
+func TestCreateMultiPartyEscrow(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, _, _, _ := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	settlement, found := k.GetSettlement(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.SettlementTypeEscrow, settlement.Type)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+
+	arbitration, found := k.GetEscrowArbitration(ctx, id)
+	require.True(t, found)
+	require.Len(t, arbitration.Arbiters, 2)
+	require.Equal(t, uint32(2), arbitration.Threshold)
+	require.Empty(t, arbitration.Approvals)
+}
+
+func TestCreateMultiPartyEscrow_ArbiterNotCompliant(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	sender := newSettlementAddress()
+	arbiter := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+	complianceKeeper.SetSanctioned(arbiter.String(), true)
+
+	_, err := k.CreateMultiPartyEscrow(ctx, sender.String(), newSettlementAddress().String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), []string{arbiter.String()}, 2, "", "", 86400)
+	require.ErrorIs(t, err, types.ErrComplianceCheckFailed)
+}
+
+func TestApproveEscrowResolution_Release(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, arbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	approvals, resolved, err := k.ApproveEscrowResolution(ctx, id, sender.String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), approvals)
+	require.False(t, resolved)
+
+	approvals, resolved, err = k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), approvals)
+	require.True(t, resolved)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+
+	arbitration, _ := k.GetEscrowArbitration(ctx, id)
+	require.Equal(t, types.EscrowResolutionRelease, arbitration.Resolution)
+
+	// Resolved escrows accept no further approvals
+	_, _, err = k.ApproveEscrowResolution(ctx, id, arbiters[1].String(), types.EscrowResolutionRefund, sdk.Coin{})
+	require.ErrorIs(t, err, types.ErrSettlementCompleted)
+}
+
+func TestApproveEscrowResolution_Refund(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, arbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	_, _, err := k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionRefund, sdk.Coin{})
+	require.NoError(t, err)
+	_, resolved, err := k.ApproveEscrowResolution(ctx, id, arbiters[1].String(), types.EscrowResolutionRefund, sdk.Coin{})
+	require.NoError(t, err)
+	require.True(t, resolved)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusRefunded, settlement.Status)
+	require.Equal(t, sdkmath.NewInt(1000000), bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, recipient, "ssusd").IsZero())
+}
+
+func TestApproveEscrowResolution_Split(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, arbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 3)
+	recipientShare := sdk.NewCoin("ssusd", sdkmath.NewInt(600000))
+
+	_, _, err := k.ApproveEscrowResolution(ctx, id, sender.String(), types.EscrowResolutionSplit, recipientShare)
+	require.NoError(t, err)
+	_, _, err = k.ApproveEscrowResolution(ctx, id, recipient.String(), types.EscrowResolutionSplit, recipientShare)
+	require.NoError(t, err)
+
+	// A different split does not count towards the threshold
+	approvals, resolved, err := k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionSplit, sdk.NewCoin("ssusd", sdkmath.NewInt(500000)))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), approvals)
+	require.False(t, resolved)
+
+	// The arbiter changes their approval to match
+	approvals, resolved, err = k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionSplit, recipientShare)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), approvals)
+	require.True(t, resolved)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, recipientShare.Sub(settlement.Fee), settlement.NetAmount)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(400000), bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+
+	arbitration, _ := k.GetEscrowArbitration(ctx, id)
+	require.Len(t, arbitration.Approvals, 3)
+}
+
+func TestApproveEscrowResolution_Invalid(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, _ := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	// Outsiders cannot approve
+	_, _, err := k.ApproveEscrowResolution(ctx, id, newSettlementAddress().String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.ErrorIs(t, err, types.ErrNotEscrowParty)
+
+	// Split must leave something for each side
+	_, _, err = k.ApproveEscrowResolution(ctx, id, sender.String(), types.EscrowResolutionSplit, sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)))
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// Plain escrows have no arbitration
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+	plainID, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), "", "", 86400)
+	require.NoError(t, err)
+	_, _, err = k.ApproveEscrowResolution(ctx, plainID, sender.String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.ErrorIs(t, err, types.ErrInvalidArbitration)
+}
+
+func TestEscrowArbitrationGenesis(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, _, _ := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+	_, _, err := k.ApproveEscrowResolution(ctx, id, sender.String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.NoError(t, err)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.EscrowArbitrations, 1)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	arbitration, found := k2.GetEscrowArbitration(ctx2, id)
+	require.True(t, found)
+	require.Len(t, arbitration.Approvals, 1)
+	require.Equal(t, sender.String(), arbitration.Approvals[0].Party)
+}
+
+func TestMultiPartyEscrow_OpenArbitrationBlocksDirectResolution(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, arbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	err := k.ReleaseEscrow(ctx, id, sender)
+	require.ErrorIs(t, err, types.ErrEscrowUnderArbitration)
+	err = k.RefundEscrow(ctx, id, recipient, "dispute")
+	require.ErrorIs(t, err, types.ErrEscrowUnderArbitration)
+
+	// Expiry leaves the escrow to its arbiters instead of refunding the sender
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * 86400 * time.Second))
+	k.ProcessExpiredEscrows(ctx)
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.True(t, bankKeeper.GetBalance(ctx, sender, "ssusd").IsZero())
+
+	_, _, err = k.ApproveEscrowResolution(ctx, id, recipient.String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.NoError(t, err)
+	_, resolved, err := k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionRelease, sdk.Coin{})
+	require.NoError(t, err)
+	require.True(t, resolved)
+
+	settlement, _ = k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+}
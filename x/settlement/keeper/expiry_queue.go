@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Expiry Queues
+// ============================================================================
+//
+// EndBlock work is driven by queues keyed by due time (or height) followed by
+// the record ID, so each block only reads the entries that are due instead of
+// scanning every record. Entries are hints: they are removed when popped and the
+// record is re-checked, so records that were settled or closed in the meantime
+// are skipped without having to unqueue them eagerly.
+
+func queueTimeKey(t time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), mustWriteUint64(id)...)
+}
+
+func queueHeightKey(height int64, id uint64) []byte {
+	return append(mustWriteUint64(uint64(height)), mustWriteUint64(id)...)
+}
+
+func (k Keeper) enqueue(ctx sdk.Context, queuePrefix, key []byte, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix)
+	store.Set(key, mustWriteUint64(id))
+}
+
+// dequeueDue removes and returns the IDs queued under queuePrefix with a key
+// prefix at or before end
+func (k Keeper) dequeueDue(ctx sdk.Context, queuePrefix, end []byte) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix)
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(end))
+
+	var keys [][]byte
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return ids
+}
+
+func (k Keeper) enqueueEscrowExpiry(ctx sdk.Context, expiresAt time.Time, settlementId uint64) {
+	k.enqueue(ctx, types.EscrowExpiryQueuePrefix, queueTimeKey(expiresAt, settlementId), settlementId)
+}
+
+func (k Keeper) enqueueChannelExpiry(ctx sdk.Context, expiresAtHeight int64, channelId uint64) {
+	k.enqueue(ctx, types.ChannelExpiryQueuePrefix, queueHeightKey(expiresAtHeight, channelId), channelId)
+}
+
+func (k Keeper) enqueueSubscriptionDue(ctx sdk.Context, due time.Time, subscriptionId uint64) {
+	k.enqueue(ctx, types.SubscriptionDueQueuePrefix, queueTimeKey(due, subscriptionId), subscriptionId)
+}
+
+func (k Keeper) enqueuePayoutRelease(ctx sdk.Context, releaseAt time.Time, settlementId uint64) {
+	k.enqueue(ctx, types.PayoutReleaseQueuePrefix, queueTimeKey(releaseAt, settlementId), settlementId)
+}
+
+func (k Keeper) enqueueNettingClose(ctx sdk.Context, closesAt time.Time, cycleId uint64) {
+	k.enqueue(ctx, types.NettingCloseQueuePrefix, queueTimeKey(closesAt, cycleId), cycleId)
+}
+
+func (k Keeper) enqueueBatchSettle(ctx sdk.Context, settleAt time.Time, batchId uint64) {
+	k.enqueue(ctx, types.BatchSettleQueuePrefix, queueTimeKey(settleAt, batchId), batchId)
+}
+
+// scheduleEscrow queues an escrow at its expiration and, for milestone escrows,
+// at each pending milestone deadline
+func (k Keeper) scheduleEscrow(ctx sdk.Context, settlement types.Settlement) {
+	if settlement.Type != types.SettlementTypeEscrow || settlement.Status != types.SettlementStatusPending {
+		return
+	}
+	if !settlement.ExpiresAt.IsZero() {
+		k.enqueueEscrowExpiry(ctx, settlement.ExpiresAt, settlement.Id)
+	}
+	if escrow, found := k.GetMilestoneEscrow(ctx, settlement.Id); found {
+		for _, m := range escrow.Milestones {
+			if m.Status == types.SettlementStatusPending && !m.Deadline.IsZero() {
+				k.enqueueEscrowExpiry(ctx, m.Deadline, settlement.Id)
+			}
+		}
+	}
+}
+
+// RebuildExpiryQueues queues every pending escrow, open channel, open
+// subscription, closing bidirectional channel, pending payout, pending
+// auto-settled batch and open netting cycle, reopening merchants' batches that
+// are not yet full. It is run when importing genesis and when migrating stores
+// written before the queues existed.
+func (k Keeper) RebuildExpiryQueues(ctx sdk.Context) {
+	k.IterateSettlements(ctx, func(s types.Settlement) bool {
+		k.scheduleEscrow(ctx, s)
+		return false
+	})
+	k.IterateChannels(ctx, func(c types.PaymentChannel) bool {
+		if c.IsOpen {
+			k.enqueueChannelExpiry(ctx, c.ExpiresAtHeight, c.Id)
+		}
+		return false
+	})
+	k.IterateSubscriptions(ctx, func(s types.Subscription) bool {
+		if s.Status.IsOpen() {
+			k.enqueueSubscriptionDue(ctx, s.NextDue, s.Id)
+		}
+		return false
+	})
+	k.IterateBidirectionalChannels(ctx, func(c types.BidirectionalChannel) bool {
+		if c.Status == types.BidirectionalChannelStatusClosing {
+			k.enqueueChannelChallenge(ctx, c.ChallengeEndsHeight, c.Id)
+		}
+		return false
+	})
+	k.IterateDelayedPayouts(ctx, func(p types.DelayedPayout) bool {
+		if p.Status == types.PayoutStatusPending {
+			k.enqueuePayoutRelease(ctx, p.ReleaseAt, p.SettlementId)
+		}
+		return false
+	})
+	k.IterateBatches(ctx, func(b types.BatchSettlement) bool {
+		k.restoreAutoBatch(ctx, b)
+		return false
+	})
+	k.IterateNettingCycles(ctx, func(c types.NettingCycle) bool {
+		if c.Status == types.NettingCycleStatusOpen {
+			k.enqueueNettingClose(ctx, c.ClosesAt, c.Id)
+		}
+		return false
+	})
+}
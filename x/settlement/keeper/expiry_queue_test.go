@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestProcessExpiredEscrows_SkipsSettledEscrows(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender := newSettlementAddress()
+	recipient := newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+
+	releasedId, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 60)
+	require.NoError(t, err)
+	expiringId, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 60)
+	require.NoError(t, err)
+	require.NoError(t, k.ReleaseEscrow(ctx, releasedId, sender))
+
+	k.ProcessExpiredEscrows(ctx.WithBlockTime(ctx.BlockTime().Add(61 * time.Second)))
+
+	released, _ := k.GetSettlement(ctx, releasedId)
+	require.Equal(t, types.SettlementStatusCompleted, released.Status)
+	expired, _ := k.GetSettlement(ctx, expiringId)
+	require.Equal(t, types.SettlementStatusCancelled, expired.Status)
+}
+
+func TestProcessExpiredChannels_EmitsOnce(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+
+	_, err := k.OpenChannel(ctx, sender.String(), newSettlementAddress().String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), 100)
+	require.NoError(t, err)
+
+	countExpired := func(height int64) int {
+		blockCtx := ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		k.ProcessExpiredChannels(blockCtx)
+		count := 0
+		for _, event := range blockCtx.EventManager().Events() {
+			if event.Type == types.EventTypeChannelExpired {
+				count++
+			}
+		}
+		return count
+	}
+
+	require.Equal(t, 0, countExpired(ctx.BlockHeight()+99))
+	require.Equal(t, 1, countExpired(ctx.BlockHeight()+100))
+	require.Equal(t, 0, countExpired(ctx.BlockHeight()+101))
+}
+
+func TestRebuildExpiryQueues_Genesis(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+
+	id, err := k.CreateEscrow(ctx, sender.String(), newSettlementAddress().String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), "", "", 60)
+	require.NoError(t, err)
+	genesis := k.ExportGenesis(ctx)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	k2.ProcessExpiredEscrows(ctx2.WithBlockTime(ctx.BlockTime().Add(61 * time.Second)))
+
+	settlement, _ := k2.GetSettlement(ctx2, id)
+	require.Equal(t, types.SettlementStatusCancelled, settlement.Status)
+}
+
+// The gas used to expire an escrow must not depend on how many other escrows
+// are outstanding.
+func TestProcessExpiredEscrows_GasIndependentOfHistory(t *testing.T) {
+	gasUsed := func(history int) storetypes.Gas {
+		k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+		// A fixed block time keeps the encoded records the same size across runs
+		ctx = ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		sender := newSettlementAddress()
+		recipient := newSettlementAddress()
+		amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+		bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(int64(history+1)*1000000))))
+
+		_, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 60)
+		require.NoError(t, err)
+		for i := 0; i < history; i++ {
+			_, err := k.CreateEscrow(ctx, sender.String(), recipient.String(), amount, "", "", 86400)
+			require.NoError(t, err)
+		}
+
+		blockCtx := ctx.WithBlockTime(ctx.BlockTime().Add(61 * time.Second)).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		k.ProcessExpiredEscrows(blockCtx)
+		return blockCtx.GasMeter().GasConsumed()
+	}
+
+	require.Equal(t, gasUsed(10), gasUsed(500))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Multi-Denom Settlement
+// ============================================================================
+//
+// Merchants are paid in their settlement denom. A payer can pay in any other
+// denom that the oracle prices and the stablecoin PSM accepts: the payment is
+// swapped in the payer's account into ssusd, and from ssusd into the
+// settlement denom when that is not ssusd, before it settles like any other
+// payment. The swap must return at least the oracle-priced amount less the
+// payer's slippage bound.
+
+// SettlementDenom returns the denom payments to the recipient settle in
+func (k Keeper) SettlementDenom(ctx sdk.Context, recipient string) string {
+	merchant, found := k.GetMerchant(ctx, recipient)
+	if found && merchant.IsActive && merchant.SettlementDenom != "" {
+		return merchant.SettlementDenom
+	}
+	return types.StablecoinDenom
+}
+
+// ConvertPayment swaps a payment the payer makes in another denom into the
+// recipient's settlement denom and returns the converted amount along with a
+// record of the conversion. A payment already in the settlement denom is
+// returned unchanged with a nil record.
+func (k Keeper) ConvertPayment(ctx sdk.Context, payer, recipient string, payment sdk.Coin, maxSlippageBps uint32) (sdk.Coin, *types.FXConversion, error) {
+	denom := k.SettlementDenom(ctx, recipient)
+	if payment.Denom == denom {
+		return payment, nil, nil
+	}
+	if k.oracleKeeper == nil || k.stablecoinKeeper == nil {
+		return sdk.Coin{}, nil, types.ErrConversionUnavailable.Wrap("multi-denom settlement is not configured")
+	}
+	if maxSlippageBps > types.MaxSlippageBps {
+		return sdk.Coin{}, nil, types.ErrInvalidSlippage
+	}
+	if maxSlippageBps == 0 {
+		maxSlippageBps = types.DefaultMaxSlippageBps
+	}
+
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return sdk.Coin{}, nil, types.ErrInvalidSettlement
+	}
+
+	oracleRate, err := k.oracleRate(ctx, payment.Denom, denom)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	expected := oracleRate.MulInt(payment.Amount).TruncateInt()
+	minOut := expected.MulRaw(int64(types.MaxSlippageBps - maxSlippageBps)).QuoRaw(types.MaxSlippageBps)
+
+	ssusdAmount := payment.Amount
+	if payment.Denom != types.StablecoinDenom {
+		ssusdAmount, _, err = k.stablecoinKeeper.PSMSwapIn(ctx, payerAddr, payment)
+		if err != nil {
+			return sdk.Coin{}, nil, types.ErrConversionUnavailable.Wrapf("swap %s into %s: %s", payment.Denom, types.StablecoinDenom, err)
+		}
+	}
+	out := ssusdAmount
+	if denom != types.StablecoinDenom {
+		out, _, err = k.stablecoinKeeper.PSMSwapOut(ctx, payerAddr, ssusdAmount, denom)
+		if err != nil {
+			return sdk.Coin{}, nil, types.ErrConversionUnavailable.Wrapf("swap %s into %s: %s", types.StablecoinDenom, denom, err)
+		}
+	}
+	if out.LT(minOut) {
+		return sdk.Coin{}, nil, types.ErrSlippageExceeded.Wrapf("converted %s%s, expected at least %s%s", out, denom, minOut, denom)
+	}
+
+	conversion := &types.FXConversion{
+		PaymentAmount: payment,
+		OracleRate:    oracleRate,
+		FxRate:        sdkmath.LegacyNewDecFromInt(out).QuoInt(payment.Amount),
+		Route:         types.FXRoutePSM,
+	}
+	return sdk.NewCoin(denom, out), conversion, nil
+}
+
+// oracleRate returns the amount of the settlement denom worth one unit of the
+// payment denom at oracle prices
+func (k Keeper) oracleRate(ctx sdk.Context, paymentDenom, settlementDenom string) (sdkmath.LegacyDec, error) {
+	paymentPrice, err := k.usdPrice(ctx, paymentDenom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	settlementPrice, err := k.usdPrice(ctx, settlementDenom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return paymentPrice.Quo(settlementPrice), nil
+}
+
+// usdPrice returns the oracle price of a denom, rejecting stale prices. ssusd
+// is the unit of account and is always priced at one; PSM assets are priced
+// by their configured oracle denom.
+func (k Keeper) usdPrice(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error) {
+	if denom == types.StablecoinDenom {
+		return sdkmath.LegacyOneDec(), nil
+	}
+
+	oracleDenom := denom
+	if config, found := k.stablecoinKeeper.GetPSMConfig(ctx, denom); found && config.OracleDenom != "" {
+		oracleDenom = config.OracleDenom
+	}
+	price, err := k.oracleKeeper.GetPriceWithStalenessCheck(ctx, oracleDenom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, types.ErrConversionUnavailable.Wrapf("price of %s: %s", denom, err)
+	}
+	if price.Amount.IsNil() || !price.Amount.IsPositive() {
+		return sdkmath.LegacyDec{}, types.ErrConversionUnavailable.Wrapf("invalid price %s for %s", price.Amount, denom)
+	}
+	return price.Amount, nil
+}
+
+// validateSettlementDenom checks that a merchant can settle in the denom
+func (k Keeper) validateSettlementDenom(ctx sdk.Context, denom string) error {
+	if denom == "" || denom == types.StablecoinDenom {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return types.ErrInvalidDenom.Wrap(err.Error())
+	}
+	if k.stablecoinKeeper == nil {
+		return types.ErrInvalidDenom.Wrapf("%s is not a PSM asset", denom)
+	}
+	config, found := k.stablecoinKeeper.GetPSMConfig(ctx, denom)
+	if !found || !config.Active {
+		return types.ErrInvalidDenom.Wrapf("%s is not an active PSM asset", denom)
+	}
+	return nil
+}
+
+// recordConversion stores how a settlement's payment was converted
+func (k Keeper) recordConversion(ctx sdk.Context, settlementId uint64, conversion *types.FXConversion) {
+	if conversion == nil {
+		return
+	}
+	settlement, found := k.GetSettlement(ctx, settlementId)
+	if !found {
+		return
+	}
+	settlement.FxConversion = conversion
+	k.storeSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePaymentConverted,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyPaymentAmount, conversion.PaymentAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, settlement.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOracleRate, conversion.OracleRate.String()),
+			sdk.NewAttribute(types.AttributeKeyFXRate, conversion.FxRate.String()),
+			sdk.NewAttribute(types.AttributeKeyRoute, conversion.Route),
+		),
+	)
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
+)
+
+// setupFXMerchant registers a merchant settling in the given denom and makes
+// uusdc a PSM asset priced at one dollar with a 0.1% mint and redeem fee
+func setupFXMerchant(t *testing.T, settlementDenom string) (keeper.Keeper, sdk.Context, *mockBankKeeper, *mockOracleKeeper, *mockStablecoinKeeper, sdk.AccAddress) {
+	k, ctx, bankKeeper, _, _, oracleKeeper, stablecoinKeeper := setupSettlementKeeperWithConversion(t)
+	stablecoinKeeper.configs["uusdc"] = stablecointypes.PSMConfig{Denom: "uusdc", Active: true, MintFeeBps: 10, RedeemFeeBps: 10, OracleDenom: "usdc"}
+	oracleKeeper.prices["usdc"] = sdkmath.LegacyOneDec()
+
+	merchant := newSettlementAddress()
+	require.NoError(t, k.RegisterMerchant(ctx, types.MerchantConfig{
+		Address:         merchant.String(),
+		Name:            "FX Merchant",
+		SettlementDenom: settlementDenom,
+	}))
+	return k, ctx, bankKeeper, oracleKeeper, stablecoinKeeper, merchant
+}
+
+func TestFX_PaymentConvertedIntoSsusd(t *testing.T) {
+	k, ctx, bankKeeper, _, _, merchant := setupFXMerchant(t, "")
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+
+	res, err := keeper.NewMsgServerImpl(k).InstantTransfer(ctx, &types.MsgInstantTransfer{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+	})
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, res.SettlementId)
+	require.Equal(t, sdk.NewCoin("ssusd", sdkmath.NewInt(999000)), settlement.Amount)
+	require.NotNil(t, settlement.FxConversion)
+	require.Equal(t, sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)), settlement.FxConversion.PaymentAmount)
+	require.Equal(t, sdkmath.LegacyOneDec(), settlement.FxConversion.OracleRate)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(999, 3), settlement.FxConversion.FxRate)
+	require.Equal(t, types.FXRoutePSM, settlement.FxConversion.Route)
+
+	require.True(t, bankKeeper.GetBalance(ctx, customer, "uusdc").IsZero())
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+}
+
+func TestFX_MerchantPaidInSettlementDenom(t *testing.T) {
+	k, ctx, bankKeeper, _, _, merchant := setupFXMerchant(t, "uusdc")
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	res, err := keeper.NewMsgServerImpl(k).InstantTransfer(ctx, &types.MsgInstantTransfer{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)),
+	})
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, res.SettlementId)
+	require.Equal(t, "uusdc", settlement.Amount.Denom)
+	require.Equal(t, "uusdc", settlement.Fee.Denom)
+	require.Equal(t, sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), settlement.FxConversion.PaymentAmount)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "uusdc").Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+
+	// Payments already in the settlement denom are not converted
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+	res, err = keeper.NewMsgServerImpl(k).InstantTransfer(ctx, &types.MsgInstantTransfer{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+	})
+	require.NoError(t, err)
+	settlement, _ = k.GetSettlement(ctx, res.SettlementId)
+	require.Nil(t, settlement.FxConversion)
+	require.Equal(t, sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)), settlement.Amount)
+}
+
+func TestFX_SlippageBound(t *testing.T) {
+	k, ctx, bankKeeper, oracleKeeper, _, merchant := setupFXMerchant(t, "")
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// The PSM swaps 1:1 while the oracle prices uusdc 3% above ssusd
+	oracleKeeper.prices["usdc"] = sdkmath.LegacyNewDecWithPrec(103, 2)
+	msg := &types.MsgInstantTransfer{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+	}
+	_, err := msgServer.InstantTransfer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrSlippageExceeded)
+
+	// The mock bank does not revert the failed swap, so fund the payer again
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+	msg.MaxSlippageBps = 500
+	res, err := msgServer.InstantTransfer(ctx, msg)
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, res.SettlementId)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(103, 2), settlement.FxConversion.OracleRate)
+}
+
+func TestFX_UnpricedOrUnswappableDenomRejected(t *testing.T) {
+	k, ctx, bankKeeper, oracleKeeper, _, merchant := setupFXMerchant(t, "")
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(
+		sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+		sdk.NewCoin("uatom", sdkmath.NewInt(1000000)),
+	))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	oracleKeeper.stale["usdc"] = true
+	_, err := msgServer.InstantTransfer(ctx, &types.MsgInstantTransfer{Sender: customer.String(), Recipient: merchant.String(), Amount: sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))})
+	require.ErrorIs(t, err, types.ErrConversionUnavailable)
+
+	// Priced by the oracle but not swappable through the PSM
+	oracleKeeper.prices["uatom"] = sdkmath.LegacyNewDec(8)
+	_, err = msgServer.InstantTransfer(ctx, &types.MsgInstantTransfer{Sender: customer.String(), Recipient: merchant.String(), Amount: sdk.NewCoin("uatom", sdkmath.NewInt(1000000))})
+	require.ErrorIs(t, err, types.ErrConversionUnavailable)
+	require.Equal(t, sdkmath.NewInt(1000000), bankKeeper.GetBalance(ctx, customer, "uatom").Amount)
+}
+
+func TestFX_EscrowAndCheckoutRecordConversion(t *testing.T) {
+	k, ctx, bankKeeper, _, _, merchant := setupFXMerchant(t, "")
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(2000000))))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	escrow, err := msgServer.CreateEscrow(ctx, &types.MsgCreateEscrow{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+		ExpiresIn: time.Hour,
+	})
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, escrow.SettlementId)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Equal(t, sdk.NewCoin("ssusd", sdkmath.NewInt(999000)), settlement.Amount)
+	require.NotNil(t, settlement.FxConversion)
+
+	checkout, err := msgServer.InstantCheckout(ctx, &types.MsgInstantCheckout{
+		Customer:       customer.String(),
+		Merchant:       merchant.String(),
+		Amount:         sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+		OrderReference: "ORDER-FX",
+	})
+	require.NoError(t, err)
+	settlement, _ = k.GetSettlement(ctx, checkout.SettlementId)
+	require.Equal(t, "ssusd", checkout.NetAmount.Denom)
+	require.Equal(t, sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)), settlement.FxConversion.PaymentAmount)
+}
+
+func TestFX_SettlementDenomMustBePSMAsset(t *testing.T) {
+	k, ctx, _, _, stablecoinKeeper, merchant := setupFXMerchant(t, "")
+
+	err := k.RegisterMerchant(ctx, types.MerchantConfig{Address: newSettlementAddress().String(), Name: "ATOM Merchant", SettlementDenom: "uatom"})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	require.NoError(t, k.UpdateMerchant(ctx, merchant.String(), map[string]interface{}{"settlement_denom": "uusdc"}))
+	require.Equal(t, "uusdc", k.SettlementDenom(ctx, merchant.String()))
+
+	stablecoinKeeper.configs["udai"] = stablecointypes.PSMConfig{Denom: "udai", Active: false}
+	err = k.UpdateMerchant(ctx, merchant.String(), map[string]interface{}{"settlement_denom": "udai"})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
+
+func TestFX_SettlementLimitsValuedInSsusd(t *testing.T) {
+	k, ctx, bankKeeper, oracleKeeper, _, merchant := setupFXMerchant(t, "uusdc")
+	params := k.GetParams(ctx)
+	params.MaxSettlementAmount = sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+	msg := &types.MsgInstantTransfer{
+		Sender:    customer.String(),
+		Recipient: merchant.String(),
+		Amount:    sdk.NewCoin("uusdc", sdkmath.NewInt(1000000)),
+	}
+
+	// Worth three times as much in ssusd, the payment exceeds the maximum
+	oracleKeeper.prices["usdc"] = sdkmath.LegacyNewDec(3)
+	_, err := keeper.NewMsgServerImpl(k).InstantTransfer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrSettlementTooLarge)
+
+	oracleKeeper.prices["usdc"] = sdkmath.LegacyOneDec()
+	_, err = keeper.NewMsgServerImpl(k).InstantTransfer(ctx, msg)
+	require.NoError(t, err)
+
+	// An unpriced settlement denom cannot be checked against the limits
+	oracleKeeper.stale["usdc"] = true
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("uusdc", sdkmath.NewInt(1000000))))
+	_, err = keeper.NewMsgServerImpl(k).InstantTransfer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConversionUnavailable)
+}
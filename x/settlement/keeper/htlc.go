@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Hash Time-Locked Transfers
+// ============================================================================
+
+// LockHTLC locks amount for the recipient until timeoutBlocks from now. The
+// recipient claims it by revealing the preimage of hashLock; after the timeout
+// only the sender can take it back.
+//
+// A non-zero channelId funds the HTLC from the balance of a payment channel the
+// sender opened to the recipient instead of the sender's account. A non-zero
+// incomingHtlcId marks the HTLC as the next hop of a routed payment: the incoming
+// HTLC must pay the sender under the same hash lock, carry at least the same
+// amount and time out at least HTLCTimeoutDelta blocks later, so the sender can
+// always claim the incoming hop once the outgoing one is claimed.
+func (k Keeper) LockHTLC(ctx sdk.Context, sender, recipient string, amount sdk.Coin, hashLock string, timeoutBlocks int64, channelId, incomingHtlcId uint64) (types.HTLC, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return types.HTLC{}, types.ErrInvalidSettlement
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return types.HTLC{}, types.ErrInvalidRecipient
+	}
+	if senderAddr.Equals(recipientAddr) {
+		return types.HTLC{}, types.ErrInvalidRecipient.Wrap("sender and recipient must be different")
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return types.HTLC{}, errorsmod.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+	if err := types.ValidateHashLock(hashLock); err != nil {
+		return types.HTLC{}, err
+	}
+	if timeoutBlocks < types.MinHTLCTimeout || timeoutBlocks > types.MaxHTLCTimeout {
+		return types.HTLC{}, errorsmod.Wrapf(types.ErrInvalidHTLC, "timeout must be between %d and %d blocks", types.MinHTLCTimeout, types.MaxHTLCTimeout)
+	}
+	timeoutHeight := ctx.BlockHeight() + timeoutBlocks
+
+	if incomingHtlcId != 0 {
+		incoming, found := k.GetHTLC(ctx, incomingHtlcId)
+		if !found {
+			return types.HTLC{}, types.ErrHTLCNotFound
+		}
+		if incoming.Status != types.HTLCStatusLocked {
+			return types.HTLC{}, types.ErrHTLCNotLocked
+		}
+		if incoming.Recipient != sender {
+			return types.HTLC{}, errorsmod.Wrap(types.ErrUnauthorized, "incoming htlc does not pay the sender")
+		}
+		if incoming.HashLock != hashLock {
+			return types.HTLC{}, errorsmod.Wrap(types.ErrInvalidHTLC, "hash lock does not match the incoming htlc")
+		}
+		if incoming.Amount.Denom != amount.Denom || incoming.Amount.IsLT(amount) {
+			return types.HTLC{}, errorsmod.Wrap(types.ErrInvalidAmount, "amount exceeds the incoming htlc")
+		}
+		if timeoutHeight+types.HTLCTimeoutDelta > incoming.TimeoutHeight {
+			return types.HTLC{}, errorsmod.Wrapf(types.ErrInvalidHTLC, "timeout must be at least %d blocks before the incoming htlc times out", types.HTLCTimeoutDelta)
+		}
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, senderAddr); err != nil {
+		return types.HTLC{}, types.ErrComplianceCheckFailed
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+		return types.HTLC{}, types.ErrComplianceCheckFailed
+	}
+
+	if channelId != 0 {
+		channel, found := k.GetChannel(ctx, channelId)
+		if !found {
+			return types.HTLC{}, types.ErrChannelNotFound
+		}
+		if !channel.IsOpen {
+			return types.HTLC{}, types.ErrChannelClosed
+		}
+		if channel.Sender != sender || channel.Recipient != recipient {
+			return types.HTLC{}, errorsmod.Wrap(types.ErrUnauthorized, "channel does not run from sender to recipient")
+		}
+		if channel.Balance.Denom != amount.Denom || channel.Balance.IsLT(amount) {
+			return types.HTLC{}, types.ErrChannelInsufficientBalance
+		}
+		if timeoutHeight > channel.ExpiresAtHeight {
+			return types.HTLC{}, errorsmod.Wrap(types.ErrInvalidHTLC, "htlc must time out before the channel expires")
+		}
+		channel.Balance = channel.Balance.Sub(amount)
+		k.storeChannel(ctx, channel)
+	} else {
+		balance := k.bankKeeper.GetBalance(wrappedCtx, senderAddr, amount.Denom)
+		if balance.IsLT(amount) {
+			return types.HTLC{}, types.ErrInsufficientFunds
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, senderAddr, types.ModuleAccountName, sdk.NewCoins(amount)); err != nil {
+			return types.HTLC{}, err
+		}
+	}
+
+	htlc := types.HTLC{
+		Id:             k.getNextHTLCID(ctx),
+		Sender:         sender,
+		Recipient:      recipient,
+		Amount:         amount,
+		HashLock:       hashLock,
+		ChannelId:      channelId,
+		IncomingHtlcId: incomingHtlcId,
+		TimeoutHeight:  timeoutHeight,
+		Status:         types.HTLCStatusLocked,
+		CreatedHeight:  ctx.BlockHeight(),
+	}
+	k.setNextHTLCID(ctx, htlc.Id+1)
+	k.storeHTLC(ctx, htlc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHTLCLocked,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, fmt.Sprintf("%d", htlc.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, hashLock),
+			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, fmt.Sprintf("%d", timeoutHeight)),
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channelId)),
+		),
+	)
+
+	return htlc, nil
+}
+
+// ClaimHTLC pays a locked HTLC to its recipient when the hex encoded preimage
+// matches the hash lock. The preimage is stored and emitted so the upstream hops
+// of a routed payment can claim theirs.
+func (k Keeper) ClaimHTLC(ctx sdk.Context, htlcId uint64, recipient sdk.AccAddress, preimage string) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	htlc, found := k.GetHTLC(ctx, htlcId)
+	if !found {
+		return types.ErrHTLCNotFound
+	}
+	if htlc.Status != types.HTLCStatusLocked {
+		return types.ErrHTLCNotLocked
+	}
+	if htlc.Recipient != recipient.String() {
+		return types.ErrUnauthorized
+	}
+	if ctx.BlockHeight() >= htlc.TimeoutHeight {
+		return types.ErrHTLCExpired
+	}
+	if !htlc.MatchesPreimage(preimage) {
+		return types.ErrInvalidPreimage
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, recipient); err != nil {
+		return types.ErrComplianceCheckFailed
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipient, sdk.NewCoins(htlc.Amount)); err != nil {
+		return err
+	}
+
+	if htlc.ChannelId != 0 {
+		if channel, found := k.GetChannel(ctx, htlc.ChannelId); found {
+			channel.Spent = channel.Spent.Add(htlc.Amount)
+			k.storeChannel(ctx, channel)
+		}
+	}
+
+	htlc.Status = types.HTLCStatusClaimed
+	htlc.Preimage = preimage
+	htlc.ResolvedHeight = ctx.BlockHeight()
+	k.storeHTLC(ctx, htlc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHTLCClaimed,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, fmt.Sprintf("%d", htlc.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, htlc.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, htlc.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock),
+			sdk.NewAttribute(types.AttributeKeyPreimage, preimage),
+		),
+	)
+
+	return nil
+}
+
+// RefundHTLC returns a timed out HTLC to its sender. Channel funded HTLCs go
+// back to the channel balance while the channel is open, and to the sender's
+// account once it has closed.
+func (k Keeper) RefundHTLC(ctx sdk.Context, htlcId uint64, sender sdk.AccAddress) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	htlc, found := k.GetHTLC(ctx, htlcId)
+	if !found {
+		return types.ErrHTLCNotFound
+	}
+	if htlc.Status != types.HTLCStatusLocked {
+		return types.ErrHTLCNotLocked
+	}
+	if htlc.Sender != sender.String() {
+		return types.ErrUnauthorized
+	}
+	if ctx.BlockHeight() < htlc.TimeoutHeight {
+		return types.ErrHTLCNotExpired
+	}
+
+	refunded := false
+	if htlc.ChannelId != 0 {
+		if channel, found := k.GetChannel(ctx, htlc.ChannelId); found && channel.IsOpen {
+			channel.Balance = channel.Balance.Add(htlc.Amount)
+			k.storeChannel(ctx, channel)
+			refunded = true
+		}
+	}
+	if !refunded {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, sender, sdk.NewCoins(htlc.Amount)); err != nil {
+			return err
+		}
+	}
+
+	htlc.Status = types.HTLCStatusRefunded
+	htlc.ResolvedHeight = ctx.BlockHeight()
+	k.storeHTLC(ctx, htlc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHTLCRefunded,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, fmt.Sprintf("%d", htlc.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, htlc.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, htlc.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetChannelRoutingFee sets the fee, in basis points of the forwarded amount,
+// the channel sender charges for routing HTLC payments over the channel
+func (k Keeper) SetChannelRoutingFee(ctx sdk.Context, channelId uint64, sender sdk.AccAddress, routingFeeBps uint32) error {
+	channel, found := k.GetChannel(ctx, channelId)
+	if !found {
+		return types.ErrChannelNotFound
+	}
+	if !channel.IsOpen {
+		return types.ErrChannelClosed
+	}
+	if channel.Sender != sender.String() {
+		return types.ErrUnauthorized
+	}
+	if routingFeeBps > types.MaxRoutingFeeBps {
+		return errorsmod.Wrapf(types.ErrInvalidSettlement, "routing fee must be <= %d bps", types.MaxRoutingFeeBps)
+	}
+
+	channel.RoutingFeeBps = routingFeeBps
+	k.storeChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelRoutingFeeUpdated,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channelId)),
+			sdk.NewAttribute(types.AttributeKeyRoutingFee, fmt.Sprintf("%d", routingFeeBps)),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) getNextHTLCID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextHTLCIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextHTLCID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextHTLCIDKey, bz)
+}
+
+func (k Keeper) storeHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HTLCKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&htlc)
+	store.Set(mustWriteUint64(htlc.Id), bz)
+}
+
+// GetHTLC retrieves an HTLC by ID
+func (k Keeper) GetHTLC(ctx sdk.Context, id uint64) (types.HTLC, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HTLCKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.HTLC{}, false
+	}
+	var htlc types.HTLC
+	types.ModuleCdc.MustUnmarshalJSON(bz, &htlc)
+	return htlc, true
+}
+
+// IterateHTLCs iterates over all HTLCs
+func (k Keeper) IterateHTLCs(ctx sdk.Context, cb func(types.HTLC) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HTLCKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var htlc types.HTLC
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &htlc)
+		if cb(htlc) {
+			break
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+var (
+	htlcSecret   = []byte("routed-payment-secret")
+	htlcPreimage = hex.EncodeToString(htlcSecret)
+	htlcHashLock = types.HTLCHashLock(htlcSecret)
+)
+
+func TestHTLC_ClaimWithPreimage(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender, recipient := newSettlementAddress(), newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(amount))
+
+	htlc, err := k.LockHTLC(ctx, sender.String(), recipient.String(), amount, htlcHashLock, 20, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+20, htlc.TimeoutHeight)
+	require.True(t, bankKeeper.GetBalance(ctx, sender, "ssusd").IsZero())
+
+	// Only the recipient can claim, and only with the right preimage
+	require.ErrorIs(t, k.ClaimHTLC(ctx, htlc.Id, sender, htlcPreimage), types.ErrUnauthorized)
+	require.ErrorIs(t, k.ClaimHTLC(ctx, htlc.Id, recipient, hex.EncodeToString([]byte("wrong"))), types.ErrInvalidPreimage)
+	require.ErrorIs(t, k.RefundHTLC(ctx, htlc.Id, sender), types.ErrHTLCNotExpired)
+
+	require.NoError(t, k.ClaimHTLC(ctx, htlc.Id, recipient, htlcPreimage))
+	require.Equal(t, amount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+
+	htlc, _ = k.GetHTLC(ctx, htlc.Id)
+	require.Equal(t, types.HTLCStatusClaimed, htlc.Status)
+	require.Equal(t, htlcPreimage, htlc.Preimage)
+	require.ErrorIs(t, k.ClaimHTLC(ctx, htlc.Id, recipient, htlcPreimage), types.ErrHTLCNotLocked)
+}
+
+func TestHTLC_RefundAfterTimeout(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender, recipient := newSettlementAddress(), newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(amount))
+
+	htlc, err := k.LockHTLC(ctx, sender.String(), recipient.String(), amount, htlcHashLock, 20, 0, 0)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(htlc.TimeoutHeight)
+	require.ErrorIs(t, k.ClaimHTLC(ctx, htlc.Id, recipient, htlcPreimage), types.ErrHTLCExpired)
+	require.ErrorIs(t, k.RefundHTLC(ctx, htlc.Id, recipient), types.ErrUnauthorized)
+
+	require.NoError(t, k.RefundHTLC(ctx, htlc.Id, sender))
+	require.Equal(t, amount.Amount, bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+
+	htlc, _ = k.GetHTLC(ctx, htlc.Id)
+	require.Equal(t, types.HTLCStatusRefunded, htlc.Status)
+}
+
+func TestHTLC_ChannelFunded(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender, recipient := newSettlementAddress(), newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	channelID, err := k.OpenChannel(ctx, sender.String(), recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), 1000)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(400000))
+
+	// The channel must run from sender to recipient and cover the amount
+	_, err = k.LockHTLC(ctx, recipient.String(), sender.String(), amount, htlcHashLock, 20, channelID, 0)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.LockHTLC(ctx, sender.String(), recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(2000000)), htlcHashLock, 20, channelID, 0)
+	require.ErrorIs(t, err, types.ErrChannelInsufficientBalance)
+
+	claimed, err := k.LockHTLC(ctx, sender.String(), recipient.String(), amount, htlcHashLock, 20, channelID, 0)
+	require.NoError(t, err)
+	refunded, err := k.LockHTLC(ctx, sender.String(), recipient.String(), amount, htlcHashLock, 20, channelID, 0)
+	require.NoError(t, err)
+
+	channel, _ := k.GetChannel(ctx, channelID)
+	require.Equal(t, sdkmath.NewInt(200000), channel.Balance.Amount)
+
+	require.NoError(t, k.ClaimHTLC(ctx, claimed.Id, recipient, htlcPreimage))
+	ctx = ctx.WithBlockHeight(refunded.TimeoutHeight)
+	require.NoError(t, k.RefundHTLC(ctx, refunded.Id, sender))
+
+	// Claims count as spent, refunds return to the channel
+	channel, _ = k.GetChannel(ctx, channelID)
+	require.Equal(t, sdkmath.NewInt(400000), channel.Spent.Amount)
+	require.Equal(t, sdkmath.NewInt(600000), channel.Balance.Amount)
+	require.Equal(t, amount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+}
+
+func TestHTLC_RoutedPayment(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer, hub, payee := newSettlementAddress(), newSettlementAddress(), newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+	bankKeeper.SetBalance(hub.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+
+	payerChannel, err := k.OpenChannel(ctx, payer.String(), hub.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(2000000)), 1000)
+	require.NoError(t, err)
+	hubChannel, err := k.OpenChannel(ctx, hub.String(), payee.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(2000000)), 1000)
+	require.NoError(t, err)
+	require.NoError(t, k.SetChannelRoutingFee(ctx, hubChannel, hub, 100))
+	require.ErrorIs(t, k.SetChannelRoutingFee(ctx, hubChannel, payer, 100), types.ErrUnauthorized)
+
+	// The payer locks the amount plus the hub's 1% fee on the first hop
+	incoming, err := k.LockHTLC(ctx, payer.String(), hub.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1010000)), htlcHashLock, 40, payerChannel, 0)
+	require.NoError(t, err)
+
+	// The hub must forward under the same hash, no more than it receives, and
+	// time out early enough to claim the incoming hop
+	payment := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	_, err = k.LockHTLC(ctx, hub.String(), payee.String(), payment, htlcHashLock, 40-types.HTLCTimeoutDelta+1, hubChannel, incoming.Id)
+	require.ErrorIs(t, err, types.ErrInvalidHTLC)
+	_, err = k.LockHTLC(ctx, hub.String(), payee.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1020000)), htlcHashLock, 20, hubChannel, incoming.Id)
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+	_, err = k.LockHTLC(ctx, hub.String(), payee.String(), payment, types.HTLCHashLock([]byte("other")), 20, hubChannel, incoming.Id)
+	require.ErrorIs(t, err, types.ErrInvalidHTLC)
+	_, err = k.LockHTLC(ctx, payee.String(), hub.String(), payment, htlcHashLock, 20, 0, incoming.Id)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	outgoing, err := k.LockHTLC(ctx, hub.String(), payee.String(), payment, htlcHashLock, 40-types.HTLCTimeoutDelta, hubChannel, incoming.Id)
+	require.NoError(t, err)
+
+	// The payee reveals the preimage, which the hub then uses upstream
+	require.NoError(t, k.ClaimHTLC(ctx, outgoing.Id, payee, htlcPreimage))
+	outgoing, _ = k.GetHTLC(ctx, outgoing.Id)
+	require.NoError(t, k.ClaimHTLC(ctx, incoming.Id, hub, outgoing.Preimage))
+
+	require.Equal(t, payment.Amount, bankKeeper.GetBalance(ctx, payee, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(1010000), bankKeeper.GetBalance(ctx, hub, "ssusd").Amount)
+}
+
+func TestHTLCGenesis(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender, recipient := newSettlementAddress(), newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(amount))
+
+	htlc, err := k.LockHTLC(ctx, sender.String(), recipient.String(), amount, htlcHashLock, 20, 0, 0)
+	require.NoError(t, err)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Htlcs, 1)
+	require.Equal(t, htlc.Id+1, genesis.NextHtlcId)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	imported, found := k2.GetHTLC(ctx2, htlc.Id)
+	require.True(t, found)
+	require.Equal(t, htlc.HashLock, imported.HashLock)
+	require.Equal(t, types.HTLCStatusLocked, imported.Status)
+}
@@ -18,13 +18,15 @@ import (
 
 // Keeper handles settlement operations
 type Keeper struct {
-	storeKey      storetypes.StoreKey
-	cdc           codec.BinaryCodec
-	bankKeeper    types.BankKeeper
-	compKeeper    types.ComplianceKeeper
-	accountKeeper types.AccountKeeper
-	authority     string
-	params        types.Params
+	storeKey         storetypes.StoreKey
+	cdc              codec.BinaryCodec
+	bankKeeper       types.BankKeeper
+	compKeeper       types.ComplianceKeeper
+	accountKeeper    types.AccountKeeper
+	oracleKeeper     types.OracleKeeper
+	stablecoinKeeper types.StablecoinKeeper
+	authority        string
+	params           types.Params
 }
 
 // NewKeeper creates a new settlement keeper
@@ -34,16 +36,20 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	compKeeper types.ComplianceKeeper,
 	accountKeeper types.AccountKeeper,
+	oracleKeeper types.OracleKeeper,
+	stablecoinKeeper types.StablecoinKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		bankKeeper:    bankKeeper,
-		compKeeper:    compKeeper,
-		accountKeeper: accountKeeper,
-		authority:     authority,
-		params:        types.DefaultParams(),
+		storeKey:         key,
+		cdc:              cdc,
+		bankKeeper:       bankKeeper,
+		compKeeper:       compKeeper,
+		accountKeeper:    accountKeeper,
+		oracleKeeper:     oracleKeeper,
+		stablecoinKeeper: stablecoinKeeper,
+		authority:        authority,
+		params:           types.DefaultParams(),
 	}
 }
 
@@ -132,9 +138,15 @@ func (k Keeper) setNextChannelID(ctx sdk.Context, id uint64) {
 // ============================================================================
 
 func (k Keeper) storeSettlement(ctx sdk.Context, settlement types.Settlement) {
+	var previous *types.Settlement
+	if existing, found := k.GetSettlement(ctx, settlement.Id); found {
+		previous = &existing
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettlementKeyPrefix)
 	bz := types.ModuleCdc.MustMarshalJSON(&settlement)
 	store.Set(mustWriteUint64(settlement.Id), bz)
+	k.indexSettlement(ctx, previous, settlement)
 }
 
 // GetSettlement retrieves a settlement by ID
@@ -151,6 +163,13 @@ func (k Keeper) GetSettlement(ctx sdk.Context, id uint64) (types.Settlement, boo
 
 // InstantTransfer performs an instant stablecoin transfer with settlement
 func (k Keeper) InstantTransfer(ctx sdk.Context, sender, recipient string, amount sdk.Coin, reference, metadata string) (uint64, error) {
+	return k.instantTransfer(ctx, types.SettlementTypeInstant, sender, recipient, amount, reference, metadata, nil)
+}
+
+// instantTransfer moves funds from sender to recipient through the module account
+// and records a completed settlement of the given type. Split rules pay the net
+// amount to several payees.
+func (k Keeper) instantTransfer(ctx sdk.Context, settlementType types.SettlementType, sender, recipient string, amount sdk.Coin, reference, metadata string, splits []types.SplitRule) (uint64, error) {
 	wrappedCtx := sdk.WrapSDKContext(ctx)
 
 	senderAddr, err := sdk.AccAddressFromBech32(sender)
@@ -178,11 +197,8 @@ func (k Keeper) InstantTransfer(ctx sdk.Context, sender, recipient string, amoun
 	if !params.InstantTransfersEnabled {
 		return 0, errorsmod.Wrap(types.ErrFeatureDisabled, "instant transfers are disabled")
 	}
-	if amount.IsLT(params.MinSettlementAmount) {
-		return 0, types.ErrSettlementTooSmall
-	}
-	if amount.IsGTE(params.MaxSettlementAmount) {
-		return 0, types.ErrSettlementTooLarge
+	if err := k.checkSettlementLimits(ctx, params, amount); err != nil {
+		return 0, err
 	}
 
 	// Check sender balance
@@ -195,28 +211,23 @@ func (k Keeper) InstantTransfer(ctx sdk.Context, sender, recipient string, amoun
 	fee := k.calculateFee(ctx, amount, recipient)
 	netAmount := sdk.NewCoin(amount.Denom, amount.Amount.Sub(fee.Amount))
 
-	// Transfer: sender -> module (escrow)
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, senderAddr, types.ModuleAccountName, sdk.NewCoins(amount)); err != nil {
-		return 0, err
+	var legs []types.PayoutLeg
+	if len(splits) > 0 {
+		legs, err = k.splitLegs(ctx, recipient, netAmount, splits)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	// Transfer: module -> recipient (net amount)
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(netAmount)); err != nil {
+	// Transfer: sender -> module (escrow)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, senderAddr, types.ModuleAccountName, sdk.NewCoins(amount)); err != nil {
 		return 0, err
 	}
 
-	// Transfer fee to fee collector (if configured) or burn it
-	if fee.IsPositive() {
-		if err := k.collectFee(ctx, fee); err != nil {
-			return 0, err
-		}
-	}
-
-	// Create settlement record
 	nextID := k.getNextSettlementID(ctx)
 	settlement := types.Settlement{
 		Id:            nextID,
-		Type:          types.SettlementTypeInstant,
+		Type:          settlementType,
 		Sender:        sender,
 		Recipient:     recipient,
 		Amount:        amount,
@@ -229,23 +240,62 @@ func (k Keeper) InstantTransfer(ctx sdk.Context, sender, recipient string, amoun
 		CreatedTime:   ctx.BlockTime(),
 		SettledHeight: ctx.BlockHeight(),
 		SettledTime:   ctx.BlockTime(),
+		Legs:          legs,
+	}
+
+	// Payments to a batching merchant stay in the module until EndBlock
+	// settles the batch, which pays out and collects fees in aggregate. Split
+	// payments are paid out one by one.
+	merchant, batched := k.batchingMerchant(ctx, recipient, amount.Denom)
+	batched = batched && len(legs) == 0
+	var delay time.Duration
+	if batched {
+		settlement.Status = types.SettlementStatusPending
+		settlement.SettledHeight = 0
+		settlement.SettledTime = time.Time{}
+		settlement.BatchId = k.addToOpenBatch(ctx, merchant, settlement.Id, amount, fee)
+	} else {
+		// Transfer: module -> recipient (net amount), unless the merchant's
+		// settlement delay holds it in the module until EndBlock releases it
+		delay = k.settlementDelay(ctx, recipient)
+		if delay <= 0 || !netAmount.IsPositive() {
+			delay = 0
+			if len(legs) > 0 {
+				if err := k.payLegs(ctx, settlement); err != nil {
+					return 0, err
+				}
+			} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(netAmount)); err != nil {
+				return 0, err
+			}
+		}
+
+		// Transfer fee to fee collector (if configured) or burn it
+		if fee.IsPositive() {
+			if err := k.collectFee(ctx, fee); err != nil {
+				return 0, err
+			}
+		}
 	}
 
 	k.storeSettlement(ctx, settlement)
 	k.setNextSettlementID(ctx, nextID+1)
+	if delay > 0 {
+		k.schedulePayout(ctx, settlement, delay)
+	}
 
 	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeInstantTransfer,
-			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
-			sdk.NewAttribute(types.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
-			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
-			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
-			sdk.NewAttribute(types.AttributeKeyReference, reference),
-		),
-	)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
+		sdk.NewAttribute(types.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(types.AttributeKeyReference, reference),
+	}
+	if batched {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyBatchID, fmt.Sprintf("%d", settlement.BatchId)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeInstantTransfer, attrs...))
 
 	return settlement.Id, nil
 }
@@ -280,11 +330,8 @@ func (k Keeper) CreateEscrow(ctx sdk.Context, sender, recipient string, amount s
 	if !params.EscrowEnabled {
 		return 0, errorsmod.Wrap(types.ErrFeatureDisabled, "escrow settlements are disabled")
 	}
-	if amount.IsLT(params.MinSettlementAmount) {
-		return 0, types.ErrSettlementTooSmall
-	}
-	if amount.IsGTE(params.MaxSettlementAmount) {
-		return 0, types.ErrSettlementTooLarge
+	if err := k.checkSettlementLimits(ctx, params, amount); err != nil {
+		return 0, err
 	}
 
 	// Validate escrow expiration
@@ -332,6 +379,7 @@ func (k Keeper) CreateEscrow(ctx sdk.Context, sender, recipient string, amount s
 
 	k.storeSettlement(ctx, settlement)
 	k.setNextSettlementID(ctx, nextID+1)
+	k.enqueueEscrowExpiry(ctx, expiresAt, nextID)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -363,6 +411,12 @@ func (k Keeper) ReleaseEscrow(ctx sdk.Context, settlementId uint64, sender sdk.A
 	if settlement.Status == types.SettlementStatusCancelled || settlement.Status == types.SettlementStatusRefunded {
 		return types.ErrSettlementCancelled
 	}
+	if _, found := k.GetMilestoneEscrow(ctx, settlementId); found {
+		return errorsmod.Wrap(types.ErrInvalidMilestone, "milestone escrows are released per milestone")
+	}
+	if k.underArbitration(ctx, settlementId) {
+		return errorsmod.Wrap(types.ErrEscrowUnderArbitration, "arbitrated escrows are released by approval")
+	}
 
 	// Only the original sender can release
 	expectedSender, err := sdk.AccAddressFromBech32(settlement.Sender)
@@ -383,8 +437,15 @@ func (k Keeper) ReleaseEscrow(ctx sdk.Context, settlementId uint64, sender sdk.A
 		return types.ErrComplianceCheckFailed
 	}
 
-	// Transfer net amount to recipient
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(settlement.NetAmount)); err != nil {
+	// Transfer net amount to recipient, or to every payee of a split checkout
+	if len(settlement.Legs) > 0 {
+		if err := k.assertPayeesCompliant(ctx, settlement.Legs); err != nil {
+			return err
+		}
+		if err := k.payLegs(ctx, settlement); err != nil {
+			return err
+		}
+	} else if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(settlement.NetAmount)); err != nil {
 		return err
 	}
 
@@ -430,6 +491,12 @@ func (k Keeper) RefundEscrow(ctx sdk.Context, settlementId uint64, recipient sdk
 	if settlement.Status == types.SettlementStatusRefunded {
 		return types.ErrSettlementCancelled
 	}
+	if _, found := k.GetMilestoneEscrow(ctx, settlementId); found {
+		return errorsmod.Wrap(types.ErrInvalidMilestone, "milestone escrows are refunded per milestone")
+	}
+	if k.underArbitration(ctx, settlementId) {
+		return errorsmod.Wrap(types.ErrEscrowUnderArbitration, "arbitrated escrows are refunded by approval")
+	}
 
 	// Only the recipient can initiate refund
 	expectedRecipient, err := sdk.AccAddressFromBech32(settlement.Recipient)
@@ -605,8 +672,6 @@ func (k Keeper) CreateBatch(ctx sdk.Context, merchant string, senders []string,
 
 // SettleBatch settles all payments in a batch
 func (k Keeper) SettleBatch(ctx sdk.Context, batchId uint64, authority string) error {
-	wrappedCtx := sdk.WrapSDKContext(ctx)
-
 	// Verify authority
 	if authority != k.GetAuthority() {
 		return types.ErrUnauthorized
@@ -621,6 +686,14 @@ func (k Keeper) SettleBatch(ctx sdk.Context, batchId uint64, authority string) e
 		return types.ErrBatchAlreadySettled
 	}
 
+	return k.settleBatch(ctx, batch, types.BatchTriggerAuthority)
+}
+
+// settleBatch pays out a pending batch to its merchant, collects its fees and
+// completes its settlements
+func (k Keeper) settleBatch(ctx sdk.Context, batch types.BatchSettlement, trigger string) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
 	merchantAddr, err := sdk.AccAddressFromBech32(batch.Merchant)
 	if err != nil {
 		return types.ErrInvalidRecipient
@@ -659,15 +732,19 @@ func (k Keeper) SettleBatch(ctx sdk.Context, batchId uint64, authority string) e
 	batch.SettledHeight = ctx.BlockHeight()
 	batch.SettledTime = ctx.BlockTime()
 	k.storeBatch(ctx, batch)
+	if batch.AutoSettle {
+		k.closeOpenBatch(ctx, batch)
+	}
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeBatchSettled,
-			sdk.NewAttribute(types.AttributeKeyBatchID, fmt.Sprintf("%d", batchId)),
+			sdk.NewAttribute(types.AttributeKeyBatchID, fmt.Sprintf("%d", batch.Id)),
 			sdk.NewAttribute(types.AttributeKeyMerchant, batch.Merchant),
 			sdk.NewAttribute(types.AttributeKeyAmount, batch.NetAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyFee, batch.TotalFees.String()),
+			sdk.NewAttribute(types.AttributeKeyTrigger, trigger),
 		),
 	)
 
@@ -756,6 +833,7 @@ func (k Keeper) OpenChannel(ctx sdk.Context, sender, recipient string, deposit s
 
 	k.storeChannel(ctx, channel)
 	k.setNextChannelID(ctx, channelID+1)
+	k.enqueueChannelExpiry(ctx, channel.ExpiresAtHeight, channelID)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -853,8 +931,7 @@ func (k Keeper) verifyChannelSignature(ctx sdk.Context, channel types.PaymentCha
 
 	// Construct the message that should have been signed
 	// Format: "channel_claim:{channelId}:{recipient}:{amount}:{nonce}"
-	msg := fmt.Sprintf("channel_claim:%d:%s:%s:%d", channel.Id, recipient.String(), amount.String(), nonce)
-	msgBytes := []byte(msg)
+	msgBytes := types.ChannelClaimSignBytes(channel.Id, recipient.String(), amount, nonce)
 
 	// Get sender's address
 	senderAddr, err := sdk.AccAddressFromBech32(channel.Sender)
@@ -958,6 +1035,9 @@ func (k Keeper) GetMerchant(ctx sdk.Context, address string) (types.MerchantConf
 
 // RegisterMerchant registers a new merchant
 func (k Keeper) RegisterMerchant(ctx sdk.Context, config types.MerchantConfig) error {
+	if err := k.validateSettlementDenom(ctx, config.SettlementDenom); err != nil {
+		return err
+	}
 	config.IsActive = true
 	config.RegisteredAt = ctx.BlockTime()
 	k.storeMerchant(ctx, config)
@@ -986,10 +1066,29 @@ func (k Keeper) UpdateMerchant(ctx sdk.Context, address string, updates map[stri
 			if enabled, ok := value.(bool); ok {
 				merchant.BatchEnabled = enabled
 			}
+		case "batch_threshold":
+			if threshold, ok := value.(sdk.Coin); ok {
+				merchant.BatchThreshold = threshold
+			}
+		case "batch_max_age":
+			if maxAge, ok := value.(time.Duration); ok {
+				merchant.BatchMaxAge = maxAge
+			}
 		case "is_active":
 			if active, ok := value.(bool); ok {
 				merchant.IsActive = active
 			}
+		case "settlement_delay":
+			if delay, ok := value.(time.Duration); ok {
+				merchant.SettlementDelay = delay
+			}
+		case "settlement_denom":
+			if denom, ok := value.(string); ok {
+				if err := k.validateSettlementDenom(ctx, denom); err != nil {
+					return err
+				}
+				merchant.SettlementDenom = denom
+			}
 		}
 	}
 
@@ -1017,6 +1116,31 @@ func (k Keeper) calculateFee(ctx sdk.Context, amount sdk.Coin, recipient string)
 	return sdk.NewCoin(amount.Denom, feeAmount)
 }
 
+// checkSettlementLimits bounds a settlement amount by the module's minimum and
+// maximum. The limits are set in ssusd; amounts in other denoms are valued in
+// ssusd at oracle prices before they are compared.
+func (k Keeper) checkSettlementLimits(ctx sdk.Context, params types.Params, amount sdk.Coin) error {
+	value := amount.Amount
+	if amount.Denom != types.StablecoinDenom {
+		if k.oracleKeeper == nil || k.stablecoinKeeper == nil {
+			return types.ErrConversionUnavailable.Wrapf("cannot value %s in %s", amount.Denom, types.StablecoinDenom)
+		}
+		price, err := k.usdPrice(ctx, amount.Denom)
+		if err != nil {
+			return err
+		}
+		value = price.MulInt(amount.Amount).TruncateInt()
+	}
+
+	if value.LT(params.MinSettlementAmount.Amount) {
+		return types.ErrSettlementTooSmall
+	}
+	if value.GTE(params.MaxSettlementAmount.Amount) {
+		return types.ErrSettlementTooLarge
+	}
+	return nil
+}
+
 // collectFee transfers the fee from the module account to the fee collector
 func (k Keeper) collectFee(ctx sdk.Context, fee sdk.Coin) error {
 	if !fee.IsPositive() {
@@ -1142,6 +1266,59 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	for _, merchant := range state.Merchants {
 		k.storeMerchant(ctx, merchant)
 	}
+	for _, arbitration := range state.EscrowArbitrations {
+		k.storeEscrowArbitration(ctx, arbitration)
+	}
+	for _, escrow := range state.MilestoneEscrows {
+		k.storeMilestoneEscrow(ctx, escrow)
+	}
+	for _, subscription := range state.Subscriptions {
+		k.storeSubscription(ctx, subscription)
+	}
+	if state.NextSubscriptionId > 0 {
+		k.setNextSubscriptionID(ctx, state.NextSubscriptionId)
+	}
+	for _, channel := range state.BidirectionalChannels {
+		k.storeBidirectionalChannel(ctx, channel)
+	}
+	if state.NextBidirectionalChannelId > 0 {
+		k.setNextBidirectionalChannelID(ctx, state.NextBidirectionalChannelId)
+	}
+	for _, htlc := range state.Htlcs {
+		k.storeHTLC(ctx, htlc)
+	}
+	if state.NextHtlcId > 0 {
+		k.setNextHTLCID(ctx, state.NextHtlcId)
+	}
+	for _, payout := range state.DelayedPayouts {
+		k.storeDelayedPayout(ctx, payout)
+	}
+	for _, cycle := range state.NettingCycles {
+		k.storeNettingCycle(ctx, cycle)
+	}
+	for _, obligation := range state.NettingObligations {
+		k.storeNettingObligation(ctx, obligation)
+	}
+	if state.NextNettingCycleId > 0 {
+		k.setNextNettingCycleID(ctx, state.NextNettingCycleId)
+	}
+	for _, template := range state.SplitTemplates {
+		k.storeSplitTemplate(ctx, template)
+	}
+	for _, mandate := range state.Mandates {
+		k.storeMandate(ctx, mandate)
+	}
+	if state.NextMandateId > 0 {
+		k.setNextMandateID(ctx, state.NextMandateId)
+	}
+	for _, stream := range state.Streams {
+		k.storeStream(ctx, stream)
+	}
+	if state.NextStreamId > 0 {
+		k.setNextStreamID(ctx, state.NextStreamId)
+	}
+
+	k.RebuildExpiryQueues(ctx)
 }
 
 // ExportGenesis exports the settlement module's genesis state
@@ -1168,6 +1345,56 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		state.Merchants = append(state.Merchants, m)
 		return false
 	})
+	k.IterateEscrowArbitrations(ctx, func(a types.EscrowArbitration) bool {
+		state.EscrowArbitrations = append(state.EscrowArbitrations, a)
+		return false
+	})
+	k.IterateMilestoneEscrows(ctx, func(m types.MilestoneEscrow) bool {
+		state.MilestoneEscrows = append(state.MilestoneEscrows, m)
+		return false
+	})
+	k.IterateSubscriptions(ctx, func(s types.Subscription) bool {
+		state.Subscriptions = append(state.Subscriptions, s)
+		return false
+	})
+	state.NextSubscriptionId = k.getNextSubscriptionID(ctx)
+	k.IterateBidirectionalChannels(ctx, func(c types.BidirectionalChannel) bool {
+		state.BidirectionalChannels = append(state.BidirectionalChannels, c)
+		return false
+	})
+	state.NextBidirectionalChannelId = k.getNextBidirectionalChannelID(ctx)
+	k.IterateHTLCs(ctx, func(h types.HTLC) bool {
+		state.Htlcs = append(state.Htlcs, h)
+		return false
+	})
+	state.NextHtlcId = k.getNextHTLCID(ctx)
+	k.IterateDelayedPayouts(ctx, func(p types.DelayedPayout) bool {
+		state.DelayedPayouts = append(state.DelayedPayouts, p)
+		return false
+	})
+	k.IterateNettingCycles(ctx, func(c types.NettingCycle) bool {
+		state.NettingCycles = append(state.NettingCycles, c)
+		return false
+	})
+	k.IterateNettingObligations(ctx, func(o types.NettingObligation) bool {
+		state.NettingObligations = append(state.NettingObligations, o)
+		return false
+	})
+	state.NextNettingCycleId = k.getNextNettingCycleID(ctx)
+	k.IterateSplitTemplates(ctx, func(t types.SplitTemplate) bool {
+		state.SplitTemplates = append(state.SplitTemplates, t)
+		return false
+	})
+	k.IterateMandates(ctx, func(m types.Mandate) bool {
+		state.Mandates = append(state.Mandates, m)
+		return false
+	})
+	state.NextMandateId = k.getNextMandateID(ctx)
+	k.IterateStreams(ctx, func(s types.Stream) bool {
+		state.Streams = append(state.Streams, s)
+		return false
+	})
+	state.NextStreamId = k.getNextStreamID(ctx)
 
 	return state
 }
@@ -1177,40 +1404,53 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 // ============================================================================
 
 // ProcessExpiredEscrows handles expired escrow settlements
-// When an escrow expires without being released, funds are returned to sender
+// When an escrow expires without being released, funds are returned to sender.
+// Only escrows queued at or before the block time are visited.
 func (k Keeper) ProcessExpiredEscrows(ctx sdk.Context) {
 	wrappedCtx := sdk.WrapSDKContext(ctx)
 	currentTime := ctx.BlockTime()
 
-	k.IterateSettlements(ctx, func(s types.Settlement) bool {
-		// Only process pending escrow settlements that have expired
-		if s.Type != types.SettlementTypeEscrow {
-			return false
+	for _, id := range k.dequeueDue(ctx, types.EscrowExpiryQueuePrefix, sdk.FormatTimeBytes(currentTime)) {
+		s, found := k.GetSettlement(ctx, id)
+		if !found {
+			continue
+		}
+		// Skip escrows settled since they were queued
+		if s.Type != types.SettlementTypeEscrow || s.Status != types.SettlementStatusPending {
+			continue
 		}
-		if s.Status != types.SettlementStatusPending {
-			return false
+		// Milestone escrows expire milestone by milestone
+		if milestones, found := k.GetMilestoneEscrow(ctx, s.Id); found {
+			k.processExpiredMilestones(ctx, s, milestones)
+			continue
 		}
 		if s.ExpiresAt.IsZero() || currentTime.Before(s.ExpiresAt) {
-			return false
+			continue
+		}
+		// Arbitrated escrows do not expire; only their parties' approvals resolve them
+		if k.underArbitration(ctx, s.Id) {
+			continue
 		}
 
 		// Escrow has expired - refund to sender
 		senderAddr, err := sdk.AccAddressFromBech32(s.Sender)
 		if err != nil {
-			return false // Skip invalid - should not happen
+			continue // Skip invalid - should not happen
 		}
 
 		// Refund the full amount to sender
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, senderAddr, sdk.NewCoins(s.Amount)); err != nil {
-			// Log error but continue processing
+			// Log error and retry next block
 			ctx.Logger().Error("failed to refund expired escrow", "settlement_id", s.Id, "error", err)
-			return false
+			k.enqueueEscrowExpiry(ctx, currentTime, s.Id)
+			continue
 		}
 
 		// Update settlement status
 		s.Status = types.SettlementStatusCancelled
 		s.Metadata = "expired: auto-refunded to sender"
 		k.storeSettlement(ctx, s)
+		k.expireCrossChainEscrow(ctx, s.Id)
 
 		// Emit event
 		ctx.EventManager().EmitEvent(
@@ -1221,26 +1461,19 @@ func (k Keeper) ProcessExpiredEscrows(ctx sdk.Context) {
 				sdk.NewAttribute(types.AttributeKeyAmount, s.Amount.String()),
 			),
 		)
-
-		return false // Continue iteration
-	})
+	}
 }
 
 // ProcessExpiredChannels handles expired payment channels
 // Note: This doesn't auto-close channels, it just marks them for close eligibility
-// Actual closing requires sender to submit a CloseChannel transaction
+// Actual closing requires sender to submit a CloseChannel transaction.
+// The event is emitted once, in the block the channel reaches its expiry height.
 func (k Keeper) ProcessExpiredChannels(ctx sdk.Context) {
-	currentHeight := ctx.BlockHeight()
-
-	k.IterateChannels(ctx, func(c types.PaymentChannel) bool {
-		// Only check open channels
-		if !c.IsOpen {
-			return false
-		}
-
-		// Check if channel has expired
-		if currentHeight < c.ExpiresAtHeight {
-			return false
+	for _, id := range k.dequeueDue(ctx, types.ChannelExpiryQueuePrefix, mustWriteUint64(uint64(ctx.BlockHeight()))) {
+		c, found := k.GetChannel(ctx, id)
+		// Only report channels that are still open
+		if !found || !c.IsOpen {
+			continue
 		}
 
 		// Emit event that channel is now closeable
@@ -1253,9 +1486,7 @@ func (k Keeper) ProcessExpiredChannels(ctx sdk.Context) {
 				sdk.NewAttribute(types.AttributeKeyAmount, c.Balance.String()),
 			),
 		)
-
-		return false // Continue iteration
-	})
+	}
 }
 
 // ============================================================================
@@ -1263,8 +1494,10 @@ func (k Keeper) ProcessExpiredChannels(ctx sdk.Context) {
 // ============================================================================
 
 // InstantCheckout performs a streamlined checkout combining compliance check and payment
-// This is optimized for ecommerce use cases where speed and simplicity are critical
-func (k Keeper) InstantCheckout(ctx sdk.Context, customer, merchant string, amount sdk.Coin, orderRef string, useEscrow bool, metadata string) (uint64, sdk.Coin, sdk.Coin, error) {
+// This is optimized for ecommerce use cases where speed and simplicity are critical.
+// The net amount is split between payees by the declared splits, otherwise by the
+// merchant's split template.
+func (k Keeper) InstantCheckout(ctx sdk.Context, customer, merchant string, amount sdk.Coin, orderRef string, useEscrow bool, metadata string, splitName string) (uint64, sdk.Coin, sdk.Coin, error) {
 	wrappedCtx := sdk.WrapSDKContext(ctx)
 
 	customerAddr, err := sdk.AccAddressFromBech32(customer)
@@ -1286,11 +1519,8 @@ func (k Keeper) InstantCheckout(ctx sdk.Context, customer, merchant string, amou
 
 	// Validate amount
 	params := k.GetParams(ctx)
-	if amount.IsLT(params.MinSettlementAmount) {
-		return 0, sdk.Coin{}, sdk.Coin{}, types.ErrSettlementTooSmall
-	}
-	if amount.IsGTE(params.MaxSettlementAmount) {
-		return 0, sdk.Coin{}, sdk.Coin{}, types.ErrSettlementTooLarge
+	if err := k.checkSettlementLimits(ctx, params, amount); err != nil {
+		return 0, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// Check balance
@@ -1303,17 +1533,34 @@ func (k Keeper) InstantCheckout(ctx sdk.Context, customer, merchant string, amou
 	fee := k.calculateFee(ctx, amount, merchant)
 	netAmount := sdk.NewCoin(amount.Denom, amount.Amount.Sub(fee.Amount))
 
+	rules, err := k.checkoutSplitRules(ctx, merchant, splitName)
+	if err != nil {
+		return 0, sdk.Coin{}, sdk.Coin{}, err
+	}
 	var settlementId uint64
 
 	if useEscrow {
-		// Create escrow for buyer protection
+		var legs []types.PayoutLeg
+		if len(rules) > 0 {
+			legs, err = k.splitLegs(ctx, merchant, netAmount, rules)
+			if err != nil {
+				return 0, sdk.Coin{}, sdk.Coin{}, err
+			}
+		}
+
+		// Create escrow for buyer protection; releasing it pays every leg
 		settlementId, err = k.CreateEscrow(ctx, customer, merchant, amount, orderRef, metadata, params.DefaultEscrowExpiration)
 		if err != nil {
 			return 0, sdk.Coin{}, sdk.Coin{}, err
 		}
+		if len(legs) > 0 {
+			settlement, _ := k.GetSettlement(ctx, settlementId)
+			settlement.Legs = legs
+			k.storeSettlement(ctx, settlement)
+		}
 	} else {
 		// Instant transfer for speed
-		settlementId, err = k.InstantTransfer(ctx, customer, merchant, amount, orderRef, metadata)
+		settlementId, err = k.instantTransfer(ctx, types.SettlementTypeInstant, customer, merchant, amount, orderRef, metadata, rules)
 		if err != nil {
 			return 0, sdk.Coin{}, sdk.Coin{}, err
 		}
@@ -1371,19 +1618,35 @@ func (k Keeper) PartialRefund(ctx sdk.Context, authority string, settlementId ui
 		return sdk.Coin{}, types.ErrInvalidSettlement
 	}
 
-	// Transfer refund from merchant to customer
-	if err := k.bankKeeper.SendCoins(wrappedCtx, merchantAddr, customerAddr, sdk.NewCoins(refundAmount)); err != nil {
+	// Refund from the payout still held by the module, otherwise from the
+	// merchant; a split checkout is refunded from every leg
+	remainingAmount := sdk.NewCoin(settlement.NetAmount.Denom, settlement.NetAmount.Amount.Sub(refundAmount.Amount))
+	recipientAmount := refundAmount
+	if len(settlement.Legs) > 0 {
+		remainingAmount, recipientAmount, err = k.refundLegs(ctx, &settlement, customerAddr, refundAmount)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if payout, found := k.GetDelayedPayout(ctx, settlementId); found && payout.Status.IsHeld() {
+		if err := k.refundHeldPayout(ctx, payout, customerAddr, refundAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err := k.bankKeeper.SendCoins(wrappedCtx, merchantAddr, customerAddr, sdk.NewCoins(refundAmount)); err != nil {
 		return sdk.Coin{}, types.ErrInsufficientFunds
 	}
 
-	// Calculate remaining amount
-	remainingAmount := sdk.NewCoin(settlement.NetAmount.Denom, settlement.NetAmount.Amount.Sub(refundAmount.Amount))
-
 	// Update settlement status if fully refunded
 	if remainingAmount.IsZero() {
 		settlement.Status = types.SettlementStatusRefunded
 	}
 	settlement.Metadata = fmt.Sprintf("partial_refund: %s - %s", refundAmount.String(), reason)
+	settlement.Refunds = append(settlement.Refunds, types.SettlementRefund{
+		Amount:          refundAmount,
+		RecipientAmount: recipientAmount,
+		Reason:          reason,
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+	})
 	k.storeSettlement(ctx, settlement)
 
 	// Emit event
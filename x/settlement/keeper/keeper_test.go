@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/log"
@@ -23,8 +24,11 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	oracletypes "github.com/stateset/core/x/oracle/types"
+	"github.com/stateset/core/x/settlement/client/voucher"
 	"github.com/stateset/core/x/settlement/keeper"
 	"github.com/stateset/core/x/settlement/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
 )
 
 var settlementConfigOnce sync.Once
@@ -75,7 +79,10 @@ func (m *mockBankKeeper) SendCoins(ctx context.Context, from, to sdk.AccAddress,
 	fromCoins := m.balances[from.String()]
 	toCoins := m.balances[to.String()]
 
-	newFrom, _ := fromCoins.SafeSub(amt...)
+	newFrom, hasNeg := fromCoins.SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
 	m.balances[from.String()] = newFrom
 	m.balances[to.String()] = toCoins.Add(amt...)
 	return nil
@@ -171,7 +178,83 @@ func (m *mockAccountKeeper) SetPubKey(addr sdk.AccAddress, pk cryptotypes.PubKey
 	m.pubKeys[addr.String()] = pk
 }
 
+// Mock oracle keeper
+type mockOracleKeeper struct {
+	prices map[string]sdkmath.LegacyDec
+	stale  map[string]bool
+}
+
+func newMockOracleKeeper() *mockOracleKeeper {
+	return &mockOracleKeeper{prices: make(map[string]sdkmath.LegacyDec), stale: make(map[string]bool)}
+}
+
+func (m *mockOracleKeeper) GetPriceDec(ctx context.Context, denom string) (sdkmath.LegacyDec, error) {
+	price, ok := m.prices[denom]
+	if !ok {
+		return sdkmath.LegacyDec{}, oracletypes.ErrPriceNotFound
+	}
+	return price, nil
+}
+
+func (m *mockOracleKeeper) GetPriceWithStalenessCheck(ctx context.Context, denom string) (oracletypes.Price, error) {
+	price, ok := m.prices[denom]
+	if !ok {
+		return oracletypes.Price{}, oracletypes.ErrPriceNotFound
+	}
+	if m.stale[denom] {
+		return oracletypes.Price{}, oracletypes.ErrPriceStale
+	}
+	return oracletypes.Price{Denom: denom, Amount: price}, nil
+}
+
+// Mock stablecoin keeper swapping PSM assets 1:1 less the configured fees
+type mockStablecoinKeeper struct {
+	bank    *mockBankKeeper
+	configs map[string]stablecointypes.PSMConfig
+}
+
+func newMockStablecoinKeeper(bank *mockBankKeeper) *mockStablecoinKeeper {
+	return &mockStablecoinKeeper{bank: bank, configs: make(map[string]stablecointypes.PSMConfig)}
+}
+
+func (m *mockStablecoinKeeper) GetPSMConfig(ctx sdk.Context, denom string) (stablecointypes.PSMConfig, bool) {
+	config, ok := m.configs[denom]
+	return config, ok
+}
+
+func (m *mockStablecoinKeeper) swap(sender sdk.AccAddress, in sdk.Coin, outDenom string, feeBps uint32) (sdkmath.Int, sdkmath.Int, error) {
+	balance := m.bank.balances[sender.String()]
+	if balance.AmountOf(in.Denom).LT(in.Amount) {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), fmt.Errorf("insufficient %s", in.Denom)
+	}
+	fee := in.Amount.MulRaw(int64(feeBps)).QuoRaw(10000)
+	out := in.Amount.Sub(fee)
+	m.bank.balances[sender.String()] = balance.Sub(in).Add(sdk.NewCoin(outDenom, out))
+	return out, fee, nil
+}
+
+func (m *mockStablecoinKeeper) PSMSwapIn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdkmath.Int, sdkmath.Int, error) {
+	config, ok := m.configs[amount.Denom]
+	if !ok || !config.Active {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), fmt.Errorf("PSM not configured for %s", amount.Denom)
+	}
+	return m.swap(sender, amount, types.StablecoinDenom, config.MintFeeBps)
+}
+
+func (m *mockStablecoinKeeper) PSMSwapOut(ctx sdk.Context, sender sdk.AccAddress, ssusdAmount sdkmath.Int, outputDenom string) (sdkmath.Int, sdkmath.Int, error) {
+	config, ok := m.configs[outputDenom]
+	if !ok || !config.Active {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), fmt.Errorf("PSM not configured for %s", outputDenom)
+	}
+	return m.swap(sender, sdk.NewCoin(types.StablecoinDenom, ssusdAmount), outputDenom, config.RedeemFeeBps)
+}
+
 func setupSettlementKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKeeper, *mockComplianceKeeper, *mockAccountKeeper) {
+	k, ctx, bankKeeper, complianceKeeper, accountKeeper, _, _ := setupSettlementKeeperWithConversion(t)
+	return k, ctx, bankKeeper, complianceKeeper, accountKeeper
+}
+
+func setupSettlementKeeperWithConversion(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKeeper, *mockComplianceKeeper, *mockAccountKeeper, *mockOracleKeeper, *mockStablecoinKeeper) {
 	t.Helper()
 	setupSettlementConfig()
 
@@ -188,6 +271,8 @@ func setupSettlementKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankK
 	bankKeeper := newMockBankKeeper()
 	complianceKeeper := newMockComplianceKeeper()
 	accountKeeper := newMockAccountKeeper()
+	oracleKeeper := newMockOracleKeeper()
+	stablecoinKeeper := newMockStablecoinKeeper(bankKeeper)
 
 	authority := newSettlementAddress()
 
@@ -197,6 +282,8 @@ func setupSettlementKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankK
 		bankKeeper,
 		complianceKeeper,
 		accountKeeper,
+		oracleKeeper,
+		stablecoinKeeper,
 		authority.String(),
 	)
 
@@ -205,7 +292,7 @@ func setupSettlementKeeper(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankK
 	// Initialize genesis
 	k.InitGenesis(ctx, types.DefaultGenesis())
 
-	return k, ctx, bankKeeper, complianceKeeper, accountKeeper
+	return k, ctx, bankKeeper, complianceKeeper, accountKeeper, oracleKeeper, stablecoinKeeper
 }
 
 func signChannelClaim(pk *secp256k1.PrivKey, channelId uint64, recipient sdk.AccAddress, amount sdk.Coin, nonce uint64) string {
@@ -440,6 +527,28 @@ func TestClaimChannel(t *testing.T) {
 	require.Equal(t, expectedBalance, channel.Balance)
 }
 
+func TestClaimChannel_Voucher(t *testing.T) {
+	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
+
+	senderKey, sender := newSettlementKeyPair()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(2000000))))
+	accountKeeper.SetPubKey(sender, senderKey.PubKey())
+
+	channelId, err := k.OpenChannel(ctx, sender.String(), recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(1000000)), 1000)
+	require.NoError(t, err)
+
+	// A voucher signed off chain is accepted as the claim signature
+	signed, err := voucher.Sign(voucher.New(channelId, recipient.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(250000)), 1), senderKey)
+	require.NoError(t, err)
+	channel, _ := k.GetChannel(ctx, channelId)
+	require.NoError(t, voucher.VerifyForChannel(signed, channel, senderKey.PubKey()))
+
+	_, err = keeper.NewMsgServerImpl(k).ClaimChannel(ctx, signed.Msg())
+	require.NoError(t, err)
+	require.Equal(t, signed.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd"))
+}
+
 func TestClaimChannel_InvalidNonce(t *testing.T) {
 	k, ctx, bankKeeper, _, accountKeeper := setupSettlementKeeper(t)
 
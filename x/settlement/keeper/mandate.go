@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Direct Debit Mandates
+// ============================================================================
+//
+// A mandate is a customer's standing authorization for a merchant to pull
+// payments from their account. Each pull is capped by the mandate's per-charge
+// maximum, and all pulls in a period together by its period cap. Unlike a
+// subscription the merchant decides when and how much to charge, which makes
+// mandates the building block for usage-based billing. Pulls run through the
+// instant transfer path, so both parties are re-checked for compliance and
+// every pull is recorded as a normal settlement. A mandate ends when it
+// expires or when either party revokes it.
+
+// CreateMandate records the customer's authorization of pull payments by the
+// merchant. A zero expiresIn creates a mandate that does not expire.
+func (k Keeper) CreateMandate(ctx sdk.Context, customer, merchant string, perChargeMax, periodCap sdk.Coin, period, expiresIn time.Duration, reference string) (types.Mandate, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	customerAddr, err := sdk.AccAddressFromBech32(customer)
+	if err != nil {
+		return types.Mandate{}, types.ErrInvalidSettlement
+	}
+	merchantAddr, err := sdk.AccAddressFromBech32(merchant)
+	if err != nil {
+		return types.Mandate{}, types.ErrInvalidRecipient
+	}
+	if customerAddr.Equals(merchantAddr) {
+		return types.Mandate{}, types.ErrInvalidRecipient.Wrap("customer and merchant must be different")
+	}
+	if err := types.ValidateMandateLimits(perChargeMax, periodCap, period, expiresIn, reference); err != nil {
+		return types.Mandate{}, err
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, customerAddr); err != nil {
+		return types.Mandate{}, types.ErrComplianceCheckFailed
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, merchantAddr); err != nil {
+		return types.Mandate{}, types.ErrComplianceCheckFailed
+	}
+
+	params := k.GetParams(ctx)
+	if !params.InstantTransfersEnabled {
+		return types.Mandate{}, errorsmod.Wrap(types.ErrFeatureDisabled, "instant transfers are disabled")
+	}
+	if perChargeMax.IsLT(params.MinSettlementAmount) {
+		return types.Mandate{}, types.ErrSettlementTooSmall
+	}
+
+	mandate := types.Mandate{
+		Id:           k.getNextMandateID(ctx),
+		Customer:     customer,
+		Merchant:     merchant,
+		PerChargeMax: perChargeMax,
+		PeriodCap:    periodCap,
+		Period:       period,
+		PeriodStart:  ctx.BlockTime(),
+		PeriodSpent:  sdk.NewCoin(periodCap.Denom, sdkmath.ZeroInt()),
+		Status:       types.MandateStatusActive,
+		Reference:    reference,
+		TotalPulled:  sdk.NewCoin(periodCap.Denom, sdkmath.ZeroInt()),
+		CreatedAt:    ctx.BlockTime(),
+	}
+	if expiresIn > 0 {
+		mandate.ExpiresAt = ctx.BlockTime().Add(expiresIn)
+	}
+	k.setNextMandateID(ctx, mandate.Id+1)
+	k.storeMandate(ctx, mandate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMandateCreated,
+			sdk.NewAttribute(types.AttributeKeyMandateID, fmt.Sprintf("%d", mandate.Id)),
+			sdk.NewAttribute(types.AttributeKeyCustomer, customer),
+			sdk.NewAttribute(types.AttributeKeyMerchant, merchant),
+			sdk.NewAttribute(types.AttributeKeyAmount, periodCap.String()),
+		),
+	)
+
+	return mandate, nil
+}
+
+// RevokeMandate stops further pulls. Either the customer or the merchant can
+// revoke.
+func (k Keeper) RevokeMandate(ctx sdk.Context, mandateId uint64, signer, reason string) error {
+	mandate, found := k.GetMandate(ctx, mandateId)
+	if !found {
+		return types.ErrMandateNotFound
+	}
+	if signer != mandate.Customer && signer != mandate.Merchant {
+		return types.ErrUnauthorized
+	}
+	if mandate.Status != types.MandateStatusActive {
+		return types.ErrMandateInactive
+	}
+
+	mandate.Status = types.MandateStatusRevoked
+	mandate.RevokedBy = signer
+	k.storeMandate(ctx, mandate)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMandateRevoked,
+			sdk.NewAttribute(types.AttributeKeyMandateID, fmt.Sprintf("%d", mandate.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, signer),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// PullPayment charges the customer of a mandate on behalf of its merchant. The
+// amount must fit within the per-charge maximum and what is left of the period
+// cap; a pull after the period has run out starts a new period. It returns the
+// settlement ID and what the merchant can still pull this period.
+func (k Keeper) PullPayment(ctx sdk.Context, mandateId uint64, merchant string, amount sdk.Coin, reference, metadata string) (uint64, sdk.Coin, error) {
+	mandate, found := k.GetMandate(ctx, mandateId)
+	if !found {
+		return 0, sdk.Coin{}, types.ErrMandateNotFound
+	}
+	if merchant != mandate.Merchant {
+		return 0, sdk.Coin{}, types.ErrUnauthorized
+	}
+	if mandate.Status != types.MandateStatusActive {
+		return 0, sdk.Coin{}, types.ErrMandateInactive.Wrapf("mandate %d is %s", mandate.Id, mandate.Status)
+	}
+	if amount.Denom != mandate.PerChargeMax.Denom {
+		return 0, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", mandate.PerChargeMax.Denom, amount.Denom)
+	}
+	if amount.IsGT(mandate.PerChargeMax) {
+		return 0, sdk.Coin{}, types.ErrMandateLimitExceeded.Wrapf("%s exceeds the per-charge maximum %s", amount, mandate.PerChargeMax)
+	}
+
+	mandate.RollPeriod(ctx.BlockTime())
+	if remaining := mandate.PeriodRemaining(); amount.IsGT(remaining) {
+		return 0, sdk.Coin{}, types.ErrMandateLimitExceeded.Wrapf("%s exceeds the %s left in the current period", amount, remaining)
+	}
+
+	if reference == "" {
+		reference = mandate.Reference
+	}
+	settlementId, err := k.instantTransfer(ctx, types.SettlementTypeDirectDebit, mandate.Customer, mandate.Merchant, amount, reference, metadata, nil)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	mandate.PeriodSpent = mandate.PeriodSpent.Add(amount)
+	mandate.TotalPulled = mandate.TotalPulled.Add(amount)
+	mandate.ChargeCount++
+	mandate.LastSettlementId = settlementId
+	k.storeMandate(ctx, mandate)
+
+	remaining := mandate.PeriodRemaining()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMandateCharged,
+			sdk.NewAttribute(types.AttributeKeyMandateID, fmt.Sprintf("%d", mandate.Id)),
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPeriodRemaining, remaining.String()),
+		),
+	)
+
+	return settlementId, remaining, nil
+}
+
+// withEffectiveStatus reports an active mandate past its expiry as expired.
+// Expiry is not processed in EndBlock; it takes effect when the mandate is
+// next read.
+func withEffectiveStatus(ctx sdk.Context, mandate types.Mandate) types.Mandate {
+	if mandate.Status == types.MandateStatusActive && mandate.IsExpired(ctx.BlockTime()) {
+		mandate.Status = types.MandateStatusExpired
+	}
+	return mandate
+}
+
+func (k Keeper) getNextMandateID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextMandateIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextMandateID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextMandateIDKey, mustWriteUint64(id))
+}
+
+func (k Keeper) storeMandate(ctx sdk.Context, mandate types.Mandate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MandateKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&mandate)
+	store.Set(mustWriteUint64(mandate.Id), bz)
+}
+
+// GetMandate retrieves a mandate by ID
+func (k Keeper) GetMandate(ctx sdk.Context, id uint64) (types.Mandate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MandateKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.Mandate{}, false
+	}
+	var mandate types.Mandate
+	types.ModuleCdc.MustUnmarshalJSON(bz, &mandate)
+	return withEffectiveStatus(ctx, mandate), true
+}
+
+// IterateMandates iterates over all mandates
+func (k Keeper) IterateMandates(ctx sdk.Context, cb func(types.Mandate) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MandateKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var mandate types.Mandate
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &mandate)
+		if cb(withEffectiveStatus(ctx, mandate)) {
+			break
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestMandate_PullPaymentWithinLimits(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	created, err := msgServer.CreateMandate(ctx, types.NewMsgCreateMandate(customer.String(), merchant.String(), ssusd(300000), ssusd(500000), 24*time.Hour, 0, "USAGE"))
+	require.NoError(t, err)
+
+	res, err := msgServer.PullPayment(ctx, types.NewMsgPullPayment(merchant.String(), created.MandateId, ssusd(300000), "", ""))
+	require.NoError(t, err)
+	require.Equal(t, ssusd(200000), res.PeriodRemaining)
+
+	settlement, found := k.GetSettlement(ctx, res.SettlementId)
+	require.True(t, found)
+	require.Equal(t, types.SettlementTypeDirectDebit, settlement.Type)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, "USAGE", settlement.Reference)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+
+	// A single charge is bounded by the per-charge maximum
+	_, err = msgServer.PullPayment(ctx, types.NewMsgPullPayment(merchant.String(), created.MandateId, ssusd(300001), "", ""))
+	require.ErrorIs(t, err, types.ErrMandateLimitExceeded)
+
+	// and all charges in a period by the period cap
+	_, err = msgServer.PullPayment(ctx, types.NewMsgPullPayment(merchant.String(), created.MandateId, ssusd(250000), "", ""))
+	require.ErrorIs(t, err, types.ErrMandateLimitExceeded)
+	res, err = msgServer.PullPayment(ctx, types.NewMsgPullPayment(merchant.String(), created.MandateId, ssusd(200000), "INV-2", ""))
+	require.NoError(t, err)
+	require.True(t, res.PeriodRemaining.IsZero())
+
+	mandate, _ := k.GetMandate(ctx, created.MandateId)
+	require.Equal(t, uint64(2), mandate.ChargeCount)
+	require.Equal(t, ssusd(500000), mandate.TotalPulled)
+	require.Equal(t, res.SettlementId, mandate.LastSettlementId)
+
+	// Only the mandate's merchant can pull
+	_, err = msgServer.PullPayment(ctx, types.NewMsgPullPayment(newSettlementAddress().String(), created.MandateId, ssusd(1000), "", ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestMandate_PeriodRollsOver(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+
+	mandate, err := k.CreateMandate(ctx, customer.String(), merchant.String(), ssusd(500000), ssusd(500000), time.Hour, 0, "")
+	require.NoError(t, err)
+	_, _, err = k.PullPayment(ctx, mandate.Id, merchant.String(), ssusd(500000), "", "")
+	require.NoError(t, err)
+	_, _, err = k.PullPayment(ctx.WithBlockTime(ctx.BlockTime().Add(59*time.Minute)), mandate.Id, merchant.String(), ssusd(1000), "", "")
+	require.ErrorIs(t, err, types.ErrMandateLimitExceeded)
+
+	// Periods are aligned to the creation time, so a pull 2.5 periods later
+	// counts against the period that started 2 periods later
+	laterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Minute))
+	_, remaining, err := k.PullPayment(laterCtx, mandate.Id, merchant.String(), ssusd(100000), "", "")
+	require.NoError(t, err)
+	require.Equal(t, ssusd(400000), remaining)
+
+	mandate, _ = k.GetMandate(ctx, mandate.Id)
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour), mandate.PeriodStart)
+	require.Equal(t, ssusd(100000), mandate.PeriodSpent)
+}
+
+func TestMandate_ExpiryAndRevocation(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+
+	expiring, err := k.CreateMandate(ctx, customer.String(), merchant.String(), ssusd(100000), ssusd(500000), time.Hour, 24*time.Hour, "")
+	require.NoError(t, err)
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	_, _, err = k.PullPayment(expiredCtx, expiring.Id, merchant.String(), ssusd(1000), "", "")
+	require.ErrorIs(t, err, types.ErrMandateInactive)
+	expiring, _ = k.GetMandate(expiredCtx, expiring.Id)
+	require.Equal(t, types.MandateStatusExpired, expiring.Status)
+
+	// A sanctioned customer cannot be charged
+	mandate, err := k.CreateMandate(ctx, customer.String(), merchant.String(), ssusd(100000), ssusd(500000), time.Hour, 0, "")
+	require.NoError(t, err)
+	complianceKeeper.sanctionedAddresses[customer.String()] = true
+	_, _, err = k.PullPayment(ctx, mandate.Id, merchant.String(), ssusd(1000), "", "")
+	require.ErrorIs(t, err, types.ErrComplianceCheckFailed)
+	delete(complianceKeeper.sanctionedAddresses, customer.String())
+
+	// Only the parties can revoke, and a revoked mandate cannot be charged
+	require.ErrorIs(t, k.RevokeMandate(ctx, mandate.Id, newSettlementAddress().String(), ""), types.ErrUnauthorized)
+	require.NoError(t, k.RevokeMandate(ctx, mandate.Id, customer.String(), "switched provider"))
+	mandate, _ = k.GetMandate(ctx, mandate.Id)
+	require.Equal(t, types.MandateStatusRevoked, mandate.Status)
+	require.Equal(t, customer.String(), mandate.RevokedBy)
+	_, _, err = k.PullPayment(ctx, mandate.Id, merchant.String(), ssusd(1000), "", "")
+	require.ErrorIs(t, err, types.ErrMandateInactive)
+	require.ErrorIs(t, k.RevokeMandate(ctx, mandate.Id, merchant.String(), ""), types.ErrMandateInactive)
+}
+
+func TestMandate_QueriesAndGenesis(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchantA := newSettlementAddress()
+	merchantB := newSettlementAddress()
+
+	for _, merchant := range []sdk.AccAddress{merchantA, merchantA, merchantB} {
+		_, err := k.CreateMandate(ctx, customer.String(), merchant.String(), ssusd(100000), ssusd(500000), time.Hour, 0, "")
+		require.NoError(t, err)
+	}
+
+	queryServer := keeper.NewQueryServerImpl(k)
+	byCustomer, err := queryServer.MandatesByCustomer(sdk.WrapSDKContext(ctx), &types.QueryMandatesByCustomerRequest{Customer: customer.String(), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, byCustomer.Mandates, 2)
+	require.Equal(t, uint64(3), byCustomer.Total)
+
+	byMerchant, err := queryServer.MandatesByMerchant(sdk.WrapSDKContext(ctx), &types.QueryMandatesByMerchantRequest{Merchant: merchantA.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), byMerchant.Total)
+
+	_, err = queryServer.Mandate(sdk.WrapSDKContext(ctx), &types.QueryMandateRequest{Id: 42})
+	require.ErrorIs(t, err, types.ErrMandateNotFound)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Mandates, 3)
+	require.Equal(t, uint64(4), genesis.NextMandateId)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	imported, found := k2.GetMandate(ctx2, 3)
+	require.True(t, found)
+	require.Equal(t, merchantB.String(), imported.Merchant)
+	mandate, err := k2.CreateMandate(ctx2, customer.String(), merchantB.String(), ssusd(100000), ssusd(500000), time.Hour, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), mandate.Id)
+}
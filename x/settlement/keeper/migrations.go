@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 populates the escrow, channel and subscription expiry queues from
+// the existing records
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RebuildExpiryQueues(ctx)
+	return nil
+}
+
+// Migrate2to3 backfills the settlement sender, recipient and reference indexes
+// and the merchant payout index
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.RebuildSettlementIndexes(ctx)
+	m.keeper.RebuildPayoutIndex(ctx)
+	return nil
+}
@@ -0,0 +1,332 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Milestone Escrow
+// ============================================================================
+
+// CreateMilestoneEscrow creates an escrow settlement funded with the sum of the
+// milestone amounts. Each milestone is released or refunded independently, and
+// fees are charged per milestone when it is released.
+func (k Keeper) CreateMilestoneEscrow(ctx sdk.Context, sender, recipient string, inputs []types.MilestoneInput, reference, metadata string, expirationSeconds int64) (uint64, error) {
+	if len(inputs) == 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidMilestone, "at least one milestone is required")
+	}
+	if len(inputs) > types.MaxEscrowMilestones {
+		return 0, errorsmod.Wrapf(types.ErrInvalidMilestone, "at most %d milestones allowed", types.MaxEscrowMilestones)
+	}
+
+	if expirationSeconds <= 0 {
+		expirationSeconds = k.GetParams(ctx).DefaultEscrowExpiration
+	}
+	expiresAt := ctx.BlockTime().Add(time.Duration(expirationSeconds) * time.Second)
+
+	total := sdk.NewCoin(types.StablecoinDenom, sdkmath.ZeroInt())
+	milestones := make([]types.Milestone, len(inputs))
+	for i, input := range inputs {
+		if input.Amount.Denom != types.StablecoinDenom {
+			return 0, errorsmod.Wrapf(types.ErrInvalidDenom, "milestone %d: expected %s, got %s", i, types.StablecoinDenom, input.Amount.Denom)
+		}
+		if !input.Amount.IsPositive() {
+			return 0, errorsmod.Wrapf(types.ErrInvalidAmount, "milestone %d amount must be positive", i)
+		}
+		if !input.Deadline.IsZero() {
+			if !input.Deadline.After(ctx.BlockTime()) {
+				return 0, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone %d deadline must be in the future", i)
+			}
+			if input.Deadline.After(expiresAt) {
+				return 0, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone %d deadline is after the escrow expiration", i)
+			}
+		}
+
+		total = total.Add(input.Amount)
+		milestones[i] = types.Milestone{
+			Description: input.Description,
+			Amount:      input.Amount,
+			Deadline:    input.Deadline,
+			Status:      types.SettlementStatusPending,
+			Fee:         sdk.NewCoin(input.Amount.Denom, sdkmath.ZeroInt()),
+			NetAmount:   sdk.NewCoin(input.Amount.Denom, sdkmath.ZeroInt()),
+		}
+	}
+
+	settlementId, err := k.CreateEscrow(ctx, sender, recipient, total, reference, metadata, expirationSeconds)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fees are charged per milestone as they are released
+	settlement, _ := k.GetSettlement(ctx, settlementId)
+	settlement.Fee = sdk.NewCoin(total.Denom, sdkmath.ZeroInt())
+	settlement.NetAmount = sdk.NewCoin(total.Denom, sdkmath.ZeroInt())
+	k.storeSettlement(ctx, settlement)
+
+	k.storeMilestoneEscrow(ctx, types.MilestoneEscrow{
+		SettlementId: settlementId,
+		Milestones:   milestones,
+	})
+	k.scheduleEscrow(ctx, settlement)
+
+	return settlementId, nil
+}
+
+// ReleaseMilestone releases a single milestone to the recipient. Only the escrow
+// sender can release.
+func (k Keeper) ReleaseMilestone(ctx sdk.Context, settlementId uint64, index uint32, sender sdk.AccAddress) (sdk.Coin, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	settlement, escrow, milestone, err := k.pendingMilestone(ctx, settlementId, index)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	expectedSender, err := sdk.AccAddressFromBech32(settlement.Sender)
+	if err != nil {
+		return sdk.Coin{}, types.ErrInvalidSettlement
+	}
+	if !expectedSender.Equals(sender) {
+		return sdk.Coin{}, types.ErrUnauthorized
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(settlement.Recipient)
+	if err != nil {
+		return sdk.Coin{}, types.ErrInvalidRecipient
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+		return sdk.Coin{}, types.ErrComplianceCheckFailed
+	}
+
+	fee := k.calculateFee(ctx, milestone.Amount, settlement.Recipient)
+	netAmount := milestone.Amount.Sub(fee)
+
+	if netAmount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(netAmount)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	if fee.IsPositive() {
+		if err := k.collectFee(ctx, fee); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	milestone.Fee = fee
+	milestone.NetAmount = netAmount
+	k.settleMilestone(ctx, &settlement, &escrow, index, milestone, types.SettlementStatusCompleted)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMilestoneReleased,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyMilestone, fmt.Sprintf("%d", index)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, settlement.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, netAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return netAmount, nil
+}
+
+// RefundMilestone returns a single milestone to the sender. Only the escrow
+// recipient can refund.
+func (k Keeper) RefundMilestone(ctx sdk.Context, settlementId uint64, index uint32, recipient sdk.AccAddress, reason string) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	settlement, escrow, milestone, err := k.pendingMilestone(ctx, settlementId, index)
+	if err != nil {
+		return err
+	}
+
+	expectedRecipient, err := sdk.AccAddressFromBech32(settlement.Recipient)
+	if err != nil {
+		return types.ErrInvalidRecipient
+	}
+	if !expectedRecipient.Equals(recipient) {
+		return types.ErrUnauthorized
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(settlement.Sender)
+	if err != nil {
+		return types.ErrInvalidSettlement
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, senderAddr); err != nil {
+		return types.ErrComplianceCheckFailed
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, senderAddr, sdk.NewCoins(milestone.Amount)); err != nil {
+		return err
+	}
+
+	k.settleMilestone(ctx, &settlement, &escrow, index, milestone, types.SettlementStatusRefunded)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMilestoneRefunded,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+			sdk.NewAttribute(types.AttributeKeyMilestone, fmt.Sprintf("%d", index)),
+			sdk.NewAttribute(types.AttributeKeySender, settlement.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, milestone.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return nil
+}
+
+// processExpiredMilestones refunds the pending milestones of an escrow whose
+// deadline (or the escrow expiration, when unset) has passed
+func (k Keeper) processExpiredMilestones(ctx sdk.Context, settlement types.Settlement, escrow types.MilestoneEscrow) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	currentTime := ctx.BlockTime()
+
+	senderAddr, err := sdk.AccAddressFromBech32(settlement.Sender)
+	if err != nil {
+		return // Skip invalid - should not happen
+	}
+
+	for i, milestone := range escrow.Milestones {
+		if milestone.Status != types.SettlementStatusPending {
+			continue
+		}
+		deadline := milestone.Deadline
+		if deadline.IsZero() {
+			deadline = settlement.ExpiresAt
+		}
+		if deadline.IsZero() || currentTime.Before(deadline) {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, senderAddr, sdk.NewCoins(milestone.Amount)); err != nil {
+			ctx.Logger().Error("failed to refund expired milestone", "settlement_id", settlement.Id, "milestone", i, "error", err)
+			k.enqueueEscrowExpiry(ctx, currentTime, settlement.Id)
+			continue
+		}
+
+		k.settleMilestone(ctx, &settlement, &escrow, uint32(i), milestone, types.SettlementStatusCancelled)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMilestoneExpired,
+				sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
+				sdk.NewAttribute(types.AttributeKeyMilestone, fmt.Sprintf("%d", i)),
+				sdk.NewAttribute(types.AttributeKeySender, settlement.Sender),
+				sdk.NewAttribute(types.AttributeKeyAmount, milestone.Amount.String()),
+			),
+		)
+	}
+}
+
+// pendingMilestone loads a milestone that can still be released or refunded
+func (k Keeper) pendingMilestone(ctx sdk.Context, settlementId uint64, index uint32) (types.Settlement, types.MilestoneEscrow, types.Milestone, error) {
+	settlement, found := k.GetSettlement(ctx, settlementId)
+	if !found {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, types.ErrSettlementNotFound
+	}
+	if settlement.Status == types.SettlementStatusCompleted {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, types.ErrSettlementCompleted
+	}
+	if settlement.Status != types.SettlementStatusPending {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, types.ErrSettlementCancelled
+	}
+
+	escrow, found := k.GetMilestoneEscrow(ctx, settlementId)
+	if !found {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, errorsmod.Wrap(types.ErrInvalidMilestone, "settlement is not a milestone escrow")
+	}
+	if int(index) >= len(escrow.Milestones) {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, errorsmod.Wrapf(types.ErrMilestoneNotFound, "milestone %d", index)
+	}
+
+	milestone := escrow.Milestones[index]
+	if milestone.Status != types.SettlementStatusPending {
+		return types.Settlement{}, types.MilestoneEscrow{}, types.Milestone{}, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone %d is %s", index, milestone.Status)
+	}
+	return settlement, escrow, milestone, nil
+}
+
+// settleMilestone records the outcome of a milestone and, once every milestone is
+// settled, closes the escrow settlement. The settlement is completed if any
+// milestone was released, otherwise refunded or cancelled.
+func (k Keeper) settleMilestone(ctx sdk.Context, settlement *types.Settlement, escrow *types.MilestoneEscrow, index uint32, milestone types.Milestone, status types.SettlementStatus) {
+	milestone.Status = status
+	milestone.SettledHeight = ctx.BlockHeight()
+	milestone.SettledTime = ctx.BlockTime()
+	escrow.Milestones[index] = milestone
+
+	if status == types.SettlementStatusCompleted {
+		settlement.Fee = settlement.Fee.Add(milestone.Fee)
+		settlement.NetAmount = settlement.NetAmount.Add(milestone.NetAmount)
+	}
+
+	var pending, released, refunded bool
+	for _, m := range escrow.Milestones {
+		switch m.Status {
+		case types.SettlementStatusPending:
+			pending = true
+		case types.SettlementStatusCompleted:
+			released = true
+		case types.SettlementStatusRefunded:
+			refunded = true
+		}
+	}
+
+	if !pending {
+		switch {
+		case released:
+			settlement.Status = types.SettlementStatusCompleted
+		case refunded:
+			settlement.Status = types.SettlementStatusRefunded
+		default:
+			settlement.Status = types.SettlementStatusCancelled
+		}
+		settlement.SettledHeight = ctx.BlockHeight()
+		settlement.SettledTime = ctx.BlockTime()
+	}
+
+	k.storeSettlement(ctx, *settlement)
+	k.storeMilestoneEscrow(ctx, *escrow)
+}
+
+func (k Keeper) storeMilestoneEscrow(ctx sdk.Context, escrow types.MilestoneEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MilestoneEscrowKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&escrow)
+	store.Set(mustWriteUint64(escrow.SettlementId), bz)
+}
+
+// GetMilestoneEscrow retrieves the milestones of an escrow settlement
+func (k Keeper) GetMilestoneEscrow(ctx sdk.Context, settlementId uint64) (types.MilestoneEscrow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MilestoneEscrowKeyPrefix)
+	bz := store.Get(mustWriteUint64(settlementId))
+	if len(bz) == 0 {
+		return types.MilestoneEscrow{}, false
+	}
+	var escrow types.MilestoneEscrow
+	types.ModuleCdc.MustUnmarshalJSON(bz, &escrow)
+	return escrow, true
+}
+
+// IterateMilestoneEscrows iterates over all milestone escrows
+func (k Keeper) IterateMilestoneEscrows(ctx sdk.Context, cb func(types.MilestoneEscrow) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MilestoneEscrowKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.MilestoneEscrow
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// createMilestoneEscrow funds a sender and creates a three-milestone escrow of
+// 200k, 300k and 500k ssusd to a new recipient. The first two milestones have
+// deadlines one and two hours out; the last falls back to the escrow
+// expiration one day out.
+func createMilestoneEscrow(t *testing.T, k keeper.Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper) (uint64, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	sender := newSettlementAddress()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	milestones := []types.MilestoneInput{
+		{Description: "design", Amount: sdk.NewCoin("ssusd", sdkmath.NewInt(200000)), Deadline: ctx.BlockTime().Add(time.Hour)},
+		{Description: "build", Amount: sdk.NewCoin("ssusd", sdkmath.NewInt(300000)), Deadline: ctx.BlockTime().Add(2 * time.Hour)},
+		{Description: "launch", Amount: sdk.NewCoin("ssusd", sdkmath.NewInt(500000))},
+	}
+	id, err := k.CreateMilestoneEscrow(ctx, sender.String(), recipient.String(), milestones, "PROJECT-1", "", 86400)
+	require.NoError(t, err)
+	return id, sender, recipient
+}
+
+func TestCreateMilestoneEscrow(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, _ := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	settlement, found := k.GetSettlement(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.SettlementTypeEscrow, settlement.Type)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Equal(t, sdkmath.NewInt(1000000), settlement.Amount.Amount)
+	require.True(t, settlement.Fee.IsZero())
+	require.True(t, bankKeeper.GetBalance(ctx, sender, "ssusd").IsZero())
+
+	escrow, found := k.GetMilestoneEscrow(ctx, id)
+	require.True(t, found)
+	require.Len(t, escrow.Milestones, 3)
+	for _, m := range escrow.Milestones {
+		require.Equal(t, types.SettlementStatusPending, m.Status)
+	}
+}
+
+func TestCreateMilestoneEscrow_InvalidDeadline(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	sender := newSettlementAddress()
+	bankKeeper.SetBalance(sender.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	// Deadline beyond the escrow expiration
+	_, err := k.CreateMilestoneEscrow(ctx, sender.String(), newSettlementAddress().String(), []types.MilestoneInput{
+		{Amount: sdk.NewCoin("ssusd", sdkmath.NewInt(1000)), Deadline: ctx.BlockTime().Add(48 * time.Hour)},
+	}, "", "", 86400)
+	require.ErrorIs(t, err, types.ErrInvalidMilestone)
+
+	// Deadline in the past
+	_, err = k.CreateMilestoneEscrow(ctx, sender.String(), newSettlementAddress().String(), []types.MilestoneInput{
+		{Amount: sdk.NewCoin("ssusd", sdkmath.NewInt(1000)), Deadline: ctx.BlockTime().Add(-time.Hour)},
+	}, "", "", 86400)
+	require.ErrorIs(t, err, types.ErrInvalidMilestone)
+}
+
+func TestReleaseMilestone(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	netAmount, err := k.ReleaseMilestone(ctx, id, 0, sender)
+	require.NoError(t, err)
+	require.Equal(t, netAmount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+
+	escrow, _ := k.GetMilestoneEscrow(ctx, id)
+	released := escrow.Milestones[0]
+	require.Equal(t, types.SettlementStatusCompleted, released.Status)
+	require.True(t, released.Fee.IsPositive())
+	require.Equal(t, released.Amount, released.Fee.Add(released.NetAmount))
+
+	// The escrow stays open while milestones are pending and tracks fees so far
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Equal(t, released.Fee, settlement.Fee)
+	require.Equal(t, released.NetAmount, settlement.NetAmount)
+
+	// Milestones cannot be released twice
+	_, err = k.ReleaseMilestone(ctx, id, 0, sender)
+	require.ErrorIs(t, err, types.ErrInvalidMilestone)
+
+	// Only the sender can release
+	_, err = k.ReleaseMilestone(ctx, id, 1, recipient)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = k.ReleaseMilestone(ctx, id, 5, sender)
+	require.ErrorIs(t, err, types.ErrMilestoneNotFound)
+}
+
+func TestRefundMilestone(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	// Only the recipient can refund
+	require.ErrorIs(t, k.RefundMilestone(ctx, id, 1, sender, "scope cut"), types.ErrUnauthorized)
+
+	require.NoError(t, k.RefundMilestone(ctx, id, 1, recipient, "scope cut"))
+	require.Equal(t, sdkmath.NewInt(300000), bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+
+	escrow, _ := k.GetMilestoneEscrow(ctx, id)
+	require.Equal(t, types.SettlementStatusRefunded, escrow.Milestones[1].Status)
+	require.True(t, escrow.Milestones[1].Fee.IsZero())
+}
+
+func TestMilestoneEscrow_CompletesWhenAllSettled(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	_, err := k.ReleaseMilestone(ctx, id, 0, sender)
+	require.NoError(t, err)
+	require.NoError(t, k.RefundMilestone(ctx, id, 1, recipient, ""))
+	_, err = k.ReleaseMilestone(ctx, id, 2, sender)
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, recipient, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(700000), settlement.Fee.Add(settlement.NetAmount).Amount)
+
+	_, err = k.ReleaseMilestone(ctx, id, 1, sender)
+	require.ErrorIs(t, err, types.ErrSettlementCompleted)
+}
+
+func TestMilestoneEscrow_WholeEscrowActionsBlocked(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	require.ErrorIs(t, k.ReleaseEscrow(ctx, id, sender), types.ErrInvalidMilestone)
+	require.ErrorIs(t, k.RefundEscrow(ctx, id, recipient, ""), types.ErrInvalidMilestone)
+}
+
+func TestProcessExpiredEscrows_Milestones(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, _ := createMilestoneEscrow(t, k, ctx, bankKeeper)
+
+	_, err := k.ReleaseMilestone(ctx, id, 0, sender)
+	require.NoError(t, err)
+
+	// Past the second deadline: only the pending second milestone is refunded
+	start := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	k.ProcessExpiredEscrows(ctx)
+
+	escrow, _ := k.GetMilestoneEscrow(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, escrow.Milestones[0].Status)
+	require.Equal(t, types.SettlementStatusCancelled, escrow.Milestones[1].Status)
+	require.Equal(t, types.SettlementStatusPending, escrow.Milestones[2].Status)
+	require.Equal(t, sdkmath.NewInt(300000), bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+
+	// Past the escrow expiration: the last milestone is refunded and the escrow closes
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Hour))
+	k.ProcessExpiredEscrows(ctx)
+
+	escrow, _ = k.GetMilestoneEscrow(ctx, id)
+	require.Equal(t, types.SettlementStatusCancelled, escrow.Milestones[2].Status)
+	require.Equal(t, sdkmath.NewInt(800000), bankKeeper.GetBalance(ctx, sender, "ssusd").Amount)
+
+	settlement, _ = k.GetSettlement(ctx, id)
+	require.Equal(t, types.SettlementStatusCompleted, settlement.Status)
+}
+
+func TestMilestoneEscrowGenesis(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, _ := createMilestoneEscrow(t, k, ctx, bankKeeper)
+	_, err := k.ReleaseMilestone(ctx, id, 0, sender)
+	require.NoError(t, err)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.MilestoneEscrows, 1)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	escrow, found := k2.GetMilestoneEscrow(ctx2, id)
+	require.True(t, found)
+	require.Len(t, escrow.Milestones, 3)
+	require.Equal(t, types.SettlementStatusCompleted, escrow.Milestones[0].Status)
+}
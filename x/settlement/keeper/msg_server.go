@@ -28,11 +28,17 @@ func (m msgServer) InstantTransfer(goCtx context.Context, msg *types.MsgInstantT
 		return nil, err
 	}
 
-	settlementId, err := m.Keeper.InstantTransfer(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.Reference, msg.Metadata)
+	amount, conversion, err := m.Keeper.ConvertPayment(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.MaxSlippageBps)
 	if err != nil {
 		return nil, err
 	}
 
+	settlementId, err := m.Keeper.InstantTransfer(ctx, msg.Sender, msg.Recipient, amount, msg.Reference, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	m.Keeper.recordConversion(ctx, settlementId, conversion)
+
 	return &types.MsgInstantTransferResponse{
 		SettlementId: settlementId,
 		TxHash:       "", // Will be filled by client
@@ -50,11 +56,17 @@ func (m msgServer) CreateEscrow(goCtx context.Context, msg *types.MsgCreateEscro
 	// Convert duration to seconds
 	expirationSeconds := int64(msg.ExpiresIn.Seconds())
 
-	settlementId, err := m.Keeper.CreateEscrow(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.Reference, msg.Metadata, expirationSeconds)
+	amount, conversion, err := m.Keeper.ConvertPayment(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.MaxSlippageBps)
 	if err != nil {
 		return nil, err
 	}
 
+	settlementId, err := m.Keeper.CreateEscrow(ctx, msg.Sender, msg.Recipient, amount, msg.Reference, msg.Metadata, expirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+	m.Keeper.recordConversion(ctx, settlementId, conversion)
+
 	// Get the settlement to return the calculated expiration
 	settlement, _ := m.Keeper.GetSettlement(ctx, settlementId)
 
@@ -228,18 +240,25 @@ func (m msgServer) RegisterMerchant(goCtx context.Context, msg *types.MsgRegiste
 	if msg.Authority != msg.Merchant && msg.Authority != m.Keeper.GetAuthority() {
 		return nil, types.ErrUnauthorized
 	}
+	// The settlement delay protects customers, so merchants cannot choose their own
+	if msg.SettlementDelay != 0 && msg.Authority != m.Keeper.GetAuthority() {
+		return nil, types.ErrUnauthorized.Wrap("only the module authority can set a settlement delay")
+	}
 
 	config := types.MerchantConfig{
-		Address:        msg.Merchant,
-		Name:           msg.Name,
-		FeeRateBps:     msg.FeeRateBps,
-		MinSettlement:  msg.MinSettlement,
-		MaxSettlement:  msg.MaxSettlement,
-		BatchEnabled:   msg.BatchEnabled,
-		BatchThreshold: msg.BatchThreshold,
-		WebhookUrl:     msg.WebhookUrl,
-		IsActive:       true,
-		RegisteredAt:   time.Time{}, // Will be set in keeper
+		Address:         msg.Merchant,
+		Name:            msg.Name,
+		FeeRateBps:      msg.FeeRateBps,
+		MinSettlement:   msg.MinSettlement,
+		MaxSettlement:   msg.MaxSettlement,
+		BatchEnabled:    msg.BatchEnabled,
+		BatchThreshold:  msg.BatchThreshold,
+		WebhookUrl:      msg.WebhookUrl,
+		SettlementDelay: msg.SettlementDelay,
+		BatchMaxAge:     msg.BatchMaxAge,
+		SettlementDenom: msg.SettlementDenom,
+		IsActive:        true,
+		RegisteredAt:    time.Time{}, // Will be set in keeper
 	}
 
 	if err := m.Keeper.RegisterMerchant(ctx, config); err != nil {
@@ -273,9 +292,24 @@ func (m msgServer) UpdateMerchant(goCtx context.Context, msg *types.MsgUpdateMer
 	if msg.BatchEnabled != nil {
 		updates["batch_enabled"] = *msg.BatchEnabled
 	}
+	if msg.BatchThreshold.Denom != "" {
+		updates["batch_threshold"] = msg.BatchThreshold
+	}
+	if msg.BatchMaxAge != nil {
+		updates["batch_max_age"] = *msg.BatchMaxAge
+	}
 	if msg.IsActive != nil {
 		updates["is_active"] = *msg.IsActive
 	}
+	if msg.SettlementDenom != "" {
+		updates["settlement_denom"] = msg.SettlementDenom
+	}
+	if msg.SettlementDelay != nil {
+		if msg.Authority != m.Keeper.GetAuthority() {
+			return nil, types.ErrUnauthorized.Wrap("only the module authority can set a settlement delay")
+		}
+		updates["settlement_delay"] = *msg.SettlementDelay
+	}
 
 	if err := m.Keeper.UpdateMerchant(ctx, msg.Merchant, updates); err != nil {
 		return nil, err
@@ -292,18 +326,25 @@ func (m msgServer) InstantCheckout(goCtx context.Context, msg *types.MsgInstantC
 		return nil, err
 	}
 
+	amount, conversion, err := m.Keeper.ConvertPayment(ctx, msg.Customer, msg.Merchant, msg.Amount, msg.MaxSlippageBps)
+	if err != nil {
+		return nil, err
+	}
+
 	settlementId, netAmount, fee, err := m.Keeper.InstantCheckout(
 		ctx,
 		msg.Customer,
 		msg.Merchant,
-		msg.Amount,
+		amount,
 		msg.OrderReference,
 		msg.UseEscrow,
 		msg.Metadata,
+		msg.SplitName,
 	)
 	if err != nil {
 		return nil, err
 	}
+	m.Keeper.recordConversion(ctx, settlementId, conversion)
 
 	status := "completed"
 	if msg.UseEscrow {
@@ -342,3 +383,519 @@ func (m msgServer) PartialRefund(goCtx context.Context, msg *types.MsgPartialRef
 		RemainingAmount: remainingAmount,
 	}, nil
 }
+
+// CreateMultiPartyEscrow creates an escrow resolved by M-of-N approval
+func (m msgServer) CreateMultiPartyEscrow(goCtx context.Context, msg *types.MsgCreateMultiPartyEscrow) (*types.MsgCreateMultiPartyEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	settlementId, err := m.Keeper.CreateMultiPartyEscrow(
+		ctx,
+		msg.Sender,
+		msg.Recipient,
+		msg.Amount,
+		msg.Arbiters,
+		msg.Threshold,
+		msg.Reference,
+		msg.Metadata,
+		int64(msg.ExpiresIn.Seconds()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, _ := m.Keeper.GetSettlement(ctx, settlementId)
+
+	return &types.MsgCreateMultiPartyEscrowResponse{
+		SettlementId: settlementId,
+		ExpiresAt:    settlement.ExpiresAt,
+	}, nil
+}
+
+// ApproveEscrowResolution records a party's approval to release, refund or split a multi-party escrow
+func (m msgServer) ApproveEscrowResolution(goCtx context.Context, msg *types.MsgApproveEscrowResolution) (*types.MsgApproveEscrowResolutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	approvals, resolved, err := m.Keeper.ApproveEscrowResolution(ctx, msg.SettlementId, msg.Signer, msg.Resolution, msg.RecipientAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveEscrowResolutionResponse{
+		Approvals: approvals,
+		Resolved:  resolved,
+	}, nil
+}
+
+// CreateMilestoneEscrow creates an escrow released milestone by milestone
+func (m msgServer) CreateMilestoneEscrow(goCtx context.Context, msg *types.MsgCreateMilestoneEscrow) (*types.MsgCreateMilestoneEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	settlementId, err := m.Keeper.CreateMilestoneEscrow(ctx, msg.Sender, msg.Recipient, msg.Milestones, msg.Reference, msg.Metadata, int64(msg.ExpiresIn.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, _ := m.Keeper.GetSettlement(ctx, settlementId)
+
+	return &types.MsgCreateMilestoneEscrowResponse{
+		SettlementId: settlementId,
+		ExpiresAt:    settlement.ExpiresAt,
+	}, nil
+}
+
+// ReleaseMilestone releases a single milestone to the recipient
+func (m msgServer) ReleaseMilestone(goCtx context.Context, msg *types.MsgReleaseMilestone) (*types.MsgReleaseMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, types.ErrInvalidSettlement
+	}
+
+	netAmount, err := m.Keeper.ReleaseMilestone(ctx, msg.SettlementId, msg.Milestone, senderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseMilestoneResponse{NetAmount: netAmount}, nil
+}
+
+// RefundMilestone refunds a single milestone to the sender
+func (m msgServer) RefundMilestone(goCtx context.Context, msg *types.MsgRefundMilestone) (*types.MsgRefundMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, types.ErrInvalidSettlement
+	}
+
+	if err := m.Keeper.RefundMilestone(ctx, msg.SettlementId, msg.Milestone, recipientAddr, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundMilestoneResponse{}, nil
+}
+
+// CreateSubscription authorizes a recurring payment to a merchant
+func (m msgServer) CreateSubscription(goCtx context.Context, msg *types.MsgCreateSubscription) (*types.MsgCreateSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	subscription, err := m.Keeper.CreateSubscription(ctx, msg.Payer, msg.Merchant, msg.Amount, msg.Interval, msg.MaxCycles, msg.GracePeriod, msg.Reference, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSubscriptionResponse{
+		SubscriptionId: subscription.Id,
+		NextDue:        subscription.NextDue,
+	}, nil
+}
+
+// CancelSubscription cancels a subscription on behalf of the payer or merchant
+func (m msgServer) CancelSubscription(goCtx context.Context, msg *types.MsgCancelSubscription) (*types.MsgCancelSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CancelSubscription(ctx, msg.SubscriptionId, msg.Signer, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSubscriptionResponse{}, nil
+}
+
+// OpenBidirectionalChannel opens a channel funded by party A
+func (m msgServer) OpenBidirectionalChannel(goCtx context.Context, msg *types.MsgOpenBidirectionalChannel) (*types.MsgOpenBidirectionalChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	channelId, err := m.Keeper.OpenBidirectionalChannel(ctx, msg.PartyA, msg.PartyB, msg.Deposit, msg.ChallengePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgOpenBidirectionalChannelResponse{ChannelId: channelId}, nil
+}
+
+// FundBidirectionalChannel adds party B's deposit to a channel
+func (m msgServer) FundBidirectionalChannel(goCtx context.Context, msg *types.MsgFundBidirectionalChannel) (*types.MsgFundBidirectionalChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.FundBidirectionalChannel(ctx, msg.ChannelId, msg.PartyB, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundBidirectionalChannelResponse{}, nil
+}
+
+// CooperativeCloseChannel settles a channel at a final state signed by both parties
+func (m msgServer) CooperativeCloseChannel(goCtx context.Context, msg *types.MsgCooperativeCloseChannel) (*types.MsgCooperativeCloseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.CooperativeCloseChannel(ctx, msg.Signer, msg.State); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCooperativeCloseChannelResponse{}, nil
+}
+
+// InitiateChannelClose starts the challenge period of a unilateral close
+func (m msgServer) InitiateChannelClose(goCtx context.Context, msg *types.MsgInitiateChannelClose) (*types.MsgInitiateChannelCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	challengeEnds, err := m.Keeper.InitiateChannelClose(ctx, msg.Signer, msg.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitiateChannelCloseResponse{ChallengeEndsHeight: challengeEnds}, nil
+}
+
+// ChallengeChannelClose submits a newer state for a closing channel
+func (m msgServer) ChallengeChannelClose(goCtx context.Context, msg *types.MsgChallengeChannelClose) (*types.MsgChallengeChannelCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.ChallengeChannelClose(ctx, msg.Signer, msg.State); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChallengeChannelCloseResponse{}, nil
+}
+
+// LockHTLC locks funds behind a hash lock and timeout
+func (m msgServer) LockHTLC(goCtx context.Context, msg *types.MsgLockHTLC) (*types.MsgLockHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	htlc, err := m.Keeper.LockHTLC(ctx, msg.Sender, msg.Recipient, msg.Amount, msg.HashLock, msg.TimeoutBlocks, msg.ChannelId, msg.IncomingHtlcId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockHTLCResponse{
+		HtlcId:        htlc.Id,
+		TimeoutHeight: htlc.TimeoutHeight,
+	}, nil
+}
+
+// ClaimHTLC claims an HTLC with its preimage
+func (m msgServer) ClaimHTLC(goCtx context.Context, msg *types.MsgClaimHTLC) (*types.MsgClaimHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, types.ErrInvalidRecipient
+	}
+
+	if err := m.Keeper.ClaimHTLC(ctx, msg.HtlcId, recipientAddr, msg.Preimage); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimHTLCResponse{}, nil
+}
+
+// RefundHTLC returns a timed out HTLC to its sender
+func (m msgServer) RefundHTLC(goCtx context.Context, msg *types.MsgRefundHTLC) (*types.MsgRefundHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, types.ErrInvalidSettlement
+	}
+
+	if err := m.Keeper.RefundHTLC(ctx, msg.HtlcId, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundHTLCResponse{}, nil
+}
+
+// SetChannelRoutingFee sets the fee a channel sender charges to forward payments
+func (m msgServer) SetChannelRoutingFee(goCtx context.Context, msg *types.MsgSetChannelRoutingFee) (*types.MsgSetChannelRoutingFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, types.ErrInvalidSettlement
+	}
+
+	if err := m.Keeper.SetChannelRoutingFee(ctx, msg.ChannelId, senderAddr, msg.RoutingFeeBps); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetChannelRoutingFeeResponse{}, nil
+}
+
+// FreezePayout holds a pending merchant payout during fraud review
+func (m msgServer) FreezePayout(goCtx context.Context, msg *types.MsgFreezePayout) (*types.MsgFreezePayoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.FreezePayout(ctx, msg.Authority, msg.SettlementId, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezePayoutResponse{}, nil
+}
+
+// UnfreezePayout returns a frozen merchant payout to the release queue
+func (m msgServer) UnfreezePayout(goCtx context.Context, msg *types.MsgUnfreezePayout) (*types.MsgUnfreezePayoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	releaseAt, err := m.Keeper.UnfreezePayout(ctx, msg.Authority, msg.SettlementId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezePayoutResponse{ReleaseAt: releaseAt}, nil
+}
+
+// CreateNettingCycle opens a netting cycle between a set of participants
+func (m msgServer) CreateNettingCycle(goCtx context.Context, msg *types.MsgCreateNettingCycle) (*types.MsgCreateNettingCycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	cycle, err := m.Keeper.CreateNettingCycle(ctx, msg.Operator, msg.Participants, msg.Denom, msg.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateNettingCycleResponse{
+		CycleId:  cycle.Id,
+		ClosesAt: cycle.ClosesAt,
+	}, nil
+}
+
+// SubmitObligation records an amount the debtor owes in a netting cycle
+func (m msgServer) SubmitObligation(goCtx context.Context, msg *types.MsgSubmitObligation) (*types.MsgSubmitObligationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	obligationId, err := m.Keeper.SubmitObligation(ctx, msg.Debtor, msg.CycleId, msg.Creditor, msg.Amount, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitObligationResponse{ObligationId: obligationId}, nil
+}
+
+// SettleNettingCycle settles a netting cycle before it closes
+func (m msgServer) SettleNettingCycle(goCtx context.Context, msg *types.MsgSettleNettingCycle) (*types.MsgSettleNettingCycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	transfers, err := m.Keeper.SettleNettingCycle(ctx, msg.Operator, msg.CycleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSettleNettingCycleResponse{Transfers: transfers}, nil
+}
+
+// SetSplitTemplate sets the split applied to a merchant's checkouts
+func (m msgServer) SetSplitTemplate(goCtx context.Context, msg *types.MsgSetSplitTemplate) (*types.MsgSetSplitTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.SetSplitTemplate(ctx, msg.Merchant, msg.Name, msg.Rules); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSplitTemplateResponse{}, nil
+}
+
+// CreateMandate authorizes a merchant to pull payments from the customer
+func (m msgServer) CreateMandate(goCtx context.Context, msg *types.MsgCreateMandate) (*types.MsgCreateMandateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	mandate, err := m.Keeper.CreateMandate(ctx, msg.Customer, msg.Merchant, msg.PerChargeMax, msg.PeriodCap, msg.Period, msg.ExpiresIn, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMandateResponse{MandateId: mandate.Id}, nil
+}
+
+// RevokeMandate revokes a mandate on behalf of the customer or merchant
+func (m msgServer) RevokeMandate(goCtx context.Context, msg *types.MsgRevokeMandate) (*types.MsgRevokeMandateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.RevokeMandate(ctx, msg.MandateId, msg.Signer, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeMandateResponse{}, nil
+}
+
+// PullPayment charges a mandate's customer on behalf of its merchant
+func (m msgServer) PullPayment(goCtx context.Context, msg *types.MsgPullPayment) (*types.MsgPullPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	settlementId, remaining, err := m.Keeper.PullPayment(ctx, msg.MandateId, msg.Merchant, msg.Amount, msg.Reference, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPullPaymentResponse{
+		SettlementId:    settlementId,
+		PeriodRemaining: remaining,
+	}, nil
+}
+
+// CreateStream starts a payment stream funded by the payer's deposit
+func (m msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	stream, err := m.Keeper.CreateStream(ctx, msg.Payer, msg.Recipient, msg.Deposit, msg.RatePerSecond, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStreamResponse{StreamId: stream.Id}, nil
+}
+
+// WithdrawStream pays a stream's recipient what has accrued
+func (m msgServer) WithdrawStream(goCtx context.Context, msg *types.MsgWithdrawStream) (*types.MsgWithdrawStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amount, err := m.Keeper.WithdrawStream(ctx, msg.StreamId, msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawStreamResponse{Amount: amount}, nil
+}
+
+// CancelStream ends a stream on behalf of its payer or recipient
+func (m msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	recipientAmount, payerRefund, err := m.Keeper.CancelStream(ctx, msg.StreamId, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStreamResponse{
+		RecipientAmount: recipientAmount,
+		PayerRefund:     payerRefund,
+	}, nil
+}
+
+// TopUpStream adds to a stream's deposit
+func (m msgServer) TopUpStream(goCtx context.Context, msg *types.MsgTopUpStream) (*types.MsgTopUpStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	stream, err := m.Keeper.TopUpStream(ctx, msg.StreamId, msg.Payer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpStreamResponse{FundedUntil: stream.FundedUntil()}, nil
+}
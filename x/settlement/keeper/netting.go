@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Multilateral Netting
+// ============================================================================
+//
+// A netting cycle collects obligations between a fixed set of participants.
+// Each obligation is submitted by its debtor and moves no funds. When the cycle
+// closes, EndBlock nets the obligations and settles them atomically: net payers
+// pay their multilateral net position into the module account and net
+// receivers are paid out of it. If any transfer fails the cycle fails and no
+// funds move.
+
+// CreateNettingCycle opens a netting cycle that collects obligations until the
+// window has passed
+func (k Keeper) CreateNettingCycle(ctx sdk.Context, operator string, participants []string, denom string, window time.Duration) (types.NettingCycle, error) {
+	sorted := append([]string(nil), participants...)
+	sort.Strings(sorted)
+
+	cycle := types.NettingCycle{
+		Id:            k.getNextNettingCycleID(ctx),
+		Operator:      operator,
+		Participants:  sorted,
+		Denom:         denom,
+		ClosesAt:      ctx.BlockTime().Add(window),
+		Status:        types.NettingCycleStatusOpen,
+		GrossTotal:    sdk.NewCoin(denom, sdkmath.ZeroInt()),
+		CreatedHeight: ctx.BlockHeight(),
+	}
+	k.storeNettingCycle(ctx, cycle)
+	k.setNextNettingCycleID(ctx, cycle.Id+1)
+	k.enqueueNettingClose(ctx, cycle.ClosesAt, cycle.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNettingCycleCreated,
+			sdk.NewAttribute(types.AttributeKeyCycleID, fmt.Sprintf("%d", cycle.Id)),
+			sdk.NewAttribute(types.AttributeKeyParty, operator),
+			sdk.NewAttribute(types.AttributeKeyClosesAt, cycle.ClosesAt.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return cycle, nil
+}
+
+// SubmitObligation records that the debtor owes the creditor an amount in an
+// open netting cycle
+func (k Keeper) SubmitObligation(ctx sdk.Context, debtor string, cycleId uint64, creditor string, amount sdk.Coin, reference string) (uint64, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	cycle, found := k.GetNettingCycle(ctx, cycleId)
+	if !found {
+		return 0, types.ErrNettingCycleNotFound
+	}
+	if cycle.Status != types.NettingCycleStatusOpen || !ctx.BlockTime().Before(cycle.ClosesAt) {
+		return 0, types.ErrNettingCycleNotOpen
+	}
+	if !cycle.IsParticipant(debtor) || !cycle.IsParticipant(creditor) {
+		return 0, types.ErrInvalidObligation.Wrap("debtor and creditor must be cycle participants")
+	}
+	if amount.Denom != cycle.Denom {
+		return 0, types.ErrInvalidObligation.Wrapf("obligation must be in %s", cycle.Denom)
+	}
+	if cycle.ObligationCount >= types.MaxNettingObligations {
+		return 0, types.ErrInvalidObligation.Wrap("netting cycle is full")
+	}
+
+	for _, party := range []string{debtor, creditor} {
+		addr, err := sdk.AccAddressFromBech32(party)
+		if err != nil {
+			return 0, types.ErrInvalidObligation
+		}
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, addr); err != nil {
+			return 0, types.ErrComplianceCheckFailed
+		}
+	}
+
+	cycle.ObligationCount++
+	cycle.GrossTotal = cycle.GrossTotal.Add(amount)
+	obligation := types.NettingObligation{
+		CycleId:       cycle.Id,
+		Id:            cycle.ObligationCount,
+		Debtor:        debtor,
+		Creditor:      creditor,
+		Amount:        amount,
+		Reference:     reference,
+		CreatedHeight: ctx.BlockHeight(),
+	}
+	k.storeNettingObligation(ctx, obligation)
+	k.storeNettingCycle(ctx, cycle)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeObligationSubmitted,
+			sdk.NewAttribute(types.AttributeKeyCycleID, fmt.Sprintf("%d", cycle.Id)),
+			sdk.NewAttribute(types.AttributeKeyObligationID, fmt.Sprintf("%d", obligation.Id)),
+			sdk.NewAttribute(types.AttributeKeyDebtor, debtor),
+			sdk.NewAttribute(types.AttributeKeyCreditor, creditor),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return obligation.Id, nil
+}
+
+// SettleNettingCycle lets the operator settle an open cycle before it closes.
+// A failed settlement leaves the cycle open.
+func (k Keeper) SettleNettingCycle(ctx sdk.Context, operator string, cycleId uint64) ([]types.NetTransfer, error) {
+	cycle, found := k.GetNettingCycle(ctx, cycleId)
+	if !found {
+		return nil, types.ErrNettingCycleNotFound
+	}
+	if operator != cycle.Operator {
+		return nil, types.ErrUnauthorized
+	}
+	if cycle.Status != types.NettingCycleStatusOpen {
+		return nil, types.ErrNettingCycleNotOpen
+	}
+
+	cycle, err := k.settleNettingCycle(ctx, cycle)
+	if err != nil {
+		return nil, err
+	}
+	return cycle.Transfers, nil
+}
+
+// ProcessNettingCycles settles the netting cycles whose window has closed. A
+// cycle that cannot settle is marked failed and none of its transfers are made.
+func (k Keeper) ProcessNettingCycles(ctx sdk.Context) {
+	currentTime := ctx.BlockTime()
+
+	for _, id := range k.dequeueDue(ctx, types.NettingCloseQueuePrefix, sdk.FormatTimeBytes(currentTime)) {
+		cycle, found := k.GetNettingCycle(ctx, id)
+		if !found || cycle.Status != types.NettingCycleStatusOpen || currentTime.Before(cycle.ClosesAt) {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.settleNettingCycle(cacheCtx, cycle); err != nil {
+			cycle.Status = types.NettingCycleStatusFailed
+			cycle.FailureReason = err.Error()
+			cycle.SettledHeight = ctx.BlockHeight()
+			cycle.SettledTime = currentTime
+			k.storeNettingCycle(ctx, cycle)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeNettingCycleFailed,
+					sdk.NewAttribute(types.AttributeKeyCycleID, fmt.Sprintf("%d", cycle.Id)),
+					sdk.NewAttribute(types.AttributeKeyReason, cycle.FailureReason),
+				),
+			)
+			continue
+		}
+		write()
+	}
+}
+
+// settleNettingCycle collects every net payer's position into the module
+// account, pays out every net receiver and records each net transfer as a
+// settlement. Callers must discard ctx on error.
+func (k Keeper) settleNettingCycle(ctx sdk.Context, cycle types.NettingCycle) (types.NettingCycle, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	positions, transfers := types.ComputeNetting(cycle, k.GetNettingObligations(ctx, cycle.Id))
+
+	for _, position := range positions {
+		if !position.NetPayable.IsPositive() && !position.NetReceivable.IsPositive() {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(position.Participant)
+		if err != nil {
+			return cycle, types.ErrInvalidNettingCycle
+		}
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, addr); err != nil {
+			return cycle, errorsmod.Wrapf(types.ErrComplianceCheckFailed, "participant %s", position.Participant)
+		}
+		if position.NetPayable.IsPositive() && k.bankKeeper.GetBalance(wrappedCtx, addr, cycle.Denom).IsLT(position.NetPayable) {
+			return cycle, errorsmod.Wrapf(types.ErrInsufficientFunds, "participant %s cannot pay net position %s", position.Participant, position.NetPayable)
+		}
+	}
+
+	for _, position := range positions {
+		if !position.NetPayable.IsPositive() {
+			continue
+		}
+		addr, _ := sdk.AccAddressFromBech32(position.Participant)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, addr, types.ModuleAccountName, sdk.NewCoins(position.NetPayable)); err != nil {
+			return cycle, err
+		}
+	}
+
+	for _, position := range positions {
+		if !position.NetReceivable.IsPositive() {
+			continue
+		}
+		addr, _ := sdk.AccAddressFromBech32(position.Participant)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, addr, sdk.NewCoins(position.NetReceivable)); err != nil {
+			return cycle, err
+		}
+	}
+
+	for i, transfer := range transfers {
+		nextID := k.getNextSettlementID(ctx)
+		k.storeSettlement(ctx, types.Settlement{
+			Id:            nextID,
+			Type:          types.SettlementTypeNetting,
+			Sender:        transfer.From,
+			Recipient:     transfer.To,
+			Amount:        transfer.Amount,
+			Fee:           sdk.NewCoin(cycle.Denom, sdkmath.ZeroInt()),
+			NetAmount:     transfer.Amount,
+			Status:        types.SettlementStatusCompleted,
+			Reference:     fmt.Sprintf("netting-cycle-%d", cycle.Id),
+			CreatedHeight: ctx.BlockHeight(),
+			CreatedTime:   ctx.BlockTime(),
+			SettledHeight: ctx.BlockHeight(),
+			SettledTime:   ctx.BlockTime(),
+		})
+		k.setNextSettlementID(ctx, nextID+1)
+		transfers[i].SettlementId = nextID
+	}
+
+	gross, _, net := types.NettingTotals(cycle.Denom, positions)
+	cycle.Status = types.NettingCycleStatusSettled
+	cycle.Transfers = transfers
+	cycle.SettledHeight = ctx.BlockHeight()
+	cycle.SettledTime = ctx.BlockTime()
+	k.storeNettingCycle(ctx, cycle)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNettingCycleSettled,
+			sdk.NewAttribute(types.AttributeKeyCycleID, fmt.Sprintf("%d", cycle.Id)),
+			sdk.NewAttribute(types.AttributeKeyGrossTotal, gross.String()),
+			sdk.NewAttribute(types.AttributeKeyNetTotal, net.String()),
+			sdk.NewAttribute(types.AttributeKeyTransfers, fmt.Sprintf("%d", len(transfers))),
+		),
+	)
+
+	return cycle, nil
+}
+
+// NettingReport returns each participant's gross and net position in a cycle
+// along with its net transfers. Transfers of an open cycle are the ones it
+// would make if it settled now.
+func (k Keeper) NettingReport(ctx sdk.Context, cycle types.NettingCycle) ([]types.NettingPosition, []types.NetTransfer) {
+	positions, transfers := types.ComputeNetting(cycle, k.GetNettingObligations(ctx, cycle.Id))
+	if cycle.Status == types.NettingCycleStatusSettled {
+		transfers = cycle.Transfers
+	}
+	return positions, transfers
+}
+
+// ============================================================================
+// Netting Storage
+// ============================================================================
+
+func (k Keeper) getNextNettingCycleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextNettingCycleIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextNettingCycleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextNettingCycleIDKey, mustWriteUint64(id))
+}
+
+func (k Keeper) storeNettingCycle(ctx sdk.Context, cycle types.NettingCycle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NettingCycleKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&cycle)
+	store.Set(mustWriteUint64(cycle.Id), bz)
+}
+
+// GetNettingCycle retrieves a netting cycle by ID
+func (k Keeper) GetNettingCycle(ctx sdk.Context, id uint64) (types.NettingCycle, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NettingCycleKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.NettingCycle{}, false
+	}
+	var cycle types.NettingCycle
+	types.ModuleCdc.MustUnmarshalJSON(bz, &cycle)
+	return cycle, true
+}
+
+// IterateNettingCycles iterates over all netting cycles
+func (k Keeper) IterateNettingCycles(ctx sdk.Context, cb func(types.NettingCycle) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NettingCycleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var cycle types.NettingCycle
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &cycle)
+		if cb(cycle) {
+			break
+		}
+	}
+}
+
+func nettingObligationKey(cycleId, id uint64) []byte {
+	return append(mustWriteUint64(cycleId), mustWriteUint64(id)...)
+}
+
+func (k Keeper) storeNettingObligation(ctx sdk.Context, obligation types.NettingObligation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NettingObligationKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&obligation)
+	store.Set(nettingObligationKey(obligation.CycleId, obligation.Id), bz)
+}
+
+// GetNettingObligations returns the obligations of a netting cycle in submission order
+func (k Keeper) GetNettingObligations(ctx sdk.Context, cycleId uint64) []types.NettingObligation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.NettingObligationKeyPrefix, mustWriteUint64(cycleId)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var obligations []types.NettingObligation
+	for ; iterator.Valid(); iterator.Next() {
+		var obligation types.NettingObligation
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &obligation)
+		obligations = append(obligations, obligation)
+	}
+	return obligations
+}
+
+// IterateNettingObligations iterates over the obligations of every netting cycle
+func (k Keeper) IterateNettingObligations(ctx sdk.Context, cb func(types.NettingObligation) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.NettingObligationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var obligation types.NettingObligation
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &obligation)
+		if cb(obligation) {
+			break
+		}
+	}
+}
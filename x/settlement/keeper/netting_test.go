@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func ssusd(amount int64) sdk.Coin {
+	return sdk.NewCoin("ssusd", sdkmath.NewInt(amount))
+}
+
+// setupNettingCycle opens a one hour cycle between three funded participants in
+// which a owes b 100, b owes c 100, c owes a 50 and b owes a 30
+func setupNettingCycle(t *testing.T, k keeper.Keeper, ctx sdk.Context, bankKeeper *mockBankKeeper) (types.NettingCycle, sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	a, b, c := newSettlementAddress(), newSettlementAddress(), newSettlementAddress()
+	for _, addr := range []sdk.AccAddress{a, b, c} {
+		bankKeeper.SetBalance(addr.String(), sdk.NewCoins(ssusd(1000)))
+	}
+
+	cycle, err := k.CreateNettingCycle(ctx, a.String(), []string{a.String(), b.String(), c.String()}, "ssusd", time.Hour)
+	require.NoError(t, err)
+
+	for _, o := range []struct {
+		debtor, creditor sdk.AccAddress
+		amount           int64
+	}{{a, b, 100}, {b, c, 100}, {c, a, 50}, {b, a, 30}} {
+		_, err := k.SubmitObligation(ctx, o.debtor.String(), cycle.Id, o.creditor.String(), ssusd(o.amount), "")
+		require.NoError(t, err)
+	}
+
+	cycle, _ = k.GetNettingCycle(ctx, cycle.Id)
+	return cycle, a, b, c
+}
+
+func TestNetting_ReportComputesGrossAndNetPositions(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	cycle, a, b, c := setupNettingCycle(t, k, ctx, bankKeeper)
+	require.Equal(t, uint64(4), cycle.ObligationCount)
+	require.Equal(t, ssusd(280), cycle.GrossTotal)
+
+	res, err := keeper.NewQueryServerImpl(k).NettingReport(ctx, &types.QueryNettingReportRequest{CycleId: cycle.Id})
+	require.NoError(t, err)
+	require.Equal(t, ssusd(280), res.GrossTotal)
+	require.Equal(t, ssusd(220), res.BilateralNetTotal)
+	require.Equal(t, ssusd(50), res.NetTotal)
+
+	positions := make(map[string]types.NettingPosition)
+	for _, position := range res.Positions {
+		positions[position.Participant] = position
+	}
+	require.Equal(t, ssusd(130), positions[b.String()].GrossPayable)
+	require.Equal(t, ssusd(100), positions[b.String()].GrossReceivable)
+	require.Equal(t, ssusd(100), positions[b.String()].BilateralNetPayable)
+	require.Equal(t, ssusd(70), positions[b.String()].BilateralNetReceivable)
+	require.Equal(t, ssusd(20), positions[a.String()].NetPayable)
+	require.Equal(t, ssusd(30), positions[b.String()].NetPayable)
+	require.Equal(t, ssusd(50), positions[c.String()].NetReceivable)
+
+	// Only the net positions are transferred
+	require.Len(t, res.Transfers, 2)
+	for _, transfer := range res.Transfers {
+		require.Equal(t, c.String(), transfer.To)
+	}
+}
+
+func TestNetting_SettlesNetTransfersWhenCycleCloses(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	cycle, a, b, c := setupNettingCycle(t, k, ctx, bankKeeper)
+
+	k.ProcessNettingCycles(ctx.WithBlockTime(cycle.ClosesAt.Add(-time.Second)))
+	cycle, _ = k.GetNettingCycle(ctx, cycle.Id)
+	require.Equal(t, types.NettingCycleStatusOpen, cycle.Status)
+
+	// Obligations are no longer accepted once the window has passed
+	closeCtx := ctx.WithBlockTime(cycle.ClosesAt).WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err := k.SubmitObligation(closeCtx, a.String(), cycle.Id, b.String(), ssusd(10), "")
+	require.ErrorIs(t, err, types.ErrNettingCycleNotOpen)
+
+	k.ProcessNettingCycles(closeCtx)
+	cycle, _ = k.GetNettingCycle(ctx, cycle.Id)
+	require.Equal(t, types.NettingCycleStatusSettled, cycle.Status)
+	require.Equal(t, closeCtx.BlockHeight(), cycle.SettledHeight)
+	require.Len(t, cycle.Transfers, 2)
+
+	require.Equal(t, sdkmath.NewInt(980), bankKeeper.GetBalance(ctx, a, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(970), bankKeeper.GetBalance(ctx, b, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(1050), bankKeeper.GetBalance(ctx, c, "ssusd").Amount)
+	require.True(t, bankKeeper.moduleBalances[types.ModuleAccountName].AmountOf("ssusd").IsZero())
+
+	for _, transfer := range cycle.Transfers {
+		settlement, found := k.GetSettlement(ctx, transfer.SettlementId)
+		require.True(t, found)
+		require.Equal(t, types.SettlementTypeNetting, settlement.Type)
+		require.Equal(t, transfer.Amount, settlement.NetAmount)
+		require.True(t, settlement.Fee.IsZero())
+	}
+}
+
+func TestNetting_InsufficientFundsFailsWholeCycle(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	cycle, a, b, c := setupNettingCycle(t, k, ctx, bankKeeper)
+	bankKeeper.SetBalance(b.String(), sdk.NewCoins(ssusd(10)))
+
+	k.ProcessNettingCycles(ctx.WithBlockTime(cycle.ClosesAt))
+	cycle, _ = k.GetNettingCycle(ctx, cycle.Id)
+	require.Equal(t, types.NettingCycleStatusFailed, cycle.Status)
+	require.Contains(t, cycle.FailureReason, b.String())
+	require.Empty(t, cycle.Transfers)
+
+	require.Equal(t, sdkmath.NewInt(1000), bankKeeper.GetBalance(ctx, a, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, b, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(1000), bankKeeper.GetBalance(ctx, c, "ssusd").Amount)
+}
+
+func TestNetting_OperatorSettlesEarly(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	cycle, _, b, c := setupNettingCycle(t, k, ctx, bankKeeper)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SettleNettingCycle(ctx, &types.MsgSettleNettingCycle{Operator: b.String(), CycleId: cycle.Id})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := msgServer.SettleNettingCycle(ctx, &types.MsgSettleNettingCycle{Operator: cycle.Operator, CycleId: cycle.Id})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 2)
+	require.Equal(t, sdkmath.NewInt(1050), bankKeeper.GetBalance(ctx, c, "ssusd").Amount)
+
+	_, err = msgServer.SettleNettingCycle(ctx, &types.MsgSettleNettingCycle{Operator: cycle.Operator, CycleId: cycle.Id})
+	require.ErrorIs(t, err, types.ErrNettingCycleNotOpen)
+
+	// The close queue entry is skipped once the cycle has settled
+	k.ProcessNettingCycles(ctx.WithBlockTime(cycle.ClosesAt))
+	require.Equal(t, sdkmath.NewInt(1050), bankKeeper.GetBalance(ctx, c, "ssusd").Amount)
+}
+
+func TestNetting_RejectsInvalidObligations(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	cycle, a, b, _ := setupNettingCycle(t, k, ctx, bankKeeper)
+
+	_, err := k.SubmitObligation(ctx, a.String(), cycle.Id, newSettlementAddress().String(), ssusd(10), "")
+	require.ErrorIs(t, err, types.ErrInvalidObligation)
+
+	_, err = k.SubmitObligation(ctx, a.String(), cycle.Id, b.String(), sdk.NewCoin("stst", sdkmath.NewInt(10)), "")
+	require.ErrorIs(t, err, types.ErrInvalidObligation)
+
+	_, err = k.SubmitObligation(ctx, a.String(), cycle.Id+1, b.String(), ssusd(10), "")
+	require.ErrorIs(t, err, types.ErrNettingCycleNotFound)
+
+	complianceKeeper.sanctionedAddresses[b.String()] = true
+	_, err = k.SubmitObligation(ctx, a.String(), cycle.Id, b.String(), ssusd(10), "")
+	require.ErrorIs(t, err, types.ErrComplianceCheckFailed)
+}
+
+func TestNetting_GenesisRoundTrip(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	cycle, _, _, c := setupNettingCycle(t, k, ctx, bankKeeper)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.NettingObligations, 4)
+
+	k2, ctx2, bankKeeper2, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	bankKeeper2.balances = bankKeeper.balances
+
+	obligations, err := keeper.NewQueryServerImpl(k2).NettingObligations(ctx2, &types.QueryNettingObligationsRequest{CycleId: cycle.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), obligations.Total)
+
+	// The restored cycle is queued to settle when it closes
+	k2.ProcessNettingCycles(ctx2.WithBlockTime(cycle.ClosesAt))
+	restored, _ := k2.GetNettingCycle(ctx2, cycle.Id)
+	require.Equal(t, types.NettingCycleStatusSettled, restored.Status)
+	require.Equal(t, sdkmath.NewInt(1050), bankKeeper2.GetBalance(ctx2, c, "ssusd").Amount)
+
+	next, err := k2.CreateNettingCycle(ctx2, c.String(), restored.Participants, "ssusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, cycle.Id+1, next.Id)
+}
@@ -0,0 +1,314 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Delayed Payouts
+// ============================================================================
+//
+// Instant settlements to a merchant with a settlement delay keep the net amount
+// in the module account. The payout is released by EndBlock once the delay has
+// passed, unless the authority has frozen it for review. Only the authority
+// sets the delay, and it applies whether or not the merchant is active.
+// Payouts are indexed by merchant and status, so a merchant's ledger is paged
+// without scanning every payout; storeDelayedPayout moves a payout's index
+// entry as its status changes.
+
+// settlementDelay returns how long payouts to the recipient are held, zero
+// when they are paid out immediately
+func (k Keeper) settlementDelay(ctx sdk.Context, recipient string) time.Duration {
+	merchant, found := k.GetMerchant(ctx, recipient)
+	if !found {
+		return 0
+	}
+	return merchant.SettlementDelay
+}
+
+// schedulePayout records the net amount of a settlement as held until the delay has passed
+func (k Keeper) schedulePayout(ctx sdk.Context, settlement types.Settlement, delay time.Duration) types.DelayedPayout {
+	payout := types.DelayedPayout{
+		SettlementId:  settlement.Id,
+		Merchant:      settlement.Recipient,
+		Amount:        settlement.NetAmount,
+		ReleaseAt:     ctx.BlockTime().Add(delay),
+		Status:        types.PayoutStatusPending,
+		CreatedHeight: ctx.BlockHeight(),
+	}
+	k.storeDelayedPayout(ctx, payout)
+	k.enqueuePayoutRelease(ctx, payout.ReleaseAt, payout.SettlementId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayoutScheduled,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", payout.SettlementId)),
+			sdk.NewAttribute(types.AttributeKeyMerchant, payout.Merchant),
+			sdk.NewAttribute(types.AttributeKeyAmount, payout.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReleaseAt, payout.ReleaseAt.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return payout
+}
+
+// FreezePayout stops a pending payout from being released until it is unfrozen
+func (k Keeper) FreezePayout(ctx sdk.Context, authority string, settlementId uint64, reason string) error {
+	if authority != k.GetAuthority() {
+		return types.ErrUnauthorized
+	}
+
+	payout, found := k.GetDelayedPayout(ctx, settlementId)
+	if !found {
+		return types.ErrPayoutNotFound
+	}
+	if payout.Status != types.PayoutStatusPending {
+		return types.ErrPayoutNotPending
+	}
+
+	k.freezePayout(ctx, payout, reason)
+	return nil
+}
+
+func (k Keeper) freezePayout(ctx sdk.Context, payout types.DelayedPayout, reason string) {
+	payout.Status = types.PayoutStatusFrozen
+	payout.FrozenReason = reason
+	k.storeDelayedPayout(ctx, payout)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayoutFrozen,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", payout.SettlementId)),
+			sdk.NewAttribute(types.AttributeKeyMerchant, payout.Merchant),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+// UnfreezePayout returns a frozen payout to pending. A payout whose release
+// time passed while it was frozen is released in the next EndBlock.
+func (k Keeper) UnfreezePayout(ctx sdk.Context, authority string, settlementId uint64) (time.Time, error) {
+	if authority != k.GetAuthority() {
+		return time.Time{}, types.ErrUnauthorized
+	}
+
+	payout, found := k.GetDelayedPayout(ctx, settlementId)
+	if !found {
+		return time.Time{}, types.ErrPayoutNotFound
+	}
+	if payout.Status != types.PayoutStatusFrozen {
+		return time.Time{}, types.ErrPayoutNotFrozen
+	}
+
+	payout.Status = types.PayoutStatusPending
+	payout.FrozenReason = ""
+	k.storeDelayedPayout(ctx, payout)
+
+	releaseAt := payout.ReleaseAt
+	if releaseAt.Before(ctx.BlockTime()) {
+		releaseAt = ctx.BlockTime()
+	}
+	k.enqueuePayoutRelease(ctx, releaseAt, payout.SettlementId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayoutUnfrozen,
+			sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", payout.SettlementId)),
+			sdk.NewAttribute(types.AttributeKeyMerchant, payout.Merchant),
+			sdk.NewAttribute(types.AttributeKeyReleaseAt, releaseAt.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return releaseAt, nil
+}
+
+// ProcessDuePayouts releases pending payouts whose settlement delay has passed.
+// Payouts to merchants that no longer pass compliance are frozen instead.
+func (k Keeper) ProcessDuePayouts(ctx sdk.Context) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	currentTime := ctx.BlockTime()
+
+	for _, id := range k.dequeueDue(ctx, types.PayoutReleaseQueuePrefix, sdk.FormatTimeBytes(currentTime)) {
+		payout, found := k.GetDelayedPayout(ctx, id)
+		if !found || payout.Status != types.PayoutStatusPending || currentTime.Before(payout.ReleaseAt) {
+			continue
+		}
+
+		merchantAddr, err := sdk.AccAddressFromBech32(payout.Merchant)
+		if err != nil {
+			continue // Skip invalid - should not happen
+		}
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, merchantAddr); err != nil {
+			k.freezePayout(ctx, payout, "merchant failed compliance check")
+			continue
+		}
+
+		// A split payout is released to every payee
+		if settlement, found := k.GetSettlement(ctx, payout.SettlementId); found && len(settlement.Legs) > 0 {
+			if err := k.assertPayeesCompliant(ctx, settlement.Legs); err != nil {
+				k.freezePayout(ctx, payout, "payee failed compliance check")
+				continue
+			}
+			cacheCtx, write := ctx.CacheContext()
+			if err := k.payLegs(cacheCtx, settlement); err != nil {
+				ctx.Logger().Error("failed to release delayed payout", "settlement_id", payout.SettlementId, "error", err)
+				k.enqueuePayoutRelease(ctx, currentTime, payout.SettlementId)
+				continue
+			}
+			write()
+		} else if payout.Amount.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, merchantAddr, sdk.NewCoins(payout.Amount)); err != nil {
+				// Log error and retry next block
+				ctx.Logger().Error("failed to release delayed payout", "settlement_id", payout.SettlementId, "error", err)
+				k.enqueuePayoutRelease(ctx, currentTime, payout.SettlementId)
+				continue
+			}
+		}
+
+		payout.Status = types.PayoutStatusReleased
+		payout.ReleasedHeight = ctx.BlockHeight()
+		k.storeDelayedPayout(ctx, payout)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePayoutReleased,
+				sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", payout.SettlementId)),
+				sdk.NewAttribute(types.AttributeKeyMerchant, payout.Merchant),
+				sdk.NewAttribute(types.AttributeKeyAmount, payout.Amount.String()),
+			),
+		)
+	}
+}
+
+// refundHeldPayout returns part of a held payout to the customer from the
+// module account, marking the payout refunded once nothing is left
+func (k Keeper) refundHeldPayout(ctx sdk.Context, payout types.DelayedPayout, customerAddr sdk.AccAddress, refundAmount sdk.Coin) error {
+	if refundAmount.Amount.GT(payout.Amount.Amount) {
+		return types.ErrRefundTooLarge
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(sdk.WrapSDKContext(ctx), types.ModuleAccountName, customerAddr, sdk.NewCoins(refundAmount)); err != nil {
+		return err
+	}
+
+	payout.Amount = payout.Amount.Sub(refundAmount)
+	if payout.Amount.IsZero() {
+		payout.Status = types.PayoutStatusRefunded
+	}
+	k.storeDelayedPayout(ctx, payout)
+	return nil
+}
+
+func (k Keeper) storeDelayedPayout(ctx sdk.Context, payout types.DelayedPayout) {
+	var previous *types.DelayedPayout
+	if existing, found := k.GetDelayedPayout(ctx, payout.SettlementId); found {
+		previous = &existing
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedPayoutKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&payout)
+	store.Set(mustWriteUint64(payout.SettlementId), bz)
+	k.indexDelayedPayout(ctx, previous, payout)
+}
+
+func payoutIndexPrefix(merchant string, status types.PayoutStatus) []byte {
+	return append(partyIndexPrefix(types.PayoutByMerchantPrefix, merchant), address.MustLengthPrefix([]byte(status))...)
+}
+
+// indexDelayedPayout writes the merchant index entry of a payout, removing
+// that of its previous version when its status changed
+func (k Keeper) indexDelayedPayout(ctx sdk.Context, previous *types.DelayedPayout, payout types.DelayedPayout) {
+	store := ctx.KVStore(k.storeKey)
+	id := mustWriteUint64(payout.SettlementId)
+	if previous != nil {
+		if previous.Merchant == payout.Merchant && previous.Status == payout.Status {
+			return
+		}
+		store.Delete(append(payoutIndexPrefix(previous.Merchant, previous.Status), id...))
+	}
+	store.Set(append(payoutIndexPrefix(payout.Merchant, payout.Status), id...), id)
+}
+
+// RebuildPayoutIndex writes the merchant index entry of every delayed payout
+func (k Keeper) RebuildPayoutIndex(ctx sdk.Context) {
+	k.IterateDelayedPayouts(ctx, func(p types.DelayedPayout) bool {
+		k.indexDelayedPayout(ctx, nil, p)
+		return false
+	})
+}
+
+// PayoutsByMerchant returns a page of a merchant's delayed payouts, only those
+// with the status unless it is empty, along with the total of the merchant's
+// payouts still held. Without a status the payouts are grouped by status.
+func (k Keeper) PayoutsByMerchant(ctx sdk.Context, merchant string, status types.PayoutStatus, pageReq *query.PageRequest) ([]types.DelayedPayout, sdk.Coins, *query.PageResponse, error) {
+	if !validIndexedParty(merchant) {
+		return nil, nil, nil, errorsmod.Wrap(types.ErrInvalidRecipient, "invalid merchant")
+	}
+	indexPrefix := partyIndexPrefix(types.PayoutByMerchantPrefix, merchant)
+	if status != "" {
+		if !status.IsValid() {
+			return nil, nil, nil, errorsmod.Wrapf(types.ErrInvalidSettlement, "invalid payout status %q", status)
+		}
+		indexPrefix = payoutIndexPrefix(merchant, status)
+	}
+
+	held := sdk.NewCoins()
+	for _, heldStatus := range []types.PayoutStatus{types.PayoutStatusPending, types.PayoutStatusFrozen} {
+		iterator := prefix.NewStore(ctx.KVStore(k.storeKey), payoutIndexPrefix(merchant, heldStatus)).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			if payout, found := k.GetDelayedPayout(ctx, binary.BigEndian.Uint64(iterator.Value())); found {
+				held = held.Add(payout.Amount)
+			}
+		}
+		iterator.Close()
+	}
+
+	page := k.settlementPage(ctx, pageReq)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	var payouts []types.DelayedPayout
+	pageRes, err := query.Paginate(store, &page, func(_, value []byte) error {
+		if payout, found := k.GetDelayedPayout(ctx, binary.BigEndian.Uint64(value)); found {
+			payouts = append(payouts, payout)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return payouts, held, pageRes, nil
+}
+
+// GetDelayedPayout retrieves the delayed payout of a settlement
+func (k Keeper) GetDelayedPayout(ctx sdk.Context, settlementId uint64) (types.DelayedPayout, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedPayoutKeyPrefix)
+	bz := store.Get(mustWriteUint64(settlementId))
+	if len(bz) == 0 {
+		return types.DelayedPayout{}, false
+	}
+	var payout types.DelayedPayout
+	types.ModuleCdc.MustUnmarshalJSON(bz, &payout)
+	return payout, true
+}
+
+// IterateDelayedPayouts iterates over all delayed payouts
+func (k Keeper) IterateDelayedPayouts(ctx sdk.Context, cb func(types.DelayedPayout) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedPayoutKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var payout types.DelayedPayout
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &payout)
+		if cb(payout) {
+			break
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func registerDelayedMerchant(t *testing.T, k keeper.Keeper, ctx sdk.Context, delay time.Duration) sdk.AccAddress {
+	merchant := newSettlementAddress()
+	require.NoError(t, k.RegisterMerchant(ctx, types.MerchantConfig{
+		Address:         merchant.String(),
+		Name:            "Delayed Merchant",
+		SettlementDelay: delay,
+	}))
+	return merchant
+}
+
+func TestDelayedPayout_ReleasedAfterDelay(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, 72*time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "ORDER-1", "")
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, settlementId)
+
+	// The net amount is held by the module alongside the collected fee
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	require.Equal(t, settlement.NetAmount.Add(settlement.Fee).Amount, bankKeeper.moduleBalances[types.ModuleAccountName].AmountOf("ssusd"))
+
+	payout, found := k.GetDelayedPayout(ctx, settlementId)
+	require.True(t, found)
+	require.Equal(t, types.PayoutStatusPending, payout.Status)
+	require.Equal(t, settlement.NetAmount, payout.Amount)
+	require.Equal(t, ctx.BlockTime().Add(72*time.Hour), payout.ReleaseAt)
+
+	// Nothing is released before the delay has passed
+	k.ProcessDuePayouts(ctx.WithBlockTime(ctx.BlockTime().Add(71 * time.Hour)))
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+
+	releaseCtx := ctx.WithBlockTime(payout.ReleaseAt).WithBlockHeight(ctx.BlockHeight() + 100)
+	k.ProcessDuePayouts(releaseCtx)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+
+	payout, _ = k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, types.PayoutStatusReleased, payout.Status)
+	require.Equal(t, releaseCtx.BlockHeight(), payout.ReleasedHeight)
+}
+
+func TestDelayedPayout_NoDelayPaysImmediately(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, 0)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+
+	_, found := k.GetDelayedPayout(ctx, settlementId)
+	require.False(t, found)
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsPositive())
+}
+
+func TestDelayedPayout_FreezeAndUnfreeze(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+
+	// Only the authority can freeze
+	require.ErrorIs(t, k.FreezePayout(ctx, merchant.String(), settlementId, "review"), types.ErrUnauthorized)
+	require.NoError(t, k.FreezePayout(ctx, k.GetAuthority(), settlementId, "chargeback pattern"))
+	require.ErrorIs(t, k.FreezePayout(ctx, k.GetAuthority(), settlementId, "again"), types.ErrPayoutNotPending)
+
+	// A frozen payout is not released when its delay passes
+	dueCtx := ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	k.ProcessDuePayouts(dueCtx)
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	payout, _ := k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, types.PayoutStatusFrozen, payout.Status)
+	require.Equal(t, "chargeback pattern", payout.FrozenReason)
+
+	// Unfreezing after the release time releases it in the next EndBlock
+	releaseAt, err := k.UnfreezePayout(dueCtx, k.GetAuthority(), settlementId)
+	require.NoError(t, err)
+	require.Equal(t, dueCtx.BlockTime(), releaseAt)
+	_, err = k.UnfreezePayout(dueCtx, k.GetAuthority(), settlementId)
+	require.ErrorIs(t, err, types.ErrPayoutNotFrozen)
+
+	k.ProcessDuePayouts(dueCtx.WithBlockTime(dueCtx.BlockTime().Add(5 * time.Second)))
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsPositive())
+	payout, _ = k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, types.PayoutStatusReleased, payout.Status)
+}
+
+func TestDelayedPayout_NonCompliantMerchantFrozen(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+
+	complianceKeeper.sanctionedAddresses[merchant.String()] = true
+	k.ProcessDuePayouts(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	payout, _ := k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, types.PayoutStatusFrozen, payout.Status)
+}
+
+func TestDelayedPayout_PartialRefundFromHeldFunds(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+	require.NoError(t, err)
+	payout, _ := k.GetDelayedPayout(ctx, settlementId)
+
+	// The refund comes out of the held payout, not the merchant's account
+	refund := sdk.NewCoin("ssusd", sdkmath.NewInt(40000))
+	_, err = k.PartialRefund(ctx, merchant.String(), settlementId, refund, "damaged item")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(940000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+
+	remaining := payout.Amount.Sub(refund)
+	payout, _ = k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, remaining, payout.Amount)
+	require.Equal(t, types.PayoutStatusPending, payout.Status)
+
+	// Only the remainder is released
+	k.ProcessDuePayouts(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Equal(t, remaining.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+}
+
+func TestDelayedPayout_MerchantLedgerQuery(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "", "")
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.NoError(t, k.FreezePayout(ctx, k.GetAuthority(), ids[1], "review"))
+
+	queryServer := keeper.NewQueryServerImpl(k)
+	res, err := queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{
+		Merchant:   merchant.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Payouts, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	payout, _ := k.GetDelayedPayout(ctx, ids[0])
+	require.Equal(t, payout.Amount.Amount.MulRaw(3), res.Held.AmountOf("ssusd"))
+
+	res, err = queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{
+		Merchant:   merchant.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Payouts, 1)
+
+	res, err = queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{Merchant: merchant.String(), Status: string(types.PayoutStatusFrozen)})
+	require.NoError(t, err)
+	require.Len(t, res.Payouts, 1)
+	require.Equal(t, ids[1], res.Payouts[0].SettlementId)
+
+	// Released payouts move out of the pending index and are no longer held
+	k.ProcessDuePayouts(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	res, err = queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{Merchant: merchant.String(), Status: string(types.PayoutStatusPending)})
+	require.NoError(t, err)
+	require.Empty(t, res.Payouts)
+	require.Equal(t, payout.Amount.Amount, res.Held.AmountOf("ssusd"))
+	res, err = queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{Merchant: merchant.String(), Status: string(types.PayoutStatusReleased)})
+	require.NoError(t, err)
+	require.Len(t, res.Payouts, 2)
+
+	_, err = queryServer.PayoutsByMerchant(ctx, &types.QueryPayoutsByMerchantRequest{Merchant: merchant.String(), Status: "paid"})
+	require.ErrorIs(t, err, types.ErrInvalidSettlement)
+	_, err = k.UnfreezePayout(ctx, k.GetAuthority(), ids[1])
+	require.NoError(t, err)
+
+	// Genesis round trips the ledger and re-queues pending payouts
+	k2, ctx2, bankKeeper2, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, k.ExportGenesis(ctx))
+	bankKeeper2.moduleBalances[types.ModuleAccountName] = bankKeeper.moduleBalances[types.ModuleAccountName]
+	k2.ProcessDuePayouts(ctx2.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Equal(t, payout.Amount.Amount, bankKeeper2.GetBalance(ctx2, merchant, "ssusd").Amount)
+	res, err = keeper.NewQueryServerImpl(k2).PayoutsByMerchant(ctx2, &types.QueryPayoutsByMerchantRequest{Merchant: merchant.String(), Status: string(types.PayoutStatusReleased)})
+	require.NoError(t, err)
+	require.Len(t, res.Payouts, 3)
+
+	// Backfilling an indexed store is a no-op
+	require.NoError(t, keeper.NewMigrator(k2).Migrate2to3(ctx2))
+	res, err = keeper.NewQueryServerImpl(k2).PayoutsByMerchant(ctx2, &types.QueryPayoutsByMerchantRequest{
+		Merchant:   merchant.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.True(t, res.Held.IsZero())
+}
+
+func TestMerchantSettlementDelay_SetOnlyByAuthority(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	merchant := registerDelayedMerchant(t, k, ctx, 48*time.Hour)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// Merchants can neither shorten nor lengthen their delay
+	for _, delay := range []time.Duration{0, time.Hour, 96 * time.Hour} {
+		_, err := msgServer.UpdateMerchant(ctx, &types.MsgUpdateMerchant{Authority: merchant.String(), Merchant: merchant.String(), SettlementDelay: &delay})
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	}
+
+	shorter := time.Hour
+	_, err := msgServer.UpdateMerchant(ctx, &types.MsgUpdateMerchant{Authority: k.GetAuthority(), Merchant: merchant.String(), SettlementDelay: &shorter})
+	require.NoError(t, err)
+	config, _ := k.GetMerchant(ctx, merchant.String())
+	require.Equal(t, time.Hour, config.SettlementDelay)
+
+	// Nor choose one when registering themselves
+	other := newSettlementAddress()
+	_, err = msgServer.RegisterMerchant(ctx, &types.MsgRegisterMerchant{Authority: other.String(), Merchant: other.String(), Name: "Other", SettlementDelay: time.Hour})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestDelayedPayout_DeactivatedMerchantStillDelayed(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := registerDelayedMerchant(t, k, ctx, 72*time.Hour)
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	inactive := false
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.UpdateMerchant(ctx, &types.MsgUpdateMerchant{Authority: merchant.String(), Merchant: merchant.String(), IsActive: &inactive})
+	require.NoError(t, err)
+
+	settlementId, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), "ORDER-1", "")
+	require.NoError(t, err)
+
+	require.True(t, bankKeeper.GetBalance(ctx, merchant, "ssusd").IsZero())
+	payout, found := k.GetDelayedPayout(ctx, settlementId)
+	require.True(t, found)
+	require.Equal(t, types.PayoutStatusPending, payout.Status)
+}
@@ -103,6 +103,52 @@ func (q queryServer) SettlementsByStatus(goCtx context.Context, req *types.Query
 	}, nil
 }
 
+// SettlementsBySender returns a page of the settlements sent by an address
+func (q queryServer) SettlementsBySender(goCtx context.Context, req *types.QuerySettlementsBySenderRequest) (*types.QuerySettlementsBySenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	settlements, pageRes, err := q.Keeper.SettlementsBySender(ctx, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementsBySenderResponse{
+		Settlements: settlements,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// SettlementsByRecipient returns a page of the settlements received by an address
+func (q queryServer) SettlementsByRecipient(goCtx context.Context, req *types.QuerySettlementsByRecipientRequest) (*types.QuerySettlementsByRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	settlements, pageRes, err := q.Keeper.SettlementsByRecipient(ctx, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementsByRecipientResponse{
+		Settlements: settlements,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// SettlementsByReference returns a page of the settlements carrying an
+// external reference
+func (q queryServer) SettlementsByReference(goCtx context.Context, req *types.QuerySettlementsByReferenceRequest) (*types.QuerySettlementsByReferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	settlements, pageRes, err := q.Keeper.SettlementsByReference(ctx, req.Reference, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySettlementsByReferenceResponse{
+		Settlements: settlements,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // Batch returns a batch by ID
 func (q queryServer) Batch(goCtx context.Context, req *types.QueryBatchRequest) (*types.QueryBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -279,6 +325,422 @@ func (q queryServer) Merchants(goCtx context.Context, req *types.QueryMerchantsR
 	}, nil
 }
 
+// Subscription returns a subscription by ID
+func (q queryServer) Subscription(goCtx context.Context, req *types.QuerySubscriptionRequest) (*types.QuerySubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscription, found := q.Keeper.GetSubscription(ctx, req.Id)
+	if !found {
+		return nil, types.ErrSubscriptionNotFound
+	}
+
+	return &types.QuerySubscriptionResponse{
+		Subscription: subscription,
+	}, nil
+}
+
+// SubscriptionsByPayer returns the subscriptions paid by an address
+func (q queryServer) SubscriptionsByPayer(goCtx context.Context, req *types.QuerySubscriptionsByPayerRequest) (*types.QuerySubscriptionsByPayerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscriptions, matched := q.subscriptionsMatching(ctx, req.Offset, req.Limit, func(s types.Subscription) bool {
+		return s.Payer == req.Payer
+	})
+
+	return &types.QuerySubscriptionsByPayerResponse{
+		Subscriptions: subscriptions,
+		Total:         matched,
+	}, nil
+}
+
+// SubscriptionsByMerchant returns the subscriptions paying a merchant
+func (q queryServer) SubscriptionsByMerchant(goCtx context.Context, req *types.QuerySubscriptionsByMerchantRequest) (*types.QuerySubscriptionsByMerchantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subscriptions, matched := q.subscriptionsMatching(ctx, req.Offset, req.Limit, func(s types.Subscription) bool {
+		return s.Merchant == req.Merchant
+	})
+
+	return &types.QuerySubscriptionsByMerchantResponse{
+		Subscriptions: subscriptions,
+		Total:         matched,
+	}, nil
+}
+
+func (q queryServer) subscriptionsMatching(ctx sdk.Context, offset, limit uint64, match func(types.Subscription) bool) ([]types.Subscription, uint64) {
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var subscriptions []types.Subscription
+	var matched uint64
+
+	q.Keeper.IterateSubscriptions(ctx, func(s types.Subscription) bool {
+		if match(s) {
+			if matched >= offset && uint64(len(subscriptions)) < limit {
+				subscriptions = append(subscriptions, s)
+			}
+			matched++
+		}
+		return false
+	})
+
+	return subscriptions, matched
+}
+
+// BidirectionalChannel returns a bidirectional channel by ID
+func (q queryServer) BidirectionalChannel(goCtx context.Context, req *types.QueryBidirectionalChannelRequest) (*types.QueryBidirectionalChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channel, found := q.Keeper.GetBidirectionalChannel(ctx, req.Id)
+	if !found {
+		return nil, types.ErrChannelNotFound
+	}
+
+	return &types.QueryBidirectionalChannelResponse{
+		Channel: channel,
+	}, nil
+}
+
+// BidirectionalChannelsByParty returns the bidirectional channels an address is a party to
+func (q queryServer) BidirectionalChannelsByParty(goCtx context.Context, req *types.QueryBidirectionalChannelsByPartyRequest) (*types.QueryBidirectionalChannelsByPartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var channels []types.BidirectionalChannel
+	var matched uint64
+
+	q.Keeper.IterateBidirectionalChannels(ctx, func(c types.BidirectionalChannel) bool {
+		if c.PartyA == req.Party || c.PartyB == req.Party {
+			if matched >= req.Offset && uint64(len(channels)) < limit {
+				channels = append(channels, c)
+			}
+			matched++
+		}
+		return false
+	})
+
+	return &types.QueryBidirectionalChannelsByPartyResponse{
+		Channels: channels,
+		Total:    matched,
+	}, nil
+}
+
+// HTLC returns an HTLC by ID
+func (q queryServer) HTLC(goCtx context.Context, req *types.QueryHTLCRequest) (*types.QueryHTLCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	htlc, found := q.Keeper.GetHTLC(ctx, req.Id)
+	if !found {
+		return nil, types.ErrHTLCNotFound
+	}
+
+	return &types.QueryHTLCResponse{
+		Htlc: htlc,
+	}, nil
+}
+
+// HTLCsByParty returns the HTLCs an address sends or receives
+func (q queryServer) HTLCsByParty(goCtx context.Context, req *types.QueryHTLCsByPartyRequest) (*types.QueryHTLCsByPartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var htlcs []types.HTLC
+	var matched uint64
+
+	q.Keeper.IterateHTLCs(ctx, func(h types.HTLC) bool {
+		if h.Sender == req.Party || h.Recipient == req.Party {
+			if matched >= req.Offset && uint64(len(htlcs)) < limit {
+				htlcs = append(htlcs, h)
+			}
+			matched++
+		}
+		return false
+	})
+
+	return &types.QueryHTLCsByPartyResponse{
+		Htlcs: htlcs,
+		Total: matched,
+	}, nil
+}
+
+// DelayedPayout returns the delayed payout of a settlement
+func (q queryServer) DelayedPayout(goCtx context.Context, req *types.QueryDelayedPayoutRequest) (*types.QueryDelayedPayoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payout, found := q.Keeper.GetDelayedPayout(ctx, req.SettlementId)
+	if !found {
+		return nil, types.ErrPayoutNotFound
+	}
+
+	return &types.QueryDelayedPayoutResponse{
+		Payout: payout,
+	}, nil
+}
+
+// SplitTemplate returns a split template of a merchant by name
+func (q queryServer) SplitTemplate(goCtx context.Context, req *types.QuerySplitTemplateRequest) (*types.QuerySplitTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	template, found := q.Keeper.GetSplitTemplate(ctx, req.Merchant, req.Name)
+	if !found {
+		return nil, types.ErrSplitTemplateNotFound
+	}
+
+	return &types.QuerySplitTemplateResponse{
+		Template: template,
+	}, nil
+}
+
+// PayoutsByMerchant returns a page of the delayed payout ledger of a merchant
+// along with the total amount still held
+func (q queryServer) PayoutsByMerchant(goCtx context.Context, req *types.QueryPayoutsByMerchantRequest) (*types.QueryPayoutsByMerchantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payouts, held, pageRes, err := q.Keeper.PayoutsByMerchant(ctx, req.Merchant, types.PayoutStatus(req.Status), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPayoutsByMerchantResponse{
+		Payouts:    payouts,
+		Pagination: pageRes,
+		Held:       held,
+	}, nil
+}
+
+// NettingCycle returns a netting cycle by ID
+func (q queryServer) NettingCycle(goCtx context.Context, req *types.QueryNettingCycleRequest) (*types.QueryNettingCycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cycle, found := q.Keeper.GetNettingCycle(ctx, req.Id)
+	if !found {
+		return nil, types.ErrNettingCycleNotFound
+	}
+
+	return &types.QueryNettingCycleResponse{
+		Cycle: cycle,
+	}, nil
+}
+
+// NettingObligations returns the obligations submitted to a netting cycle
+func (q queryServer) NettingObligations(goCtx context.Context, req *types.QueryNettingObligationsRequest) (*types.QueryNettingObligationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	all := q.Keeper.GetNettingObligations(ctx, req.CycleId)
+	total := uint64(len(all))
+
+	var obligations []types.NettingObligation
+	if req.Offset < total {
+		end := req.Offset + limit
+		if end > total {
+			end = total
+		}
+		obligations = all[req.Offset:end]
+	}
+
+	return &types.QueryNettingObligationsResponse{
+		Obligations: obligations,
+		Total:       total,
+	}, nil
+}
+
+// NettingReport compares the gross obligations of a netting cycle with the
+// net positions and transfers that settle them
+func (q queryServer) NettingReport(goCtx context.Context, req *types.QueryNettingReportRequest) (*types.QueryNettingReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cycle, found := q.Keeper.GetNettingCycle(ctx, req.CycleId)
+	if !found {
+		return nil, types.ErrNettingCycleNotFound
+	}
+
+	positions, transfers := q.Keeper.NettingReport(ctx, cycle)
+	gross, bilateralNet, net := types.NettingTotals(cycle.Denom, positions)
+
+	return &types.QueryNettingReportResponse{
+		Cycle:             cycle,
+		Positions:         positions,
+		Transfers:         transfers,
+		GrossTotal:        gross,
+		BilateralNetTotal: bilateralNet,
+		NetTotal:          net,
+	}, nil
+}
+
+// Mandate returns a direct debit mandate by ID
+func (q queryServer) Mandate(goCtx context.Context, req *types.QueryMandateRequest) (*types.QueryMandateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mandate, found := q.Keeper.GetMandate(ctx, req.Id)
+	if !found {
+		return nil, types.ErrMandateNotFound
+	}
+
+	return &types.QueryMandateResponse{
+		Mandate: mandate,
+	}, nil
+}
+
+// MandatesByCustomer returns the mandates granted by a customer
+func (q queryServer) MandatesByCustomer(goCtx context.Context, req *types.QueryMandatesByCustomerRequest) (*types.QueryMandatesByCustomerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mandates, matched := q.mandatesMatching(ctx, req.Offset, req.Limit, func(m types.Mandate) bool {
+		return m.Customer == req.Customer
+	})
+
+	return &types.QueryMandatesByCustomerResponse{
+		Mandates: mandates,
+		Total:    matched,
+	}, nil
+}
+
+// MandatesByMerchant returns the mandates granted to a merchant
+func (q queryServer) MandatesByMerchant(goCtx context.Context, req *types.QueryMandatesByMerchantRequest) (*types.QueryMandatesByMerchantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mandates, matched := q.mandatesMatching(ctx, req.Offset, req.Limit, func(m types.Mandate) bool {
+		return m.Merchant == req.Merchant
+	})
+
+	return &types.QueryMandatesByMerchantResponse{
+		Mandates: mandates,
+		Total:    matched,
+	}, nil
+}
+
+func (q queryServer) mandatesMatching(ctx sdk.Context, offset, limit uint64, match func(types.Mandate) bool) ([]types.Mandate, uint64) {
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var mandates []types.Mandate
+	var matched uint64
+
+	q.Keeper.IterateMandates(ctx, func(m types.Mandate) bool {
+		if match(m) {
+			if matched >= offset && uint64(len(mandates)) < limit {
+				mandates = append(mandates, m)
+			}
+			matched++
+		}
+		return false
+	})
+
+	return mandates, matched
+}
+
+// Stream returns a stream by ID with what has accrued as of the current block
+func (q queryServer) Stream(goCtx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stream, found := q.Keeper.GetStream(ctx, req.Id)
+	if !found {
+		return nil, types.ErrStreamNotFound
+	}
+
+	return &types.QueryStreamResponse{
+		Stream:       stream,
+		Accrued:      stream.Accrued(ctx.BlockTime()),
+		Withdrawable: stream.Withdrawable(ctx.BlockTime()),
+		FundedUntil:  stream.FundedUntil(),
+	}, nil
+}
+
+// StreamsByParty returns the streams an address pays or receives
+func (q queryServer) StreamsByParty(goCtx context.Context, req *types.QueryStreamsByPartyRequest) (*types.QueryStreamsByPartyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := q.Keeper.GetParams(ctx)
+	maxLimit := uint64(params.MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > maxLimit {
+		limit = maxLimit
+	}
+	offset := req.Offset
+
+	var streams []types.Stream
+	var matched uint64
+
+	q.Keeper.IterateStreams(ctx, func(s types.Stream) bool {
+		if s.Payer == req.Address || s.Recipient == req.Address {
+			if matched >= offset && uint64(len(streams)) < limit {
+				streams = append(streams, s)
+			}
+			matched++
+		}
+		return false
+	})
+
+	return &types.QueryStreamsByPartyResponse{
+		Streams: streams,
+		Total:   matched,
+	}, nil
+}
+
+// Statement returns the settlement statement of an account
+func (q queryServer) Statement(goCtx context.Context, req *types.QueryStatementRequest) (*types.QueryStatementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	statement, pageRes, err := q.Keeper.Statement(ctx, req.Account, req.Denom, req.FromHeight, req.ToHeight, req.FromTime, req.ToTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStatementResponse{
+		Statement:  statement,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params returns the module parameters
 func (q queryServer) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Settlement Indexes
+// ============================================================================
+//
+// Settlements are indexed by sender, by recipient and by external reference so
+// parties and order IDs can be looked up without scanning every settlement.
+// Index keys are the indexed value followed by the settlement ID, so a party's
+// settlements are iterated in creation order. Parties are length prefixed and
+// references are hashed, which keeps keys bounded for references of any
+// length. storeSettlement maintains the indexes; Migrate2to3 backfills them for
+// settlements stored before they existed.
+
+func validIndexedParty(party string) bool {
+	return party != "" && len(party) <= address.MaxAddrLen
+}
+
+func partyIndexPrefix(indexPrefix []byte, party string) []byte {
+	return append(append([]byte{}, indexPrefix...), address.MustLengthPrefix([]byte(party))...)
+}
+
+func referenceIndexPrefix(reference string) []byte {
+	hash := sha256.Sum256([]byte(reference))
+	return append(append([]byte{}, types.SettlementByReferencePrefix...), hash[:]...)
+}
+
+// settlementIndexPrefixes returns the index prefixes a settlement is stored under
+func settlementIndexPrefixes(settlement types.Settlement) [][]byte {
+	var prefixes [][]byte
+	if validIndexedParty(settlement.Sender) {
+		prefixes = append(prefixes, partyIndexPrefix(types.SettlementBySenderPrefix, settlement.Sender))
+	}
+	if validIndexedParty(settlement.Recipient) {
+		prefixes = append(prefixes, partyIndexPrefix(types.SettlementByRecipientPrefix, settlement.Recipient))
+	}
+	if settlement.Reference != "" {
+		prefixes = append(prefixes, referenceIndexPrefix(settlement.Reference))
+	}
+	return prefixes
+}
+
+// indexSettlement writes the index entries of a settlement, removing those of
+// its previous version when an indexed field changed
+func (k Keeper) indexSettlement(ctx sdk.Context, previous *types.Settlement, settlement types.Settlement) {
+	store := ctx.KVStore(k.storeKey)
+	id := mustWriteUint64(settlement.Id)
+	if previous != nil {
+		if previous.Sender == settlement.Sender && previous.Recipient == settlement.Recipient && previous.Reference == settlement.Reference {
+			return
+		}
+		for _, indexPrefix := range settlementIndexPrefixes(*previous) {
+			store.Delete(append(indexPrefix, id...))
+		}
+	}
+	for _, indexPrefix := range settlementIndexPrefixes(settlement) {
+		store.Set(append(indexPrefix, id...), id)
+	}
+}
+
+// RebuildSettlementIndexes writes the index entries of every stored settlement
+func (k Keeper) RebuildSettlementIndexes(ctx sdk.Context) {
+	k.IterateSettlements(ctx, func(s types.Settlement) bool {
+		k.indexSettlement(ctx, nil, s)
+		return false
+	})
+}
+
+// settlementPage copies a page request, capping its size at the MaxQueryLimit
+// param
+func (k Keeper) settlementPage(ctx sdk.Context, pageReq *query.PageRequest) query.PageRequest {
+	maxLimit := uint64(k.GetParams(ctx).MaxQueryLimit)
+	if maxLimit == 0 {
+		maxLimit = 100
+	}
+	page := query.PageRequest{}
+	if pageReq != nil {
+		page = *pageReq
+	}
+	if page.Limit == 0 || page.Limit > maxLimit {
+		page.Limit = maxLimit
+	}
+	return page
+}
+
+// paginateSettlementIndex returns a page of the settlements stored under an
+// index prefix, capping the page size at the MaxQueryLimit param
+func (k Keeper) paginateSettlementIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.Settlement, *query.PageResponse, error) {
+	page := k.settlementPage(ctx, pageReq)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	var settlements []types.Settlement
+	pageRes, err := query.Paginate(store, &page, func(_, value []byte) error {
+		if settlement, found := k.GetSettlement(ctx, binary.BigEndian.Uint64(value)); found {
+			settlements = append(settlements, settlement)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return settlements, pageRes, nil
+}
+
+// SettlementsBySender returns a page of the settlements sent by an address
+func (k Keeper) SettlementsBySender(ctx sdk.Context, sender string, pageReq *query.PageRequest) ([]types.Settlement, *query.PageResponse, error) {
+	if !validIndexedParty(sender) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "invalid sender")
+	}
+	return k.paginateSettlementIndex(ctx, partyIndexPrefix(types.SettlementBySenderPrefix, sender), pageReq)
+}
+
+// SettlementsByRecipient returns a page of the settlements received by an address
+func (k Keeper) SettlementsByRecipient(ctx sdk.Context, recipient string, pageReq *query.PageRequest) ([]types.Settlement, *query.PageResponse, error) {
+	if !validIndexedParty(recipient) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "invalid recipient")
+	}
+	return k.paginateSettlementIndex(ctx, partyIndexPrefix(types.SettlementByRecipientPrefix, recipient), pageReq)
+}
+
+// SettlementsByReference returns a page of the settlements carrying an
+// external reference
+func (k Keeper) SettlementsByReference(ctx sdk.Context, reference string, pageReq *query.PageRequest) ([]types.Settlement, *query.PageResponse, error) {
+	if reference == "" {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "reference cannot be empty")
+	}
+	return k.paginateSettlementIndex(ctx, referenceIndexPrefix(reference), pageReq)
+}
+
+// accountSettlements returns a page of the settlements an address sent or
+// received, in creation order. The sender and recipient indexes are merged, so
+// the page key is the ID of the next settlement.
+func (k Keeper) accountSettlements(ctx sdk.Context, account string, pageReq *query.PageRequest) ([]types.Settlement, *query.PageResponse, error) {
+	if !validIndexedParty(account) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "invalid account")
+	}
+	page := k.settlementPage(ctx, pageReq)
+	if page.Reverse {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "account settlements cannot be paged in reverse")
+	}
+	if len(page.Key) > 0 {
+		if page.Offset > 0 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "page key and offset cannot both be set")
+		}
+		if len(page.Key) != 8 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidSettlement, "invalid page key")
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	sent := prefix.NewStore(store, partyIndexPrefix(types.SettlementBySenderPrefix, account)).Iterator(page.Key, nil)
+	defer sent.Close()
+	received := prefix.NewStore(store, partyIndexPrefix(types.SettlementByRecipientPrefix, account)).Iterator(page.Key, nil)
+	defer received.Close()
+
+	var settlements []types.Settlement
+	var skipped uint64
+	for sent.Valid() || received.Valid() {
+		var id []byte
+		if !received.Valid() || (sent.Valid() && bytes.Compare(sent.Key(), received.Key()) <= 0) {
+			id = append(id, sent.Key()...)
+		} else {
+			id = append(id, received.Key()...)
+		}
+		if uint64(len(settlements)) == page.Limit {
+			return settlements, &query.PageResponse{NextKey: id}, nil
+		}
+
+		// A settlement an account paid to itself is in both indexes
+		if sent.Valid() && bytes.Equal(sent.Key(), id) {
+			sent.Next()
+		}
+		if received.Valid() && bytes.Equal(received.Key(), id) {
+			received.Next()
+		}
+		if skipped < page.Offset {
+			skipped++
+			continue
+		}
+		if settlement, found := k.GetSettlement(ctx, binary.BigEndian.Uint64(id)); found {
+			settlements = append(settlements, settlement)
+		}
+	}
+	return settlements, &query.PageResponse{}, nil
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Split Payouts
+// ============================================================================
+//
+// A checkout can pay its net amount to several payees: a seller's platform,
+// affiliates or a tax authority take their share by basis points or a fixed
+// amount and the merchant is paid the remainder. The merchant registers its
+// splits as split templates: a default template and named templates a
+// checkout selects by name. Since the customer signs the checkout, it can only
+// choose among the splits the merchant registered. Every leg is recorded on
+// the checkout's settlement. Refunds are taken back from all payees in
+// proportion to what each holds: out of the payout while it is held, and from
+// each payee's account once it is paid out.
+
+// SetSplitTemplate sets a split applied to the merchant's checkouts: the
+// default template without a name, or a template checkouts select by name.
+// Empty rules remove the template.
+func (k Keeper) SetSplitTemplate(ctx sdk.Context, merchant, name string, rules []types.SplitRule) error {
+	config, found := k.GetMerchant(ctx, merchant)
+	if !found {
+		return types.ErrMerchantNotFound
+	}
+	if !config.IsActive {
+		return types.ErrMerchantInactive
+	}
+	if err := types.ValidateSplitName(name); err != nil {
+		return err
+	}
+	if err := types.ValidateSplitRules(merchant, rules); err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		k.deleteSplitTemplate(ctx, merchant, name)
+	} else {
+		k.storeSplitTemplate(ctx, types.SplitTemplate{Merchant: merchant, Name: name, Rules: rules})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSplitTemplateSet,
+			sdk.NewAttribute(types.AttributeKeyMerchant, merchant),
+			sdk.NewAttribute(types.AttributeKeySplitName, name),
+			sdk.NewAttribute(types.AttributeKeyRules, fmt.Sprintf("%d", len(rules))),
+		),
+	)
+	return nil
+}
+
+// checkoutSplitRules returns the split of a checkout: the merchant's template
+// the checkout names, or its default template. A checkout naming a split the
+// merchant did not register is rejected.
+func (k Keeper) checkoutSplitRules(ctx sdk.Context, merchant, splitName string) ([]types.SplitRule, error) {
+	template, found := k.GetSplitTemplate(ctx, merchant, splitName)
+	if !found && splitName != "" {
+		return nil, types.ErrSplitTemplateNotFound.Wrapf("merchant %s has no split %q", merchant, splitName)
+	}
+	return template.Rules, nil
+}
+
+// splitLegs computes the legs paying a split settlement's net amount,
+// requiring every payee to pass compliance
+func (k Keeper) splitLegs(ctx sdk.Context, merchant string, net sdk.Coin, rules []types.SplitRule) ([]types.PayoutLeg, error) {
+	if err := types.ValidateSplitRules(merchant, rules); err != nil {
+		return nil, err
+	}
+	legs, err := types.ComputePayoutLegs(merchant, net, rules)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.assertPayeesCompliant(ctx, legs); err != nil {
+		return nil, err
+	}
+	return legs, nil
+}
+
+func (k Keeper) assertPayeesCompliant(ctx sdk.Context, legs []types.PayoutLeg) error {
+	for _, leg := range legs {
+		payee, err := sdk.AccAddressFromBech32(leg.Payee)
+		if err != nil {
+			return types.ErrInvalidRecipient
+		}
+		if err := k.compKeeper.AssertCompliant(sdk.WrapSDKContext(ctx), payee); err != nil {
+			return types.ErrComplianceCheckFailed.Wrapf("payee %s", leg.Payee)
+		}
+	}
+	return nil
+}
+
+// payLegs pays each payee of a split settlement what it holds of its leg out
+// of the module account
+func (k Keeper) payLegs(ctx sdk.Context, settlement types.Settlement) error {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+	for _, leg := range settlement.Legs {
+		amount := leg.Remaining()
+		if !amount.IsPositive() {
+			continue
+		}
+		payee, err := sdk.AccAddressFromBech32(leg.Payee)
+		if err != nil {
+			return types.ErrInvalidRecipient
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, payee, sdk.NewCoins(amount)); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSplitPaid,
+				sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
+				sdk.NewAttribute(types.AttributeKeyPayee, leg.Payee),
+				sdk.NewAttribute(types.AttributeKeyRole, leg.Role),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyReference, settlement.Reference),
+			),
+		)
+	}
+	return nil
+}
+
+// refundLegs returns part of a split settlement to the customer, taken from
+// every leg in proportion to what each payee holds. While the payout is held
+// the refund comes out of the module account; once the legs are paid out each
+// payee returns its share, and the refund fails if one cannot. It returns what
+// is left to refund of the settlement and the merchant's share of the refund.
+func (k Keeper) refundLegs(ctx sdk.Context, settlement *types.Settlement, customerAddr sdk.AccAddress, refundAmount sdk.Coin) (sdk.Coin, sdk.Coin, error) {
+	refunded := sdkmath.ZeroInt()
+	for _, refund := range settlement.Refunds {
+		refunded = refunded.Add(refund.Amount.Amount)
+	}
+	remaining := settlement.NetAmount.Amount.Sub(refunded)
+	if refundAmount.Amount.GT(remaining) {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrRefundTooLarge
+	}
+	remaining = remaining.Sub(refundAmount.Amount)
+
+	shares, err := types.AllocateRefund(settlement.Legs, refundAmount)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	payout, found := k.GetDelayedPayout(ctx, settlement.Id)
+	held := found && payout.Status.IsHeld()
+	if held {
+		if err := k.refundHeldPayout(ctx, payout, customerAddr, refundAmount); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	merchantShare := sdk.NewCoin(refundAmount.Denom, shares[len(shares)-1])
+	for i, leg := range settlement.Legs {
+		share := sdk.NewCoin(refundAmount.Denom, shares[i])
+		if !share.IsPositive() {
+			continue
+		}
+		if !held {
+			payee, err := sdk.AccAddressFromBech32(leg.Payee)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, types.ErrInvalidRecipient
+			}
+			if err := k.bankKeeper.SendCoins(sdk.WrapSDKContext(ctx), payee, customerAddr, sdk.NewCoins(share)); err != nil {
+				return sdk.Coin{}, sdk.Coin{}, types.ErrInsufficientFunds.Wrapf("payee %s cannot return its share %s", leg.Payee, share)
+			}
+		}
+		settlement.Legs[i].Refunded = leg.Refunded.Add(share)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSplitRefunded,
+				sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlement.Id)),
+				sdk.NewAttribute(types.AttributeKeyPayee, leg.Payee),
+				sdk.NewAttribute(types.AttributeKeyRole, leg.Role),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+
+	return sdk.NewCoin(refundAmount.Denom, remaining), merchantShare, nil
+}
+
+func splitTemplateKey(merchant, name string) []byte {
+	return append(address.MustLengthPrefix([]byte(merchant)), name...)
+}
+
+func (k Keeper) storeSplitTemplate(ctx sdk.Context, template types.SplitTemplate) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SplitTemplateKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&template)
+	store.Set(splitTemplateKey(template.Merchant, template.Name), bz)
+}
+
+func (k Keeper) deleteSplitTemplate(ctx sdk.Context, merchant, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SplitTemplateKeyPrefix)
+	store.Delete(splitTemplateKey(merchant, name))
+}
+
+// GetSplitTemplate retrieves a split template of a merchant by name; the
+// empty name is the merchant's default template
+func (k Keeper) GetSplitTemplate(ctx sdk.Context, merchant, name string) (types.SplitTemplate, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SplitTemplateKeyPrefix)
+	bz := store.Get(splitTemplateKey(merchant, name))
+	if len(bz) == 0 {
+		return types.SplitTemplate{}, false
+	}
+	var template types.SplitTemplate
+	types.ModuleCdc.MustUnmarshalJSON(bz, &template)
+	return template, true
+}
+
+// IterateSplitTemplates iterates over all merchant split templates
+func (k Keeper) IterateSplitTemplates(ctx sdk.Context, cb func(types.SplitTemplate) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SplitTemplateKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var template types.SplitTemplate
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &template)
+		if cb(template) {
+			break
+		}
+	}
+}
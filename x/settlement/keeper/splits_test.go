@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+type splitPayees struct {
+	merchant, platform, affiliate, tax sdk.AccAddress
+}
+
+// setupSplitMerchant registers a merchant charged a 1% fee whose checkouts pay
+// 10% of the net amount to the platform, 50000 to an affiliate and 8% to the
+// tax authority
+func setupSplitMerchant(t *testing.T, k keeper.Keeper, ctx sdk.Context, delay time.Duration) splitPayees {
+	p := splitPayees{newSettlementAddress(), newSettlementAddress(), newSettlementAddress(), newSettlementAddress()}
+	require.NoError(t, k.RegisterMerchant(ctx, types.MerchantConfig{
+		Address:         p.merchant.String(),
+		Name:            "Marketplace Seller",
+		FeeRateBps:      100,
+		SettlementDelay: delay,
+	}))
+	require.NoError(t, k.SetSplitTemplate(ctx, p.merchant.String(), "", []types.SplitRule{
+		{Payee: p.platform.String(), Role: "platform", Bps: 1000},
+		{Payee: p.affiliate.String(), Role: "affiliate", Amount: ssusd(50000)},
+		{Payee: p.tax.String(), Role: "tax", Bps: 800},
+	}))
+	return p
+}
+
+func TestSplits_CheckoutPaysTemplatePayees(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(1000000)))
+
+	settlementId, netAmount, fee, err := k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(1000000), "ORDER-1", false, "", "")
+	require.NoError(t, err)
+	require.Equal(t, ssusd(990000), netAmount)
+	require.Equal(t, ssusd(10000), fee)
+
+	require.Equal(t, sdkmath.NewInt(99000), bankKeeper.GetBalance(ctx, p.platform, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(50000), bankKeeper.GetBalance(ctx, p.affiliate, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(79200), bankKeeper.GetBalance(ctx, p.tax, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(761800), bankKeeper.GetBalance(ctx, p.merchant, "ssusd").Amount)
+
+	// Every leg is recorded under the one settlement
+	settlement, _ := k.GetSettlement(ctx, settlementId)
+	require.Equal(t, "ORDER-1", settlement.Reference)
+	require.Len(t, settlement.Legs, 4)
+	require.Equal(t, p.merchant.String(), settlement.Legs[3].Payee)
+	require.Equal(t, types.SplitRoleMerchant, settlement.Legs[3].Role)
+	require.Equal(t, ssusd(761800), settlement.Legs[3].Amount)
+}
+
+func TestSplits_CheckoutSelectsNamedSplit(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	require.NoError(t, k.SetSplitTemplate(ctx, p.merchant.String(), "direct", []types.SplitRule{
+		{Payee: p.platform.String(), Role: "platform", Bps: 1000},
+		{Payee: p.tax.String(), Role: "tax", Bps: 800},
+	}))
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(2000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	checkout := func(ref, splitName string) (*types.MsgInstantCheckoutResponse, error) {
+		return msgServer.InstantCheckout(ctx, &types.MsgInstantCheckout{
+			Customer:       customer.String(),
+			Merchant:       p.merchant.String(),
+			Amount:         ssusd(1000000),
+			OrderReference: ref,
+			SplitName:      splitName,
+		})
+	}
+
+	// A checkout without an affiliate selects the merchant's direct split
+	res, err := checkout("ORDER-2", "direct")
+	require.NoError(t, err)
+	settlement, _ := k.GetSettlement(ctx, res.SettlementId)
+	require.Len(t, settlement.Legs, 3)
+	require.True(t, bankKeeper.GetBalance(ctx, p.affiliate, "ssusd").IsZero())
+	require.Equal(t, sdkmath.NewInt(811800), bankKeeper.GetBalance(ctx, p.merchant, "ssusd").Amount)
+
+	// It cannot select a split the merchant did not register
+	_, err = checkout("ORDER-3", "customer")
+	require.ErrorIs(t, err, types.ErrSplitTemplateNotFound)
+	require.Equal(t, sdkmath.NewInt(1000000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+
+	// Selecting none applies the default template
+	res, err = checkout("ORDER-3", "")
+	require.NoError(t, err)
+	settlement, _ = k.GetSettlement(ctx, res.SettlementId)
+	require.Len(t, settlement.Legs, 4)
+	require.Equal(t, sdkmath.NewInt(50000), bankKeeper.GetBalance(ctx, p.affiliate, "ssusd").Amount)
+
+	// Removing the named split leaves the default template in place
+	require.NoError(t, k.SetSplitTemplate(ctx, p.merchant.String(), "direct", nil))
+	_, found := k.GetSplitTemplate(ctx, p.merchant.String(), "direct")
+	require.False(t, found)
+	_, found = k.GetSplitTemplate(ctx, p.merchant.String(), "")
+	require.True(t, found)
+}
+
+func TestSplits_RefundAfterPayoutClawsBackLegs(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(1000000)))
+
+	settlementId, _, _, err := k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(1000000), "ORDER-3", false, "", "")
+	require.NoError(t, err)
+
+	remaining, err := k.PartialRefund(ctx, p.merchant.String(), settlementId, ssusd(99000), "one item returned")
+	require.NoError(t, err)
+	require.Equal(t, ssusd(891000), remaining)
+	require.Equal(t, sdkmath.NewInt(99000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+
+	// Every payee returns a tenth of its leg
+	require.Equal(t, sdkmath.NewInt(89100), bankKeeper.GetBalance(ctx, p.platform, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(45000), bankKeeper.GetBalance(ctx, p.affiliate, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(71280), bankKeeper.GetBalance(ctx, p.tax, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(685620), bankKeeper.GetBalance(ctx, p.merchant, "ssusd").Amount)
+
+	settlement, _ := k.GetSettlement(ctx, settlementId)
+	require.Equal(t, ssusd(9900), settlement.Legs[0].Refunded)
+	require.Equal(t, ssusd(76180), settlement.Refunds[0].RecipientAmount)
+
+	// A payee that spent its leg blocks the refund, and nothing moves
+	bankKeeper.SetBalance(p.platform.String(), sdk.NewCoins())
+	_, err = k.PartialRefund(ctx, p.merchant.String(), settlementId, ssusd(891000), "order cancelled")
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	require.Equal(t, sdkmath.NewInt(99000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+
+	bankKeeper.SetBalance(p.platform.String(), sdk.NewCoins(ssusd(89100)))
+	_, err = k.PartialRefund(ctx, p.merchant.String(), settlementId, ssusd(891001), "order cancelled")
+	require.ErrorIs(t, err, types.ErrRefundTooLarge)
+
+	remaining, err = k.PartialRefund(ctx, p.merchant.String(), settlementId, ssusd(891000), "order cancelled")
+	require.NoError(t, err)
+	require.True(t, remaining.IsZero())
+	settlement, _ = k.GetSettlement(ctx, settlementId)
+	require.Equal(t, types.SettlementStatusRefunded, settlement.Status)
+	require.Equal(t, sdkmath.NewInt(990000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+	for _, payee := range []sdk.AccAddress{p.platform, p.affiliate, p.tax, p.merchant} {
+		require.True(t, bankKeeper.GetBalance(ctx, payee, "ssusd").IsZero())
+	}
+}
+
+func TestSplits_EscrowPaysLegsOnRelease(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(1000000)))
+
+	settlementId, _, _, err := k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(1000000), "ORDER-5", true, "", "")
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, settlementId)
+	require.Equal(t, types.SettlementStatusPending, settlement.Status)
+	require.Len(t, settlement.Legs, 4)
+	require.True(t, bankKeeper.GetBalance(ctx, p.platform, "ssusd").IsZero())
+
+	require.NoError(t, k.ReleaseEscrow(ctx, settlementId, customer))
+	require.Equal(t, sdkmath.NewInt(99000), bankKeeper.GetBalance(ctx, p.platform, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(761800), bankKeeper.GetBalance(ctx, p.merchant, "ssusd").Amount)
+}
+
+func TestSplits_DelayedPayoutReleasesLegs(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, time.Hour)
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(1000000)))
+
+	settlementId, _, _, err := k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(1000000), "ORDER-6", false, "", "")
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetBalance(ctx, p.platform, "ssusd").IsZero())
+
+	// A refund while the payout is held comes out of the module account
+	_, err = k.PartialRefund(ctx, p.merchant.String(), settlementId, ssusd(99000), "")
+	require.NoError(t, err)
+	payout, _ := k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, ssusd(891000), payout.Amount)
+
+	k.ProcessDuePayouts(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Equal(t, sdkmath.NewInt(89100), bankKeeper.GetBalance(ctx, p.platform, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(45000), bankKeeper.GetBalance(ctx, p.affiliate, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(71280), bankKeeper.GetBalance(ctx, p.tax, "ssusd").Amount)
+	require.Equal(t, sdkmath.NewInt(685620), bankKeeper.GetBalance(ctx, p.merchant, "ssusd").Amount)
+	require.True(t, bankKeeper.moduleBalances[types.ModuleAccountName].AmountOf("ssusd").Equal(sdkmath.NewInt(10000)))
+
+	payout, _ = k.GetDelayedPayout(ctx, settlementId)
+	require.Equal(t, types.PayoutStatusReleased, payout.Status)
+}
+
+func TestSplits_RejectsInvalidSplits(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	customer := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(1000000)))
+
+	// The fixed affiliate share exceeds the net amount of a small checkout
+	_, _, _, err := k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(40000), "ORDER-7", false, "", "")
+	require.ErrorIs(t, err, types.ErrInvalidSplit)
+	require.Equal(t, sdkmath.NewInt(1000000), bankKeeper.GetBalance(ctx, customer, "ssusd").Amount)
+
+	complianceKeeper.sanctionedAddresses[p.tax.String()] = true
+	_, _, _, err = k.InstantCheckout(ctx, customer.String(), p.merchant.String(), ssusd(1000000), "ORDER-8", false, "", "")
+	require.ErrorIs(t, err, types.ErrComplianceCheckFailed)
+
+	err = k.SetSplitTemplate(ctx, newSettlementAddress().String(), "", []types.SplitRule{{Payee: p.platform.String(), Bps: 100}})
+	require.ErrorIs(t, err, types.ErrMerchantNotFound)
+}
+
+func TestSplits_TemplateQueryAndGenesis(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	p := setupSplitMerchant(t, k, ctx, 0)
+	require.NoError(t, k.SetSplitTemplate(ctx, p.merchant.String(), "direct", []types.SplitRule{{Payee: p.platform.String(), Bps: 1000}}))
+
+	res, err := keeper.NewQueryServerImpl(k).SplitTemplate(ctx, &types.QuerySplitTemplateRequest{Merchant: p.merchant.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Template.Rules, 3)
+	named, err := keeper.NewQueryServerImpl(k).SplitTemplate(ctx, &types.QuerySplitTemplateRequest{Merchant: p.merchant.String(), Name: "direct"})
+	require.NoError(t, err)
+	require.Len(t, named.Template.Rules, 1)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	template, found := k2.GetSplitTemplate(ctx2, p.merchant.String(), "")
+	require.True(t, found)
+	require.Equal(t, res.Template, template)
+	template, found = k2.GetSplitTemplate(ctx2, p.merchant.String(), "direct")
+	require.True(t, found)
+	require.Equal(t, named.Template, template)
+
+	// Setting no rules removes the template
+	require.NoError(t, k.SetSplitTemplate(ctx, p.merchant.String(), "", nil))
+	_, err = keeper.NewQueryServerImpl(k).SplitTemplate(ctx, &types.QuerySplitTemplateRequest{Merchant: p.merchant.String()})
+	require.ErrorIs(t, err, types.ErrSplitTemplateNotFound)
+}
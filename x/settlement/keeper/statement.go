@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Statements
+// ============================================================================
+//
+// A statement lists what the settlements of an account booked on it in one
+// denom over a height and time range: payments received and made, fees, the
+// shares of split checkouts paid to other payees and refunds. Entries are
+// booked when a settlement completes and when it is refunded, so pending
+// escrows and queued batch payments appear once they settle. The opening
+// balance is the sum of everything booked before the range, which makes
+// consecutive statements chain.
+//
+// Statements are paged over the account's settlements in creation order. A
+// page books what its settlements booked, opening balance included, so the
+// pages of a statement add up to it through Statement.AppendPage.
+
+// Statement builds a page of the statement of an account. Zero heights and
+// times leave that end of the range open, and an empty denom selects the
+// account's settlement denom.
+func (k Keeper) Statement(ctx sdk.Context, account, denom string, fromHeight, toHeight int64, fromTime, toTime time.Time, pageReq *query.PageRequest) (types.Statement, *query.PageResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return types.Statement{}, nil, errorsmod.Wrap(types.ErrInvalidStatementRange, "invalid account address")
+	}
+	if fromHeight < 0 || toHeight < 0 {
+		return types.Statement{}, nil, errorsmod.Wrap(types.ErrInvalidStatementRange, "heights cannot be negative")
+	}
+	if toHeight > 0 && toHeight < fromHeight {
+		return types.Statement{}, nil, errorsmod.Wrap(types.ErrInvalidStatementRange, "to height is before from height")
+	}
+	if !toTime.IsZero() && toTime.Before(fromTime) {
+		return types.Statement{}, nil, errorsmod.Wrap(types.ErrInvalidStatementRange, "to time is before from time")
+	}
+	if denom == "" {
+		denom = k.SettlementDenom(ctx, account)
+	}
+
+	settlements, pageRes, err := k.accountSettlements(ctx, account, pageReq)
+	if err != nil {
+		return types.Statement{}, nil, err
+	}
+	var entries []types.StatementEntry
+	for _, s := range settlements {
+		entries = append(entries, types.StatementEntries(s, account, denom)...)
+	}
+	// Settlements are paged by ID, so entries booked in the same block stay
+	// ordered by settlement
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Height < entries[j].Height
+	})
+
+	statement := types.Statement{
+		Account:         account,
+		Denom:           denom,
+		FromHeight:      fromHeight,
+		ToHeight:        toHeight,
+		FromTime:        fromTime,
+		ToTime:          toTime,
+		OpeningBalance:  sdkmath.ZeroInt(),
+		TotalCredits:    sdkmath.ZeroInt(),
+		TotalDebits:     sdkmath.ZeroInt(),
+		TotalFees:       sdkmath.ZeroInt(),
+		GeneratedHeight: ctx.BlockHeight(),
+		GeneratedTime:   ctx.BlockTime(),
+	}
+	for _, entry := range entries {
+		switch {
+		case entry.Height < fromHeight || entry.Time.Before(fromTime):
+			statement.OpeningBalance = statement.OpeningBalance.Add(entry.Signed())
+			continue
+		case toHeight > 0 && entry.Height > toHeight, !toTime.IsZero() && entry.Time.After(toTime):
+			continue
+		}
+
+		statement.Entries = append(statement.Entries, entry)
+		if entry.Direction == types.StatementCredit {
+			statement.TotalCredits = statement.TotalCredits.Add(entry.Amount.Amount)
+		} else {
+			statement.TotalDebits = statement.TotalDebits.Add(entry.Amount.Amount)
+		}
+		if entry.EntryType == types.StatementEntryFee {
+			statement.TotalFees = statement.TotalFees.Add(entry.Amount.Amount)
+		}
+	}
+	statement.ClosingBalance = statement.OpeningBalance.Add(statement.TotalCredits).Sub(statement.TotalDebits)
+
+	return statement, pageRes, nil
+}
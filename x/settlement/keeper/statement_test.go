@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestStatement_BooksPaymentsFeesAndRefunds(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	supplier := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+	bankKeeper.SetBalance(merchant.String(), sdk.NewCoins(ssusd(10000000)))
+	start := ctx.BlockTime()
+
+	// Height 1: a payment received
+	first, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), ssusd(1000000), "INV-1", "")
+	require.NoError(t, err)
+
+	// Height 2: a payment made, a pending escrow and a refund of the first
+	ctx2 := ctx.WithBlockHeight(2).WithBlockTime(start.Add(time.Hour))
+	_, err = k.InstantTransfer(ctx2, merchant.String(), supplier.String(), ssusd(300000), "PO-7", "")
+	require.NoError(t, err)
+	_, err = k.CreateEscrow(ctx2, customer.String(), merchant.String(), ssusd(500000), "ORDER-9", "", 3600)
+	require.NoError(t, err)
+	_, err = k.PartialRefund(ctx2, merchant.String(), first, ssusd(200000), "damaged")
+	require.NoError(t, err)
+
+	settlement, _ := k.GetSettlement(ctx, first)
+	require.Len(t, settlement.Refunds, 1)
+	require.Equal(t, ssusd(200000), settlement.Refunds[0].RecipientAmount)
+	require.Equal(t, int64(2), settlement.Refunds[0].Height)
+
+	statement, _, err := k.Statement(ctx2, merchant.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ssusd", statement.Denom)
+	require.True(t, statement.OpeningBalance.IsZero())
+
+	// The pending escrow books nothing until it is released
+	require.Len(t, statement.Entries, 4)
+	require.Equal(t, types.StatementEntryPayment, statement.Entries[0].EntryType)
+	require.Equal(t, types.StatementCredit, statement.Entries[0].Direction)
+	require.Equal(t, customer.String(), statement.Entries[0].Counterparty)
+	require.Equal(t, types.StatementEntryFee, statement.Entries[1].EntryType)
+	require.Equal(t, settlement.Fee, statement.Entries[1].Amount)
+	// Entries in the same block are ordered by settlement
+	require.Equal(t, types.StatementEntryRefund, statement.Entries[2].EntryType)
+	require.Equal(t, "INV-1", statement.Entries[2].Reference)
+	require.Equal(t, types.StatementDebit, statement.Entries[3].Direction)
+	require.Equal(t, "PO-7", statement.Entries[3].Reference)
+
+	require.Equal(t, settlement.Fee.Amount, statement.TotalFees)
+	require.Equal(t, sdkmath.NewInt(1000000), statement.TotalCredits)
+	expected := sdkmath.NewInt(1000000).Sub(settlement.Fee.Amount).SubRaw(300000).SubRaw(200000)
+	require.Equal(t, expected, statement.ClosingBalance)
+
+	// The customer is debited the payment and credited the refund
+	customerStatement, _, err := k.Statement(ctx2, customer.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, customerStatement.Entries, 2)
+	require.Equal(t, sdkmath.NewInt(-800000), customerStatement.ClosingBalance)
+}
+
+func TestStatement_RangeCarriesOpeningBalance(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+	start := ctx.BlockTime()
+
+	for height := int64(1); height <= 3; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Hour))
+		_, err := k.InstantTransfer(blockCtx, customer.String(), merchant.String(), ssusd(1000000), "", "")
+		require.NoError(t, err)
+	}
+
+	full, _, err := k.Statement(ctx, merchant.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+
+	byHeight, _, err := k.Statement(ctx, merchant.String(), "", 2, 2, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, byHeight.Entries, 2)
+	require.Equal(t, full.Entries[1].Amount.Amount.Neg().Add(full.Entries[0].Amount.Amount), byHeight.OpeningBalance)
+
+	byTime, _, err := k.Statement(ctx, merchant.String(), "", 0, 0, start.Add(3*time.Hour), time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, byTime.Entries, 2)
+	require.Equal(t, full.ClosingBalance, byTime.ClosingBalance)
+	require.Equal(t, byHeight.ClosingBalance, byTime.OpeningBalance)
+
+	_, _, err = k.Statement(ctx, merchant.String(), "", 3, 2, time.Time{}, time.Time{}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidStatementRange)
+	_, _, err = k.Statement(ctx, "invalid", "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.ErrorIs(t, err, types.ErrInvalidStatementRange)
+}
+
+func TestStatement_CSVAndCamt053(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+
+	_, err := k.InstantTransfer(ctx, customer.String(), merchant.String(), ssusd(1000000), "INV-1", "")
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(k).Statement(sdk.WrapSDKContext(ctx), &types.QueryStatementRequest{Account: merchant.String()})
+	require.NoError(t, err)
+	statement := res.Statement
+
+	bz, err := statement.MarshalCSV()
+	require.NoError(t, err)
+	rows, err := csv.NewReader(strings.NewReader(string(bz))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "balance", rows[0][11])
+	require.Equal(t, "1000000", rows[1][11])
+	require.Equal(t, statement.ClosingBalance.String(), rows[2][11])
+
+	bz, err = statement.MarshalCamt053()
+	require.NoError(t, err)
+	var doc struct {
+		XMLName xml.Name `xml:"Document"`
+		Stmt    struct {
+			Currency string `xml:"Acct>Ccy"`
+			Bal      []struct {
+				Code      string `xml:"Tp>CdOrPrtry>Cd"`
+				Amount    string `xml:"Amt"`
+				Indicator string `xml:"CdtDbtInd"`
+			} `xml:"Bal"`
+			Ntry []struct {
+				Amount struct {
+					Currency string `xml:"Ccy,attr"`
+					Value    string `xml:",chardata"`
+				} `xml:"Amt"`
+				Indicator string `xml:"CdtDbtInd"`
+				EndToEnd  string `xml:"NtryDtls>TxDtls>Refs>EndToEndId"`
+				Debtor    string `xml:"NtryDtls>TxDtls>RltdPties>Dbtr>Pty>Nm"`
+			} `xml:"Ntry"`
+		} `xml:"BkToCstmrStmt>Stmt"`
+	}
+	require.NoError(t, xml.Unmarshal(bz, &doc))
+	require.Equal(t, types.Camt053Namespace, doc.XMLName.Space)
+	require.Len(t, doc.Stmt.Bal, 2)
+	require.Equal(t, "CLBD", doc.Stmt.Bal[1].Code)
+	// Amounts are rendered in dollars rather than ssusd base units
+	require.Equal(t, "USD", doc.Stmt.Currency)
+	closing, err := sdkmath.LegacyNewDecFromStr(doc.Stmt.Bal[1].Amount)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDecFromIntWithPrec(statement.ClosingBalance, 6), closing)
+	require.Len(t, doc.Stmt.Ntry, 2)
+	require.Equal(t, "USD", doc.Stmt.Ntry[0].Amount.Currency)
+	require.Equal(t, "1.00", doc.Stmt.Ntry[0].Amount.Value)
+	require.Equal(t, "CRDT", doc.Stmt.Ntry[0].Indicator)
+	require.Equal(t, "INV-1", doc.Stmt.Ntry[0].EndToEnd)
+	require.Equal(t, customer.String(), doc.Stmt.Ntry[0].Debtor)
+	require.Equal(t, "DBIT", doc.Stmt.Ntry[1].Indicator)
+
+	// Denoms without an ISO 4217 currency cannot be rendered as camt.053
+	statement.Denom = "uatom"
+	_, err = statement.MarshalCamt053()
+	require.Error(t, err)
+}
+
+func TestStatement_BooksWhatArbitrationAndMilestonesPaid(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	id, sender, recipient, arbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+
+	// The arbiters award the recipient 400000 of the 1000000 escrowed
+	award := ssusd(400000)
+	_, _, err := k.ApproveEscrowResolution(ctx, id, arbiters[0].String(), types.EscrowResolutionSplit, award)
+	require.NoError(t, err)
+	_, _, err = k.ApproveEscrowResolution(ctx, id, arbiters[1].String(), types.EscrowResolutionSplit, award)
+	require.NoError(t, err)
+
+	recipientStatement, _, err := k.Statement(ctx, recipient.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, award.Amount, recipientStatement.TotalCredits)
+	settlement, _ := k.GetSettlement(ctx, id)
+	require.Equal(t, settlement.NetAmount.Amount, recipientStatement.ClosingBalance)
+
+	senderStatement, _, err := k.Statement(ctx, sender.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, award.Amount.Neg(), senderStatement.ClosingBalance)
+
+	// A fully refunded arbitrated escrow books nothing
+	refundedId, refundedSender, _, refundedArbiters := createMultiPartyEscrow(t, k, ctx, bankKeeper, 2)
+	for _, arbiter := range refundedArbiters {
+		_, _, err = k.ApproveEscrowResolution(ctx, refundedId, arbiter.String(), types.EscrowResolutionRefund, sdk.Coin{})
+		require.NoError(t, err)
+	}
+	refunded, _, err := k.Statement(ctx, refundedSender.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, refunded.Entries)
+
+	// A milestone escrow books only its released milestones
+	client := newSettlementAddress()
+	contractor := newSettlementAddress()
+	bankKeeper.SetBalance(client.String(), sdk.NewCoins(ssusd(1000000)))
+	milestoneId, err := k.CreateMilestoneEscrow(ctx, client.String(), contractor.String(), []types.MilestoneInput{
+		{Description: "design", Amount: ssusd(300000)},
+		{Description: "build", Amount: ssusd(700000)},
+	}, "", "", 86400)
+	require.NoError(t, err)
+	_, err = k.ReleaseMilestone(ctx, milestoneId, 0, client)
+	require.NoError(t, err)
+	require.NoError(t, k.RefundMilestone(ctx, milestoneId, 1, contractor, "cancelled"))
+
+	milestones, _, err := k.Statement(ctx, client.String(), "", 0, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Len(t, milestones.Entries, 1)
+	require.Equal(t, sdkmath.NewInt(-300000), milestones.ClosingBalance)
+}
+
+func TestStatement_Paginated(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	customer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	supplier := newSettlementAddress()
+	bankKeeper.SetBalance(customer.String(), sdk.NewCoins(ssusd(10000000)))
+	bankKeeper.SetBalance(merchant.String(), sdk.NewCoins(ssusd(10000000)))
+	start := ctx.BlockTime()
+
+	// Payments received and made interleave the merchant's sender and
+	// recipient indexes
+	for height := int64(1); height <= 3; height++ {
+		blockCtx := ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Hour))
+		_, err := k.InstantTransfer(blockCtx, customer.String(), merchant.String(), ssusd(1000000), "", "")
+		require.NoError(t, err)
+		_, err = k.InstantTransfer(blockCtx, merchant.String(), supplier.String(), ssusd(100000), "", "")
+		require.NoError(t, err)
+	}
+	// Unrelated settlements are not scanned
+	_, err := k.InstantTransfer(ctx, customer.String(), supplier.String(), ssusd(100000), "", "")
+	require.NoError(t, err)
+
+	full, pageRes, err := k.Statement(ctx, merchant.String(), "", 2, 0, time.Time{}, time.Time{}, nil)
+	require.NoError(t, err)
+	require.Empty(t, pageRes.NextKey)
+
+	var merged types.Statement
+	var pages int
+	page := &query.PageRequest{Limit: 2}
+	for {
+		statement, pageRes, err := k.Statement(ctx, merchant.String(), "", 2, 0, time.Time{}, time.Time{}, page)
+		require.NoError(t, err)
+		if pages == 0 {
+			merged = statement
+		} else {
+			merged.AppendPage(statement)
+		}
+		pages++
+		if len(pageRes.NextKey) == 0 {
+			break
+		}
+		page = &query.PageRequest{Key: pageRes.NextKey, Limit: 2}
+	}
+
+	require.Equal(t, 3, pages)
+	require.Equal(t, full.OpeningBalance, merged.OpeningBalance)
+	require.Equal(t, full.ClosingBalance, merged.ClosingBalance)
+	require.Equal(t, full.TotalCredits, merged.TotalCredits)
+	require.Equal(t, full.TotalDebits, merged.TotalDebits)
+	require.Equal(t, full.Entries, merged.Entries)
+}
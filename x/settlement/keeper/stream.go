@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Payment Streams
+// ============================================================================
+//
+// A stream pays its recipient continuously at a fixed rate per second out of a
+// deposit the payer locks in the module account. Nothing is done per block:
+// what has accrued is computed from block time whenever the stream is touched,
+// from the amount accrued at the stream's last checkpoint. Withdrawals, top-ups
+// and cancellation move the checkpoint to the current block, so a top-up of a
+// stream that ran dry resumes accrual from the top-up rather than paying for
+// the time it was unfunded. Either party can cancel: the recipient is paid
+// what accrued and the payer gets the rest of the deposit back.
+
+// CreateStream locks the payer's deposit and starts streaming it to the
+// recipient at ratePerSecond
+func (k Keeper) CreateStream(ctx sdk.Context, payer, recipient string, deposit sdk.Coin, ratePerSecond sdkmath.Int, reference string) (types.Stream, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return types.Stream{}, types.ErrInvalidSettlement
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return types.Stream{}, types.ErrInvalidRecipient
+	}
+	if payerAddr.Equals(recipientAddr) {
+		return types.Stream{}, types.ErrInvalidRecipient.Wrap("payer and recipient must be different")
+	}
+	if err := types.ValidateStreamTerms(deposit, ratePerSecond, reference); err != nil {
+		return types.Stream{}, err
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, payerAddr); err != nil {
+		return types.Stream{}, types.ErrComplianceCheckFailed
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+		return types.Stream{}, types.ErrComplianceCheckFailed
+	}
+
+	params := k.GetParams(ctx)
+	if deposit.IsLT(params.MinSettlementAmount) {
+		return types.Stream{}, types.ErrSettlementTooSmall
+	}
+
+	if k.bankKeeper.GetBalance(wrappedCtx, payerAddr, deposit.Denom).IsLT(deposit) {
+		return types.Stream{}, types.ErrInsufficientFunds
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, payerAddr, types.ModuleAccountName, sdk.NewCoins(deposit)); err != nil {
+		return types.Stream{}, err
+	}
+
+	stream := types.Stream{
+		Id:                  k.getNextStreamID(ctx),
+		Payer:               payer,
+		Recipient:           recipient,
+		Deposit:             deposit,
+		RatePerSecond:       ratePerSecond,
+		AccruedAtCheckpoint: sdkmath.ZeroInt(),
+		CheckpointTime:      ctx.BlockTime(),
+		Withdrawn:           sdk.NewCoin(deposit.Denom, sdkmath.ZeroInt()),
+		Status:              types.StreamStatusActive,
+		Reference:           reference,
+		CreatedAt:           ctx.BlockTime(),
+	}
+	k.setNextStreamID(ctx, stream.Id+1)
+	k.storeStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamCreated,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, payer),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyRatePerSecond, ratePerSecond.String()),
+		),
+	)
+
+	return stream, nil
+}
+
+// WithdrawStream pays the recipient everything accrued that it has not
+// withdrawn yet
+func (k Keeper) WithdrawStream(ctx sdk.Context, streamId uint64, recipient string) (sdk.Coin, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	stream, found := k.GetStream(ctx, streamId)
+	if !found {
+		return sdk.Coin{}, types.ErrStreamNotFound
+	}
+	if recipient != stream.Recipient {
+		return sdk.Coin{}, types.ErrUnauthorized
+	}
+	if stream.Status != types.StreamStatusActive {
+		return sdk.Coin{}, types.ErrStreamInactive.Wrapf("stream %d is %s", stream.Id, stream.Status)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return sdk.Coin{}, types.ErrInvalidRecipient
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+		return sdk.Coin{}, types.ErrComplianceCheckFailed
+	}
+
+	stream.Checkpoint(ctx.BlockTime())
+	amount := stream.Withdrawable(ctx.BlockTime())
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidAmount, "nothing has accrued since the last withdrawal")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(amount)
+	k.storeStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// TopUpStream adds to the deposit of a stream, extending how long it is
+// funded. Accrual resumes from now for a stream that had run dry.
+func (k Keeper) TopUpStream(ctx sdk.Context, streamId uint64, payer string, amount sdk.Coin) (types.Stream, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	stream, found := k.GetStream(ctx, streamId)
+	if !found {
+		return types.Stream{}, types.ErrStreamNotFound
+	}
+	if payer != stream.Payer {
+		return types.Stream{}, types.ErrUnauthorized
+	}
+	if stream.Status != types.StreamStatusActive {
+		return types.Stream{}, types.ErrStreamInactive.Wrapf("stream %d is %s", stream.Id, stream.Status)
+	}
+	if amount.Denom != stream.Deposit.Denom {
+		return types.Stream{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", stream.Deposit.Denom, amount.Denom)
+	}
+
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return types.Stream{}, types.ErrInvalidSettlement
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, payerAddr); err != nil {
+		return types.Stream{}, types.ErrComplianceCheckFailed
+	}
+	if k.bankKeeper.GetBalance(wrappedCtx, payerAddr, amount.Denom).IsLT(amount) {
+		return types.Stream{}, types.ErrInsufficientFunds
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(wrappedCtx, payerAddr, types.ModuleAccountName, sdk.NewCoins(amount)); err != nil {
+		return types.Stream{}, err
+	}
+
+	stream.Checkpoint(ctx.BlockTime())
+	stream.Deposit = stream.Deposit.Add(amount)
+	k.storeStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamToppedUp,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFundedUntil, stream.FundedUntil().UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return stream, nil
+}
+
+// CancelStream ends a stream pro rata: the recipient is paid what accrued and
+// not yet withdrawn, and the payer is refunded the rest of the deposit. Either
+// party can cancel.
+func (k Keeper) CancelStream(ctx sdk.Context, streamId uint64, signer string) (sdk.Coin, sdk.Coin, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	stream, found := k.GetStream(ctx, streamId)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrStreamNotFound
+	}
+	if signer != stream.Payer && signer != stream.Recipient {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrUnauthorized
+	}
+	if stream.Status != types.StreamStatusActive {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrStreamInactive.Wrapf("stream %d is %s", stream.Id, stream.Status)
+	}
+
+	payerAddr, err := sdk.AccAddressFromBech32(stream.Payer)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrInvalidSettlement
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(stream.Recipient)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrInvalidRecipient
+	}
+
+	stream.Checkpoint(ctx.BlockTime())
+	recipientAmount := stream.Withdrawable(ctx.BlockTime())
+	payerRefund := stream.Deposit.Sub(sdk.NewCoin(stream.Deposit.Denom, stream.AccruedAtCheckpoint))
+
+	// As with a withdrawal, the accrued amount is only paid to a compliant
+	// recipient
+	if recipientAmount.IsPositive() {
+		if err := k.compKeeper.AssertCompliant(wrappedCtx, recipientAddr); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, types.ErrComplianceCheckFailed
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, recipientAddr, sdk.NewCoins(recipientAmount)); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	if payerRefund.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(wrappedCtx, types.ModuleAccountName, payerAddr, sdk.NewCoins(payerRefund)); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(recipientAmount)
+	stream.Status = types.StreamStatusCancelled
+	stream.CancelledAt = ctx.BlockTime()
+	stream.CancelledBy = signer
+	k.storeStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamCancelled,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeySender, signer),
+			sdk.NewAttribute(types.AttributeKeyAmount, recipientAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyPayerRefund, payerRefund.String()),
+		),
+	)
+
+	return recipientAmount, payerRefund, nil
+}
+
+func (k Keeper) getNextStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextStreamIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextStreamIDKey, mustWriteUint64(id))
+}
+
+func (k Keeper) storeStream(ctx sdk.Context, stream types.Stream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&stream)
+	store.Set(mustWriteUint64(stream.Id), bz)
+}
+
+// GetStream retrieves a stream by ID
+func (k Keeper) GetStream(ctx sdk.Context, id uint64) (types.Stream, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.Stream{}, false
+	}
+	var stream types.Stream
+	types.ModuleCdc.MustUnmarshalJSON(bz, &stream)
+	return stream, true
+}
+
+// IterateStreams iterates over all streams
+func (k Keeper) IterateStreams(ctx sdk.Context, cb func(types.Stream) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
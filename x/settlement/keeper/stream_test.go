@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestStream_AccruesLazilyAndWithdraws(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(ssusd(10000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	start := ctx.BlockTime()
+
+	created, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), ssusd(1000000), sdkmath.NewInt(100), "CONTRACT-7"))
+	require.NoError(t, err)
+	require.Equal(t, ssusd(9000000), bankKeeper.GetBalance(ctx, payer, "ssusd"))
+
+	// Nothing accrues in the block the stream is created
+	_, err = msgServer.WithdrawStream(ctx, types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// Accrual is read from block time, in whole seconds
+	later := ctx.WithBlockTime(start.Add(60*time.Second + 500*time.Millisecond))
+	res, err := keeper.NewQueryServerImpl(k).Stream(later, &types.QueryStreamRequest{Id: created.StreamId})
+	require.NoError(t, err)
+	require.Equal(t, ssusd(6000), res.Accrued)
+	require.Equal(t, ssusd(6000), res.Withdrawable)
+	require.Equal(t, start.Add(10000*time.Second), res.FundedUntil)
+
+	withdrawn, err := msgServer.WithdrawStream(later, types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.NoError(t, err)
+	require.Equal(t, ssusd(6000), withdrawn.Amount)
+	require.Equal(t, ssusd(6000), bankKeeper.GetBalance(ctx, recipient, "ssusd"))
+
+	// Only the recipient can withdraw
+	_, err = msgServer.WithdrawStream(later, types.NewMsgWithdrawStream(payer.String(), created.StreamId))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// Accrual stops at the deposit
+	end := ctx.WithBlockTime(start.Add(24 * time.Hour))
+	withdrawn, err = msgServer.WithdrawStream(end, types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.NoError(t, err)
+	require.Equal(t, ssusd(994000), withdrawn.Amount)
+	require.Equal(t, ssusd(1000000), bankKeeper.GetBalance(ctx, recipient, "ssusd"))
+}
+
+func TestStream_TopUpResumesFromNow(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(ssusd(10000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	start := ctx.BlockTime()
+
+	created, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), ssusd(1000000), sdkmath.NewInt(1000), ""))
+	require.NoError(t, err)
+
+	// The stream runs dry after 1000 seconds and is topped up 1000 seconds later
+	toppedUp := ctx.WithBlockTime(start.Add(2000 * time.Second))
+	res, err := msgServer.TopUpStream(toppedUp, types.NewMsgTopUpStream(payer.String(), created.StreamId, ssusd(500000)))
+	require.NoError(t, err)
+	require.Equal(t, start.Add(2500*time.Second), res.FundedUntil)
+
+	// The unfunded gap is not paid
+	stream, _ := k.GetStream(ctx, created.StreamId)
+	require.Equal(t, ssusd(1100000), stream.Accrued(start.Add(2100*time.Second)))
+
+	_, err = msgServer.TopUpStream(toppedUp, types.NewMsgTopUpStream(recipient.String(), created.StreamId, ssusd(1000)))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.TopUpStream(toppedUp, types.NewMsgTopUpStream(payer.String(), created.StreamId, sdk.NewInt64Coin("uatom", 1000)))
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
+
+func TestStream_CheckpointsKeepPartialSeconds(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(ssusd(10000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	start := ctx.BlockTime()
+
+	created, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), ssusd(1000000), sdkmath.NewInt(100), ""))
+	require.NoError(t, err)
+
+	// Top-ups checkpoint the stream more often than once a second
+	for i := 1; i <= 5; i++ {
+		blockCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * 600 * time.Millisecond))
+		_, err := msgServer.TopUpStream(blockCtx, types.NewMsgTopUpStream(payer.String(), created.StreamId, ssusd(1000)))
+		require.NoError(t, err)
+	}
+
+	stream, _ := k.GetStream(ctx, created.StreamId)
+	require.Equal(t, start.Add(3*time.Second), stream.CheckpointTime)
+	require.Equal(t, sdkmath.NewInt(300), stream.AccruedAtCheckpoint)
+	require.Equal(t, ssusd(400), stream.Accrued(start.Add(4*time.Second)))
+}
+
+func TestStream_CancelSettlesProRata(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	recipient := newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(ssusd(1000000)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	start := ctx.BlockTime()
+
+	created, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), ssusd(1000000), sdkmath.NewInt(100), ""))
+	require.NoError(t, err)
+
+	_, err = msgServer.WithdrawStream(ctx.WithBlockTime(start.Add(100*time.Second)), types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.NoError(t, err)
+
+	// Only the payer or the recipient can cancel
+	cancelCtx := ctx.WithBlockTime(start.Add(250 * time.Second))
+	_, err = msgServer.CancelStream(cancelCtx, types.NewMsgCancelStream(newSettlementAddress().String(), created.StreamId))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := msgServer.CancelStream(cancelCtx, types.NewMsgCancelStream(payer.String(), created.StreamId))
+	require.NoError(t, err)
+	require.Equal(t, ssusd(15000), res.RecipientAmount)
+	require.Equal(t, ssusd(975000), res.PayerRefund)
+	require.Equal(t, ssusd(25000), bankKeeper.GetBalance(ctx, recipient, "ssusd"))
+	require.Equal(t, ssusd(975000), bankKeeper.GetBalance(ctx, payer, "ssusd"))
+
+	// A cancelled stream stops accruing and cannot be used again
+	stream, _ := k.GetStream(ctx, created.StreamId)
+	require.Equal(t, types.StreamStatusCancelled, stream.Status)
+	require.Equal(t, payer.String(), stream.CancelledBy)
+	require.Equal(t, ssusd(25000), stream.Accrued(start.Add(time.Hour)))
+	_, err = msgServer.WithdrawStream(ctx.WithBlockTime(start.Add(time.Hour)), types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.ErrorIs(t, err, types.ErrStreamInactive)
+
+	// Streams survive a genesis round trip
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+	imported, found := k2.GetStream(ctx2, created.StreamId)
+	require.True(t, found)
+	require.Equal(t, stream.Withdrawn, imported.Withdrawn)
+
+	listed, err := keeper.NewQueryServerImpl(k2).StreamsByParty(ctx2, &types.QueryStreamsByPartyRequest{Address: recipient.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), listed.Total)
+}
+
+func TestStream_HugeRateCannotOverflow(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	recipient := newSettlementAddress()
+	huge, ok := sdkmath.NewIntFromString("10000000000000000000000000000000000000000000000000000000000000000000000")
+	require.True(t, ok)
+	deposit := sdk.NewCoin("ssusd", huge)
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(deposit))
+	msgServer := keeper.NewMsgServerImpl(k)
+	start := ctx.BlockTime()
+
+	// The rate cannot exceed the deposit
+	_, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), ssusd(1000), sdkmath.NewInt(1001), ""))
+	require.ErrorIs(t, err, types.ErrInvalidStream)
+
+	// Rate times elapsed seconds would overflow; accrual stops at the deposit
+	created, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(payer.String(), recipient.String(), deposit, huge, ""))
+	require.NoError(t, err)
+	later := ctx.WithBlockTime(start.Add(100 * 365 * 24 * time.Hour))
+	res, err := keeper.NewQueryServerImpl(k).Stream(later, &types.QueryStreamRequest{Id: created.StreamId})
+	require.NoError(t, err)
+	require.Equal(t, deposit, res.Accrued)
+	withdrawn, err := msgServer.WithdrawStream(later, types.NewMsgWithdrawStream(recipient.String(), created.StreamId))
+	require.NoError(t, err)
+	require.Equal(t, deposit, withdrawn.Amount)
+
+	// Streams stored before the rate was bounded accrue safely too
+	stream := types.Stream{
+		Deposit:             ssusd(10),
+		RatePerSecond:       huge,
+		AccruedAtCheckpoint: sdkmath.ZeroInt(),
+		Status:              types.StreamStatusActive,
+		CheckpointTime:      start,
+	}
+	require.Equal(t, ssusd(10), stream.Accrued(start.Add(100*365*24*time.Hour)))
+	require.Equal(t, ssusd(0), stream.Accrued(start))
+}
@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stateset/core/x/settlement/types"
+)
+
+// ============================================================================
+// Subscriptions
+// ============================================================================
+
+// CreateSubscription records the payer's authorization of a recurring payment to
+// the merchant. The first cycle is charged in the EndBlock of the creating block
+// and every interval after that until max cycles (0 for unlimited) is reached or
+// either party cancels.
+func (k Keeper) CreateSubscription(ctx sdk.Context, payer, merchant string, amount sdk.Coin, interval time.Duration, maxCycles uint64, gracePeriod time.Duration, reference, metadata string) (types.Subscription, error) {
+	wrappedCtx := sdk.WrapSDKContext(ctx)
+
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return types.Subscription{}, types.ErrInvalidSettlement
+	}
+	merchantAddr, err := sdk.AccAddressFromBech32(merchant)
+	if err != nil {
+		return types.Subscription{}, types.ErrInvalidRecipient
+	}
+	if payerAddr.Equals(merchantAddr) {
+		return types.Subscription{}, types.ErrInvalidRecipient.Wrap("payer and merchant must be different")
+	}
+	if interval < types.MinSubscriptionInterval {
+		return types.Subscription{}, errorsmod.Wrapf(types.ErrInvalidSubscription, "interval must be at least %s", types.MinSubscriptionInterval)
+	}
+	if gracePeriod < 0 || gracePeriod >= interval {
+		return types.Subscription{}, errorsmod.Wrap(types.ErrInvalidSubscription, "grace period must be shorter than the interval")
+	}
+
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, payerAddr); err != nil {
+		return types.Subscription{}, types.ErrComplianceCheckFailed
+	}
+	if err := k.compKeeper.AssertCompliant(wrappedCtx, merchantAddr); err != nil {
+		return types.Subscription{}, types.ErrComplianceCheckFailed
+	}
+
+	params := k.GetParams(ctx)
+	if !params.InstantTransfersEnabled {
+		return types.Subscription{}, errorsmod.Wrap(types.ErrFeatureDisabled, "instant transfers are disabled")
+	}
+	if amount.Denom != types.StablecoinDenom {
+		return types.Subscription{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s, got %s", types.StablecoinDenom, amount.Denom)
+	}
+	if amount.IsLT(params.MinSettlementAmount) {
+		return types.Subscription{}, types.ErrSettlementTooSmall
+	}
+	if amount.IsGTE(params.MaxSettlementAmount) {
+		return types.Subscription{}, types.ErrSettlementTooLarge
+	}
+
+	subscription := types.Subscription{
+		Id:          k.getNextSubscriptionID(ctx),
+		Payer:       payer,
+		Merchant:    merchant,
+		Amount:      amount,
+		Interval:    interval,
+		MaxCycles:   maxCycles,
+		NextDue:     ctx.BlockTime(),
+		GracePeriod: gracePeriod,
+		Status:      types.SubscriptionStatusActive,
+		Reference:   reference,
+		Metadata:    metadata,
+		CreatedAt:   ctx.BlockTime(),
+	}
+	k.setNextSubscriptionID(ctx, subscription.Id+1)
+	k.storeSubscription(ctx, subscription)
+	k.enqueueSubscriptionDue(ctx, subscription.NextDue, subscription.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubscriptionCreated,
+			sdk.NewAttribute(types.AttributeKeySubscription, fmt.Sprintf("%d", subscription.Id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, payer),
+			sdk.NewAttribute(types.AttributeKeyMerchant, merchant),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return subscription, nil
+}
+
+// CancelSubscription stops future charges. Either the payer or the merchant can
+// cancel.
+func (k Keeper) CancelSubscription(ctx sdk.Context, subscriptionId uint64, signer, reason string) error {
+	subscription, found := k.GetSubscription(ctx, subscriptionId)
+	if !found {
+		return types.ErrSubscriptionNotFound
+	}
+	if signer != subscription.Payer && signer != subscription.Merchant {
+		return types.ErrUnauthorized
+	}
+	if !subscription.Status.IsOpen() {
+		return types.ErrSubscriptionInactive
+	}
+
+	subscription.CancelledBy = signer
+	k.endSubscription(ctx, subscription, types.SubscriptionStatusCancelled, reason)
+	return nil
+}
+
+// ProcessSubscriptions charges the open subscriptions queued as due. Payments run
+// through the instant transfer path, so both parties are re-checked for
+// compliance on each cycle; a failed compliance check cancels the subscription.
+// Any other failure marks it past due and it is retried each block until it is
+// paid or the grace period runs out, at which point it lapses.
+func (k Keeper) ProcessSubscriptions(ctx sdk.Context) {
+	currentTime := ctx.BlockTime()
+
+	for _, id := range k.dequeueDue(ctx, types.SubscriptionDueQueuePrefix, sdk.FormatTimeBytes(currentTime)) {
+		subscription, found := k.GetSubscription(ctx, id)
+		if !found || !subscription.Status.IsOpen() || currentTime.Before(subscription.NextDue) {
+			continue
+		}
+		k.chargeSubscription(ctx, subscription)
+	}
+}
+
+func (k Keeper) chargeSubscription(ctx sdk.Context, subscription types.Subscription) {
+	cacheCtx, write := ctx.CacheContext()
+	settlementId, err := k.instantTransfer(cacheCtx, types.SettlementTypeRecurring, subscription.Payer, subscription.Merchant, subscription.Amount, subscription.Reference, subscription.Metadata, nil)
+	if err == nil {
+		write()
+
+		subscription.CyclesCompleted++
+		subscription.LastSettlementId = settlementId
+		subscription.NextDue = subscription.NextDue.Add(subscription.Interval)
+		subscription.PastDueSince = time.Time{}
+		subscription.Status = types.SubscriptionStatusActive
+		k.storeSubscription(ctx, subscription)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSubscriptionCharged,
+				sdk.NewAttribute(types.AttributeKeySubscription, fmt.Sprintf("%d", subscription.Id)),
+				sdk.NewAttribute(types.AttributeKeySettlementID, fmt.Sprintf("%d", settlementId)),
+				sdk.NewAttribute(types.AttributeKeyCycle, fmt.Sprintf("%d", subscription.CyclesCompleted)),
+				sdk.NewAttribute(types.AttributeKeyNextDue, subscription.NextDue.Format(time.RFC3339)),
+			),
+		)
+
+		if subscription.MaxCycles > 0 && subscription.CyclesCompleted >= subscription.MaxCycles {
+			k.endSubscription(ctx, subscription, types.SubscriptionStatusCompleted, "")
+			return
+		}
+		k.enqueueSubscriptionDue(ctx, subscription.NextDue, subscription.Id)
+		return
+	}
+
+	if errors.Is(err, types.ErrComplianceCheckFailed) {
+		k.endSubscription(ctx, subscription, types.SubscriptionStatusCancelled, "compliance check failed")
+		return
+	}
+
+	if subscription.Status == types.SubscriptionStatusActive {
+		subscription.Status = types.SubscriptionStatusPastDue
+		subscription.PastDueSince = ctx.BlockTime()
+		k.storeSubscription(ctx, subscription)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSubscriptionPastDue,
+				sdk.NewAttribute(types.AttributeKeySubscription, fmt.Sprintf("%d", subscription.Id)),
+				sdk.NewAttribute(types.AttributeKeyPayer, subscription.Payer),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+	}
+
+	if !ctx.BlockTime().Before(subscription.PastDueSince.Add(subscription.GracePeriod)) {
+		k.endSubscription(ctx, subscription, types.SubscriptionStatusLapsed, err.Error())
+		return
+	}
+	// Retry in the next block
+	k.enqueueSubscriptionDue(ctx, ctx.BlockTime(), subscription.Id)
+}
+
+func (k Keeper) endSubscription(ctx sdk.Context, subscription types.Subscription, status types.SubscriptionStatus, reason string) {
+	subscription.Status = status
+	k.storeSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubscriptionEnded,
+			sdk.NewAttribute(types.AttributeKeySubscription, fmt.Sprintf("%d", subscription.Id)),
+			sdk.NewAttribute(types.AttributeKeyStatus, string(status)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
+func (k Keeper) getNextSubscriptionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextSubscriptionIDKey)
+	if len(bz) == 0 {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setNextSubscriptionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextSubscriptionIDKey, bz)
+}
+
+func (k Keeper) storeSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubscriptionKeyPrefix)
+	bz := types.ModuleCdc.MustMarshalJSON(&subscription)
+	store.Set(mustWriteUint64(subscription.Id), bz)
+}
+
+// GetSubscription retrieves a subscription by ID
+func (k Keeper) GetSubscription(ctx sdk.Context, id uint64) (types.Subscription, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubscriptionKeyPrefix)
+	bz := store.Get(mustWriteUint64(id))
+	if len(bz) == 0 {
+		return types.Subscription{}, false
+	}
+	var subscription types.Subscription
+	types.ModuleCdc.MustUnmarshalJSON(bz, &subscription)
+	return subscription, true
+}
+
+// IterateSubscriptions iterates over all subscriptions
+func (k Keeper) IterateSubscriptions(ctx sdk.Context, cb func(types.Subscription) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubscriptionKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var subscription types.Subscription
+		types.ModuleCdc.MustUnmarshalJSON(iterator.Value(), &subscription)
+		if cb(subscription) {
+			break
+		}
+	}
+}
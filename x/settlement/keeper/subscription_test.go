@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stateset/core/x/settlement/keeper"
+	"github.com/stateset/core/x/settlement/types"
+)
+
+func TestSubscription_ChargesEachInterval(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(100000))
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	subscription, err := k.CreateSubscription(ctx, payer.String(), merchant.String(), amount, time.Hour, 2, 0, "PLAN-PRO", "")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), subscription.NextDue)
+
+	// The first cycle is charged in the creating block
+	k.ProcessSubscriptions(ctx)
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, uint64(1), subscription.CyclesCompleted)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), subscription.NextDue)
+	require.Equal(t, sdkmath.NewInt(900000), bankKeeper.GetBalance(ctx, payer, "ssusd").Amount)
+
+	settlement, found := k.GetSettlement(ctx, subscription.LastSettlementId)
+	require.True(t, found)
+	require.Equal(t, types.SettlementTypeRecurring, settlement.Type)
+	require.Equal(t, "PLAN-PRO", settlement.Reference)
+	require.Equal(t, settlement.NetAmount.Amount, bankKeeper.GetBalance(ctx, merchant, "ssusd").Amount)
+
+	// Nothing is charged before the next due time
+	k.ProcessSubscriptions(ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)))
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, uint64(1), subscription.CyclesCompleted)
+
+	// The second cycle reaches max cycles and completes the subscription
+	k.ProcessSubscriptions(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, uint64(2), subscription.CyclesCompleted)
+	require.Equal(t, types.SubscriptionStatusCompleted, subscription.Status)
+
+	k.ProcessSubscriptions(ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour)))
+	require.Equal(t, sdkmath.NewInt(800000), bankKeeper.GetBalance(ctx, payer, "ssusd").Amount)
+}
+
+func TestSubscription_GracePeriod(t *testing.T) {
+	k, ctx, bankKeeper, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(100000))
+
+	subscription, err := k.CreateSubscription(ctx, payer.String(), merchant.String(), amount, 24*time.Hour, 0, time.Hour, "", "")
+	require.NoError(t, err)
+
+	// Insufficient balance marks the subscription past due
+	k.ProcessSubscriptions(ctx)
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusPastDue, subscription.Status)
+	require.Equal(t, ctx.BlockTime(), subscription.PastDueSince)
+
+	// Topping up within the grace period recovers the subscription on schedule
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(amount))
+	retryCtx := ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	k.ProcessSubscriptions(retryCtx)
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusActive, subscription.Status)
+	require.Equal(t, uint64(1), subscription.CyclesCompleted)
+	require.True(t, subscription.PastDueSince.IsZero())
+	require.Equal(t, ctx.BlockTime().Add(24*time.Hour), subscription.NextDue)
+
+	// The next cycle fails again and lapses once the grace period passes
+	dueCtx := ctx.WithBlockTime(subscription.NextDue)
+	k.ProcessSubscriptions(dueCtx)
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusPastDue, subscription.Status)
+
+	k.ProcessSubscriptions(dueCtx.WithBlockTime(dueCtx.BlockTime().Add(time.Hour)))
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusLapsed, subscription.Status)
+	require.Equal(t, uint64(1), subscription.CyclesCompleted)
+}
+
+func TestSubscription_ComplianceFailureCancels(t *testing.T) {
+	k, ctx, bankKeeper, complianceKeeper, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+	bankKeeper.SetBalance(payer.String(), sdk.NewCoins(sdk.NewCoin("ssusd", sdkmath.NewInt(1000000))))
+
+	subscription, err := k.CreateSubscription(ctx, payer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), time.Hour, 0, 0, "", "")
+	require.NoError(t, err)
+
+	complianceKeeper.SetSanctioned(merchant.String(), true)
+	k.ProcessSubscriptions(ctx)
+
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusCancelled, subscription.Status)
+	require.Equal(t, sdkmath.NewInt(1000000), bankKeeper.GetBalance(ctx, payer, "ssusd").Amount)
+}
+
+func TestCancelSubscription(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	payer := newSettlementAddress()
+	merchant := newSettlementAddress()
+
+	subscription, err := k.CreateSubscription(ctx, payer.String(), merchant.String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), time.Hour, 0, 0, "", "")
+	require.NoError(t, err)
+
+	require.ErrorIs(t, k.CancelSubscription(ctx, subscription.Id, newSettlementAddress().String(), ""), types.ErrUnauthorized)
+	require.NoError(t, k.CancelSubscription(ctx, subscription.Id, merchant.String(), "plan retired"))
+
+	subscription, _ = k.GetSubscription(ctx, subscription.Id)
+	require.Equal(t, types.SubscriptionStatusCancelled, subscription.Status)
+	require.Equal(t, merchant.String(), subscription.CancelledBy)
+
+	require.ErrorIs(t, k.CancelSubscription(ctx, subscription.Id, payer.String(), ""), types.ErrSubscriptionInactive)
+	require.ErrorIs(t, k.CancelSubscription(ctx, 99, payer.String(), ""), types.ErrSubscriptionNotFound)
+}
+
+func TestQueryServer_SubscriptionsByParty(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	queryServer := keeper.NewQueryServerImpl(k)
+	payer := newSettlementAddress()
+	merchantA := newSettlementAddress()
+	merchantB := newSettlementAddress()
+	amount := sdk.NewCoin("ssusd", sdkmath.NewInt(100000))
+
+	for _, merchant := range []sdk.AccAddress{merchantA, merchantB, merchantA} {
+		_, err := k.CreateSubscription(ctx, payer.String(), merchant.String(), amount, time.Hour, 0, 0, "", "")
+		require.NoError(t, err)
+	}
+
+	byPayer, err := queryServer.SubscriptionsByPayer(sdk.WrapSDKContext(ctx), &types.QuerySubscriptionsByPayerRequest{Payer: payer.String(), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, byPayer.Subscriptions, 2)
+	require.Equal(t, uint64(3), byPayer.Total)
+
+	byMerchant, err := queryServer.SubscriptionsByMerchant(sdk.WrapSDKContext(ctx), &types.QuerySubscriptionsByMerchantRequest{Merchant: merchantA.String()})
+	require.NoError(t, err)
+	require.Len(t, byMerchant.Subscriptions, 2)
+	require.Equal(t, uint64(2), byMerchant.Total)
+
+	_, err = queryServer.Subscription(sdk.WrapSDKContext(ctx), &types.QuerySubscriptionRequest{Id: 42})
+	require.ErrorIs(t, err, types.ErrSubscriptionNotFound)
+}
+
+func TestSubscriptionGenesis(t *testing.T) {
+	k, ctx, _, _, _ := setupSettlementKeeper(t)
+	subscription, err := k.CreateSubscription(ctx, newSettlementAddress().String(), newSettlementAddress().String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), time.Hour, 12, 0, "", "")
+	require.NoError(t, err)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Subscriptions, 1)
+	require.Equal(t, uint64(2), genesis.NextSubscriptionId)
+
+	k2, ctx2, _, _, _ := setupSettlementKeeper(t)
+	k2.InitGenesis(ctx2, genesis)
+
+	imported, found := k2.GetSubscription(ctx2, subscription.Id)
+	require.True(t, found)
+	require.Equal(t, subscription.Interval, imported.Interval)
+	require.Equal(t, uint64(12), imported.MaxCycles)
+
+	next, err := k2.CreateSubscription(ctx2, newSettlementAddress().String(), newSettlementAddress().String(), sdk.NewCoin("ssusd", sdkmath.NewInt(100000)), time.Hour, 0, 0, "", "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next.Id)
+}
@@ -64,7 +64,9 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// Register gRPC gateway routes here if needed
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetTxCmd returns the capability module's root tx command
@@ -101,6 +103,14 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the module
@@ -123,7 +133,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion returns the consensus state-breaking version for the module
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the module
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -131,10 +141,17 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the module
-// Handles expired escrows and payment channels
+// Handles expired escrows, payment channels, due subscriptions, channel
+// challenge periods, delayed merchant payouts, auto-settled batches and
+// closed netting cycles
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	am.keeper.ProcessExpiredEscrows(sdkCtx)
 	am.keeper.ProcessExpiredChannels(sdkCtx)
+	am.keeper.ProcessSubscriptions(sdkCtx)
+	am.keeper.ProcessChannelChallenges(sdkCtx)
+	am.keeper.ProcessDuePayouts(sdkCtx)
+	am.keeper.ProcessDueBatches(sdkCtx)
+	am.keeper.ProcessNettingCycles(sdkCtx)
 	return nil
 }
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ChannelStateSignBytes returns the message both parties sign to agree on a
+// bidirectional channel state. A state can be submitted to start or challenge a
+// unilateral close.
+func ChannelStateSignBytes(channelId uint64, balanceA, balanceB string, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("channel_state:%d:%s:%s:%d", channelId, balanceA, balanceB, nonce))
+}
+
+// ChannelCloseSignBytes returns the message both parties sign to close a
+// bidirectional channel cooperatively with the given final balances
+func ChannelCloseSignBytes(channelId uint64, balanceA, balanceB string, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("channel_close:%d:%s:%s:%d", channelId, balanceA, balanceB, nonce))
+}
+
+// SignBytes returns the bytes signed for the state
+func (s ChannelState) SignBytes() []byte {
+	return ChannelStateSignBytes(s.ChannelId, s.BalanceA.String(), s.BalanceB.String(), s.Nonce)
+}
+
+// CloseSignBytes returns the bytes signed to close the channel at this state
+func (s ChannelState) CloseSignBytes() []byte {
+	return ChannelCloseSignBytes(s.ChannelId, s.BalanceA.String(), s.BalanceB.String(), s.Nonce)
+}
+
+// ValidateBasic performs stateless checks on a channel state. Signatures are only
+// optional on the opening state (nonce zero).
+func (s ChannelState) ValidateBasic() error {
+	if s.ChannelId == 0 {
+		return errorsmod.Wrap(ErrInvalidChannelState, "channel id required")
+	}
+	if !s.BalanceA.IsValid() || !s.BalanceB.IsValid() {
+		return errorsmod.Wrap(ErrInvalidChannelState, "invalid balances")
+	}
+	if s.BalanceA.Denom != s.BalanceB.Denom {
+		return errorsmod.Wrap(ErrInvalidChannelState, "balances must share a denom")
+	}
+	if s.Nonce == 0 {
+		return nil
+	}
+	if len(s.SignatureA) < 64 || len(s.SignatureB) < 64 {
+		return errorsmod.Wrap(ErrInvalidSignature, "both parties must sign the state")
+	}
+	return nil
+}
@@ -25,6 +25,35 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateMerchant{}, "settlement/UpdateMerchant", nil)
 	cdc.RegisterConcrete(&MsgInstantCheckout{}, "settlement/InstantCheckout", nil)
 	cdc.RegisterConcrete(&MsgPartialRefund{}, "settlement/PartialRefund", nil)
+	cdc.RegisterConcrete(&MsgCreateMultiPartyEscrow{}, "settlement/CreateMultiPartyEscrow", nil)
+	cdc.RegisterConcrete(&MsgApproveEscrowResolution{}, "settlement/ApproveEscrowResolution", nil)
+	cdc.RegisterConcrete(&MsgCreateMilestoneEscrow{}, "settlement/CreateMilestoneEscrow", nil)
+	cdc.RegisterConcrete(&MsgReleaseMilestone{}, "settlement/ReleaseMilestone", nil)
+	cdc.RegisterConcrete(&MsgRefundMilestone{}, "settlement/RefundMilestone", nil)
+	cdc.RegisterConcrete(&MsgCreateSubscription{}, "settlement/CreateSubscription", nil)
+	cdc.RegisterConcrete(&MsgCancelSubscription{}, "settlement/CancelSubscription", nil)
+	cdc.RegisterConcrete(&MsgOpenBidirectionalChannel{}, "settlement/OpenBidirectionalChannel", nil)
+	cdc.RegisterConcrete(&MsgFundBidirectionalChannel{}, "settlement/FundBidirectionalChannel", nil)
+	cdc.RegisterConcrete(&MsgCooperativeCloseChannel{}, "settlement/CooperativeCloseChannel", nil)
+	cdc.RegisterConcrete(&MsgInitiateChannelClose{}, "settlement/InitiateChannelClose", nil)
+	cdc.RegisterConcrete(&MsgChallengeChannelClose{}, "settlement/ChallengeChannelClose", nil)
+	cdc.RegisterConcrete(&MsgLockHTLC{}, "settlement/LockHTLC", nil)
+	cdc.RegisterConcrete(&MsgClaimHTLC{}, "settlement/ClaimHTLC", nil)
+	cdc.RegisterConcrete(&MsgRefundHTLC{}, "settlement/RefundHTLC", nil)
+	cdc.RegisterConcrete(&MsgSetChannelRoutingFee{}, "settlement/SetChannelRoutingFee", nil)
+	cdc.RegisterConcrete(&MsgFreezePayout{}, "settlement/FreezePayout", nil)
+	cdc.RegisterConcrete(&MsgUnfreezePayout{}, "settlement/UnfreezePayout", nil)
+	cdc.RegisterConcrete(&MsgCreateNettingCycle{}, "settlement/CreateNettingCycle", nil)
+	cdc.RegisterConcrete(&MsgSubmitObligation{}, "settlement/SubmitObligation", nil)
+	cdc.RegisterConcrete(&MsgSettleNettingCycle{}, "settlement/SettleNettingCycle", nil)
+	cdc.RegisterConcrete(&MsgSetSplitTemplate{}, "settlement/SetSplitTemplate", nil)
+	cdc.RegisterConcrete(&MsgCreateMandate{}, "settlement/CreateMandate", nil)
+	cdc.RegisterConcrete(&MsgRevokeMandate{}, "settlement/RevokeMandate", nil)
+	cdc.RegisterConcrete(&MsgPullPayment{}, "settlement/PullPayment", nil)
+	cdc.RegisterConcrete(&MsgCreateStream{}, "settlement/CreateStream", nil)
+	cdc.RegisterConcrete(&MsgWithdrawStream{}, "settlement/WithdrawStream", nil)
+	cdc.RegisterConcrete(&MsgCancelStream{}, "settlement/CancelStream", nil)
+	cdc.RegisterConcrete(&MsgTopUpStream{}, "settlement/TopUpStream", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
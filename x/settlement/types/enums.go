@@ -16,8 +16,114 @@ const (
 type SettlementType string
 
 const (
-	SettlementTypeInstant   SettlementType = "instant"
-	SettlementTypeEscrow    SettlementType = "escrow"
-	SettlementTypeBatch     SettlementType = "batch"
-	SettlementTypeRecurring SettlementType = "recurring"
+	SettlementTypeInstant     SettlementType = "instant"
+	SettlementTypeEscrow      SettlementType = "escrow"
+	SettlementTypeBatch       SettlementType = "batch"
+	SettlementTypeRecurring   SettlementType = "recurring"
+	SettlementTypeCrossChain  SettlementType = "cross_chain"
+	SettlementTypeNetting     SettlementType = "netting"
+	SettlementTypeDirectDebit SettlementType = "direct_debit"
 )
+
+// SubscriptionStatus represents the lifecycle of a recurring payment.
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusActive    SubscriptionStatus = "active"
+	SubscriptionStatusPastDue   SubscriptionStatus = "past_due"
+	SubscriptionStatusCompleted SubscriptionStatus = "completed"
+	SubscriptionStatusCancelled SubscriptionStatus = "cancelled"
+	SubscriptionStatusLapsed    SubscriptionStatus = "lapsed"
+)
+
+// IsOpen reports whether the subscription is still being charged.
+func (s SubscriptionStatus) IsOpen() bool {
+	return s == SubscriptionStatusActive || s == SubscriptionStatusPastDue
+}
+
+// BidirectionalChannelStatus represents the lifecycle of a bidirectional channel.
+type BidirectionalChannelStatus string
+
+const (
+	BidirectionalChannelStatusOpen    BidirectionalChannelStatus = "open"
+	BidirectionalChannelStatusClosing BidirectionalChannelStatus = "closing"
+	BidirectionalChannelStatusClosed  BidirectionalChannelStatus = "closed"
+)
+
+// HTLCStatus represents the lifecycle of a hash time-locked transfer.
+type HTLCStatus string
+
+const (
+	HTLCStatusLocked   HTLCStatus = "locked"
+	HTLCStatusClaimed  HTLCStatus = "claimed"
+	HTLCStatusRefunded HTLCStatus = "refunded"
+)
+
+// PayoutStatus represents the lifecycle of a delayed merchant payout.
+type PayoutStatus string
+
+const (
+	PayoutStatusPending  PayoutStatus = "pending"
+	PayoutStatusFrozen   PayoutStatus = "frozen"
+	PayoutStatusReleased PayoutStatus = "released"
+	PayoutStatusRefunded PayoutStatus = "refunded"
+)
+
+// IsHeld reports whether the payout amount is still held by the module.
+func (s PayoutStatus) IsHeld() bool {
+	return s == PayoutStatusPending || s == PayoutStatusFrozen
+}
+
+// IsValid reports whether the status is a known payout status.
+func (s PayoutStatus) IsValid() bool {
+	switch s {
+	case PayoutStatusPending, PayoutStatusFrozen, PayoutStatusReleased, PayoutStatusRefunded:
+		return true
+	}
+	return false
+}
+
+// NettingCycleStatus represents the lifecycle of a netting cycle.
+type NettingCycleStatus string
+
+const (
+	NettingCycleStatusOpen    NettingCycleStatus = "open"
+	NettingCycleStatusSettled NettingCycleStatus = "settled"
+	NettingCycleStatusFailed  NettingCycleStatus = "failed"
+)
+
+// MandateStatus represents the lifecycle of a direct debit mandate.
+type MandateStatus string
+
+const (
+	MandateStatusActive  MandateStatus = "active"
+	MandateStatusRevoked MandateStatus = "revoked"
+	MandateStatusExpired MandateStatus = "expired"
+)
+
+// StreamStatus represents the lifecycle of a payment stream.
+type StreamStatus string
+
+const (
+	StreamStatusActive    StreamStatus = "active"
+	StreamStatusCancelled StreamStatus = "cancelled"
+)
+
+// EscrowResolution represents how an arbitrated escrow is resolved.
+type EscrowResolution string
+
+const (
+	EscrowResolutionRelease EscrowResolution = "release"
+	EscrowResolutionRefund  EscrowResolution = "refund"
+	EscrowResolutionSplit   EscrowResolution = "split"
+)
+
+// IsValid reports whether the resolution is a known value.
+func (r EscrowResolution) IsValid() bool {
+	switch r {
+	case EscrowResolutionRelease, EscrowResolutionRefund, EscrowResolutionSplit:
+		return true
+	default:
+		return false
+	}
+}
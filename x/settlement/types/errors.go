@@ -43,4 +43,43 @@ var (
 	ErrSettlementNotCompleted     = errorsmod.Register(ModuleName, 35, "settlement not in completed status")
 	ErrRefundTooLarge             = errorsmod.Register(ModuleName, 36, "refund amount exceeds settlement amount")
 	ErrFeatureDisabled            = errorsmod.Register(ModuleName, 37, "feature disabled")
+	ErrInvalidArbitration         = errorsmod.Register(ModuleName, 38, "invalid escrow arbitration")
+	ErrNotEscrowParty             = errorsmod.Register(ModuleName, 39, "signer is not a party to the escrow")
+	ErrInvalidMilestone           = errorsmod.Register(ModuleName, 40, "invalid milestone")
+	ErrMilestoneNotFound          = errorsmod.Register(ModuleName, 41, "milestone not found")
+	ErrSubscriptionNotFound       = errorsmod.Register(ModuleName, 42, "subscription not found")
+	ErrInvalidSubscription        = errorsmod.Register(ModuleName, 43, "invalid subscription")
+	ErrSubscriptionInactive       = errorsmod.Register(ModuleName, 44, "subscription is not active")
+	ErrInvalidChannelState        = errorsmod.Register(ModuleName, 45, "invalid channel state")
+	ErrChannelNotClosing          = errorsmod.Register(ModuleName, 46, "channel is not closing")
+	ErrChallengePeriodElapsed     = errorsmod.Register(ModuleName, 47, "challenge period has elapsed")
+	ErrHTLCNotFound               = errorsmod.Register(ModuleName, 48, "htlc not found")
+	ErrInvalidHTLC                = errorsmod.Register(ModuleName, 49, "invalid htlc")
+	ErrHTLCNotLocked              = errorsmod.Register(ModuleName, 50, "htlc is not locked")
+	ErrInvalidPreimage            = errorsmod.Register(ModuleName, 51, "preimage does not match hash lock")
+	ErrHTLCExpired                = errorsmod.Register(ModuleName, 52, "htlc has timed out")
+	ErrHTLCNotExpired             = errorsmod.Register(ModuleName, 53, "htlc has not timed out")
+	ErrPayoutNotFound             = errorsmod.Register(ModuleName, 54, "delayed payout not found")
+	ErrPayoutNotPending           = errorsmod.Register(ModuleName, 55, "delayed payout is not pending")
+	ErrPayoutNotFrozen            = errorsmod.Register(ModuleName, 56, "delayed payout is not frozen")
+	ErrInvalidSettlementDelay     = errorsmod.Register(ModuleName, 57, "invalid settlement delay")
+	ErrInvalidBatchMaxAge         = errorsmod.Register(ModuleName, 58, "invalid batch max age")
+	ErrNettingCycleNotFound       = errorsmod.Register(ModuleName, 59, "netting cycle not found")
+	ErrInvalidNettingCycle        = errorsmod.Register(ModuleName, 60, "invalid netting cycle")
+	ErrNettingCycleNotOpen        = errorsmod.Register(ModuleName, 61, "netting cycle is not open")
+	ErrInvalidObligation          = errorsmod.Register(ModuleName, 62, "invalid netting obligation")
+	ErrConversionUnavailable      = errorsmod.Register(ModuleName, 63, "payment cannot be converted into the settlement denom")
+	ErrSlippageExceeded           = errorsmod.Register(ModuleName, 64, "conversion slippage exceeds the allowed bound")
+	ErrInvalidSlippage            = errorsmod.Register(ModuleName, 65, "invalid slippage bound")
+	ErrInvalidSplit               = errorsmod.Register(ModuleName, 66, "invalid payout split")
+	ErrSplitTemplateNotFound      = errorsmod.Register(ModuleName, 67, "split template not found")
+	ErrMandateNotFound            = errorsmod.Register(ModuleName, 68, "mandate not found")
+	ErrInvalidMandate             = errorsmod.Register(ModuleName, 69, "invalid mandate")
+	ErrMandateInactive            = errorsmod.Register(ModuleName, 70, "mandate is not active")
+	ErrMandateLimitExceeded       = errorsmod.Register(ModuleName, 71, "mandate limit exceeded")
+	ErrInvalidStatementRange      = errorsmod.Register(ModuleName, 72, "invalid statement range")
+	ErrStreamNotFound             = errorsmod.Register(ModuleName, 73, "stream not found")
+	ErrInvalidStream              = errorsmod.Register(ModuleName, 74, "invalid stream")
+	ErrStreamInactive             = errorsmod.Register(ModuleName, 75, "stream is not active")
+	ErrEscrowUnderArbitration     = errorsmod.Register(ModuleName, 76, "escrow is under arbitration")
 )
@@ -6,6 +6,9 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	oracletypes "github.com/stateset/core/x/oracle/types"
+	stablecointypes "github.com/stateset/core/x/stablecoin/types"
 )
 
 // BankKeeper defines the expected bank keeper interface
@@ -24,9 +27,19 @@ type ComplianceKeeper interface {
 	AssertCompliant(ctx context.Context, addr sdk.AccAddress) error
 }
 
-// OracleKeeper defines the expected oracle keeper interface (for fee calculations)
+// OracleKeeper defines the expected oracle keeper interface (for fee calculations
+// and pricing payments made in another denom)
 type OracleKeeper interface {
-	GetPriceDec(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+	GetPriceDec(ctx context.Context, denom string) (sdkmath.LegacyDec, error)
+	GetPriceWithStalenessCheck(ctx context.Context, denom string) (oracletypes.Price, error)
+}
+
+// StablecoinKeeper defines the expected stablecoin keeper interface for
+// converting payments through the PSM
+type StablecoinKeeper interface {
+	GetPSMConfig(ctx sdk.Context, denom string) (stablecointypes.PSMConfig, bool)
+	PSMSwapIn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdkmath.Int, sdkmath.Int, error)
+	PSMSwapOut(ctx sdk.Context, sender sdk.AccAddress, ssusdAmount sdkmath.Int, outputDenom string) (sdkmath.Int, sdkmath.Int, error)
 }
 
 // AccountKeeper defines the expected account keeper interface for signature verification
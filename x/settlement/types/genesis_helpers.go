@@ -64,14 +64,30 @@ func (p Params) Validate() error {
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:           DefaultParams(),
-		Settlements:      []Settlement{},
-		Batches:          []BatchSettlement{},
-		Channels:         []PaymentChannel{},
-		Merchants:        []MerchantConfig{},
-		NextSettlementId: 1,
-		NextBatchId:      1,
-		NextChannelId:    1,
+		Params:                     DefaultParams(),
+		Settlements:                []Settlement{},
+		Batches:                    []BatchSettlement{},
+		Channels:                   []PaymentChannel{},
+		Merchants:                  []MerchantConfig{},
+		NextSettlementId:           1,
+		NextBatchId:                1,
+		NextChannelId:              1,
+		EscrowArbitrations:         []EscrowArbitration{},
+		MilestoneEscrows:           []MilestoneEscrow{},
+		Subscriptions:              []Subscription{},
+		NextSubscriptionId:         1,
+		BidirectionalChannels:      []BidirectionalChannel{},
+		NextBidirectionalChannelId: 1,
+		Htlcs:                      []HTLC{},
+		NextHtlcId:                 1,
+		DelayedPayouts:             []DelayedPayout{},
+		NettingCycles:              []NettingCycle{},
+		NettingObligations:         []NettingObligation{},
+		NextNettingCycleId:         1,
+		Mandates:                   []Mandate{},
+		NextMandateId:              1,
+		Streams:                    []Stream{},
+		NextStreamId:               1,
 	}
 }
 
@@ -113,5 +129,178 @@ func (gs GenesisState) Validate() error {
 		merchantAddrs[m.Address] = true
 	}
 
+	arbitrationIds := make(map[uint64]bool)
+	for _, a := range gs.EscrowArbitrations {
+		if arbitrationIds[a.SettlementId] {
+			return fmt.Errorf("duplicate escrow arbitration for settlement: %d", a.SettlementId)
+		}
+		if !settlementIds[a.SettlementId] {
+			return fmt.Errorf("escrow arbitration references unknown settlement: %d", a.SettlementId)
+		}
+		if err := ValidateArbiters("", "", a.Arbiters, a.Threshold); err != nil {
+			return fmt.Errorf("invalid escrow arbitration for settlement %d: %w", a.SettlementId, err)
+		}
+		arbitrationIds[a.SettlementId] = true
+	}
+
+	milestoneIds := make(map[uint64]bool)
+	for _, m := range gs.MilestoneEscrows {
+		if milestoneIds[m.SettlementId] {
+			return fmt.Errorf("duplicate milestone escrow for settlement: %d", m.SettlementId)
+		}
+		if !settlementIds[m.SettlementId] {
+			return fmt.Errorf("milestone escrow references unknown settlement: %d", m.SettlementId)
+		}
+		if len(m.Milestones) == 0 {
+			return fmt.Errorf("milestone escrow for settlement %d has no milestones", m.SettlementId)
+		}
+		milestoneIds[m.SettlementId] = true
+	}
+
+	subscriptionIds := make(map[uint64]bool)
+	for _, s := range gs.Subscriptions {
+		if subscriptionIds[s.Id] {
+			return fmt.Errorf("duplicate subscription id: %d", s.Id)
+		}
+		if s.Id >= gs.NextSubscriptionId {
+			return fmt.Errorf("subscription id %d is not below next subscription id %d", s.Id, gs.NextSubscriptionId)
+		}
+		if s.Interval < MinSubscriptionInterval {
+			return fmt.Errorf("subscription %d interval below minimum", s.Id)
+		}
+		subscriptionIds[s.Id] = true
+	}
+
+	bidirectionalIds := make(map[uint64]bool)
+	for _, c := range gs.BidirectionalChannels {
+		if bidirectionalIds[c.Id] {
+			return fmt.Errorf("duplicate bidirectional channel id: %d", c.Id)
+		}
+		if c.Id >= gs.NextBidirectionalChannelId {
+			return fmt.Errorf("bidirectional channel id %d is not below next bidirectional channel id %d", c.Id, gs.NextBidirectionalChannelId)
+		}
+		if c.Status != BidirectionalChannelStatusClosed && !c.BalanceA.Add(c.BalanceB).IsEqual(c.DepositA.Add(c.DepositB)) {
+			return fmt.Errorf("bidirectional channel %d balances do not match its deposits", c.Id)
+		}
+		bidirectionalIds[c.Id] = true
+	}
+
+	htlcIds := make(map[uint64]bool)
+	for _, h := range gs.Htlcs {
+		if htlcIds[h.Id] {
+			return fmt.Errorf("duplicate htlc id: %d", h.Id)
+		}
+		if h.Id >= gs.NextHtlcId {
+			return fmt.Errorf("htlc id %d is not below next htlc id %d", h.Id, gs.NextHtlcId)
+		}
+		if err := ValidateHashLock(h.HashLock); err != nil {
+			return fmt.Errorf("htlc %d: %w", h.Id, err)
+		}
+		htlcIds[h.Id] = true
+	}
+
+	payoutIds := make(map[uint64]bool)
+	for _, p := range gs.DelayedPayouts {
+		if payoutIds[p.SettlementId] {
+			return fmt.Errorf("duplicate delayed payout for settlement: %d", p.SettlementId)
+		}
+		if !settlementIds[p.SettlementId] {
+			return fmt.Errorf("delayed payout references unknown settlement: %d", p.SettlementId)
+		}
+		if !p.Amount.IsValid() {
+			return fmt.Errorf("delayed payout for settlement %d has invalid amount", p.SettlementId)
+		}
+		if _, err := sdk.AccAddressFromBech32(p.Merchant); err != nil {
+			return fmt.Errorf("delayed payout for settlement %d has invalid merchant: %w", p.SettlementId, err)
+		}
+		if !p.Status.IsValid() {
+			return fmt.Errorf("delayed payout for settlement %d has invalid status %q", p.SettlementId, p.Status)
+		}
+		payoutIds[p.SettlementId] = true
+	}
+
+	nettingCycles := make(map[uint64]NettingCycle)
+	for _, c := range gs.NettingCycles {
+		if _, found := nettingCycles[c.Id]; found {
+			return fmt.Errorf("duplicate netting cycle id: %d", c.Id)
+		}
+		if c.Id >= gs.NextNettingCycleId {
+			return fmt.Errorf("netting cycle id %d is not below next netting cycle id %d", c.Id, gs.NextNettingCycleId)
+		}
+		nettingCycles[c.Id] = c
+	}
+
+	type obligationKey struct{ cycle, id uint64 }
+	obligationIds := make(map[obligationKey]bool)
+	for _, o := range gs.NettingObligations {
+		key := obligationKey{o.CycleId, o.Id}
+		if obligationIds[key] {
+			return fmt.Errorf("duplicate obligation %d in netting cycle %d", o.Id, o.CycleId)
+		}
+		cycle, found := nettingCycles[o.CycleId]
+		if !found {
+			return fmt.Errorf("obligation %d references unknown netting cycle: %d", o.Id, o.CycleId)
+		}
+		if o.Id == 0 || o.Id > cycle.ObligationCount {
+			return fmt.Errorf("obligation id %d is out of range for netting cycle %d", o.Id, o.CycleId)
+		}
+		if !o.Amount.IsValid() || o.Amount.Denom != cycle.Denom {
+			return fmt.Errorf("obligation %d in netting cycle %d has invalid amount", o.Id, o.CycleId)
+		}
+		obligationIds[key] = true
+	}
+
+	templateKeys := make(map[[2]string]bool)
+	for _, t := range gs.SplitTemplates {
+		key := [2]string{t.Merchant, t.Name}
+		if templateKeys[key] {
+			return fmt.Errorf("duplicate split template %q for merchant: %s", t.Name, t.Merchant)
+		}
+		if len(t.Rules) == 0 {
+			return fmt.Errorf("split template for merchant %s has no rules", t.Merchant)
+		}
+		if err := ValidateSplitName(t.Name); err != nil {
+			return fmt.Errorf("invalid split template for merchant %s: %w", t.Merchant, err)
+		}
+		if err := ValidateSplitRules(t.Merchant, t.Rules); err != nil {
+			return fmt.Errorf("invalid split template for merchant %s: %w", t.Merchant, err)
+		}
+		templateKeys[key] = true
+	}
+
+	mandateIds := make(map[uint64]bool)
+	for _, m := range gs.Mandates {
+		if mandateIds[m.Id] {
+			return fmt.Errorf("duplicate mandate id: %d", m.Id)
+		}
+		if m.Id >= gs.NextMandateId {
+			return fmt.Errorf("mandate id %d is not below next mandate id %d", m.Id, gs.NextMandateId)
+		}
+		if err := ValidateMandateLimits(m.PerChargeMax, m.PeriodCap, m.Period, 0, m.Reference); err != nil {
+			return fmt.Errorf("invalid mandate %d: %w", m.Id, err)
+		}
+		mandateIds[m.Id] = true
+	}
+
+	streamIds := make(map[uint64]bool)
+	for _, s := range gs.Streams {
+		if streamIds[s.Id] {
+			return fmt.Errorf("duplicate stream id: %d", s.Id)
+		}
+		if s.Id >= gs.NextStreamId {
+			return fmt.Errorf("stream id %d is not below next stream id %d", s.Id, gs.NextStreamId)
+		}
+		if err := ValidateStreamTerms(s.Deposit, s.RatePerSecond, s.Reference); err != nil {
+			return fmt.Errorf("invalid stream %d: %w", s.Id, err)
+		}
+		if s.AccruedAtCheckpoint.IsNil() || s.AccruedAtCheckpoint.IsNegative() || s.AccruedAtCheckpoint.GT(s.Deposit.Amount) {
+			return fmt.Errorf("stream %d has invalid accrual", s.Id)
+		}
+		if s.Withdrawn.Denom != s.Deposit.Denom || s.Withdrawn.Amount.GT(s.AccruedAtCheckpoint) {
+			return fmt.Errorf("stream %d has withdrawn more than accrued", s.Id)
+		}
+		streamIds[s.Id] = true
+	}
+
 	return nil
 }
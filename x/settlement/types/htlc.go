@@ -0,0 +1,33 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// HTLCHashLock returns the hex encoded SHA-256 hash locking an HTLC to the
+// preimage
+func HTLCHashLock(preimage []byte) string {
+	hash := sha256.Sum256(preimage)
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateHashLock checks that a hash lock is a hex encoded SHA-256 hash
+func ValidateHashLock(hashLock string) error {
+	bz, err := hex.DecodeString(hashLock)
+	if err != nil || len(bz) != sha256.Size {
+		return errorsmod.Wrap(ErrInvalidHTLC, "hash lock must be a hex encoded sha256 hash")
+	}
+	return nil
+}
+
+// MatchesPreimage reports whether the hex encoded preimage unlocks the HTLC
+func (h HTLC) MatchesPreimage(preimage string) bool {
+	bz, err := hex.DecodeString(preimage)
+	if err != nil {
+		return false
+	}
+	return HTLCHashLock(bz) == h.HashLock
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "settlement"
@@ -48,6 +50,194 @@ var (
 
 	// FeeCollectorKey is the key for accumulated fees
 	FeeCollectorKey = []byte{0x09}
+
+	// CrossChainEscrowKeyPrefix is the prefix for IBC-funded escrow storage
+	CrossChainEscrowKeyPrefix = []byte{0x0A}
+
+	// EscrowArbitrationKeyPrefix is the prefix for multi-party escrow arbitration
+	EscrowArbitrationKeyPrefix = []byte{0x0B}
+
+	// MilestoneEscrowKeyPrefix is the prefix for milestone escrow storage
+	MilestoneEscrowKeyPrefix = []byte{0x0C}
+
+	// SubscriptionKeyPrefix is the prefix for recurring payment subscriptions
+	SubscriptionKeyPrefix = []byte{0x0D}
+
+	// NextSubscriptionIDKey is the key for the next subscription ID
+	NextSubscriptionIDKey = []byte{0x0E}
+
+	// EscrowExpiryQueuePrefix queues escrow settlement IDs by expiry time
+	EscrowExpiryQueuePrefix = []byte{0x0F}
+
+	// ChannelExpiryQueuePrefix queues payment channel IDs by expiry height
+	ChannelExpiryQueuePrefix = []byte{0x10}
+
+	// SubscriptionDueQueuePrefix queues subscription IDs by next due time
+	SubscriptionDueQueuePrefix = []byte{0x11}
+
+	// BidirectionalChannelKeyPrefix is the prefix for bidirectional channel storage
+	BidirectionalChannelKeyPrefix = []byte{0x12}
+
+	// NextBidirectionalChannelIDKey is the key for the next bidirectional channel ID
+	NextBidirectionalChannelIDKey = []byte{0x13}
+
+	// ChannelChallengeQueuePrefix queues closing bidirectional channel IDs by the
+	// height their challenge period ends
+	ChannelChallengeQueuePrefix = []byte{0x14}
+
+	// HTLCKeyPrefix is the prefix for hash time-locked transfer storage
+	HTLCKeyPrefix = []byte{0x15}
+
+	// NextHTLCIDKey is the key for the next HTLC ID
+	NextHTLCIDKey = []byte{0x16}
+
+	// DelayedPayoutKeyPrefix is the prefix for delayed merchant payouts, keyed
+	// by settlement ID
+	DelayedPayoutKeyPrefix = []byte{0x17}
+
+	// PayoutReleaseQueuePrefix queues pending payout settlement IDs by release time
+	PayoutReleaseQueuePrefix = []byte{0x18}
+
+	// OpenBatchKeyPrefix maps a merchant address to the ID of the batch
+	// collecting its incoming payments
+	OpenBatchKeyPrefix = []byte{0x19}
+
+	// BatchSettleQueuePrefix queues auto-settled batch IDs by the time they
+	// are due to settle
+	BatchSettleQueuePrefix = []byte{0x1A}
+
+	// NettingCycleKeyPrefix is the prefix for netting cycle storage
+	NettingCycleKeyPrefix = []byte{0x1B}
+
+	// NextNettingCycleIDKey is the key for the next netting cycle ID
+	NextNettingCycleIDKey = []byte{0x1C}
+
+	// NettingObligationKeyPrefix is the prefix for netting obligations, keyed
+	// by cycle ID and obligation ID
+	NettingObligationKeyPrefix = []byte{0x1D}
+
+	// NettingCloseQueuePrefix queues open netting cycle IDs by close time
+	NettingCloseQueuePrefix = []byte{0x1E}
+
+	// SplitTemplateKeyPrefix is the prefix for merchant split templates, keyed
+	// by length-prefixed merchant address and template name
+	SplitTemplateKeyPrefix = []byte{0x1F}
+
+	// MandateKeyPrefix is the prefix for direct debit mandates
+	MandateKeyPrefix = []byte{0x20}
+
+	// NextMandateIDKey stores the next mandate ID
+	NextMandateIDKey = []byte{0x21}
+
+	// SettlementBySenderPrefix indexes settlement IDs by sender
+	SettlementBySenderPrefix = []byte{0x22}
+
+	// SettlementByRecipientPrefix indexes settlement IDs by recipient
+	SettlementByRecipientPrefix = []byte{0x23}
+
+	// SettlementByReferencePrefix indexes settlement IDs by the hash of their
+	// external reference
+	SettlementByReferencePrefix = []byte{0x24}
+
+	// StreamKeyPrefix is the prefix for payment streams
+	StreamKeyPrefix = []byte{0x25}
+
+	// NextStreamIDKey stores the next stream ID
+	NextStreamIDKey = []byte{0x26}
+
+	// PayoutByMerchantPrefix indexes delayed payout settlement IDs by merchant
+	// and payout status
+	PayoutByMerchantPrefix = []byte{0x27}
+)
+
+const (
+	// MaxEscrowArbiters bounds the number of arbiters on a multi-party escrow
+	MaxEscrowArbiters = 10
+
+	// MaxEscrowMilestones bounds the number of milestones on a milestone escrow
+	MaxEscrowMilestones = 50
+
+	// MinSubscriptionInterval is the shortest allowed billing interval
+	MinSubscriptionInterval = time.Minute
+
+	// MinChannelChallengePeriod and MaxChannelChallengePeriod bound the challenge
+	// period of a bidirectional channel, in blocks
+	MinChannelChallengePeriod = 10
+	MaxChannelChallengePeriod = 100800
+
+	// MinHTLCTimeout and MaxHTLCTimeout bound how long an HTLC stays locked, in
+	// blocks
+	MinHTLCTimeout = 10
+	MaxHTLCTimeout = 100800
+
+	// HTLCTimeoutDelta is the minimum number of blocks a forwarded HTLC must time
+	// out before the HTLC it forwards, leaving the forwarding party time to claim
+	// the incoming hop once the outgoing one is claimed
+	HTLCTimeoutDelta = 10
+
+	// MaxRoutingFeeBps bounds the routing fee a channel can charge
+	MaxRoutingFeeBps = 10000
+
+	// MaxSettlementDelay bounds how long a merchant payout can be held
+	MaxSettlementDelay = 90 * 24 * time.Hour
+
+	// DefaultBatchMaxAge is how long an open batch collects payments when its
+	// merchant has not configured a max age
+	DefaultBatchMaxAge = 24 * time.Hour
+
+	// MinBatchMaxAge and MaxBatchMaxAge bound a merchant's batch max age
+	MinBatchMaxAge = time.Minute
+	MaxBatchMaxAge = 30 * 24 * time.Hour
+
+	// BatchTriggerThreshold, BatchTriggerSize and BatchTriggerMaxAge describe
+	// why EndBlock settled an auto-settled batch. BatchTriggerAuthority marks
+	// a batch settled by the authority.
+	BatchTriggerThreshold = "threshold"
+	BatchTriggerSize      = "max_batch_size"
+	BatchTriggerMaxAge    = "max_age"
+	BatchTriggerAuthority = "authority"
+
+	// MinNettingWindow and MaxNettingWindow bound how long a netting cycle
+	// collects obligations
+	MinNettingWindow = time.Minute
+	MaxNettingWindow = 7 * 24 * time.Hour
+
+	// MaxNettingParticipants bounds the participants of a netting cycle
+	MaxNettingParticipants = 100
+
+	// MaxNettingObligations bounds the obligations submitted to a netting cycle
+	MaxNettingObligations = 10000
+
+	// DefaultMaxSlippageBps bounds the conversion of a payment in another denom
+	// when the message does not set a slippage bound
+	DefaultMaxSlippageBps = 100
+
+	// MaxSlippageBps bounds the slippage a payer can accept
+	MaxSlippageBps = 10000
+
+	// FXRoutePSM marks a payment converted through the stablecoin PSM
+	FXRoutePSM = "psm"
+
+	// MaxSplitRules bounds the payees a checkout is split between, besides the
+	// merchant
+	MaxSplitRules = 10
+
+	// MaxSplitRoleLength bounds the role of a split payee
+	MaxSplitRoleLength = 32
+
+	// MaxSplitNameLength bounds the name of a merchant's split template
+	MaxSplitNameLength = 32
+
+	// SplitRoleMerchant is the role of the leg paying the merchant the
+	// remainder of a split checkout
+	SplitRoleMerchant = "merchant"
+
+	// MinMandatePeriod is the shortest allowed mandate spending period
+	MinMandatePeriod = time.Minute
+
+	// MaxStreamDuration bounds the funded-until time reported for a stream
+	// whose deposit would last longer
+	MaxStreamDuration = 100 * 365 * 24 * time.Hour
 )
 
 // Event types
@@ -67,6 +257,60 @@ const (
 	EventTypeChannelExpired      = "channel_expired"
 	EventTypeInstantCheckout     = "instant_checkout"
 	EventTypePartialRefund       = "partial_refund"
+	EventTypeMultiPartyEscrow    = "multi_party_escrow_created"
+	EventTypeEscrowApproval      = "escrow_approval"
+	EventTypeEscrowResolved      = "escrow_resolved"
+	EventTypeMilestoneReleased   = "milestone_released"
+	EventTypeMilestoneRefunded   = "milestone_refunded"
+	EventTypeMilestoneExpired    = "milestone_expired"
+	EventTypeSubscriptionCreated = "subscription_created"
+	EventTypeSubscriptionCharged = "subscription_charged"
+	EventTypeSubscriptionPastDue = "subscription_past_due"
+	EventTypeSubscriptionEnded   = "subscription_ended"
+
+	// Bidirectional channels
+	EventTypeBidirectionalChannelOpened  = "bidirectional_channel_opened"
+	EventTypeBidirectionalChannelFunded  = "bidirectional_channel_funded"
+	EventTypeChannelCloseInitiated       = "channel_close_initiated"
+	EventTypeChannelChallenged           = "channel_challenged"
+	EventTypeBidirectionalChannelSettled = "bidirectional_channel_settled"
+
+	// HTLCs
+	EventTypeHTLCLocked               = "htlc_locked"
+	EventTypeHTLCClaimed              = "htlc_claimed"
+	EventTypeHTLCRefunded             = "htlc_refunded"
+	EventTypeChannelRoutingFeeUpdated = "channel_routing_fee_updated"
+
+	// Delayed payouts
+	EventTypePayoutScheduled = "payout_scheduled"
+	EventTypePayoutReleased  = "payout_released"
+	EventTypePayoutFrozen    = "payout_frozen"
+	EventTypePayoutUnfrozen  = "payout_unfrozen"
+
+	// Netting
+	EventTypeNettingCycleCreated = "netting_cycle_created"
+	EventTypeObligationSubmitted = "obligation_submitted"
+	EventTypeNettingCycleSettled = "netting_cycle_settled"
+	EventTypeNettingCycleFailed  = "netting_cycle_failed"
+
+	// Multi-denom settlement
+	EventTypePaymentConverted = "payment_converted"
+
+	// Split payouts
+	EventTypeSplitTemplateSet = "split_template_set"
+	EventTypeSplitPaid        = "split_paid"
+	EventTypeSplitRefunded    = "split_refunded"
+
+	// Direct debit mandates
+	EventTypeMandateCreated = "mandate_created"
+	EventTypeMandateCharged = "mandate_charged"
+	EventTypeMandateRevoked = "mandate_revoked"
+
+	// Payment streams
+	EventTypeStreamCreated   = "stream_created"
+	EventTypeStreamWithdrawn = "stream_withdrawn"
+	EventTypeStreamToppedUp  = "stream_topped_up"
+	EventTypeStreamCancelled = "stream_cancelled"
 )
 
 // Event attribute keys
@@ -82,4 +326,70 @@ const (
 	AttributeKeyMerchant     = "merchant"
 	AttributeKeyOrderID      = "order_id"
 	AttributeKeyReference    = "reference"
+	AttributeKeyArbiters     = "arbiters"
+	AttributeKeyThreshold    = "threshold"
+	AttributeKeyParty        = "party"
+	AttributeKeyResolution   = "resolution"
+	AttributeKeyApprovals    = "approvals"
+	AttributeKeyMilestone    = "milestone"
+	AttributeKeyReason       = "reason"
+	AttributeKeySubscription = "subscription_id"
+	AttributeKeyPayer        = "payer"
+	AttributeKeyCycle        = "cycle"
+	AttributeKeyNextDue      = "next_due"
+
+	// Bidirectional channels
+	AttributeKeyPartyA        = "party_a"
+	AttributeKeyPartyB        = "party_b"
+	AttributeKeyBalanceA      = "balance_a"
+	AttributeKeyBalanceB      = "balance_b"
+	AttributeKeyNonce         = "nonce"
+	AttributeKeyChallengeEnds = "challenge_ends_height"
+
+	// HTLCs
+	AttributeKeyHTLCID        = "htlc_id"
+	AttributeKeyHashLock      = "hash_lock"
+	AttributeKeyPreimage      = "preimage"
+	AttributeKeyTimeoutHeight = "timeout_height"
+	AttributeKeyRoutingFee    = "routing_fee_bps"
+
+	// Delayed payouts
+	AttributeKeyReleaseAt = "release_at"
+
+	// Auto-settled batches
+	AttributeKeySettleBy = "settle_by"
+	AttributeKeyTrigger  = "trigger"
+
+	// Netting
+	AttributeKeyCycleID      = "cycle_id"
+	AttributeKeyObligationID = "obligation_id"
+	AttributeKeyDebtor       = "debtor"
+	AttributeKeyCreditor     = "creditor"
+	AttributeKeyClosesAt     = "closes_at"
+	AttributeKeyGrossTotal   = "gross_total"
+	AttributeKeyNetTotal     = "net_total"
+	AttributeKeyTransfers    = "transfers"
+
+	// Multi-denom settlement
+	AttributeKeyPaymentAmount = "payment_amount"
+	AttributeKeyOracleRate    = "oracle_rate"
+	AttributeKeyFXRate        = "fx_rate"
+	AttributeKeyRoute         = "route"
+
+	// Split payouts
+	AttributeKeyPayee     = "payee"
+	AttributeKeyRole      = "role"
+	AttributeKeyRules     = "rules"
+	AttributeKeySplitName = "split_name"
+
+	// Direct debit mandates
+	AttributeKeyMandateID       = "mandate_id"
+	AttributeKeyCustomer        = "customer"
+	AttributeKeyPeriodRemaining = "period_remaining"
+
+	// Payment streams
+	AttributeKeyStreamID      = "stream_id"
+	AttributeKeyRatePerSecond = "rate_per_second"
+	AttributeKeyPayerRefund   = "payer_refund"
+	AttributeKeyFundedUntil   = "funded_until"
 )
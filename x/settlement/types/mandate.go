@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateMandateLimits checks the limits a customer sets on a mandate: both
+// caps are positive stablecoin amounts, a single charge cannot exceed the
+// period cap and the period is at least MinMandatePeriod.
+func ValidateMandateLimits(perChargeMax, periodCap sdk.Coin, period, expiresIn time.Duration, reference string) error {
+	for _, limit := range []sdk.Coin{perChargeMax, periodCap} {
+		if !limit.IsValid() || limit.IsZero() {
+			return errorsmod.Wrap(ErrInvalidAmount, "mandate limits must be positive")
+		}
+		if limit.Denom != StablecoinDenom {
+			return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, limit.Denom)
+		}
+	}
+	if perChargeMax.IsGT(periodCap) {
+		return errorsmod.Wrap(ErrInvalidMandate, "per-charge maximum exceeds the period cap")
+	}
+	if period < MinMandatePeriod {
+		return errorsmod.Wrapf(ErrInvalidMandate, "period must be at least %s", MinMandatePeriod)
+	}
+	if expiresIn < 0 {
+		return errorsmod.Wrap(ErrInvalidMandate, "expiry cannot be negative")
+	}
+	if len(reference) > 256 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "reference too long")
+	}
+	return nil
+}
+
+// IsExpired reports whether the mandate has passed its expiry at the given time
+func (m Mandate) IsExpired(now time.Time) bool {
+	return !m.ExpiresAt.IsZero() && !now.Before(m.ExpiresAt)
+}
+
+// RollPeriod advances the mandate's spending period to the one containing now,
+// resetting what was spent when a new period starts
+func (m *Mandate) RollPeriod(now time.Time) {
+	if m.Period <= 0 || now.Before(m.PeriodStart.Add(m.Period)) {
+		return
+	}
+	elapsed := now.Sub(m.PeriodStart) / m.Period
+	m.PeriodStart = m.PeriodStart.Add(elapsed * m.Period)
+	m.PeriodSpent = sdk.NewCoin(m.PeriodCap.Denom, sdkmath.ZeroInt())
+}
+
+// PeriodRemaining returns what can still be pulled in the current period
+func (m Mandate) PeriodRemaining() sdk.Coin {
+	if m.PeriodSpent.IsGTE(m.PeriodCap) {
+		return sdk.NewCoin(m.PeriodCap.Denom, sdkmath.ZeroInt())
+	}
+	return m.PeriodCap.Sub(m.PeriodSpent)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -76,6 +77,25 @@ func ValidateWebhookURL(webhookURL string) error {
 	return nil
 }
 
+// ValidateSettlementDelay checks that a merchant settlement delay is within bounds
+func ValidateSettlementDelay(delay time.Duration) error {
+	if delay < 0 || delay > MaxSettlementDelay {
+		return errorsmod.Wrapf(ErrInvalidSettlementDelay, "settlement delay must be between 0 and %s", MaxSettlementDelay)
+	}
+	return nil
+}
+
+// ValidateBatchMaxAge checks a merchant's batch max age. Zero uses the module default.
+func ValidateBatchMaxAge(maxAge time.Duration) error {
+	if maxAge == 0 {
+		return nil
+	}
+	if maxAge < MinBatchMaxAge || maxAge > MaxBatchMaxAge {
+		return errorsmod.Wrapf(ErrInvalidBatchMaxAge, "batch max age must be between %s and %s", MinBatchMaxAge, MaxBatchMaxAge)
+	}
+	return nil
+}
+
 func NewMsgInstantTransfer(sender, recipient string, amount sdk.Coin, reference, metadata string) *MsgInstantTransfer {
 	return &MsgInstantTransfer{
 		Sender:    sender,
@@ -99,8 +119,8 @@ func (m MsgInstantTransfer) ValidateBasic() error {
 	if !m.Amount.IsValid() || m.Amount.IsZero() {
 		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
 	}
-	if m.Amount.Denom != StablecoinDenom {
-		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.Amount.Denom)
+	if m.MaxSlippageBps > MaxSlippageBps {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "max slippage must be <= %d bps", MaxSlippageBps)
 	}
 	return nil
 }
@@ -133,8 +153,8 @@ func (m MsgCreateEscrow) ValidateBasic() error {
 	if !m.Amount.IsValid() || m.Amount.IsZero() {
 		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
 	}
-	if m.Amount.Denom != StablecoinDenom {
-		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.Amount.Denom)
+	if m.MaxSlippageBps > MaxSlippageBps {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "max slippage must be <= %d bps", MaxSlippageBps)
 	}
 	if m.ExpiresIn < 0 {
 		return errorsmod.Wrap(ErrInvalidSettlement, "expiration cannot be negative")
@@ -327,17 +347,19 @@ func (m MsgClaimChannel) GetSigners() []sdk.AccAddress {
 	return mustGetSigner(m.Recipient)
 }
 
-func NewMsgRegisterMerchant(authority, merchant, name string, feeRateBps uint32, minSettlement, maxSettlement sdk.Coin, batchEnabled bool, batchThreshold sdk.Coin, webhookUrl string) *MsgRegisterMerchant {
+func NewMsgRegisterMerchant(authority, merchant, name string, feeRateBps uint32, minSettlement, maxSettlement sdk.Coin, batchEnabled bool, batchThreshold sdk.Coin, webhookUrl string, settlementDelay, batchMaxAge time.Duration) *MsgRegisterMerchant {
 	return &MsgRegisterMerchant{
-		Authority:      authority,
-		Merchant:       merchant,
-		Name:           name,
-		FeeRateBps:     feeRateBps,
-		MinSettlement:  minSettlement,
-		MaxSettlement:  maxSettlement,
-		BatchEnabled:   batchEnabled,
-		BatchThreshold: batchThreshold,
-		WebhookUrl:     webhookUrl,
+		Authority:       authority,
+		Merchant:        merchant,
+		Name:            name,
+		FeeRateBps:      feeRateBps,
+		MinSettlement:   minSettlement,
+		MaxSettlement:   maxSettlement,
+		BatchEnabled:    batchEnabled,
+		BatchThreshold:  batchThreshold,
+		WebhookUrl:      webhookUrl,
+		SettlementDelay: settlementDelay,
+		BatchMaxAge:     batchMaxAge,
 	}
 }
 
@@ -357,6 +379,20 @@ func (m MsgRegisterMerchant) ValidateBasic() error {
 	if err := ValidateWebhookURL(m.WebhookUrl); err != nil {
 		return err
 	}
+	if err := ValidateSettlementDelay(m.SettlementDelay); err != nil {
+		return err
+	}
+	if err := ValidateBatchMaxAge(m.BatchMaxAge); err != nil {
+		return err
+	}
+	if m.BatchThreshold.Denom != "" && !m.BatchThreshold.IsValid() {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid batch threshold")
+	}
+	if m.SettlementDenom != "" {
+		if err := sdk.ValidateDenom(m.SettlementDenom); err != nil {
+			return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -383,6 +419,24 @@ func (m MsgUpdateMerchant) ValidateBasic() error {
 	if m.FeeRateBps > 10000 {
 		return errorsmod.Wrap(ErrInvalidSettlement, "fee rate must be <= 10000 bps (100%)")
 	}
+	if m.SettlementDelay != nil {
+		if err := ValidateSettlementDelay(*m.SettlementDelay); err != nil {
+			return err
+		}
+	}
+	if m.BatchMaxAge != nil {
+		if err := ValidateBatchMaxAge(*m.BatchMaxAge); err != nil {
+			return err
+		}
+	}
+	if m.BatchThreshold.Denom != "" && !m.BatchThreshold.IsValid() {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid batch threshold")
+	}
+	if m.SettlementDenom != "" {
+		if err := sdk.ValidateDenom(m.SettlementDenom); err != nil {
+			return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -415,13 +469,13 @@ func (m MsgInstantCheckout) ValidateBasic() error {
 	if !m.Amount.IsValid() || m.Amount.IsZero() {
 		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
 	}
-	if m.Amount.Denom != StablecoinDenom {
-		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.Amount.Denom)
+	if m.MaxSlippageBps > MaxSlippageBps {
+		return errorsmod.Wrapf(ErrInvalidSlippage, "max slippage must be <= %d bps", MaxSlippageBps)
 	}
 	if m.OrderReference == "" {
 		return errorsmod.Wrap(ErrInvalidSettlement, "order reference is required")
 	}
-	return nil
+	return ValidateSplitName(m.SplitName)
 }
 
 func (m MsgInstantCheckout) GetSigners() []sdk.AccAddress {
@@ -456,3 +510,751 @@ func (m MsgPartialRefund) ValidateBasic() error {
 func (m MsgPartialRefund) GetSigners() []sdk.AccAddress {
 	return mustGetSigner(m.Authority)
 }
+
+// ValidateArbiters checks the arbiter set and approval threshold of a multi-party
+// escrow. The threshold counts the sender and recipient as well as the arbiters,
+// and must be at least two so neither party can resolve the escrow alone.
+func ValidateArbiters(sender, recipient string, arbiters []string, threshold uint32) error {
+	if len(arbiters) == 0 {
+		return errorsmod.Wrap(ErrInvalidArbitration, "at least one arbiter is required")
+	}
+	if len(arbiters) > MaxEscrowArbiters {
+		return errorsmod.Wrapf(ErrInvalidArbitration, "at most %d arbiters allowed", MaxEscrowArbiters)
+	}
+
+	seen := make(map[string]bool, len(arbiters))
+	for _, arbiter := range arbiters {
+		if _, err := sdk.AccAddressFromBech32(arbiter); err != nil {
+			return errorsmod.Wrapf(ErrInvalidArbitration, "invalid arbiter address %s", arbiter)
+		}
+		if arbiter == sender || arbiter == recipient {
+			return errorsmod.Wrap(ErrInvalidArbitration, "arbiters must differ from sender and recipient")
+		}
+		if seen[arbiter] {
+			return errorsmod.Wrapf(ErrInvalidArbitration, "duplicate arbiter %s", arbiter)
+		}
+		seen[arbiter] = true
+	}
+
+	parties := uint32(len(arbiters) + 2)
+	if threshold < 2 || threshold > parties {
+		return errorsmod.Wrapf(ErrInvalidArbitration, "threshold must be between 2 and %d", parties)
+	}
+	return nil
+}
+
+func NewMsgCreateMultiPartyEscrow(sender, recipient string, amount sdk.Coin, arbiters []string, threshold uint32, reference, metadata string, expiresIn time.Duration) *MsgCreateMultiPartyEscrow {
+	return &MsgCreateMultiPartyEscrow{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Arbiters:  arbiters,
+		Threshold: threshold,
+		Reference: reference,
+		Metadata:  metadata,
+		ExpiresIn: expiresIn,
+	}
+}
+
+func (m MsgCreateMultiPartyEscrow) ValidateBasic() error {
+	if err := NewMsgCreateEscrow(m.Sender, m.Recipient, m.Amount, m.Reference, m.Metadata, m.ExpiresIn).ValidateBasic(); err != nil {
+		return err
+	}
+	if m.Amount.Denom != StablecoinDenom {
+		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.Amount.Denom)
+	}
+	return ValidateArbiters(m.Sender, m.Recipient, m.Arbiters, m.Threshold)
+}
+
+func (m MsgCreateMultiPartyEscrow) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgApproveEscrowResolution(signer string, settlementId uint64, resolution EscrowResolution, recipientAmount sdk.Coin) *MsgApproveEscrowResolution {
+	return &MsgApproveEscrowResolution{
+		Signer:          signer,
+		SettlementId:    settlementId,
+		Resolution:      resolution,
+		RecipientAmount: recipientAmount,
+	}
+}
+
+func (m MsgApproveEscrowResolution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid signer address")
+	}
+	if m.SettlementId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "settlement id required")
+	}
+	if !m.Resolution.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidArbitration, "unknown resolution %q", m.Resolution)
+	}
+	if m.Resolution == EscrowResolutionSplit {
+		if !m.RecipientAmount.IsValid() || m.RecipientAmount.IsZero() {
+			return errorsmod.Wrap(ErrInvalidAmount, "split requires a positive recipient amount")
+		}
+		if m.RecipientAmount.Denom != StablecoinDenom {
+			return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.RecipientAmount.Denom)
+		}
+	}
+	return nil
+}
+
+func (m MsgApproveEscrowResolution) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgCreateMilestoneEscrow(sender, recipient string, milestones []MilestoneInput, reference, metadata string, expiresIn time.Duration) *MsgCreateMilestoneEscrow {
+	return &MsgCreateMilestoneEscrow{
+		Sender:     sender,
+		Recipient:  recipient,
+		Milestones: milestones,
+		Reference:  reference,
+		Metadata:   metadata,
+		ExpiresIn:  expiresIn,
+	}
+}
+
+func (m MsgCreateMilestoneEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid recipient address")
+	}
+	if m.Sender == m.Recipient {
+		return errorsmod.Wrap(ErrInvalidRecipient, "sender and recipient must be different")
+	}
+	if len(m.Milestones) == 0 {
+		return errorsmod.Wrap(ErrInvalidMilestone, "at least one milestone is required")
+	}
+	if len(m.Milestones) > MaxEscrowMilestones {
+		return errorsmod.Wrapf(ErrInvalidMilestone, "at most %d milestones allowed", MaxEscrowMilestones)
+	}
+	for i, milestone := range m.Milestones {
+		if !milestone.Amount.IsValid() || milestone.Amount.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "milestone %d amount must be positive", i)
+		}
+		if milestone.Amount.Denom != StablecoinDenom {
+			return errorsmod.Wrapf(ErrInvalidDenom, "milestone %d: expected %s, got %s", i, StablecoinDenom, milestone.Amount.Denom)
+		}
+	}
+	if m.ExpiresIn < 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "expiration cannot be negative")
+	}
+	return nil
+}
+
+func (m MsgCreateMilestoneEscrow) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgReleaseMilestone(sender string, settlementId uint64, milestone uint32) *MsgReleaseMilestone {
+	return &MsgReleaseMilestone{Sender: sender, SettlementId: settlementId, Milestone: milestone}
+}
+
+func (m MsgReleaseMilestone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid sender address")
+	}
+	if m.SettlementId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "settlement id required")
+	}
+	return nil
+}
+
+func (m MsgReleaseMilestone) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgRefundMilestone(recipient string, settlementId uint64, milestone uint32, reason string) *MsgRefundMilestone {
+	return &MsgRefundMilestone{Recipient: recipient, SettlementId: settlementId, Milestone: milestone, Reason: reason}
+}
+
+func (m MsgRefundMilestone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid recipient address")
+	}
+	if m.SettlementId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "settlement id required")
+	}
+	return nil
+}
+
+func (m MsgRefundMilestone) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Recipient)
+}
+
+func NewMsgCreateSubscription(payer, merchant string, amount sdk.Coin, interval time.Duration, maxCycles uint64, gracePeriod time.Duration, reference, metadata string) *MsgCreateSubscription {
+	return &MsgCreateSubscription{
+		Payer:       payer,
+		Merchant:    merchant,
+		Amount:      amount,
+		Interval:    interval,
+		MaxCycles:   maxCycles,
+		GracePeriod: gracePeriod,
+		Reference:   reference,
+		Metadata:    metadata,
+	}
+}
+
+func (m MsgCreateSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid payer address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Merchant); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid merchant address")
+	}
+	if m.Payer == m.Merchant {
+		return errorsmod.Wrap(ErrInvalidRecipient, "payer and merchant must be different")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return ErrInvalidAmount
+	}
+	if m.Amount.Denom != StablecoinDenom {
+		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, m.Amount.Denom)
+	}
+	if m.Interval < MinSubscriptionInterval {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "interval must be at least %s", MinSubscriptionInterval)
+	}
+	if m.GracePeriod < 0 {
+		return errorsmod.Wrap(ErrInvalidSubscription, "grace period cannot be negative")
+	}
+	if m.GracePeriod >= m.Interval {
+		return errorsmod.Wrap(ErrInvalidSubscription, "grace period must be shorter than the interval")
+	}
+	if len(m.Reference) > 256 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "reference too long")
+	}
+	return nil
+}
+
+func (m MsgCreateSubscription) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Payer)
+}
+
+func NewMsgCancelSubscription(signer string, subscriptionId uint64, reason string) *MsgCancelSubscription {
+	return &MsgCancelSubscription{Signer: signer, SubscriptionId: subscriptionId, Reason: reason}
+}
+
+func (m MsgCancelSubscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	if m.SubscriptionId == 0 {
+		return errorsmod.Wrap(ErrInvalidSubscription, "subscription id required")
+	}
+	return nil
+}
+
+func (m MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgOpenBidirectionalChannel(partyA, partyB string, deposit sdk.Coin, challengePeriod int64) *MsgOpenBidirectionalChannel {
+	return &MsgOpenBidirectionalChannel{
+		PartyA:          partyA,
+		PartyB:          partyB,
+		Deposit:         deposit,
+		ChallengePeriod: challengePeriod,
+	}
+}
+
+func (m MsgOpenBidirectionalChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.PartyA); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid party a address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.PartyB); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid party b address")
+	}
+	if m.PartyA == m.PartyB {
+		return errorsmod.Wrap(ErrInvalidRecipient, "channel parties must be different")
+	}
+	if !m.Deposit.IsValid() || m.Deposit.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "deposit must be positive")
+	}
+	if m.ChallengePeriod < MinChannelChallengePeriod || m.ChallengePeriod > MaxChannelChallengePeriod {
+		return errorsmod.Wrapf(ErrInvalidChannelExpiration, "challenge period must be between %d and %d blocks", MinChannelChallengePeriod, MaxChannelChallengePeriod)
+	}
+	return nil
+}
+
+func (m MsgOpenBidirectionalChannel) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.PartyA)
+}
+
+func NewMsgFundBidirectionalChannel(partyB string, channelId uint64, deposit sdk.Coin) *MsgFundBidirectionalChannel {
+	return &MsgFundBidirectionalChannel{PartyB: partyB, ChannelId: channelId, Deposit: deposit}
+}
+
+func (m MsgFundBidirectionalChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.PartyB); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid party b address")
+	}
+	if m.ChannelId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "channel id required")
+	}
+	if !m.Deposit.IsValid() || m.Deposit.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "deposit must be positive")
+	}
+	return nil
+}
+
+func (m MsgFundBidirectionalChannel) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.PartyB)
+}
+
+func NewMsgCooperativeCloseChannel(signer string, state ChannelState) *MsgCooperativeCloseChannel {
+	return &MsgCooperativeCloseChannel{Signer: signer, State: state}
+}
+
+func (m MsgCooperativeCloseChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	if err := m.State.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(m.State.SignatureA) < 64 || len(m.State.SignatureB) < 64 {
+		return errorsmod.Wrap(ErrInvalidSignature, "both parties must sign a cooperative close")
+	}
+	return nil
+}
+
+func (m MsgCooperativeCloseChannel) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgInitiateChannelClose(signer string, state ChannelState) *MsgInitiateChannelClose {
+	return &MsgInitiateChannelClose{Signer: signer, State: state}
+}
+
+func (m MsgInitiateChannelClose) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	return m.State.ValidateBasic()
+}
+
+func (m MsgInitiateChannelClose) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgChallengeChannelClose(signer string, state ChannelState) *MsgChallengeChannelClose {
+	return &MsgChallengeChannelClose{Signer: signer, State: state}
+}
+
+func (m MsgChallengeChannelClose) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	if err := m.State.ValidateBasic(); err != nil {
+		return err
+	}
+	if m.State.Nonce == 0 {
+		return errorsmod.Wrap(ErrInvalidNonce, "a challenge must carry a signed state")
+	}
+	return nil
+}
+
+func (m MsgChallengeChannelClose) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgLockHTLC(sender, recipient string, amount sdk.Coin, hashLock string, timeoutBlocks int64, channelId, incomingHtlcId uint64) *MsgLockHTLC {
+	return &MsgLockHTLC{
+		Sender:         sender,
+		Recipient:      recipient,
+		Amount:         amount,
+		HashLock:       hashLock,
+		TimeoutBlocks:  timeoutBlocks,
+		ChannelId:      channelId,
+		IncomingHtlcId: incomingHtlcId,
+	}
+}
+
+func (m MsgLockHTLC) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid recipient address")
+	}
+	if m.Sender == m.Recipient {
+		return errorsmod.Wrap(ErrInvalidRecipient, "sender and recipient must be different")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if err := ValidateHashLock(m.HashLock); err != nil {
+		return err
+	}
+	if m.TimeoutBlocks < MinHTLCTimeout || m.TimeoutBlocks > MaxHTLCTimeout {
+		return errorsmod.Wrapf(ErrInvalidHTLC, "timeout must be between %d and %d blocks", MinHTLCTimeout, MaxHTLCTimeout)
+	}
+	return nil
+}
+
+func (m MsgLockHTLC) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgClaimHTLC(recipient string, htlcId uint64, preimage string) *MsgClaimHTLC {
+	return &MsgClaimHTLC{Recipient: recipient, HtlcId: htlcId, Preimage: preimage}
+}
+
+func (m MsgClaimHTLC) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid recipient address")
+	}
+	if m.HtlcId == 0 {
+		return errorsmod.Wrap(ErrInvalidHTLC, "htlc id required")
+	}
+	if m.Preimage == "" {
+		return errorsmod.Wrap(ErrInvalidPreimage, "preimage required")
+	}
+	return nil
+}
+
+func (m MsgClaimHTLC) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Recipient)
+}
+
+func NewMsgRefundHTLC(sender string, htlcId uint64) *MsgRefundHTLC {
+	return &MsgRefundHTLC{Sender: sender, HtlcId: htlcId}
+}
+
+func (m MsgRefundHTLC) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid sender address")
+	}
+	if m.HtlcId == 0 {
+		return errorsmod.Wrap(ErrInvalidHTLC, "htlc id required")
+	}
+	return nil
+}
+
+func (m MsgRefundHTLC) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgSetChannelRoutingFee(sender string, channelId uint64, routingFeeBps uint32) *MsgSetChannelRoutingFee {
+	return &MsgSetChannelRoutingFee{Sender: sender, ChannelId: channelId, RoutingFeeBps: routingFeeBps}
+}
+
+func (m MsgSetChannelRoutingFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid sender address")
+	}
+	if m.ChannelId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "channel id required")
+	}
+	if m.RoutingFeeBps > MaxRoutingFeeBps {
+		return errorsmod.Wrapf(ErrInvalidSettlement, "routing fee must be <= %d bps", MaxRoutingFeeBps)
+	}
+	return nil
+}
+
+func (m MsgSetChannelRoutingFee) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Sender)
+}
+
+func NewMsgFreezePayout(authority string, settlementId uint64, reason string) *MsgFreezePayout {
+	return &MsgFreezePayout{Authority: authority, SettlementId: settlementId, Reason: reason}
+}
+
+func (m MsgFreezePayout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid authority address")
+	}
+	if m.SettlementId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "settlement id required")
+	}
+	if len(m.Reason) == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "freeze reason required")
+	}
+	return nil
+}
+
+func (m MsgFreezePayout) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Authority)
+}
+
+func NewMsgUnfreezePayout(authority string, settlementId uint64) *MsgUnfreezePayout {
+	return &MsgUnfreezePayout{Authority: authority, SettlementId: settlementId}
+}
+
+func (m MsgUnfreezePayout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid authority address")
+	}
+	if m.SettlementId == 0 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "settlement id required")
+	}
+	return nil
+}
+
+func (m MsgUnfreezePayout) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Authority)
+}
+
+func NewMsgCreateNettingCycle(operator string, participants []string, denom string, window time.Duration) *MsgCreateNettingCycle {
+	return &MsgCreateNettingCycle{Operator: operator, Participants: participants, Denom: denom, Window: window}
+}
+
+func (m MsgCreateNettingCycle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(ErrInvalidNettingCycle, "invalid operator address")
+	}
+	if len(m.Participants) < 2 || len(m.Participants) > MaxNettingParticipants {
+		return errorsmod.Wrapf(ErrInvalidNettingCycle, "netting cycle must have between 2 and %d participants", MaxNettingParticipants)
+	}
+	seen := make(map[string]bool, len(m.Participants))
+	for _, participant := range m.Participants {
+		if _, err := sdk.AccAddressFromBech32(participant); err != nil {
+			return errorsmod.Wrapf(ErrInvalidNettingCycle, "invalid participant address %s", participant)
+		}
+		if seen[participant] {
+			return errorsmod.Wrapf(ErrInvalidNettingCycle, "duplicate participant %s", participant)
+		}
+		seen[participant] = true
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidNettingCycle, err.Error())
+	}
+	if m.Window < MinNettingWindow || m.Window > MaxNettingWindow {
+		return errorsmod.Wrapf(ErrInvalidNettingCycle, "window must be between %s and %s", MinNettingWindow, MaxNettingWindow)
+	}
+	return nil
+}
+
+func (m MsgCreateNettingCycle) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Operator)
+}
+
+func NewMsgSubmitObligation(debtor string, cycleId uint64, creditor string, amount sdk.Coin, reference string) *MsgSubmitObligation {
+	return &MsgSubmitObligation{Debtor: debtor, CycleId: cycleId, Creditor: creditor, Amount: amount, Reference: reference}
+}
+
+func (m MsgSubmitObligation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Debtor); err != nil {
+		return errorsmod.Wrap(ErrInvalidObligation, "invalid debtor address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Creditor); err != nil {
+		return errorsmod.Wrap(ErrInvalidObligation, "invalid creditor address")
+	}
+	if m.Debtor == m.Creditor {
+		return errorsmod.Wrap(ErrInvalidObligation, "debtor and creditor must be different")
+	}
+	if m.CycleId == 0 {
+		return errorsmod.Wrap(ErrInvalidObligation, "cycle id required")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if len(m.Reference) > 256 {
+		return errorsmod.Wrap(ErrInvalidObligation, "reference too long")
+	}
+	return nil
+}
+
+func (m MsgSubmitObligation) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Debtor)
+}
+
+func NewMsgSettleNettingCycle(operator string, cycleId uint64) *MsgSettleNettingCycle {
+	return &MsgSettleNettingCycle{Operator: operator, CycleId: cycleId}
+}
+
+func (m MsgSettleNettingCycle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(ErrInvalidNettingCycle, "invalid operator address")
+	}
+	if m.CycleId == 0 {
+		return errorsmod.Wrap(ErrInvalidNettingCycle, "cycle id required")
+	}
+	return nil
+}
+
+func (m MsgSettleNettingCycle) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Operator)
+}
+
+func NewMsgSetSplitTemplate(merchant, name string, rules []SplitRule) *MsgSetSplitTemplate {
+	return &MsgSetSplitTemplate{Merchant: merchant, Name: name, Rules: rules}
+}
+
+func (m MsgSetSplitTemplate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Merchant); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid merchant address")
+	}
+	if err := ValidateSplitName(m.Name); err != nil {
+		return err
+	}
+	return ValidateSplitRules(m.Merchant, m.Rules)
+}
+
+func (m MsgSetSplitTemplate) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Merchant)
+}
+
+func NewMsgCreateMandate(customer, merchant string, perChargeMax, periodCap sdk.Coin, period, expiresIn time.Duration, reference string) *MsgCreateMandate {
+	return &MsgCreateMandate{
+		Customer:     customer,
+		Merchant:     merchant,
+		PerChargeMax: perChargeMax,
+		PeriodCap:    periodCap,
+		Period:       period,
+		ExpiresIn:    expiresIn,
+		Reference:    reference,
+	}
+}
+
+func (m MsgCreateMandate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Customer); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid customer address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Merchant); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid merchant address")
+	}
+	if m.Customer == m.Merchant {
+		return errorsmod.Wrap(ErrInvalidRecipient, "customer and merchant must be different")
+	}
+	return ValidateMandateLimits(m.PerChargeMax, m.PeriodCap, m.Period, m.ExpiresIn, m.Reference)
+}
+
+func (m MsgCreateMandate) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Customer)
+}
+
+func NewMsgRevokeMandate(signer string, mandateId uint64, reason string) *MsgRevokeMandate {
+	return &MsgRevokeMandate{Signer: signer, MandateId: mandateId, Reason: reason}
+}
+
+func (m MsgRevokeMandate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	if m.MandateId == 0 {
+		return errorsmod.Wrap(ErrInvalidMandate, "mandate id required")
+	}
+	return nil
+}
+
+func (m MsgRevokeMandate) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgPullPayment(merchant string, mandateId uint64, amount sdk.Coin, reference, metadata string) *MsgPullPayment {
+	return &MsgPullPayment{
+		Merchant:  merchant,
+		MandateId: mandateId,
+		Amount:    amount,
+		Reference: reference,
+		Metadata:  metadata,
+	}
+}
+
+func (m MsgPullPayment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Merchant); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid merchant address")
+	}
+	if m.MandateId == 0 {
+		return errorsmod.Wrap(ErrInvalidMandate, "mandate id required")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if len(m.Reference) > 256 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "reference too long")
+	}
+	return nil
+}
+
+func (m MsgPullPayment) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Merchant)
+}
+
+func NewMsgCreateStream(payer, recipient string, deposit sdk.Coin, ratePerSecond sdkmath.Int, reference string) *MsgCreateStream {
+	return &MsgCreateStream{
+		Payer:         payer,
+		Recipient:     recipient,
+		Deposit:       deposit,
+		RatePerSecond: ratePerSecond,
+		Reference:     reference,
+	}
+}
+
+func (m MsgCreateStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid payer address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid recipient address")
+	}
+	if m.Payer == m.Recipient {
+		return errorsmod.Wrap(ErrInvalidRecipient, "payer and recipient must be different")
+	}
+	return ValidateStreamTerms(m.Deposit, m.RatePerSecond, m.Reference)
+}
+
+func (m MsgCreateStream) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Payer)
+}
+
+func NewMsgWithdrawStream(recipient string, streamId uint64) *MsgWithdrawStream {
+	return &MsgWithdrawStream{Recipient: recipient, StreamId: streamId}
+}
+
+func (m MsgWithdrawStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(ErrInvalidRecipient, "invalid recipient address")
+	}
+	if m.StreamId == 0 {
+		return errorsmod.Wrap(ErrInvalidStream, "stream id required")
+	}
+	return nil
+}
+
+func (m MsgWithdrawStream) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Recipient)
+}
+
+func NewMsgCancelStream(signer string, streamId uint64) *MsgCancelStream {
+	return &MsgCancelStream{Signer: signer, StreamId: streamId}
+}
+
+func (m MsgCancelStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(ErrUnauthorized, "invalid signer address")
+	}
+	if m.StreamId == 0 {
+		return errorsmod.Wrap(ErrInvalidStream, "stream id required")
+	}
+	return nil
+}
+
+func (m MsgCancelStream) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Signer)
+}
+
+func NewMsgTopUpStream(payer string, streamId uint64, amount sdk.Coin) *MsgTopUpStream {
+	return &MsgTopUpStream{Payer: payer, StreamId: streamId, Amount: amount}
+}
+
+func (m MsgTopUpStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return errorsmod.Wrap(ErrInvalidSettlement, "invalid payer address")
+	}
+	if m.StreamId == 0 {
+		return errorsmod.Wrap(ErrInvalidStream, "stream id required")
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	return nil
+}
+
+func (m MsgTopUpStream) GetSigners() []sdk.AccAddress {
+	return mustGetSigner(m.Payer)
+}
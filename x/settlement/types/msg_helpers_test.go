@@ -1,9 +1,11 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -140,11 +142,22 @@ func TestMsgInstantTransfer_ValidateBasic(t *testing.T) {
 			expectErr: true,
 		},
 		{
-			name: "wrong denom",
+			name: "other denom is converted",
 			msg: &types.MsgInstantTransfer{
-				Sender:    validSender,
-				Recipient: validRecipient,
-				Amount:    sdk.NewInt64Coin("uatom", 100),
+				Sender:         validSender,
+				Recipient:      validRecipient,
+				Amount:         sdk.NewInt64Coin("uusdc", 100),
+				MaxSlippageBps: 50,
+			},
+			expectErr: false,
+		},
+		{
+			name: "slippage too large",
+			msg: &types.MsgInstantTransfer{
+				Sender:         validSender,
+				Recipient:      validRecipient,
+				Amount:         sdk.NewInt64Coin("uusdc", 100),
+				MaxSlippageBps: types.MaxSlippageBps + 1,
 			},
 			expectErr: true,
 		},
@@ -202,13 +215,24 @@ func TestMsgCreateEscrow_ValidateBasic(t *testing.T) {
 			expectErr: true,
 		},
 		{
-			name: "wrong denom",
+			name: "other denom is converted",
 			msg: &types.MsgCreateEscrow{
 				Sender:    validSender,
 				Recipient: validRecipient,
-				Amount:    sdk.NewInt64Coin("uatom", 100),
+				Amount:    sdk.NewInt64Coin("uusdc", 100),
 				ExpiresIn: time.Hour,
 			},
+			expectErr: false,
+		},
+		{
+			name: "slippage too large",
+			msg: &types.MsgCreateEscrow{
+				Sender:         validSender,
+				Recipient:      validRecipient,
+				Amount:         sdk.NewInt64Coin("uusdc", 100),
+				ExpiresIn:      time.Hour,
+				MaxSlippageBps: types.MaxSlippageBps + 1,
+			},
 			expectErr: true,
 		},
 		{
@@ -630,6 +654,40 @@ func TestMsgRegisterMerchant_ValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "settlement delay too long",
+			msg: &types.MsgRegisterMerchant{
+				Authority:       validAuthority,
+				Merchant:        validMerchant,
+				Name:            "Test Merchant",
+				FeeRateBps:      100,
+				SettlementDelay: types.MaxSettlementDelay + time.Hour,
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative settlement delay",
+			msg: &types.MsgRegisterMerchant{
+				Authority:       validAuthority,
+				Merchant:        validMerchant,
+				Name:            "Test Merchant",
+				FeeRateBps:      100,
+				SettlementDelay: -time.Hour,
+			},
+			expectErr: true,
+		},
+		{
+			name: "batch max age too short",
+			msg: &types.MsgRegisterMerchant{
+				Authority:    validAuthority,
+				Merchant:     validMerchant,
+				Name:         "Test Merchant",
+				FeeRateBps:   100,
+				BatchEnabled: true,
+				BatchMaxAge:  time.Second,
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -684,13 +742,24 @@ func TestMsgInstantCheckout_ValidateBasic(t *testing.T) {
 			expectErr: true,
 		},
 		{
-			name: "wrong denom",
+			name: "other denom is converted",
 			msg: &types.MsgInstantCheckout{
 				Customer:       validCustomer,
 				Merchant:       validMerchant,
-				Amount:         sdk.NewInt64Coin("uatom", 100),
+				Amount:         sdk.NewInt64Coin("uusdc", 100),
 				OrderReference: "ORDER-123",
 			},
+			expectErr: false,
+		},
+		{
+			name: "slippage too large",
+			msg: &types.MsgInstantCheckout{
+				Customer:       validCustomer,
+				Merchant:       validMerchant,
+				Amount:         sdk.NewInt64Coin("uusdc", 100),
+				OrderReference: "ORDER-123",
+				MaxSlippageBps: types.MaxSlippageBps + 1,
+			},
 			expectErr: true,
 		},
 		{
@@ -789,6 +858,557 @@ func TestMsgPartialRefund_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgCreateMultiPartyEscrow_ValidateBasic(t *testing.T) {
+	validSender := sdk.AccAddress("sender______________").String()
+	validRecipient := sdk.AccAddress("recipient___________").String()
+	arbiter1 := sdk.AccAddress("arbiter1____________").String()
+	arbiter2 := sdk.AccAddress("arbiter2____________").String()
+	amount := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgCreateMultiPartyEscrow
+		expectErr bool
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{arbiter1, arbiter2}, 2, "", "", time.Hour),
+			expectErr: false,
+		},
+		{
+			name:      "threshold counts all parties",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{arbiter1, arbiter2}, 4, "", "", time.Hour),
+			expectErr: false,
+		},
+		{
+			name:      "no arbiters",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, nil, 2, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "threshold of one",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{arbiter1}, 1, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "threshold above party count",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{arbiter1}, 4, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "duplicate arbiter",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{arbiter1, arbiter1}, 2, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "recipient as arbiter",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{validRecipient}, 2, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "invalid arbiter",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, amount, []string{"invalid"}, 2, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "wrong denom",
+			msg:       types.NewMsgCreateMultiPartyEscrow(validSender, validRecipient, sdk.NewInt64Coin("uatom", 100), []string{arbiter1}, 2, "", "", time.Hour),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgApproveEscrowResolution_ValidateBasic(t *testing.T) {
+	validSigner := sdk.AccAddress("signer______________").String()
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgApproveEscrowResolution
+		expectErr bool
+	}{
+		{
+			name:      "valid release",
+			msg:       types.NewMsgApproveEscrowResolution(validSigner, 1, types.EscrowResolutionRelease, sdk.Coin{}),
+			expectErr: false,
+		},
+		{
+			name:      "valid split",
+			msg:       types.NewMsgApproveEscrowResolution(validSigner, 1, types.EscrowResolutionSplit, sdk.NewInt64Coin(types.StablecoinDenom, 40)),
+			expectErr: false,
+		},
+		{
+			name:      "split without amount",
+			msg:       types.NewMsgApproveEscrowResolution(validSigner, 1, types.EscrowResolutionSplit, sdk.Coin{}),
+			expectErr: true,
+		},
+		{
+			name:      "unknown resolution",
+			msg:       types.NewMsgApproveEscrowResolution(validSigner, 1, "burn", sdk.Coin{}),
+			expectErr: true,
+		},
+		{
+			name:      "zero settlement id",
+			msg:       types.NewMsgApproveEscrowResolution(validSigner, 0, types.EscrowResolutionRefund, sdk.Coin{}),
+			expectErr: true,
+		},
+		{
+			name:      "invalid signer",
+			msg:       types.NewMsgApproveEscrowResolution("invalid", 1, types.EscrowResolutionRefund, sdk.Coin{}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateMilestoneEscrow_ValidateBasic(t *testing.T) {
+	validSender := sdk.AccAddress("sender______________").String()
+	validRecipient := sdk.AccAddress("recipient___________").String()
+	milestone := types.MilestoneInput{Description: "phase 1", Amount: sdk.NewInt64Coin(types.StablecoinDenom, 100)}
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgCreateMilestoneEscrow
+		expectErr bool
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validRecipient, []types.MilestoneInput{milestone, milestone}, "", "", time.Hour),
+			expectErr: false,
+		},
+		{
+			name:      "no milestones",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validRecipient, nil, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "zero milestone amount",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validRecipient, []types.MilestoneInput{{Amount: sdk.NewInt64Coin(types.StablecoinDenom, 0)}}, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "wrong milestone denom",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validRecipient, []types.MilestoneInput{{Amount: sdk.NewInt64Coin("uatom", 100)}}, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "same sender and recipient",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validSender, []types.MilestoneInput{milestone}, "", "", time.Hour),
+			expectErr: true,
+		},
+		{
+			name:      "negative expiration",
+			msg:       types.NewMsgCreateMilestoneEscrow(validSender, validRecipient, []types.MilestoneInput{milestone}, "", "", -time.Hour),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgReleaseAndRefundMilestone_ValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress("party_______________").String()
+
+	require.NoError(t, types.NewMsgReleaseMilestone(validAddr, 1, 0).ValidateBasic())
+	require.Error(t, types.NewMsgReleaseMilestone(validAddr, 0, 0).ValidateBasic())
+	require.Error(t, types.NewMsgReleaseMilestone("invalid", 1, 0).ValidateBasic())
+
+	require.NoError(t, types.NewMsgRefundMilestone(validAddr, 1, 2, "late").ValidateBasic())
+	require.Error(t, types.NewMsgRefundMilestone(validAddr, 0, 2, "").ValidateBasic())
+	require.Error(t, types.NewMsgRefundMilestone("invalid", 1, 2, "").ValidateBasic())
+}
+
+func TestMsgCreateSubscription_ValidateBasic(t *testing.T) {
+	validPayer := sdk.AccAddress("payer_______________").String()
+	validMerchant := sdk.AccAddress("merchant____________").String()
+	amount := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgCreateSubscription
+		expectErr bool
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgCreateSubscription(validPayer, validMerchant, amount, 720*time.Hour, 12, 72*time.Hour, "plan", ""),
+			expectErr: false,
+		},
+		{
+			name:      "interval too short",
+			msg:       types.NewMsgCreateSubscription(validPayer, validMerchant, amount, time.Second, 0, 0, "", ""),
+			expectErr: true,
+		},
+		{
+			name:      "grace period not shorter than interval",
+			msg:       types.NewMsgCreateSubscription(validPayer, validMerchant, amount, time.Hour, 0, time.Hour, "", ""),
+			expectErr: true,
+		},
+		{
+			name:      "wrong denom",
+			msg:       types.NewMsgCreateSubscription(validPayer, validMerchant, sdk.NewInt64Coin("uatom", 100), time.Hour, 0, 0, "", ""),
+			expectErr: true,
+		},
+		{
+			name:      "payer is merchant",
+			msg:       types.NewMsgCreateSubscription(validPayer, validPayer, amount, time.Hour, 0, 0, "", ""),
+			expectErr: true,
+		},
+		{
+			name:      "invalid merchant",
+			msg:       types.NewMsgCreateSubscription(validPayer, "invalid", amount, time.Hour, 0, 0, "", ""),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelSubscription_ValidateBasic(t *testing.T) {
+	validSigner := sdk.AccAddress("signer______________").String()
+
+	require.NoError(t, types.NewMsgCancelSubscription(validSigner, 1, "").ValidateBasic())
+	require.Error(t, types.NewMsgCancelSubscription(validSigner, 0, "").ValidateBasic())
+	require.Error(t, types.NewMsgCancelSubscription("invalid", 1, "").ValidateBasic())
+}
+
+func TestMsgOpenBidirectionalChannel_ValidateBasic(t *testing.T) {
+	partyA := sdk.AccAddress("party_a_____________").String()
+	partyB := sdk.AccAddress("party_b_____________").String()
+	deposit := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgOpenBidirectionalChannel
+		expectErr bool
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgOpenBidirectionalChannel(partyA, partyB, deposit, 100),
+			expectErr: false,
+		},
+		{
+			name:      "same parties",
+			msg:       types.NewMsgOpenBidirectionalChannel(partyA, partyA, deposit, 100),
+			expectErr: true,
+		},
+		{
+			name:      "zero deposit",
+			msg:       types.NewMsgOpenBidirectionalChannel(partyA, partyB, sdk.NewInt64Coin(types.StablecoinDenom, 0), 100),
+			expectErr: true,
+		},
+		{
+			name:      "challenge period too short",
+			msg:       types.NewMsgOpenBidirectionalChannel(partyA, partyB, deposit, 1),
+			expectErr: true,
+		},
+		{
+			name:      "challenge period too long",
+			msg:       types.NewMsgOpenBidirectionalChannel(partyA, partyB, deposit, types.MaxChannelChallengePeriod+1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgChannelClose_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________").String()
+	sig := strings.Repeat("ab", 64)
+	signed := types.ChannelState{
+		ChannelId:  1,
+		BalanceA:   sdk.NewInt64Coin(types.StablecoinDenom, 60),
+		BalanceB:   sdk.NewInt64Coin(types.StablecoinDenom, 40),
+		Nonce:      3,
+		SignatureA: sig,
+		SignatureB: sig,
+	}
+	opening := types.ChannelState{
+		ChannelId: 1,
+		BalanceA:  sdk.NewInt64Coin(types.StablecoinDenom, 100),
+		BalanceB:  sdk.NewInt64Coin(types.StablecoinDenom, 0),
+	}
+	oneSigned := signed
+	oneSigned.SignatureB = ""
+	mixedDenoms := signed
+	mixedDenoms.BalanceB = sdk.NewInt64Coin("uatom", 40)
+
+	require.NoError(t, types.NewMsgInitiateChannelClose(signer, signed).ValidateBasic())
+	require.NoError(t, types.NewMsgInitiateChannelClose(signer, opening).ValidateBasic())
+	require.Error(t, types.NewMsgInitiateChannelClose(signer, oneSigned).ValidateBasic())
+	require.Error(t, types.NewMsgInitiateChannelClose(signer, mixedDenoms).ValidateBasic())
+	require.Error(t, types.NewMsgInitiateChannelClose("invalid", signed).ValidateBasic())
+
+	require.NoError(t, types.NewMsgChallengeChannelClose(signer, signed).ValidateBasic())
+	require.Error(t, types.NewMsgChallengeChannelClose(signer, opening).ValidateBasic())
+
+	require.NoError(t, types.NewMsgCooperativeCloseChannel(signer, signed).ValidateBasic())
+	require.Error(t, types.NewMsgCooperativeCloseChannel(signer, opening).ValidateBasic())
+}
+
+func TestMsgLockHTLC_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	recipient := sdk.AccAddress("recipient___________").String()
+	amount := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+	hashLock := types.HTLCHashLock([]byte("secret"))
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgLockHTLC
+		expectErr bool
+	}{
+		{
+			name:      "valid message",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, hashLock, 100, 0, 0),
+			expectErr: false,
+		},
+		{
+			name:      "valid forwarded hop",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, hashLock, 100, 1, 1),
+			expectErr: false,
+		},
+		{
+			name:      "same parties",
+			msg:       types.NewMsgLockHTLC(sender, sender, amount, hashLock, 100, 0, 0),
+			expectErr: true,
+		},
+		{
+			name:      "zero amount",
+			msg:       types.NewMsgLockHTLC(sender, recipient, sdk.NewInt64Coin(types.StablecoinDenom, 0), hashLock, 100, 0, 0),
+			expectErr: true,
+		},
+		{
+			name:      "hash lock not hex",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, "not-a-hash", 100, 0, 0),
+			expectErr: true,
+		},
+		{
+			name:      "hash lock wrong length",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, hashLock[:32], 100, 0, 0),
+			expectErr: true,
+		},
+		{
+			name:      "timeout too short",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, hashLock, types.MinHTLCTimeout-1, 0, 0),
+			expectErr: true,
+		},
+		{
+			name:      "timeout too long",
+			msg:       types.NewMsgLockHTLC(sender, recipient, amount, hashLock, types.MaxHTLCTimeout+1, 0, 0),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgHTLCResolution_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________").String()
+
+	require.NoError(t, types.NewMsgClaimHTLC(signer, 1, "736563726574").ValidateBasic())
+	require.Error(t, types.NewMsgClaimHTLC(signer, 0, "736563726574").ValidateBasic())
+	require.Error(t, types.NewMsgClaimHTLC(signer, 1, "").ValidateBasic())
+	require.Error(t, types.NewMsgClaimHTLC("invalid", 1, "736563726574").ValidateBasic())
+
+	require.NoError(t, types.NewMsgRefundHTLC(signer, 1).ValidateBasic())
+	require.Error(t, types.NewMsgRefundHTLC(signer, 0).ValidateBasic())
+
+	require.NoError(t, types.NewMsgSetChannelRoutingFee(signer, 1, 100).ValidateBasic())
+	require.Error(t, types.NewMsgSetChannelRoutingFee(signer, 1, types.MaxRoutingFeeBps+1).ValidateBasic())
+	require.Error(t, types.NewMsgSetChannelRoutingFee(signer, 0, 100).ValidateBasic())
+}
+
+func TestMsgPayoutFreeze_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+
+	require.NoError(t, types.NewMsgFreezePayout(authority, 1, "fraud review").ValidateBasic())
+	require.Error(t, types.NewMsgFreezePayout(authority, 0, "fraud review").ValidateBasic())
+	require.Error(t, types.NewMsgFreezePayout(authority, 1, "").ValidateBasic())
+	require.Error(t, types.NewMsgFreezePayout("invalid", 1, "fraud review").ValidateBasic())
+
+	require.NoError(t, types.NewMsgUnfreezePayout(authority, 1).ValidateBasic())
+	require.Error(t, types.NewMsgUnfreezePayout(authority, 0).ValidateBasic())
+	require.Error(t, types.NewMsgUnfreezePayout("invalid", 1).ValidateBasic())
+}
+
+func TestMsgNetting_ValidateBasic(t *testing.T) {
+	a := sdk.AccAddress("participant_a_______").String()
+	b := sdk.AccAddress("participant_b_______").String()
+	coin := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	require.NoError(t, types.NewMsgCreateNettingCycle(a, []string{a, b}, "ssusd", time.Hour).ValidateBasic())
+	require.Error(t, types.NewMsgCreateNettingCycle(a, []string{a}, "ssusd", time.Hour).ValidateBasic())
+	require.Error(t, types.NewMsgCreateNettingCycle(a, []string{a, a}, "ssusd", time.Hour).ValidateBasic())
+	require.Error(t, types.NewMsgCreateNettingCycle(a, []string{a, "invalid"}, "ssusd", time.Hour).ValidateBasic())
+	require.Error(t, types.NewMsgCreateNettingCycle(a, []string{a, b}, "ssusd", time.Second).ValidateBasic())
+	require.Error(t, types.NewMsgCreateNettingCycle(a, []string{a, b}, "", time.Hour).ValidateBasic())
+
+	require.NoError(t, types.NewMsgSubmitObligation(a, 1, b, coin, "INV-1").ValidateBasic())
+	require.Error(t, types.NewMsgSubmitObligation(a, 0, b, coin, "").ValidateBasic())
+	require.Error(t, types.NewMsgSubmitObligation(a, 1, a, coin, "").ValidateBasic())
+	require.Error(t, types.NewMsgSubmitObligation(a, 1, b, sdk.NewInt64Coin(types.StablecoinDenom, 0), "").ValidateBasic())
+
+	require.NoError(t, types.NewMsgSettleNettingCycle(a, 1).ValidateBasic())
+	require.Error(t, types.NewMsgSettleNettingCycle(a, 0).ValidateBasic())
+}
+
+func TestMsgSetSplitTemplate_ValidateBasic(t *testing.T) {
+	merchant := sdk.AccAddress("merchant____________").String()
+	platform := sdk.AccAddress("platform____________").String()
+	affiliate := sdk.AccAddress("affiliate___________").String()
+	fixed := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	require.NoError(t, types.NewMsgSetSplitTemplate(merchant, "", nil).ValidateBasic())
+	require.NoError(t, types.NewMsgSetSplitTemplate(merchant, "affiliate-sale", []types.SplitRule{
+		{Payee: platform, Role: "platform", Bps: 1000},
+		{Payee: affiliate, Role: "affiliate", Amount: fixed},
+	}).ValidateBasic())
+
+	for name, rules := range map[string][]types.SplitRule{
+		"merchant as payee": {{Payee: merchant, Bps: 100}},
+		"duplicate payee":   {{Payee: platform, Bps: 100}, {Payee: platform, Bps: 100}},
+		"invalid payee":     {{Payee: "invalid", Bps: 100}},
+		"no share":          {{Payee: platform}},
+		"bps and amount":    {{Payee: platform, Bps: 100, Amount: fixed}},
+		"bps above 100%":    {{Payee: platform, Bps: 6000}, {Payee: affiliate, Bps: 5000}},
+	} {
+		require.ErrorIs(t, types.NewMsgSetSplitTemplate(merchant, "", rules).ValidateBasic(), types.ErrInvalidSplit, name)
+	}
+
+	longName := strings.Repeat("x", types.MaxSplitNameLength+1)
+	require.ErrorIs(t, types.NewMsgSetSplitTemplate(merchant, longName, nil).ValidateBasic(), types.ErrInvalidSplit)
+	checkout := types.NewMsgInstantCheckout(affiliate, merchant, fixed, "ORDER-1", false, nil, "")
+	checkout.SplitName = longName
+	require.ErrorIs(t, checkout.ValidateBasic(), types.ErrInvalidSplit)
+}
+
+func TestAllocateRefund(t *testing.T) {
+	leg := func(amount, refunded int64) types.PayoutLeg {
+		return types.PayoutLeg{
+			Amount:   sdk.NewInt64Coin(types.StablecoinDenom, amount),
+			Refunded: sdk.NewInt64Coin(types.StablecoinDenom, refunded),
+		}
+	}
+	legs := []types.PayoutLeg{leg(1, 0), leg(1, 0), leg(1, 0)}
+
+	// Rounding dust is taken from the legs in order
+	shares, err := types.AllocateRefund(legs, sdk.NewInt64Coin(types.StablecoinDenom, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1, 0}, []int64{shares[0].Int64(), shares[1].Int64(), shares[2].Int64()})
+
+	_, err = types.AllocateRefund([]types.PayoutLeg{leg(10, 5)}, sdk.NewInt64Coin(types.StablecoinDenom, 6))
+	require.ErrorIs(t, err, types.ErrRefundTooLarge)
+}
+
+func TestMsgCreateMandate_ValidateBasic(t *testing.T) {
+	customer := sdk.AccAddress("customer____________").String()
+	merchant := sdk.AccAddress("merchant____________").String()
+	perCharge := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+	periodCap := sdk.NewInt64Coin(types.StablecoinDenom, 500)
+
+	require.NoError(t, types.NewMsgCreateMandate(customer, merchant, perCharge, periodCap, time.Hour, 0, "usage").ValidateBasic())
+	require.NoError(t, types.NewMsgCreateMandate(customer, merchant, periodCap, periodCap, time.Hour, 720*time.Hour, "").ValidateBasic())
+
+	require.ErrorIs(t, types.NewMsgCreateMandate(customer, merchant, periodCap, perCharge, time.Hour, 0, "").ValidateBasic(), types.ErrInvalidMandate)
+	require.ErrorIs(t, types.NewMsgCreateMandate(customer, merchant, perCharge, periodCap, time.Second, 0, "").ValidateBasic(), types.ErrInvalidMandate)
+	require.ErrorIs(t, types.NewMsgCreateMandate(customer, merchant, perCharge, periodCap, time.Hour, -time.Hour, "").ValidateBasic(), types.ErrInvalidMandate)
+	require.ErrorIs(t, types.NewMsgCreateMandate(customer, merchant, sdk.NewInt64Coin("uatom", 100), periodCap, time.Hour, 0, "").ValidateBasic(), types.ErrInvalidDenom)
+	require.ErrorIs(t, types.NewMsgCreateMandate(customer, merchant, sdk.NewInt64Coin(types.StablecoinDenom, 0), periodCap, time.Hour, 0, "").ValidateBasic(), types.ErrInvalidAmount)
+	require.Error(t, types.NewMsgCreateMandate(customer, customer, perCharge, periodCap, time.Hour, 0, "").ValidateBasic())
+	require.Error(t, types.NewMsgCreateMandate("invalid", merchant, perCharge, periodCap, time.Hour, 0, "").ValidateBasic())
+}
+
+func TestMsgPullPayment_ValidateBasic(t *testing.T) {
+	merchant := sdk.AccAddress("merchant____________").String()
+	amount := sdk.NewInt64Coin(types.StablecoinDenom, 100)
+
+	require.NoError(t, types.NewMsgPullPayment(merchant, 1, amount, "INV-1", "").ValidateBasic())
+	require.Error(t, types.NewMsgPullPayment(merchant, 0, amount, "", "").ValidateBasic())
+	require.Error(t, types.NewMsgPullPayment(merchant, 1, sdk.NewInt64Coin(types.StablecoinDenom, 0), "", "").ValidateBasic())
+	require.Error(t, types.NewMsgPullPayment("invalid", 1, amount, "", "").ValidateBasic())
+
+	require.NoError(t, types.NewMsgRevokeMandate(merchant, 1, "").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeMandate(merchant, 0, "").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeMandate("invalid", 1, "").ValidateBasic())
+}
+
+func TestMsgCreateStream_ValidateBasic(t *testing.T) {
+	payer := sdk.AccAddress("payer_______________").String()
+	recipient := sdk.AccAddress("recipient___________").String()
+	deposit := sdk.NewInt64Coin(types.StablecoinDenom, 1000)
+
+	require.NoError(t, types.NewMsgCreateStream(payer, recipient, deposit, sdkmath.NewInt(10), "contract-7").ValidateBasic())
+
+	require.ErrorIs(t, types.NewMsgCreateStream(payer, recipient, deposit, sdkmath.ZeroInt(), "").ValidateBasic(), types.ErrInvalidStream)
+	require.ErrorIs(t, types.NewMsgCreateStream(payer, recipient, deposit, sdkmath.Int{}, "").ValidateBasic(), types.ErrInvalidStream)
+	require.ErrorIs(t, types.NewMsgCreateStream(payer, recipient, sdk.NewInt64Coin("uatom", 1000), sdkmath.NewInt(10), "").ValidateBasic(), types.ErrInvalidDenom)
+	require.ErrorIs(t, types.NewMsgCreateStream(payer, recipient, sdk.NewInt64Coin(types.StablecoinDenom, 0), sdkmath.NewInt(10), "").ValidateBasic(), types.ErrInvalidAmount)
+	require.Error(t, types.NewMsgCreateStream(payer, payer, deposit, sdkmath.NewInt(10), "").ValidateBasic())
+	require.Error(t, types.NewMsgCreateStream("invalid", recipient, deposit, sdkmath.NewInt(10), "").ValidateBasic())
+
+	require.NoError(t, types.NewMsgWithdrawStream(recipient, 1).ValidateBasic())
+	require.Error(t, types.NewMsgWithdrawStream(recipient, 0).ValidateBasic())
+	require.NoError(t, types.NewMsgCancelStream(payer, 1).ValidateBasic())
+	require.Error(t, types.NewMsgCancelStream("invalid", 1).ValidateBasic())
+	require.NoError(t, types.NewMsgTopUpStream(payer, 1, deposit).ValidateBasic())
+	require.Error(t, types.NewMsgTopUpStream(payer, 1, sdk.NewInt64Coin(types.StablecoinDenom, 0)).ValidateBasic())
+}
+
 func TestMsgInstantTransfer_GetSigners(t *testing.T) {
 	sender := sdk.AccAddress("sender______________")
 	msg := types.MsgInstantTransfer{
@@ -0,0 +1,135 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsParticipant reports whether the address takes part in the netting cycle
+func (c NettingCycle) IsParticipant(address string) bool {
+	for _, participant := range c.Participants {
+		if participant == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeNetting nets the obligations of a cycle. It returns the position of
+// each participant, in the cycle's participant order, and the transfers that
+// settle the multilateral net positions. Net payers are matched to net
+// receivers in participant order, so the transfers are deterministic and there
+// are fewer of them than participants.
+func ComputeNetting(cycle NettingCycle, obligations []NettingObligation) ([]NettingPosition, []NetTransfer) {
+	index := make(map[string]int, len(cycle.Participants))
+	for i, participant := range cycle.Participants {
+		index[participant] = i
+	}
+
+	n := len(cycle.Participants)
+	payable := make([]sdkmath.Int, n)
+	receivable := make([]sdkmath.Int, n)
+	owed := make([][]sdkmath.Int, n)
+	for i := range owed {
+		payable[i] = sdkmath.ZeroInt()
+		receivable[i] = sdkmath.ZeroInt()
+		owed[i] = make([]sdkmath.Int, n)
+		for j := range owed[i] {
+			owed[i][j] = sdkmath.ZeroInt()
+		}
+	}
+
+	for _, o := range obligations {
+		debtor, ok := index[o.Debtor]
+		if !ok {
+			continue
+		}
+		creditor, ok := index[o.Creditor]
+		if !ok {
+			continue
+		}
+		payable[debtor] = payable[debtor].Add(o.Amount.Amount)
+		receivable[creditor] = receivable[creditor].Add(o.Amount.Amount)
+		owed[debtor][creditor] = owed[debtor][creditor].Add(o.Amount.Amount)
+	}
+
+	bilateralPayable := make([]sdkmath.Int, n)
+	bilateralReceivable := make([]sdkmath.Int, n)
+	for i := range bilateralPayable {
+		bilateralPayable[i] = sdkmath.ZeroInt()
+		bilateralReceivable[i] = sdkmath.ZeroInt()
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			diff := owed[i][j].Sub(owed[j][i])
+			switch {
+			case diff.IsPositive():
+				bilateralPayable[i] = bilateralPayable[i].Add(diff)
+				bilateralReceivable[j] = bilateralReceivable[j].Add(diff)
+			case diff.IsNegative():
+				bilateralPayable[j] = bilateralPayable[j].Sub(diff)
+				bilateralReceivable[i] = bilateralReceivable[i].Sub(diff)
+			}
+		}
+	}
+
+	coin := func(amount sdkmath.Int) sdk.Coin { return sdk.NewCoin(cycle.Denom, amount) }
+	positions := make([]NettingPosition, n)
+	net := make([]sdkmath.Int, n)
+	for i, participant := range cycle.Participants {
+		net[i] = receivable[i].Sub(payable[i])
+		position := NettingPosition{
+			Participant:            participant,
+			GrossPayable:           coin(payable[i]),
+			GrossReceivable:        coin(receivable[i]),
+			BilateralNetPayable:    coin(bilateralPayable[i]),
+			BilateralNetReceivable: coin(bilateralReceivable[i]),
+			NetPayable:             coin(sdkmath.ZeroInt()),
+			NetReceivable:          coin(sdkmath.ZeroInt()),
+		}
+		if net[i].IsNegative() {
+			position.NetPayable = coin(net[i].Neg())
+		} else {
+			position.NetReceivable = coin(net[i])
+		}
+		positions[i] = position
+	}
+
+	var transfers []NetTransfer
+	payer, receiver := 0, 0
+	for {
+		for payer < n && !net[payer].IsNegative() {
+			payer++
+		}
+		for receiver < n && !net[receiver].IsPositive() {
+			receiver++
+		}
+		if payer == n || receiver == n {
+			break
+		}
+		amount := sdkmath.MinInt(net[payer].Neg(), net[receiver])
+		transfers = append(transfers, NetTransfer{
+			From:   cycle.Participants[payer],
+			To:     cycle.Participants[receiver],
+			Amount: coin(amount),
+		})
+		net[payer] = net[payer].Add(amount)
+		net[receiver] = net[receiver].Sub(amount)
+	}
+
+	return positions, transfers
+}
+
+// NettingTotals sums the gross obligations of a cycle and what is left to
+// transfer after bilateral and multilateral netting
+func NettingTotals(denom string, positions []NettingPosition) (gross, bilateralNet, net sdk.Coin) {
+	gross = sdk.NewCoin(denom, sdkmath.ZeroInt())
+	bilateralNet = sdk.NewCoin(denom, sdkmath.ZeroInt())
+	net = sdk.NewCoin(denom, sdkmath.ZeroInt())
+	for _, p := range positions {
+		gross = gross.Add(p.GrossPayable)
+		bilateralNet = bilateralNet.Add(p.BilateralNetPayable)
+		net = net.Add(p.NetPayable)
+	}
+	return gross, bilateralNet, net
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -48,6 +50,13 @@ type Settlement struct {
 	SettledTime   time.Time                               `protobuf:"bytes,14,opt,name=settled_time,json=settledTime,proto3,stdtime" json:"settled_time"`
 	ExpiresAt     time.Time                               `protobuf:"bytes,15,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
 	BatchId       uint64                                  `protobuf:"varint,16,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// fx_conversion is set when the payer paid in a denom other than the
+	// recipient's settlement denom
+	FxConversion *FXConversion `protobuf:"bytes,17,opt,name=fx_conversion,json=fxConversion,proto3" json:"fx_conversion,omitempty"`
+	// legs are the payees a split checkout pays, the merchant's remainder last
+	Legs []PayoutLeg `protobuf:"bytes,18,rep,name=legs,proto3" json:"legs"`
+	// refunds are the partial refunds issued after the settlement completed
+	Refunds []SettlementRefund `protobuf:"bytes,19,rep,name=refunds,proto3" json:"refunds"`
 }
 
 func (m *Settlement) Reset()         { *m = Settlement{} }
@@ -174,34 +183,50 @@ func (m *Settlement) GetBatchId() uint64 {
 	return 0
 }
 
-// BatchSettlement represents a batch of settlements processed together.
-type BatchSettlement struct {
-	Id            uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Merchant      string                                  `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
-	SettlementIds []uint64                                `protobuf:"varint,3,rep,packed,name=settlement_ids,json=settlementIds,proto3" json:"settlement_ids,omitempty"`
-	TotalAmount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_amount"`
-	TotalFees     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_fees"`
-	NetAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_amount"`
-	Count         uint64                                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
-	Status        SettlementStatus                        `protobuf:"bytes,8,opt,name=status,proto3,casttype=SettlementStatus" json:"status,omitempty"`
-	CreatedHeight int64                                   `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
-	CreatedTime   time.Time                               `protobuf:"bytes,10,opt,name=created_time,json=createdTime,proto3,stdtime" json:"created_time"`
-	SettledHeight int64                                   `protobuf:"varint,11,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
-	SettledTime   time.Time                               `protobuf:"bytes,12,opt,name=settled_time,json=settledTime,proto3,stdtime" json:"settled_time"`
+func (m *Settlement) GetFxConversion() *FXConversion {
+	if m != nil {
+		return m.FxConversion
+	}
+	return nil
 }
 
-func (m *BatchSettlement) Reset()         { *m = BatchSettlement{} }
-func (m *BatchSettlement) String() string { return proto.CompactTextString(m) }
-func (*BatchSettlement) ProtoMessage()    {}
-func (*BatchSettlement) Descriptor() ([]byte, []int) {
+func (m *Settlement) GetLegs() []PayoutLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *Settlement) GetRefunds() []SettlementRefund {
+	if m != nil {
+		return m.Refunds
+	}
+	return nil
+}
+
+// SettlementRefund records a refund of a completed settlement to its sender.
+type SettlementRefund struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// recipient_amount is the part of the refund taken from the recipient; the
+	// rest was taken from the other payees of a split settlement
+	RecipientAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=recipient_amount,json=recipientAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"recipient_amount"`
+	Reason          string                                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height          int64                                   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time            time.Time                               `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SettlementRefund) Reset()         { *m = SettlementRefund{} }
+func (m *SettlementRefund) String() string { return proto.CompactTextString(m) }
+func (*SettlementRefund) ProtoMessage()    {}
+func (*SettlementRefund) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d6475673dc68b2fe, []int{1}
 }
-func (m *BatchSettlement) XXX_Unmarshal(b []byte) error {
+func (m *SettlementRefund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SettlementRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchSettlement.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SettlementRefund.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -211,110 +236,250 @@ func (m *BatchSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *BatchSettlement) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchSettlement.Merge(m, src)
+func (m *SettlementRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementRefund.Merge(m, src)
 }
-func (m *BatchSettlement) XXX_Size() int {
+func (m *SettlementRefund) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchSettlement) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchSettlement.DiscardUnknown(m)
+func (m *SettlementRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementRefund.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchSettlement proto.InternalMessageInfo
+var xxx_messageInfo_SettlementRefund proto.InternalMessageInfo
 
-func (m *BatchSettlement) GetId() uint64 {
+func (m *SettlementRefund) GetReason() string {
 	if m != nil {
-		return m.Id
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SettlementRefund) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
 	return 0
 }
 
-func (m *BatchSettlement) GetMerchant() string {
+func (m *SettlementRefund) GetTime() time.Time {
 	if m != nil {
-		return m.Merchant
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// SplitRule directs a share of a checkout's net amount to a payee other than
+// the merchant. A rule sets either basis points of the net amount or a fixed
+// amount in the merchant's settlement denom.
+type SplitRule struct {
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// role describes the payee, e.g. platform, affiliate or tax
+	Role   string                                  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Bps    uint32                                  `protobuf:"varint,3,opt,name=bps,proto3" json:"bps,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *SplitRule) Reset()         { *m = SplitRule{} }
+func (m *SplitRule) String() string { return proto.CompactTextString(m) }
+func (*SplitRule) ProtoMessage()    {}
+func (*SplitRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{2}
+}
+func (m *SplitRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitRule.Merge(m, src)
+}
+func (m *SplitRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitRule proto.InternalMessageInfo
+
+func (m *SplitRule) GetPayee() string {
+	if m != nil {
+		return m.Payee
 	}
 	return ""
 }
 
-func (m *BatchSettlement) GetSettlementIds() []uint64 {
+func (m *SplitRule) GetRole() string {
 	if m != nil {
-		return m.SettlementIds
+		return m.Role
 	}
-	return nil
+	return ""
 }
 
-func (m *BatchSettlement) GetCount() uint64 {
+func (m *SplitRule) GetBps() uint32 {
 	if m != nil {
-		return m.Count
+		return m.Bps
 	}
 	return 0
 }
 
-func (m *BatchSettlement) GetStatus() SettlementStatus {
+// SplitTemplate is a split a merchant registered for its checkouts. The
+// template without a name applies to checkouts that select none.
+type SplitTemplate struct {
+	Merchant string      `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Rules    []SplitRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+	Name     string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *SplitTemplate) Reset()         { *m = SplitTemplate{} }
+func (m *SplitTemplate) String() string { return proto.CompactTextString(m) }
+func (*SplitTemplate) ProtoMessage()    {}
+func (*SplitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{3}
+}
+func (m *SplitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitTemplate.Merge(m, src)
+}
+func (m *SplitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitTemplate proto.InternalMessageInfo
+
+func (m *SplitTemplate) GetMerchant() string {
 	if m != nil {
-		return m.Status
+		return m.Merchant
 	}
 	return ""
 }
 
-func (m *BatchSettlement) GetCreatedHeight() int64 {
+func (m *SplitTemplate) GetRules() []SplitRule {
 	if m != nil {
-		return m.CreatedHeight
+		return m.Rules
 	}
-	return 0
+	return nil
 }
 
-func (m *BatchSettlement) GetCreatedTime() time.Time {
+func (m *SplitTemplate) GetName() string {
 	if m != nil {
-		return m.CreatedTime
+		return m.Name
 	}
-	return time.Time{}
+	return ""
 }
 
-func (m *BatchSettlement) GetSettledHeight() int64 {
+// PayoutLeg is what one payee of a split settlement is paid, and how much of
+// it has been refunded.
+type PayoutLeg struct {
+	Payee    string                                  `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	Role     string                                  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Refunded github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=refunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"refunded"`
+}
+
+func (m *PayoutLeg) Reset()         { *m = PayoutLeg{} }
+func (m *PayoutLeg) String() string { return proto.CompactTextString(m) }
+func (*PayoutLeg) ProtoMessage()    {}
+func (*PayoutLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{4}
+}
+func (m *PayoutLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutLeg.Merge(m, src)
+}
+func (m *PayoutLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutLeg proto.InternalMessageInfo
+
+func (m *PayoutLeg) GetPayee() string {
 	if m != nil {
-		return m.SettledHeight
+		return m.Payee
 	}
-	return 0
+	return ""
 }
 
-func (m *BatchSettlement) GetSettledTime() time.Time {
+func (m *PayoutLeg) GetRole() string {
 	if m != nil {
-		return m.SettledTime
+		return m.Role
 	}
-	return time.Time{}
+	return ""
 }
 
-// PaymentChannel represents a payment channel for streaming payments.
-type PaymentChannel struct {
-	Id              uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender          string                                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient       string                                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Deposit         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
-	Spent           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=spent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"spent"`
-	Balance         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
-	IsOpen          bool                                    `protobuf:"varint,7,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	OpenedHeight    int64                                   `protobuf:"varint,8,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
-	OpenedTime      time.Time                               `protobuf:"bytes,9,opt,name=opened_time,json=openedTime,proto3,stdtime" json:"opened_time"`
-	ClosedHeight    int64                                   `protobuf:"varint,10,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
-	ClosedTime      time.Time                               `protobuf:"bytes,11,opt,name=closed_time,json=closedTime,proto3,stdtime" json:"closed_time"`
-	ExpiresAtHeight int64                                   `protobuf:"varint,12,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
-	Nonce           uint64                                  `protobuf:"varint,13,opt,name=nonce,proto3" json:"nonce,omitempty"`
+// StatementEntry is one booked movement on an account's settlement statement.
+type StatementEntry struct {
+	SettlementId   uint64         `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	SettlementType SettlementType `protobuf:"bytes,2,opt,name=settlement_type,json=settlementType,proto3,casttype=SettlementType" json:"settlement_type,omitempty"`
+	// entry_type is payment, fee, split or refund
+	EntryType string `protobuf:"bytes,3,opt,name=entry_type,json=entryType,proto3" json:"entry_type,omitempty"`
+	// direction is credit or debit from the account's point of view
+	Direction    string                                  `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Counterparty string                                  `protobuf:"bytes,6,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Reference    string                                  `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	Height       int64                                   `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Time         time.Time                               `protobuf:"bytes,9,opt,name=time,proto3,stdtime" json:"time"`
+	BatchId      uint64                                  `protobuf:"varint,10,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 }
 
-func (m *PaymentChannel) Reset()         { *m = PaymentChannel{} }
-func (m *PaymentChannel) String() string { return proto.CompactTextString(m) }
-func (*PaymentChannel) ProtoMessage()    {}
-func (*PaymentChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{2}
+func (m *StatementEntry) Reset()         { *m = StatementEntry{} }
+func (m *StatementEntry) String() string { return proto.CompactTextString(m) }
+func (*StatementEntry) ProtoMessage()    {}
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{5}
 }
-func (m *PaymentChannel) XXX_Unmarshal(b []byte) error {
+func (m *StatementEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PaymentChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StatementEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PaymentChannel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StatementEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -324,115 +489,113 @@ func (m *PaymentChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PaymentChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentChannel.Merge(m, src)
+func (m *StatementEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementEntry.Merge(m, src)
 }
-func (m *PaymentChannel) XXX_Size() int {
+func (m *StatementEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *PaymentChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentChannel.DiscardUnknown(m)
+func (m *StatementEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentChannel proto.InternalMessageInfo
+var xxx_messageInfo_StatementEntry proto.InternalMessageInfo
 
-func (m *PaymentChannel) GetId() uint64 {
+func (m *StatementEntry) GetSettlementId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.SettlementId
 	}
 	return 0
 }
 
-func (m *PaymentChannel) GetSender() string {
+func (m *StatementEntry) GetSettlementType() SettlementType {
 	if m != nil {
-		return m.Sender
+		return m.SettlementType
 	}
 	return ""
 }
 
-func (m *PaymentChannel) GetRecipient() string {
+func (m *StatementEntry) GetEntryType() string {
 	if m != nil {
-		return m.Recipient
+		return m.EntryType
 	}
 	return ""
 }
 
-func (m *PaymentChannel) GetIsOpen() bool {
+func (m *StatementEntry) GetDirection() string {
 	if m != nil {
-		return m.IsOpen
+		return m.Direction
 	}
-	return false
+	return ""
 }
 
-func (m *PaymentChannel) GetOpenedHeight() int64 {
+func (m *StatementEntry) GetCounterparty() string {
 	if m != nil {
-		return m.OpenedHeight
+		return m.Counterparty
 	}
-	return 0
+	return ""
 }
 
-func (m *PaymentChannel) GetOpenedTime() time.Time {
+func (m *StatementEntry) GetReference() string {
 	if m != nil {
-		return m.OpenedTime
+		return m.Reference
 	}
-	return time.Time{}
+	return ""
 }
 
-func (m *PaymentChannel) GetClosedHeight() int64 {
+func (m *StatementEntry) GetHeight() int64 {
 	if m != nil {
-		return m.ClosedHeight
+		return m.Height
 	}
 	return 0
 }
 
-func (m *PaymentChannel) GetClosedTime() time.Time {
+func (m *StatementEntry) GetTime() time.Time {
 	if m != nil {
-		return m.ClosedTime
+		return m.Time
 	}
 	return time.Time{}
 }
 
-func (m *PaymentChannel) GetExpiresAtHeight() int64 {
-	if m != nil {
-		return m.ExpiresAtHeight
-	}
-	return 0
-}
-
-func (m *PaymentChannel) GetNonce() uint64 {
+func (m *StatementEntry) GetBatchId() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.BatchId
 	}
 	return 0
 }
 
-// MerchantConfig represents merchant-specific configuration.
-type MerchantConfig struct {
-	Address         string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name            string                                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	FeeRateBps      uint32                                  `protobuf:"varint,3,opt,name=fee_rate_bps,json=feeRateBps,proto3" json:"fee_rate_bps,omitempty"`
-	MinSettlement   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=min_settlement,json=minSettlement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_settlement"`
-	MaxSettlement   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=max_settlement,json=maxSettlement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_settlement"`
-	BatchEnabled    bool                                    `protobuf:"varint,6,opt,name=batch_enabled,json=batchEnabled,proto3" json:"batch_enabled,omitempty"`
-	BatchThreshold  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=batch_threshold,json=batchThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"batch_threshold"`
-	SettlementDelay time.Duration                           `protobuf:"bytes,8,opt,name=settlement_delay,json=settlementDelay,proto3,stdduration" json:"settlement_delay"`
-	IsActive        bool                                    `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	WebhookUrl      string                                  `protobuf:"bytes,10,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
-	RegisteredAt    time.Time                               `protobuf:"bytes,11,opt,name=registered_at,json=registeredAt,proto3,stdtime" json:"registered_at"`
+// Statement lists the settlement movements of an account in one denom over a
+// height and time range, with the balance of those movements before and after
+// the range.
+type Statement struct {
+	Account         string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom           string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	FromHeight      int64                 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight        int64                 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	FromTime        time.Time             `protobuf:"bytes,5,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time"`
+	ToTime          time.Time             `protobuf:"bytes,6,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time"`
+	OpeningBalance  cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=opening_balance,json=openingBalance,proto3,customtype=cosmossdk.io/math.Int" json:"opening_balance"`
+	ClosingBalance  cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=closing_balance,json=closingBalance,proto3,customtype=cosmossdk.io/math.Int" json:"closing_balance"`
+	TotalCredits    cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=total_credits,json=totalCredits,proto3,customtype=cosmossdk.io/math.Int" json:"total_credits"`
+	TotalDebits     cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=total_debits,json=totalDebits,proto3,customtype=cosmossdk.io/math.Int" json:"total_debits"`
+	TotalFees       cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=total_fees,json=totalFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees"`
+	Entries         []StatementEntry      `protobuf:"bytes,12,rep,name=entries,proto3" json:"entries"`
+	GeneratedHeight int64                 `protobuf:"varint,13,opt,name=generated_height,json=generatedHeight,proto3" json:"generated_height,omitempty"`
+	GeneratedTime   time.Time             `protobuf:"bytes,14,opt,name=generated_time,json=generatedTime,proto3,stdtime" json:"generated_time"`
 }
 
-func (m *MerchantConfig) Reset()         { *m = MerchantConfig{} }
-func (m *MerchantConfig) String() string { return proto.CompactTextString(m) }
-func (*MerchantConfig) ProtoMessage()    {}
-func (*MerchantConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{3}
+func (m *Statement) Reset()         { *m = Statement{} }
+func (m *Statement) String() string { return proto.CompactTextString(m) }
+func (*Statement) ProtoMessage()    {}
+func (*Statement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{6}
 }
-func (m *MerchantConfig) XXX_Unmarshal(b []byte) error {
+func (m *Statement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MerchantConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Statement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MerchantConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Statement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -442,94 +605,107 @@ func (m *MerchantConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MerchantConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantConfig.Merge(m, src)
+func (m *Statement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Statement.Merge(m, src)
 }
-func (m *MerchantConfig) XXX_Size() int {
+func (m *Statement) XXX_Size() int {
 	return m.Size()
 }
-func (m *MerchantConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantConfig.DiscardUnknown(m)
+func (m *Statement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Statement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MerchantConfig proto.InternalMessageInfo
+var xxx_messageInfo_Statement proto.InternalMessageInfo
 
-func (m *MerchantConfig) GetAddress() string {
+func (m *Statement) GetAccount() string {
 	if m != nil {
-		return m.Address
+		return m.Account
 	}
 	return ""
 }
 
-func (m *MerchantConfig) GetName() string {
+func (m *Statement) GetDenom() string {
 	if m != nil {
-		return m.Name
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *MerchantConfig) GetFeeRateBps() uint32 {
+func (m *Statement) GetFromHeight() int64 {
 	if m != nil {
-		return m.FeeRateBps
+		return m.FromHeight
 	}
 	return 0
 }
 
-func (m *MerchantConfig) GetBatchEnabled() bool {
+func (m *Statement) GetToHeight() int64 {
 	if m != nil {
-		return m.BatchEnabled
+		return m.ToHeight
 	}
-	return false
+	return 0
 }
 
-func (m *MerchantConfig) GetSettlementDelay() time.Duration {
+func (m *Statement) GetFromTime() time.Time {
 	if m != nil {
-		return m.SettlementDelay
+		return m.FromTime
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *MerchantConfig) GetIsActive() bool {
+func (m *Statement) GetToTime() time.Time {
 	if m != nil {
-		return m.IsActive
+		return m.ToTime
 	}
-	return false
+	return time.Time{}
 }
 
-func (m *MerchantConfig) GetWebhookUrl() string {
+func (m *Statement) GetEntries() []StatementEntry {
 	if m != nil {
-		return m.WebhookUrl
+		return m.Entries
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantConfig) GetRegisteredAt() time.Time {
+func (m *Statement) GetGeneratedHeight() int64 {
 	if m != nil {
-		return m.RegisteredAt
+		return m.GeneratedHeight
+	}
+	return 0
+}
+
+func (m *Statement) GetGeneratedTime() time.Time {
+	if m != nil {
+		return m.GeneratedTime
 	}
 	return time.Time{}
 }
 
-// CheckoutItem represents an item in a checkout.
-type CheckoutItem struct {
-	ProductId   string                                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity    uint64                                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"unit_price"`
-	Description string                                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+// FXConversion records how a payment was converted into the recipient's
+// settlement denom before it was settled.
+type FXConversion struct {
+	// payment_amount is the amount the payer paid, in the payment denom
+	PaymentAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=payment_amount,json=paymentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"payment_amount"`
+	// oracle_rate is the settlement denom paid per unit of the payment denom at
+	// oracle prices
+	OracleRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=oracle_rate,json=oracleRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"oracle_rate"`
+	// fx_rate is the rate the payment was converted at, after swap fees
+	FxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fx_rate,json=fxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fx_rate"`
+	// route is how the payment was converted
+	Route string `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
 }
 
-func (m *CheckoutItem) Reset()         { *m = CheckoutItem{} }
-func (m *CheckoutItem) String() string { return proto.CompactTextString(m) }
-func (*CheckoutItem) ProtoMessage()    {}
-func (*CheckoutItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{4}
+func (m *FXConversion) Reset()         { *m = FXConversion{} }
+func (m *FXConversion) String() string { return proto.CompactTextString(m) }
+func (*FXConversion) ProtoMessage()    {}
+func (*FXConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{7}
 }
-func (m *CheckoutItem) XXX_Unmarshal(b []byte) error {
+func (m *FXConversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckoutItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FXConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckoutItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FXConversion.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -539,64 +715,62 @@ func (m *CheckoutItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CheckoutItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckoutItem.Merge(m, src)
+func (m *FXConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FXConversion.Merge(m, src)
 }
-func (m *CheckoutItem) XXX_Size() int {
+func (m *FXConversion) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckoutItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckoutItem.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CheckoutItem proto.InternalMessageInfo
-
-func (m *CheckoutItem) GetProductId() string {
-	if m != nil {
-		return m.ProductId
-	}
-	return ""
+func (m *FXConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FXConversion.DiscardUnknown(m)
 }
 
-func (m *CheckoutItem) GetQuantity() uint64 {
-	if m != nil {
-		return m.Quantity
-	}
-	return 0
-}
+var xxx_messageInfo_FXConversion proto.InternalMessageInfo
 
-func (m *CheckoutItem) GetDescription() string {
+func (m *FXConversion) GetRoute() string {
 	if m != nil {
-		return m.Description
+		return m.Route
 	}
 	return ""
 }
 
-// TransferReceipt represents the receipt of a transfer.
-type TransferReceipt struct {
-	SettlementId uint64                                  `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
-	TxHash       string                                  `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	BlockHeight  int64                                   `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Timestamp    time.Time                               `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
-	Sender       string                                  `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient    string                                  `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Amount       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
-	Fee          github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee"`
-	Reference    string                                  `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
+// BatchSettlement represents a batch of settlements processed together.
+type BatchSettlement struct {
+	Id            uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Merchant      string                                  `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	SettlementIds []uint64                                `protobuf:"varint,3,rep,packed,name=settlement_ids,json=settlementIds,proto3" json:"settlement_ids,omitempty"`
+	TotalAmount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_amount"`
+	TotalFees     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_fees"`
+	NetAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_amount"`
+	Count         uint64                                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	Status        SettlementStatus                        `protobuf:"bytes,8,opt,name=status,proto3,casttype=SettlementStatus" json:"status,omitempty"`
+	CreatedHeight int64                                   `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	CreatedTime   time.Time                               `protobuf:"bytes,10,opt,name=created_time,json=createdTime,proto3,stdtime" json:"created_time"`
+	SettledHeight int64                                   `protobuf:"varint,11,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	SettledTime   time.Time                               `protobuf:"bytes,12,opt,name=settled_time,json=settledTime,proto3,stdtime" json:"settled_time"`
+	// auto_settle marks a batch that collects a merchant's incoming payments
+	// and is settled by EndBlock rather than by the authority.
+	AutoSettle bool `protobuf:"varint,13,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
+	// settle_by is when EndBlock settles an auto-settled batch that has not
+	// reached its merchant's threshold.
+	SettleBy time.Time `protobuf:"bytes,14,opt,name=settle_by,json=settleBy,proto3,stdtime" json:"settle_by"`
+	// release_at is set when an auto-settled batch stops collecting payments:
+	// EndBlock pays it out once the merchant's settlement delay has passed.
+	ReleaseAt time.Time `protobuf:"bytes,15,opt,name=release_at,json=releaseAt,proto3,stdtime" json:"release_at"`
 }
 
-func (m *TransferReceipt) Reset()         { *m = TransferReceipt{} }
-func (m *TransferReceipt) String() string { return proto.CompactTextString(m) }
-func (*TransferReceipt) ProtoMessage()    {}
-func (*TransferReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{5}
+func (m *BatchSettlement) Reset()         { *m = BatchSettlement{} }
+func (m *BatchSettlement) String() string { return proto.CompactTextString(m) }
+func (*BatchSettlement) ProtoMessage()    {}
+func (*BatchSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{8}
 }
-func (m *TransferReceipt) XXX_Unmarshal(b []byte) error {
+func (m *BatchSettlement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransferReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransferReceipt.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchSettlement.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -606,96 +780,134 @@ func (m *TransferReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *TransferReceipt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransferReceipt.Merge(m, src)
+func (m *BatchSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSettlement.Merge(m, src)
 }
-func (m *TransferReceipt) XXX_Size() int {
+func (m *BatchSettlement) XXX_Size() int {
 	return m.Size()
 }
-func (m *TransferReceipt) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransferReceipt.DiscardUnknown(m)
+func (m *BatchSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSettlement.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransferReceipt proto.InternalMessageInfo
+var xxx_messageInfo_BatchSettlement proto.InternalMessageInfo
 
-func (m *TransferReceipt) GetSettlementId() uint64 {
+func (m *BatchSettlement) GetId() uint64 {
 	if m != nil {
-		return m.SettlementId
+		return m.Id
 	}
 	return 0
 }
 
-func (m *TransferReceipt) GetTxHash() string {
+func (m *BatchSettlement) GetMerchant() string {
 	if m != nil {
-		return m.TxHash
+		return m.Merchant
 	}
 	return ""
 }
 
-func (m *TransferReceipt) GetBlockHeight() int64 {
+func (m *BatchSettlement) GetSettlementIds() []uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.SettlementIds
+	}
+	return nil
+}
+
+func (m *BatchSettlement) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
 	return 0
 }
 
-func (m *TransferReceipt) GetTimestamp() time.Time {
+func (m *BatchSettlement) GetStatus() SettlementStatus {
 	if m != nil {
-		return m.Timestamp
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BatchSettlement) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *BatchSettlement) GetCreatedTime() time.Time {
+	if m != nil {
+		return m.CreatedTime
 	}
 	return time.Time{}
 }
 
-func (m *TransferReceipt) GetSender() string {
+func (m *BatchSettlement) GetSettledHeight() int64 {
 	if m != nil {
-		return m.Sender
+		return m.SettledHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *TransferReceipt) GetRecipient() string {
+func (m *BatchSettlement) GetSettledTime() time.Time {
 	if m != nil {
-		return m.Recipient
+		return m.SettledTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *TransferReceipt) GetReference() string {
+func (m *BatchSettlement) GetAutoSettle() bool {
 	if m != nil {
-		return m.Reference
+		return m.AutoSettle
 	}
-	return ""
+	return false
 }
 
-// Params defines the parameters for the settlement module.
-type Params struct {
-	DefaultFeeRateBps       uint32                                  `protobuf:"varint,1,opt,name=default_fee_rate_bps,json=defaultFeeRateBps,proto3" json:"default_fee_rate_bps,omitempty"`
-	FeeCollector            string                                  `protobuf:"bytes,2,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
-	MinSettlementAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=min_settlement_amount,json=minSettlementAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_settlement_amount"`
-	MaxSettlementAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=max_settlement_amount,json=maxSettlementAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_settlement_amount"`
-	DefaultEscrowExpiration int64                                   `protobuf:"varint,5,opt,name=default_escrow_expiration,json=defaultEscrowExpiration,proto3" json:"default_escrow_expiration,omitempty"`
-	MaxEscrowExpiration     int64                                   `protobuf:"varint,6,opt,name=max_escrow_expiration,json=maxEscrowExpiration,proto3" json:"max_escrow_expiration,omitempty"`
-	MinChannelExpiration    int64                                   `protobuf:"varint,7,opt,name=min_channel_expiration,json=minChannelExpiration,proto3" json:"min_channel_expiration,omitempty"`
-	MaxChannelExpiration    int64                                   `protobuf:"varint,8,opt,name=max_channel_expiration,json=maxChannelExpiration,proto3" json:"max_channel_expiration,omitempty"`
-	MaxBatchSize            uint32                                  `protobuf:"varint,9,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
-	MaxQueryLimit           uint32                                  `protobuf:"varint,10,opt,name=max_query_limit,json=maxQueryLimit,proto3" json:"max_query_limit,omitempty"`
-	InstantTransfersEnabled bool                                    `protobuf:"varint,11,opt,name=instant_transfers_enabled,json=instantTransfersEnabled,proto3" json:"instant_transfers_enabled,omitempty"`
-	EscrowEnabled           bool                                    `protobuf:"varint,12,opt,name=escrow_enabled,json=escrowEnabled,proto3" json:"escrow_enabled,omitempty"`
-	ChannelsEnabled         bool                                    `protobuf:"varint,13,opt,name=channels_enabled,json=channelsEnabled,proto3" json:"channels_enabled,omitempty"`
+func (m *BatchSettlement) GetSettleBy() time.Time {
+	if m != nil {
+		return m.SettleBy
+	}
+	return time.Time{}
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{6}
+func (m *BatchSettlement) GetReleaseAt() time.Time {
+	if m != nil {
+		return m.ReleaseAt
+	}
+	return time.Time{}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+
+// PaymentChannel represents a payment channel for streaming payments.
+type PaymentChannel struct {
+	Id              uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender          string                                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient       string                                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Deposit         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	Spent           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=spent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"spent"`
+	Balance         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
+	IsOpen          bool                                    `protobuf:"varint,7,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	OpenedHeight    int64                                   `protobuf:"varint,8,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
+	OpenedTime      time.Time                               `protobuf:"bytes,9,opt,name=opened_time,json=openedTime,proto3,stdtime" json:"opened_time"`
+	ClosedHeight    int64                                   `protobuf:"varint,10,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	ClosedTime      time.Time                               `protobuf:"bytes,11,opt,name=closed_time,json=closedTime,proto3,stdtime" json:"closed_time"`
+	ExpiresAtHeight int64                                   `protobuf:"varint,12,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	Nonce           uint64                                  `protobuf:"varint,13,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// routing_fee_bps is charged by the sender when forwarding HTLC payments
+	// over this channel
+	RoutingFeeBps uint32 `protobuf:"varint,14,opt,name=routing_fee_bps,json=routingFeeBps,proto3" json:"routing_fee_bps,omitempty"`
+}
+
+func (m *PaymentChannel) Reset()         { *m = PaymentChannel{} }
+func (m *PaymentChannel) String() string { return proto.CompactTextString(m) }
+func (*PaymentChannel) ProtoMessage()    {}
+func (*PaymentChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{9}
+}
+func (m *PaymentChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PaymentChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PaymentChannel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -705,119 +917,128 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *PaymentChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentChannel.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *PaymentChannel) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *PaymentChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_PaymentChannel proto.InternalMessageInfo
 
-func (m *Params) GetDefaultFeeRateBps() uint32 {
+func (m *PaymentChannel) GetId() uint64 {
 	if m != nil {
-		return m.DefaultFeeRateBps
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Params) GetFeeCollector() string {
+func (m *PaymentChannel) GetSender() string {
 	if m != nil {
-		return m.FeeCollector
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *Params) GetDefaultEscrowExpiration() int64 {
+func (m *PaymentChannel) GetRecipient() string {
 	if m != nil {
-		return m.DefaultEscrowExpiration
+		return m.Recipient
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetMaxEscrowExpiration() int64 {
+func (m *PaymentChannel) GetIsOpen() bool {
 	if m != nil {
-		return m.MaxEscrowExpiration
+		return m.IsOpen
 	}
-	return 0
+	return false
 }
 
-func (m *Params) GetMinChannelExpiration() int64 {
+func (m *PaymentChannel) GetOpenedHeight() int64 {
 	if m != nil {
-		return m.MinChannelExpiration
+		return m.OpenedHeight
 	}
 	return 0
 }
 
-func (m *Params) GetMaxChannelExpiration() int64 {
+func (m *PaymentChannel) GetOpenedTime() time.Time {
 	if m != nil {
-		return m.MaxChannelExpiration
+		return m.OpenedTime
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *Params) GetMaxBatchSize() uint32 {
+func (m *PaymentChannel) GetClosedHeight() int64 {
 	if m != nil {
-		return m.MaxBatchSize
+		return m.ClosedHeight
 	}
 	return 0
 }
 
-func (m *Params) GetMaxQueryLimit() uint32 {
+func (m *PaymentChannel) GetClosedTime() time.Time {
 	if m != nil {
-		return m.MaxQueryLimit
+		return m.ClosedTime
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *Params) GetInstantTransfersEnabled() bool {
+func (m *PaymentChannel) GetExpiresAtHeight() int64 {
 	if m != nil {
-		return m.InstantTransfersEnabled
+		return m.ExpiresAtHeight
 	}
-	return false
+	return 0
 }
 
-func (m *Params) GetEscrowEnabled() bool {
+func (m *PaymentChannel) GetNonce() uint64 {
 	if m != nil {
-		return m.EscrowEnabled
+		return m.Nonce
 	}
-	return false
+	return 0
 }
 
-func (m *Params) GetChannelsEnabled() bool {
+func (m *PaymentChannel) GetRoutingFeeBps() uint32 {
 	if m != nil {
-		return m.ChannelsEnabled
+		return m.RoutingFeeBps
 	}
-	return false
+	return 0
 }
 
-// GenesisState defines the settlement module's genesis state.
-type GenesisState struct {
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Settlements      []Settlement      `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements"`
-	Batches          []BatchSettlement `protobuf:"bytes,3,rep,name=batches,proto3" json:"batches"`
-	Channels         []PaymentChannel  `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels"`
-	Merchants        []MerchantConfig  `protobuf:"bytes,5,rep,name=merchants,proto3" json:"merchants"`
-	NextSettlementId uint64            `protobuf:"varint,6,opt,name=next_settlement_id,json=nextSettlementId,proto3" json:"next_settlement_id,omitempty"`
-	NextBatchId      uint64            `protobuf:"varint,7,opt,name=next_batch_id,json=nextBatchId,proto3" json:"next_batch_id,omitempty"`
-	NextChannelId    uint64            `protobuf:"varint,8,opt,name=next_channel_id,json=nextChannelId,proto3" json:"next_channel_id,omitempty"`
+// MerchantConfig represents merchant-specific configuration.
+type MerchantConfig struct {
+	Address         string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name            string                                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FeeRateBps      uint32                                  `protobuf:"varint,3,opt,name=fee_rate_bps,json=feeRateBps,proto3" json:"fee_rate_bps,omitempty"`
+	MinSettlement   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=min_settlement,json=minSettlement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_settlement"`
+	MaxSettlement   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=max_settlement,json=maxSettlement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_settlement"`
+	BatchEnabled    bool                                    `protobuf:"varint,6,opt,name=batch_enabled,json=batchEnabled,proto3" json:"batch_enabled,omitempty"`
+	BatchThreshold  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=batch_threshold,json=batchThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"batch_threshold"`
+	SettlementDelay time.Duration                           `protobuf:"bytes,8,opt,name=settlement_delay,json=settlementDelay,proto3,stdduration" json:"settlement_delay"`
+	IsActive        bool                                    `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	WebhookUrl      string                                  `protobuf:"bytes,10,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	RegisteredAt    time.Time                               `protobuf:"bytes,11,opt,name=registered_at,json=registeredAt,proto3,stdtime" json:"registered_at"`
+	// batch_max_age is how long an open batch collects payments before it is
+	// settled regardless of the threshold. Zero uses the module default.
+	BatchMaxAge time.Duration `protobuf:"bytes,12,opt,name=batch_max_age,json=batchMaxAge,proto3,stdduration" json:"batch_max_age"`
+	// settlement_denom is the denom the merchant is paid in. Payments in other
+	// oracle-priced denoms are converted into it. Empty means ssusd.
+	SettlementDenom string `protobuf:"bytes,13,opt,name=settlement_denom,json=settlementDenom,proto3" json:"settlement_denom,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d6475673dc68b2fe, []int{7}
+func (m *MerchantConfig) Reset()         { *m = MerchantConfig{} }
+func (m *MerchantConfig) String() string { return proto.CompactTextString(m) }
+func (*MerchantConfig) ProtoMessage()    {}
+func (*MerchantConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{10}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *MerchantConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MerchantConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MerchantConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -827,1438 +1048,14264 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *MerchantConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantConfig.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *MerchantConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *MerchantConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_MerchantConfig proto.InternalMessageInfo
 
-func (m *GenesisState) GetParams() Params {
+func (m *MerchantConfig) GetAddress() string {
 	if m != nil {
-		return m.Params
+		return m.Address
 	}
-	return Params{}
+	return ""
 }
 
-func (m *GenesisState) GetSettlements() []Settlement {
+func (m *MerchantConfig) GetName() string {
 	if m != nil {
-		return m.Settlements
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *GenesisState) GetBatches() []BatchSettlement {
+func (m *MerchantConfig) GetFeeRateBps() uint32 {
 	if m != nil {
-		return m.Batches
+		return m.FeeRateBps
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetChannels() []PaymentChannel {
+func (m *MerchantConfig) GetBatchEnabled() bool {
 	if m != nil {
-		return m.Channels
+		return m.BatchEnabled
 	}
-	return nil
+	return false
 }
 
-func (m *GenesisState) GetMerchants() []MerchantConfig {
+func (m *MerchantConfig) GetSettlementDelay() time.Duration {
 	if m != nil {
-		return m.Merchants
+		return m.SettlementDelay
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetNextSettlementId() uint64 {
+func (m *MerchantConfig) GetIsActive() bool {
 	if m != nil {
-		return m.NextSettlementId
+		return m.IsActive
 	}
-	return 0
+	return false
 }
 
-func (m *GenesisState) GetNextBatchId() uint64 {
+func (m *MerchantConfig) GetWebhookUrl() string {
 	if m != nil {
-		return m.NextBatchId
+		return m.WebhookUrl
 	}
-	return 0
+	return ""
 }
 
-func (m *GenesisState) GetNextChannelId() uint64 {
+func (m *MerchantConfig) GetRegisteredAt() time.Time {
 	if m != nil {
-		return m.NextChannelId
+		return m.RegisteredAt
+	}
+	return time.Time{}
+}
+
+func (m *MerchantConfig) GetBatchMaxAge() time.Duration {
+	if m != nil {
+		return m.BatchMaxAge
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Settlement)(nil), "stateset.settlement.Settlement")
-	proto.RegisterType((*BatchSettlement)(nil), "stateset.settlement.BatchSettlement")
-	proto.RegisterType((*PaymentChannel)(nil), "stateset.settlement.PaymentChannel")
-	proto.RegisterType((*MerchantConfig)(nil), "stateset.settlement.MerchantConfig")
-	proto.RegisterType((*CheckoutItem)(nil), "stateset.settlement.CheckoutItem")
-	proto.RegisterType((*TransferReceipt)(nil), "stateset.settlement.TransferReceipt")
-	proto.RegisterType((*Params)(nil), "stateset.settlement.Params")
-	proto.RegisterType((*GenesisState)(nil), "stateset.settlement.GenesisState")
+func (m *MerchantConfig) GetSettlementDenom() string {
+	if m != nil {
+		return m.SettlementDenom
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("stateset/settlement/settlement.proto", fileDescriptor_d6475673dc68b2fe)
+// CheckoutItem represents an item in a checkout.
+type CheckoutItem struct {
+	ProductId   string                                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity    uint64                                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"unit_price"`
+	Description string                                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-var fileDescriptor_d6475673dc68b2fe = []byte{
-	// 1606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x72, 0x1b, 0x59,
-	0x19, 0xb6, 0xac, 0x8b, 0xa5, 0xbf, 0x75, 0xf1, 0x9c, 0x98, 0x49, 0xc7, 0x03, 0x96, 0x90, 0x33,
-	0x83, 0x81, 0x41, 0xaa, 0x31, 0x6c, 0x60, 0x67, 0x39, 0x1e, 0x8f, 0xab, 0xb8, 0x84, 0x8e, 0xd9,
-	0x50, 0x54, 0x35, 0x47, 0xdd, 0xbf, 0xa4, 0x53, 0xee, 0x5b, 0xfa, 0x1c, 0x25, 0x72, 0x16, 0x3c,
-	0x43, 0x56, 0x14, 0x6f, 0x42, 0xf1, 0x06, 0x59, 0x40, 0x91, 0x15, 0x45, 0xb1, 0x30, 0x94, 0xf3,
-	0x16, 0x59, 0x51, 0xe7, 0xd2, 0x6a, 0xc9, 0x97, 0x54, 0x3c, 0x65, 0xad, 0xac, 0xf3, 0xdf, 0x3e,
-	0x9f, 0xa3, 0xef, 0xbf, 0x09, 0x1e, 0x73, 0x41, 0x05, 0x72, 0x14, 0x7d, 0x8e, 0x42, 0x04, 0x18,
-	0x62, 0xb4, 0xf8, 0xb1, 0x97, 0xa4, 0xb1, 0x88, 0xc9, 0x83, 0xcc, 0xaa, 0x97, 0xab, 0xb6, 0xb7,
-	0xc6, 0xf1, 0x38, 0x56, 0xfa, 0xbe, 0xfc, 0xa4, 0x4d, 0xb7, 0x77, 0xbc, 0x98, 0x87, 0x31, 0xef,
-	0x0f, 0x29, 0xc7, 0xfe, 0x8b, 0xaf, 0x86, 0x28, 0xe8, 0x57, 0x7d, 0x2f, 0x66, 0x51, 0xa6, 0x1f,
-	0xc7, 0xf1, 0x38, 0xc0, 0xbe, 0x3a, 0x0d, 0xa7, 0xa3, 0xbe, 0x3f, 0x4d, 0xa9, 0x60, 0x71, 0xa6,
-	0x6f, 0x5f, 0xd5, 0x0b, 0x16, 0x22, 0x17, 0x34, 0x4c, 0xb4, 0x41, 0xf7, 0x9f, 0x15, 0x80, 0x67,
-	0xf3, 0xff, 0x82, 0x34, 0x61, 0x9d, 0xf9, 0x76, 0xa1, 0x53, 0xd8, 0x2b, 0x39, 0xeb, 0xcc, 0x27,
-	0x5f, 0x40, 0x49, 0x9c, 0x27, 0x68, 0xaf, 0x77, 0x0a, 0x7b, 0xb5, 0x01, 0x79, 0x7f, 0xd1, 0x6e,
-	0xe6, 0xd6, 0xa7, 0xe7, 0x09, 0x3a, 0x4a, 0x4f, 0x3e, 0x85, 0x0a, 0xc7, 0xc8, 0xc7, 0xd4, 0x2e,
-	0x4a, 0x4b, 0xc7, 0x9c, 0xc8, 0x77, 0xa1, 0x96, 0xa2, 0xc7, 0x12, 0x86, 0x91, 0xb0, 0x4b, 0x4a,
-	0x95, 0x0b, 0xc8, 0x10, 0x2a, 0x34, 0x8c, 0xa7, 0x91, 0xb0, 0xcb, 0x9d, 0xc2, 0x9e, 0xb5, 0xff,
-	0xa8, 0xa7, 0xaf, 0xdb, 0x93, 0xd7, 0xed, 0x99, 0xeb, 0xf6, 0x0e, 0x63, 0x16, 0x0d, 0xfa, 0x6f,
-	0x2e, 0xda, 0x6b, 0xff, 0xb9, 0x68, 0xff, 0x60, 0xcc, 0xc4, 0x64, 0x3a, 0xec, 0x79, 0x71, 0xd8,
-	0x37, 0x6f, 0xa3, 0xff, 0xfc, 0x84, 0xfb, 0x67, 0x7d, 0xf9, 0xbf, 0x70, 0xe5, 0xe0, 0x98, 0xc8,
-	0xe4, 0x0f, 0x50, 0x1c, 0x21, 0xda, 0x95, 0x7b, 0x07, 0x90, 0x61, 0x09, 0x03, 0x88, 0x50, 0xb8,
-	0xe6, 0x16, 0x1b, 0xf7, 0x0e, 0x52, 0x8b, 0x50, 0x1c, 0xe8, 0x8b, 0x7c, 0x09, 0x15, 0xc9, 0x9b,
-	0x29, 0xb7, 0xab, 0xea, 0xcb, 0xd8, 0x7a, 0x7f, 0xd1, 0xde, 0xcc, 0xbf, 0x8c, 0x67, 0x4a, 0xe7,
-	0x18, 0x1b, 0xfd, 0xf0, 0x23, 0x4c, 0x31, 0xf2, 0xd0, 0xae, 0x65, 0x0f, 0x6f, 0x04, 0x64, 0x1b,
-	0xaa, 0x21, 0x0a, 0xea, 0x53, 0x41, 0x6d, 0x50, 0xca, 0xf9, 0x99, 0x7c, 0x0e, 0x4d, 0x2f, 0x45,
-	0x2a, 0xd0, 0x77, 0x27, 0xc8, 0xc6, 0x13, 0x61, 0x5b, 0x9d, 0xc2, 0x5e, 0xd1, 0x69, 0x18, 0xe9,
-	0x37, 0x4a, 0x48, 0x8e, 0xa1, 0x9e, 0x99, 0x49, 0x4e, 0xd9, 0x75, 0x75, 0xf7, 0xed, 0x9e, 0x26,
-	0x5c, 0x2f, 0x23, 0x5c, 0xef, 0x34, 0x23, 0xdc, 0xa0, 0x2a, 0x2f, 0xff, 0xfa, 0xbf, 0xed, 0x82,
-	0x63, 0x19, 0x4f, 0xa9, 0x93, 0x78, 0x3a, 0x0d, 0xe6, 0x78, 0x0d, 0x8d, 0x67, 0xa4, 0x39, 0x5e,
-	0x66, 0xa6, 0xf0, 0x9a, 0x77, 0xc1, 0x33, 0x9e, 0x0a, 0xef, 0x10, 0x00, 0x67, 0x09, 0x4b, 0x91,
-	0xbb, 0x54, 0xd8, 0xad, 0x3b, 0x84, 0xa9, 0x19, 0xbf, 0x03, 0x41, 0x1e, 0x41, 0x75, 0x48, 0x85,
-	0x37, 0x71, 0x99, 0x6f, 0x6f, 0xaa, 0x6c, 0xd9, 0x50, 0xe7, 0x13, 0xbf, 0xfb, 0x8f, 0x32, 0xb4,
-	0x06, 0xf2, 0xf3, 0x07, 0xd2, 0x4a, 0xbd, 0x7f, 0xea, 0x4d, 0x68, 0x24, 0x74, 0x6a, 0x39, 0xf3,
-	0x73, 0xfe, 0x1e, 0xd2, 0xd3, 0x65, 0x3e, 0xb7, 0x8b, 0x9d, 0xe2, 0x5e, 0x29, 0x7b, 0x0f, 0x29,
-	0x3d, 0xf1, 0x39, 0x09, 0xa1, 0x2e, 0x62, 0x41, 0x83, 0x8c, 0x7b, 0xa5, 0x7b, 0xe7, 0x9e, 0xa5,
-	0xe2, 0x1b, 0xf6, 0x31, 0x00, 0x0d, 0x37, 0x42, 0xe4, 0x2b, 0x48, 0xd7, 0x9a, 0x8a, 0xfe, 0x35,
-	0x22, 0xbf, 0x92, 0x53, 0x95, 0x55, 0xe6, 0xd4, 0x16, 0x94, 0xbd, 0x79, 0xe6, 0x96, 0x1c, 0x7d,
-	0xb8, 0x63, 0xa6, 0x5d, 0xcf, 0x97, 0xda, 0xc7, 0xe4, 0x0b, 0xdc, 0x5f, 0xbe, 0x58, 0x1f, 0x93,
-	0x2f, 0xf5, 0x6f, 0x99, 0x2f, 0xdd, 0xbf, 0x96, 0xa1, 0xf9, 0x94, 0x9e, 0xcb, 0x9b, 0x1f, 0x4e,
-	0x68, 0x14, 0x61, 0x70, 0x8d, 0xce, 0x79, 0xf5, 0x5f, 0xbf, 0xbd, 0xfa, 0x17, 0xaf, 0x56, 0x7f,
-	0x1f, 0x36, 0x7c, 0x4c, 0x62, 0xce, 0x56, 0x41, 0xde, 0x2c, 0x34, 0xf9, 0x23, 0x94, 0x79, 0x82,
-	0x2b, 0x69, 0x31, 0x3a, 0xb0, 0xbc, 0xc7, 0x90, 0x06, 0x54, 0x16, 0xda, 0xfb, 0x27, 0x6b, 0x16,
-	0x9a, 0x3c, 0x84, 0x0d, 0xc6, 0xdd, 0x38, 0xc1, 0x48, 0x91, 0xb5, 0xea, 0x54, 0x18, 0xff, 0x4d,
-	0x82, 0x11, 0xd9, 0x85, 0x86, 0x94, 0xe6, 0x74, 0xa8, 0x2a, 0x3a, 0xd4, 0xb5, 0xd0, 0xb0, 0xe1,
-	0x08, 0x2c, 0x63, 0xa4, 0xc8, 0x50, 0xbb, 0x03, 0x19, 0x40, 0x3b, 0x2a, 0xee, 0xed, 0x42, 0xc3,
-	0x0b, 0x62, 0x9e, 0x63, 0x81, 0xc6, 0xd2, 0xc2, 0x1c, 0xcb, 0x18, 0x29, 0x2c, 0xeb, 0x2e, 0x58,
-	0xda, 0x51, 0x61, 0xfd, 0x08, 0x3e, 0xc9, 0xeb, 0x74, 0x86, 0x57, 0x57, 0x78, 0xad, 0x79, 0x21,
-	0x36, 0x90, 0x5b, 0x50, 0x8e, 0x62, 0xf9, 0x05, 0x34, 0x74, 0x1e, 0xab, 0x43, 0xf7, 0x6f, 0x65,
-	0x68, 0xfe, 0xca, 0x94, 0xd5, 0xc3, 0x38, 0x1a, 0xb1, 0x31, 0xb1, 0x61, 0x83, 0xfa, 0x7e, 0x8a,
-	0x9c, 0x2b, 0xfa, 0xd6, 0x9c, 0xec, 0x48, 0x08, 0x94, 0x22, 0x1a, 0x9a, 0x49, 0xc7, 0x51, 0x9f,
-	0x49, 0x07, 0xea, 0x23, 0x44, 0x37, 0xa5, 0x02, 0xdd, 0x61, 0xc2, 0x15, 0x85, 0x1b, 0x0e, 0x8c,
-	0x10, 0x1d, 0x2a, 0x70, 0x90, 0x70, 0xf2, 0x1c, 0x9a, 0x21, 0x8b, 0xdc, 0xbc, 0x34, 0xaf, 0x80,
-	0xca, 0x8d, 0x90, 0x45, 0x0b, 0xbd, 0x44, 0x42, 0xd2, 0xd9, 0x22, 0x64, 0x79, 0x05, 0x90, 0x74,
-	0xb6, 0x00, 0xb9, 0x0b, 0x0d, 0xdd, 0xed, 0x30, 0xa2, 0xc3, 0x00, 0x7d, 0xc5, 0xf3, 0xaa, 0x53,
-	0x57, 0xc2, 0x23, 0x2d, 0x23, 0x1c, 0x5a, 0xda, 0x48, 0x4c, 0x52, 0xe4, 0x93, 0x38, 0xf0, 0x57,
-	0x30, 0x0f, 0x35, 0x15, 0xc4, 0x69, 0x86, 0x40, 0x7e, 0x0d, 0x9b, 0x0b, 0xcd, 0xd2, 0xc7, 0x80,
-	0x9e, 0x2b, 0xfe, 0x4b, 0xd4, 0xab, 0x84, 0x7b, 0x62, 0x46, 0x63, 0xcd, 0xb7, 0xbf, 0x48, 0xbe,
-	0xb5, 0x72, 0xe7, 0x27, 0xd2, 0x97, 0x7c, 0x06, 0x35, 0xc6, 0x5d, 0xea, 0x09, 0xf6, 0x42, 0x67,
-	0x49, 0xd5, 0xa9, 0x32, 0x7e, 0xa0, 0xce, 0xa4, 0x0d, 0xd6, 0x4b, 0x1c, 0x4e, 0xe2, 0xf8, 0xcc,
-	0x9d, 0xa6, 0x81, 0x19, 0x9c, 0xc0, 0x88, 0x7e, 0x97, 0x06, 0xe4, 0x04, 0x1a, 0x29, 0x8e, 0x19,
-	0x17, 0x98, 0xa2, 0x2f, 0xa7, 0x8b, 0xbb, 0x70, 0xbf, 0x9e, 0xbb, 0x1e, 0x88, 0xee, 0xbf, 0x0a,
-	0x50, 0x3f, 0x9c, 0xa0, 0x77, 0x16, 0x4f, 0xc5, 0x89, 0xc0, 0x90, 0x7c, 0x0f, 0x20, 0x49, 0x63,
-	0x7f, 0xea, 0xc9, 0x99, 0xc0, 0x90, 0xb7, 0x66, 0x24, 0x27, 0x6a, 0xa2, 0x78, 0x3e, 0xa5, 0x91,
-	0x60, 0xe2, 0x5c, 0x51, 0xb8, 0xe4, 0xcc, 0xcf, 0xb2, 0xa1, 0x4e, 0x23, 0x26, 0xdc, 0x24, 0x65,
-	0x1e, 0x2a, 0x12, 0xdf, 0x73, 0x43, 0x95, 0xd1, 0x9f, 0xca, 0xe0, 0xa4, 0x03, 0x96, 0x8f, 0xdc,
-	0x4b, 0x59, 0x22, 0x5f, 0xda, 0x4c, 0xfc, 0x8b, 0xa2, 0xee, 0xdf, 0x8b, 0xd0, 0x3a, 0x4d, 0x69,
-	0xc4, 0x47, 0x98, 0x3a, 0xe8, 0x21, 0x4b, 0x14, 0xbf, 0x96, 0x46, 0x1e, 0xd3, 0x5a, 0xea, 0x8b,
-	0x13, 0x8f, 0x2c, 0x80, 0x62, 0xe6, 0x4e, 0x28, 0x9f, 0x64, 0x5d, 0x46, 0xcc, 0xbe, 0xa1, 0x7c,
-	0x42, 0xbe, 0x0f, 0xf5, 0x61, 0x10, 0x7b, 0x67, 0x59, 0x8d, 0x28, 0xaa, 0x1a, 0x61, 0x29, 0x99,
-	0xa9, 0x0f, 0x03, 0xa8, 0xcd, 0x17, 0x1f, 0x93, 0xa1, 0x1f, 0x39, 0xf2, 0xcd, 0xdd, 0x16, 0x9a,
-	0x5c, 0xf9, 0xf6, 0x26, 0x57, 0xb9, 0x7d, 0xc5, 0xd9, 0x58, 0xf5, 0x8a, 0x53, 0x5d, 0xcd, 0x8a,
-	0xf3, 0xc1, 0x4d, 0xa2, 0xfb, 0xe7, 0x0a, 0x54, 0x9e, 0xd2, 0x94, 0x86, 0x9c, 0xf4, 0x61, 0xcb,
-	0xc7, 0x11, 0x9d, 0x06, 0xc2, 0x5d, 0xaa, 0x9a, 0x05, 0x55, 0x35, 0x3f, 0x31, 0xba, 0xaf, 0xf3,
-	0xe2, 0xb9, 0x0b, 0x0d, 0x69, 0xe8, 0xc5, 0x41, 0x80, 0x9e, 0x88, 0xb3, 0xe9, 0x41, 0xd6, 0xdc,
-	0xc3, 0x4c, 0x46, 0xfe, 0x04, 0xdf, 0x59, 0xae, 0xb0, 0xd9, 0x60, 0x78, 0xff, 0x3c, 0x7e, 0xb0,
-	0x54, 0x68, 0xcd, 0x88, 0x28, 0xf1, 0x97, 0xca, 0xed, 0xea, 0x06, 0xee, 0x07, 0x4b, 0x55, 0xd7,
-	0xe0, 0xff, 0x02, 0x1e, 0x65, 0xaf, 0x2a, 0xb3, 0x28, 0x7e, 0xe9, 0xaa, 0xe6, 0xa7, 0x2a, 0x99,
-	0x62, 0x62, 0xd1, 0x79, 0x68, 0x0c, 0x8e, 0x94, 0xfe, 0x68, 0xae, 0x26, 0xfb, 0xfa, 0x7f, 0xbf,
-	0xee, 0x57, 0x51, 0x7e, 0x12, 0xef, 0x9a, 0xcf, 0xcf, 0xe0, 0x53, 0xf9, 0xde, 0x9e, 0x1e, 0xf5,
-	0x16, 0x9d, 0x36, 0x94, 0xd3, 0x56, 0xc8, 0x22, 0x33, 0x07, 0x5e, 0xf1, 0xa2, 0xb3, 0x9b, 0xbc,
-	0xaa, 0xc6, 0x8b, 0xce, 0xae, 0x7b, 0x3d, 0xd6, 0xad, 0x4c, 0xb7, 0x0d, 0xce, 0x5e, 0x69, 0x7e,
-	0x35, 0x9c, 0x7a, 0x48, 0x67, 0x7a, 0x85, 0x62, 0xaf, 0x90, 0x7c, 0x01, 0x2d, 0x69, 0xf5, 0x7c,
-	0x8a, 0xe9, 0xb9, 0x1b, 0xb0, 0x90, 0xe9, 0xb1, 0xa3, 0xa1, 0xba, 0xd4, 0x6f, 0xa5, 0xf4, 0x97,
-	0x52, 0x28, 0x5f, 0x8a, 0x45, 0x5c, 0xd0, 0x48, 0xb8, 0xc2, 0x14, 0x18, 0x3e, 0xef, 0x58, 0x96,
-	0xaa, 0xe5, 0x0f, 0x8d, 0x41, 0x56, 0x80, 0x78, 0xd6, 0xbc, 0x3e, 0x87, 0x66, 0xf6, 0x4a, 0xc6,
-	0xa1, 0xae, 0x1c, 0x1a, 0x5a, 0x9a, 0x99, 0xfd, 0x10, 0x36, 0xcd, 0x15, 0xf3, 0xc8, 0x0d, 0x65,
-	0xd8, 0xca, 0xe4, 0xc6, 0xb4, 0x7b, 0x59, 0x84, 0xfa, 0x31, 0x46, 0xc8, 0x19, 0x97, 0x0b, 0x03,
-	0x92, 0x9f, 0x43, 0x25, 0x51, 0x89, 0xa2, 0x12, 0xc2, 0xda, 0xff, 0xac, 0x77, 0xc3, 0xcf, 0x40,
-	0x3d, 0x9d, 0x4b, 0x83, 0x92, 0xe4, 0x8e, 0x63, 0x1c, 0xc8, 0x31, 0x58, 0xb9, 0x09, 0xb7, 0xd7,
-	0x3b, 0xc5, 0x3d, 0x6b, 0xbf, 0x7d, 0xa3, 0x7f, 0xce, 0x1f, 0x13, 0x63, 0xd1, 0x93, 0x3c, 0x01,
-	0xbd, 0xa6, 0xa2, 0x5e, 0x2a, 0xad, 0xfd, 0xc7, 0x37, 0x06, 0xb9, 0xb2, 0xbe, 0x9a, 0x48, 0x99,
-	0x2b, 0x39, 0x82, 0x6a, 0x76, 0x5b, 0xbb, 0xa4, 0xc2, 0xec, 0xde, 0x72, 0x97, 0xc5, 0xad, 0xc1,
-	0x44, 0x99, 0xbb, 0x92, 0x63, 0xa8, 0x65, 0x4b, 0xaf, 0xdc, 0x28, 0x6f, 0x8f, 0xb3, 0x3c, 0xc3,
-	0x99, 0x38, 0xb9, 0x2f, 0xf9, 0x12, 0x48, 0x84, 0x33, 0xe1, 0x2e, 0xf7, 0x90, 0x8a, 0xea, 0x21,
-	0x9b, 0x52, 0xf3, 0x6c, 0xb1, 0x8f, 0x74, 0xa1, 0xa1, 0xac, 0xe7, 0xfb, 0xbb, 0xde, 0xfd, 0x2c,
-	0x29, 0x1c, 0xe8, 0x1d, 0x5e, 0x52, 0x4e, 0xd9, 0x64, 0x7c, 0x66, 0xbe, 0xe2, 0x71, 0xc9, 0x51,
-	0xae, 0xe6, 0x42, 0x27, 0xfe, 0xe0, 0xe8, 0xcd, 0xe5, 0x4e, 0xe1, 0xed, 0xe5, 0x4e, 0xe1, 0x7f,
-	0x97, 0x3b, 0x85, 0xd7, 0xef, 0x76, 0xd6, 0xde, 0xbe, 0xdb, 0x59, 0xfb, 0xf7, 0xbb, 0x9d, 0xb5,
-	0xdf, 0xff, 0x78, 0x21, 0xe9, 0xe7, 0x3f, 0x0a, 0x7a, 0x71, 0x8a, 0xfd, 0xd9, 0xe2, 0x6f, 0x83,
-	0x2a, 0xfb, 0x87, 0x15, 0xd5, 0x83, 0x7e, 0xfa, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x53,
-	0x45, 0xbd, 0x3f, 0x14, 0x00, 0x00,
+func (m *CheckoutItem) Reset()         { *m = CheckoutItem{} }
+func (m *CheckoutItem) String() string { return proto.CompactTextString(m) }
+func (*CheckoutItem) ProtoMessage()    {}
+func (*CheckoutItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{11}
+}
+func (m *CheckoutItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckoutItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckoutItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckoutItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckoutItem.Merge(m, src)
+}
+func (m *CheckoutItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckoutItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckoutItem.DiscardUnknown(m)
 }
 
-func (m *Settlement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckoutItem proto.InternalMessageInfo
+
+func (m *CheckoutItem) GetProductId() string {
+	if m != nil {
+		return m.ProductId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Settlement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckoutItem) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
 
-func (m *Settlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BatchId != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.BatchId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+func (m *CheckoutItem) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
-	if err1 != nil {
-		return 0, err1
+	return ""
+}
+
+// TransferReceipt represents the receipt of a transfer.
+type TransferReceipt struct {
+	SettlementId uint64                                  `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	TxHash       string                                  `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHeight  int64                                   `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp    time.Time                               `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Sender       string                                  `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient    string                                  `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Fee          github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee"`
+	Reference    string                                  `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *TransferReceipt) Reset()         { *m = TransferReceipt{} }
+func (m *TransferReceipt) String() string { return proto.CompactTextString(m) }
+func (*TransferReceipt) ProtoMessage()    {}
+func (*TransferReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{12}
+}
+func (m *TransferReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintSettlement(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x7a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
-	if err2 != nil {
-		return 0, err2
+}
+func (m *TransferReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferReceipt.Merge(m, src)
+}
+func (m *TransferReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferReceipt proto.InternalMessageInfo
+
+func (m *TransferReceipt) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	i -= n2
-	i = encodeVarintSettlement(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x72
-	if m.SettledHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
-		i--
-		dAtA[i] = 0x68
+	return 0
+}
+
+func (m *TransferReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime):])
-	if err3 != nil {
-		return 0, err3
+	return ""
+}
+
+func (m *TransferReceipt) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i -= n3
-	i = encodeVarintSettlement(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x62
-	if m.CreatedHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *TransferReceipt) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
+	return time.Time{}
+}
+
+func (m *TransferReceipt) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *TransferReceipt) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *TransferReceipt) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	{
-		size := m.NetAmount.Size()
-		i -= size
-		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// EscrowApproval records a party's vote on how an arbitrated escrow resolves.
+type EscrowApproval struct {
+	Party           string                                  `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	Resolution      EscrowResolution                        `protobuf:"bytes,2,opt,name=resolution,proto3,casttype=EscrowResolution" json:"resolution,omitempty"`
+	RecipientAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=recipient_amount,json=recipientAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"recipient_amount"`
+	ApprovedAt      time.Time                               `protobuf:"bytes,4,opt,name=approved_at,json=approvedAt,proto3,stdtime" json:"approved_at"`
+}
+
+func (m *EscrowApproval) Reset()         { *m = EscrowApproval{} }
+func (m *EscrowApproval) String() string { return proto.CompactTextString(m) }
+func (*EscrowApproval) ProtoMessage()    {}
+func (*EscrowApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{13}
+}
+func (m *EscrowApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+}
+func (m *EscrowApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowApproval.Merge(m, src)
+}
+func (m *EscrowApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowApproval proto.InternalMessageInfo
+
+func (m *EscrowApproval) GetParty() string {
+	if m != nil {
+		return m.Party
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EscrowApproval) GetResolution() EscrowResolution {
+	if m != nil {
+		return m.Resolution
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EscrowApproval) GetApprovedAt() time.Time {
+	if m != nil {
+		return m.ApprovedAt
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
+	return time.Time{}
+}
+
+// EscrowArbitration attaches arbiters and an approval threshold to an escrow
+// settlement. Any threshold of {sender, recipient, arbiters} approving the same
+// resolution releases, refunds or splits the escrowed funds.
+type EscrowArbitration struct {
+	SettlementId   uint64           `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Arbiters       []string         `protobuf:"bytes,2,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	Threshold      uint32           `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Approvals      []EscrowApproval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals"`
+	Resolution     EscrowResolution `protobuf:"bytes,5,opt,name=resolution,proto3,casttype=EscrowResolution" json:"resolution,omitempty"`
+	ResolvedHeight int64            `protobuf:"varint,6,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *EscrowArbitration) Reset()         { *m = EscrowArbitration{} }
+func (m *EscrowArbitration) String() string { return proto.CompactTextString(m) }
+func (*EscrowArbitration) ProtoMessage()    {}
+func (*EscrowArbitration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{14}
+}
+func (m *EscrowArbitration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowArbitration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowArbitration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EscrowArbitration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowArbitration.Merge(m, src)
+}
+func (m *EscrowArbitration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowArbitration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowArbitration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowArbitration proto.InternalMessageInfo
+
+func (m *EscrowArbitration) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	if m.Id != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EscrowArbitration) GetArbiters() []string {
+	if m != nil {
+		return m.Arbiters
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchSettlement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EscrowArbitration) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchSettlement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EscrowArbitration) GetApprovals() []EscrowApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
 }
 
-func (m *BatchSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
-	if err7 != nil {
-		return 0, err7
+func (m *EscrowArbitration) GetResolution() EscrowResolution {
+	if m != nil {
+		return m.Resolution
 	}
-	i -= n7
-	i = encodeVarintSettlement(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x62
-	if m.SettledHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
-		i--
-		dAtA[i] = 0x58
+	return ""
+}
+
+func (m *EscrowArbitration) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime):])
-	if err8 != nil {
-		return 0, err8
+	return 0
+}
+
+// Milestone is a tranche of a milestone escrow that is released or refunded on
+// its own. A zero deadline falls back to the escrow's expiration.
+type Milestone struct {
+	Description   string                                  `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Deadline      time.Time                               `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	Status        SettlementStatus                        `protobuf:"bytes,4,opt,name=status,proto3,casttype=SettlementStatus" json:"status,omitempty"`
+	Fee           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee"`
+	NetAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_amount"`
+	SettledHeight int64                                   `protobuf:"varint,7,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	SettledTime   time.Time                               `protobuf:"bytes,8,opt,name=settled_time,json=settledTime,proto3,stdtime" json:"settled_time"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{15}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n8
-	i = encodeVarintSettlement(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x52
-	if m.CreatedHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *Milestone) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
 	}
-	if m.Count != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.NetAmount.Size()
-		i -= size
-		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Milestone) GetStatus() SettlementStatus {
+	if m != nil {
+		return m.Status
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.TotalFees.Size()
-		i -= size
-		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Milestone) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Milestone) GetSettledTime() time.Time {
+	if m != nil {
+		return m.SettledTime
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.SettlementIds) > 0 {
-		dAtA13 := make([]byte, len(m.SettlementIds)*10)
-		var j12 int
-		for _, num := range m.SettlementIds {
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
+	return time.Time{}
+}
+
+// MilestoneEscrow holds the ordered milestones of an escrow settlement whose
+// amount is the sum of its milestones.
+type MilestoneEscrow struct {
+	SettlementId uint64      `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Milestones   []Milestone `protobuf:"bytes,2,rep,name=milestones,proto3" json:"milestones"`
+}
+
+func (m *MilestoneEscrow) Reset()         { *m = MilestoneEscrow{} }
+func (m *MilestoneEscrow) String() string { return proto.CompactTextString(m) }
+func (*MilestoneEscrow) ProtoMessage()    {}
+func (*MilestoneEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{16}
+}
+func (m *MilestoneEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MilestoneEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MilestoneEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j12
-		copy(dAtA[i:], dAtA13[:j12])
-		i = encodeVarintSettlement(dAtA, i, uint64(j12))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MilestoneEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneEscrow.Merge(m, src)
+}
+func (m *MilestoneEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MilestoneEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneEscrow proto.InternalMessageInfo
+
+func (m *MilestoneEscrow) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PaymentChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MilestoneEscrow) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Subscription is a recurring payment the payer authorizes once and the module
+// charges each interval from EndBlock.
+type Subscription struct {
+	Id               uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payer            string                                  `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Merchant         string                                  `protobuf:"bytes,3,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Interval         time.Duration                           `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval"`
+	MaxCycles        uint64                                  `protobuf:"varint,6,opt,name=max_cycles,json=maxCycles,proto3" json:"max_cycles,omitempty"`
+	CyclesCompleted  uint64                                  `protobuf:"varint,7,opt,name=cycles_completed,json=cyclesCompleted,proto3" json:"cycles_completed,omitempty"`
+	NextDue          time.Time                               `protobuf:"bytes,8,opt,name=next_due,json=nextDue,proto3,stdtime" json:"next_due"`
+	GracePeriod      time.Duration                           `protobuf:"bytes,9,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+	PastDueSince     time.Time                               `protobuf:"bytes,10,opt,name=past_due_since,json=pastDueSince,proto3,stdtime" json:"past_due_since"`
+	Status           SubscriptionStatus                      `protobuf:"bytes,11,opt,name=status,proto3,casttype=SubscriptionStatus" json:"status,omitempty"`
+	Reference        string                                  `protobuf:"bytes,12,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata         string                                  `protobuf:"bytes,13,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LastSettlementId uint64                                  `protobuf:"varint,14,opt,name=last_settlement_id,json=lastSettlementId,proto3" json:"last_settlement_id,omitempty"`
+	CreatedAt        time.Time                               `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	CancelledBy      string                                  `protobuf:"bytes,16,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
 }
 
-func (m *PaymentChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.ExpiresAtHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.ExpiresAtHeight))
-		i--
-		dAtA[i] = 0x60
-	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime):])
-	if err14 != nil {
-		return 0, err14
-	}
-	i -= n14
-	i = encodeVarintSettlement(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x5a
-	if m.ClosedHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.ClosedHeight))
-		i--
-		dAtA[i] = 0x50
-	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime):])
-	if err15 != nil {
-		return 0, err15
-	}
-	i -= n15
-	i = encodeVarintSettlement(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x4a
-	if m.OpenedHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.OpenedHeight))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.IsOpen {
-		i--
-		if m.IsOpen {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{17}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
+		return b[:n], nil
 	}
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Spent.Size()
-		i -= size
-		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Subscription) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Subscription) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Subscription) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Subscription) GetMaxCycles() uint64 {
+	if m != nil {
+		return m.MaxCycles
 	}
-	if m.Id != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Subscription) GetCyclesCompleted() uint64 {
+	if m != nil {
+		return m.CyclesCompleted
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MerchantConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Subscription) GetNextDue() time.Time {
+	if m != nil {
+		return m.NextDue
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MerchantConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Subscription) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
 }
 
-func (m *MerchantConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegisteredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt):])
-	if err19 != nil {
-		return 0, err19
+func (m *Subscription) GetPastDueSince() time.Time {
+	if m != nil {
+		return m.PastDueSince
 	}
-	i -= n19
-	i = encodeVarintSettlement(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x5a
-	if len(m.WebhookUrl) > 0 {
-		i -= len(m.WebhookUrl)
-		copy(dAtA[i:], m.WebhookUrl)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.WebhookUrl)))
-		i--
-		dAtA[i] = 0x52
+	return time.Time{}
+}
+
+func (m *Subscription) GetStatus() SubscriptionStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *Subscription) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SettlementDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay):])
-	if err20 != nil {
-		return 0, err20
+	return ""
+}
+
+func (m *Subscription) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	i -= n20
-	i = encodeVarintSettlement(dAtA, i, uint64(n20))
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.BatchThreshold.Size()
-		i -= size
-		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Subscription) GetLastSettlementId() uint64 {
+	if m != nil {
+		return m.LastSettlementId
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.BatchEnabled {
-		i--
-		if m.BatchEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Subscription) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	{
-		size := m.MaxSettlement.Size()
-		i -= size
-		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Subscription) GetCancelledBy() string {
+	if m != nil {
+		return m.CancelledBy
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MinSettlement.Size()
-		i -= size
-		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// Mandate is a customer's standing authorization for a merchant to pull
+// payments, each up to a per-charge maximum and together up to a cap per
+// period, until it expires or either party revokes it.
+type Mandate struct {
+	Id           uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Customer     string                                  `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	Merchant     string                                  `protobuf:"bytes,3,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	PerChargeMax github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=per_charge_max,json=perChargeMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"per_charge_max"`
+	PeriodCap    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=period_cap,json=periodCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"period_cap"`
+	Period       time.Duration                           `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period"`
+	// period_start is the start of the period period_spent counts against
+	PeriodStart time.Time                               `protobuf:"bytes,7,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=period_spent,json=periodSpent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"period_spent"`
+	// expires_at is zero for a mandate that does not expire
+	ExpiresAt        time.Time                               `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	Status           MandateStatus                           `protobuf:"bytes,10,opt,name=status,proto3,casttype=MandateStatus" json:"status,omitempty"`
+	Reference        string                                  `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
+	TotalPulled      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,12,opt,name=total_pulled,json=totalPulled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_pulled"`
+	ChargeCount      uint64                                  `protobuf:"varint,13,opt,name=charge_count,json=chargeCount,proto3" json:"charge_count,omitempty"`
+	LastSettlementId uint64                                  `protobuf:"varint,14,opt,name=last_settlement_id,json=lastSettlementId,proto3" json:"last_settlement_id,omitempty"`
+	CreatedAt        time.Time                               `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	RevokedBy        string                                  `protobuf:"bytes,16,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+}
+
+func (m *Mandate) Reset()         { *m = Mandate{} }
+func (m *Mandate) String() string { return proto.CompactTextString(m) }
+func (*Mandate) ProtoMessage()    {}
+func (*Mandate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{18}
+}
+func (m *Mandate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Mandate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Mandate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.FeeRateBps != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.FeeRateBps))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Mandate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mandate.Merge(m, src)
+}
+func (m *Mandate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Mandate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mandate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mandate proto.InternalMessageInfo
+
+func (m *Mandate) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Mandate) GetCustomer() string {
+	if m != nil {
+		return m.Customer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CheckoutItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Mandate) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CheckoutItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Mandate) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func (m *CheckoutItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+func (m *Mandate) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
 	}
-	{
-		size := m.UnitPrice.Size()
-		i -= size
-		if _, err := m.UnitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Mandate) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Quantity != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.Quantity))
-		i--
-		dAtA[i] = 0x10
+	return time.Time{}
+}
+
+func (m *Mandate) GetStatus() MandateStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.ProductId) > 0 {
-		i -= len(m.ProductId)
-		copy(dAtA[i:], m.ProductId)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.ProductId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Mandate) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TransferReceipt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Mandate) GetChargeCount() uint64 {
+	if m != nil {
+		return m.ChargeCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TransferReceipt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Mandate) GetLastSettlementId() uint64 {
+	if m != nil {
+		return m.LastSettlementId
+	}
+	return 0
 }
 
-func (m *TransferReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x4a
+func (m *Mandate) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Mandate) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// Stream is a continuous payment from a payer to a recipient at a fixed rate
+// per second, funded by the payer's deposit. Accrual is computed from block
+// time when the stream is read, never stored per block.
+type Stream struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payer     string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// deposit is everything the payer has deposited, including top-ups
+	Deposit github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	// rate_per_second is paid in base units of the deposit denom
+	RatePerSecond cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=rate_per_second,json=ratePerSecond,proto3,customtype=cosmossdk.io/math.Int" json:"rate_per_second"`
+	// accrued_at_checkpoint is what had accrued at checkpoint_time; accrual
+	// continues from there while the deposit lasts
+	AccruedAtCheckpoint cosmossdk_io_math.Int                   `protobuf:"bytes,6,opt,name=accrued_at_checkpoint,json=accruedAtCheckpoint,proto3,customtype=cosmossdk.io/math.Int" json:"accrued_at_checkpoint"`
+	CheckpointTime      time.Time                               `protobuf:"bytes,7,opt,name=checkpoint_time,json=checkpointTime,proto3,stdtime" json:"checkpoint_time"`
+	Withdrawn           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=withdrawn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"withdrawn"`
+	Status              StreamStatus                            `protobuf:"bytes,9,opt,name=status,proto3,casttype=StreamStatus" json:"status,omitempty"`
+	Reference           string                                  `protobuf:"bytes,10,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt           time.Time                               `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	CancelledAt         time.Time                               `protobuf:"bytes,12,opt,name=cancelled_at,json=cancelledAt,proto3,stdtime" json:"cancelled_at"`
+	CancelledBy         string                                  `protobuf:"bytes,13,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{19}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *Stream) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err27 != nil {
-		return 0, err27
+	return ""
+}
+
+func (m *Stream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	i -= n27
-	i = encodeVarintSettlement(dAtA, i, uint64(n27))
-	i--
-	dAtA[i] = 0x22
-	if m.BlockHeight != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *Stream) GetCheckpointTime() time.Time {
+	if m != nil {
+		return m.CheckpointTime
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
+	return time.Time{}
+}
+
+func (m *Stream) GetStatus() StreamStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.SettlementId != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Stream) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Stream) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Stream) GetCancelledAt() time.Time {
+	if m != nil {
+		return m.CancelledAt
+	}
+	return time.Time{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelsEnabled {
-		i--
-		if m.ChannelsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+func (m *Stream) GetCancelledBy() string {
+	if m != nil {
+		return m.CancelledBy
 	}
-	if m.EscrowEnabled {
-		i--
-		if m.EscrowEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// BidirectionalChannel is a payment channel funded by two parties whose balances
+// move back and forth through off-chain states signed by both.
+type BidirectionalChannel struct {
+	Id       uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PartyA   string                                  `protobuf:"bytes,2,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
+	PartyB   string                                  `protobuf:"bytes,3,opt,name=party_b,json=partyB,proto3" json:"party_b,omitempty"`
+	DepositA github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit_a,json=depositA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit_a"`
+	DepositB github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=deposit_b,json=depositB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit_b"`
+	// balance_a and balance_b hold the latest state submitted on-chain
+	BalanceA github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=balance_a,json=balanceA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance_a"`
+	BalanceB github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=balance_b,json=balanceB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance_b"`
+	Nonce    uint64                                  `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Status   BidirectionalChannelStatus              `protobuf:"bytes,9,opt,name=status,proto3,casttype=BidirectionalChannelStatus" json:"status,omitempty"`
+	// challenge_period is the number of blocks a unilateral close stays open to
+	// challenges
+	ChallengePeriod     int64     `protobuf:"varint,10,opt,name=challenge_period,json=challengePeriod,proto3" json:"challenge_period,omitempty"`
+	ClosingParty        string    `protobuf:"bytes,11,opt,name=closing_party,json=closingParty,proto3" json:"closing_party,omitempty"`
+	ChallengeEndsHeight int64     `protobuf:"varint,12,opt,name=challenge_ends_height,json=challengeEndsHeight,proto3" json:"challenge_ends_height,omitempty"`
+	OpenedHeight        int64     `protobuf:"varint,13,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height,omitempty"`
+	OpenedTime          time.Time `protobuf:"bytes,14,opt,name=opened_time,json=openedTime,proto3,stdtime" json:"opened_time"`
+	ClosedHeight        int64     `protobuf:"varint,15,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	ClosedTime          time.Time `protobuf:"bytes,16,opt,name=closed_time,json=closedTime,proto3,stdtime" json:"closed_time"`
+}
+
+func (m *BidirectionalChannel) Reset()         { *m = BidirectionalChannel{} }
+func (m *BidirectionalChannel) String() string { return proto.CompactTextString(m) }
+func (*BidirectionalChannel) ProtoMessage()    {}
+func (*BidirectionalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{20}
+}
+func (m *BidirectionalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidirectionalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidirectionalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
+		return b[:n], nil
 	}
-	if m.InstantTransfersEnabled {
-		i--
-		if m.InstantTransfersEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+}
+func (m *BidirectionalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidirectionalChannel.Merge(m, src)
+}
+func (m *BidirectionalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidirectionalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidirectionalChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidirectionalChannel proto.InternalMessageInfo
+
+func (m *BidirectionalChannel) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.MaxQueryLimit != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxQueryLimit))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *BidirectionalChannel) GetPartyA() string {
+	if m != nil {
+		return m.PartyA
 	}
-	if m.MaxBatchSize != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxBatchSize))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *BidirectionalChannel) GetPartyB() string {
+	if m != nil {
+		return m.PartyB
 	}
-	if m.MaxChannelExpiration != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxChannelExpiration))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *BidirectionalChannel) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.MinChannelExpiration != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.MinChannelExpiration))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *BidirectionalChannel) GetStatus() BidirectionalChannelStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.MaxEscrowExpiration != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxEscrowExpiration))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *BidirectionalChannel) GetChallengePeriod() int64 {
+	if m != nil {
+		return m.ChallengePeriod
 	}
-	if m.DefaultEscrowExpiration != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.DefaultEscrowExpiration))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *BidirectionalChannel) GetClosingParty() string {
+	if m != nil {
+		return m.ClosingParty
 	}
-	{
-		size := m.MaxSettlementAmount.Size()
-		i -= size
-		if _, err := m.MaxSettlementAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *BidirectionalChannel) GetChallengeEndsHeight() int64 {
+	if m != nil {
+		return m.ChallengeEndsHeight
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.MinSettlementAmount.Size()
-		i -= size
-		if _, err := m.MinSettlementAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BidirectionalChannel) GetOpenedHeight() int64 {
+	if m != nil {
+		return m.OpenedHeight
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.FeeCollector) > 0 {
-		i -= len(m.FeeCollector)
-		copy(dAtA[i:], m.FeeCollector)
-		i = encodeVarintSettlement(dAtA, i, uint64(len(m.FeeCollector)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *BidirectionalChannel) GetOpenedTime() time.Time {
+	if m != nil {
+		return m.OpenedTime
 	}
-	if m.DefaultFeeRateBps != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.DefaultFeeRateBps))
-		i--
-		dAtA[i] = 0x8
+	return time.Time{}
+}
+
+func (m *BidirectionalChannel) GetClosedHeight() int64 {
+	if m != nil {
+		return m.ClosedHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BidirectionalChannel) GetClosedTime() time.Time {
+	if m != nil {
+		return m.ClosedTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChannelState is a balance split of a bidirectional channel signed by both
+// parties. Signatures are hex encoded.
+type ChannelState struct {
+	ChannelId  uint64                                  `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	BalanceA   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=balance_a,json=balanceA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance_a"`
+	BalanceB   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=balance_b,json=balanceB,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance_b"`
+	Nonce      uint64                                  `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	SignatureA string                                  `protobuf:"bytes,5,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SignatureB string                                  `protobuf:"bytes,6,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextChannelId != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.NextChannelId))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.NextBatchId != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.NextBatchId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.NextSettlementId != 0 {
-		i = encodeVarintSettlement(dAtA, i, uint64(m.NextSettlementId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Merchants) > 0 {
-		for iNdEx := len(m.Merchants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Merchants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettlement(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *ChannelState) Reset()         { *m = ChannelState{} }
+func (m *ChannelState) String() string { return proto.CompactTextString(m) }
+func (*ChannelState) ProtoMessage()    {}
+func (*ChannelState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{21}
+}
+func (m *ChannelState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Channels) > 0 {
-		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettlement(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *ChannelState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelState.Merge(m, src)
+}
+func (m *ChannelState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelState proto.InternalMessageInfo
+
+func (m *ChannelState) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettlement(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return 0
+}
+
+func (m *ChannelState) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if len(m.Settlements) > 0 {
-		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettlement(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *ChannelState) GetSignatureA() string {
+	if m != nil {
+		return m.SignatureA
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *ChannelState) GetSignatureB() string {
+	if m != nil {
+		return m.SignatureB
+	}
+	return ""
+}
+
+// HTLC is a hash time-locked transfer. The recipient claims it by revealing the
+// SHA-256 preimage of hash_lock before timeout_height; after that the sender can
+// refund it. HTLCs funded from a payment channel lock part of its balance.
+type HTLC struct {
+	Id        uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    string                                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string                                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// hash_lock is the hex encoded SHA-256 hash of the preimage
+	HashLock string `protobuf:"bytes,5,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// channel_id is the payment channel funding the HTLC, 0 if funded from the
+	// sender's account
+	ChannelId uint64 `protobuf:"varint,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// incoming_htlc_id is the HTLC this one forwards, 0 for the first hop
+	IncomingHtlcId uint64     `protobuf:"varint,7,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
+	TimeoutHeight  int64      `protobuf:"varint,8,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	Status         HTLCStatus `protobuf:"bytes,9,opt,name=status,proto3,casttype=HTLCStatus" json:"status,omitempty"`
+	Preimage       string     `protobuf:"bytes,10,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	CreatedHeight  int64      `protobuf:"varint,11,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ResolvedHeight int64      `protobuf:"varint,12,opt,name=resolved_height,json=resolvedHeight,proto3" json:"resolved_height,omitempty"`
+}
+
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{22}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintSettlement(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(m, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
 }
 
-func encodeVarintSettlement(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSettlement(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Settlement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSettlement(uint64(m.Id))
+
+func (m *HTLC) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return ""
+}
+
+func (m *HTLC) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return ""
+}
+
+func (m *HTLC) GetHashLock() string {
+	if m != nil {
+		return m.HashLock
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return ""
+}
+
+func (m *HTLC) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.NetAmount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *HTLC) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
 	}
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *HTLC) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *HTLC) GetStatus() HTLCStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.CreatedHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	return ""
+}
+
+func (m *HTLC) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.SettledHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	return ""
+}
+
+func (m *HTLC) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.BatchId != 0 {
-		n += 2 + sovSettlement(uint64(m.BatchId))
+	return 0
+}
+
+func (m *HTLC) GetResolvedHeight() int64 {
+	if m != nil {
+		return m.ResolvedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *BatchSettlement) Size() (n int) {
-	if m == nil {
-		return 0
+// DelayedPayout holds the net amount of a settlement in the module account
+// until the merchant's settlement delay has passed. The authority can freeze
+// a pending payout while it is under review.
+type DelayedPayout struct {
+	SettlementId   uint64                                  `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Merchant       string                                  `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	ReleaseAt      time.Time                               `protobuf:"bytes,4,opt,name=release_at,json=releaseAt,proto3,stdtime" json:"release_at"`
+	Status         PayoutStatus                            `protobuf:"bytes,5,opt,name=status,proto3,casttype=PayoutStatus" json:"status,omitempty"`
+	FrozenReason   string                                  `protobuf:"bytes,6,opt,name=frozen_reason,json=frozenReason,proto3" json:"frozen_reason,omitempty"`
+	CreatedHeight  int64                                   `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ReleasedHeight int64                                   `protobuf:"varint,8,opt,name=released_height,json=releasedHeight,proto3" json:"released_height,omitempty"`
+}
+
+func (m *DelayedPayout) Reset()         { *m = DelayedPayout{} }
+func (m *DelayedPayout) String() string { return proto.CompactTextString(m) }
+func (*DelayedPayout) ProtoMessage()    {}
+func (*DelayedPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{23}
+}
+func (m *DelayedPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSettlement(uint64(m.Id))
+}
+func (m *DelayedPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedPayout.Merge(m, src)
+}
+func (m *DelayedPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedPayout proto.InternalMessageInfo
+
+func (m *DelayedPayout) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *DelayedPayout) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	if len(m.SettlementIds) > 0 {
-		l = 0
-		for _, e := range m.SettlementIds {
-			l += sovSettlement(uint64(e))
-		}
-		n += 1 + sovSettlement(uint64(l)) + l
-	}
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.TotalFees.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.NetAmount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.Count != 0 {
-		n += 1 + sovSettlement(uint64(m.Count))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return ""
+}
+
+func (m *DelayedPayout) GetReleaseAt() time.Time {
+	if m != nil {
+		return m.ReleaseAt
 	}
-	if m.CreatedHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	return time.Time{}
+}
+
+func (m *DelayedPayout) GetStatus() PayoutStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.SettledHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	return ""
+}
+
+func (m *DelayedPayout) GetFrozenReason() string {
+	if m != nil {
+		return m.FrozenReason
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	return n
+	return ""
 }
 
-func (m *PaymentChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelayedPayout) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSettlement(uint64(m.Id))
+	return 0
+}
+
+func (m *DelayedPayout) GetReleasedHeight() int64 {
+	if m != nil {
+		return m.ReleasedHeight
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+// Params defines the parameters for the settlement module.
+type Params struct {
+	DefaultFeeRateBps       uint32                                  `protobuf:"varint,1,opt,name=default_fee_rate_bps,json=defaultFeeRateBps,proto3" json:"default_fee_rate_bps,omitempty"`
+	FeeCollector            string                                  `protobuf:"bytes,2,opt,name=fee_collector,json=feeCollector,proto3" json:"fee_collector,omitempty"`
+	MinSettlementAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=min_settlement_amount,json=minSettlementAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"min_settlement_amount"`
+	MaxSettlementAmount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=max_settlement_amount,json=maxSettlementAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_settlement_amount"`
+	DefaultEscrowExpiration int64                                   `protobuf:"varint,5,opt,name=default_escrow_expiration,json=defaultEscrowExpiration,proto3" json:"default_escrow_expiration,omitempty"`
+	MaxEscrowExpiration     int64                                   `protobuf:"varint,6,opt,name=max_escrow_expiration,json=maxEscrowExpiration,proto3" json:"max_escrow_expiration,omitempty"`
+	MinChannelExpiration    int64                                   `protobuf:"varint,7,opt,name=min_channel_expiration,json=minChannelExpiration,proto3" json:"min_channel_expiration,omitempty"`
+	MaxChannelExpiration    int64                                   `protobuf:"varint,8,opt,name=max_channel_expiration,json=maxChannelExpiration,proto3" json:"max_channel_expiration,omitempty"`
+	MaxBatchSize            uint32                                  `protobuf:"varint,9,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	MaxQueryLimit           uint32                                  `protobuf:"varint,10,opt,name=max_query_limit,json=maxQueryLimit,proto3" json:"max_query_limit,omitempty"`
+	InstantTransfersEnabled bool                                    `protobuf:"varint,11,opt,name=instant_transfers_enabled,json=instantTransfersEnabled,proto3" json:"instant_transfers_enabled,omitempty"`
+	EscrowEnabled           bool                                    `protobuf:"varint,12,opt,name=escrow_enabled,json=escrowEnabled,proto3" json:"escrow_enabled,omitempty"`
+	ChannelsEnabled         bool                                    `protobuf:"varint,13,opt,name=channels_enabled,json=channelsEnabled,proto3" json:"channels_enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{24}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDefaultFeeRateBps() uint32 {
+	if m != nil {
+		return m.DefaultFeeRateBps
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.Spent.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.IsOpen {
-		n += 2
+	return 0
+}
+
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
 	}
-	if m.OpenedHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.OpenedHeight))
+	return ""
+}
+
+func (m *Params) GetDefaultEscrowExpiration() int64 {
+	if m != nil {
+		return m.DefaultEscrowExpiration
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.ClosedHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.ClosedHeight))
+	return 0
+}
+
+func (m *Params) GetMaxEscrowExpiration() int64 {
+	if m != nil {
+		return m.MaxEscrowExpiration
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.ExpiresAtHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.ExpiresAtHeight))
+	return 0
+}
+
+func (m *Params) GetMinChannelExpiration() int64 {
+	if m != nil {
+		return m.MinChannelExpiration
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovSettlement(uint64(m.Nonce))
+	return 0
+}
+
+func (m *Params) GetMaxChannelExpiration() int64 {
+	if m != nil {
+		return m.MaxChannelExpiration
 	}
-	return n
+	return 0
 }
 
-func (m *MerchantConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *Params) GetMaxQueryLimit() uint32 {
+	if m != nil {
+		return m.MaxQueryLimit
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+func (m *Params) GetInstantTransfersEnabled() bool {
+	if m != nil {
+		return m.InstantTransfersEnabled
 	}
-	if m.FeeRateBps != 0 {
-		n += 1 + sovSettlement(uint64(m.FeeRateBps))
+	return false
+}
+
+func (m *Params) GetEscrowEnabled() bool {
+	if m != nil {
+		return m.EscrowEnabled
 	}
-	l = m.MinSettlement.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.MaxSettlement.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.BatchEnabled {
-		n += 2
+	return false
+}
+
+func (m *Params) GetChannelsEnabled() bool {
+	if m != nil {
+		return m.ChannelsEnabled
 	}
-	l = m.BatchThreshold.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay)
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.IsActive {
-		n += 2
+	return false
+}
+
+// NettingCycle collects payment obligations between a fixed set of
+// participants until it closes. Settling the cycle transfers only each
+// participant's multilateral net position.
+type NettingCycle struct {
+	Id              uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operator        string                                  `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Participants    []string                                `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	Denom           string                                  `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	ClosesAt        time.Time                               `protobuf:"bytes,5,opt,name=closes_at,json=closesAt,proto3,stdtime" json:"closes_at"`
+	Status          NettingCycleStatus                      `protobuf:"bytes,6,opt,name=status,proto3,casttype=NettingCycleStatus" json:"status,omitempty"`
+	ObligationCount uint64                                  `protobuf:"varint,7,opt,name=obligation_count,json=obligationCount,proto3" json:"obligation_count,omitempty"`
+	GrossTotal      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=gross_total,json=grossTotal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"gross_total"`
+	CreatedHeight   int64                                   `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	SettledHeight   int64                                   `protobuf:"varint,10,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	SettledTime     time.Time                               `protobuf:"bytes,11,opt,name=settled_time,json=settledTime,proto3,stdtime" json:"settled_time"`
+	FailureReason   string                                  `protobuf:"bytes,12,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// transfers are the net transfers made when the cycle settled
+	Transfers []NetTransfer `protobuf:"bytes,13,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *NettingCycle) Reset()         { *m = NettingCycle{} }
+func (m *NettingCycle) String() string { return proto.CompactTextString(m) }
+func (*NettingCycle) ProtoMessage()    {}
+func (*NettingCycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{25}
+}
+func (m *NettingCycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NettingCycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NettingCycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.WebhookUrl)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+}
+func (m *NettingCycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NettingCycle.Merge(m, src)
+}
+func (m *NettingCycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *NettingCycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_NettingCycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NettingCycle proto.InternalMessageInfo
+
+func (m *NettingCycle) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt)
-	n += 1 + l + sovSettlement(uint64(l))
-	return n
+	return 0
 }
 
-func (m *CheckoutItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NettingCycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	var l int
-	_ = l
-	l = len(m.ProductId)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
-	}
-	if m.Quantity != 0 {
-		n += 1 + sovSettlement(uint64(m.Quantity))
-	}
-	l = m.UnitPrice.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
-	}
-	return n
+	return ""
 }
 
-func (m *TransferReceipt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SettlementId != 0 {
-		n += 1 + sovSettlement(uint64(m.SettlementId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovSettlement(uint64(m.BlockHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovSettlement(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+func (m *NettingCycle) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
 	}
-	return n
+	return nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NettingCycle) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	if m.DefaultFeeRateBps != 0 {
-		n += 1 + sovSettlement(uint64(m.DefaultFeeRateBps))
+	return ""
+}
+
+func (m *NettingCycle) GetClosesAt() time.Time {
+	if m != nil {
+		return m.ClosesAt
 	}
-	l = len(m.FeeCollector)
-	if l > 0 {
-		n += 1 + l + sovSettlement(uint64(l))
+	return time.Time{}
+}
+
+func (m *NettingCycle) GetStatus() NettingCycleStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = m.MinSettlementAmount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	l = m.MaxSettlementAmount.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	if m.DefaultEscrowExpiration != 0 {
-		n += 1 + sovSettlement(uint64(m.DefaultEscrowExpiration))
+	return ""
+}
+
+func (m *NettingCycle) GetObligationCount() uint64 {
+	if m != nil {
+		return m.ObligationCount
 	}
-	if m.MaxEscrowExpiration != 0 {
-		n += 1 + sovSettlement(uint64(m.MaxEscrowExpiration))
+	return 0
+}
+
+func (m *NettingCycle) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if m.MinChannelExpiration != 0 {
-		n += 1 + sovSettlement(uint64(m.MinChannelExpiration))
+	return 0
+}
+
+func (m *NettingCycle) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
 	}
-	if m.MaxChannelExpiration != 0 {
-		n += 1 + sovSettlement(uint64(m.MaxChannelExpiration))
+	return 0
+}
+
+func (m *NettingCycle) GetSettledTime() time.Time {
+	if m != nil {
+		return m.SettledTime
 	}
-	if m.MaxBatchSize != 0 {
-		n += 1 + sovSettlement(uint64(m.MaxBatchSize))
+	return time.Time{}
+}
+
+func (m *NettingCycle) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
 	}
-	if m.MaxQueryLimit != 0 {
-		n += 1 + sovSettlement(uint64(m.MaxQueryLimit))
+	return ""
+}
+
+func (m *NettingCycle) GetTransfers() []NetTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	if m.InstantTransfersEnabled {
-		n += 2
+	return nil
+}
+
+// NettingObligation is an amount a debtor owes a creditor in a netting cycle.
+type NettingObligation struct {
+	CycleId       uint64                                  `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id,omitempty"`
+	Id            uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Debtor        string                                  `protobuf:"bytes,3,opt,name=debtor,proto3" json:"debtor,omitempty"`
+	Creditor      string                                  `protobuf:"bytes,4,opt,name=creditor,proto3" json:"creditor,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Reference     string                                  `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedHeight int64                                   `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *NettingObligation) Reset()         { *m = NettingObligation{} }
+func (m *NettingObligation) String() string { return proto.CompactTextString(m) }
+func (*NettingObligation) ProtoMessage()    {}
+func (*NettingObligation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{26}
+}
+func (m *NettingObligation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NettingObligation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NettingObligation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EscrowEnabled {
-		n += 2
+}
+func (m *NettingObligation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NettingObligation.Merge(m, src)
+}
+func (m *NettingObligation) XXX_Size() int {
+	return m.Size()
+}
+func (m *NettingObligation) XXX_DiscardUnknown() {
+	xxx_messageInfo_NettingObligation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NettingObligation proto.InternalMessageInfo
+
+func (m *NettingObligation) GetCycleId() uint64 {
+	if m != nil {
+		return m.CycleId
 	}
-	if m.ChannelsEnabled {
-		n += 2
+	return 0
+}
+
+func (m *NettingObligation) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NettingObligation) GetDebtor() string {
+	if m != nil {
+		return m.Debtor
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovSettlement(uint64(l))
-	if len(m.Settlements) > 0 {
-		for _, e := range m.Settlements {
-			l = e.Size()
-			n += 1 + l + sovSettlement(uint64(l))
-		}
+	return ""
+}
+
+func (m *NettingObligation) GetCreditor() string {
+	if m != nil {
+		return m.Creditor
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovSettlement(uint64(l))
-		}
+	return ""
+}
+
+func (m *NettingObligation) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	if len(m.Channels) > 0 {
-		for _, e := range m.Channels {
-			l = e.Size()
-			n += 1 + l + sovSettlement(uint64(l))
-		}
+	return ""
+}
+
+func (m *NettingObligation) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
 	}
-	if len(m.Merchants) > 0 {
-		for _, e := range m.Merchants {
-			l = e.Size()
-			n += 1 + l + sovSettlement(uint64(l))
+	return 0
+}
+
+// NetTransfer is a single transfer settling net positions of a netting cycle.
+type NetTransfer struct {
+	From   string                                  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string                                  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// settlement_id is the settlement recording the transfer, 0 until the
+	// cycle has settled
+	SettlementId uint64 `protobuf:"varint,4,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+}
+
+func (m *NetTransfer) Reset()         { *m = NetTransfer{} }
+func (m *NetTransfer) String() string { return proto.CompactTextString(m) }
+func (*NetTransfer) ProtoMessage()    {}
+func (*NetTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{27}
+}
+func (m *NetTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NextSettlementId != 0 {
-		n += 1 + sovSettlement(uint64(m.NextSettlementId))
+}
+func (m *NetTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetTransfer.Merge(m, src)
+}
+func (m *NetTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetTransfer proto.InternalMessageInfo
+
+func (m *NetTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if m.NextBatchId != 0 {
-		n += 1 + sovSettlement(uint64(m.NextBatchId))
+	return ""
+}
+
+func (m *NetTransfer) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	if m.NextChannelId != 0 {
-		n += 1 + sovSettlement(uint64(m.NextChannelId))
+	return ""
+}
+
+func (m *NetTransfer) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	return n
+	return 0
 }
 
-func sovSettlement(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// NettingPosition reports a participant's obligations in a netting cycle
+// gross, netted against each counterparty, and netted across all
+// counterparties.
+type NettingPosition struct {
+	Participant            string                                  `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	GrossPayable           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=gross_payable,json=grossPayable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"gross_payable"`
+	GrossReceivable        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=gross_receivable,json=grossReceivable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"gross_receivable"`
+	BilateralNetPayable    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=bilateral_net_payable,json=bilateralNetPayable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"bilateral_net_payable"`
+	BilateralNetReceivable github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=bilateral_net_receivable,json=bilateralNetReceivable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"bilateral_net_receivable"`
+	NetPayable             github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=net_payable,json=netPayable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_payable"`
+	NetReceivable          github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=net_receivable,json=netReceivable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_receivable"`
 }
-func sozSettlement(x uint64) (n int) {
-	return sovSettlement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *NettingPosition) Reset()         { *m = NettingPosition{} }
+func (m *NettingPosition) String() string { return proto.CompactTextString(m) }
+func (*NettingPosition) ProtoMessage()    {}
+func (*NettingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{28}
 }
-func (m *Settlement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettlement
+func (m *NettingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NettingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NettingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NettingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NettingPosition.Merge(m, src)
+}
+func (m *NettingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *NettingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_NettingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NettingPosition proto.InternalMessageInfo
+
+func (m *NettingPosition) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// GenesisState defines the settlement module's genesis state.
+type GenesisState struct {
+	Params                     Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Settlements                []Settlement           `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements"`
+	Batches                    []BatchSettlement      `protobuf:"bytes,3,rep,name=batches,proto3" json:"batches"`
+	Channels                   []PaymentChannel       `protobuf:"bytes,4,rep,name=channels,proto3" json:"channels"`
+	Merchants                  []MerchantConfig       `protobuf:"bytes,5,rep,name=merchants,proto3" json:"merchants"`
+	NextSettlementId           uint64                 `protobuf:"varint,6,opt,name=next_settlement_id,json=nextSettlementId,proto3" json:"next_settlement_id,omitempty"`
+	NextBatchId                uint64                 `protobuf:"varint,7,opt,name=next_batch_id,json=nextBatchId,proto3" json:"next_batch_id,omitempty"`
+	NextChannelId              uint64                 `protobuf:"varint,8,opt,name=next_channel_id,json=nextChannelId,proto3" json:"next_channel_id,omitempty"`
+	EscrowArbitrations         []EscrowArbitration    `protobuf:"bytes,9,rep,name=escrow_arbitrations,json=escrowArbitrations,proto3" json:"escrow_arbitrations"`
+	MilestoneEscrows           []MilestoneEscrow      `protobuf:"bytes,10,rep,name=milestone_escrows,json=milestoneEscrows,proto3" json:"milestone_escrows"`
+	Subscriptions              []Subscription         `protobuf:"bytes,11,rep,name=subscriptions,proto3" json:"subscriptions"`
+	NextSubscriptionId         uint64                 `protobuf:"varint,12,opt,name=next_subscription_id,json=nextSubscriptionId,proto3" json:"next_subscription_id,omitempty"`
+	BidirectionalChannels      []BidirectionalChannel `protobuf:"bytes,13,rep,name=bidirectional_channels,json=bidirectionalChannels,proto3" json:"bidirectional_channels"`
+	NextBidirectionalChannelId uint64                 `protobuf:"varint,14,opt,name=next_bidirectional_channel_id,json=nextBidirectionalChannelId,proto3" json:"next_bidirectional_channel_id,omitempty"`
+	Htlcs                      []HTLC                 `protobuf:"bytes,15,rep,name=htlcs,proto3" json:"htlcs"`
+	NextHtlcId                 uint64                 `protobuf:"varint,16,opt,name=next_htlc_id,json=nextHtlcId,proto3" json:"next_htlc_id,omitempty"`
+	DelayedPayouts             []DelayedPayout        `protobuf:"bytes,17,rep,name=delayed_payouts,json=delayedPayouts,proto3" json:"delayed_payouts"`
+	NettingCycles              []NettingCycle         `protobuf:"bytes,18,rep,name=netting_cycles,json=nettingCycles,proto3" json:"netting_cycles"`
+	NettingObligations         []NettingObligation    `protobuf:"bytes,19,rep,name=netting_obligations,json=nettingObligations,proto3" json:"netting_obligations"`
+	NextNettingCycleId         uint64                 `protobuf:"varint,20,opt,name=next_netting_cycle_id,json=nextNettingCycleId,proto3" json:"next_netting_cycle_id,omitempty"`
+	SplitTemplates             []SplitTemplate        `protobuf:"bytes,21,rep,name=split_templates,json=splitTemplates,proto3" json:"split_templates"`
+	Mandates                   []Mandate              `protobuf:"bytes,22,rep,name=mandates,proto3" json:"mandates"`
+	NextMandateId              uint64                 `protobuf:"varint,23,opt,name=next_mandate_id,json=nextMandateId,proto3" json:"next_mandate_id,omitempty"`
+	Streams                    []Stream               `protobuf:"bytes,24,rep,name=streams,proto3" json:"streams"`
+	NextStreamId               uint64                 `protobuf:"varint,25,opt,name=next_stream_id,json=nextStreamId,proto3" json:"next_stream_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6475673dc68b2fe, []int{29}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSettlements() []Settlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatches() []BatchSettlement {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannels() []PaymentChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerchants() []MerchantConfig {
+	if m != nil {
+		return m.Merchants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSettlementId() uint64 {
+	if m != nil {
+		return m.NextSettlementId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextBatchId() uint64 {
+	if m != nil {
+		return m.NextBatchId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNextChannelId() uint64 {
+	if m != nil {
+		return m.NextChannelId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEscrowArbitrations() []EscrowArbitration {
+	if m != nil {
+		return m.EscrowArbitrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMilestoneEscrows() []MilestoneEscrow {
+	if m != nil {
+		return m.MilestoneEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSubscriptionId() uint64 {
+	if m != nil {
+		return m.NextSubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBidirectionalChannels() []BidirectionalChannel {
+	if m != nil {
+		return m.BidirectionalChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextBidirectionalChannelId() uint64 {
+	if m != nil {
+		return m.NextBidirectionalChannelId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHtlcs() []HTLC {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextHtlcId() uint64 {
+	if m != nil {
+		return m.NextHtlcId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelayedPayouts() []DelayedPayout {
+	if m != nil {
+		return m.DelayedPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNettingCycles() []NettingCycle {
+	if m != nil {
+		return m.NettingCycles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNettingObligations() []NettingObligation {
+	if m != nil {
+		return m.NettingObligations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextNettingCycleId() uint64 {
+	if m != nil {
+		return m.NextNettingCycleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSplitTemplates() []SplitTemplate {
+	if m != nil {
+		return m.SplitTemplates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMandates() []Mandate {
+	if m != nil {
+		return m.Mandates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMandateId() uint64 {
+	if m != nil {
+		return m.NextMandateId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextStreamId() uint64 {
+	if m != nil {
+		return m.NextStreamId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Settlement)(nil), "stateset.settlement.Settlement")
+	proto.RegisterType((*SettlementRefund)(nil), "stateset.settlement.SettlementRefund")
+	proto.RegisterType((*SplitRule)(nil), "stateset.settlement.SplitRule")
+	proto.RegisterType((*SplitTemplate)(nil), "stateset.settlement.SplitTemplate")
+	proto.RegisterType((*PayoutLeg)(nil), "stateset.settlement.PayoutLeg")
+	proto.RegisterType((*StatementEntry)(nil), "stateset.settlement.StatementEntry")
+	proto.RegisterType((*Statement)(nil), "stateset.settlement.Statement")
+	proto.RegisterType((*FXConversion)(nil), "stateset.settlement.FXConversion")
+	proto.RegisterType((*BatchSettlement)(nil), "stateset.settlement.BatchSettlement")
+	proto.RegisterType((*PaymentChannel)(nil), "stateset.settlement.PaymentChannel")
+	proto.RegisterType((*MerchantConfig)(nil), "stateset.settlement.MerchantConfig")
+	proto.RegisterType((*CheckoutItem)(nil), "stateset.settlement.CheckoutItem")
+	proto.RegisterType((*TransferReceipt)(nil), "stateset.settlement.TransferReceipt")
+	proto.RegisterType((*EscrowApproval)(nil), "stateset.settlement.EscrowApproval")
+	proto.RegisterType((*EscrowArbitration)(nil), "stateset.settlement.EscrowArbitration")
+	proto.RegisterType((*Milestone)(nil), "stateset.settlement.Milestone")
+	proto.RegisterType((*MilestoneEscrow)(nil), "stateset.settlement.MilestoneEscrow")
+	proto.RegisterType((*Subscription)(nil), "stateset.settlement.Subscription")
+	proto.RegisterType((*Mandate)(nil), "stateset.settlement.Mandate")
+	proto.RegisterType((*Stream)(nil), "stateset.settlement.Stream")
+	proto.RegisterType((*BidirectionalChannel)(nil), "stateset.settlement.BidirectionalChannel")
+	proto.RegisterType((*ChannelState)(nil), "stateset.settlement.ChannelState")
+	proto.RegisterType((*HTLC)(nil), "stateset.settlement.HTLC")
+	proto.RegisterType((*DelayedPayout)(nil), "stateset.settlement.DelayedPayout")
+	proto.RegisterType((*Params)(nil), "stateset.settlement.Params")
+	proto.RegisterType((*NettingCycle)(nil), "stateset.settlement.NettingCycle")
+	proto.RegisterType((*NettingObligation)(nil), "stateset.settlement.NettingObligation")
+	proto.RegisterType((*NetTransfer)(nil), "stateset.settlement.NetTransfer")
+	proto.RegisterType((*NettingPosition)(nil), "stateset.settlement.NettingPosition")
+	proto.RegisterType((*GenesisState)(nil), "stateset.settlement.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("stateset/settlement/settlement.proto", fileDescriptor_d6475673dc68b2fe)
+}
+
+var fileDescriptor_d6475673dc68b2fe = []byte{
+	// 4269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x4d, 0x6f, 0x1b, 0x49,
+	0x76, 0x23, 0x92, 0x22, 0xd9, 0x8f, 0x5f, 0x72, 0x59, 0xb6, 0x69, 0x7b, 0x46, 0xd2, 0xd2, 0x63,
+	0xaf, 0x27, 0x3b, 0x2b, 0x65, 0x26, 0x9b, 0x20, 0xd9, 0x45, 0x3e, 0x48, 0x4a, 0xb6, 0x35, 0xb1,
+	0xbd, 0xda, 0x96, 0x82, 0x04, 0x41, 0x82, 0x4e, 0xb1, 0xbb, 0x48, 0x36, 0xd4, 0xec, 0xee, 0xe9,
+	0x2a, 0x7a, 0xc8, 0x49, 0x10, 0x20, 0x1f, 0x3f, 0x60, 0x0f, 0x41, 0x30, 0xd8, 0xdc, 0x73, 0x09,
+	0x72, 0x0b, 0x10, 0x60, 0x8f, 0x39, 0xed, 0x21, 0x87, 0xcd, 0x02, 0xc9, 0x06, 0x39, 0x68, 0x83,
+	0x99, 0x7f, 0x90, 0x43, 0x0e, 0x3e, 0x05, 0xf5, 0xd5, 0x1f, 0x14, 0xa5, 0x21, 0x05, 0x51, 0xc8,
+	0xc9, 0xaa, 0x57, 0xf5, 0xde, 0xeb, 0xaa, 0x7a, 0xdf, 0xaf, 0x68, 0x78, 0x9f, 0x32, 0xcc, 0x08,
+	0x25, 0x6c, 0x8f, 0x12, 0xc6, 0x3c, 0x32, 0x22, 0x7e, 0xfa, 0xcf, 0xdd, 0x30, 0x0a, 0x58, 0x80,
+	0x6e, 0xeb, 0x55, 0xbb, 0xc9, 0xd4, 0x83, 0xcd, 0x41, 0x30, 0x08, 0xc4, 0xfc, 0x1e, 0xff, 0x4b,
+	0x2e, 0x7d, 0x70, 0xdf, 0x0e, 0xe8, 0x28, 0xa0, 0x96, 0x9c, 0x90, 0x03, 0x35, 0xb5, 0x25, 0x47,
+	0x7b, 0x3d, 0x4c, 0xc9, 0xde, 0x9b, 0x8f, 0x7a, 0x84, 0xe1, 0x8f, 0xf6, 0xec, 0xc0, 0xf5, 0xf5,
+	0xfc, 0x20, 0x08, 0x06, 0x1e, 0xd9, 0x13, 0xa3, 0xde, 0xb8, 0xbf, 0xe7, 0x8c, 0x23, 0xcc, 0xdc,
+	0x40, 0xcf, 0x6f, 0xcf, 0xce, 0x33, 0x77, 0x44, 0x28, 0xc3, 0xa3, 0x50, 0x2e, 0x68, 0x7d, 0x51,
+	0x06, 0x38, 0x8e, 0x3f, 0x10, 0xd5, 0x21, 0xe7, 0x3a, 0xcd, 0xb5, 0x9d, 0xb5, 0xa7, 0x05, 0x33,
+	0xe7, 0x3a, 0xe8, 0x09, 0x14, 0xd8, 0x34, 0x24, 0xcd, 0xdc, 0xce, 0xda, 0x53, 0xa3, 0x83, 0xde,
+	0x9e, 0x6d, 0xd7, 0x93, 0xd5, 0x27, 0xd3, 0x90, 0x98, 0x62, 0x1e, 0xdd, 0x85, 0x22, 0x25, 0xbe,
+	0x43, 0xa2, 0x66, 0x9e, 0xaf, 0x34, 0xd5, 0x08, 0xbd, 0x0b, 0x46, 0x44, 0x6c, 0x37, 0x74, 0x89,
+	0xcf, 0x9a, 0x05, 0x31, 0x95, 0x00, 0x50, 0x0f, 0x8a, 0x78, 0x14, 0x8c, 0x7d, 0xd6, 0x5c, 0xdf,
+	0x59, 0x7b, 0x5a, 0xf9, 0xf8, 0xfe, 0xae, 0xda, 0x3c, 0xdf, 0xee, 0xae, 0xda, 0xee, 0x6e, 0x37,
+	0x70, 0xfd, 0xce, 0xde, 0x4f, 0xce, 0xb6, 0xdf, 0xf9, 0xaf, 0xb3, 0xed, 0x6f, 0x0e, 0x5c, 0x36,
+	0x1c, 0xf7, 0x76, 0xed, 0x60, 0xa4, 0x4e, 0x4a, 0xfd, 0xf3, 0x6d, 0xea, 0x9c, 0xee, 0xf1, 0x6f,
+	0xa1, 0x02, 0xc1, 0x54, 0x94, 0xd1, 0x1f, 0x41, 0xbe, 0x4f, 0x48, 0xb3, 0x78, 0xed, 0x0c, 0x38,
+	0x59, 0xe4, 0x02, 0xf8, 0x84, 0x59, 0x6a, 0x17, 0xa5, 0x6b, 0x67, 0x62, 0xf8, 0x84, 0xb5, 0xe5,
+	0x46, 0x3e, 0x84, 0x22, 0x17, 0xa9, 0x31, 0x6d, 0x96, 0xc5, 0x65, 0x6c, 0xbe, 0x3d, 0xdb, 0xde,
+	0x48, 0x2e, 0xe3, 0x58, 0xcc, 0x99, 0x6a, 0x8d, 0x3c, 0xf8, 0x3e, 0x89, 0x88, 0x6f, 0x93, 0xa6,
+	0xa1, 0x0f, 0x5e, 0x01, 0xd0, 0x03, 0x28, 0x8f, 0x08, 0xc3, 0x0e, 0x66, 0xb8, 0x09, 0x62, 0x32,
+	0x1e, 0xa3, 0xc7, 0x50, 0xb7, 0x23, 0x82, 0x19, 0x71, 0xac, 0x21, 0x71, 0x07, 0x43, 0xd6, 0xac,
+	0xec, 0xac, 0x3d, 0xcd, 0x9b, 0x35, 0x05, 0x7d, 0x21, 0x80, 0xe8, 0x39, 0x54, 0xf5, 0x32, 0x2e,
+	0x53, 0xcd, 0xaa, 0xd8, 0xfb, 0x83, 0x5d, 0x29, 0x70, 0xbb, 0x5a, 0xe0, 0x76, 0x4f, 0xb4, 0xc0,
+	0x75, 0xca, 0x7c, 0xf3, 0x3f, 0xfc, 0xc5, 0xf6, 0x9a, 0x59, 0x51, 0x98, 0x7c, 0x8e, 0xf3, 0x93,
+	0x1a, 0x12, 0xf3, 0xab, 0x49, 0x7e, 0x0a, 0x9a, 0xf0, 0xd3, 0xcb, 0x04, 0xbf, 0xfa, 0x32, 0xfc,
+	0x14, 0xa6, 0xe0, 0xd7, 0x05, 0x20, 0x93, 0xd0, 0x8d, 0x08, 0xb5, 0x30, 0x6b, 0x36, 0x96, 0x20,
+	0x63, 0x28, 0xbc, 0x36, 0x43, 0xf7, 0xa1, 0xdc, 0xc3, 0xcc, 0x1e, 0x5a, 0xae, 0xd3, 0xdc, 0x10,
+	0xda, 0x52, 0x12, 0xe3, 0x43, 0x07, 0x3d, 0x83, 0x5a, 0x7f, 0x62, 0xd9, 0x81, 0xff, 0x86, 0x44,
+	0xd4, 0x0d, 0xfc, 0xe6, 0x2d, 0xc1, 0xe2, 0x1b, 0xbb, 0x73, 0x0c, 0xc2, 0xee, 0xb3, 0x3f, 0xe8,
+	0xc6, 0x0b, 0xcd, 0x6a, 0x7f, 0x92, 0x8c, 0xd0, 0xaf, 0x43, 0xc1, 0x23, 0x03, 0xda, 0x44, 0x3b,
+	0xf9, 0xa7, 0x95, 0x8f, 0xb7, 0xe6, 0xa2, 0x1f, 0xe1, 0x69, 0x30, 0x66, 0x2f, 0xc9, 0xa0, 0x53,
+	0xe0, 0x5f, 0x69, 0x0a, 0x0c, 0x74, 0x00, 0xa5, 0x88, 0xf4, 0xc7, 0xbe, 0x43, 0x9b, 0xb7, 0x05,
+	0xf2, 0xe3, 0xb9, 0xc8, 0x89, 0xec, 0x98, 0x62, 0xb5, 0xa2, 0xa1, 0x71, 0x5b, 0x3f, 0xcf, 0xc1,
+	0xc6, 0xec, 0x9a, 0x94, 0xca, 0xae, 0xad, 0x4c, 0x65, 0xc7, 0xb0, 0x11, 0xdb, 0x08, 0xad, 0x5a,
+	0xb9, 0x6b, 0xe7, 0xd6, 0x88, 0x79, 0x28, 0x05, 0xbb, 0x0b, 0xc5, 0x88, 0x60, 0x1a, 0xf8, 0xda,
+	0x86, 0xc9, 0x11, 0x87, 0x2b, 0xc1, 0x2c, 0x08, 0xc1, 0x54, 0x23, 0x7e, 0x41, 0x42, 0x12, 0xd7,
+	0x97, 0x10, 0x21, 0x81, 0xd1, 0xfa, 0x87, 0x35, 0x30, 0x8e, 0x43, 0xcf, 0x65, 0xe6, 0xd8, 0x23,
+	0x68, 0x13, 0xd6, 0x43, 0x3c, 0x25, 0x44, 0x9c, 0xa8, 0x61, 0xca, 0x01, 0x42, 0x50, 0x88, 0x02,
+	0x4f, 0x59, 0x5e, 0x53, 0xfc, 0x8d, 0x36, 0x20, 0xdf, 0x0b, 0xa9, 0xf8, 0xbc, 0x9a, 0xc9, 0xff,
+	0x4c, 0x5d, 0x47, 0x61, 0x55, 0xd7, 0xd1, 0xfa, 0x53, 0xa8, 0x89, 0x8f, 0x3d, 0x21, 0xa3, 0xd0,
+	0xc3, 0x4c, 0x59, 0x8f, 0xc8, 0x1e, 0x62, 0x25, 0x05, 0xc2, 0x7a, 0xc8, 0x31, 0xfa, 0x2e, 0xac,
+	0x47, 0x63, 0x8f, 0xd0, 0x66, 0xee, 0x12, 0xb1, 0x8d, 0xf7, 0xae, 0x44, 0x4e, 0xa2, 0xf0, 0x2d,
+	0xfb, 0x78, 0x44, 0xd4, 0xf1, 0x8b, 0xbf, 0x5b, 0x7f, 0x91, 0x03, 0x23, 0x96, 0xf2, 0x25, 0x8e,
+	0x2a, 0x39, 0x98, 0xfc, 0xca, 0xe4, 0xb4, 0x0f, 0x65, 0xa9, 0x2b, 0xc4, 0x59, 0xc1, 0xf1, 0xc7,
+	0xb4, 0x5b, 0xff, 0x92, 0x87, 0x3a, 0x37, 0xef, 0xe2, 0xf0, 0x0e, 0x7c, 0x16, 0x4d, 0xd1, 0x23,
+	0xa8, 0x25, 0xe7, 0x69, 0xc5, 0x2e, 0xbb, 0x9a, 0x00, 0x0f, 0x1d, 0xf4, 0x3d, 0x68, 0xa4, 0x16,
+	0x7d, 0x8d, 0x1f, 0xaf, 0xd3, 0xcc, 0x18, 0xbd, 0x07, 0x40, 0x38, 0x2b, 0x89, 0x27, 0xaf, 0xc4,
+	0x10, 0x10, 0x31, 0xfd, 0x2e, 0x18, 0x8e, 0x1b, 0x11, 0x9b, 0xc7, 0x1a, 0xda, 0xb1, 0xc7, 0x80,
+	0x1b, 0x71, 0xec, 0x2d, 0xa8, 0xda, 0xfc, 0x0f, 0x12, 0x85, 0x38, 0x62, 0x53, 0xe1, 0xe1, 0x0d,
+	0x33, 0x03, 0xcb, 0x7a, 0xc1, 0xd2, 0xac, 0x17, 0x4c, 0x14, 0xbb, 0x3c, 0x57, 0xb1, 0x8d, 0x65,
+	0x15, 0x3b, 0xe3, 0x16, 0x20, 0xe3, 0x16, 0x5a, 0x3f, 0x2b, 0x82, 0x11, 0x5f, 0x22, 0x6a, 0x42,
+	0x09, 0xdb, 0x76, 0x6c, 0x47, 0x0d, 0x53, 0x0f, 0xb9, 0x88, 0x3b, 0xc4, 0x0f, 0x46, 0x4a, 0x9a,
+	0xe5, 0x00, 0x6d, 0x43, 0xa5, 0x1f, 0x05, 0x23, 0xed, 0x21, 0xf3, 0xe2, 0x7b, 0x81, 0x83, 0x94,
+	0x7b, 0x7c, 0x08, 0x06, 0x0b, 0xac, 0x8c, 0x9d, 0x2a, 0xb3, 0x40, 0x4d, 0xb6, 0xc1, 0x10, 0xd8,
+	0x4b, 0x9b, 0xab, 0x32, 0x47, 0x13, 0x5e, 0xf3, 0x37, 0xa1, 0xc4, 0x02, 0x49, 0xa0, 0xb8, 0x04,
+	0x81, 0x22, 0x0b, 0x04, 0xfa, 0x09, 0x34, 0x82, 0x90, 0xf8, 0xae, 0x3f, 0xb0, 0x7a, 0xd8, 0xc3,
+	0xf1, 0x75, 0x74, 0xbe, 0xa5, 0xae, 0xff, 0x8e, 0xbc, 0x6c, 0xea, 0x9c, 0xee, 0xba, 0xc1, 0xde,
+	0x08, 0xb3, 0xe1, 0xee, 0xa1, 0xcf, 0x7e, 0xf6, 0x4f, 0xdf, 0x06, 0x25, 0x39, 0x87, 0x3e, 0x33,
+	0xeb, 0x8a, 0x46, 0x47, 0x92, 0xe0, 0x54, 0x6d, 0x2f, 0xa0, 0x69, 0xaa, 0xe5, 0x2b, 0x50, 0x55,
+	0x34, 0x34, 0xd5, 0x23, 0xa8, 0xb1, 0x80, 0x61, 0xcf, 0xb2, 0x23, 0xe2, 0xb8, 0x8c, 0xca, 0xf0,
+	0x69, 0x39, 0x9a, 0x55, 0x41, 0xa1, 0x2b, 0x09, 0xa0, 0xd7, 0x20, 0xc7, 0x96, 0x43, 0x7a, 0x9c,
+	0x20, 0x2c, 0x4f, 0xb0, 0x22, 0x08, 0xec, 0x0b, 0x7c, 0xf4, 0x09, 0x80, 0xa4, 0xd7, 0x27, 0x84,
+	0x8a, 0xf0, 0x6c, 0x49, 0x6a, 0x86, 0x40, 0x7f, 0x46, 0x08, 0x45, 0x5d, 0x28, 0x71, 0xad, 0x76,
+	0x09, 0x6d, 0x56, 0x85, 0xc9, 0x7e, 0x34, 0xdf, 0x64, 0x67, 0xec, 0x8f, 0x0e, 0x15, 0x14, 0x26,
+	0xfa, 0x00, 0x36, 0x06, 0xc4, 0x27, 0x51, 0x3a, 0x6a, 0x94, 0x51, 0x5c, 0x23, 0x86, 0x2b, 0x59,
+	0xfc, 0x5d, 0xa8, 0x27, 0x4b, 0x97, 0x8e, 0xe4, 0x6a, 0x31, 0x2e, 0x9f, 0x6d, 0xfd, 0x73, 0x0e,
+	0xaa, 0xe9, 0x10, 0x0a, 0x7d, 0x0a, 0xf5, 0x10, 0x4f, 0x47, 0xa9, 0xc0, 0xe1, 0xfa, 0xc3, 0x94,
+	0x9a, 0xe2, 0xa0, 0xc2, 0x06, 0x13, 0x2a, 0x41, 0x84, 0x6d, 0x8f, 0x58, 0xfc, 0xbb, 0x94, 0x85,
+	0xfd, 0x48, 0x11, 0x7d, 0x78, 0xfe, 0x36, 0x5e, 0x92, 0x01, 0xb6, 0xa7, 0xfb, 0xc4, 0x4e, 0xdd,
+	0xc9, 0x3e, 0xb1, 0x4d, 0x90, 0x54, 0x4c, 0xee, 0x61, 0x3f, 0x81, 0x52, 0x7f, 0x22, 0xe9, 0xe5,
+	0xaf, 0x4a, 0xaf, 0xd8, 0x9f, 0x08, 0x5a, 0x9b, 0xb0, 0x1e, 0x05, 0x63, 0x46, 0x94, 0x95, 0x96,
+	0x83, 0xd6, 0xff, 0x16, 0xa1, 0xd1, 0xe1, 0xa6, 0xe9, 0x92, 0xe4, 0x2f, 0xed, 0xe7, 0x73, 0x33,
+	0x7e, 0x3e, 0x8e, 0xda, 0x95, 0x03, 0xe2, 0x51, 0x49, 0xfe, 0x69, 0xc1, 0xac, 0xa5, 0x3d, 0x10,
+	0x45, 0x23, 0x2d, 0xf9, 0x2b, 0x8b, 0x52, 0xa4, 0x62, 0xa8, 0xbb, 0x70, 0x33, 0x8a, 0x71, 0xfd,
+	0xbe, 0x27, 0xa5, 0x37, 0xd9, 0xcc, 0xaf, 0xb8, 0xca, 0xcc, 0x6f, 0x13, 0xd6, 0xed, 0x38, 0xbf,
+	0x2c, 0x98, 0x72, 0xb0, 0x64, 0x3e, 0x78, 0x3e, 0xab, 0x33, 0x16, 0xc9, 0xea, 0xe0, 0xfa, 0xb2,
+	0xba, 0xca, 0x22, 0x59, 0x5d, 0xf5, 0xaa, 0x59, 0xdd, 0x36, 0x54, 0xf0, 0x98, 0x05, 0x96, 0x84,
+	0x09, 0xe3, 0x53, 0x36, 0x81, 0x83, 0xe4, 0x91, 0x70, 0x1f, 0x28, 0xe7, 0xac, 0xde, 0x74, 0x29,
+	0x93, 0x53, 0x96, 0x68, 0x9d, 0x29, 0xcf, 0x1c, 0x23, 0xe2, 0x11, 0x4c, 0xc9, 0xd2, 0x99, 0xa3,
+	0xc2, 0x6b, 0xb3, 0xd6, 0x2f, 0xd6, 0xa1, 0x7e, 0x24, 0x0d, 0x48, 0x77, 0x88, 0x7d, 0x9f, 0x78,
+	0xe7, 0xf4, 0x2e, 0x29, 0xa6, 0xe4, 0x2e, 0x2e, 0xa6, 0xe4, 0x67, 0x8b, 0x29, 0x0e, 0x94, 0x1c,
+	0x12, 0x06, 0xd4, 0x5d, 0x85, 0x96, 0x69, 0xd2, 0xe8, 0x4f, 0x60, 0x9d, 0x86, 0x64, 0x25, 0x81,
+	0x9d, 0x24, 0xcc, 0xf7, 0xa1, 0x9d, 0xf9, 0xf5, 0x6b, 0x95, 0x26, 0x8d, 0xee, 0x41, 0xc9, 0xa5,
+	0x16, 0x8f, 0x27, 0x84, 0x56, 0x95, 0xcd, 0xa2, 0x4b, 0xbf, 0x1f, 0x12, 0x9f, 0x47, 0xd6, 0x1c,
+	0x9a, 0xc8, 0xad, 0x8c, 0x0d, 0xab, 0x12, 0xa8, 0xc4, 0xf6, 0x00, 0x2a, 0x6a, 0xd1, 0xd2, 0x81,
+	0x22, 0x48, 0x44, 0x21, 0xb4, 0x8f, 0xa0, 0xc6, 0x63, 0x8f, 0x84, 0x17, 0x48, 0x5e, 0x12, 0x98,
+	0xf0, 0x52, 0x8b, 0x04, 0xaf, 0xca, 0x32, 0xbc, 0x24, 0xa2, 0xe0, 0xf5, 0x4b, 0x70, 0x2b, 0x29,
+	0x7b, 0x68, 0x7e, 0x55, 0xe9, 0xa3, 0xe3, 0xba, 0x86, 0x62, 0xb9, 0x09, 0xeb, 0x7e, 0xc0, 0x2f,
+	0xa0, 0x26, 0x0d, 0x8e, 0x18, 0xa0, 0x27, 0xd0, 0xe0, 0xbe, 0x83, 0x47, 0x5b, 0x7d, 0x42, 0x2c,
+	0x9e, 0x89, 0xd6, 0x45, 0x26, 0x5a, 0x53, 0xe0, 0x67, 0x84, 0x74, 0x42, 0xda, 0xfa, 0xc7, 0x22,
+	0xd4, 0x5f, 0x29, 0x3f, 0xd1, 0x0d, 0xfc, 0xbe, 0x3b, 0x10, 0xe1, 0xae, 0xe3, 0x44, 0x84, 0xd2,
+	0x38, 0xdc, 0x95, 0xc3, 0x38, 0xe7, 0xcb, 0x25, 0x39, 0x1f, 0xda, 0x81, 0x2a, 0x67, 0xc0, 0xdd,
+	0x9f, 0x95, 0xe4, 0xbb, 0xd0, 0x27, 0xc2, 0x39, 0x76, 0x42, 0xca, 0xdd, 0xfc, 0xc8, 0xf5, 0xad,
+	0xc4, 0xd7, 0xac, 0x40, 0xe4, 0x6b, 0x23, 0xd7, 0x4f, 0x39, 0x47, 0xce, 0x12, 0x4f, 0xd2, 0x2c,
+	0xd7, 0x57, 0xc0, 0x12, 0x4f, 0x52, 0x2c, 0x1f, 0x41, 0x4d, 0x66, 0x13, 0xc4, 0xc7, 0x3d, 0x8f,
+	0x38, 0x42, 0x1f, 0xca, 0x66, 0x55, 0x00, 0x0f, 0x24, 0x0c, 0x51, 0x68, 0xc8, 0x45, 0x6c, 0x18,
+	0x11, 0x3a, 0x0c, 0x3c, 0x67, 0x05, 0x65, 0xc8, 0xba, 0x60, 0x71, 0xa2, 0x39, 0xa0, 0xd7, 0xb0,
+	0x91, 0xf2, 0xfe, 0x0e, 0xf1, 0xf0, 0x54, 0xe8, 0x09, 0xe7, 0x3a, 0x2b, 0x98, 0xfb, 0xaa, 0x22,
+	0x2d, 0xe5, 0xf2, 0x0b, 0x2e, 0x97, 0xa9, 0xb4, 0x74, 0x9f, 0xe3, 0xf2, 0xec, 0xc5, 0xa5, 0x16,
+	0xb6, 0x99, 0xfb, 0x46, 0x6a, 0x53, 0xd9, 0x2c, 0xbb, 0xb4, 0x2d, 0xc6, 0xdc, 0xb4, 0x7f, 0x46,
+	0x7a, 0xc3, 0x20, 0x38, 0xb5, 0xc6, 0x91, 0xa7, 0xea, 0x95, 0xa0, 0x40, 0xbf, 0x17, 0x79, 0xe8,
+	0x10, 0x6a, 0x11, 0x19, 0xb8, 0x94, 0x91, 0x88, 0x38, 0xdc, 0x34, 0x2f, 0xa3, 0x23, 0xd5, 0x04,
+	0xb5, 0xcd, 0xfd, 0x91, 0x3a, 0x72, 0x7e, 0xd7, 0x78, 0xa0, 0x1d, 0xd2, 0x42, 0xbb, 0xaa, 0x08,
+	0xcc, 0x57, 0x78, 0xd2, 0x1e, 0x10, 0x1e, 0x11, 0x67, 0x4e, 0x88, 0x67, 0x74, 0x35, 0xf1, 0xe5,
+	0x99, 0xcd, 0xfb, 0xc1, 0xa8, 0xf5, 0x1f, 0x6b, 0x50, 0xed, 0x0e, 0x89, 0x7d, 0x1a, 0x8c, 0xd9,
+	0x21, 0x23, 0x23, 0x9e, 0x7a, 0x87, 0x51, 0xe0, 0x8c, 0xed, 0x38, 0xb3, 0x37, 0x4c, 0x43, 0x41,
+	0x0e, 0x45, 0x58, 0xf6, 0xe9, 0x18, 0xfb, 0xcc, 0x65, 0x53, 0xa1, 0x36, 0x05, 0x33, 0x1e, 0xf3,
+	0xa8, 0x64, 0xec, 0xbb, 0xcc, 0x0a, 0x23, 0xd7, 0x26, 0x2b, 0x28, 0x7d, 0x18, 0x9c, 0xfa, 0x11,
+	0x27, 0x8e, 0x76, 0xa0, 0xe2, 0x10, 0x6a, 0x47, 0x6e, 0x98, 0xaa, 0x01, 0xa4, 0x41, 0xad, 0x7f,
+	0xcd, 0x43, 0xe3, 0x24, 0xc2, 0x3e, 0xed, 0x93, 0xc8, 0x24, 0x36, 0x71, 0x43, 0xb6, 0x58, 0xe1,
+	0xe2, 0x1e, 0x94, 0xd8, 0xc4, 0x1a, 0x62, 0x3a, 0xd4, 0x1e, 0x90, 0x4d, 0x5e, 0x60, 0x3a, 0x44,
+	0xdf, 0x80, 0x6a, 0xcf, 0x0b, 0xec, 0xd3, 0x6c, 0x1e, 0x5c, 0x11, 0x30, 0x65, 0xbb, 0x3a, 0x60,
+	0xc4, 0x3d, 0x0e, 0x65, 0x15, 0x16, 0xf4, 0xd1, 0x31, 0x5a, 0xca, 0x01, 0xaf, 0x5f, 0xec, 0x80,
+	0x8b, 0x17, 0x77, 0x33, 0x4a, 0xab, 0xee, 0x66, 0x94, 0x57, 0xd3, 0xcd, 0xb8, 0xb4, 0x69, 0xd0,
+	0xfa, 0x51, 0x0e, 0xea, 0x07, 0xd4, 0x8e, 0x82, 0xcf, 0xda, 0x61, 0x18, 0x05, 0x6f, 0xb0, 0x27,
+	0xeb, 0x71, 0x11, 0x9b, 0x26, 0xf5, 0xb8, 0x88, 0x4d, 0xd1, 0x77, 0x78, 0x9c, 0x44, 0x03, 0x6f,
+	0x2c, 0x04, 0x23, 0x97, 0x44, 0xa7, 0x12, 0xdb, 0x8c, 0xe7, 0xcc, 0xd4, 0xba, 0xb9, 0x55, 0xdf,
+	0xfc, 0xea, 0xab, 0xbe, 0x07, 0x50, 0xc1, 0x62, 0x3b, 0xd2, 0x74, 0x2c, 0x23, 0x31, 0xa0, 0x11,
+	0xdb, 0x8c, 0x1f, 0xce, 0x2d, 0x75, 0x38, 0x51, 0xcf, 0x65, 0xd2, 0x38, 0x2c, 0x26, 0xed, 0x0f,
+	0xa0, 0x8c, 0x39, 0x0e, 0x89, 0x64, 0xd5, 0xd4, 0x30, 0xe3, 0x31, 0xbf, 0x91, 0xc4, 0xae, 0x4b,
+	0x3f, 0x98, 0x00, 0xd0, 0x73, 0x30, 0xb0, 0xba, 0x0a, 0xda, 0x2c, 0x5c, 0x92, 0xbd, 0x67, 0xaf,
+	0x4d, 0x65, 0xef, 0x09, 0xee, 0xcc, 0x8d, 0xad, 0x2f, 0x78, 0x63, 0xdf, 0x84, 0x86, 0x18, 0xbd,
+	0x49, 0x02, 0x98, 0xa2, 0x50, 0xc8, 0xba, 0x06, 0x4b, 0x9d, 0x6c, 0xfd, 0xb8, 0x00, 0xc6, 0x2b,
+	0xd7, 0x23, 0x94, 0x05, 0xfe, 0x39, 0xc3, 0xb1, 0x76, 0xce, 0x70, 0xa4, 0x34, 0x29, 0xb7, 0x32,
+	0x4d, 0xfa, 0x1d, 0x28, 0x3b, 0x04, 0x3b, 0x9e, 0xeb, 0x6b, 0x3b, 0xb9, 0x60, 0x3a, 0xa0, 0xb1,
+	0x52, 0x09, 0x58, 0x61, 0x81, 0x04, 0x4c, 0x69, 0xee, 0xfa, 0x4d, 0xf4, 0x21, 0x57, 0x9a, 0x8d,
+	0x9e, 0xcf, 0xec, 0x4a, 0x8b, 0x64, 0x76, 0xe5, 0x2b, 0x66, 0x76, 0xad, 0x3f, 0x83, 0x46, 0x2c,
+	0x3b, 0x52, 0x1c, 0x17, 0x53, 0xab, 0x7d, 0x80, 0x91, 0xc6, 0xbb, 0xbc, 0x1d, 0x11, 0x93, 0x57,
+	0x8a, 0x91, 0xc2, 0x6b, 0xfd, 0x4d, 0x11, 0xaa, 0xc7, 0xe3, 0x5e, 0x22, 0x9b, 0xb3, 0xc9, 0x9a,
+	0x6a, 0x49, 0xe8, 0x5c, 0x4d, 0x0e, 0x32, 0xa5, 0x93, 0xfc, 0x4c, 0xe9, 0xe4, 0x06, 0x7a, 0x36,
+	0xe8, 0xb7, 0xa1, 0xec, 0xfa, 0x8c, 0x44, 0x6f, 0xb0, 0x17, 0x8b, 0xdc, 0x02, 0x21, 0x4c, 0x8c,
+	0xc4, 0x63, 0x10, 0x1e, 0x02, 0xd9, 0x53, 0xdb, 0x23, 0x54, 0x08, 0x54, 0xc1, 0x34, 0x46, 0x78,
+	0xd2, 0x15, 0x00, 0x1e, 0xde, 0xc8, 0x29, 0xcb, 0x0e, 0x46, 0xa1, 0x47, 0x18, 0x71, 0x54, 0x75,
+	0xa2, 0x21, 0xe1, 0x5d, 0x0d, 0xe6, 0x9f, 0xe2, 0x93, 0x09, 0xb3, 0x9c, 0xf1, 0x72, 0x42, 0x50,
+	0xe2, 0x58, 0xfb, 0x63, 0x82, 0x9e, 0x41, 0x75, 0x10, 0x61, 0x9b, 0x58, 0x21, 0x89, 0xdc, 0xc0,
+	0x51, 0xd9, 0xd6, 0x62, 0x21, 0x99, 0x40, 0x3c, 0x12, 0x78, 0xe8, 0x13, 0xa8, 0x87, 0x98, 0x8a,
+	0x0f, 0xb1, 0xa8, 0xcb, 0x5d, 0xdc, 0x32, 0xd5, 0x8d, 0x2a, 0xc7, 0xdd, 0x1f, 0x93, 0x63, 0x8e,
+	0x89, 0x76, 0x63, 0xdd, 0x97, 0xd5, 0xd7, 0xbb, 0x6f, 0xcf, 0xb6, 0x51, 0x5a, 0x4e, 0x2e, 0x6b,
+	0xc7, 0x57, 0x2f, 0x6b, 0xc7, 0xd7, 0x66, 0xda, 0xf1, 0x1f, 0x02, 0xf2, 0xf8, 0x57, 0x67, 0x05,
+	0xbe, 0x2e, 0xce, 0x7a, 0x83, 0xcf, 0x1c, 0xa7, 0x85, 0xbe, 0x0b, 0xa0, 0xeb, 0x37, 0xcb, 0x96,
+	0x28, 0x14, 0x5e, 0x9b, 0xf1, 0x28, 0xcb, 0xe6, 0x49, 0xb2, 0xc7, 0x95, 0xb7, 0x37, 0x15, 0x0d,
+	0x6e, 0xc3, 0xac, 0xc4, 0xb0, 0xce, 0xb4, 0xf5, 0xef, 0x25, 0x28, 0xbd, 0xc2, 0xbe, 0x83, 0x19,
+	0x99, 0x57, 0x36, 0xb4, 0xc7, 0x94, 0x05, 0xa3, 0x58, 0x29, 0xe2, 0xf1, 0xa5, 0x7a, 0x11, 0x42,
+	0x3d, 0x24, 0x91, 0x65, 0x0f, 0x71, 0x34, 0x20, 0x3c, 0x00, 0x5f, 0x81, 0x7e, 0x54, 0x43, 0x12,
+	0x75, 0x05, 0x83, 0x57, 0x78, 0xc2, 0xad, 0xa6, 0x94, 0x29, 0xcb, 0xc6, 0xe1, 0x2a, 0xca, 0x85,
+	0x92, 0x7a, 0x17, 0x87, 0xe8, 0x7b, 0x50, 0x54, 0xe2, 0x5b, 0x5c, 0x5c, 0x7c, 0x15, 0x0a, 0xb7,
+	0xa5, 0xea, 0x3b, 0x29, 0xc3, 0x91, 0x8e, 0x2f, 0x17, 0xb4, 0xa5, 0x12, 0xf3, 0x98, 0x23, 0xa2,
+	0x51, 0x42, 0x48, 0x14, 0x71, 0xae, 0x3f, 0x8e, 0xd4, 0xec, 0x44, 0x29, 0x27, 0xfb, 0xd4, 0xc2,
+	0xb8, 0xda, 0x53, 0x8b, 0x0f, 0x62, 0x55, 0x93, 0x6d, 0x93, 0x5b, 0x6f, 0xcf, 0xb6, 0x6b, 0x4a,
+	0xf6, 0x2e, 0xd3, 0xb2, 0xca, 0xac, 0x96, 0xc5, 0xb5, 0xe8, 0x70, 0xcc, 0xa5, 0x38, 0x4e, 0xed,
+	0xae, 0xbb, 0x16, 0x7d, 0x24, 0xc8, 0x0b, 0x2d, 0x92, 0xa2, 0x2c, 0x8b, 0xb7, 0xb2, 0x96, 0x52,
+	0x91, 0xb0, 0xae, 0x2a, 0xe1, 0xde, 0xb8, 0x6e, 0xbf, 0xc7, 0x23, 0xbd, 0x37, 0xc1, 0x69, 0x5a,
+	0xb3, 0x0d, 0x05, 0xe9, 0x4c, 0x5b, 0x3f, 0x2a, 0x42, 0xf1, 0x98, 0x45, 0x04, 0x8f, 0x16, 0x74,
+	0x74, 0xff, 0x1f, 0x6a, 0x92, 0xc7, 0xd0, 0x10, 0xb5, 0x22, 0x6e, 0x3d, 0x28, 0xb1, 0x03, 0xdf,
+	0x51, 0x21, 0xec, 0x52, 0x3d, 0xb1, 0x1a, 0xa7, 0x71, 0x44, 0xa2, 0x63, 0x41, 0x01, 0x59, 0x70,
+	0x07, 0xdb, 0x76, 0x34, 0x16, 0xa7, 0x6d, 0xd9, 0x3c, 0x3f, 0x0f, 0x03, 0x57, 0xe7, 0x7d, 0xcb,
+	0x91, 0xbe, 0xad, 0x28, 0xb5, 0x59, 0x37, 0xa6, 0x83, 0x5e, 0x41, 0x23, 0xa1, 0x2a, 0x63, 0xa4,
+	0x65, 0xf4, 0xba, 0x9e, 0x20, 0x8b, 0xfa, 0xde, 0x10, 0x8c, 0xcf, 0x5c, 0x36, 0x74, 0x22, 0xfc,
+	0x99, 0xbf, 0x02, 0xbd, 0x4e, 0x88, 0xa3, 0xa7, 0xb1, 0x42, 0xca, 0xc6, 0xe8, 0xc6, 0xdb, 0xb3,
+	0xed, 0xaa, 0x14, 0x9a, 0xcb, 0xf4, 0x11, 0x66, 0xf5, 0x31, 0x2b, 0xcf, 0x95, 0xab, 0xc9, 0xf3,
+	0xf3, 0xb4, 0xaf, 0xc2, 0x6c, 0xc9, 0x67, 0x68, 0x1a, 0x73, 0x8e, 0xd3, 0xab, 0x9d, 0x77, 0x7a,
+	0x7f, 0x5f, 0x82, 0xcd, 0x8e, 0x1b, 0xbf, 0x72, 0xc0, 0xde, 0x45, 0x05, 0xfc, 0x7b, 0x50, 0x12,
+	0x99, 0xb0, 0x85, 0x75, 0xfd, 0x42, 0x0c, 0xdb, 0xc9, 0x44, 0x4f, 0xbf, 0x31, 0x12, 0xc3, 0x0e,
+	0x1a, 0x80, 0xa1, 0xa4, 0xd9, 0xc2, 0xab, 0x78, 0x4b, 0xa2, 0x88, 0xb7, 0xd3, 0x8c, 0x7a, 0x2b,
+	0xf0, 0x78, 0x9a, 0x91, 0xd8, 0x91, 0xaa, 0xb5, 0x5b, 0x78, 0x05, 0x09, 0x49, 0x59, 0x11, 0x6f,
+	0xa7, 0x19, 0xf5, 0x56, 0x50, 0x79, 0xd1, 0x8c, 0x3a, 0x49, 0x55, 0xbc, 0x9c, 0xae, 0x8a, 0xff,
+	0xda, 0x8c, 0x36, 0x6c, 0xbd, 0x3d, 0xdb, 0x7e, 0x30, 0x4f, 0x4a, 0x66, 0x74, 0x83, 0x47, 0xd0,
+	0x43, 0xec, 0x79, 0xc4, 0x1f, 0xc4, 0x91, 0xad, 0x2c, 0xff, 0x37, 0x62, 0xb8, 0x0a, 0x5c, 0x55,
+	0x9b, 0xc0, 0xf5, 0x07, 0x96, 0xac, 0xb6, 0x54, 0xd4, 0x53, 0x17, 0x09, 0x3c, 0x12, 0x45, 0x97,
+	0x8f, 0xe1, 0x4e, 0x42, 0x8f, 0xf8, 0x0e, 0xcd, 0xd6, 0xf8, 0x6f, 0xc7, 0x93, 0x07, 0xbe, 0x43,
+	0x55, 0x8e, 0x76, 0xae, 0xd7, 0x51, 0xfb, 0xfa, 0x5e, 0x47, 0xfd, 0xba, 0x7a, 0x1d, 0x8d, 0xaf,
+	0xef, 0x75, 0x6c, 0x5c, 0xad, 0xd7, 0xd1, 0xfa, 0x79, 0x0e, 0xaa, 0xa9, 0x53, 0x17, 0x8f, 0x99,
+	0x6c, 0x39, 0x4e, 0xb2, 0x45, 0x43, 0x41, 0x0e, 0x9d, 0xac, 0xac, 0xe6, 0x6e, 0x4a, 0x56, 0xf3,
+	0x37, 0x21, 0xab, 0x85, 0xb4, 0xac, 0x6e, 0x43, 0x85, 0xba, 0x03, 0x1f, 0xb3, 0x71, 0xc4, 0x77,
+	0x2a, 0x8b, 0x9b, 0x10, 0x83, 0xda, 0xd9, 0x05, 0x3d, 0x55, 0xe2, 0x4c, 0x16, 0x74, 0x5a, 0xff,
+	0x96, 0x87, 0xc2, 0x8b, 0x93, 0x97, 0xdd, 0x6b, 0xea, 0x59, 0xde, 0x44, 0x2a, 0xfc, 0x10, 0x8c,
+	0x21, 0xa6, 0x43, 0xcb, 0x0b, 0xec, 0x53, 0xb5, 0xe5, 0x32, 0x07, 0xbc, 0x0c, 0xec, 0xd3, 0x19,
+	0xc1, 0x28, 0xce, 0x0a, 0xc6, 0x53, 0xd8, 0x70, 0x7d, 0x3b, 0x18, 0x71, 0xd5, 0x1b, 0x32, 0xcf,
+	0xe6, 0x8b, 0x64, 0x9a, 0x5b, 0xd7, 0xf0, 0x17, 0xcc, 0xb3, 0x0f, 0x1d, 0xf4, 0x18, 0xea, 0x5c,
+	0x64, 0x83, 0x31, 0xcb, 0xf6, 0x0d, 0x6b, 0x0a, 0xaa, 0x04, 0xfc, 0xc9, 0x8c, 0xb5, 0xa8, 0xbf,
+	0x3d, 0xdb, 0x06, 0x7e, 0xa0, 0x33, 0xd6, 0xe1, 0x01, 0x94, 0xc3, 0x88, 0xb8, 0x23, 0x3c, 0xd0,
+	0x8e, 0x33, 0x1e, 0x2f, 0xfa, 0x40, 0x7b, 0x4e, 0x75, 0xae, 0x3a, 0xb7, 0x3a, 0xf7, 0x45, 0x1e,
+	0x6a, 0xa2, 0x0d, 0x43, 0x1c, 0xf9, 0xd2, 0x72, 0xe1, 0xb2, 0xe5, 0x85, 0xaf, 0x43, 0x6e, 0xe2,
+	0xf5, 0x65, 0xb6, 0x1b, 0x5f, 0xb8, 0x52, 0x37, 0x3e, 0x15, 0xcb, 0xac, 0x27, 0xb1, 0x8c, 0x3c,
+	0x85, 0x99, 0x1b, 0x79, 0x04, 0xb5, 0x7e, 0x14, 0x7c, 0x4e, 0x7c, 0x4b, 0x3d, 0x12, 0x56, 0xef,
+	0x0d, 0x25, 0xd0, 0x94, 0x4f, 0x85, 0xcf, 0x5f, 0x4d, 0xe9, 0xc2, 0xab, 0x11, 0x9f, 0x30, 0xd3,
+	0x65, 0xae, 0x6b, 0xb0, 0xba, 0x9a, 0xbf, 0x2d, 0x42, 0xf1, 0x08, 0x47, 0x78, 0x44, 0xd1, 0x1e,
+	0x6c, 0x3a, 0xa4, 0x8f, 0xc7, 0x1e, 0xb3, 0x32, 0xcd, 0xd1, 0x35, 0x51, 0x14, 0xbe, 0xa5, 0xe6,
+	0x9e, 0x25, 0x3d, 0x52, 0xfe, 0xc1, 0x84, 0x27, 0x1f, 0x9e, 0x47, 0x6c, 0x16, 0x68, 0xc5, 0xac,
+	0xf6, 0x09, 0xe9, 0x6a, 0x18, 0xfa, 0x73, 0xb8, 0x93, 0x6d, 0xa4, 0xae, 0xae, 0xf2, 0x7e, 0x3b,
+	0xd3, 0x4f, 0x55, 0xc5, 0x44, 0xce, 0x3f, 0xd3, 0x55, 0x5d, 0xdd, 0x43, 0xa1, 0xdb, 0x99, 0xe6,
+	0xaa, 0xe2, 0xff, 0x5d, 0xb8, 0xaf, 0x4f, 0x95, 0x88, 0xda, 0xa2, 0x25, 0x12, 0x4f, 0x1c, 0xd7,
+	0xc1, 0xf3, 0xe6, 0x3d, 0xb5, 0x40, 0xd6, 0x1e, 0x0f, 0xe2, 0x69, 0xee, 0x71, 0xf9, 0xb7, 0x9f,
+	0xc7, 0x93, 0x45, 0x70, 0xce, 0xef, 0x1c, 0xce, 0x77, 0xe0, 0x2e, 0x3f, 0x6f, 0x6d, 0x73, 0x52,
+	0x48, 0x52, 0x50, 0x36, 0x47, 0xae, 0xaf, 0x3c, 0xd7, 0x0c, 0x16, 0x9e, 0xcc, 0xc3, 0x2a, 0x2b,
+	0x2c, 0x3c, 0x39, 0x8f, 0xf5, 0xbe, 0xec, 0x58, 0xcb, 0x7e, 0x26, 0x75, 0x3f, 0x97, 0x2d, 0x9d,
+	0x9a, 0x59, 0x1d, 0xe1, 0x89, 0x7c, 0xfa, 0xe5, 0x7e, 0x2e, 0xba, 0xfa, 0x7c, 0xd5, 0xa7, 0x63,
+	0x12, 0x4d, 0x2d, 0xcf, 0x1d, 0xb9, 0xf2, 0x15, 0x42, 0x4d, 0x34, 0xa3, 0x7f, 0xc0, 0xa1, 0x2f,
+	0x39, 0x90, 0x9f, 0x94, 0xeb, 0x53, 0x86, 0x79, 0xae, 0xa2, 0x7a, 0x7a, 0x34, 0x6e, 0x4c, 0x57,
+	0x44, 0xcb, 0xf6, 0x9e, 0x5a, 0xa0, 0x7b, 0x7e, 0x54, 0xf7, 0xa8, 0x1f, 0x43, 0x5d, 0x9f, 0x92,
+	0x42, 0xa8, 0x0a, 0x84, 0x9a, 0x84, 0xea, 0x65, 0x32, 0x24, 0xe2, 0xbb, 0x48, 0x28, 0xcb, 0x87,
+	0x3c, 0x0d, 0x0d, 0x57, 0x4b, 0x5b, 0x7f, 0xb5, 0x0e, 0xd5, 0xd7, 0x84, 0x31, 0xd7, 0x1f, 0x88,
+	0x8a, 0xe4, 0xbc, 0x22, 0x54, 0x10, 0x92, 0x08, 0x27, 0x82, 0x1f, 0x8f, 0x51, 0x0b, 0xaa, 0x3c,
+	0x8e, 0x72, 0x6d, 0x37, 0xc4, 0x3e, 0x93, 0x2f, 0xd7, 0x0c, 0x33, 0x03, 0x4b, 0x9e, 0xe1, 0x16,
+	0xd2, 0xcf, 0x70, 0xdb, 0x60, 0x88, 0x30, 0x43, 0xd4, 0x33, 0x96, 0x7a, 0x48, 0x2b, 0xd1, 0xda,
+	0x2c, 0x55, 0x39, 0x2c, 0x26, 0x95, 0xc3, 0xf4, 0x56, 0xce, 0xc7, 0x89, 0x41, 0xcf, 0x73, 0x07,
+	0xe2, 0x4e, 0xad, 0xf4, 0x3b, 0xb0, 0x46, 0x02, 0x97, 0xe5, 0x84, 0x53, 0xa8, 0x0c, 0xa2, 0x80,
+	0x52, 0x4b, 0x94, 0x21, 0x56, 0x90, 0x04, 0x82, 0x20, 0x7f, 0xc2, 0xa9, 0x2f, 0xfa, 0xa0, 0xec,
+	0x7c, 0xb7, 0x00, 0x16, 0xe9, 0x16, 0x54, 0xae, 0xfa, 0x0e, 0xec, 0x31, 0xd4, 0xfb, 0xd8, 0xf5,
+	0x78, 0xfc, 0xa2, 0xec, 0xb4, 0xac, 0xb6, 0xd6, 0x14, 0x54, 0x19, 0xea, 0x7d, 0x30, 0x62, 0x29,
+	0x6e, 0xd6, 0x44, 0x6f, 0x60, 0x67, 0x6e, 0x6f, 0xe0, 0x35, 0x89, 0xc5, 0x59, 0xb7, 0xcd, 0x62,
+	0xc4, 0xd6, 0xdf, 0xe5, 0xe0, 0x96, 0xba, 0xba, 0xef, 0xc7, 0x77, 0x81, 0xee, 0x43, 0x59, 0xd4,
+	0xc0, 0x13, 0xc7, 0x59, 0x12, 0xe3, 0x43, 0x47, 0x49, 0x69, 0x2e, 0x1d, 0x35, 0x39, 0xa4, 0xc7,
+	0x65, 0x54, 0xa5, 0x83, 0x72, 0x24, 0x4a, 0xa8, 0xe2, 0xed, 0x70, 0x10, 0x29, 0x01, 0x8c, 0xc7,
+	0x37, 0xf2, 0xb6, 0x3e, 0x93, 0xb8, 0x17, 0x67, 0x13, 0xf7, 0xc5, 0xbc, 0x5c, 0xeb, 0xc7, 0x6b,
+	0x50, 0x49, 0x1d, 0x1f, 0x42, 0x50, 0xe8, 0x47, 0xc1, 0x48, 0x35, 0xfc, 0xc4, 0xdf, 0xfc, 0x40,
+	0x58, 0xa0, 0x14, 0x34, 0xc7, 0x82, 0x1b, 0x09, 0x1c, 0xce, 0x45, 0x37, 0x85, 0xf3, 0xd1, 0x4d,
+	0xeb, 0x2f, 0x8b, 0xd0, 0x50, 0x57, 0x7b, 0xc4, 0x13, 0x5a, 0x7e, 0xb1, 0x3b, 0x50, 0x49, 0xd9,
+	0x08, 0xdd, 0xb8, 0x4c, 0x81, 0x50, 0x00, 0x35, 0xa9, 0x81, 0x21, 0x9e, 0x72, 0x43, 0xb5, 0x82,
+	0x64, 0xa2, 0x2a, 0x18, 0x1c, 0x49, 0xfa, 0x68, 0x0c, 0x1b, 0x92, 0x61, 0x44, 0x6c, 0xe2, 0xbe,
+	0x11, 0x3c, 0x57, 0xd0, 0x34, 0x17, 0x3c, 0xcc, 0x98, 0x05, 0x77, 0xdb, 0x3d, 0xd7, 0xc3, 0x8c,
+	0x44, 0xd8, 0xb3, 0x7c, 0xc2, 0xe2, 0xfd, 0xae, 0xc0, 0x6d, 0xc7, 0x8c, 0x5e, 0x13, 0xa6, 0xb7,
+	0xfd, 0xd7, 0x6b, 0xd0, 0xcc, 0x7e, 0x40, 0x6a, 0xff, 0xd7, 0xaf, 0x16, 0x77, 0xd3, 0xdf, 0x90,
+	0x3a, 0x86, 0x53, 0xa8, 0xa4, 0x37, 0x7f, 0xfd, 0x55, 0x0e, 0xf0, 0x93, 0x3d, 0x7f, 0x0a, 0xf5,
+	0x99, 0x8d, 0x5e, 0x7f, 0xb1, 0xa3, 0xe6, 0xa7, 0xf7, 0xd7, 0xfa, 0x9f, 0x2a, 0x54, 0x9f, 0x13,
+	0x9f, 0x50, 0x97, 0xca, 0x3c, 0xfa, 0x37, 0xa0, 0x18, 0x8a, 0x70, 0x54, 0x3d, 0xab, 0x7f, 0x78,
+	0xc1, 0xaf, 0x12, 0xf9, 0x12, 0x65, 0x2e, 0x15, 0x02, 0x7a, 0x0e, 0x95, 0x64, 0x89, 0xee, 0xc7,
+	0x6e, 0x7f, 0xcd, 0x0f, 0x13, 0x15, 0x8d, 0x34, 0x26, 0xda, 0x07, 0xf9, 0x9b, 0x1a, 0x22, 0x1d,
+	0x77, 0xe5, 0xe3, 0xf7, 0xe7, 0x12, 0x99, 0x79, 0xdc, 0xae, 0x7f, 0xb1, 0xa0, 0x50, 0xd1, 0x01,
+	0x94, 0x75, 0x4c, 0x71, 0xe9, 0xcb, 0x89, 0xec, 0x53, 0x5d, 0x45, 0x25, 0x46, 0x45, 0xcf, 0xc1,
+	0xd0, 0x49, 0x0f, 0x4f, 0x21, 0x2e, 0xa6, 0x93, 0x7d, 0x10, 0xa9, 0x5d, 0x49, 0x8c, 0x8b, 0x3e,
+	0x04, 0x24, 0xba, 0xa4, 0x59, 0xcb, 0x24, 0x13, 0xd2, 0x0d, 0x3e, 0x93, 0x69, 0x05, 0xb4, 0xa0,
+	0x26, 0x56, 0xc7, 0x3f, 0x36, 0x92, 0x11, 0x41, 0x85, 0x03, 0x3b, 0xea, 0x77, 0xa8, 0x4f, 0xa0,
+	0x21, 0xd6, 0xa4, 0xf2, 0x5b, 0x59, 0xb8, 0x12, 0xa8, 0xdd, 0x38, 0xc7, 0xfd, 0x63, 0xb8, 0xad,
+	0x82, 0x33, 0x9c, 0xbc, 0x5c, 0xe1, 0xf9, 0x29, 0xdf, 0xcc, 0x93, 0xcb, 0x9e, 0x93, 0x24, 0xcb,
+	0xd5, 0x7e, 0x10, 0x99, 0x9d, 0xa0, 0xe8, 0xf7, 0xe1, 0x56, 0xdc, 0x4e, 0x57, 0xb1, 0x32, 0x6d,
+	0xc2, 0x25, 0x17, 0x37, 0xd3, 0xec, 0x57, 0xa4, 0x37, 0x46, 0x59, 0x30, 0x45, 0xaf, 0xa0, 0x46,
+	0x53, 0x0d, 0x57, 0xda, 0xac, 0x08, 0xa2, 0xf3, 0x7f, 0x67, 0x9b, 0x6e, 0xcd, 0x2a, 0x8a, 0x59,
+	0x6c, 0xf4, 0xcb, 0xb0, 0x29, 0x2f, 0x20, 0x05, 0xe5, 0x67, 0x56, 0x15, 0x67, 0x26, 0x2e, 0x27,
+	0x4d, 0xe4, 0xd0, 0x41, 0x7d, 0xb8, 0xdb, 0x4b, 0xd7, 0xf9, 0xac, 0x58, 0xa0, 0x64, 0x40, 0xf1,
+	0xc1, 0x7c, 0xb9, 0x9c, 0x53, 0x1a, 0x54, 0x5f, 0x74, 0xa7, 0x37, 0x67, 0x8e, 0xa2, 0x36, 0xbc,
+	0x27, 0x2f, 0x7b, 0x1e, 0xb3, 0xa4, 0x61, 0xf4, 0x40, 0x5c, 0xfe, 0x1c, 0x0a, 0x87, 0x0e, 0xfa,
+	0x55, 0x58, 0x1f, 0x32, 0xcf, 0xa6, 0xcd, 0x86, 0xf8, 0xb2, 0xfb, 0x73, 0xbf, 0xec, 0xc5, 0xc9,
+	0xcb, 0xae, 0xfe, 0x41, 0xa6, 0x58, 0x8d, 0x76, 0xa0, 0x2a, 0x38, 0xeb, 0xd2, 0x87, 0xfc, 0xa5,
+	0x33, 0x70, 0x98, 0x2a, 0x7b, 0xfc, 0x00, 0x1a, 0x8e, 0x2c, 0x1d, 0x70, 0x2b, 0x18, 0x8c, 0x19,
+	0x6d, 0xde, 0x12, 0x2c, 0x5a, 0x73, 0x59, 0x64, 0xca, 0x0c, 0x8a, 0x57, 0xdd, 0x49, 0x03, 0x29,
+	0x7a, 0x2d, 0xec, 0x9c, 0x78, 0x66, 0xac, 0x5e, 0x1f, 0xa0, 0x4b, 0x2e, 0x36, 0x1d, 0x39, 0xeb,
+	0x8b, 0xf5, 0x53, 0x30, 0xca, 0xe5, 0x5b, 0xd3, 0x4b, 0x02, 0x66, 0xfd, 0xcb, 0xe8, 0x27, 0x97,
+	0x11, 0x4d, 0x62, 0x3a, 0x2d, 0xdf, 0xfe, 0xec, 0x04, 0x45, 0x1f, 0xc1, 0x1d, 0x71, 0x46, 0x99,
+	0x6f, 0xe6, 0x87, 0xb5, 0x99, 0x08, 0x4e, 0xfa, 0x23, 0xe5, 0xa1, 0xd1, 0xd0, 0x73, 0x99, 0xc5,
+	0xd4, 0x2f, 0x6a, 0x69, 0xf3, 0xce, 0x25, 0x87, 0x96, 0xf9, 0xf1, 0xad, 0x3e, 0x34, 0x9a, 0x06,
+	0x52, 0xf4, 0x5b, 0x50, 0x1e, 0xc9, 0x96, 0x28, 0x6d, 0xde, 0x15, 0xb4, 0xde, 0x9d, 0xaf, 0x5c,
+	0x72, 0x91, 0xb6, 0x63, 0x1a, 0x27, 0x36, 0x16, 0x0a, 0xc0, 0xbf, 0xff, 0x5e, 0x62, 0x2c, 0x14,
+	0x96, 0xf8, 0x49, 0x69, 0x89, 0x8a, 0x4e, 0x0f, 0x6d, 0x36, 0x05, 0x9b, 0x87, 0x17, 0xfc, 0x5a,
+	0x8c, 0xaf, 0xd1, 0x36, 0x57, 0x61, 0xf0, 0x84, 0x54, 0xaa, 0x98, 0x18, 0x73, 0x1e, 0xf7, 0x65,
+	0xe4, 0x25, 0x94, 0x4b, 0x00, 0x0f, 0x9d, 0xce, 0xc1, 0x4f, 0xbe, 0xdc, 0x5a, 0xfb, 0xe9, 0x97,
+	0x5b, 0x6b, 0xff, 0xfd, 0xe5, 0xd6, 0xda, 0x0f, 0xbf, 0xda, 0x7a, 0xe7, 0xa7, 0x5f, 0x6d, 0xbd,
+	0xf3, 0x9f, 0x5f, 0x6d, 0xbd, 0xf3, 0x87, 0xdf, 0x4a, 0xb9, 0xb1, 0xf8, 0xff, 0xe0, 0xb0, 0x83,
+	0x88, 0xec, 0x4d, 0xd2, 0xff, 0x15, 0x87, 0xf0, 0x67, 0xbd, 0xa2, 0xc8, 0x19, 0x7e, 0xe5, 0xff,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x48, 0xce, 0xe3, 0xae, 0x43, 0x00, 0x00,
+}
+
+func (m *Settlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Settlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Settlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.FxConversion != nil {
+		{
+			size, err := m.FxConversion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettlement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSettlement(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x7a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSettlement(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x72
+	if m.SettledHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSettlement(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x62
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintSettlement(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.RecipientAmount.Size()
+		i -= size
+		if _, err := m.RecipientAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Bps != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatementEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatementEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatementEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x50
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintSettlement(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EntryType) > 0 {
+		i -= len(m.EntryType)
+		copy(dAtA[i:], m.EntryType)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.EntryType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SettlementType) > 0 {
+		i -= len(m.SettlementType)
+		copy(dAtA[i:], m.SettlementType)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.SettlementType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Statement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Statement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Statement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GeneratedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GeneratedTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintSettlement(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x72
+	if m.GeneratedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.GeneratedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.TotalDebits.Size()
+		i -= size
+		if _, err := m.TotalDebits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TotalCredits.Size()
+		i -= size
+		if _, err := m.TotalCredits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ClosingBalance.Size()
+		i -= size
+		if _, err := m.ClosingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.OpeningBalance.Size()
+		i -= size
+		if _, err := m.OpeningBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintSettlement(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FromTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintSettlement(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	if m.ToHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FXConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FXConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FXConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FxRate.Size()
+		i -= size
+		if _, err := m.FxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OracleRate.Size()
+		i -= size
+		if _, err := m.OracleRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PaymentAmount.Size()
+		i -= size
+		if _, err := m.PaymentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintSettlement(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x7a
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettleBy, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettleBy):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintSettlement(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x72
+	if m.AutoSettle {
+		i--
+		if m.AutoSettle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintSettlement(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x62
+	if m.SettledHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintSettlement(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x52
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Count != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SettlementIds) > 0 {
+		dAtA28 := make([]byte, len(m.SettlementIds)*10)
+		var j27 int
+		for _, num := range m.SettlementIds {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintSettlement(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoutingFeeBps != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.RoutingFeeBps))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintSettlement(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x5a
+	if m.ClosedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ClosedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintSettlement(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x4a
+	if m.OpenedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.OpenedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsOpen {
+		i--
+		if m.IsOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerchantConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerchantConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerchantConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementDenom) > 0 {
+		i -= len(m.SettlementDenom)
+		copy(dAtA[i:], m.SettlementDenom)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.SettlementDenom)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n34, err34 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BatchMaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BatchMaxAge):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintSettlement(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x62
+	n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RegisteredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintSettlement(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.WebhookUrl) > 0 {
+		i -= len(m.WebhookUrl)
+		copy(dAtA[i:], m.WebhookUrl)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.WebhookUrl)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n36, err36 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SettlementDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintSettlement(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.BatchThreshold.Size()
+		i -= size
+		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BatchEnabled {
+		i--
+		if m.BatchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxSettlement.Size()
+		i -= size
+		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinSettlement.Size()
+		i -= size
+		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.FeeRateBps != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.FeeRateBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckoutItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckoutItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckoutItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.UnitPrice.Size()
+		i -= size
+		if _, err := m.UnitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Quantity != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProductId) > 0 {
+		i -= len(m.ProductId)
+		copy(dAtA[i:], m.ProductId)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.ProductId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintSettlement(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n44, err44 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ApprovedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ApprovedAt):])
+	if err44 != nil {
+		return 0, err44
+	}
+	i -= n44
+	i = encodeVarintSettlement(dAtA, i, uint64(n44))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RecipientAmount.Size()
+		i -= size
+		if _, err := m.RecipientAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Party) > 0 {
+		i -= len(m.Party)
+		copy(dAtA[i:], m.Party)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Party)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowArbitration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowArbitration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowArbitration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arbiters[iNdEx])
+			copy(dAtA[i:], m.Arbiters[iNdEx])
+			i = encodeVarintSettlement(dAtA, i, uint64(len(m.Arbiters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n46, err46 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
+	if err46 != nil {
+		return 0, err46
+	}
+	i -= n46
+	i = encodeVarintSettlement(dAtA, i, uint64(n46))
+	i--
+	dAtA[i] = 0x42
+	if m.SettledHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n49, err49 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err49 != nil {
+		return 0, err49
+	}
+	i -= n49
+	i = encodeVarintSettlement(dAtA, i, uint64(n49))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MilestoneEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MilestoneEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MilestoneEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	n51, err51 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err51 != nil {
+		return 0, err51
+	}
+	i -= n51
+	i = encodeVarintSettlement(dAtA, i, uint64(n51))
+	i--
+	dAtA[i] = 0x7a
+	if m.LastSettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.LastSettlementId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n52, err52 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PastDueSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PastDueSince):])
+	if err52 != nil {
+		return 0, err52
+	}
+	i -= n52
+	i = encodeVarintSettlement(dAtA, i, uint64(n52))
+	i--
+	dAtA[i] = 0x52
+	n53, err53 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod):])
+	if err53 != nil {
+		return 0, err53
+	}
+	i -= n53
+	i = encodeVarintSettlement(dAtA, i, uint64(n53))
+	i--
+	dAtA[i] = 0x4a
+	n54, err54 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextDue, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDue):])
+	if err54 != nil {
+		return 0, err54
+	}
+	i -= n54
+	i = encodeVarintSettlement(dAtA, i, uint64(n54))
+	i--
+	dAtA[i] = 0x42
+	if m.CyclesCompleted != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CyclesCompleted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxCycles != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxCycles))
+		i--
+		dAtA[i] = 0x30
+	}
+	n55, err55 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err55 != nil {
+		return 0, err55
+	}
+	i -= n55
+	i = encodeVarintSettlement(dAtA, i, uint64(n55))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Mandate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mandate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Mandate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	n57, err57 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err57 != nil {
+		return 0, err57
+	}
+	i -= n57
+	i = encodeVarintSettlement(dAtA, i, uint64(n57))
+	i--
+	dAtA[i] = 0x7a
+	if m.LastSettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.LastSettlementId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ChargeCount != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ChargeCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.TotalPulled.Size()
+		i -= size
+		if _, err := m.TotalPulled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n59, err59 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err59 != nil {
+		return 0, err59
+	}
+	i -= n59
+	i = encodeVarintSettlement(dAtA, i, uint64(n59))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PeriodSpent.Size()
+		i -= size
+		if _, err := m.PeriodSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n61, err61 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err61 != nil {
+		return 0, err61
+	}
+	i -= n61
+	i = encodeVarintSettlement(dAtA, i, uint64(n61))
+	i--
+	dAtA[i] = 0x3a
+	n62, err62 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err62 != nil {
+		return 0, err62
+	}
+	i -= n62
+	i = encodeVarintSettlement(dAtA, i, uint64(n62))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PeriodCap.Size()
+		i -= size
+		if _, err := m.PeriodCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PerChargeMax.Size()
+		i -= size
+		if _, err := m.PerChargeMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.CancelledBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	n65, err65 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CancelledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt):])
+	if err65 != nil {
+		return 0, err65
+	}
+	i -= n65
+	i = encodeVarintSettlement(dAtA, i, uint64(n65))
+	i--
+	dAtA[i] = 0x62
+	n66, err66 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err66 != nil {
+		return 0, err66
+	}
+	i -= n66
+	i = encodeVarintSettlement(dAtA, i, uint64(n66))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.Withdrawn.Size()
+		i -= size
+		if _, err := m.Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n68, err68 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CheckpointTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CheckpointTime):])
+	if err68 != nil {
+		return 0, err68
+	}
+	i -= n68
+	i = encodeVarintSettlement(dAtA, i, uint64(n68))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AccruedAtCheckpoint.Size()
+		i -= size
+		if _, err := m.AccruedAtCheckpoint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RatePerSecond.Size()
+		i -= size
+		if _, err := m.RatePerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidirectionalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidirectionalChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidirectionalChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n70, err70 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime):])
+	if err70 != nil {
+		return 0, err70
+	}
+	i -= n70
+	i = encodeVarintSettlement(dAtA, i, uint64(n70))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.ClosedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ClosedHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	n71, err71 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime):])
+	if err71 != nil {
+		return 0, err71
+	}
+	i -= n71
+	i = encodeVarintSettlement(dAtA, i, uint64(n71))
+	i--
+	dAtA[i] = 0x72
+	if m.OpenedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.OpenedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ChallengeEndsHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ChallengeEndsHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ClosingParty) > 0 {
+		i -= len(m.ClosingParty)
+		copy(dAtA[i:], m.ClosingParty)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.ClosingParty)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ChallengePeriod != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ChallengePeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.BalanceB.Size()
+		i -= size
+		if _, err := m.BalanceB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BalanceA.Size()
+		i -= size
+		if _, err := m.BalanceA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DepositB.Size()
+		i -= size
+		if _, err := m.DepositB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DepositA.Size()
+		i -= size
+		if _, err := m.DepositA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PartyB) > 0 {
+		i -= len(m.PartyB)
+		copy(dAtA[i:], m.PartyB)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.PartyB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartyA) > 0 {
+		i -= len(m.PartyA)
+		copy(dAtA[i:], m.PartyA)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.PartyA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignatureB) > 0 {
+		i -= len(m.SignatureB)
+		copy(dAtA[i:], m.SignatureB)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.SignatureB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignatureA) > 0 {
+		i -= len(m.SignatureA)
+		copy(dAtA[i:], m.SignatureA)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.SignatureA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BalanceB.Size()
+		i -= size
+		if _, err := m.BalanceB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BalanceA.Size()
+		i -= size
+		if _, err := m.BalanceA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChannelId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ResolvedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IncomingHtlcId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.IncomingHtlcId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ReleasedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FrozenReason) > 0 {
+		i -= len(m.FrozenReason)
+		copy(dAtA[i:], m.FrozenReason)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.FrozenReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n79, err79 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt):])
+	if err79 != nil {
+		return 0, err79
+	}
+	i -= n79
+	i = encodeVarintSettlement(dAtA, i, uint64(n79))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelsEnabled {
+		i--
+		if m.ChannelsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.EscrowEnabled {
+		i--
+		if m.EscrowEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.InstantTransfersEnabled {
+		i--
+		if m.InstantTransfersEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxQueryLimit != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxQueryLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxChannelExpiration != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxChannelExpiration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinChannelExpiration != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MinChannelExpiration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxEscrowExpiration != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.MaxEscrowExpiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DefaultEscrowExpiration != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.DefaultEscrowExpiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxSettlementAmount.Size()
+		i -= size
+		if _, err := m.MaxSettlementAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinSettlementAmount.Size()
+		i -= size
+		if _, err := m.MinSettlementAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DefaultFeeRateBps != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.DefaultFeeRateBps))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NettingCycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NettingCycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NettingCycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n83, err83 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SettledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime):])
+	if err83 != nil {
+		return 0, err83
+	}
+	i -= n83
+	i = encodeVarintSettlement(dAtA, i, uint64(n83))
+	i--
+	dAtA[i] = 0x5a
+	if m.SettledHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.GrossTotal.Size()
+		i -= size
+		if _, err := m.GrossTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.ObligationCount != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.ObligationCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n85, err85 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosesAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosesAt):])
+	if err85 != nil {
+		return 0, err85
+	}
+	i -= n85
+	i = encodeVarintSettlement(dAtA, i, uint64(n85))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintSettlement(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NettingObligation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NettingObligation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NettingObligation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creditor) > 0 {
+		i -= len(m.Creditor)
+		copy(dAtA[i:], m.Creditor)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Creditor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Debtor) > 0 {
+		i -= len(m.Debtor)
+		copy(dAtA[i:], m.Debtor)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Debtor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CycleId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.CycleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NettingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NettingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NettingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetReceivable.Size()
+		i -= size
+		if _, err := m.NetReceivable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NetPayable.Size()
+		i -= size
+		if _, err := m.NetPayable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BilateralNetReceivable.Size()
+		i -= size
+		if _, err := m.BilateralNetReceivable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BilateralNetPayable.Size()
+		i -= size
+		if _, err := m.BilateralNetPayable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GrossReceivable.Size()
+		i -= size
+		if _, err := m.GrossReceivable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.GrossPayable.Size()
+		i -= size
+		if _, err := m.GrossPayable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextStreamId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextStreamId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.NextMandateId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextMandateId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Mandates) > 0 {
+		for iNdEx := len(m.Mandates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mandates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.SplitTemplates) > 0 {
+		for iNdEx := len(m.SplitTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SplitTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.NextNettingCycleId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextNettingCycleId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.NettingObligations) > 0 {
+		for iNdEx := len(m.NettingObligations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NettingObligations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.NettingCycles) > 0 {
+		for iNdEx := len(m.NettingCycles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NettingCycles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.DelayedPayouts) > 0 {
+		for iNdEx := len(m.DelayedPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NextHtlcId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextHtlcId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Htlcs) > 0 {
+		for iNdEx := len(m.Htlcs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Htlcs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextBidirectionalChannelId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextBidirectionalChannelId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.BidirectionalChannels) > 0 {
+		for iNdEx := len(m.BidirectionalChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidirectionalChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextSubscriptionId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextSubscriptionId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MilestoneEscrows) > 0 {
+		for iNdEx := len(m.MilestoneEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MilestoneEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EscrowArbitrations) > 0 {
+		for iNdEx := len(m.EscrowArbitrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowArbitrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextChannelId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextChannelId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextBatchId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextBatchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextSettlementId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.NextSettlementId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Merchants) > 0 {
+		for iNdEx := len(m.Merchants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Merchants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSettlement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSettlement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Settlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.SettledHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.BatchId != 0 {
+		n += 2 + sovSettlement(uint64(m.BatchId))
+	}
+	if m.FxConversion != nil {
+		l = m.FxConversion.Size()
+		n += 2 + l + sovSettlement(uint64(l))
+	}
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.RecipientAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSettlement(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *SplitRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Bps != 0 {
+		n += 1 + sovSettlement(uint64(m.Bps))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *SplitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *PayoutLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *StatementEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	l = len(m.SettlementType)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.EntryType)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSettlement(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.BatchId != 0 {
+		n += 1 + sovSettlement(uint64(m.BatchId))
+	}
+	return n
+}
+
+func (m *Statement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ToHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.OpeningBalance.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.ClosingBalance.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.TotalCredits.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.TotalDebits.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.TotalFees.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.GeneratedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.GeneratedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GeneratedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *FXConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.OracleRate.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.FxRate.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.SettlementIds) > 0 {
+		l = 0
+		for _, e := range m.SettlementIds {
+			l += sovSettlement(uint64(e))
+		}
+		n += 1 + sovSettlement(uint64(l)) + l
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.TotalFees.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovSettlement(uint64(m.Count))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.SettledHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.AutoSettle {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettleBy)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *PaymentChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.IsOpen {
+		n += 2
+	}
+	if m.OpenedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.OpenedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.ClosedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ClosedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ExpiresAtHeight))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovSettlement(uint64(m.Nonce))
+	}
+	if m.RoutingFeeBps != 0 {
+		n += 1 + sovSettlement(uint64(m.RoutingFeeBps))
+	}
+	return n
+}
+
+func (m *MerchantConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.FeeRateBps != 0 {
+		n += 1 + sovSettlement(uint64(m.FeeRateBps))
+	}
+	l = m.MinSettlement.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.MaxSettlement.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.BatchEnabled {
+		n += 2
+	}
+	l = m.BatchThreshold.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.IsActive {
+		n += 2
+	}
+	l = len(m.WebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RegisteredAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BatchMaxAge)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.SettlementDenom)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckoutItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProductId)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovSettlement(uint64(m.Quantity))
+	}
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *EscrowApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Party)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.RecipientAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ApprovedAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *EscrowArbitration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	if len(m.Arbiters) > 0 {
+		for _, s := range m.Arbiters {
+			l = len(s)
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovSettlement(uint64(m.Threshold))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.SettledHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *MilestoneEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.MaxCycles != 0 {
+		n += 1 + sovSettlement(uint64(m.MaxCycles))
+	}
+	if m.CyclesCompleted != 0 {
+		n += 1 + sovSettlement(uint64(m.CyclesCompleted))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDue)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PastDueSince)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.LastSettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.LastSettlementId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 2 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *Mandate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.PerChargeMax.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.PeriodCap.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.PeriodSpent.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.TotalPulled.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.ChargeCount != 0 {
+		n += 1 + sovSettlement(uint64(m.ChargeCount))
+	}
+	if m.LastSettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.LastSettlementId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 2 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.RatePerSecond.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.AccruedAtCheckpoint.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CheckpointTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CancelledAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *BidirectionalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.PartyA)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.DepositA.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.DepositB.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.BalanceA.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.BalanceB.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovSettlement(uint64(m.Nonce))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.ChallengePeriod != 0 {
+		n += 1 + sovSettlement(uint64(m.ChallengePeriod))
+	}
+	l = len(m.ClosingParty)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.ChallengeEndsHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ChallengeEndsHeight))
+	}
+	if m.OpenedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.OpenedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.ClosedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ClosedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedTime)
+	n += 2 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *ChannelState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovSettlement(uint64(m.ChannelId))
+	}
+	l = m.BalanceA.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.BalanceB.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovSettlement(uint64(m.Nonce))
+	}
+	l = len(m.SignatureA)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.SignatureB)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	return n
+}
+
+func (m *HTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovSettlement(uint64(m.ChannelId))
+	}
+	if m.IncomingHtlcId != 0 {
+		n += 1 + sovSettlement(uint64(m.IncomingHtlcId))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.TimeoutHeight))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	if m.ResolvedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ResolvedHeight))
+	}
+	return n
+}
+
+func (m *DelayedPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.FrozenReason)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	if m.ReleasedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.ReleasedHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultFeeRateBps != 0 {
+		n += 1 + sovSettlement(uint64(m.DefaultFeeRateBps))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.MinSettlementAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.MaxSettlementAmount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.DefaultEscrowExpiration != 0 {
+		n += 1 + sovSettlement(uint64(m.DefaultEscrowExpiration))
+	}
+	if m.MaxEscrowExpiration != 0 {
+		n += 1 + sovSettlement(uint64(m.MaxEscrowExpiration))
+	}
+	if m.MinChannelExpiration != 0 {
+		n += 1 + sovSettlement(uint64(m.MinChannelExpiration))
+	}
+	if m.MaxChannelExpiration != 0 {
+		n += 1 + sovSettlement(uint64(m.MaxChannelExpiration))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovSettlement(uint64(m.MaxBatchSize))
+	}
+	if m.MaxQueryLimit != 0 {
+		n += 1 + sovSettlement(uint64(m.MaxQueryLimit))
+	}
+	if m.InstantTransfersEnabled {
+		n += 2
+	}
+	if m.EscrowEnabled {
+		n += 2
+	}
+	if m.ChannelsEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *NettingCycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosesAt)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.ObligationCount != 0 {
+		n += 1 + sovSettlement(uint64(m.ObligationCount))
+	}
+	l = m.GrossTotal.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SettledTime)
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NettingObligation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CycleId != 0 {
+		n += 1 + sovSettlement(uint64(m.CycleId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	l = len(m.Debtor)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Creditor)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *NetTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if m.SettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *NettingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = m.GrossPayable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.GrossReceivable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.BilateralNetPayable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.BilateralNetReceivable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.NetPayable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	l = m.NetReceivable.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovSettlement(uint64(l))
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Merchants) > 0 {
+		for _, e := range m.Merchants {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextSettlementId != 0 {
+		n += 1 + sovSettlement(uint64(m.NextSettlementId))
+	}
+	if m.NextBatchId != 0 {
+		n += 1 + sovSettlement(uint64(m.NextBatchId))
+	}
+	if m.NextChannelId != 0 {
+		n += 1 + sovSettlement(uint64(m.NextChannelId))
+	}
+	if len(m.EscrowArbitrations) > 0 {
+		for _, e := range m.EscrowArbitrations {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.MilestoneEscrows) > 0 {
+		for _, e := range m.MilestoneEscrows {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextSubscriptionId != 0 {
+		n += 1 + sovSettlement(uint64(m.NextSubscriptionId))
+	}
+	if len(m.BidirectionalChannels) > 0 {
+		for _, e := range m.BidirectionalChannels {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextBidirectionalChannelId != 0 {
+		n += 1 + sovSettlement(uint64(m.NextBidirectionalChannelId))
+	}
+	if len(m.Htlcs) > 0 {
+		for _, e := range m.Htlcs {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextHtlcId != 0 {
+		n += 2 + sovSettlement(uint64(m.NextHtlcId))
+	}
+	if len(m.DelayedPayouts) > 0 {
+		for _, e := range m.DelayedPayouts {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.NettingCycles) > 0 {
+		for _, e := range m.NettingCycles {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.NettingObligations) > 0 {
+		for _, e := range m.NettingObligations {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextNettingCycleId != 0 {
+		n += 2 + sovSettlement(uint64(m.NextNettingCycleId))
+	}
+	if len(m.SplitTemplates) > 0 {
+		for _, e := range m.SplitTemplates {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if len(m.Mandates) > 0 {
+		for _, e := range m.Mandates {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextMandateId != 0 {
+		n += 2 + sovSettlement(uint64(m.NextMandateId))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 2 + l + sovSettlement(uint64(l))
+		}
+	}
+	if m.NextStreamId != 0 {
+		n += 2 + sovSettlement(uint64(m.NextStreamId))
+	}
+	return n
+}
+
+func sovSettlement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSettlement(x uint64) (n int) {
+	return sovSettlement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Settlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Settlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Settlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = SettlementType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SettlementStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FxConversion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FxConversion == nil {
+				m.FxConversion = &FXConversion{}
+			}
+			if err := m.FxConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, PayoutLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunds = append(m.Refunds, SettlementRefund{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, SplitRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatementEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatementEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatementEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementType = SettlementType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Statement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Statement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Statement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpeningBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCredits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalCredits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDebits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDebits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, StatementEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedHeight", wireType)
+			}
+			m.GeneratedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeneratedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GeneratedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FXConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FXConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FXConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettlement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SettlementIds = append(m.SettlementIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettlement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSettlement
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSettlement
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SettlementIds) == 0 {
+					m.SettlementIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettlement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SettlementIds = append(m.SettlementIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SettlementStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoSettle = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettleBy, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOpen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOpen = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+			}
+			m.OpenedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
+			}
+			m.ClosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingFeeBps", wireType)
+			}
+			m.RoutingFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoutingFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerchantConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerchantConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
+			}
+			m.FeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SettlementDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BatchMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckoutItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckoutItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProductId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Party", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Party = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = EscrowResolution(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ApprovedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowArbitration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowArbitration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowArbitration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, EscrowApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = EscrowResolution(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SettlementStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MilestoneEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MilestoneEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MilestoneEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCycles", wireType)
+			}
+			m.MaxCycles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCycles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CyclesCompleted", wireType)
+			}
+			m.CyclesCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CyclesCompleted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextDue, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastDueSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PastDueSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = SubscriptionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementId", wireType)
+			}
+			m.LastSettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mandate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mandate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mandate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerChargeMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerChargeMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = MandateStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPulled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPulled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeCount", wireType)
+			}
+			m.ChargeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChargeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementId", wireType)
+			}
+			m.LastSettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatePerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedAtCheckpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedAtCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CheckpointTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = StreamStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CancelledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidirectionalChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidirectionalChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidirectionalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = BidirectionalChannelStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePeriod", wireType)
+			}
+			m.ChallengePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingParty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosingParty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEndsHeight", wireType)
+			}
+			m.ChallengeEndsHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeEndsHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
+			}
+			m.OpenedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
+			}
+			m.ClosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BalanceB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTLC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTLC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTLC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingHtlcId", wireType)
+			}
+			m.IncomingHtlcId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncomingHtlcId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = HTLCStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preimage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedHeight", wireType)
+			}
+			m.ResolvedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Settlement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Settlement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2268,16 +15315,30 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2287,27 +15348,28 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = SettlementType(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2335,11 +15397,11 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Status = PayoutStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2367,13 +15429,13 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.FrozenReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			var msglen int
+			m.CreatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2383,30 +15445,104 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedHeight", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.ReleasedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFeeRateBps", wireType)
+			}
+			m.DefaultFeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultFeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2416,28 +15552,27 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlementAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2464,15 +15599,15 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSettlementAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2482,29 +15617,30 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SettlementStatus(dAtA[iNdEx:postIndex])
+			if err := m.MaxSettlementAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultEscrowExpiration", wireType)
 			}
-			var stringLen uint64
+			m.DefaultEscrowExpiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2514,29 +15650,35 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DefaultEscrowExpiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEscrowExpiration", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxEscrowExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEscrowExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinChannelExpiration", wireType)
 			}
-			var stringLen uint64
+			m.MinChannelExpiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2546,29 +15688,16 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinChannelExpiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChannelExpiration", wireType)
 			}
-			m.CreatedHeight = 0
+			m.MaxChannelExpiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2578,16 +15707,16 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedHeight |= int64(b&0x7F) << shift
+				m.MaxChannelExpiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var msglen int
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2597,30 +15726,16 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryLimit", wireType)
 			}
-			m.SettledHeight = 0
+			m.MaxQueryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2630,16 +15745,16 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettledHeight |= int64(b&0x7F) << shift
+				m.MaxQueryLimit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantTransfersEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2649,30 +15764,17 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			m.InstantTransfersEnabled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2682,30 +15784,17 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
+			m.EscrowEnabled = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelsEnabled", wireType)
 			}
-			m.BatchId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2715,11 +15804,12 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ChannelsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettlement(dAtA[iNdEx:])
@@ -2741,7 +15831,7 @@ func (m *Settlement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
+func (m *NettingCycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2764,10 +15854,10 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSettlement: wiretype end group for non-group")
+			return fmt.Errorf("proto: NettingCycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NettingCycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2791,7 +15881,7 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2819,89 +15909,45 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSettlement
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SettlementIds = append(m.SettlementIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSettlement
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSettlement
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSettlement
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SettlementIds) == 0 {
-					m.SettlementIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettlement
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SettlementIds = append(m.SettlementIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementIds", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2911,28 +15957,27 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosesAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2959,15 +16004,15 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosesAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -2977,30 +16022,29 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = NettingCycleStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObligationCount", wireType)
 			}
-			m.Count = 0
+			m.ObligationCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3010,16 +16054,16 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				m.ObligationCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossTotal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3029,23 +16073,24 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SettlementStatus(dAtA[iNdEx:postIndex])
+			if err := m.GrossTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 0 {
@@ -3067,8 +16112,27 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3095,15 +16159,15 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			m.SettledHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3113,14 +16177,27 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettledHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3147,7 +16224,8 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SettledTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, NetTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3172,7 +16250,7 @@ func (m *BatchSettlement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
+func (m *NettingObligation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3195,17 +16273,17 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PaymentChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: NettingObligation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaymentChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NettingObligation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CycleId", wireType)
 			}
-			m.Id = 0
+			m.CycleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3215,16 +16293,16 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CycleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3234,27 +16312,14 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3282,13 +16347,13 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Debtor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creditor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3298,28 +16363,27 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creditor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3346,139 +16410,15 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOpen", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsOpen = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenedHeight", wireType)
-			}
-			m.OpenedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OpenedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
-			}
-			m.ClosedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClosedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3488,49 +16428,29 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
-			}
-			m.ExpiresAtHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAtHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return ErrInvalidLengthSettlement
 			}
-		case 13:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			m.Nonce = 0
+			m.CreatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3540,7 +16460,7 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.CreatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3566,7 +16486,7 @@ func (m *PaymentChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
+func (m *NetTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,15 +16509,15 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MerchantConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MerchantConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3625,11 +16545,11 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3657,30 +16577,11 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
-			}
-			m.FeeRateBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeRateBps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3707,15 +16608,15 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
 			}
-			var msglen int
+			m.SettlementId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3725,50 +16626,66 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SettlementId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NettingPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.BatchEnabled = bool(v != 0)
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NettingPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NettingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3778,28 +16695,27 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossPayable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3826,65 +16742,13 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SettlementDelay, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GrossPayable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossReceivable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3911,65 +16775,15 @@ func (m *MerchantConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RegisteredAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GrossReceivable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettlement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettlement
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CheckoutItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckoutItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProductId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BilateralNetPayable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -3979,29 +16793,30 @@ func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProductId = string(dAtA[iNdEx:postIndex])
+			if err := m.BilateralNetPayable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BilateralNetReceivable", wireType)
 			}
-			m.Quantity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4011,14 +16826,28 @@ func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BilateralNetReceivable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetPayable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4045,15 +16874,15 @@ func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetPayable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetReceivable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4063,23 +16892,24 @@ func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.NetReceivable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4102,7 +16932,7 @@ func (m *CheckoutItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4125,36 +16955,17 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
-			}
-			m.SettlementId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4164,46 +16975,28 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4230,15 +17023,16 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			m.Settlements = append(m.Settlements, Settlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4248,29 +17042,31 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Batches = append(m.Batches, BatchSettlement{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4280,27 +17076,29 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Channels = append(m.Channels, PaymentChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4327,15 +17125,16 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Merchants = append(m.Merchants, MerchantConfig{})
+			if err := m.Merchants[len(m.Merchants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSettlementId", wireType)
 			}
-			var msglen int
+			m.NextSettlementId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4345,30 +17144,16 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextSettlementId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBatchId", wireType)
 			}
-			var stringLen uint64
+			m.NextBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4378,79 +17163,16 @@ func (m *TransferReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettlement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettlement
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFeeRateBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelId", wireType)
 			}
-			m.DefaultFeeRateBps = 0
+			m.NextChannelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4460,16 +17182,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultFeeRateBps |= uint32(b&0x7F) << shift
+				m.NextChannelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowArbitrations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4479,27 +17201,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettlement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			m.EscrowArbitrations = append(m.EscrowArbitrations, EscrowArbitration{})
+			if err := m.EscrowArbitrations[len(m.EscrowArbitrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlementAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneEscrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4526,13 +17250,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSettlementAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MilestoneEscrows = append(m.MilestoneEscrows, MilestoneEscrow{})
+			if err := m.MilestoneEscrows[len(m.MilestoneEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4559,15 +17284,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSettlementAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultEscrowExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSubscriptionId", wireType)
 			}
-			m.DefaultEscrowExpiration = 0
+			m.NextSubscriptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4577,16 +17303,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DefaultEscrowExpiration |= int64(b&0x7F) << shift
+				m.NextSubscriptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEscrowExpiration", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidirectionalChannels", wireType)
 			}
-			m.MaxEscrowExpiration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4596,54 +17322,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEscrowExpiration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinChannelExpiration", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
 			}
-			m.MinChannelExpiration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinChannelExpiration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChannelExpiration", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxChannelExpiration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxChannelExpiration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.BidirectionalChannels = append(m.BidirectionalChannels, BidirectionalChannel{})
+			if err := m.BidirectionalChannels[len(m.BidirectionalChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 9:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBidirectionalChannelId", wireType)
 			}
-			m.MaxBatchSize = 0
+			m.NextBidirectionalChannelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4653,16 +17356,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				m.NextBidirectionalChannelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryLimit", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Htlcs", wireType)
 			}
-			m.MaxQueryLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4672,36 +17375,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQueryLimit |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantTransfersEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettlement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
 			}
-			m.InstantTransfersEnabled = bool(v != 0)
-		case 12:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Htlcs = append(m.Htlcs, HTLC{})
+			if err := m.Htlcs[len(m.Htlcs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHtlcId", wireType)
 			}
-			var v int
+			m.NextHtlcId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4711,17 +17409,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextHtlcId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EscrowEnabled = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelsEnabled", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedPayouts", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4731,65 +17428,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChannelsEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettlement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettlement
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettlement
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DelayedPayouts = append(m.DelayedPayouts, DelayedPayout{})
+			if err := m.DelayedPayouts[len(m.DelayedPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NettingCycles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4816,13 +17477,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NettingCycles = append(m.NettingCycles, NettingCycle{})
+			if err := m.NettingCycles[len(m.NettingCycles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NettingObligations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4849,16 +17511,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Settlements = append(m.Settlements, Settlement{})
-			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NettingObligations = append(m.NettingObligations, NettingObligation{})
+			if err := m.NettingObligations[len(m.NettingObligations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNettingCycleId", wireType)
 			}
-			var msglen int
+			m.NextNettingCycleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4868,29 +17530,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextNettingCycleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettlement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Batches = append(m.Batches, BatchSettlement{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitTemplates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4917,14 +17564,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, PaymentChannel{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SplitTemplates = append(m.SplitTemplates, SplitTemplate{})
+			if err := m.SplitTemplates[len(m.SplitTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mandates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4951,16 +17598,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchants = append(m.Merchants, MerchantConfig{})
-			if err := m.Merchants[len(m.Merchants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mandates = append(m.Mandates, Mandate{})
+			if err := m.Mandates[len(m.Mandates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMandateId", wireType)
 			}
-			m.NextSettlementId = 0
+			m.NextMandateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4970,16 +17617,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSettlementId |= uint64(b&0x7F) << shift
+				m.NextMandateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextBatchId", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			m.NextBatchId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -4989,16 +17636,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextBatchId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStreamId", wireType)
 			}
-			m.NextChannelId = 0
+			m.NextStreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettlement
@@ -5008,7 +17670,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextChannelId |= uint64(b&0x7F) << shift
+				m.NextStreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
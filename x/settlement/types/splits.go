@@ -0,0 +1,136 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateSplitRules checks the rules of a split paying a merchant's checkouts.
+// Every rule pays a distinct payee other than the merchant either basis points
+// of the net amount or a fixed amount, and the basis points add up to at most
+// 100%.
+func ValidateSplitRules(merchant string, rules []SplitRule) error {
+	if len(rules) > MaxSplitRules {
+		return ErrInvalidSplit.Wrapf("at most %d split rules allowed", MaxSplitRules)
+	}
+
+	payees := make(map[string]bool, len(rules))
+	var totalBps uint32
+	for i, rule := range rules {
+		if _, err := sdk.AccAddressFromBech32(rule.Payee); err != nil {
+			return ErrInvalidSplit.Wrapf("rule %d: invalid payee address", i)
+		}
+		if rule.Payee == merchant {
+			return ErrInvalidSplit.Wrapf("rule %d: the merchant is paid the remainder", i)
+		}
+		if payees[rule.Payee] {
+			return ErrInvalidSplit.Wrapf("rule %d: duplicate payee %s", i, rule.Payee)
+		}
+		payees[rule.Payee] = true
+
+		if len(rule.Role) > MaxSplitRoleLength {
+			return ErrInvalidSplit.Wrapf("rule %d: role too long", i)
+		}
+
+		fixed := !rule.Amount.Amount.IsNil() && !rule.Amount.IsZero()
+		switch {
+		case rule.Bps > 0 && fixed:
+			return ErrInvalidSplit.Wrapf("rule %d: set either bps or a fixed amount", i)
+		case rule.Bps == 0 && !fixed:
+			return ErrInvalidSplit.Wrapf("rule %d: share must be positive", i)
+		case fixed && !rule.Amount.IsValid():
+			return ErrInvalidSplit.Wrapf("rule %d: invalid amount %s", i, rule.Amount)
+		}
+		totalBps += rule.Bps
+		if totalBps > 10000 {
+			return ErrInvalidSplit.Wrap("split bps exceed 10000")
+		}
+	}
+	return nil
+}
+
+// ValidateSplitName checks the name of a merchant's split template. An empty
+// name is the merchant's default template.
+func ValidateSplitName(name string) error {
+	if len(name) > MaxSplitNameLength {
+		return ErrInvalidSplit.Wrapf("split name cannot exceed %d characters", MaxSplitNameLength)
+	}
+	return nil
+}
+
+// ComputePayoutLegs splits a checkout's net amount into the legs paying each
+// rule's payee, followed by the leg paying the merchant what remains. Shares in
+// basis points are rounded down, so rounding dust goes to the merchant.
+func ComputePayoutLegs(merchant string, net sdk.Coin, rules []SplitRule) ([]PayoutLeg, error) {
+	zero := sdk.NewCoin(net.Denom, sdkmath.ZeroInt())
+	legs := make([]PayoutLeg, 0, len(rules)+1)
+	remainder := net.Amount
+	for i, rule := range rules {
+		share := net.Amount.MulRaw(int64(rule.Bps)).QuoRaw(10000)
+		if rule.Bps == 0 {
+			if rule.Amount.Denom != net.Denom {
+				return nil, ErrInvalidSplit.Wrapf("rule %d: fixed amount must be in %s", i, net.Denom)
+			}
+			share = rule.Amount.Amount
+		}
+		if share.GT(remainder) {
+			return nil, ErrInvalidSplit.Wrapf("split shares exceed the net amount %s", net)
+		}
+		remainder = remainder.Sub(share)
+		legs = append(legs, PayoutLeg{
+			Payee:    rule.Payee,
+			Role:     rule.Role,
+			Amount:   sdk.NewCoin(net.Denom, share),
+			Refunded: zero,
+		})
+	}
+
+	legs = append(legs, PayoutLeg{
+		Payee:    merchant,
+		Role:     SplitRoleMerchant,
+		Amount:   sdk.NewCoin(net.Denom, remainder),
+		Refunded: zero,
+	})
+	return legs, nil
+}
+
+// Remaining returns what the payee keeps of the leg after refunds
+func (l PayoutLeg) Remaining() sdk.Coin {
+	return l.Amount.Sub(l.Refunded)
+}
+
+// AllocateRefund spreads a refund across the legs of a split settlement in
+// proportion to what each payee still holds. Proportional shares are rounded
+// down and the rounding dust is taken from the legs in order. The returned
+// shares line up with the legs.
+func AllocateRefund(legs []PayoutLeg, refund sdk.Coin) ([]sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	for _, leg := range legs {
+		total = total.Add(leg.Remaining().Amount)
+	}
+	if refund.Amount.GT(total) {
+		return nil, ErrRefundTooLarge
+	}
+
+	shares := make([]sdkmath.Int, len(legs))
+	allocated := sdkmath.ZeroInt()
+	for i, leg := range legs {
+		shares[i] = sdkmath.ZeroInt()
+		if total.IsPositive() {
+			shares[i] = refund.Amount.Mul(leg.Remaining().Amount).Quo(total)
+		}
+		allocated = allocated.Add(shares[i])
+	}
+
+	dust := refund.Amount.Sub(allocated)
+	for i, leg := range legs {
+		if !dust.IsPositive() {
+			break
+		}
+		room := leg.Remaining().Amount.Sub(shares[i])
+		take := sdkmath.MinInt(room, dust)
+		shares[i] = shares[i].Add(take)
+		dust = dust.Sub(take)
+	}
+	return shares, nil
+}
@@ -0,0 +1,449 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Statement entry types
+const (
+	StatementEntryPayment = "payment"
+	StatementEntryFee     = "fee"
+	StatementEntrySplit   = "split"
+	StatementEntryRefund  = "refund"
+)
+
+// Statement entry directions
+const (
+	StatementCredit = "credit"
+	StatementDebit  = "debit"
+)
+
+// Camt053Namespace is the ISO 20022 bank-to-customer statement schema
+// statements are rendered in
+const Camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+// StatementCurrency is the ISO 4217 currency a denom is reported in on a
+// camt.053 statement, with the number of decimals of the denom's base unit
+type StatementCurrency struct {
+	Code     string
+	Exponent int
+}
+
+// StatementCurrencies maps the denoms statements can be rendered in as
+// camt.053 to their currency
+var StatementCurrencies = map[string]StatementCurrency{
+	StablecoinDenom: {Code: "USD", Exponent: 6},
+}
+
+// StatementEntries returns the movements a settlement books on an account in
+// the given denom. The recipient is credited what was released to it and
+// debited the fee, the shares paid to other payees of a split and its part of
+// every refund; the sender is debited what it paid and credited what came back
+// to it. Settlements that are still open, and escrows that returned everything
+// to the sender, book nothing.
+func StatementEntries(s Settlement, account, denom string) []StatementEntry {
+	if s.SettledHeight == 0 {
+		return nil
+	}
+	switch s.Status {
+	case SettlementStatusCompleted, SettlementStatusRefunded, SettlementStatusCancelled:
+	default:
+		return nil
+	}
+	released := s.ReleasedAmount()
+
+	var entries []StatementEntry
+	add := func(entryType, direction string, amount sdk.Coin, counterparty string, height int64, at time.Time) {
+		if amount.Denom != denom || !amount.IsPositive() {
+			return
+		}
+		entries = append(entries, StatementEntry{
+			SettlementId:   s.Id,
+			SettlementType: s.Type,
+			EntryType:      entryType,
+			Direction:      direction,
+			Amount:         amount,
+			Counterparty:   counterparty,
+			Reference:      s.Reference,
+			Height:         height,
+			Time:           at,
+			BatchId:        s.BatchId,
+		})
+	}
+
+	if s.Recipient == account {
+		add(StatementEntryPayment, StatementCredit, released, s.Sender, s.SettledHeight, s.SettledTime)
+		add(StatementEntryFee, StatementDebit, s.Fee, "", s.SettledHeight, s.SettledTime)
+		for _, leg := range s.Legs {
+			if leg.Payee != account {
+				add(StatementEntrySplit, StatementDebit, leg.Amount, leg.Payee, s.SettledHeight, s.SettledTime)
+			}
+		}
+		for _, refund := range s.Refunds {
+			add(StatementEntryRefund, StatementDebit, refund.RecipientAmount, s.Sender, refund.Height, refund.Time)
+		}
+	}
+
+	if s.Sender == account {
+		if s.FxConversion != nil {
+			// The whole payment was converted, so what was not released
+			// came back in the settlement denom
+			add(StatementEntryPayment, StatementDebit, s.FxConversion.PaymentAmount, s.Recipient, s.SettledHeight, s.SettledTime)
+			add(StatementEntryRefund, StatementCredit, s.Amount.Sub(released), s.Recipient, s.SettledHeight, s.SettledTime)
+		} else {
+			add(StatementEntryPayment, StatementDebit, released, s.Recipient, s.SettledHeight, s.SettledTime)
+		}
+		for _, refund := range s.Refunds {
+			add(StatementEntryRefund, StatementCredit, refund.Amount, s.Recipient, refund.Height, refund.Time)
+		}
+	}
+
+	return entries
+}
+
+// ReleasedAmount returns the part of the settlement amount paid to the
+// recipient side, fee included. It is less than the amount when an arbitrated
+// escrow was split or refunded, or when milestones were refunded.
+func (s Settlement) ReleasedAmount() sdk.Coin {
+	released := sdkmath.ZeroInt()
+	if !s.Fee.Amount.IsNil() {
+		released = released.Add(s.Fee.Amount)
+	}
+	if !s.NetAmount.Amount.IsNil() {
+		released = released.Add(s.NetAmount.Amount)
+	}
+	return sdk.NewCoin(s.Amount.Denom, released)
+}
+
+// AppendPage adds the next page of a statement to it. Pages cover the
+// account's settlements in creation order, so their balances and totals add
+// up, and entries stay ordered by height and then by settlement.
+func (s *Statement) AppendPage(page Statement) {
+	s.OpeningBalance = s.OpeningBalance.Add(page.OpeningBalance)
+	s.ClosingBalance = s.ClosingBalance.Add(page.ClosingBalance)
+	s.TotalCredits = s.TotalCredits.Add(page.TotalCredits)
+	s.TotalDebits = s.TotalDebits.Add(page.TotalDebits)
+	s.TotalFees = s.TotalFees.Add(page.TotalFees)
+	s.Entries = append(s.Entries, page.Entries...)
+	sort.SliceStable(s.Entries, func(i, j int) bool {
+		return s.Entries[i].Height < s.Entries[j].Height
+	})
+}
+
+// Signed returns the entry amount, negative for a debit
+func (e StatementEntry) Signed() sdkmath.Int {
+	if e.Direction == StatementDebit {
+		return e.Amount.Amount.Neg()
+	}
+	return e.Amount.Amount
+}
+
+// MarshalCSV renders the statement's entries as CSV, one row per entry with
+// the running balance after it
+func (s Statement) MarshalCSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{"height", "time", "settlement_id", "settlement_type", "entry_type", "direction", "amount", "denom", "counterparty", "reference", "batch_id", "balance"}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	balance := s.OpeningBalance
+	for _, e := range s.Entries {
+		balance = balance.Add(e.Signed())
+		row := []string{
+			strconv.FormatInt(e.Height, 10),
+			e.Time.UTC().Format(time.RFC3339),
+			strconv.FormatUint(e.SettlementId, 10),
+			string(e.SettlementType),
+			e.EntryType,
+			e.Direction,
+			e.Amount.Amount.String(),
+			e.Amount.Denom,
+			e.Counterparty,
+			e.Reference,
+			strconv.FormatUint(e.BatchId, 10),
+			balance.String(),
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalCamt053 renders the statement as an ISO 20022 camt.053
+// bank-to-customer statement. The denom must map to an ISO 4217 currency in
+// StatementCurrencies, and amounts are converted from base units into that
+// currency.
+func (s Statement) MarshalCamt053() ([]byte, error) {
+	currency, ok := StatementCurrencies[s.Denom]
+	if !ok {
+		return nil, fmt.Errorf("denom %s has no ISO 4217 currency", s.Denom)
+	}
+	created := s.GeneratedTime.UTC().Format(time.RFC3339)
+	id := fmt.Sprintf("STMT-%s-%d", s.Account, s.GeneratedHeight)
+
+	// An open-ended statement opens at its first entry
+	openedAt := s.FromTime
+	if openedAt.IsZero() && len(s.Entries) > 0 {
+		openedAt = s.Entries[0].Time
+	}
+	if openedAt.IsZero() {
+		openedAt = s.GeneratedTime
+	}
+
+	stmt := camtStatement{
+		ID:      id,
+		CreDtTm: created,
+		Acct: camtAccount{
+			ID:  camtAccountID{Othr: camtOther{ID: s.Account}},
+			Ccy: currency.Code,
+		},
+		Bal: []camtBalance{
+			newCamtBalance("OPBD", currency, s.OpeningBalance, openedAt),
+			newCamtBalance("CLBD", currency, s.ClosingBalance, s.GeneratedTime),
+		},
+	}
+	if !s.FromTime.IsZero() || !s.ToTime.IsZero() {
+		stmt.FrToDt = &camtPeriod{FrDtTm: camtTime(s.FromTime), ToDtTm: camtTime(s.ToTime)}
+	}
+
+	var credits, debits int
+	for _, e := range s.Entries {
+		if e.Direction == StatementCredit {
+			credits++
+		} else {
+			debits++
+		}
+		booked := camtDate{DtTm: e.Time.UTC().Format(time.RFC3339)}
+		entry := camtEntry{
+			NtryRef:   fmt.Sprintf("%d-%s", e.SettlementId, e.EntryType),
+			Amt:       camtAmount{Ccy: currency.Code, Value: currency.decimal(e.Amount.Amount)},
+			CdtDbtInd: camtIndicator(e.Signed()),
+			Sts:       camtStatus{Cd: "BOOK"},
+			BookgDt:   booked,
+			ValDt:     booked,
+			BkTxCd:    camtTxCode{Prtry: camtProprietary{Cd: e.EntryType, Issr: ModuleName}},
+			NtryDtls: camtEntryDetails{TxDtls: camtTxDetails{
+				Refs:       camtRefs{EndToEndID: e.Reference, TxID: strconv.FormatUint(e.SettlementId, 10)},
+				AddtlTxInf: string(e.SettlementType),
+			}},
+		}
+		details := &entry.NtryDtls.TxDtls
+		if details.Refs.EndToEndID == "" {
+			details.Refs.EndToEndID = "NOTPROVIDED"
+		}
+		// The counterparty paid a credit and was paid a debit
+		if e.Direction == StatementCredit {
+			details.Debtor = e.Counterparty
+		} else {
+			details.Creditor = e.Counterparty
+		}
+		stmt.Ntry = append(stmt.Ntry, entry)
+	}
+
+	net := s.TotalCredits.Sub(s.TotalDebits)
+	stmt.TxsSummry = camtSummary{
+		TtlNtries: camtTotals{
+			NbOfNtries: strconv.Itoa(len(s.Entries)),
+			TtlNetNtry: &camtNet{Amt: currency.decimal(net), CdtDbtInd: camtIndicator(net)},
+		},
+		TtlCdtNtries: camtTotals{NbOfNtries: strconv.Itoa(credits), Sum: currency.decimal(s.TotalCredits)},
+		TtlDbtNtries: camtTotals{NbOfNtries: strconv.Itoa(debits), Sum: currency.decimal(s.TotalDebits)},
+	}
+
+	doc := camtDocument{
+		Xmlns: Camt053Namespace,
+		BkToCstmrStmt: camtBankToCustomer{
+			GrpHdr: camtGroupHeader{MsgID: id, CreDtTm: created},
+			Stmt:   stmt,
+		},
+	}
+	bz, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), bz...), nil
+}
+
+func newCamtBalance(code string, currency StatementCurrency, amount sdkmath.Int, at time.Time) camtBalance {
+	return camtBalance{
+		Tp:        camtBalanceType{CdOrPrtry: camtCode{Cd: code}},
+		Amt:       camtAmount{Ccy: currency.Code, Value: currency.decimal(amount)},
+		CdtDbtInd: camtIndicator(amount),
+		Dt:        camtDate{DtTm: camtTime(at)},
+	}
+}
+
+// decimal renders the absolute value of an amount in base units as a decimal
+// amount of the currency, with at least two decimals
+func (c StatementCurrency) decimal(amount sdkmath.Int) string {
+	digits := amount.Abs().String()
+	if c.Exponent <= 0 {
+		return digits
+	}
+	if len(digits) <= c.Exponent {
+		digits = strings.Repeat("0", c.Exponent-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-c.Exponent], strings.TrimRight(digits[len(digits)-c.Exponent:], "0")
+	for len(frac) < 2 && len(frac) < c.Exponent {
+		frac += "0"
+	}
+	return whole + "." + frac
+}
+
+func camtIndicator(amount sdkmath.Int) string {
+	if amount.IsNegative() {
+		return "DBIT"
+	}
+	return "CRDT"
+}
+
+func camtTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+type camtDocument struct {
+	XMLName       xml.Name           `xml:"Document"`
+	Xmlns         string             `xml:"xmlns,attr"`
+	BkToCstmrStmt camtBankToCustomer `xml:"BkToCstmrStmt"`
+}
+
+type camtBankToCustomer struct {
+	GrpHdr camtGroupHeader `xml:"GrpHdr"`
+	Stmt   camtStatement   `xml:"Stmt"`
+}
+
+type camtGroupHeader struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID        string        `xml:"Id"`
+	CreDtTm   string        `xml:"CreDtTm"`
+	FrToDt    *camtPeriod   `xml:"FrToDt,omitempty"`
+	Acct      camtAccount   `xml:"Acct"`
+	Bal       []camtBalance `xml:"Bal"`
+	TxsSummry camtSummary   `xml:"TxsSummry"`
+	Ntry      []camtEntry   `xml:"Ntry"`
+}
+
+type camtPeriod struct {
+	FrDtTm string `xml:"FrDtTm,omitempty"`
+	ToDtTm string `xml:"ToDtTm,omitempty"`
+}
+
+type camtAccount struct {
+	ID  camtAccountID `xml:"Id"`
+	Ccy string        `xml:"Ccy"`
+}
+
+type camtAccountID struct {
+	Othr camtOther `xml:"Othr"`
+}
+
+type camtOther struct {
+	ID string `xml:"Id"`
+}
+
+type camtBalance struct {
+	Tp        camtBalanceType `xml:"Tp"`
+	Amt       camtAmount      `xml:"Amt"`
+	CdtDbtInd string          `xml:"CdtDbtInd"`
+	Dt        camtDate        `xml:"Dt"`
+}
+
+type camtBalanceType struct {
+	CdOrPrtry camtCode `xml:"CdOrPrtry"`
+}
+
+type camtCode struct {
+	Cd string `xml:"Cd"`
+}
+
+type camtAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtDate struct {
+	DtTm string `xml:"DtTm,omitempty"`
+}
+
+type camtSummary struct {
+	TtlNtries    camtTotals `xml:"TtlNtries"`
+	TtlCdtNtries camtTotals `xml:"TtlCdtNtries"`
+	TtlDbtNtries camtTotals `xml:"TtlDbtNtries"`
+}
+
+type camtTotals struct {
+	NbOfNtries string   `xml:"NbOfNtries"`
+	Sum        string   `xml:"Sum,omitempty"`
+	TtlNetNtry *camtNet `xml:"TtlNetNtry,omitempty"`
+}
+
+type camtNet struct {
+	Amt       string `xml:"Amt"`
+	CdtDbtInd string `xml:"CdtDbtInd"`
+}
+
+type camtEntry struct {
+	NtryRef   string           `xml:"NtryRef"`
+	Amt       camtAmount       `xml:"Amt"`
+	CdtDbtInd string           `xml:"CdtDbtInd"`
+	Sts       camtStatus       `xml:"Sts"`
+	BookgDt   camtDate         `xml:"BookgDt"`
+	ValDt     camtDate         `xml:"ValDt"`
+	BkTxCd    camtTxCode       `xml:"BkTxCd"`
+	NtryDtls  camtEntryDetails `xml:"NtryDtls"`
+}
+
+type camtStatus struct {
+	Cd string `xml:"Cd"`
+}
+
+type camtTxCode struct {
+	Prtry camtProprietary `xml:"Prtry"`
+}
+
+type camtProprietary struct {
+	Cd   string `xml:"Cd"`
+	Issr string `xml:"Issr"`
+}
+
+type camtEntryDetails struct {
+	TxDtls camtTxDetails `xml:"TxDtls"`
+}
+
+type camtTxDetails struct {
+	Refs       camtRefs `xml:"Refs"`
+	Debtor     string   `xml:"RltdPties>Dbtr>Pty>Nm,omitempty"`
+	Creditor   string   `xml:"RltdPties>Cdtr>Pty>Nm,omitempty"`
+	AddtlTxInf string   `xml:"AddtlTxInf,omitempty"`
+}
+
+type camtRefs struct {
+	EndToEndID string `xml:"EndToEndId"`
+	TxID       string `xml:"TxId"`
+}
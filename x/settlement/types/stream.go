@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateStreamTerms checks the terms a payer sets on a stream: a positive
+// stablecoin deposit, a positive rate no greater than the deposit and a
+// bounded reference.
+func ValidateStreamTerms(deposit sdk.Coin, ratePerSecond sdkmath.Int, reference string) error {
+	if !deposit.IsValid() || deposit.IsZero() {
+		return errorsmod.Wrap(ErrInvalidAmount, "deposit must be positive")
+	}
+	if deposit.Denom != StablecoinDenom {
+		return errorsmod.Wrapf(ErrInvalidDenom, "expected %s, got %s", StablecoinDenom, deposit.Denom)
+	}
+	if ratePerSecond.IsNil() || !ratePerSecond.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidStream, "rate per second must be positive")
+	}
+	if ratePerSecond.GT(deposit.Amount) {
+		return errorsmod.Wrap(ErrInvalidStream, "rate per second cannot exceed the deposit")
+	}
+	if len(reference) > 256 {
+		return errorsmod.Wrap(ErrInvalidSettlement, "reference too long")
+	}
+	return nil
+}
+
+// Accrued returns everything streamed to the recipient by now: what had
+// accrued at the checkpoint plus the rate for each whole second since, capped
+// at the deposit. A cancelled stream stops accruing. The seconds are capped at
+// those the rest of the deposit lasts before multiplying, so the product
+// cannot overflow however large the rate or the elapsed time.
+func (s Stream) Accrued(now time.Time) sdk.Coin {
+	accrued := s.AccruedAtCheckpoint
+	if s.Status == StreamStatusActive && now.After(s.CheckpointTime) && s.RatePerSecond.IsPositive() {
+		seconds := sdkmath.NewInt(int64(now.Sub(s.CheckpointTime) / time.Second))
+		remaining := s.Deposit.Amount.Sub(accrued)
+		if seconds.GT(remaining.Quo(s.RatePerSecond)) {
+			accrued = s.Deposit.Amount
+		} else {
+			accrued = accrued.Add(s.RatePerSecond.Mul(seconds))
+		}
+	}
+	if accrued.GT(s.Deposit.Amount) {
+		accrued = s.Deposit.Amount
+	}
+	return sdk.NewCoin(s.Deposit.Denom, accrued)
+}
+
+// Withdrawable returns the accrued amount the recipient has not withdrawn yet
+func (s Stream) Withdrawable(now time.Time) sdk.Coin {
+	return s.Accrued(now).Sub(s.Withdrawn)
+}
+
+// FundedUntil returns when the deposit runs out at the stream's rate
+func (s Stream) FundedUntil() time.Time {
+	remaining := s.Deposit.Amount.Sub(s.AccruedAtCheckpoint)
+	if !remaining.IsPositive() || !s.RatePerSecond.IsPositive() {
+		return s.CheckpointTime
+	}
+	// Round up so the last, partial second is included
+	seconds := remaining.Add(s.RatePerSecond).SubRaw(1).Quo(s.RatePerSecond)
+	if !seconds.IsInt64() || seconds.Int64() > int64(MaxStreamDuration/time.Second) {
+		return s.CheckpointTime.Add(MaxStreamDuration)
+	}
+	return s.CheckpointTime.Add(time.Duration(seconds.Int64()) * time.Second)
+}
+
+// Checkpoint folds the accrual up to now into the stream, so later changes to
+// the deposit only affect accrual from now on. Only whole seconds accrue, so
+// the checkpoint advances by the whole seconds counted and the fraction of a
+// second left over accrues after it.
+func (s *Stream) Checkpoint(now time.Time) {
+	s.AccruedAtCheckpoint = s.Accrued(now).Amount
+	if now.After(s.CheckpointTime) {
+		s.CheckpointTime = s.CheckpointTime.Add(now.Sub(s.CheckpointTime).Truncate(time.Second))
+	}
+}
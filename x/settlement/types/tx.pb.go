@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -43,6 +45,9 @@ type MsgInstantTransfer struct {
 	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 	Reference string                                  `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
 	Metadata  string                                  `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// max_slippage_bps bounds how far below the oracle rate a payment in
+	// another denom may convert. Zero uses the module default.
+	MaxSlippageBps uint32 `protobuf:"varint,6,opt,name=max_slippage_bps,json=maxSlippageBps,proto3" json:"max_slippage_bps,omitempty"`
 }
 
 func (m *MsgInstantTransfer) Reset()         { *m = MsgInstantTransfer{} }
@@ -106,6 +111,13 @@ func (m *MsgInstantTransfer) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgInstantTransfer) GetMaxSlippageBps() uint32 {
+	if m != nil {
+		return m.MaxSlippageBps
+	}
+	return 0
+}
+
 type MsgInstantTransferResponse struct {
 	SettlementId uint64 `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 	TxHash       string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -165,6 +177,9 @@ type MsgCreateEscrow struct {
 	Reference string                                  `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
 	Metadata  string                                  `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	ExpiresIn time.Duration                           `protobuf:"bytes,6,opt,name=expires_in,json=expiresIn,proto3,stdduration" json:"expires_in"`
+	// max_slippage_bps bounds how far below the oracle rate a payment in
+	// another denom may convert. Zero uses the module default.
+	MaxSlippageBps uint32 `protobuf:"varint,7,opt,name=max_slippage_bps,json=maxSlippageBps,proto3" json:"max_slippage_bps,omitempty"`
 }
 
 func (m *MsgCreateEscrow) Reset()         { *m = MsgCreateEscrow{} }
@@ -235,6 +250,13 @@ func (m *MsgCreateEscrow) GetExpiresIn() time.Duration {
 	return 0
 }
 
+func (m *MsgCreateEscrow) GetMaxSlippageBps() uint32 {
+	if m != nil {
+		return m.MaxSlippageBps
+	}
+	return 0
+}
+
 type MsgCreateEscrowResponse struct {
 	SettlementId uint64    `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 	ExpiresAt    time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
@@ -1002,6 +1024,11 @@ type MsgRegisterMerchant struct {
 	BatchEnabled   bool                                    `protobuf:"varint,7,opt,name=batch_enabled,json=batchEnabled,proto3" json:"batch_enabled,omitempty"`
 	BatchThreshold github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=batch_threshold,json=batchThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"batch_threshold"`
 	WebhookUrl     string                                  `protobuf:"bytes,9,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// settlement_delay holds instant payouts for this long. Only the module
+	// authority can set it.
+	SettlementDelay time.Duration `protobuf:"bytes,10,opt,name=settlement_delay,json=settlementDelay,proto3,stdduration" json:"settlement_delay"`
+	BatchMaxAge     time.Duration `protobuf:"bytes,11,opt,name=batch_max_age,json=batchMaxAge,proto3,stdduration" json:"batch_max_age"`
+	SettlementDenom string        `protobuf:"bytes,12,opt,name=settlement_denom,json=settlementDenom,proto3" json:"settlement_denom,omitempty"`
 }
 
 func (m *MsgRegisterMerchant) Reset()         { *m = MsgRegisterMerchant{} }
@@ -1079,6 +1106,27 @@ func (m *MsgRegisterMerchant) GetWebhookUrl() string {
 	return ""
 }
 
+func (m *MsgRegisterMerchant) GetSettlementDelay() time.Duration {
+	if m != nil {
+		return m.SettlementDelay
+	}
+	return 0
+}
+
+func (m *MsgRegisterMerchant) GetBatchMaxAge() time.Duration {
+	if m != nil {
+		return m.BatchMaxAge
+	}
+	return 0
+}
+
+func (m *MsgRegisterMerchant) GetSettlementDenom() string {
+	if m != nil {
+		return m.SettlementDenom
+	}
+	return ""
+}
+
 type MsgRegisterMerchantResponse struct {
 }
 
@@ -1126,6 +1174,13 @@ type MsgUpdateMerchant struct {
 	BatchThreshold github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=batch_threshold,json=batchThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"batch_threshold"`
 	IsActive       *bool                                   `protobuf:"bytes,9,opt,name=is_active,json=isActive,proto3,wktptr" json:"is_active,omitempty"`
 	WebhookUrl     string                                  `protobuf:"bytes,10,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// settlement_delay is left unchanged when unset. Only the module authority
+	// can set it.
+	SettlementDelay *time.Duration `protobuf:"bytes,11,opt,name=settlement_delay,json=settlementDelay,proto3,stdduration" json:"settlement_delay,omitempty"`
+	// batch_max_age is left unchanged when unset
+	BatchMaxAge *time.Duration `protobuf:"bytes,12,opt,name=batch_max_age,json=batchMaxAge,proto3,stdduration" json:"batch_max_age,omitempty"`
+	// settlement_denom is left unchanged when empty
+	SettlementDenom string `protobuf:"bytes,13,opt,name=settlement_denom,json=settlementDenom,proto3" json:"settlement_denom,omitempty"`
 }
 
 func (m *MsgUpdateMerchant) Reset()         { *m = MsgUpdateMerchant{} }
@@ -1210,6 +1265,27 @@ func (m *MsgUpdateMerchant) GetWebhookUrl() string {
 	return ""
 }
 
+func (m *MsgUpdateMerchant) GetSettlementDelay() *time.Duration {
+	if m != nil {
+		return m.SettlementDelay
+	}
+	return nil
+}
+
+func (m *MsgUpdateMerchant) GetBatchMaxAge() *time.Duration {
+	if m != nil {
+		return m.BatchMaxAge
+	}
+	return nil
+}
+
+func (m *MsgUpdateMerchant) GetSettlementDenom() string {
+	if m != nil {
+		return m.SettlementDenom
+	}
+	return ""
+}
+
 type MsgUpdateMerchantResponse struct {
 }
 
@@ -1254,6 +1330,13 @@ type MsgInstantCheckout struct {
 	UseEscrow      bool                                    `protobuf:"varint,5,opt,name=use_escrow,json=useEscrow,proto3" json:"use_escrow,omitempty"`
 	Items          []CheckoutItem                          `protobuf:"bytes,6,rep,name=items,proto3" json:"items"`
 	Metadata       string                                  `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// max_slippage_bps bounds how far below the oracle rate a payment in
+	// another denom may convert. Zero uses the module default.
+	MaxSlippageBps uint32 `protobuf:"varint,8,opt,name=max_slippage_bps,json=maxSlippageBps,proto3" json:"max_slippage_bps,omitempty"`
+	// split_name selects one of the merchant's named split templates to pay
+	// the net amount to several payees. Empty applies the merchant's default
+	// template, if any.
+	SplitName string `protobuf:"bytes,9,opt,name=split_name,json=splitName,proto3" json:"split_name,omitempty"`
 }
 
 func (m *MsgInstantCheckout) Reset()         { *m = MsgInstantCheckout{} }
@@ -1331,6 +1414,20 @@ func (m *MsgInstantCheckout) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgInstantCheckout) GetMaxSlippageBps() uint32 {
+	if m != nil {
+		return m.MaxSlippageBps
+	}
+	return 0
+}
+
+func (m *MsgInstantCheckout) GetSplitName() string {
+	if m != nil {
+		return m.SplitName
+	}
+	return ""
+}
+
 type MsgInstantCheckoutResponse struct {
 	SettlementId  uint64                                  `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
 	Status        string                                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1492,2468 +1589,17510 @@ func (m *MsgPartialRefundResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPartialRefundResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgInstantTransfer)(nil), "stateset.settlement.MsgInstantTransfer")
-	proto.RegisterType((*MsgInstantTransferResponse)(nil), "stateset.settlement.MsgInstantTransferResponse")
-	proto.RegisterType((*MsgCreateEscrow)(nil), "stateset.settlement.MsgCreateEscrow")
-	proto.RegisterType((*MsgCreateEscrowResponse)(nil), "stateset.settlement.MsgCreateEscrowResponse")
-	proto.RegisterType((*MsgReleaseEscrow)(nil), "stateset.settlement.MsgReleaseEscrow")
-	proto.RegisterType((*MsgReleaseEscrowResponse)(nil), "stateset.settlement.MsgReleaseEscrowResponse")
-	proto.RegisterType((*MsgRefundEscrow)(nil), "stateset.settlement.MsgRefundEscrow")
-	proto.RegisterType((*MsgRefundEscrowResponse)(nil), "stateset.settlement.MsgRefundEscrowResponse")
-	proto.RegisterType((*MsgCreateBatch)(nil), "stateset.settlement.MsgCreateBatch")
-	proto.RegisterType((*MsgCreateBatchResponse)(nil), "stateset.settlement.MsgCreateBatchResponse")
-	proto.RegisterType((*MsgSettleBatch)(nil), "stateset.settlement.MsgSettleBatch")
-	proto.RegisterType((*MsgSettleBatchResponse)(nil), "stateset.settlement.MsgSettleBatchResponse")
-	proto.RegisterType((*MsgOpenChannel)(nil), "stateset.settlement.MsgOpenChannel")
-	proto.RegisterType((*MsgOpenChannelResponse)(nil), "stateset.settlement.MsgOpenChannelResponse")
-	proto.RegisterType((*MsgCloseChannel)(nil), "stateset.settlement.MsgCloseChannel")
-	proto.RegisterType((*MsgCloseChannelResponse)(nil), "stateset.settlement.MsgCloseChannelResponse")
-	proto.RegisterType((*MsgClaimChannel)(nil), "stateset.settlement.MsgClaimChannel")
-	proto.RegisterType((*MsgClaimChannelResponse)(nil), "stateset.settlement.MsgClaimChannelResponse")
-	proto.RegisterType((*MsgRegisterMerchant)(nil), "stateset.settlement.MsgRegisterMerchant")
-	proto.RegisterType((*MsgRegisterMerchantResponse)(nil), "stateset.settlement.MsgRegisterMerchantResponse")
-	proto.RegisterType((*MsgUpdateMerchant)(nil), "stateset.settlement.MsgUpdateMerchant")
-	proto.RegisterType((*MsgUpdateMerchantResponse)(nil), "stateset.settlement.MsgUpdateMerchantResponse")
-	proto.RegisterType((*MsgInstantCheckout)(nil), "stateset.settlement.MsgInstantCheckout")
-	proto.RegisterType((*MsgInstantCheckoutResponse)(nil), "stateset.settlement.MsgInstantCheckoutResponse")
-	proto.RegisterType((*MsgPartialRefund)(nil), "stateset.settlement.MsgPartialRefund")
-	proto.RegisterType((*MsgPartialRefundResponse)(nil), "stateset.settlement.MsgPartialRefundResponse")
+type MsgCreateMultiPartyEscrow struct {
+	Sender    string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Arbiters  []string                                `protobuf:"bytes,4,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	Threshold uint32                                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Reference string                                  `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata  string                                  `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ExpiresIn time.Duration                           `protobuf:"bytes,8,opt,name=expires_in,json=expiresIn,proto3,stdduration" json:"expires_in"`
 }
 
-func init() { proto.RegisterFile("stateset/settlement/tx.proto", fileDescriptor_19e3855a8d88c072) }
-
-var fileDescriptor_19e3855a8d88c072 = []byte{
-	// 1708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xbd, 0x6f, 0x1b, 0xc9,
-	0x15, 0xd7, 0x92, 0xd4, 0x07, 0x9f, 0x44, 0xca, 0xde, 0x3b, 0x9c, 0x57, 0xbc, 0x33, 0xa5, 0xd0,
-	0xbe, 0x58, 0xf0, 0xe5, 0xc8, 0xd8, 0xe9, 0x0e, 0xb8, 0x42, 0x54, 0x14, 0x9c, 0x0a, 0x21, 0xc1,
-	0x9e, 0x1d, 0x20, 0xc6, 0x01, 0x8b, 0xe1, 0xee, 0x23, 0xb9, 0xd0, 0xee, 0x0c, 0xb3, 0x33, 0xb4,
-	0x74, 0x40, 0x8a, 0x20, 0x41, 0x52, 0xa4, 0xba, 0x26, 0x40, 0xca, 0xfc, 0x09, 0x57, 0x5d, 0x9f,
-	0xce, 0xe9, 0x5c, 0x06, 0x01, 0xe2, 0x04, 0x32, 0x02, 0x77, 0xe9, 0x92, 0x22, 0x55, 0xb0, 0x33,
-	0xbb, 0xb3, 0xbb, 0xfc, 0x90, 0x68, 0x83, 0x32, 0x82, 0x20, 0x95, 0x38, 0x33, 0x6f, 0xe7, 0xf7,
-	0xde, 0x9b, 0xdf, 0xbc, 0x37, 0xef, 0x09, 0x3e, 0xe0, 0x82, 0x08, 0xe4, 0x28, 0x3a, 0x1c, 0x85,
-	0x08, 0x30, 0x44, 0x2a, 0x3a, 0xe2, 0xbc, 0x3d, 0x8a, 0x98, 0x60, 0xe6, 0x3b, 0xe9, 0x6a, 0x3b,
-	0x5b, 0x6d, 0xbc, 0x3b, 0x60, 0x03, 0x26, 0xd7, 0x3b, 0xf1, 0x2f, 0x25, 0xda, 0x68, 0xba, 0x8c,
-	0x87, 0x8c, 0x77, 0x7a, 0x84, 0x63, 0xe7, 0xe9, 0x83, 0x1e, 0x0a, 0xf2, 0xa0, 0xe3, 0x32, 0x9f,
-	0x26, 0xeb, 0xb7, 0x92, 0xf5, 0x90, 0x0f, 0x3a, 0x4f, 0x1f, 0xc4, 0x7f, 0xd2, 0x0f, 0x07, 0x8c,
-	0x0d, 0x02, 0xec, 0xc8, 0x51, 0x6f, 0xdc, 0xef, 0x78, 0xe3, 0x88, 0x08, 0x9f, 0xa5, 0x1f, 0xee,
-	0x4e, 0xae, 0x0b, 0x3f, 0x44, 0x2e, 0x48, 0x38, 0x9a, 0xb7, 0xc1, 0x59, 0x44, 0x46, 0x23, 0x8c,
-	0x78, 0xb2, 0x7e, 0x77, 0x96, 0x89, 0xd9, 0x4f, 0x25, 0xd5, 0xfa, 0xa7, 0x01, 0xe6, 0x09, 0x1f,
-	0x1c, 0x53, 0x2e, 0x08, 0x15, 0x8f, 0x22, 0x42, 0x79, 0x1f, 0x23, 0xf3, 0x3d, 0x58, 0xe3, 0x48,
-	0x3d, 0x8c, 0x2c, 0x63, 0xcf, 0xd8, 0xaf, 0xda, 0xc9, 0xc8, 0xfc, 0x00, 0xaa, 0x11, 0xba, 0xfe,
-	0xc8, 0x47, 0x2a, 0xac, 0x92, 0x5c, 0xca, 0x26, 0xcc, 0x1e, 0xac, 0x91, 0x90, 0x8d, 0xa9, 0xb0,
-	0xca, 0x7b, 0xc6, 0xfe, 0xe6, 0xc3, 0x9d, 0xb6, 0xb2, 0xbe, 0x1d, 0x7b, 0xa7, 0x9d, 0x78, 0xa7,
-	0x7d, 0xc8, 0x7c, 0xda, 0xed, 0x3c, 0x7b, 0xb1, 0xbb, 0xf2, 0xe7, 0x17, 0xbb, 0xf7, 0x06, 0xbe,
-	0x18, 0x8e, 0x7b, 0x6d, 0x97, 0x85, 0x9d, 0xc4, 0x55, 0xea, 0xcf, 0xc7, 0xdc, 0x3b, 0xed, 0x88,
-	0x2f, 0x47, 0xc8, 0xe5, 0x07, 0x76, 0xb2, 0xb3, 0xd2, 0xa0, 0x8f, 0x11, 0x52, 0x17, 0xad, 0x4a,
-	0xaa, 0x41, 0x32, 0x61, 0x36, 0x60, 0x23, 0x44, 0x41, 0x3c, 0x22, 0x88, 0xb5, 0x2a, 0x17, 0xf5,
-	0xf8, 0x93, 0xcd, 0x5f, 0xbc, 0xfa, 0xfa, 0x7e, 0x62, 0x48, 0xeb, 0x09, 0x34, 0xa6, 0xcd, 0xb6,
-	0x91, 0x8f, 0x18, 0xe5, 0x68, 0xde, 0x81, 0x5a, 0xe6, 0x29, 0xc7, 0xf7, 0xa4, 0x17, 0x2a, 0xf6,
-	0x56, 0x36, 0x79, 0xec, 0x99, 0xb7, 0x60, 0x5d, 0x9c, 0x3b, 0x43, 0xc2, 0x87, 0x89, 0x27, 0xd6,
-	0xc4, 0xf9, 0x67, 0x84, 0x0f, 0x5b, 0xdf, 0x94, 0x60, 0xfb, 0x84, 0x0f, 0x0e, 0x23, 0x24, 0x02,
-	0x8f, 0xb8, 0x1b, 0xb1, 0xb3, 0xff, 0x45, 0x87, 0x9a, 0x5d, 0x00, 0x3c, 0x1f, 0xf9, 0x11, 0x72,
-	0xc7, 0xa7, 0xd6, 0x5a, 0xa2, 0xa1, 0xa2, 0x65, 0x3b, 0xa5, 0x65, 0xfb, 0xfb, 0x09, 0xaf, 0xbb,
-	0x1b, 0xb1, 0x86, 0xbf, 0xfb, 0xeb, 0xae, 0x61, 0x57, 0x93, 0xcf, 0x8e, 0x69, 0xf1, 0x50, 0x7e,
-	0x69, 0xc0, 0xad, 0x09, 0xc7, 0xbd, 0xde, 0x91, 0x1c, 0x66, 0x1a, 0x11, 0xe5, 0xce, 0xcd, 0x87,
-	0x8d, 0x29, 0x8d, 0x1e, 0xa5, 0x37, 0x49, 0xa9, 0xf4, 0x55, 0x5e, 0xa5, 0x03, 0xd1, 0xfa, 0x02,
-	0x6e, 0x9c, 0xf0, 0x81, 0x8d, 0x01, 0x12, 0x7e, 0xd5, 0xf1, 0x4d, 0x69, 0x55, 0x9a, 0xd6, 0xaa,
-	0x68, 0x63, 0x03, 0xac, 0xc9, 0xdd, 0x53, 0x1b, 0x5b, 0x3f, 0x93, 0xbc, 0xb1, 0xb1, 0x3f, 0xa6,
-	0x5e, 0x02, 0x5c, 0xe0, 0x87, 0x31, 0xc9, 0x8f, 0x45, 0xe0, 0x63, 0xdd, 0x23, 0x24, 0x9c, 0x51,
-	0x49, 0xa2, 0xaa, 0x9d, 0x8c, 0x3e, 0xa9, 0xc7, 0x6a, 0x65, 0x9b, 0xb5, 0x76, 0xa4, 0xf3, 0xf3,
-	0xe8, 0x5a, 0xb1, 0x7f, 0x1b, 0x50, 0xd7, 0x07, 0xd3, 0x25, 0xc2, 0x1d, 0xc6, 0x8a, 0x91, 0xb1,
-	0x18, 0xb2, 0xc8, 0x17, 0x5f, 0xa6, 0x8a, 0xe9, 0x09, 0x45, 0x9b, 0xc8, 0x1d, 0x12, 0xcd, 0x6a,
-	0x3d, 0x36, 0x2d, 0x58, 0x57, 0xbe, 0xe0, 0x56, 0x79, 0xaf, 0xbc, 0x5f, 0xb5, 0xd3, 0xa1, 0xe9,
-	0xc1, 0xba, 0x22, 0x25, 0xb7, 0x2a, 0x7b, 0xe5, 0x25, 0xf3, 0x3d, 0xdd, 0xda, 0x6c, 0x02, 0x68,
-	0x7e, 0x73, 0x6b, 0x55, 0xaa, 0x90, 0x9b, 0x49, 0xfc, 0xa2, 0x6d, 0x69, 0x3d, 0x81, 0xf7, 0x8a,
-	0xb6, 0x6b, 0x4e, 0xee, 0xc0, 0x46, 0x2f, 0x9e, 0xc8, 0xe8, 0xb8, 0x2e, 0xc7, 0xc7, 0x9e, 0xf9,
-	0x21, 0xd4, 0x0b, 0x27, 0xc3, 0xad, 0xd2, 0x5e, 0x79, 0xbf, 0x62, 0xd7, 0xf2, 0x47, 0xc3, 0x5b,
-	0x3f, 0x91, 0x7e, 0xfd, 0x5c, 0xce, 0x2d, 0xe2, 0xd7, 0x3c, 0x62, 0xa9, 0x80, 0x38, 0xa5, 0xf6,
-	0x5f, 0x4a, 0x52, 0xef, 0xdc, 0xde, 0x5a, 0xef, 0x10, 0xb6, 0x04, 0x13, 0x24, 0x70, 0x92, 0xe0,
-	0x62, 0x2c, 0x3d, 0xb8, 0x6c, 0xca, 0xfd, 0x0f, 0x54, 0x84, 0xf1, 0x01, 0x14, 0x5c, 0x1f, 0x91,
-	0x27, 0xb7, 0x72, 0x99, 0x60, 0x55, 0xb9, 0xfb, 0x0f, 0x10, 0x79, 0x0c, 0x45, 0x51, 0x38, 0xd7,
-	0x16, 0x34, 0xab, 0x14, 0x85, 0xb2, 0xaa, 0xf5, 0x77, 0x75, 0x27, 0x7e, 0x38, 0x42, 0x7a, 0x38,
-	0x24, 0x94, 0x62, 0xf0, 0x86, 0x41, 0xde, 0x83, 0x75, 0x0f, 0x47, 0x8c, 0xfb, 0xd7, 0xa1, 0x70,
-	0xba, 0xb5, 0x79, 0x1f, 0x6e, 0x66, 0xc1, 0xda, 0xe9, 0x05, 0xcc, 0x3d, 0xe5, 0x32, 0xdc, 0x97,
-	0xed, 0x6d, 0x1d, 0x8e, 0xbb, 0x72, 0xba, 0x18, 0xb0, 0x5c, 0x49, 0xa3, 0x9c, 0x99, 0x9a, 0x46,
-	0xb7, 0x01, 0x5c, 0x35, 0x95, 0x5d, 0x80, 0x6a, 0x32, 0x73, 0xec, 0xe5, 0x11, 0x89, 0x70, 0x86,
-	0xe8, 0x0f, 0x86, 0xca, 0xfa, 0x0c, 0xf1, 0x40, 0x7c, 0x26, 0xa7, 0x5b, 0x8f, 0x55, 0xc6, 0x0c,
-	0x18, 0xc7, 0x9c, 0x33, 0xdd, 0x78, 0xac, 0x9d, 0xa9, 0x46, 0x13, 0xa8, 0xa5, 0x09, 0xd4, 0x44,
-	0x77, 0x25, 0xdb, 0xfa, 0x4d, 0x92, 0x50, 0x72, 0xfb, 0x6a, 0xed, 0x19, 0xd4, 0xfa, 0x3e, 0x25,
-	0x81, 0xd3, 0x23, 0x01, 0x89, 0x73, 0xdf, 0xf2, 0x6f, 0xc1, 0x96, 0x04, 0xe8, 0xaa, 0xfd, 0x5b,
-	0xff, 0x32, 0x12, 0x23, 0x89, 0x1f, 0xa6, 0x46, 0x5e, 0x1e, 0xde, 0x2f, 0x37, 0xf5, 0xad, 0xbc,
-	0x0e, 0xde, 0x85, 0x55, 0xca, 0xd2, 0x97, 0x41, 0xc5, 0x56, 0x83, 0x58, 0x6d, 0xee, 0x0f, 0x28,
-	0x11, 0xe3, 0x08, 0x93, 0x67, 0x41, 0x36, 0x31, 0x95, 0x58, 0x7e, 0x55, 0x4a, 0x4e, 0x21, 0x33,
-	0x5c, 0x9f, 0xc2, 0x4f, 0xa1, 0xae, 0x90, 0x1c, 0x37, 0x5e, 0x46, 0xef, 0x1a, 0x8e, 0xa1, 0xa6,
-	0x10, 0x0e, 0x15, 0x80, 0x79, 0x06, 0x37, 0x23, 0x0c, 0x89, 0x4f, 0x7d, 0x3a, 0xd0, 0x87, 0xbf,
-	0xfc, 0xa8, 0x74, 0x43, 0x83, 0xa4, 0x04, 0xf8, 0xa6, 0x02, 0xef, 0xc8, 0x0c, 0x3b, 0xf0, 0xb9,
-	0xc0, 0xe8, 0x24, 0x4d, 0x88, 0x6f, 0x9e, 0x4a, 0x4d, 0xa8, 0x50, 0x12, 0x62, 0x92, 0xd8, 0xe5,
-	0x6f, 0x73, 0x0f, 0xb6, 0xfa, 0x88, 0x4e, 0x44, 0x04, 0x3a, 0xbd, 0x91, 0xba, 0xe3, 0x35, 0x1b,
-	0xfa, 0x88, 0x76, 0x9c, 0xc0, 0x46, 0x3c, 0xf6, 0x79, 0xe8, 0x53, 0x27, 0xcb, 0x44, 0xf2, 0x08,
-	0x97, 0xec, 0xf3, 0xd0, 0xa7, 0x9f, 0x6b, 0x00, 0x09, 0x49, 0xce, 0xf3, 0x90, 0x6b, 0xd7, 0x00,
-	0x49, 0xce, 0x73, 0x90, 0x77, 0xa0, 0xa6, 0x52, 0x25, 0x52, 0xd2, 0x0b, 0xd0, 0xb3, 0xd6, 0xf7,
-	0x8c, 0xfd, 0x0d, 0x7b, 0x4b, 0x4e, 0x1e, 0xa9, 0x39, 0x93, 0xc3, 0xb6, 0x12, 0x12, 0xc3, 0x08,
-	0xf9, 0x90, 0x05, 0x9e, 0xb5, 0xb1, 0x74, 0xc5, 0xea, 0x12, 0xe2, 0x51, 0x8a, 0x60, 0xee, 0xc2,
-	0xe6, 0x19, 0xf6, 0x86, 0x8c, 0x9d, 0x3a, 0xe3, 0x28, 0xb0, 0xaa, 0xf2, 0xf0, 0x20, 0x99, 0x7a,
-	0x1c, 0x05, 0x53, 0xa9, 0xfc, 0x36, 0xbc, 0x3f, 0x83, 0x37, 0xfa, 0x75, 0xf6, 0xdb, 0x55, 0xb8,
-	0x79, 0xc2, 0x07, 0x8f, 0x47, 0x1e, 0x11, 0xf8, 0x7f, 0x56, 0x5d, 0x2b, 0xab, 0x8e, 0x66, 0xb1,
-	0x6a, 0x56, 0x91, 0xd1, 0x65, 0x2c, 0xf8, 0x31, 0x09, 0xc6, 0xd8, 0xad, 0xfc, 0x3e, 0x2e, 0x30,
-	0xfe, 0x0b, 0x78, 0xf7, 0x29, 0x54, 0x7d, 0xee, 0x10, 0x57, 0xf8, 0x4f, 0x51, 0xb2, 0x6e, 0x11,
-	0xbd, 0x37, 0x7c, 0x7e, 0x20, 0xbf, 0x98, 0xa4, 0x2d, 0x5c, 0x49, 0xdb, 0xf7, 0x61, 0x67, 0x8a,
-	0x96, 0x9a, 0xb4, 0x17, 0xa5, 0x7c, 0xe3, 0xe1, 0x70, 0x88, 0xee, 0x29, 0x1b, 0x8b, 0x98, 0x97,
-	0xee, 0x98, 0x0b, 0x16, 0xea, 0xbc, 0xaf, 0xc7, 0x97, 0x72, 0xf6, 0x6d, 0xe4, 0xc2, 0x7b, 0xb0,
-	0xcd, 0x22, 0x0f, 0x23, 0x67, 0xb2, 0x5e, 0xae, 0xcb, 0x69, 0x5b, 0x17, 0xcd, 0xb7, 0x01, 0xc6,
-	0x1c, 0x1d, 0x94, 0x45, 0x94, 0xbc, 0x06, 0x1b, 0x76, 0x75, 0xac, 0x8b, 0xc9, 0x4f, 0x61, 0xd5,
-	0x17, 0x18, 0x72, 0x6b, 0x4d, 0x16, 0x39, 0xdf, 0x6a, 0xcf, 0x68, 0x37, 0xb5, 0x53, 0x8f, 0x1c,
-	0x0b, 0x0c, 0xbb, 0x95, 0x58, 0x65, 0x5b, 0x7d, 0x55, 0x28, 0xc9, 0xd7, 0x27, 0x7a, 0x1c, 0xb5,
-	0xf8, 0x08, 0xb4, 0xc7, 0x5a, 0x7f, 0x2c, 0xe5, 0xdb, 0x1c, 0xe9, 0x96, 0xaf, 0x57, 0x53, 0xc7,
-	0x8f, 0x5a, 0x41, 0xc4, 0x98, 0xa7, 0x5d, 0x0e, 0x35, 0x7a, 0x8b, 0x4f, 0x6d, 0xf3, 0x0b, 0x28,
-	0xf7, 0x51, 0x39, 0x7b, 0xb9, 0x18, 0xf1, 0xb6, 0x71, 0xa9, 0x26, 0x22, 0x42, 0x79, 0x7c, 0x31,
-	0x18, 0x8d, 0xdd, 0xa0, 0x5e, 0x34, 0xb5, 0xdc, 0xec, 0xb1, 0xd7, 0xfa, 0x87, 0x21, 0xfb, 0x02,
-	0x3f, 0x22, 0x91, 0xf0, 0x49, 0xa0, 0xca, 0xe4, 0x2b, 0x82, 0xec, 0x42, 0xe5, 0x39, 0x83, 0x5a,
-	0x24, 0x37, 0xbb, 0x3e, 0x57, 0x6e, 0x29, 0x80, 0xc4, 0x9b, 0x59, 0x3f, 0xa0, 0x32, 0xa3, 0x1f,
-	0x90, 0x5d, 0xdf, 0x5f, 0x97, 0x64, 0xab, 0xa2, 0x60, 0xb0, 0xa6, 0x0e, 0x87, 0x6d, 0xb5, 0x29,
-	0x7a, 0xd7, 0x57, 0x45, 0xd6, 0x53, 0x88, 0x44, 0xf3, 0x31, 0x64, 0x8f, 0xaa, 0x14, 0x75, 0xf9,
-	0x0f, 0xb7, 0x6d, 0x8d, 0xa1, 0x60, 0x1f, 0xfe, 0x01, 0xa0, 0x7c, 0xc2, 0x07, 0xe6, 0x29, 0x6c,
-	0x4f, 0xf6, 0x49, 0xef, 0xcd, 0xbc, 0xbb, 0xd3, 0x9d, 0xc5, 0x46, 0x67, 0x41, 0x41, 0xed, 0xe0,
-	0x1e, 0x6c, 0x15, 0x1a, 0x88, 0x77, 0xe7, 0x6d, 0x90, 0x97, 0x6a, 0x7c, 0x67, 0x11, 0x29, 0x8d,
-	0x81, 0x50, 0x2b, 0xb6, 0xb9, 0x3e, 0x9c, 0xf7, 0x79, 0x41, 0xac, 0xf1, 0xf1, 0x42, 0x62, 0x79,
-	0x53, 0x0a, 0x3d, 0xad, 0xbb, 0xf3, 0x3f, 0xcf, 0xa4, 0xe6, 0x9b, 0x32, 0xab, 0x43, 0x65, 0x3a,
-	0xb0, 0x99, 0xef, 0x4e, 0xdd, 0xb9, 0xdc, 0x0f, 0x52, 0xa8, 0xf1, 0xd1, 0x02, 0x42, 0x79, 0x80,
-	0x7c, 0x9b, 0x66, 0x2e, 0x40, 0x4e, 0x68, 0x3e, 0xc0, 0xac, 0xa6, 0x8c, 0x03, 0x9b, 0xf9, 0x5e,
-	0xc2, 0x5c, 0x80, 0x9c, 0xd0, 0x7c, 0x80, 0x59, 0xe5, 0x7a, 0xcc, 0xa8, 0x7c, 0x81, 0x3d, 0x9f,
-	0x51, 0x39, 0xa9, 0x4b, 0x18, 0x35, 0xab, 0xa8, 0x96, 0x18, 0xb9, 0xfa, 0xf6, 0x12, 0x8c, 0x4c,
-	0xea, 0x32, 0x8c, 0x19, 0x25, 0x23, 0x85, 0x1b, 0x53, 0x25, 0xd4, 0xfe, 0x7c, 0xb2, 0x14, 0x25,
-	0x1b, 0xdf, 0x5d, 0x54, 0x52, 0xe3, 0x0d, 0xa1, 0x3e, 0xf1, 0xb4, 0xfe, 0xf6, 0xbc, 0x3d, 0x8a,
-	0x72, 0x8d, 0xf6, 0x62, 0x72, 0x1a, 0x29, 0x0b, 0x30, 0xfa, 0x3d, 0x74, 0x55, 0x80, 0x49, 0x05,
-	0xaf, 0x0c, 0x30, 0x53, 0xc9, 0x1f, 0xa1, 0x56, 0xcc, 0x65, 0x73, 0x2f, 0x7f, 0x41, 0x6c, 0xfe,
-	0xe5, 0x9f, 0x99, 0x28, 0x1a, 0xab, 0x3f, 0x7f, 0xf5, 0xf5, 0x7d, 0xa3, 0x7b, 0xf4, 0xec, 0xa2,
-	0x69, 0x3c, 0xbf, 0x68, 0x1a, 0x7f, 0xbb, 0x68, 0x1a, 0x5f, 0xbd, 0x6c, 0xae, 0x3c, 0x7f, 0xd9,
-	0x5c, 0xf9, 0xd3, 0xcb, 0xe6, 0xca, 0x93, 0x8f, 0x72, 0x71, 0x59, 0xff, 0xcb, 0xca, 0x65, 0x11,
-	0x76, 0xce, 0x0b, 0xff, 0x9c, 0x8b, 0x03, 0x74, 0x6f, 0x4d, 0xbe, 0x53, 0xbf, 0xf7, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x30, 0x6b, 0x72, 0x7b, 0xc0, 0x1b, 0x00, 0x00,
+func (m *MsgCreateMultiPartyEscrow) Reset()         { *m = MsgCreateMultiPartyEscrow{} }
+func (m *MsgCreateMultiPartyEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultiPartyEscrow) ProtoMessage()    {}
+func (*MsgCreateMultiPartyEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{26}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	InstantTransfer(ctx context.Context, in *MsgInstantTransfer, opts ...grpc.CallOption) (*MsgInstantTransferResponse, error)
-	CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error)
-	ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error)
-	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
-	CreateBatch(ctx context.Context, in *MsgCreateBatch, opts ...grpc.CallOption) (*MsgCreateBatchResponse, error)
-	SettleBatch(ctx context.Context, in *MsgSettleBatch, opts ...grpc.CallOption) (*MsgSettleBatchResponse, error)
-	OpenChannel(ctx context.Context, in *MsgOpenChannel, opts ...grpc.CallOption) (*MsgOpenChannelResponse, error)
-	CloseChannel(ctx context.Context, in *MsgCloseChannel, opts ...grpc.CallOption) (*MsgCloseChannelResponse, error)
-	ClaimChannel(ctx context.Context, in *MsgClaimChannel, opts ...grpc.CallOption) (*MsgClaimChannelResponse, error)
-	RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error)
-	UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error)
-	InstantCheckout(ctx context.Context, in *MsgInstantCheckout, opts ...grpc.CallOption) (*MsgInstantCheckoutResponse, error)
-	PartialRefund(ctx context.Context, in *MsgPartialRefund, opts ...grpc.CallOption) (*MsgPartialRefundResponse, error)
+func (m *MsgCreateMultiPartyEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateMultiPartyEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultiPartyEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateMultiPartyEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultiPartyEscrow.Merge(m, src)
 }
-
-func (c *msgClient) InstantTransfer(ctx context.Context, in *MsgInstantTransfer, opts ...grpc.CallOption) (*MsgInstantTransferResponse, error) {
-	out := new(MsgInstantTransferResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/InstantTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateMultiPartyEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultiPartyEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultiPartyEscrow.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error) {
-	out := new(MsgCreateEscrowResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateEscrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMultiPartyEscrow proto.InternalMessageInfo
+
+func (m *MsgCreateMultiPartyEscrow) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error) {
-	out := new(MsgReleaseEscrowResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ReleaseEscrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
-	out := new(MsgRefundEscrowResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RefundEscrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetArbiters() []string {
+	if m != nil {
+		return m.Arbiters
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CreateBatch(ctx context.Context, in *MsgCreateBatch, opts ...grpc.CallOption) (*MsgCreateBatchResponse, error) {
-	out := new(MsgCreateBatchResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SettleBatch(ctx context.Context, in *MsgSettleBatch, opts ...grpc.CallOption) (*MsgSettleBatchResponse, error) {
-	out := new(MsgSettleBatchResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SettleBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) OpenChannel(ctx context.Context, in *MsgOpenChannel, opts ...grpc.CallOption) (*MsgOpenChannelResponse, error) {
-	out := new(MsgOpenChannelResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/OpenChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CloseChannel(ctx context.Context, in *MsgCloseChannel, opts ...grpc.CallOption) (*MsgCloseChannelResponse, error) {
-	out := new(MsgCloseChannelResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CloseChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrow) GetExpiresIn() time.Duration {
+	if m != nil {
+		return m.ExpiresIn
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ClaimChannel(ctx context.Context, in *MsgClaimChannel, opts ...grpc.CallOption) (*MsgClaimChannelResponse, error) {
-	out := new(MsgClaimChannelResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ClaimChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCreateMultiPartyEscrowResponse struct {
+	SettlementId uint64    `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	ExpiresAt    time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
 }
 
-func (c *msgClient) RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error) {
-	out := new(MsgRegisterMerchantResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RegisterMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrowResponse) Reset()         { *m = MsgCreateMultiPartyEscrowResponse{} }
+func (m *MsgCreateMultiPartyEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMultiPartyEscrowResponse) ProtoMessage()    {}
+func (*MsgCreateMultiPartyEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{27}
+}
+func (m *MsgCreateMultiPartyEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMultiPartyEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMultiPartyEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateMultiPartyEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMultiPartyEscrowResponse.Merge(m, src)
+}
+func (m *MsgCreateMultiPartyEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMultiPartyEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMultiPartyEscrowResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error) {
-	out := new(MsgUpdateMerchantResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/UpdateMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMultiPartyEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMultiPartyEscrowResponse) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) InstantCheckout(ctx context.Context, in *MsgInstantCheckout, opts ...grpc.CallOption) (*MsgInstantCheckoutResponse, error) {
-	out := new(MsgInstantCheckoutResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/InstantCheckout", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMultiPartyEscrowResponse) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *msgClient) PartialRefund(ctx context.Context, in *MsgPartialRefund, opts ...grpc.CallOption) (*MsgPartialRefundResponse, error) {
-	out := new(MsgPartialRefundResponse)
-	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/PartialRefund", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgApproveEscrowResolution struct {
+	Signer       string           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	SettlementId uint64           `protobuf:"varint,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Resolution   EscrowResolution `protobuf:"bytes,3,opt,name=resolution,proto3,casttype=EscrowResolution" json:"resolution,omitempty"`
+	// recipient_amount is the share paid to the recipient for a split resolution
+	RecipientAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=recipient_amount,json=recipientAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"recipient_amount"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	InstantTransfer(context.Context, *MsgInstantTransfer) (*MsgInstantTransferResponse, error)
-	CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error)
-	ReleaseEscrow(context.Context, *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error)
-	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
-	CreateBatch(context.Context, *MsgCreateBatch) (*MsgCreateBatchResponse, error)
-	SettleBatch(context.Context, *MsgSettleBatch) (*MsgSettleBatchResponse, error)
-	OpenChannel(context.Context, *MsgOpenChannel) (*MsgOpenChannelResponse, error)
-	CloseChannel(context.Context, *MsgCloseChannel) (*MsgCloseChannelResponse, error)
-	ClaimChannel(context.Context, *MsgClaimChannel) (*MsgClaimChannelResponse, error)
-	RegisterMerchant(context.Context, *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error)
-	UpdateMerchant(context.Context, *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error)
-	InstantCheckout(context.Context, *MsgInstantCheckout) (*MsgInstantCheckoutResponse, error)
-	PartialRefund(context.Context, *MsgPartialRefund) (*MsgPartialRefundResponse, error)
+func (m *MsgApproveEscrowResolution) Reset()         { *m = MsgApproveEscrowResolution{} }
+func (m *MsgApproveEscrowResolution) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveEscrowResolution) ProtoMessage()    {}
+func (*MsgApproveEscrowResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{28}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgApproveEscrowResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) InstantTransfer(ctx context.Context, req *MsgInstantTransfer) (*MsgInstantTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantTransfer not implemented")
+func (m *MsgApproveEscrowResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveEscrowResolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateEscrow(ctx context.Context, req *MsgCreateEscrow) (*MsgCreateEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+func (m *MsgApproveEscrowResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveEscrowResolution.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ReleaseEscrow(ctx context.Context, req *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+func (m *MsgApproveEscrowResolution) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RefundEscrow(ctx context.Context, req *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+func (m *MsgApproveEscrowResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveEscrowResolution.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateBatch(ctx context.Context, req *MsgCreateBatch) (*MsgCreateBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
+
+var xxx_messageInfo_MsgApproveEscrowResolution proto.InternalMessageInfo
+
+func (m *MsgApproveEscrowResolution) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SettleBatch(ctx context.Context, req *MsgSettleBatch) (*MsgSettleBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettleBatch not implemented")
+
+func (m *MsgApproveEscrowResolution) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) OpenChannel(ctx context.Context, req *MsgOpenChannel) (*MsgOpenChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenChannel not implemented")
+
+func (m *MsgApproveEscrowResolution) GetResolution() EscrowResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CloseChannel(ctx context.Context, req *MsgCloseChannel) (*MsgCloseChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseChannel not implemented")
+
+type MsgApproveEscrowResolutionResponse struct {
+	Approvals uint32 `protobuf:"varint,1,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	Resolved  bool   `protobuf:"varint,2,opt,name=resolved,proto3" json:"resolved,omitempty"`
 }
-func (*UnimplementedMsgServer) ClaimChannel(ctx context.Context, req *MsgClaimChannel) (*MsgClaimChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimChannel not implemented")
+
+func (m *MsgApproveEscrowResolutionResponse) Reset()         { *m = MsgApproveEscrowResolutionResponse{} }
+func (m *MsgApproveEscrowResolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveEscrowResolutionResponse) ProtoMessage()    {}
+func (*MsgApproveEscrowResolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{29}
 }
-func (*UnimplementedMsgServer) RegisterMerchant(ctx context.Context, req *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterMerchant not implemented")
+func (m *MsgApproveEscrowResolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateMerchant(ctx context.Context, req *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+func (m *MsgApproveEscrowResolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveEscrowResolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) InstantCheckout(ctx context.Context, req *MsgInstantCheckout) (*MsgInstantCheckoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstantCheckout not implemented")
+func (m *MsgApproveEscrowResolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveEscrowResolutionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) PartialRefund(ctx context.Context, req *MsgPartialRefund) (*MsgPartialRefundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PartialRefund not implemented")
+func (m *MsgApproveEscrowResolutionResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgApproveEscrowResolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveEscrowResolutionResponse.DiscardUnknown(m)
 }
 
-func _Msg_InstantTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/InstantTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantTransfer(ctx, req.(*MsgInstantTransfer))
+var xxx_messageInfo_MsgApproveEscrowResolutionResponse proto.InternalMessageInfo
+
+func (m *MsgApproveEscrowResolutionResponse) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEscrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEscrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/CreateEscrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEscrow(ctx, req.(*MsgCreateEscrow))
+func (m *MsgApproveEscrowResolutionResponse) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReleaseEscrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReleaseEscrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/ReleaseEscrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReleaseEscrow(ctx, req.(*MsgReleaseEscrow))
-	}
-	return interceptor(ctx, in, info, handler)
+// MilestoneInput defines a milestone when creating a milestone escrow.
+type MilestoneInput struct {
+	Description string                                  `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Deadline    time.Time                               `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
 }
 
-func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRefundEscrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RefundEscrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/RefundEscrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+func (m *MilestoneInput) Reset()         { *m = MilestoneInput{} }
+func (m *MilestoneInput) String() string { return proto.CompactTextString(m) }
+func (*MilestoneInput) ProtoMessage()    {}
+func (*MilestoneInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{30}
+}
+func (m *MilestoneInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MilestoneInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MilestoneInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MilestoneInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneInput.Merge(m, src)
+}
+func (m *MilestoneInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *MilestoneInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneInput.DiscardUnknown(m)
 }
 
-func _Msg_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/CreateBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBatch(ctx, req.(*MsgCreateBatch))
+var xxx_messageInfo_MilestoneInput proto.InternalMessageInfo
+
+func (m *MilestoneInput) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SettleBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSettleBatch)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MilestoneInput) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SettleBatch(ctx, in)
+	return time.Time{}
+}
+
+type MsgCreateMilestoneEscrow struct {
+	Sender     string           `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient  string           `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Milestones []MilestoneInput `protobuf:"bytes,3,rep,name=milestones,proto3" json:"milestones"`
+	Reference  string           `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata   string           `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ExpiresIn  time.Duration    `protobuf:"bytes,6,opt,name=expires_in,json=expiresIn,proto3,stdduration" json:"expires_in"`
+}
+
+func (m *MsgCreateMilestoneEscrow) Reset()         { *m = MsgCreateMilestoneEscrow{} }
+func (m *MsgCreateMilestoneEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMilestoneEscrow) ProtoMessage()    {}
+func (*MsgCreateMilestoneEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{31}
+}
+func (m *MsgCreateMilestoneEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMilestoneEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMilestoneEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/SettleBatch",
+}
+func (m *MsgCreateMilestoneEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMilestoneEscrow.Merge(m, src)
+}
+func (m *MsgCreateMilestoneEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMilestoneEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMilestoneEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMilestoneEscrow proto.InternalMessageInfo
+
+func (m *MsgCreateMilestoneEscrow) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SettleBatch(ctx, req.(*MsgSettleBatch))
+	return ""
+}
+
+func (m *MsgCreateMilestoneEscrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_OpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenChannel)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateMilestoneEscrow) GetMilestones() []MilestoneInput {
+	if m != nil {
+		return m.Milestones
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenChannel(ctx, in)
+	return nil
+}
+
+func (m *MsgCreateMilestoneEscrow) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/OpenChannel",
+	return ""
+}
+
+func (m *MsgCreateMilestoneEscrow) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenChannel(ctx, req.(*MsgOpenChannel))
+	return ""
+}
+
+func (m *MsgCreateMilestoneEscrow) GetExpiresIn() time.Duration {
+	if m != nil {
+		return m.ExpiresIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/CloseChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseChannel(ctx, req.(*MsgCloseChannel))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCreateMilestoneEscrowResponse struct {
+	SettlementId uint64    `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	ExpiresAt    time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
 }
 
-func _Msg_ClaimChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimChannel)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateMilestoneEscrowResponse) Reset()         { *m = MsgCreateMilestoneEscrowResponse{} }
+func (m *MsgCreateMilestoneEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMilestoneEscrowResponse) ProtoMessage()    {}
+func (*MsgCreateMilestoneEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{32}
+}
+func (m *MsgCreateMilestoneEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMilestoneEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMilestoneEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimChannel(ctx, in)
+}
+func (m *MsgCreateMilestoneEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMilestoneEscrowResponse.Merge(m, src)
+}
+func (m *MsgCreateMilestoneEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMilestoneEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMilestoneEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateMilestoneEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMilestoneEscrowResponse) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/ClaimChannel",
+	return 0
+}
+
+func (m *MsgCreateMilestoneEscrowResponse) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimChannel(ctx, req.(*MsgClaimChannel))
+	return time.Time{}
+}
+
+type MsgReleaseMilestone struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SettlementId uint64 `protobuf:"varint,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Milestone    uint32 `protobuf:"varint,3,opt,name=milestone,proto3" json:"milestone,omitempty"`
+}
+
+func (m *MsgReleaseMilestone) Reset()         { *m = MsgReleaseMilestone{} }
+func (m *MsgReleaseMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseMilestone) ProtoMessage()    {}
+func (*MsgReleaseMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{33}
+}
+func (m *MsgReleaseMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReleaseMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseMilestone.Merge(m, src)
+}
+func (m *MsgReleaseMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseMilestone.DiscardUnknown(m)
 }
 
-func _Msg_RegisterMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterMerchant)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseMilestone proto.InternalMessageInfo
+
+func (m *MsgReleaseMilestone) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterMerchant(ctx, in)
+	return ""
+}
+
+func (m *MsgReleaseMilestone) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/RegisterMerchant",
+	return 0
+}
+
+func (m *MsgReleaseMilestone) GetMilestone() uint32 {
+	if m != nil {
+		return m.Milestone
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterMerchant(ctx, req.(*MsgRegisterMerchant))
+	return 0
+}
+
+type MsgReleaseMilestoneResponse struct {
+	NetAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=net_amount,json=netAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"net_amount"`
+}
+
+func (m *MsgReleaseMilestoneResponse) Reset()         { *m = MsgReleaseMilestoneResponse{} }
+func (m *MsgReleaseMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseMilestoneResponse) ProtoMessage()    {}
+func (*MsgReleaseMilestoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{34}
+}
+func (m *MsgReleaseMilestoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseMilestoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReleaseMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseMilestoneResponse.Merge(m, src)
+}
+func (m *MsgReleaseMilestoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseMilestoneResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMerchant)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseMilestoneResponse proto.InternalMessageInfo
+
+type MsgRefundMilestone struct {
+	Recipient    string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	SettlementId uint64 `protobuf:"varint,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Milestone    uint32 `protobuf:"varint,3,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRefundMilestone) Reset()         { *m = MsgRefundMilestone{} }
+func (m *MsgRefundMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundMilestone) ProtoMessage()    {}
+func (*MsgRefundMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{35}
+}
+func (m *MsgRefundMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMerchant(ctx, in)
+}
+func (m *MsgRefundMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundMilestone.Merge(m, src)
+}
+func (m *MsgRefundMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundMilestone proto.InternalMessageInfo
+
+func (m *MsgRefundMilestone) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/UpdateMerchant",
+	return ""
+}
+
+func (m *MsgRefundMilestone) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMerchant(ctx, req.(*MsgUpdateMerchant))
+	return 0
+}
+
+func (m *MsgRefundMilestone) GetMilestone() uint32 {
+	if m != nil {
+		return m.Milestone
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_InstantCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInstantCheckout)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRefundMilestone) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).InstantCheckout(ctx, in)
+	return ""
+}
+
+type MsgRefundMilestoneResponse struct {
+}
+
+func (m *MsgRefundMilestoneResponse) Reset()         { *m = MsgRefundMilestoneResponse{} }
+func (m *MsgRefundMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundMilestoneResponse) ProtoMessage()    {}
+func (*MsgRefundMilestoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{36}
+}
+func (m *MsgRefundMilestoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundMilestoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/InstantCheckout",
+}
+func (m *MsgRefundMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundMilestoneResponse.Merge(m, src)
+}
+func (m *MsgRefundMilestoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundMilestoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundMilestoneResponse proto.InternalMessageInfo
+
+type MsgCreateSubscription struct {
+	Payer       string                                  `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Merchant    string                                  `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Interval    time.Duration                           `protobuf:"bytes,4,opt,name=interval,proto3,stdduration" json:"interval"`
+	MaxCycles   uint64                                  `protobuf:"varint,5,opt,name=max_cycles,json=maxCycles,proto3" json:"max_cycles,omitempty"`
+	GracePeriod time.Duration                           `protobuf:"bytes,6,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+	Reference   string                                  `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata    string                                  `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgCreateSubscription) Reset()         { *m = MsgCreateSubscription{} }
+func (m *MsgCreateSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscription) ProtoMessage()    {}
+func (*MsgCreateSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{37}
+}
+func (m *MsgCreateSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InstantCheckout(ctx, req.(*MsgInstantCheckout))
+}
+func (m *MsgCreateSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscription.Merge(m, src)
+}
+func (m *MsgCreateSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSubscription proto.InternalMessageInfo
+
+func (m *MsgCreateSubscription) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PartialRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPartialRefund)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateSubscription) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PartialRefund(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateSubscription) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stateset.settlement.Msg/PartialRefund",
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetMaxCycles() uint64 {
+	if m != nil {
+		return m.MaxCycles
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PartialRefund(ctx, req.(*MsgPartialRefund))
+	return 0
+}
+
+func (m *MsgCreateSubscription) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stateset.settlement.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InstantTransfer",
-			Handler:    _Msg_InstantTransfer_Handler,
-		},
-		{
-			MethodName: "CreateEscrow",
-			Handler:    _Msg_CreateEscrow_Handler,
-		},
-		{
-			MethodName: "ReleaseEscrow",
-			Handler:    _Msg_ReleaseEscrow_Handler,
-		},
-		{
-			MethodName: "RefundEscrow",
-			Handler:    _Msg_RefundEscrow_Handler,
-		},
-		{
-			MethodName: "CreateBatch",
-			Handler:    _Msg_CreateBatch_Handler,
-		},
-		{
-			MethodName: "SettleBatch",
-			Handler:    _Msg_SettleBatch_Handler,
-		},
-		{
-			MethodName: "OpenChannel",
-			Handler:    _Msg_OpenChannel_Handler,
-		},
-		{
-			MethodName: "CloseChannel",
-			Handler:    _Msg_CloseChannel_Handler,
-		},
-		{
-			MethodName: "ClaimChannel",
-			Handler:    _Msg_ClaimChannel_Handler,
-		},
-		{
-			MethodName: "RegisterMerchant",
-			Handler:    _Msg_RegisterMerchant_Handler,
-		},
-		{
-			MethodName: "UpdateMerchant",
-			Handler:    _Msg_UpdateMerchant_Handler,
-		},
-		{
-			MethodName: "InstantCheckout",
-			Handler:    _Msg_InstantCheckout_Handler,
-		},
-		{
-			MethodName: "PartialRefund",
-			Handler:    _Msg_PartialRefund_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stateset/settlement/tx.proto",
+func (m *MsgCreateSubscription) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
 }
 
-func (m *MsgInstantTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSubscription) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgInstantTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateSubscriptionResponse struct {
+	SubscriptionId uint64    `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	NextDue        time.Time `protobuf:"bytes,2,opt,name=next_due,json=nextDue,proto3,stdtime" json:"next_due"`
 }
 
-func (m *MsgInstantTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateSubscriptionResponse) Reset()         { *m = MsgCreateSubscriptionResponse{} }
+func (m *MsgCreateSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{38}
+}
+func (m *MsgCreateSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgInstantTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.Merge(m, src)
 }
-
-func (m *MsgInstantTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstantTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgCreateSubscriptionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSubscriptionResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSubscriptionResponse) GetNextDue() time.Time {
+	if m != nil {
+		return m.NextDue
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgCreateEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelSubscription struct {
+	Signer         string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	SubscriptionId uint64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Reason         string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgCreateEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiresIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x32
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCancelSubscription) Reset()         { *m = MsgCancelSubscription{} }
+func (m *MsgCancelSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscription) ProtoMessage()    {}
+func (*MsgCancelSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{39}
+}
+func (m *MsgCancelSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscription.Merge(m, src)
+}
+func (m *MsgCancelSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscription.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelSubscription proto.InternalMessageInfo
+
+func (m *MsgCancelSubscription) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelSubscription) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-func (m *MsgCreateEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCancelSubscription) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgReleaseEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgCancelSubscriptionResponse struct {
+}
+
+func (m *MsgCancelSubscriptionResponse) Reset()         { *m = MsgCancelSubscriptionResponse{} }
+func (m *MsgCancelSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{40}
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgCancelSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgReleaseEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelSubscriptionResponse proto.InternalMessageInfo
+
+type MsgOpenBidirectionalChannel struct {
+	PartyA          string                                  `protobuf:"bytes,1,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
+	PartyB          string                                  `protobuf:"bytes,2,opt,name=party_b,json=partyB,proto3" json:"party_b,omitempty"`
+	Deposit         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	ChallengePeriod int64                                   `protobuf:"varint,4,opt,name=challenge_period,json=challengePeriod,proto3" json:"challenge_period,omitempty"`
 }
 
-func (m *MsgReleaseEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgOpenBidirectionalChannel) Reset()         { *m = MsgOpenBidirectionalChannel{} }
+func (m *MsgOpenBidirectionalChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenBidirectionalChannel) ProtoMessage()    {}
+func (*MsgOpenBidirectionalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{41}
+}
+func (m *MsgOpenBidirectionalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenBidirectionalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenBidirectionalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgOpenBidirectionalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenBidirectionalChannel.Merge(m, src)
+}
+func (m *MsgOpenBidirectionalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenBidirectionalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenBidirectionalChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenBidirectionalChannel proto.InternalMessageInfo
+
+func (m *MsgOpenBidirectionalChannel) GetPartyA() string {
+	if m != nil {
+		return m.PartyA
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgReleaseEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenBidirectionalChannel) GetPartyB() string {
+	if m != nil {
+		return m.PartyB
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgReleaseEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenBidirectionalChannel) GetChallengePeriod() int64 {
+	if m != nil {
+		return m.ChallengePeriod
+	}
+	return 0
 }
 
-func (m *MsgReleaseEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgOpenBidirectionalChannelResponse struct {
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *MsgRefundEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenBidirectionalChannelResponse) Reset()         { *m = MsgOpenBidirectionalChannelResponse{} }
+func (m *MsgOpenBidirectionalChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenBidirectionalChannelResponse) ProtoMessage()    {}
+func (*MsgOpenBidirectionalChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{42}
+}
+func (m *MsgOpenBidirectionalChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenBidirectionalChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenBidirectionalChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRefundEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenBidirectionalChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenBidirectionalChannelResponse.Merge(m, src)
+}
+func (m *MsgOpenBidirectionalChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenBidirectionalChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenBidirectionalChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRefundEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgOpenBidirectionalChannelResponse proto.InternalMessageInfo
+
+func (m *MsgOpenBidirectionalChannelResponse) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+type MsgFundBidirectionalChannel struct {
+	PartyB    string                                  `protobuf:"bytes,1,opt,name=party_b,json=partyB,proto3" json:"party_b,omitempty"`
+	ChannelId uint64                                  `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Deposit   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+}
+
+func (m *MsgFundBidirectionalChannel) Reset()         { *m = MsgFundBidirectionalChannel{} }
+func (m *MsgFundBidirectionalChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgFundBidirectionalChannel) ProtoMessage()    {}
+func (*MsgFundBidirectionalChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{43}
+}
+func (m *MsgFundBidirectionalChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundBidirectionalChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundBidirectionalChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFundBidirectionalChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundBidirectionalChannel.Merge(m, src)
+}
+func (m *MsgFundBidirectionalChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundBidirectionalChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundBidirectionalChannel.DiscardUnknown(m)
 }
 
-func (m *MsgRefundEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundBidirectionalChannel proto.InternalMessageInfo
+
+func (m *MsgFundBidirectionalChannel) GetPartyB() string {
+	if m != nil {
+		return m.PartyB
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRefundEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFundBidirectionalChannel) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
 }
 
-func (m *MsgRefundEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgFundBidirectionalChannelResponse struct {
 }
 
-func (m *MsgCreateBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundBidirectionalChannelResponse) Reset()         { *m = MsgFundBidirectionalChannelResponse{} }
+func (m *MsgFundBidirectionalChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundBidirectionalChannelResponse) ProtoMessage()    {}
+func (*MsgFundBidirectionalChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{44}
+}
+func (m *MsgFundBidirectionalChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundBidirectionalChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundBidirectionalChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFundBidirectionalChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundBidirectionalChannelResponse.Merge(m, src)
+}
+func (m *MsgFundBidirectionalChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundBidirectionalChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundBidirectionalChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFundBidirectionalChannelResponse proto.InternalMessageInfo
+
+type MsgCooperativeCloseChannel struct {
+	Signer string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	State  ChannelState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
 }
 
-func (m *MsgCreateBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.References) > 0 {
-		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.References[iNdEx])
-			copy(dAtA[i:], m.References[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.References[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgCooperativeCloseChannel) Reset()         { *m = MsgCooperativeCloseChannel{} }
+func (m *MsgCooperativeCloseChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgCooperativeCloseChannel) ProtoMessage()    {}
+func (*MsgCooperativeCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{45}
+}
+func (m *MsgCooperativeCloseChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCooperativeCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCooperativeCloseChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Amounts[iNdEx].Size()
-				i -= size
-				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Senders) > 0 {
-		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Senders[iNdEx])
-			copy(dAtA[i:], m.Senders[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Senders[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgCooperativeCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCooperativeCloseChannel.Merge(m, src)
+}
+func (m *MsgCooperativeCloseChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCooperativeCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCooperativeCloseChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCooperativeCloseChannel proto.InternalMessageInfo
+
+func (m *MsgCooperativeCloseChannel) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCooperativeCloseChannel) GetState() ChannelState {
+	if m != nil {
+		return m.State
 	}
-	return len(dAtA) - i, nil
+	return ChannelState{}
 }
 
-func (m *MsgCreateBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgCooperativeCloseChannelResponse struct {
+}
+
+func (m *MsgCooperativeCloseChannelResponse) Reset()         { *m = MsgCooperativeCloseChannelResponse{} }
+func (m *MsgCooperativeCloseChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCooperativeCloseChannelResponse) ProtoMessage()    {}
+func (*MsgCooperativeCloseChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{46}
+}
+func (m *MsgCooperativeCloseChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCooperativeCloseChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCooperativeCloseChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCooperativeCloseChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCooperativeCloseChannelResponse.Merge(m, src)
+}
+func (m *MsgCooperativeCloseChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCooperativeCloseChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCooperativeCloseChannelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCooperativeCloseChannelResponse proto.InternalMessageInfo
+
+type MsgInitiateChannelClose struct {
+	Signer string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	State  ChannelState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
 }
 
-func (m *MsgCreateBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettlementIds) > 0 {
-		dAtA6 := make([]byte, len(m.SettlementIds)*10)
-		var j5 int
-		for _, num := range m.SettlementIds {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+func (m *MsgInitiateChannelClose) Reset()         { *m = MsgInitiateChannelClose{} }
+func (m *MsgInitiateChannelClose) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateChannelClose) ProtoMessage()    {}
+func (*MsgInitiateChannelClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{47}
+}
+func (m *MsgInitiateChannelClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateChannelClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateChannelClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.BatchId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgInitiateChannelClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateChannelClose.Merge(m, src)
+}
+func (m *MsgInitiateChannelClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateChannelClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateChannelClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateChannelClose proto.InternalMessageInfo
+
+func (m *MsgInitiateChannelClose) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSettleBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgInitiateChannelClose) GetState() ChannelState {
+	if m != nil {
+		return m.State
 	}
-	return dAtA[:n], nil
+	return ChannelState{}
 }
 
-func (m *MsgSettleBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgInitiateChannelCloseResponse struct {
+	ChallengeEndsHeight int64 `protobuf:"varint,1,opt,name=challenge_ends_height,json=challengeEndsHeight,proto3" json:"challenge_ends_height,omitempty"`
 }
 
-func (m *MsgSettleBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BatchId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgInitiateChannelCloseResponse) Reset()         { *m = MsgInitiateChannelCloseResponse{} }
+func (m *MsgInitiateChannelCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateChannelCloseResponse) ProtoMessage()    {}
+func (*MsgInitiateChannelCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{48}
+}
+func (m *MsgInitiateChannelCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateChannelCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateChannelCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgInitiateChannelCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateChannelCloseResponse.Merge(m, src)
+}
+func (m *MsgInitiateChannelCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateChannelCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateChannelCloseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSettleBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInitiateChannelCloseResponse proto.InternalMessageInfo
+
+func (m *MsgInitiateChannelCloseResponse) GetChallengeEndsHeight() int64 {
+	if m != nil {
+		return m.ChallengeEndsHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSettleBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChallengeChannelClose struct {
+	Signer string       `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	State  ChannelState `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
 }
 
-func (m *MsgSettleBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NetAmount.Size()
-		i -= size
-		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalFees.Size()
-		i -= size
-		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChallengeChannelClose) Reset()         { *m = MsgChallengeChannelClose{} }
+func (m *MsgChallengeChannelClose) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeChannelClose) ProtoMessage()    {}
+func (*MsgChallengeChannelClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{49}
+}
+func (m *MsgChallengeChannelClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeChannelClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeChannelClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgChallengeChannelClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeChannelClose.Merge(m, src)
+}
+func (m *MsgChallengeChannelClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeChannelClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeChannelClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChallengeChannelClose proto.InternalMessageInfo
+
+func (m *MsgChallengeChannelClose) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgOpenChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgChallengeChannelClose) GetState() ChannelState {
+	if m != nil {
+		return m.State
 	}
-	return dAtA[:n], nil
+	return ChannelState{}
 }
 
-func (m *MsgOpenChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgChallengeChannelCloseResponse struct {
 }
 
-func (m *MsgOpenChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiresInBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresInBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgChallengeChannelCloseResponse) Reset()         { *m = MsgChallengeChannelCloseResponse{} }
+func (m *MsgChallengeChannelCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeChannelCloseResponse) ProtoMessage()    {}
+func (*MsgChallengeChannelCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{50}
+}
+func (m *MsgChallengeChannelCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeChannelCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeChannelCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgOpenChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgChallengeChannelCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeChannelCloseResponse.Merge(m, src)
+}
+func (m *MsgChallengeChannelCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeChannelCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeChannelCloseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgChallengeChannelCloseResponse proto.InternalMessageInfo
+
+type MsgLockHTLC struct {
+	Sender         string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient      string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	HashLock       string                                  `protobuf:"bytes,4,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	TimeoutBlocks  int64                                   `protobuf:"varint,5,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	ChannelId      uint64                                  `protobuf:"varint,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	IncomingHtlcId uint64                                  `protobuf:"varint,7,opt,name=incoming_htlc_id,json=incomingHtlcId,proto3" json:"incoming_htlc_id,omitempty"`
 }
 
-func (m *MsgOpenChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpiresAtHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgLockHTLC) Reset()         { *m = MsgLockHTLC{} }
+func (m *MsgLockHTLC) String() string { return proto.CompactTextString(m) }
+func (*MsgLockHTLC) ProtoMessage()    {}
+func (*MsgLockHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{51}
+}
+func (m *MsgLockHTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockHTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ChannelId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgLockHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockHTLC.Merge(m, src)
+}
+func (m *MsgLockHTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockHTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockHTLC proto.InternalMessageInfo
+
+func (m *MsgLockHTLC) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCloseChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLockHTLC) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCloseChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLockHTLC) GetHashLock() string {
+	if m != nil {
+		return m.HashLock
+	}
+	return ""
 }
 
-func (m *MsgCloseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgLockHTLC) GetTimeoutBlocks() int64 {
+	if m != nil {
+		return m.TimeoutBlocks
 	}
-	if len(m.Closer) > 0 {
-		i -= len(m.Closer)
-		copy(dAtA[i:], m.Closer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Closer)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgLockHTLC) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCloseChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLockHTLC) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCloseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgLockHTLCResponse struct {
+	HtlcId        uint64 `protobuf:"varint,1,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	TimeoutHeight int64  `protobuf:"varint,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
 }
 
-func (m *MsgCloseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FinalBalance.Size()
-		i -= size
-		if _, err := m.FinalBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgLockHTLCResponse) Reset()         { *m = MsgLockHTLCResponse{} }
+func (m *MsgLockHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockHTLCResponse) ProtoMessage()    {}
+func (*MsgLockHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{52}
+}
+func (m *MsgLockHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgLockHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockHTLCResponse.Merge(m, src)
+}
+func (m *MsgLockHTLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockHTLCResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgLockHTLCResponse proto.InternalMessageInfo
+
+func (m *MsgLockHTLCResponse) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLockHTLCResponse) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
 }
 
-func (m *MsgClaimChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type MsgClaimHTLC struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	HtlcId    uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	Preimage  string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (m *MsgClaimHTLC) Reset()         { *m = MsgClaimHTLC{} }
+func (m *MsgClaimHTLC) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHTLC) ProtoMessage()    {}
+func (*MsgClaimHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{53}
+}
+func (m *MsgClaimHTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ChannelId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *MsgClaimHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHTLC.Merge(m, src)
+}
+func (m *MsgClaimHTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimHTLC proto.InternalMessageInfo
+
+func (m *MsgClaimHTLC) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgClaimHTLC) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClaimChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimHTLC) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClaimHTLCResponse struct {
 }
 
-func (m *MsgClaimChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingBalance.Size()
-		i -= size
-		if _, err := m.RemainingBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.AmountClaimed.Size()
-		i -= size
-		if _, err := m.AmountClaimed.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClaimHTLCResponse) Reset()         { *m = MsgClaimHTLCResponse{} }
+func (m *MsgClaimHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHTLCResponse) ProtoMessage()    {}
+func (*MsgClaimHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{54}
+}
+func (m *MsgClaimHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRegisterMerchant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHTLCResponse.Merge(m, src)
+}
+func (m *MsgClaimHTLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHTLCResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterMerchant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimHTLCResponse proto.InternalMessageInfo
+
+type MsgRefundHTLC struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
 }
 
-func (m *MsgRegisterMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WebhookUrl) > 0 {
-		i -= len(m.WebhookUrl)
-		copy(dAtA[i:], m.WebhookUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WebhookUrl)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	{
-		size := m.BatchThreshold.Size()
-		i -= size
-		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRefundHTLC) Reset()         { *m = MsgRefundHTLC{} }
+func (m *MsgRefundHTLC) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundHTLC) ProtoMessage()    {}
+func (*MsgRefundHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{55}
+}
+func (m *MsgRefundHTLC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundHTLC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.BatchEnabled {
-		i--
-		if m.BatchEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+}
+func (m *MsgRefundHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundHTLC.Merge(m, src)
+}
+func (m *MsgRefundHTLC) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundHTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundHTLC proto.InternalMessageInfo
+
+func (m *MsgRefundHTLC) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	{
-		size := m.MaxSettlement.Size()
-		i -= size
-		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+func (m *MsgRefundHTLC) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type MsgRefundHTLCResponse struct {
+}
+
+func (m *MsgRefundHTLCResponse) Reset()         { *m = MsgRefundHTLCResponse{} }
+func (m *MsgRefundHTLCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundHTLCResponse) ProtoMessage()    {}
+func (*MsgRefundHTLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{56}
+}
+func (m *MsgRefundHTLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundHTLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundHTLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MinSettlement.Size()
-		i -= size
-		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgRefundHTLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundHTLCResponse.Merge(m, src)
+}
+func (m *MsgRefundHTLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundHTLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundHTLCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundHTLCResponse proto.InternalMessageInfo
+
+type MsgSetChannelRoutingFee struct {
+	Sender        string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ChannelId     uint64 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	RoutingFeeBps uint32 `protobuf:"varint,3,opt,name=routing_fee_bps,json=routingFeeBps,proto3" json:"routing_fee_bps,omitempty"`
+}
+
+func (m *MsgSetChannelRoutingFee) Reset()         { *m = MsgSetChannelRoutingFee{} }
+func (m *MsgSetChannelRoutingFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelRoutingFee) ProtoMessage()    {}
+func (*MsgSetChannelRoutingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{57}
+}
+func (m *MsgSetChannelRoutingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelRoutingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelRoutingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.FeeRateBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeRateBps))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgSetChannelRoutingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelRoutingFee.Merge(m, src)
+}
+func (m *MsgSetChannelRoutingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelRoutingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelRoutingFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelRoutingFee proto.InternalMessageInfo
+
+func (m *MsgSetChannelRoutingFee) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgSetChannelRoutingFee) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
 	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgSetChannelRoutingFee) GetRoutingFeeBps() uint32 {
+	if m != nil {
+		return m.RoutingFeeBps
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+type MsgSetChannelRoutingFeeResponse struct {
+}
+
+func (m *MsgSetChannelRoutingFeeResponse) Reset()         { *m = MsgSetChannelRoutingFeeResponse{} }
+func (m *MsgSetChannelRoutingFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelRoutingFeeResponse) ProtoMessage()    {}
+func (*MsgSetChannelRoutingFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{58}
+}
+func (m *MsgSetChannelRoutingFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelRoutingFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelRoutingFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetChannelRoutingFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelRoutingFeeResponse.Merge(m, src)
+}
+func (m *MsgSetChannelRoutingFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelRoutingFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelRoutingFeeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterMerchantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetChannelRoutingFeeResponse proto.InternalMessageInfo
+
+type MsgFreezePayout struct {
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SettlementId uint64 `protobuf:"varint,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgFreezePayout) Reset()         { *m = MsgFreezePayout{} }
+func (m *MsgFreezePayout) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezePayout) ProtoMessage()    {}
+func (*MsgFreezePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{59}
+}
+func (m *MsgFreezePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFreezePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezePayout.Merge(m, src)
+}
+func (m *MsgFreezePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezePayout.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezePayout proto.InternalMessageInfo
+
+func (m *MsgFreezePayout) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgRegisterMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFreezePayout) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
+	}
+	return 0
 }
 
-func (m *MsgUpdateMerchant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezePayout) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMerchant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgFreezePayoutResponse struct {
 }
 
-func (m *MsgUpdateMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WebhookUrl) > 0 {
-		i -= len(m.WebhookUrl)
-		copy(dAtA[i:], m.WebhookUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WebhookUrl)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.IsActive != nil {
-		n18, err18 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.IsActive, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.IsActive):])
-		if err18 != nil {
-			return 0, err18
+func (m *MsgFreezePayoutResponse) Reset()         { *m = MsgFreezePayoutResponse{} }
+func (m *MsgFreezePayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezePayoutResponse) ProtoMessage()    {}
+func (*MsgFreezePayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{60}
+}
+func (m *MsgFreezePayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezePayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezePayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n18
-		i = encodeVarintTx(dAtA, i, uint64(n18))
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	{
-		size := m.BatchThreshold.Size()
-		i -= size
-		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgFreezePayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezePayoutResponse.Merge(m, src)
+}
+func (m *MsgFreezePayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezePayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezePayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezePayoutResponse proto.InternalMessageInfo
+
+type MsgUnfreezePayout struct {
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	SettlementId uint64 `protobuf:"varint,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+}
+
+func (m *MsgUnfreezePayout) Reset()         { *m = MsgUnfreezePayout{} }
+func (m *MsgUnfreezePayout) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezePayout) ProtoMessage()    {}
+func (*MsgUnfreezePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{61}
+}
+func (m *MsgUnfreezePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.BatchEnabled != nil {
-		n20, err20 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.BatchEnabled, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.BatchEnabled):])
-		if err20 != nil {
-			return 0, err20
-		}
-		i -= n20
-		i = encodeVarintTx(dAtA, i, uint64(n20))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *MsgUnfreezePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezePayout.Merge(m, src)
+}
+func (m *MsgUnfreezePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezePayout proto.InternalMessageInfo
+
+func (m *MsgUnfreezePayout) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	{
-		size := m.MaxSettlement.Size()
-		i -= size
-		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *MsgUnfreezePayout) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MinSettlement.Size()
-		i -= size
-		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type MsgUnfreezePayoutResponse struct {
+	ReleaseAt time.Time `protobuf:"bytes,1,opt,name=release_at,json=releaseAt,proto3,stdtime" json:"release_at"`
+}
+
+func (m *MsgUnfreezePayoutResponse) Reset()         { *m = MsgUnfreezePayoutResponse{} }
+func (m *MsgUnfreezePayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezePayoutResponse) ProtoMessage()    {}
+func (*MsgUnfreezePayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{62}
+}
+func (m *MsgUnfreezePayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezePayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezePayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.FeeRateBps != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FeeRateBps))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgUnfreezePayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezePayoutResponse.Merge(m, src)
+}
+func (m *MsgUnfreezePayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezePayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezePayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezePayoutResponse proto.InternalMessageInfo
+
+func (m *MsgUnfreezePayoutResponse) GetReleaseAt() time.Time {
+	if m != nil {
+		return m.ReleaseAt
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return time.Time{}
+}
+
+type MsgCreateNettingCycle struct {
+	Operator     string   `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Denom        string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window is how long the cycle collects obligations before EndBlock settles it
+	Window time.Duration `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *MsgCreateNettingCycle) Reset()         { *m = MsgCreateNettingCycle{} }
+func (m *MsgCreateNettingCycle) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNettingCycle) ProtoMessage()    {}
+func (*MsgCreateNettingCycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{63}
+}
+func (m *MsgCreateNettingCycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNettingCycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNettingCycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgCreateNettingCycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNettingCycle.Merge(m, src)
+}
+func (m *MsgCreateNettingCycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNettingCycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNettingCycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateNettingCycle proto.InternalMessageInfo
+
+func (m *MsgCreateNettingCycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCreateNettingCycle) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgUpdateMerchantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateNettingCycle) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateNettingCycle) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
 }
 
-func (m *MsgUpdateMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCreateNettingCycleResponse struct {
+	CycleId  uint64    `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id,omitempty"`
+	ClosesAt time.Time `protobuf:"bytes,2,opt,name=closes_at,json=closesAt,proto3,stdtime" json:"closes_at"`
 }
 
-func (m *MsgInstantCheckout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateNettingCycleResponse) Reset()         { *m = MsgCreateNettingCycleResponse{} }
+func (m *MsgCreateNettingCycleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateNettingCycleResponse) ProtoMessage()    {}
+func (*MsgCreateNettingCycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{64}
+}
+func (m *MsgCreateNettingCycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateNettingCycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateNettingCycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCreateNettingCycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateNettingCycleResponse.Merge(m, src)
+}
+func (m *MsgCreateNettingCycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateNettingCycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateNettingCycleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstantCheckout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateNettingCycleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateNettingCycleResponse) GetCycleId() uint64 {
+	if m != nil {
+		return m.CycleId
+	}
+	return 0
 }
 
-func (m *MsgInstantCheckout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgCreateNettingCycleResponse) GetClosesAt() time.Time {
+	if m != nil {
+		return m.ClosesAt
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	return time.Time{}
+}
+
+type MsgSubmitObligation struct {
+	Debtor    string                                  `protobuf:"bytes,1,opt,name=debtor,proto3" json:"debtor,omitempty"`
+	CycleId   uint64                                  `protobuf:"varint,2,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id,omitempty"`
+	Creditor  string                                  `protobuf:"bytes,3,opt,name=creditor,proto3" json:"creditor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Reference string                                  `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *MsgSubmitObligation) Reset()         { *m = MsgSubmitObligation{} }
+func (m *MsgSubmitObligation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitObligation) ProtoMessage()    {}
+func (*MsgSubmitObligation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{65}
+}
+func (m *MsgSubmitObligation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitObligation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitObligation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.UseEscrow {
-		i--
-		if m.UseEscrow {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.OrderReference) > 0 {
-		i -= len(m.OrderReference)
-		copy(dAtA[i:], m.OrderReference)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderReference)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgSubmitObligation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitObligation.Merge(m, src)
+}
+func (m *MsgSubmitObligation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitObligation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitObligation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitObligation proto.InternalMessageInfo
+
+func (m *MsgSubmitObligation) GetDebtor() string {
+	if m != nil {
+		return m.Debtor
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Merchant) > 0 {
-		i -= len(m.Merchant)
-		copy(dAtA[i:], m.Merchant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSubmitObligation) GetCycleId() uint64 {
+	if m != nil {
+		return m.CycleId
 	}
-	if len(m.Customer) > 0 {
-		i -= len(m.Customer)
-		copy(dAtA[i:], m.Customer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgSubmitObligation) GetCreditor() string {
+	if m != nil {
+		return m.Creditor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgInstantCheckoutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitObligation) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgInstantCheckoutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitObligationResponse struct {
+	ObligationId uint64 `protobuf:"varint,1,opt,name=obligation_id,json=obligationId,proto3" json:"obligation_id,omitempty"`
 }
 
-func (m *MsgInstantCheckoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TransactionId) > 0 {
-		i -= len(m.TransactionId)
-		copy(dAtA[i:], m.TransactionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransactionId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NetAmount.Size()
-		i -= size
-		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSubmitObligationResponse) Reset()         { *m = MsgSubmitObligationResponse{} }
+func (m *MsgSubmitObligationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitObligationResponse) ProtoMessage()    {}
+func (*MsgSubmitObligationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{66}
+}
+func (m *MsgSubmitObligationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitObligationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitObligationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitObligationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitObligationResponse.Merge(m, src)
+}
+func (m *MsgSubmitObligationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitObligationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitObligationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPartialRefund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitObligationResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitObligationResponse) GetObligationId() uint64 {
+	if m != nil {
+		return m.ObligationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgPartialRefund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSettleNettingCycle lets the operator settle a cycle before it closes
+type MsgSettleNettingCycle struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	CycleId  uint64 `protobuf:"varint,2,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id,omitempty"`
 }
 
-func (m *MsgPartialRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.RefundAmount.Size()
-		i -= size
-		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSettleNettingCycle) Reset()         { *m = MsgSettleNettingCycle{} }
+func (m *MsgSettleNettingCycle) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleNettingCycle) ProtoMessage()    {}
+func (*MsgSettleNettingCycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{67}
+}
+func (m *MsgSettleNettingCycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleNettingCycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleNettingCycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.SettlementId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSettleNettingCycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleNettingCycle.Merge(m, src)
+}
+func (m *MsgSettleNettingCycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleNettingCycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleNettingCycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleNettingCycle proto.InternalMessageInfo
+
+func (m *MsgSettleNettingCycle) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPartialRefundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSettleNettingCycle) GetCycleId() uint64 {
+	if m != nil {
+		return m.CycleId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgPartialRefundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSettleNettingCycleResponse struct {
+	Transfers []NetTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
 }
 
-func (m *MsgPartialRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingAmount.Size()
-		i -= size
-		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.RefundedAmount.Size()
-		i -= size
-		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSettleNettingCycleResponse) Reset()         { *m = MsgSettleNettingCycleResponse{} }
+func (m *MsgSettleNettingCycleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleNettingCycleResponse) ProtoMessage()    {}
+func (*MsgSettleNettingCycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{68}
+}
+func (m *MsgSettleNettingCycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleNettingCycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleNettingCycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgSettleNettingCycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleNettingCycleResponse.Merge(m, src)
+}
+func (m *MsgSettleNettingCycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleNettingCycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleNettingCycleResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgSettleNettingCycleResponse proto.InternalMessageInfo
+
+func (m *MsgSettleNettingCycleResponse) GetTransfers() []NetTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgInstantTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+// MsgSetSplitTemplate sets the split applied to the merchant's checkouts.
+// Empty rules remove the template.
+type MsgSetSplitTemplate struct {
+	Merchant string      `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	Rules    []SplitRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+	// name registers a split checkouts select by name. Empty sets the default
+	// template.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgInstantTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetSplitTemplate) Reset()         { *m = MsgSetSplitTemplate{} }
+func (m *MsgSetSplitTemplate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSplitTemplate) ProtoMessage()    {}
+func (*MsgSetSplitTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{69}
+}
+func (m *MsgSetSplitTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSplitTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSplitTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetSplitTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSplitTemplate.Merge(m, src)
+}
+func (m *MsgSetSplitTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSplitTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSplitTemplate.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgSetSplitTemplate proto.InternalMessageInfo
+
+func (m *MsgSetSplitTemplate) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgCreateEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
+func (m *MsgSetSplitTemplate) GetRules() []SplitRule {
+	if m != nil {
+		return m.Rules
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgReleaseEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
+func (m *MsgSetSplitTemplate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *MsgReleaseEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type MsgSetSplitTemplateResponse struct {
 }
 
-func (m *MsgRefundEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetSplitTemplateResponse) Reset()         { *m = MsgSetSplitTemplateResponse{} }
+func (m *MsgSetSplitTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSplitTemplateResponse) ProtoMessage()    {}
+func (*MsgSetSplitTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{70}
+}
+func (m *MsgSetSplitTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSplitTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSplitTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetSplitTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSplitTemplateResponse.Merge(m, src)
+}
+func (m *MsgSetSplitTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSplitTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSplitTemplateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRefundEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetSplitTemplateResponse proto.InternalMessageInfo
+
+// MsgCreateMandate authorizes a merchant to pull payments from the customer
+type MsgCreateMandate struct {
+	Customer     string                                  `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Merchant     string                                  `protobuf:"bytes,2,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	PerChargeMax github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=per_charge_max,json=perChargeMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"per_charge_max"`
+	PeriodCap    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=period_cap,json=periodCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"period_cap"`
+	Period       time.Duration                           `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	// expires_in is how long the mandate lasts, zero for no expiry
+	ExpiresIn time.Duration `protobuf:"bytes,6,opt,name=expires_in,json=expiresIn,proto3,stdduration" json:"expires_in"`
+	Reference string        `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
 }
 
-func (m *MsgCreateBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Senders) > 0 {
-		for _, s := range m.Senders {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.References) > 0 {
-		for _, s := range m.References {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateMandate) Reset()         { *m = MsgCreateMandate{} }
+func (m *MsgCreateMandate) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMandate) ProtoMessage()    {}
+func (*MsgCreateMandate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{71}
+}
+func (m *MsgCreateMandate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMandate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMandate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCreateMandate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMandate.Merge(m, src)
+}
+func (m *MsgCreateMandate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMandate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMandate.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchId != 0 {
-		n += 1 + sovTx(uint64(m.BatchId))
-	}
-	if len(m.SettlementIds) > 0 {
-		l = 0
-		for _, e := range m.SettlementIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+var xxx_messageInfo_MsgCreateMandate proto.InternalMessageInfo
+
+func (m *MsgCreateMandate) GetCustomer() string {
+	if m != nil {
+		return m.Customer
 	}
-	return n
+	return ""
 }
 
-func (m *MsgSettleBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BatchId != 0 {
-		n += 1 + sovTx(uint64(m.BatchId))
+func (m *MsgCreateMandate) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	return n
+	return ""
 }
 
-func (m *MsgSettleBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMandate) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	var l int
-	_ = l
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TotalFees.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.NetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgOpenChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMandate) GetExpiresIn() time.Duration {
+	if m != nil {
+		return m.ExpiresIn
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.ExpiresInBlocks != 0 {
-		n += 1 + sovTx(uint64(m.ExpiresInBlocks))
-	}
-	return n
+	return 0
 }
 
-func (m *MsgOpenChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		n += 1 + sovTx(uint64(m.ChannelId))
-	}
-	if m.ExpiresAtHeight != 0 {
-		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+func (m *MsgCreateMandate) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCloseChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Closer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChannelId != 0 {
-		n += 1 + sovTx(uint64(m.ChannelId))
-	}
-	return n
+type MsgCreateMandateResponse struct {
+	MandateId uint64 `protobuf:"varint,1,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
 }
 
-func (m *MsgCloseChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateMandateResponse) Reset()         { *m = MsgCreateMandateResponse{} }
+func (m *MsgCreateMandateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMandateResponse) ProtoMessage()    {}
+func (*MsgCreateMandateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{72}
+}
+func (m *MsgCreateMandateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMandateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMandateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.FinalBalance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCreateMandateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMandateResponse.Merge(m, src)
+}
+func (m *MsgCreateMandateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMandateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMandateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChannelId != 0 {
-		n += 1 + sovTx(uint64(m.ChannelId))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+var xxx_messageInfo_MsgCreateMandateResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMandateResponse) GetMandateId() uint64 {
+	if m != nil {
+		return m.MandateId
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgRevokeMandate stops further pulls; the customer or the merchant can revoke
+type MsgRevokeMandate struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	MandateId uint64 `protobuf:"varint,2,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRevokeMandate) Reset()         { *m = MsgRevokeMandate{} }
+func (m *MsgRevokeMandate) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMandate) ProtoMessage()    {}
+func (*MsgRevokeMandate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{73}
+}
+func (m *MsgRevokeMandate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMandate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMandate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRevokeMandate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMandate.Merge(m, src)
+}
+func (m *MsgRevokeMandate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMandate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMandate.DiscardUnknown(m)
 }
 
-func (m *MsgClaimChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRevokeMandate proto.InternalMessageInfo
+
+func (m *MsgRevokeMandate) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	var l int
-	_ = l
-	l = m.AmountClaimed.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RemainingBalance.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgRegisterMerchant) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeMandate) GetMandateId() uint64 {
+	if m != nil {
+		return m.MandateId
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgRevokeMandate) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgRevokeMandateResponse struct {
+}
+
+func (m *MsgRevokeMandateResponse) Reset()         { *m = MsgRevokeMandateResponse{} }
+func (m *MsgRevokeMandateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeMandateResponse) ProtoMessage()    {}
+func (*MsgRevokeMandateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{74}
+}
+func (m *MsgRevokeMandateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeMandateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeMandateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRevokeMandateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeMandateResponse.Merge(m, src)
+}
+func (m *MsgRevokeMandateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeMandateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeMandateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeMandateResponse proto.InternalMessageInfo
+
+// MsgPullPayment charges the customer of a mandate
+type MsgPullPayment struct {
+	Merchant  string                                  `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	MandateId uint64                                  `protobuf:"varint,2,opt,name=mandate_id,json=mandateId,proto3" json:"mandate_id,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Reference string                                  `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	Metadata  string                                  `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgPullPayment) Reset()         { *m = MsgPullPayment{} }
+func (m *MsgPullPayment) String() string { return proto.CompactTextString(m) }
+func (*MsgPullPayment) ProtoMessage()    {}
+func (*MsgPullPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{75}
+}
+func (m *MsgPullPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPullPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPullPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.FeeRateBps != 0 {
-		n += 1 + sovTx(uint64(m.FeeRateBps))
+}
+func (m *MsgPullPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPullPayment.Merge(m, src)
+}
+func (m *MsgPullPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPullPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPullPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPullPayment proto.InternalMessageInfo
+
+func (m *MsgPullPayment) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
 	}
-	l = m.MinSettlement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSettlement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.BatchEnabled {
-		n += 2
+	return ""
+}
+
+func (m *MsgPullPayment) GetMandateId() uint64 {
+	if m != nil {
+		return m.MandateId
 	}
-	l = m.BatchThreshold.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.WebhookUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgPullPayment) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return n
+	return ""
 }
 
-func (m *MsgRegisterMerchantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPullPayment) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateMerchant) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgPullPaymentResponse struct {
+	SettlementId uint64 `protobuf:"varint,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// period_remaining is what the merchant can still pull this period
+	PeriodRemaining github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=period_remaining,json=periodRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"period_remaining"`
+}
+
+func (m *MsgPullPaymentResponse) Reset()         { *m = MsgPullPaymentResponse{} }
+func (m *MsgPullPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPullPaymentResponse) ProtoMessage()    {}
+func (*MsgPullPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{76}
+}
+func (m *MsgPullPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPullPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPullPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPullPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPullPaymentResponse.Merge(m, src)
+}
+func (m *MsgPullPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPullPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPullPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPullPaymentResponse proto.InternalMessageInfo
+
+func (m *MsgPullPaymentResponse) GetSettlementId() uint64 {
+	if m != nil {
+		return m.SettlementId
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FeeRateBps != 0 {
-		n += 1 + sovTx(uint64(m.FeeRateBps))
+	return 0
+}
+
+// MsgCreateStream starts paying the recipient continuously from a deposit
+type MsgCreateStream struct {
+	Payer         string                                  `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Recipient     string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Deposit       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	RatePerSecond cosmossdk_io_math.Int                   `protobuf:"bytes,4,opt,name=rate_per_second,json=ratePerSecond,proto3,customtype=cosmossdk.io/math.Int" json:"rate_per_second"`
+	Reference     string                                  `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *MsgCreateStream) Reset()         { *m = MsgCreateStream{} }
+func (m *MsgCreateStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStream) ProtoMessage()    {}
+func (*MsgCreateStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{77}
+}
+func (m *MsgCreateStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.MinSettlement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MaxSettlement.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.BatchEnabled != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.BatchEnabled)
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreateStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStream.Merge(m, src)
+}
+func (m *MsgCreateStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateStream proto.InternalMessageInfo
+
+func (m *MsgCreateStream) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	l = m.BatchThreshold.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.IsActive != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.IsActive)
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = len(m.WebhookUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateStream) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateMerchantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgCreateStreamResponse struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreateStreamResponse) Reset()         { *m = MsgCreateStreamResponse{} }
+func (m *MsgCreateStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStreamResponse) ProtoMessage()    {}
+func (*MsgCreateStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{78}
+}
+func (m *MsgCreateStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgCreateStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStreamResponse.DiscardUnknown(m)
 }
 
-func (m *MsgInstantCheckout) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCreateStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCreateStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	var l int
-	_ = l
-	l = len(m.Customer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgWithdrawStream pays the recipient what has accrued on a stream
+type MsgWithdrawStream struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	StreamId  uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgWithdrawStream) Reset()         { *m = MsgWithdrawStream{} }
+func (m *MsgWithdrawStream) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawStream) ProtoMessage()    {}
+func (*MsgWithdrawStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{79}
+}
+func (m *MsgWithdrawStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Merchant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawStream.Merge(m, src)
+}
+func (m *MsgWithdrawStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawStream proto.InternalMessageInfo
+
+func (m *MsgWithdrawStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.OrderReference)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgWithdrawStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	if m.UseEscrow {
-		n += 2
+	return 0
+}
+
+type MsgWithdrawStreamResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *MsgWithdrawStreamResponse) Reset()         { *m = MsgWithdrawStreamResponse{} }
+func (m *MsgWithdrawStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawStreamResponse) ProtoMessage()    {}
+func (*MsgWithdrawStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{80}
+}
+func (m *MsgWithdrawStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawStreamResponse.Merge(m, src)
+}
+func (m *MsgWithdrawStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawStreamResponse proto.InternalMessageInfo
+
+// MsgCancelStream ends a stream; the payer or the recipient can cancel
+type MsgCancelStream struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelStream) Reset()         { *m = MsgCancelStream{} }
+func (m *MsgCancelStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStream) ProtoMessage()    {}
+func (*MsgCancelStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{81}
+}
+func (m *MsgCancelStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCancelStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStream.Merge(m, src)
+}
+func (m *MsgCancelStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStream proto.InternalMessageInfo
+
+func (m *MsgCancelStream) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return n
+	return ""
 }
 
-func (m *MsgInstantCheckoutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	var l int
-	_ = l
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
+	return 0
+}
+
+type MsgCancelStreamResponse struct {
+	// recipient_amount is the accrued amount paid to the recipient
+	RecipientAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=recipient_amount,json=recipientAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"recipient_amount"`
+	// payer_refund is the unstreamed deposit returned to the payer
+	PayerRefund github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=payer_refund,json=payerRefund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"payer_refund"`
+}
+
+func (m *MsgCancelStreamResponse) Reset()         { *m = MsgCancelStreamResponse{} }
+func (m *MsgCancelStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStreamResponse) ProtoMessage()    {}
+func (*MsgCancelStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{82}
+}
+func (m *MsgCancelStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCancelStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStreamResponse proto.InternalMessageInfo
+
+// MsgTopUpStream adds to a stream's deposit
+type MsgTopUpStream struct {
+	Payer    string                                  `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	StreamId uint64                                  `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *MsgTopUpStream) Reset()         { *m = MsgTopUpStream{} }
+func (m *MsgTopUpStream) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpStream) ProtoMessage()    {}
+func (*MsgTopUpStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{83}
+}
+func (m *MsgTopUpStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.NetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.TransactionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgTopUpStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpStream.Merge(m, src)
+}
+func (m *MsgTopUpStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpStream proto.InternalMessageInfo
+
+func (m *MsgTopUpStream) GetPayer() string {
+	if m != nil {
+		return m.Payer
 	}
-	return n
+	return ""
 }
 
-func (m *MsgPartialRefund) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTopUpStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+	return 0
+}
+
+type MsgTopUpStreamResponse struct {
+	FundedUntil time.Time `protobuf:"bytes,1,opt,name=funded_until,json=fundedUntil,proto3,stdtime" json:"funded_until"`
+}
+
+func (m *MsgTopUpStreamResponse) Reset()         { *m = MsgTopUpStreamResponse{} }
+func (m *MsgTopUpStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpStreamResponse) ProtoMessage()    {}
+func (*MsgTopUpStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19e3855a8d88c072, []int{84}
+}
+func (m *MsgTopUpStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpStreamResponse.Merge(m, src)
+}
+func (m *MsgTopUpStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpStreamResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpStreamResponse) GetFundedUntil() time.Time {
+	if m != nil {
+		return m.FundedUntil
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MsgInstantTransfer)(nil), "stateset.settlement.MsgInstantTransfer")
+	proto.RegisterType((*MsgInstantTransferResponse)(nil), "stateset.settlement.MsgInstantTransferResponse")
+	proto.RegisterType((*MsgCreateEscrow)(nil), "stateset.settlement.MsgCreateEscrow")
+	proto.RegisterType((*MsgCreateEscrowResponse)(nil), "stateset.settlement.MsgCreateEscrowResponse")
+	proto.RegisterType((*MsgReleaseEscrow)(nil), "stateset.settlement.MsgReleaseEscrow")
+	proto.RegisterType((*MsgReleaseEscrowResponse)(nil), "stateset.settlement.MsgReleaseEscrowResponse")
+	proto.RegisterType((*MsgRefundEscrow)(nil), "stateset.settlement.MsgRefundEscrow")
+	proto.RegisterType((*MsgRefundEscrowResponse)(nil), "stateset.settlement.MsgRefundEscrowResponse")
+	proto.RegisterType((*MsgCreateBatch)(nil), "stateset.settlement.MsgCreateBatch")
+	proto.RegisterType((*MsgCreateBatchResponse)(nil), "stateset.settlement.MsgCreateBatchResponse")
+	proto.RegisterType((*MsgSettleBatch)(nil), "stateset.settlement.MsgSettleBatch")
+	proto.RegisterType((*MsgSettleBatchResponse)(nil), "stateset.settlement.MsgSettleBatchResponse")
+	proto.RegisterType((*MsgOpenChannel)(nil), "stateset.settlement.MsgOpenChannel")
+	proto.RegisterType((*MsgOpenChannelResponse)(nil), "stateset.settlement.MsgOpenChannelResponse")
+	proto.RegisterType((*MsgCloseChannel)(nil), "stateset.settlement.MsgCloseChannel")
+	proto.RegisterType((*MsgCloseChannelResponse)(nil), "stateset.settlement.MsgCloseChannelResponse")
+	proto.RegisterType((*MsgClaimChannel)(nil), "stateset.settlement.MsgClaimChannel")
+	proto.RegisterType((*MsgClaimChannelResponse)(nil), "stateset.settlement.MsgClaimChannelResponse")
+	proto.RegisterType((*MsgRegisterMerchant)(nil), "stateset.settlement.MsgRegisterMerchant")
+	proto.RegisterType((*MsgRegisterMerchantResponse)(nil), "stateset.settlement.MsgRegisterMerchantResponse")
+	proto.RegisterType((*MsgUpdateMerchant)(nil), "stateset.settlement.MsgUpdateMerchant")
+	proto.RegisterType((*MsgUpdateMerchantResponse)(nil), "stateset.settlement.MsgUpdateMerchantResponse")
+	proto.RegisterType((*MsgInstantCheckout)(nil), "stateset.settlement.MsgInstantCheckout")
+	proto.RegisterType((*MsgInstantCheckoutResponse)(nil), "stateset.settlement.MsgInstantCheckoutResponse")
+	proto.RegisterType((*MsgPartialRefund)(nil), "stateset.settlement.MsgPartialRefund")
+	proto.RegisterType((*MsgPartialRefundResponse)(nil), "stateset.settlement.MsgPartialRefundResponse")
+	proto.RegisterType((*MsgCreateMultiPartyEscrow)(nil), "stateset.settlement.MsgCreateMultiPartyEscrow")
+	proto.RegisterType((*MsgCreateMultiPartyEscrowResponse)(nil), "stateset.settlement.MsgCreateMultiPartyEscrowResponse")
+	proto.RegisterType((*MsgApproveEscrowResolution)(nil), "stateset.settlement.MsgApproveEscrowResolution")
+	proto.RegisterType((*MsgApproveEscrowResolutionResponse)(nil), "stateset.settlement.MsgApproveEscrowResolutionResponse")
+	proto.RegisterType((*MilestoneInput)(nil), "stateset.settlement.MilestoneInput")
+	proto.RegisterType((*MsgCreateMilestoneEscrow)(nil), "stateset.settlement.MsgCreateMilestoneEscrow")
+	proto.RegisterType((*MsgCreateMilestoneEscrowResponse)(nil), "stateset.settlement.MsgCreateMilestoneEscrowResponse")
+	proto.RegisterType((*MsgReleaseMilestone)(nil), "stateset.settlement.MsgReleaseMilestone")
+	proto.RegisterType((*MsgReleaseMilestoneResponse)(nil), "stateset.settlement.MsgReleaseMilestoneResponse")
+	proto.RegisterType((*MsgRefundMilestone)(nil), "stateset.settlement.MsgRefundMilestone")
+	proto.RegisterType((*MsgRefundMilestoneResponse)(nil), "stateset.settlement.MsgRefundMilestoneResponse")
+	proto.RegisterType((*MsgCreateSubscription)(nil), "stateset.settlement.MsgCreateSubscription")
+	proto.RegisterType((*MsgCreateSubscriptionResponse)(nil), "stateset.settlement.MsgCreateSubscriptionResponse")
+	proto.RegisterType((*MsgCancelSubscription)(nil), "stateset.settlement.MsgCancelSubscription")
+	proto.RegisterType((*MsgCancelSubscriptionResponse)(nil), "stateset.settlement.MsgCancelSubscriptionResponse")
+	proto.RegisterType((*MsgOpenBidirectionalChannel)(nil), "stateset.settlement.MsgOpenBidirectionalChannel")
+	proto.RegisterType((*MsgOpenBidirectionalChannelResponse)(nil), "stateset.settlement.MsgOpenBidirectionalChannelResponse")
+	proto.RegisterType((*MsgFundBidirectionalChannel)(nil), "stateset.settlement.MsgFundBidirectionalChannel")
+	proto.RegisterType((*MsgFundBidirectionalChannelResponse)(nil), "stateset.settlement.MsgFundBidirectionalChannelResponse")
+	proto.RegisterType((*MsgCooperativeCloseChannel)(nil), "stateset.settlement.MsgCooperativeCloseChannel")
+	proto.RegisterType((*MsgCooperativeCloseChannelResponse)(nil), "stateset.settlement.MsgCooperativeCloseChannelResponse")
+	proto.RegisterType((*MsgInitiateChannelClose)(nil), "stateset.settlement.MsgInitiateChannelClose")
+	proto.RegisterType((*MsgInitiateChannelCloseResponse)(nil), "stateset.settlement.MsgInitiateChannelCloseResponse")
+	proto.RegisterType((*MsgChallengeChannelClose)(nil), "stateset.settlement.MsgChallengeChannelClose")
+	proto.RegisterType((*MsgChallengeChannelCloseResponse)(nil), "stateset.settlement.MsgChallengeChannelCloseResponse")
+	proto.RegisterType((*MsgLockHTLC)(nil), "stateset.settlement.MsgLockHTLC")
+	proto.RegisterType((*MsgLockHTLCResponse)(nil), "stateset.settlement.MsgLockHTLCResponse")
+	proto.RegisterType((*MsgClaimHTLC)(nil), "stateset.settlement.MsgClaimHTLC")
+	proto.RegisterType((*MsgClaimHTLCResponse)(nil), "stateset.settlement.MsgClaimHTLCResponse")
+	proto.RegisterType((*MsgRefundHTLC)(nil), "stateset.settlement.MsgRefundHTLC")
+	proto.RegisterType((*MsgRefundHTLCResponse)(nil), "stateset.settlement.MsgRefundHTLCResponse")
+	proto.RegisterType((*MsgSetChannelRoutingFee)(nil), "stateset.settlement.MsgSetChannelRoutingFee")
+	proto.RegisterType((*MsgSetChannelRoutingFeeResponse)(nil), "stateset.settlement.MsgSetChannelRoutingFeeResponse")
+	proto.RegisterType((*MsgFreezePayout)(nil), "stateset.settlement.MsgFreezePayout")
+	proto.RegisterType((*MsgFreezePayoutResponse)(nil), "stateset.settlement.MsgFreezePayoutResponse")
+	proto.RegisterType((*MsgUnfreezePayout)(nil), "stateset.settlement.MsgUnfreezePayout")
+	proto.RegisterType((*MsgUnfreezePayoutResponse)(nil), "stateset.settlement.MsgUnfreezePayoutResponse")
+	proto.RegisterType((*MsgCreateNettingCycle)(nil), "stateset.settlement.MsgCreateNettingCycle")
+	proto.RegisterType((*MsgCreateNettingCycleResponse)(nil), "stateset.settlement.MsgCreateNettingCycleResponse")
+	proto.RegisterType((*MsgSubmitObligation)(nil), "stateset.settlement.MsgSubmitObligation")
+	proto.RegisterType((*MsgSubmitObligationResponse)(nil), "stateset.settlement.MsgSubmitObligationResponse")
+	proto.RegisterType((*MsgSettleNettingCycle)(nil), "stateset.settlement.MsgSettleNettingCycle")
+	proto.RegisterType((*MsgSettleNettingCycleResponse)(nil), "stateset.settlement.MsgSettleNettingCycleResponse")
+	proto.RegisterType((*MsgSetSplitTemplate)(nil), "stateset.settlement.MsgSetSplitTemplate")
+	proto.RegisterType((*MsgSetSplitTemplateResponse)(nil), "stateset.settlement.MsgSetSplitTemplateResponse")
+	proto.RegisterType((*MsgCreateMandate)(nil), "stateset.settlement.MsgCreateMandate")
+	proto.RegisterType((*MsgCreateMandateResponse)(nil), "stateset.settlement.MsgCreateMandateResponse")
+	proto.RegisterType((*MsgRevokeMandate)(nil), "stateset.settlement.MsgRevokeMandate")
+	proto.RegisterType((*MsgRevokeMandateResponse)(nil), "stateset.settlement.MsgRevokeMandateResponse")
+	proto.RegisterType((*MsgPullPayment)(nil), "stateset.settlement.MsgPullPayment")
+	proto.RegisterType((*MsgPullPaymentResponse)(nil), "stateset.settlement.MsgPullPaymentResponse")
+	proto.RegisterType((*MsgCreateStream)(nil), "stateset.settlement.MsgCreateStream")
+	proto.RegisterType((*MsgCreateStreamResponse)(nil), "stateset.settlement.MsgCreateStreamResponse")
+	proto.RegisterType((*MsgWithdrawStream)(nil), "stateset.settlement.MsgWithdrawStream")
+	proto.RegisterType((*MsgWithdrawStreamResponse)(nil), "stateset.settlement.MsgWithdrawStreamResponse")
+	proto.RegisterType((*MsgCancelStream)(nil), "stateset.settlement.MsgCancelStream")
+	proto.RegisterType((*MsgCancelStreamResponse)(nil), "stateset.settlement.MsgCancelStreamResponse")
+	proto.RegisterType((*MsgTopUpStream)(nil), "stateset.settlement.MsgTopUpStream")
+	proto.RegisterType((*MsgTopUpStreamResponse)(nil), "stateset.settlement.MsgTopUpStreamResponse")
+}
+
+func init() { proto.RegisterFile("stateset/settlement/tx.proto", fileDescriptor_19e3855a8d88c072) }
+
+var fileDescriptor_19e3855a8d88c072 = []byte{
+	// 3983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x8c, 0x24, 0x47,
+	0x56, 0xce, 0xea, 0x5f, 0xd5, 0xab, 0xae, 0xee, 0x76, 0x7a, 0xec, 0xa9, 0x29, 0xdb, 0x3d, 0xed,
+	0x9c, 0xf1, 0x4e, 0x7b, 0xec, 0xe9, 0x5e, 0x0f, 0xcb, 0x02, 0x83, 0x2c, 0xe8, 0xea, 0x99, 0x59,
+	0x17, 0xda, 0xb6, 0x47, 0xd5, 0x33, 0x8b, 0xd6, 0x32, 0x5b, 0x44, 0x55, 0x46, 0x57, 0xa5, 0x3a,
+	0x7f, 0x9b, 0x11, 0xd5, 0xd3, 0xbd, 0x62, 0xc0, 0x62, 0xb5, 0x2b, 0x40, 0x80, 0x2c, 0x71, 0x41,
+	0x42, 0x08, 0x4e, 0x1c, 0xe0, 0xb2, 0x12, 0x7b, 0xe1, 0xc8, 0x6d, 0xf7, 0xb6, 0x20, 0x21, 0xad,
+	0x90, 0x30, 0x2b, 0x5b, 0x68, 0x6f, 0x7b, 0x41, 0x42, 0xc8, 0x17, 0x50, 0x7c, 0x32, 0x32, 0x32,
+	0x2b, 0x33, 0xab, 0xda, 0xdb, 0xdd, 0x1e, 0x21, 0x4e, 0xd3, 0x11, 0xf5, 0xe2, 0xbd, 0x17, 0x2f,
+	0xde, 0x7b, 0xf1, 0x3e, 0x91, 0x03, 0x2f, 0x11, 0x8a, 0x28, 0x26, 0x98, 0x6e, 0x13, 0x4c, 0xa9,
+	0x8b, 0x3d, 0xec, 0xd3, 0x6d, 0x7a, 0xbc, 0x15, 0x46, 0x01, 0x0d, 0xcc, 0xe7, 0xe2, 0x5f, 0xb7,
+	0x92, 0x5f, 0x5b, 0x97, 0x86, 0xc1, 0x30, 0xe0, 0xbf, 0x6f, 0xb3, 0xbf, 0x04, 0x68, 0x6b, 0x7d,
+	0x10, 0x10, 0x2f, 0x20, 0xdb, 0x7d, 0x44, 0xf0, 0xf6, 0xd1, 0x9b, 0x7d, 0x4c, 0xd1, 0x9b, 0xdb,
+	0x83, 0xc0, 0xf1, 0xe5, 0xef, 0x97, 0xe5, 0xef, 0x1e, 0x19, 0x6e, 0x1f, 0xbd, 0xc9, 0xfe, 0x91,
+	0x3f, 0x5c, 0x11, 0x3f, 0xf4, 0x04, 0x46, 0x31, 0x88, 0x71, 0x0e, 0x83, 0x60, 0xe8, 0xe2, 0x6d,
+	0x3e, 0xea, 0x8f, 0x0f, 0xb6, 0xed, 0x71, 0x84, 0xa8, 0x13, 0xc4, 0x38, 0xaf, 0x66, 0x7f, 0xa7,
+	0x8e, 0x87, 0x09, 0x45, 0x5e, 0x58, 0x84, 0xe0, 0x71, 0x84, 0xc2, 0x10, 0x47, 0x31, 0x81, 0xeb,
+	0x79, 0xbb, 0x4f, 0xfe, 0x14, 0x50, 0xd6, 0x5f, 0x55, 0xc0, 0xdc, 0x23, 0xc3, 0x8e, 0x4f, 0x28,
+	0xf2, 0xe9, 0xc3, 0x08, 0xf9, 0xe4, 0x00, 0x47, 0xe6, 0x0b, 0xb0, 0x48, 0xb0, 0x6f, 0xe3, 0xa8,
+	0x69, 0x6c, 0x18, 0x9b, 0xb5, 0xae, 0x1c, 0x99, 0x2f, 0x41, 0x2d, 0xc2, 0x03, 0x27, 0x74, 0xb0,
+	0x4f, 0x9b, 0x15, 0xfe, 0x53, 0x32, 0x61, 0xf6, 0x61, 0x11, 0x79, 0xc1, 0xd8, 0xa7, 0xcd, 0xb9,
+	0x0d, 0x63, 0xb3, 0x7e, 0xfb, 0xca, 0x96, 0xdc, 0x32, 0x13, 0xdc, 0x96, 0x14, 0xdc, 0xd6, 0x6e,
+	0xe0, 0xf8, 0xed, 0xed, 0x1f, 0x7c, 0x74, 0xf5, 0x99, 0x7f, 0xfd, 0xe8, 0xea, 0x8d, 0xa1, 0x43,
+	0x47, 0xe3, 0xfe, 0xd6, 0x20, 0xf0, 0xa4, 0x7c, 0xe4, 0x3f, 0xb7, 0x88, 0x7d, 0xb8, 0x4d, 0x4f,
+	0x42, 0x4c, 0xf8, 0x82, 0xae, 0xc4, 0x2c, 0x38, 0x38, 0xc0, 0x11, 0xf6, 0x07, 0xb8, 0x39, 0x1f,
+	0x73, 0x20, 0x27, 0xcc, 0x16, 0x54, 0x3d, 0x4c, 0x91, 0x8d, 0x28, 0x6a, 0x2e, 0xf0, 0x1f, 0xd5,
+	0xd8, 0xdc, 0x84, 0x35, 0x0f, 0x1d, 0xf7, 0x88, 0xeb, 0x84, 0x21, 0x1a, 0xe2, 0x5e, 0x3f, 0x24,
+	0xcd, 0xc5, 0x0d, 0x63, 0xb3, 0xd1, 0x5d, 0xf1, 0xd0, 0xf1, 0xbe, 0x9c, 0x6e, 0x87, 0xe4, 0x4e,
+	0xfd, 0xf7, 0x7f, 0xfa, 0xbd, 0x9b, 0x72, 0xcb, 0xd6, 0x7b, 0xd0, 0x9a, 0x14, 0x50, 0x17, 0x93,
+	0x30, 0xf0, 0x09, 0x36, 0xaf, 0x41, 0x23, 0x91, 0x69, 0xcf, 0xb1, 0xb9, 0xbc, 0xe6, 0xbb, 0xcb,
+	0xc9, 0x64, 0xc7, 0x36, 0x2f, 0xc3, 0x12, 0x3d, 0xee, 0x8d, 0x10, 0x19, 0x49, 0x99, 0x2d, 0xd2,
+	0xe3, 0xb7, 0x11, 0x19, 0x59, 0x3f, 0xa9, 0xc0, 0xea, 0x1e, 0x19, 0xee, 0x46, 0x18, 0x51, 0x7c,
+	0x8f, 0x0c, 0xa2, 0xe0, 0xf1, 0xff, 0x49, 0xd1, 0xb7, 0x01, 0xf0, 0x71, 0xe8, 0x44, 0x98, 0xf4,
+	0x1c, 0x9f, 0x0b, 0x9d, 0x71, 0x28, 0x14, 0x78, 0x2b, 0x56, 0xe0, 0xad, 0xbb, 0xd2, 0x02, 0xda,
+	0x55, 0xc6, 0xe1, 0x9f, 0xff, 0xfb, 0x55, 0xa3, 0x5b, 0x93, 0xcb, 0x3a, 0x7e, 0xee, 0xf1, 0x2d,
+	0x4d, 0x3f, 0xbe, 0x6f, 0x1b, 0x70, 0x39, 0x23, 0xe2, 0xd3, 0x1d, 0xde, 0x6e, 0xc2, 0x3b, 0x12,
+	0x82, 0xaf, 0xdf, 0x6e, 0x4d, 0xf0, 0xfe, 0x30, 0xb6, 0x4e, 0xc1, 0xfc, 0x87, 0x3a, 0xf3, 0x3b,
+	0xd4, 0x7a, 0x1f, 0xd6, 0xf6, 0xc8, 0xb0, 0x8b, 0x5d, 0x8c, 0xc8, 0xb4, 0x83, 0x9e, 0xe0, 0xaa,
+	0x32, 0xc9, 0x55, 0x7a, 0x8f, 0x2d, 0x68, 0x66, 0xb1, 0xc7, 0x7b, 0xb4, 0x7e, 0x87, 0x6b, 0x58,
+	0x17, 0x1f, 0x8c, 0x7d, 0x5b, 0x12, 0x4e, 0x69, 0x92, 0x91, 0xd5, 0xa4, 0x59, 0xc8, 0x33, 0xde,
+	0x23, 0x8c, 0x48, 0xe0, 0x73, 0x75, 0xab, 0x75, 0xe5, 0xe8, 0xce, 0x0a, 0x63, 0x2b, 0x41, 0x66,
+	0x5d, 0xe1, 0xc2, 0xd7, 0xa9, 0x2b, 0xc6, 0x3e, 0x35, 0x60, 0x45, 0x1d, 0x4c, 0x1b, 0xd1, 0xc1,
+	0x88, 0x31, 0x86, 0xc6, 0x74, 0x14, 0x44, 0x0e, 0x3d, 0x89, 0x19, 0x53, 0x13, 0x42, 0xc1, 0xa2,
+	0xc1, 0x08, 0x29, 0xfd, 0x57, 0x63, 0xb3, 0x09, 0x4b, 0x42, 0x16, 0xa4, 0x39, 0xb7, 0x31, 0xb7,
+	0x59, 0xeb, 0xc6, 0x43, 0xd3, 0x86, 0x25, 0xa1, 0xbe, 0xa4, 0x39, 0xbf, 0x31, 0x77, 0xc6, 0x96,
+	0x11, 0xa3, 0x36, 0xd7, 0x01, 0x94, 0x25, 0x90, 0xe6, 0x02, 0x67, 0x41, 0x9b, 0x91, 0x72, 0x51,
+	0x7b, 0xb1, 0xde, 0x83, 0x17, 0xd2, 0x7b, 0x57, 0x3a, 0x79, 0x05, 0xaa, 0x7d, 0x36, 0x91, 0xa8,
+	0xe3, 0x12, 0x1f, 0x77, 0x6c, 0xf3, 0x55, 0x58, 0x49, 0x9d, 0x0c, 0x69, 0x56, 0x36, 0xe6, 0x36,
+	0xe7, 0xbb, 0x0d, 0xfd, 0x68, 0x88, 0xf5, 0x75, 0x2e, 0xd7, 0x7d, 0x3e, 0x37, 0x8b, 0x5c, 0x75,
+	0x8a, 0x95, 0x14, 0xc5, 0x09, 0xb6, 0xff, 0xad, 0xc2, 0xf9, 0xd6, 0x70, 0x2b, 0xbe, 0x3d, 0x58,
+	0xa6, 0x01, 0x45, 0x6e, 0x4f, 0xba, 0x21, 0xe3, 0xcc, 0xdd, 0x50, 0x9d, 0xe3, 0xdf, 0x11, 0xbe,
+	0xc8, 0x01, 0x10, 0xe4, 0x0e, 0x30, 0x26, 0xd2, 0x2a, 0xcf, 0x92, 0x58, 0x8d, 0x63, 0xbf, 0x8f,
+	0x31, 0x61, 0xa4, 0x7c, 0x4c, 0x7b, 0xe7, 0xe6, 0x5e, 0x6b, 0x3e, 0xa6, 0x62, 0x57, 0xd6, 0x7f,
+	0x08, 0x9b, 0x78, 0x37, 0xc4, 0xfe, 0xee, 0x08, 0xf9, 0x3e, 0x76, 0x3f, 0xe3, 0x75, 0x60, 0xc3,
+	0x92, 0x8d, 0xc3, 0x80, 0x38, 0xe7, 0xc1, 0x70, 0x8c, 0xda, 0xbc, 0x09, 0xcf, 0x26, 0x6e, 0xbd,
+	0xd7, 0x77, 0x83, 0xc1, 0x21, 0xe1, 0x17, 0xc3, 0x5c, 0x77, 0x55, 0x39, 0xee, 0x36, 0x9f, 0x4e,
+	0x3b, 0xac, 0x01, 0x57, 0x23, 0x6d, 0x9b, 0x4a, 0x8d, 0x5e, 0x06, 0x18, 0x88, 0xa9, 0xc4, 0x00,
+	0x6a, 0x72, 0xa6, 0x63, 0xeb, 0x14, 0x11, 0xed, 0x8d, 0xb0, 0x33, 0x1c, 0x89, 0xdd, 0x27, 0x14,
+	0x77, 0xe8, 0xdb, 0x7c, 0xda, 0x7a, 0x24, 0xee, 0x56, 0x37, 0x20, 0x58, 0x13, 0xe6, 0x80, 0x8d,
+	0x95, 0x30, 0xc5, 0x28, 0x43, 0xb5, 0x92, 0xa1, 0x2a, 0x79, 0x17, 0xb0, 0xd6, 0x1f, 0xc9, 0x0b,
+	0x45, 0xc3, 0xab, 0xb8, 0x0f, 0xa0, 0x71, 0xe0, 0xf8, 0xc8, 0xed, 0xf5, 0x91, 0x8b, 0xd8, 0x2d,
+	0x79, 0xf6, 0x56, 0xb0, 0xcc, 0x09, 0xb4, 0x05, 0x7e, 0xeb, 0xbf, 0x0c, 0xb9, 0x49, 0xe4, 0x78,
+	0xf1, 0x26, 0xcb, 0xdd, 0x7b, 0xf9, 0x56, 0x2f, 0x24, 0x8e, 0xb8, 0x04, 0x0b, 0x7e, 0x10, 0xc7,
+	0x10, 0xf3, 0x5d, 0x31, 0x60, 0x6c, 0x13, 0x67, 0xe8, 0x23, 0x3a, 0x8e, 0xb0, 0x0c, 0x20, 0x92,
+	0x89, 0x89, 0x8b, 0xe5, 0x3b, 0x15, 0x79, 0x0a, 0xc9, 0xc6, 0xd5, 0x29, 0x7c, 0x13, 0x56, 0x04,
+	0xa5, 0xde, 0x80, 0xfd, 0x8c, 0xed, 0x73, 0x38, 0x86, 0x86, 0xa0, 0xb0, 0x2b, 0x08, 0x98, 0x8f,
+	0xe1, 0xd9, 0x08, 0x7b, 0xc8, 0xf1, 0x1d, 0x7f, 0xa8, 0x0e, 0xff, 0xec, 0xbd, 0xd2, 0x9a, 0x22,
+	0x12, 0x2b, 0xc0, 0xa7, 0x0b, 0xf0, 0x1c, 0xbf, 0x61, 0x87, 0x0e, 0xa1, 0x38, 0xda, 0x8b, 0x2f,
+	0xc4, 0xcf, 0x7e, 0x95, 0x9a, 0x30, 0xef, 0x23, 0x0f, 0xcb, 0x8b, 0x9d, 0xff, 0x6d, 0x6e, 0xc0,
+	0xf2, 0x01, 0xc6, 0xbd, 0x08, 0x51, 0x11, 0x77, 0xcd, 0xf3, 0xb8, 0x0b, 0x0e, 0x30, 0xee, 0xb2,
+	0x0b, 0x2c, 0x24, 0x4c, 0xe6, 0x9e, 0xe3, 0xf7, 0x92, 0x9b, 0x88, 0x1f, 0xe1, 0x19, 0xcb, 0xdc,
+	0x73, 0xfc, 0x7d, 0x45, 0x80, 0x93, 0x64, 0x01, 0x61, 0x42, 0x72, 0xf1, 0x1c, 0x48, 0xa2, 0x63,
+	0x8d, 0xe4, 0x35, 0x68, 0x88, 0xab, 0x12, 0xfb, 0xa8, 0xef, 0x62, 0x9b, 0x07, 0xa0, 0xd5, 0xee,
+	0x32, 0x9f, 0xbc, 0x27, 0xe6, 0x4c, 0x02, 0xab, 0x02, 0x88, 0x8e, 0x22, 0x4c, 0x46, 0x81, 0x6b,
+	0x37, 0xab, 0x67, 0xce, 0xd8, 0x0a, 0x27, 0xf1, 0x30, 0xa6, 0x60, 0x5e, 0x85, 0xfa, 0x63, 0xdc,
+	0x1f, 0x05, 0xc1, 0x61, 0x6f, 0x1c, 0xb9, 0xcd, 0x1a, 0x3f, 0x3c, 0x90, 0x53, 0x8f, 0x22, 0xd7,
+	0x7c, 0x07, 0xd6, 0xb4, 0xe0, 0xc1, 0xc6, 0x2e, 0x3a, 0x69, 0xc2, 0xec, 0x81, 0xf8, 0x6a, 0xb2,
+	0xf8, 0x2e, 0x5b, 0x6b, 0x7e, 0x25, 0x16, 0x05, 0x3b, 0x03, 0x34, 0xc4, 0xcd, 0xfa, 0xec, 0xc8,
+	0xea, 0x7c, 0xe5, 0x1e, 0x3a, 0xde, 0x19, 0x62, 0xf3, 0xb5, 0x0c, 0x63, 0x7e, 0xe0, 0x35, 0x97,
+	0x39, 0xfb, 0x29, 0x9a, 0x7e, 0xe0, 0x4d, 0x84, 0x23, 0x2f, 0xc3, 0x8b, 0x39, 0xba, 0xaf, 0x22,
+	0xcc, 0x7f, 0x5a, 0x84, 0x67, 0xf7, 0xc8, 0xf0, 0x51, 0x68, 0x23, 0x8a, 0xff, 0xdf, 0x32, 0xce,
+	0xd5, 0x32, 0xee, 0xe5, 0x59, 0x46, 0x5e, 0xa2, 0xd4, 0x0e, 0x02, 0xf7, 0x6b, 0xc8, 0x1d, 0xe3,
+	0xf6, 0xfc, 0x5f, 0x33, 0x5d, 0x78, 0x0a, 0x6c, 0xe7, 0x2d, 0xa8, 0x39, 0xa4, 0x87, 0x06, 0xd4,
+	0x39, 0xc2, 0xdc, 0x72, 0x66, 0xe1, 0xbb, 0xea, 0x90, 0x1d, 0xbe, 0x22, 0x6b, 0x7a, 0x30, 0x61,
+	0x7a, 0xbf, 0x91, 0x63, 0x7a, 0x53, 0xad, 0x65, 0x3e, 0xdf, 0xec, 0x76, 0xb3, 0x66, 0xb7, 0x3c,
+	0x1b, 0xa2, 0xa9, 0x26, 0xd7, 0x98, 0xcd, 0xe4, 0x5e, 0x84, 0x2b, 0x13, 0x26, 0xa5, 0x0c, 0xee,
+	0xef, 0xe6, 0xf4, 0x62, 0xd2, 0xee, 0x08, 0x0f, 0x0e, 0x83, 0x31, 0x65, 0x36, 0x35, 0x18, 0x13,
+	0x1a, 0x78, 0x2a, 0xee, 0x52, 0xe3, 0x52, 0x7b, 0xbb, 0x88, 0x58, 0xe4, 0x06, 0xac, 0x06, 0x91,
+	0x8d, 0xa3, 0x5e, 0xb6, 0xb2, 0xb1, 0xc2, 0xa7, 0xbb, 0xaa, 0xbc, 0xf1, 0x32, 0xc0, 0x98, 0xe0,
+	0x1e, 0xe6, 0x49, 0x2c, 0x37, 0xe1, 0x6a, 0xb7, 0x36, 0x56, 0xc9, 0xfc, 0x5b, 0xb0, 0xe0, 0x50,
+	0xec, 0x91, 0xe6, 0x22, 0x4f, 0x32, 0x5f, 0xd9, 0xca, 0xa9, 0x2e, 0x6e, 0xc5, 0x12, 0xe9, 0x50,
+	0xec, 0xb5, 0xe7, 0x19, 0xcb, 0x5d, 0xb1, 0x2a, 0x55, 0x3c, 0x59, 0x9a, 0xa1, 0x6e, 0x55, 0xcd,
+	0x2b, 0x7c, 0x30, 0x1e, 0x49, 0xe8, 0x3a, 0xb4, 0xc7, 0xdd, 0x54, 0x4d, 0xc6, 0x50, 0x6c, 0xe6,
+	0x1d, 0xe4, 0xe1, 0x3b, 0x0d, 0x76, 0x96, 0x4a, 0xf4, 0xd6, 0x0f, 0x2b, 0x7a, 0x65, 0x2b, 0xe6,
+	0xed, 0x74, 0xc5, 0x11, 0x96, 0x9d, 0x50, 0x44, 0xc7, 0x24, 0x2e, 0x6c, 0x89, 0xd1, 0x05, 0xe6,
+	0x4c, 0xe6, 0xfb, 0x30, 0x77, 0x80, 0xc5, 0xa9, 0x9d, 0x2d, 0x0d, 0x86, 0x96, 0xe5, 0xdc, 0x34,
+	0x42, 0x3e, 0x61, 0xde, 0x21, 0xf0, 0x99, 0x18, 0x44, 0x68, 0xda, 0xd0, 0x66, 0x3b, 0xb6, 0xf5,
+	0x33, 0x83, 0x17, 0x78, 0x1e, 0xa0, 0x88, 0x3a, 0xc8, 0x15, 0xf5, 0x8e, 0x29, 0x37, 0xcd, 0x4c,
+	0x75, 0x96, 0x00, 0x1a, 0x11, 0x47, 0x76, 0x7e, 0xa2, 0x5c, 0x16, 0x04, 0xa4, 0x34, 0x93, 0xc2,
+	0xce, 0x7c, 0x4e, 0x61, 0x27, 0xf1, 0x03, 0xdf, 0xad, 0xf0, 0x9a, 0x53, 0x6a, 0xc3, 0x4a, 0x75,
+	0x08, 0xac, 0x0a, 0xa4, 0xd8, 0x3e, 0xbf, 0x72, 0xc0, 0x4a, 0x4c, 0x42, 0x72, 0x3e, 0x86, 0x24,
+	0x3a, 0x8e, 0xa9, 0x9e, 0x7d, 0x04, 0xbe, 0xaa, 0x68, 0xc8, 0x94, 0xfd, 0xd3, 0x0a, 0xf7, 0x88,
+	0xa2, 0x94, 0xb3, 0x37, 0x76, 0xa9, 0xc3, 0x64, 0x72, 0xf2, 0xd4, 0x17, 0x73, 0x5b, 0x50, 0x45,
+	0x51, 0xdf, 0xa1, 0x38, 0x12, 0x85, 0xb1, 0x5a, 0x57, 0x8d, 0x19, 0x77, 0xc9, 0xfd, 0xbb, 0xc0,
+	0x5d, 0x4d, 0x32, 0x91, 0x2e, 0x03, 0x2f, 0x96, 0x95, 0x81, 0x97, 0x4a, 0xcb, 0xc0, 0xd5, 0xcf,
+	0x52, 0x06, 0x4e, 0xd7, 0x11, 0xfe, 0xc4, 0x80, 0x57, 0x0a, 0x85, 0xff, 0x39, 0x94, 0x79, 0xff,
+	0x4c, 0xb8, 0xd4, 0x9d, 0x30, 0x8c, 0x82, 0xa3, 0xa4, 0x12, 0x1b, 0xb8, 0x63, 0xb6, 0x21, 0xae,
+	0x0d, 0xce, 0xd0, 0xd7, 0xb4, 0x81, 0x8f, 0x66, 0x73, 0x05, 0x5f, 0x02, 0x88, 0x14, 0x2a, 0x11,
+	0x83, 0xb6, 0x2f, 0x7d, 0xfa, 0xd1, 0xd5, 0xb5, 0x2c, 0x99, 0xae, 0x06, 0x27, 0xac, 0x42, 0xea,
+	0x55, 0x6c, 0x15, 0xf3, 0xe7, 0x61, 0x15, 0x92, 0x86, 0xb0, 0x8a, 0xf8, 0x94, 0xf8, 0xf6, 0xac,
+	0x6f, 0x80, 0x55, 0x2c, 0x14, 0x75, 0x4a, 0xcc, 0x5b, 0x72, 0x10, 0xe4, 0x12, 0x2e, 0x9f, 0x46,
+	0x37, 0x99, 0x60, 0x6a, 0xc5, 0x77, 0x75, 0x84, 0x85, 0x74, 0xaa, 0x5d, 0x35, 0xb6, 0x7e, 0x6c,
+	0xc0, 0xca, 0x9e, 0xe3, 0x62, 0x42, 0x03, 0x1f, 0x77, 0xfc, 0x70, 0x4c, 0xcd, 0x0d, 0xa8, 0xdb,
+	0xec, 0xaa, 0x76, 0x42, 0x2e, 0x2d, 0x21, 0x6e, 0x7d, 0x4a, 0xb3, 0xb1, 0xca, 0xb9, 0xd9, 0xd8,
+	0xaf, 0x43, 0xd5, 0xc6, 0xc8, 0x76, 0x1d, 0x1f, 0x4b, 0x4b, 0x9e, 0x4d, 0xa3, 0xd4, 0x2a, 0xeb,
+	0x6f, 0x84, 0x9b, 0x95, 0x0a, 0x1e, 0xef, 0xf1, 0xe7, 0x72, 0x2e, 0x1d, 0x00, 0x2f, 0x46, 0x24,
+	0xaa, 0xe5, 0xf5, 0xdb, 0xd7, 0x72, 0xc3, 0x95, 0xb4, 0x4c, 0x65, 0xc0, 0xa2, 0x2d, 0xfe, 0x7c,
+	0x1b, 0x42, 0x69, 0x4f, 0xf0, 0xc7, 0x06, 0x6c, 0x14, 0x09, 0xea, 0x73, 0x70, 0x04, 0x4f, 0x64,
+	0x55, 0x86, 0x77, 0x64, 0x14, 0x3b, 0x3f, 0x57, 0xcb, 0x87, 0x49, 0x5b, 0xc9, 0x9e, 0xab, 0x53,
+	0xa3, 0x9b, 0x4c, 0xa4, 0xa5, 0xf1, 0x07, 0x86, 0xcc, 0x8c, 0xd3, 0xf4, 0x95, 0x20, 0xd2, 0xe1,
+	0x99, 0x71, 0x9e, 0x25, 0xed, 0xbf, 0x34, 0x78, 0x4e, 0x20, 0x22, 0x84, 0x44, 0x12, 0x67, 0xd0,
+	0x83, 0x2a, 0x95, 0xc7, 0x94, 0x40, 0x26, 0x29, 0x24, 0xbe, 0xc4, 0x3d, 0x76, 0x86, 0x3d, 0x95,
+	0xd1, 0xfc, 0xc5, 0x1c, 0x3c, 0xaf, 0xd4, 0x6a, 0x7f, 0xdc, 0x4f, 0xfc, 0xc7, 0x25, 0x58, 0x08,
+	0xd1, 0x89, 0x3a, 0x49, 0x31, 0xf8, 0xdc, 0xd3, 0x99, 0x5f, 0x83, 0xaa, 0xe3, 0x53, 0x1c, 0x1d,
+	0x21, 0x57, 0xb9, 0xf9, 0x19, 0xac, 0x4a, 0x2d, 0x62, 0x29, 0x04, 0x4b, 0x36, 0x06, 0x27, 0x03,
+	0x97, 0x37, 0xb2, 0x78, 0x79, 0xd8, 0x43, 0xc7, 0xbb, 0x7c, 0xc2, 0xbc, 0x0f, 0xcb, 0xc3, 0x08,
+	0x0d, 0x70, 0x2f, 0xc4, 0x91, 0x13, 0xd8, 0xa7, 0xb1, 0xdc, 0x3a, 0x5f, 0xf8, 0x80, 0xaf, 0x4b,
+	0x7b, 0x8e, 0xa5, 0x32, 0xcf, 0x51, 0x4d, 0x7b, 0x8e, 0x3b, 0xc0, 0xce, 0x4f, 0x48, 0xdb, 0xfa,
+	0x43, 0x03, 0x5e, 0xce, 0x3d, 0x1d, 0xa5, 0xe8, 0x37, 0x60, 0x95, 0x68, 0xf3, 0x89, 0xcd, 0xaf,
+	0xe8, 0xd3, 0x1d, 0x9b, 0x09, 0xce, 0xc7, 0xc7, 0xb4, 0x67, 0x8f, 0xf1, 0xa9, 0x6c, 0x7e, 0x89,
+	0xad, 0xba, 0x3b, 0xc6, 0xd6, 0x13, 0xa1, 0x28, 0xc8, 0x1f, 0x60, 0x37, 0xa5, 0x28, 0x45, 0x97,
+	0x7e, 0x0e, 0x6b, 0x95, 0x5c, 0xd6, 0x8a, 0x7a, 0xad, 0xa9, 0x3b, 0xf6, 0xaa, 0x90, 0xc4, 0x04,
+	0x79, 0xa5, 0xc9, 0x3f, 0x13, 0x2e, 0xe1, 0xdd, 0x10, 0xfb, 0x6d, 0xc7, 0x76, 0x22, 0xcc, 0x53,
+	0x17, 0xe4, 0xc6, 0x5d, 0x83, 0xcb, 0xb0, 0x14, 0xb2, 0xd8, 0xa9, 0x87, 0x62, 0x3e, 0xf9, 0x70,
+	0x27, 0xf9, 0xa1, 0x1f, 0xe7, 0x78, 0x7c, 0xd8, 0xbe, 0xa0, 0x1e, 0xd3, 0x6b, 0xb0, 0x36, 0x18,
+	0x21, 0xd7, 0xc5, 0xfe, 0x50, 0x69, 0x9d, 0x6c, 0x31, 0xa9, 0x79, 0xa1, 0x54, 0x77, 0x96, 0x99,
+	0x40, 0xe2, 0x5d, 0x58, 0x77, 0xe1, 0x5a, 0xc9, 0x7e, 0x67, 0x6c, 0x38, 0x59, 0x3f, 0x14, 0x62,
+	0xbb, 0x3f, 0xf6, 0xed, 0x72, 0xb1, 0xf5, 0x53, 0x62, 0x6b, 0x4f, 0xeb, 0xb3, 0x5c, 0x88, 0xf0,
+	0x52, 0x12, 0xe9, 0x5b, 0xaf, 0x72, 0x89, 0x14, 0x6d, 0x45, 0x69, 0xca, 0x07, 0x06, 0x77, 0x89,
+	0xbb, 0x41, 0x10, 0x62, 0x66, 0xc5, 0x47, 0x38, 0xdb, 0x43, 0xcb, 0xd5, 0xe7, 0xb7, 0x60, 0x81,
+	0x07, 0x11, 0xd2, 0x7c, 0x8a, 0x2a, 0x20, 0x1c, 0xc9, 0x3e, 0xfb, 0x29, 0xae, 0x80, 0x70, 0xb8,
+	0xb4, 0x36, 0x5f, 0xe7, 0x11, 0x63, 0x01, 0x07, 0x8a, 0xd1, 0x27, 0xbc, 0x05, 0xd4, 0xf1, 0x1d,
+	0xea, 0x20, 0x1a, 0xff, 0xca, 0x21, 0x2f, 0x84, 0xc9, 0x47, 0x70, 0xb5, 0x80, 0xbc, 0x52, 0xae,
+	0xdb, 0xf0, 0x7c, 0xa2, 0xbc, 0xd8, 0xb7, 0x49, 0xdc, 0xb2, 0x34, 0xb8, 0x06, 0x3f, 0xa7, 0x7e,
+	0xbc, 0xe7, 0xdb, 0x44, 0xb6, 0x2d, 0x7f, 0x57, 0x44, 0x7c, 0xf1, 0x2f, 0x17, 0xbe, 0x2d, 0x4b,
+	0x04, 0x52, 0x79, 0xf4, 0x95, 0xe4, 0xff, 0xb1, 0x02, 0xf5, 0x3d, 0x32, 0xfc, 0x6a, 0x30, 0x38,
+	0x7c, 0xfb, 0xe1, 0x57, 0x77, 0x9f, 0xe2, 0x34, 0xf7, 0x45, 0xa8, 0x8d, 0x10, 0x19, 0xf5, 0xdc,
+	0x60, 0x70, 0x28, 0x23, 0x81, 0x2a, 0x9b, 0x60, 0xac, 0xf3, 0xe2, 0x8e, 0xe3, 0xe1, 0x60, 0x4c,
+	0xe3, 0xe6, 0xf5, 0x02, 0x3f, 0x97, 0x86, 0x9c, 0x15, 0xad, 0xeb, 0x8c, 0x29, 0x2f, 0x66, 0x4d,
+	0x79, 0x13, 0xd6, 0x1c, 0x7f, 0x10, 0x78, 0x8e, 0x3f, 0xec, 0x8d, 0xa8, 0x3b, 0x60, 0x40, 0x4b,
+	0xc2, 0x93, 0xc7, 0xf3, 0x6f, 0x53, 0x77, 0x90, 0x7d, 0xb4, 0xf3, 0x88, 0x87, 0x88, 0xb1, 0x08,
+	0x95, 0xca, 0x5c, 0x86, 0xa5, 0x18, 0x89, 0x70, 0x46, 0x8b, 0x23, 0xbe, 0x58, 0x67, 0x36, 0xd5,
+	0xf7, 0x8e, 0x99, 0x95, 0xea, 0xf3, 0x4d, 0x58, 0x8e, 0xfb, 0xa2, 0xfc, 0x68, 0xca, 0x03, 0x2d,
+	0x8d, 0x5a, 0x25, 0x45, 0xad, 0x05, 0xd5, 0x30, 0xc2, 0x8e, 0x87, 0x86, 0x71, 0xbf, 0x43, 0x8d,
+	0x27, 0x42, 0xa8, 0x17, 0xe0, 0x92, 0x4e, 0x52, 0x69, 0xc9, 0x1e, 0x34, 0x54, 0x68, 0x55, 0xaa,
+	0x26, 0x45, 0x5c, 0xa4, 0x05, 0x76, 0x99, 0xdf, 0xb0, 0x09, 0x3a, 0x45, 0xe7, 0x3b, 0xa2, 0x23,
+	0xbf, 0x8f, 0x69, 0xec, 0x21, 0x82, 0x31, 0x75, 0xfc, 0xe1, 0x7d, 0x5c, 0x1c, 0x71, 0x4f, 0x71,
+	0xcf, 0x5f, 0x80, 0xd5, 0x48, 0x20, 0xe9, 0x1d, 0x60, 0x51, 0x72, 0x15, 0x11, 0x66, 0x23, 0x52,
+	0xb8, 0x27, 0x9e, 0x9a, 0xbd, 0xc2, 0x1d, 0x42, 0x1e, 0x1b, 0x99, 0xd7, 0x58, 0xf7, 0x23, 0x8c,
+	0xbf, 0x85, 0x1f, 0xa0, 0x93, 0x60, 0x4c, 0xcf, 0xa2, 0x4a, 0x58, 0xfe, 0x1a, 0x2b, 0x29, 0xda,
+	0x89, 0xd7, 0x58, 0x3a, 0x75, 0xc5, 0xd8, 0x81, 0x68, 0x95, 0xf9, 0x07, 0x67, 0xcb, 0xda, 0x04,
+	0x0b, 0xbf, 0x2d, 0xfa, 0x07, 0x29, 0x3a, 0x4a, 0xf7, 0x77, 0x01, 0x22, 0x91, 0xb2, 0xb0, 0xd4,
+	0xcb, 0x38, 0x4d, 0xea, 0x25, 0xd7, 0xed, 0x50, 0xeb, 0x1f, 0x0c, 0x2d, 0x64, 0x7f, 0x07, 0x53,
+	0x76, 0x04, 0x3c, 0x7a, 0x65, 0x3a, 0x2d, 0x2e, 0x94, 0x40, 0xf5, 0x21, 0xe2, 0xb1, 0x69, 0xc1,
+	0x32, 0xbb, 0x26, 0x9d, 0x81, 0x13, 0x22, 0x9f, 0x8a, 0x97, 0x55, 0xb5, 0x6e, 0x6a, 0x8e, 0x85,
+	0xfc, 0xa2, 0x57, 0x22, 0xa4, 0x2c, 0x06, 0xe6, 0xaf, 0xc2, 0xe2, 0x63, 0xc7, 0xb7, 0x83, 0xc7,
+	0xa7, 0x09, 0xb8, 0xe5, 0x12, 0x59, 0x92, 0x8f, 0xb9, 0xb0, 0x9e, 0x68, 0xf1, 0xac, 0xce, 0xba,
+	0xfe, 0x3a, 0x8c, 0x87, 0xe6, 0xda, 0xeb, 0x30, 0x3e, 0xee, 0xd8, 0xe6, 0x0e, 0xd4, 0xf8, 0x0b,
+	0x95, 0x53, 0xa7, 0xad, 0x55, 0xb1, 0x6c, 0x87, 0x5a, 0xff, 0x69, 0x70, 0x9f, 0xb4, 0x3f, 0xee,
+	0x7b, 0x0e, 0x7d, 0xb7, 0xef, 0x3a, 0x43, 0x14, 0x87, 0xb0, 0x36, 0xee, 0x27, 0x62, 0x93, 0xa3,
+	0x14, 0x37, 0x95, 0x34, 0x37, 0x2d, 0xa8, 0x0e, 0x22, 0x6c, 0x3b, 0x6c, 0x91, 0xf4, 0x1f, 0xf1,
+	0x58, 0xf3, 0xfb, 0xf3, 0x17, 0xf3, 0x56, 0x75, 0x21, 0x93, 0x60, 0x48, 0xb3, 0x15, 0xbb, 0xb0,
+	0xda, 0x3c, 0xc2, 0xcb, 0x6e, 0x5a, 0x2f, 0x1a, 0x04, 0x6a, 0x56, 0x2b, 0x1a, 0x24, 0x93, 0x1d,
+	0xdb, 0xfa, 0x2d, 0xae, 0x73, 0xa2, 0x1f, 0x3a, 0xb3, 0xce, 0x15, 0x8b, 0x2f, 0xab, 0x17, 0x98,
+	0xeb, 0xc5, 0x24, 0x7a, 0xc5, 0xe4, 0x5d, 0xa8, 0x51, 0xf9, 0x34, 0x99, 0x34, 0x0d, 0x5e, 0xd3,
+	0xd9, 0xc8, 0x0d, 0x02, 0xde, 0xc1, 0xea, 0x0d, 0xb3, 0x8c, 0x01, 0x92, 0x85, 0xd6, 0x87, 0xf2,
+	0xfc, 0x31, 0xdd, 0x0f, 0x5d, 0x87, 0x3e, 0xc4, 0x5e, 0xe8, 0x22, 0x8a, 0x53, 0x59, 0xad, 0x91,
+	0xc9, 0x6a, 0xef, 0xc0, 0x42, 0x34, 0x76, 0xb1, 0xb0, 0x98, 0xfa, 0xed, 0xf5, 0x5c, 0xaa, 0x1c,
+	0x5d, 0x77, 0xec, 0xaa, 0xb8, 0x83, 0x2f, 0xc9, 0x6b, 0xa8, 0xcb, 0x9d, 0xc7, 0xe8, 0x65, 0x8b,
+	0x3f, 0xcb, 0x91, 0x72, 0x5b, 0xff, 0x32, 0xc7, 0xfb, 0x2e, 0xb2, 0xec, 0x83, 0x7c, 0x5b, 0xb2,
+	0xfb, 0x99, 0xfa, 0x8d, 0x21, 0xac, 0x84, 0x38, 0xea, 0x0d, 0x46, 0x28, 0x1a, 0xe2, 0x9e, 0x87,
+	0x8e, 0xcf, 0xa3, 0xdb, 0x12, 0xe2, 0x68, 0x97, 0x13, 0xd8, 0x43, 0xc7, 0xa6, 0x03, 0x20, 0x52,
+	0x9a, 0xde, 0x00, 0x85, 0xe7, 0x60, 0x0d, 0x35, 0x81, 0x7d, 0x17, 0x85, 0xcc, 0x4d, 0xc9, 0xec,
+	0x69, 0xe1, 0x14, 0x6e, 0x4a, 0x2c, 0x39, 0x93, 0xf7, 0xdb, 0xa5, 0x29, 0x7f, 0xb6, 0x37, 0xf9,
+	0x2b, 0x7a, 0xd9, 0x53, 0x1c, 0xab, 0x9e, 0xb1, 0x79, 0x62, 0x4a, 0xcb, 0xd8, 0xe4, 0x4c, 0xc7,
+	0xb6, 0x7c, 0xf9, 0xd4, 0xfa, 0x28, 0x38, 0x54, 0x1a, 0x51, 0x14, 0x37, 0xa7, 0x51, 0x55, 0x32,
+	0xa8, 0x66, 0xcb, 0xbc, 0xe3, 0xc7, 0xd7, 0x1a, 0x3d, 0xa5, 0x9e, 0xff, 0x2d, 0xde, 0x73, 0x3e,
+	0x18, 0xbb, 0xee, 0x03, 0x74, 0xc2, 0x1f, 0x4a, 0x94, 0xd9, 0xd2, 0x14, 0x76, 0x9e, 0xea, 0x37,
+	0xfe, 0x59, 0xc3, 0xfd, 0xbe, 0xc1, 0xdf, 0x78, 0x6a, 0x5b, 0x3f, 0x5d, 0x19, 0x76, 0x0c, 0x6b,
+	0xd2, 0x34, 0x54, 0xc7, 0xed, 0x3c, 0xda, 0x79, 0x82, 0x46, 0x37, 0x26, 0x61, 0xfd, 0xad, 0xfe,
+	0x45, 0xc6, 0x3e, 0x8d, 0x30, 0xf2, 0x0a, 0x4a, 0x7d, 0x4f, 0xc3, 0x03, 0xdc, 0x7d, 0x58, 0xe5,
+	0x2f, 0x8f, 0x98, 0xdb, 0x22, 0x78, 0x10, 0xf8, 0xa2, 0x36, 0x52, 0x6b, 0xbf, 0x2e, 0x51, 0x3e,
+	0x2f, 0x10, 0x10, 0xfb, 0x70, 0xcb, 0x09, 0xb6, 0x3d, 0x44, 0x47, 0x5b, 0x1d, 0x9f, 0xfe, 0xf3,
+	0xf7, 0x6f, 0x81, 0xe4, 0xa6, 0xe3, 0xd3, 0x6e, 0x83, 0xe1, 0x78, 0x80, 0xa3, 0x7d, 0x8e, 0x61,
+	0xca, 0xd5, 0xa9, 0xd7, 0xdf, 0xbe, 0xac, 0x7d, 0x5a, 0x21, 0x64, 0xa5, 0xce, 0xf8, 0x45, 0xa8,
+	0x11, 0x3e, 0x93, 0x9c, 0x6f, 0x55, 0x4c, 0x74, 0x6c, 0xeb, 0x1b, 0x3c, 0xd8, 0xfc, 0x4d, 0x87,
+	0x8e, 0xec, 0x08, 0x3d, 0x96, 0x52, 0x2e, 0x4f, 0x54, 0x52, 0xf8, 0x2a, 0x69, 0x7c, 0x13, 0x09,
+	0xc9, 0xef, 0xf1, 0x20, 0x33, 0x8d, 0x5f, 0x71, 0x96, 0x58, 0x91, 0x71, 0x5e, 0x56, 0x64, 0xed,
+	0x0b, 0x25, 0x12, 0xd5, 0x38, 0xb1, 0xbd, 0x22, 0x17, 0x54, 0xba, 0xb1, 0x94, 0xa3, 0xf9, 0x1f,
+	0xf9, 0xf0, 0x58, 0xc3, 0xaa, 0x36, 0x95, 0xd7, 0xe6, 0x33, 0xce, 0xbd, 0xcd, 0x67, 0x7a, 0x2c,
+	0x6a, 0x3e, 0x11, 0xaf, 0x67, 0xc6, 0xbe, 0x7d, 0x0e, 0x06, 0x5a, 0xe7, 0xf8, 0x45, 0xd2, 0x67,
+	0xfd, 0xbd, 0x70, 0xa7, 0x0f, 0x83, 0xf0, 0x51, 0x58, 0x6a, 0x9b, 0x65, 0x42, 0xbd, 0x08, 0x37,
+	0x9a, 0xb2, 0x12, 0xc4, 0x1d, 0xa1, 0xc6, 0xb4, 0x3a, 0xb5, 0xaf, 0xc0, 0xb2, 0x7c, 0x25, 0x31,
+	0xf6, 0xa9, 0xe3, 0x9e, 0x2a, 0xe3, 0xa9, 0x8b, 0x95, 0x8f, 0xd8, 0xc2, 0xdb, 0x7f, 0x7a, 0x1d,
+	0xe6, 0xf6, 0xc8, 0xd0, 0x3c, 0x84, 0xd5, 0xec, 0x97, 0x7c, 0x37, 0xf2, 0x5b, 0x7b, 0x13, 0x5f,
+	0xb4, 0xb5, 0xb6, 0x67, 0x04, 0xd4, 0x0c, 0x69, 0x39, 0xf5, 0xe1, 0xda, 0xf5, 0x22, 0x04, 0x3a,
+	0x54, 0xeb, 0x8d, 0x59, 0xa0, 0x14, 0x0d, 0x0c, 0x8d, 0xf4, 0x47, 0x53, 0xaf, 0x16, 0x2d, 0x4f,
+	0x81, 0xb5, 0x6e, 0xcd, 0x04, 0xa6, 0x6f, 0x25, 0xf5, 0x85, 0xd4, 0xf5, 0xe2, 0xe5, 0x09, 0x54,
+	0xf1, 0x56, 0xf2, 0xbe, 0x77, 0x32, 0x7b, 0x50, 0xd7, 0xbf, 0x75, 0xba, 0x56, 0x2e, 0x07, 0x0e,
+	0xd4, 0x7a, 0x7d, 0x06, 0x20, 0x9d, 0x80, 0xfe, 0xd1, 0x4f, 0x21, 0x01, 0x0d, 0xa8, 0x98, 0x40,
+	0xde, 0x27, 0x3e, 0x3d, 0xa8, 0xeb, 0x5f, 0xa6, 0x14, 0x12, 0xd0, 0x80, 0x8a, 0x09, 0xe4, 0x7d,
+	0xfc, 0xc1, 0x34, 0x4a, 0x2f, 0x35, 0x17, 0x6b, 0x94, 0x06, 0x55, 0xa2, 0x51, 0x79, 0x9f, 0x68,
+	0x70, 0x1a, 0xda, 0xd7, 0x12, 0x25, 0x34, 0x12, 0xa8, 0x32, 0x1a, 0x39, 0x1f, 0x20, 0xf8, 0xb0,
+	0x36, 0xf1, 0x20, 0x7f, 0xb3, 0x58, 0x59, 0xd2, 0x90, 0xad, 0x2f, 0xce, 0x0a, 0xa9, 0xe8, 0x8d,
+	0x60, 0x25, 0xf3, 0xc8, 0xf9, 0x0b, 0x45, 0x38, 0xd2, 0x70, 0xad, 0xad, 0xd9, 0xe0, 0x14, 0xa5,
+	0xc4, 0xc1, 0xa8, 0xd7, 0x9d, 0xd3, 0x1c, 0x4c, 0x0c, 0x38, 0xd5, 0xc1, 0x4c, 0xbc, 0x40, 0xc4,
+	0xd0, 0x48, 0x3f, 0xa8, 0x2b, 0x34, 0xfe, 0x14, 0x58, 0xb1, 0xf1, 0xe7, 0xbf, 0x56, 0xfb, 0xc0,
+	0x80, 0x17, 0x0a, 0x9e, 0x6f, 0x6d, 0x95, 0xdb, 0x5f, 0x16, 0xbe, 0xf5, 0xe5, 0xd3, 0xc1, 0x2b,
+	0x16, 0xbe, 0x6d, 0xc0, 0xe5, 0xa2, 0x47, 0x43, 0x85, 0x62, 0x2b, 0x58, 0xd0, 0xfa, 0xa5, 0x53,
+	0x2e, 0x50, 0x5c, 0x3c, 0x81, 0xe7, 0xf3, 0x1f, 0x9a, 0xdc, 0x9a, 0xb2, 0xad, 0x34, 0x78, 0xeb,
+	0x17, 0x4f, 0x05, 0x9e, 0xb6, 0x9a, 0xcc, 0x83, 0x89, 0xcd, 0x29, 0x7e, 0x5c, 0x41, 0x96, 0x59,
+	0x4d, 0xc1, 0x23, 0x88, 0x43, 0x58, 0xcd, 0xbe, 0x4a, 0xb8, 0x51, 0xee, 0xd1, 0x13, 0x6a, 0xdb,
+	0x33, 0x02, 0x2a, 0x62, 0x14, 0xcc, 0x9c, 0x47, 0x04, 0x37, 0xcb, 0x25, 0xa5, 0xc3, 0xb6, 0x6e,
+	0xcf, 0x0e, 0x9b, 0xa2, 0x3a, 0xd9, 0x91, 0x2e, 0xa6, 0x3a, 0x01, 0x5b, 0x42, 0xb5, 0xb0, 0xd5,
+	0x6c, 0x7e, 0xd7, 0x80, 0x66, 0x61, 0x9f, 0xf9, 0x8b, 0x65, 0x17, 0x42, 0xde, 0x8a, 0xd6, 0x2f,
+	0x9f, 0x76, 0x45, 0x8a, 0x91, 0xc2, 0xce, 0x6d, 0x21, 0x23, 0x45, 0x2b, 0x8a, 0x19, 0x99, 0xd6,
+	0x52, 0xe5, 0xf6, 0x5d, 0xd4, 0x4f, 0x2d, 0x54, 0xa5, 0x82, 0x05, 0xc5, 0xf6, 0x3d, 0xa5, 0x5f,
+	0x6a, 0x7e, 0x0b, 0x2e, 0xe5, 0x36, 0x4b, 0xdf, 0x28, 0x76, 0xcc, 0x93, 0xd0, 0xad, 0x2f, 0x9d,
+	0x06, 0x3a, 0xe5, 0x5b, 0x72, 0x5b, 0x9a, 0xc5, 0xbe, 0x25, 0x0f, 0xbc, 0xc4, 0xb7, 0x94, 0x35,
+	0x2c, 0xcd, 0xaf, 0x41, 0x55, 0x35, 0x2b, 0x37, 0x8a, 0x50, 0xc4, 0x10, 0xad, 0xcd, 0x69, 0x10,
+	0x0a, 0xef, 0xd7, 0xa1, 0x96, 0xb4, 0xda, 0x5e, 0x29, 0x0d, 0x12, 0x38, 0xe6, 0xd7, 0xa6, 0x82,
+	0x28, 0xd4, 0xef, 0x03, 0x68, 0xad, 0x33, 0xab, 0xdc, 0xe1, 0x70, 0xe4, 0x37, 0xa7, 0xc3, 0xe8,
+	0xba, 0x90, 0xdb, 0x2f, 0x7b, 0xa3, 0x24, 0x20, 0x9c, 0x80, 0x2e, 0xd6, 0x85, 0xb2, 0x26, 0x18,
+	0x0b, 0xc1, 0x52, 0x1d, 0xb0, 0xc2, 0x10, 0x4c, 0x87, 0x2a, 0x0e, 0xc1, 0xf2, 0xfa, 0x59, 0x3c,
+	0x24, 0x4a, 0x37, 0xb3, 0x8a, 0x43, 0xa2, 0x14, 0x5c, 0x49, 0x48, 0x94, 0xdf, 0xb4, 0x52, 0x9e,
+	0x3d, 0x55, 0xf7, 0x9f, 0xe2, 0xd9, 0x75, 0xd8, 0x69, 0x9e, 0x3d, 0xb7, 0xe0, 0xef, 0xc3, 0xda,
+	0x44, 0x9b, 0xa6, 0x50, 0x6d, 0xb3, 0x90, 0xc5, 0x97, 0x65, 0x61, 0x17, 0x84, 0x82, 0x99, 0xd3,
+	0xdd, 0xb8, 0x59, 0x9e, 0x3e, 0xcc, 0xb6, 0xcb, 0x92, 0xb6, 0x06, 0xdb, 0x65, 0xb6, 0x19, 0xb1,
+	0x59, 0x82, 0x27, 0x05, 0x59, 0xb2, 0xcb, 0x82, 0x76, 0x02, 0x8b, 0x38, 0xd3, 0xad, 0x84, 0x57,
+	0xa7, 0x84, 0x32, 0x02, 0xac, 0x75, 0x6b, 0x26, 0xb0, 0x74, 0x56, 0xab, 0xd7, 0xa7, 0x4b, 0xb2,
+	0x5a, 0x0d, 0xac, 0x2c, 0xab, 0xcd, 0xa9, 0x3e, 0xb3, 0x7c, 0x4d, 0xaf, 0x3c, 0x17, 0xe6, 0x6b,
+	0x1a, 0x50, 0x71, 0xbe, 0x96, 0x57, 0xc8, 0x55, 0x15, 0x00, 0x59, 0x8c, 0x99, 0x52, 0x01, 0x10,
+	0x50, 0xd3, 0x2a, 0x00, 0x99, 0x1a, 0xc9, 0x08, 0x56, 0x32, 0x85, 0xc2, 0x42, 0x43, 0x4e, 0xc3,
+	0x15, 0x1b, 0x72, 0x61, 0x61, 0x70, 0x39, 0x55, 0xb1, 0xbb, 0x3e, 0x25, 0xf4, 0x99, 0xb6, 0x9b,
+	0xbc, 0x3a, 0x5d, 0x0f, 0xea, 0x7a, 0xf5, 0xaa, 0xf0, 0x48, 0x34, 0xa0, 0xe2, 0x23, 0xc9, 0x29,
+	0x29, 0xb5, 0x16, 0x3e, 0xf8, 0xe9, 0xf7, 0x6e, 0x1a, 0xed, 0x7b, 0x3f, 0xf8, 0x78, 0xdd, 0xf8,
+	0xd1, 0xc7, 0xeb, 0xc6, 0x4f, 0x3e, 0x5e, 0x37, 0x3e, 0xfc, 0x64, 0xfd, 0x99, 0x1f, 0x7d, 0xb2,
+	0xfe, 0xcc, 0x8f, 0x3f, 0x59, 0x7f, 0xe6, 0xbd, 0xd7, 0xb5, 0x52, 0x96, 0xfa, 0x1f, 0xa2, 0x06,
+	0x41, 0x84, 0xb7, 0x8f, 0x53, 0xff, 0x4d, 0xd6, 0x49, 0x88, 0x49, 0x7f, 0x91, 0x97, 0xa0, 0x7e,
+	0xe1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x28, 0x86, 0xd5, 0x39, 0x4a, 0x4b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	InstantTransfer(ctx context.Context, in *MsgInstantTransfer, opts ...grpc.CallOption) (*MsgInstantTransferResponse, error)
+	CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error)
+	ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error)
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
+	CreateBatch(ctx context.Context, in *MsgCreateBatch, opts ...grpc.CallOption) (*MsgCreateBatchResponse, error)
+	SettleBatch(ctx context.Context, in *MsgSettleBatch, opts ...grpc.CallOption) (*MsgSettleBatchResponse, error)
+	OpenChannel(ctx context.Context, in *MsgOpenChannel, opts ...grpc.CallOption) (*MsgOpenChannelResponse, error)
+	CloseChannel(ctx context.Context, in *MsgCloseChannel, opts ...grpc.CallOption) (*MsgCloseChannelResponse, error)
+	ClaimChannel(ctx context.Context, in *MsgClaimChannel, opts ...grpc.CallOption) (*MsgClaimChannelResponse, error)
+	RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error)
+	UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error)
+	InstantCheckout(ctx context.Context, in *MsgInstantCheckout, opts ...grpc.CallOption) (*MsgInstantCheckoutResponse, error)
+	PartialRefund(ctx context.Context, in *MsgPartialRefund, opts ...grpc.CallOption) (*MsgPartialRefundResponse, error)
+	CreateMultiPartyEscrow(ctx context.Context, in *MsgCreateMultiPartyEscrow, opts ...grpc.CallOption) (*MsgCreateMultiPartyEscrowResponse, error)
+	ApproveEscrowResolution(ctx context.Context, in *MsgApproveEscrowResolution, opts ...grpc.CallOption) (*MsgApproveEscrowResolutionResponse, error)
+	CreateMilestoneEscrow(ctx context.Context, in *MsgCreateMilestoneEscrow, opts ...grpc.CallOption) (*MsgCreateMilestoneEscrowResponse, error)
+	ReleaseMilestone(ctx context.Context, in *MsgReleaseMilestone, opts ...grpc.CallOption) (*MsgReleaseMilestoneResponse, error)
+	RefundMilestone(ctx context.Context, in *MsgRefundMilestone, opts ...grpc.CallOption) (*MsgRefundMilestoneResponse, error)
+	CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error)
+	OpenBidirectionalChannel(ctx context.Context, in *MsgOpenBidirectionalChannel, opts ...grpc.CallOption) (*MsgOpenBidirectionalChannelResponse, error)
+	FundBidirectionalChannel(ctx context.Context, in *MsgFundBidirectionalChannel, opts ...grpc.CallOption) (*MsgFundBidirectionalChannelResponse, error)
+	CooperativeCloseChannel(ctx context.Context, in *MsgCooperativeCloseChannel, opts ...grpc.CallOption) (*MsgCooperativeCloseChannelResponse, error)
+	InitiateChannelClose(ctx context.Context, in *MsgInitiateChannelClose, opts ...grpc.CallOption) (*MsgInitiateChannelCloseResponse, error)
+	ChallengeChannelClose(ctx context.Context, in *MsgChallengeChannelClose, opts ...grpc.CallOption) (*MsgChallengeChannelCloseResponse, error)
+	LockHTLC(ctx context.Context, in *MsgLockHTLC, opts ...grpc.CallOption) (*MsgLockHTLCResponse, error)
+	ClaimHTLC(ctx context.Context, in *MsgClaimHTLC, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error)
+	RefundHTLC(ctx context.Context, in *MsgRefundHTLC, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error)
+	SetChannelRoutingFee(ctx context.Context, in *MsgSetChannelRoutingFee, opts ...grpc.CallOption) (*MsgSetChannelRoutingFeeResponse, error)
+	FreezePayout(ctx context.Context, in *MsgFreezePayout, opts ...grpc.CallOption) (*MsgFreezePayoutResponse, error)
+	UnfreezePayout(ctx context.Context, in *MsgUnfreezePayout, opts ...grpc.CallOption) (*MsgUnfreezePayoutResponse, error)
+	CreateNettingCycle(ctx context.Context, in *MsgCreateNettingCycle, opts ...grpc.CallOption) (*MsgCreateNettingCycleResponse, error)
+	SubmitObligation(ctx context.Context, in *MsgSubmitObligation, opts ...grpc.CallOption) (*MsgSubmitObligationResponse, error)
+	SettleNettingCycle(ctx context.Context, in *MsgSettleNettingCycle, opts ...grpc.CallOption) (*MsgSettleNettingCycleResponse, error)
+	SetSplitTemplate(ctx context.Context, in *MsgSetSplitTemplate, opts ...grpc.CallOption) (*MsgSetSplitTemplateResponse, error)
+	CreateMandate(ctx context.Context, in *MsgCreateMandate, opts ...grpc.CallOption) (*MsgCreateMandateResponse, error)
+	RevokeMandate(ctx context.Context, in *MsgRevokeMandate, opts ...grpc.CallOption) (*MsgRevokeMandateResponse, error)
+	PullPayment(ctx context.Context, in *MsgPullPayment, opts ...grpc.CallOption) (*MsgPullPaymentResponse, error)
+	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
+	WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error)
+	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
+	TopUpStream(ctx context.Context, in *MsgTopUpStream, opts ...grpc.CallOption) (*MsgTopUpStreamResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) InstantTransfer(ctx context.Context, in *MsgInstantTransfer, opts ...grpc.CallOption) (*MsgInstantTransferResponse, error) {
+	out := new(MsgInstantTransferResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/InstantTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error) {
+	out := new(MsgCreateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseEscrow(ctx context.Context, in *MsgReleaseEscrow, opts ...grpc.CallOption) (*MsgReleaseEscrowResponse, error) {
+	out := new(MsgReleaseEscrowResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ReleaseEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RefundEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBatch(ctx context.Context, in *MsgCreateBatch, opts ...grpc.CallOption) (*MsgCreateBatchResponse, error) {
+	out := new(MsgCreateBatchResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleBatch(ctx context.Context, in *MsgSettleBatch, opts ...grpc.CallOption) (*MsgSettleBatchResponse, error) {
+	out := new(MsgSettleBatchResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SettleBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenChannel(ctx context.Context, in *MsgOpenChannel, opts ...grpc.CallOption) (*MsgOpenChannelResponse, error) {
+	out := new(MsgOpenChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/OpenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseChannel(ctx context.Context, in *MsgCloseChannel, opts ...grpc.CallOption) (*MsgCloseChannelResponse, error) {
+	out := new(MsgCloseChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CloseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimChannel(ctx context.Context, in *MsgClaimChannel, opts ...grpc.CallOption) (*MsgClaimChannelResponse, error) {
+	out := new(MsgClaimChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ClaimChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterMerchant(ctx context.Context, in *MsgRegisterMerchant, opts ...grpc.CallOption) (*MsgRegisterMerchantResponse, error) {
+	out := new(MsgRegisterMerchantResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RegisterMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMerchant(ctx context.Context, in *MsgUpdateMerchant, opts ...grpc.CallOption) (*MsgUpdateMerchantResponse, error) {
+	out := new(MsgUpdateMerchantResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/UpdateMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InstantCheckout(ctx context.Context, in *MsgInstantCheckout, opts ...grpc.CallOption) (*MsgInstantCheckoutResponse, error) {
+	out := new(MsgInstantCheckoutResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/InstantCheckout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PartialRefund(ctx context.Context, in *MsgPartialRefund, opts ...grpc.CallOption) (*MsgPartialRefundResponse, error) {
+	out := new(MsgPartialRefundResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/PartialRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMultiPartyEscrow(ctx context.Context, in *MsgCreateMultiPartyEscrow, opts ...grpc.CallOption) (*MsgCreateMultiPartyEscrowResponse, error) {
+	out := new(MsgCreateMultiPartyEscrowResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateMultiPartyEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveEscrowResolution(ctx context.Context, in *MsgApproveEscrowResolution, opts ...grpc.CallOption) (*MsgApproveEscrowResolutionResponse, error) {
+	out := new(MsgApproveEscrowResolutionResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ApproveEscrowResolution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMilestoneEscrow(ctx context.Context, in *MsgCreateMilestoneEscrow, opts ...grpc.CallOption) (*MsgCreateMilestoneEscrowResponse, error) {
+	out := new(MsgCreateMilestoneEscrowResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateMilestoneEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseMilestone(ctx context.Context, in *MsgReleaseMilestone, opts ...grpc.CallOption) (*MsgReleaseMilestoneResponse, error) {
+	out := new(MsgReleaseMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ReleaseMilestone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundMilestone(ctx context.Context, in *MsgRefundMilestone, opts ...grpc.CallOption) (*MsgRefundMilestoneResponse, error) {
+	out := new(MsgRefundMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RefundMilestone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSubscription(ctx context.Context, in *MsgCreateSubscription, opts ...grpc.CallOption) (*MsgCreateSubscriptionResponse, error) {
+	out := new(MsgCreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSubscription(ctx context.Context, in *MsgCancelSubscription, opts ...grpc.CallOption) (*MsgCancelSubscriptionResponse, error) {
+	out := new(MsgCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CancelSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenBidirectionalChannel(ctx context.Context, in *MsgOpenBidirectionalChannel, opts ...grpc.CallOption) (*MsgOpenBidirectionalChannelResponse, error) {
+	out := new(MsgOpenBidirectionalChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/OpenBidirectionalChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundBidirectionalChannel(ctx context.Context, in *MsgFundBidirectionalChannel, opts ...grpc.CallOption) (*MsgFundBidirectionalChannelResponse, error) {
+	out := new(MsgFundBidirectionalChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/FundBidirectionalChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CooperativeCloseChannel(ctx context.Context, in *MsgCooperativeCloseChannel, opts ...grpc.CallOption) (*MsgCooperativeCloseChannelResponse, error) {
+	out := new(MsgCooperativeCloseChannelResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CooperativeCloseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateChannelClose(ctx context.Context, in *MsgInitiateChannelClose, opts ...grpc.CallOption) (*MsgInitiateChannelCloseResponse, error) {
+	out := new(MsgInitiateChannelCloseResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/InitiateChannelClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChallengeChannelClose(ctx context.Context, in *MsgChallengeChannelClose, opts ...grpc.CallOption) (*MsgChallengeChannelCloseResponse, error) {
+	out := new(MsgChallengeChannelCloseResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ChallengeChannelClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockHTLC(ctx context.Context, in *MsgLockHTLC, opts ...grpc.CallOption) (*MsgLockHTLCResponse, error) {
+	out := new(MsgLockHTLCResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/LockHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHTLC(ctx context.Context, in *MsgClaimHTLC, opts ...grpc.CallOption) (*MsgClaimHTLCResponse, error) {
+	out := new(MsgClaimHTLCResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/ClaimHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundHTLC(ctx context.Context, in *MsgRefundHTLC, opts ...grpc.CallOption) (*MsgRefundHTLCResponse, error) {
+	out := new(MsgRefundHTLCResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RefundHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelRoutingFee(ctx context.Context, in *MsgSetChannelRoutingFee, opts ...grpc.CallOption) (*MsgSetChannelRoutingFeeResponse, error) {
+	out := new(MsgSetChannelRoutingFeeResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SetChannelRoutingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezePayout(ctx context.Context, in *MsgFreezePayout, opts ...grpc.CallOption) (*MsgFreezePayoutResponse, error) {
+	out := new(MsgFreezePayoutResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/FreezePayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezePayout(ctx context.Context, in *MsgUnfreezePayout, opts ...grpc.CallOption) (*MsgUnfreezePayoutResponse, error) {
+	out := new(MsgUnfreezePayoutResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/UnfreezePayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateNettingCycle(ctx context.Context, in *MsgCreateNettingCycle, opts ...grpc.CallOption) (*MsgCreateNettingCycleResponse, error) {
+	out := new(MsgCreateNettingCycleResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateNettingCycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitObligation(ctx context.Context, in *MsgSubmitObligation, opts ...grpc.CallOption) (*MsgSubmitObligationResponse, error) {
+	out := new(MsgSubmitObligationResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SubmitObligation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleNettingCycle(ctx context.Context, in *MsgSettleNettingCycle, opts ...grpc.CallOption) (*MsgSettleNettingCycleResponse, error) {
+	out := new(MsgSettleNettingCycleResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SettleNettingCycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSplitTemplate(ctx context.Context, in *MsgSetSplitTemplate, opts ...grpc.CallOption) (*MsgSetSplitTemplateResponse, error) {
+	out := new(MsgSetSplitTemplateResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/SetSplitTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMandate(ctx context.Context, in *MsgCreateMandate, opts ...grpc.CallOption) (*MsgCreateMandateResponse, error) {
+	out := new(MsgCreateMandateResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateMandate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeMandate(ctx context.Context, in *MsgRevokeMandate, opts ...grpc.CallOption) (*MsgRevokeMandateResponse, error) {
+	out := new(MsgRevokeMandateResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/RevokeMandate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PullPayment(ctx context.Context, in *MsgPullPayment, opts ...grpc.CallOption) (*MsgPullPaymentResponse, error) {
+	out := new(MsgPullPaymentResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/PullPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
+	out := new(MsgCreateStreamResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CreateStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawStream(ctx context.Context, in *MsgWithdrawStream, opts ...grpc.CallOption) (*MsgWithdrawStreamResponse, error) {
+	out := new(MsgWithdrawStreamResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/WithdrawStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
+	out := new(MsgCancelStreamResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/CancelStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpStream(ctx context.Context, in *MsgTopUpStream, opts ...grpc.CallOption) (*MsgTopUpStreamResponse, error) {
+	out := new(MsgTopUpStreamResponse)
+	err := c.cc.Invoke(ctx, "/stateset.settlement.Msg/TopUpStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	InstantTransfer(context.Context, *MsgInstantTransfer) (*MsgInstantTransferResponse, error)
+	CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error)
+	ReleaseEscrow(context.Context, *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error)
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
+	CreateBatch(context.Context, *MsgCreateBatch) (*MsgCreateBatchResponse, error)
+	SettleBatch(context.Context, *MsgSettleBatch) (*MsgSettleBatchResponse, error)
+	OpenChannel(context.Context, *MsgOpenChannel) (*MsgOpenChannelResponse, error)
+	CloseChannel(context.Context, *MsgCloseChannel) (*MsgCloseChannelResponse, error)
+	ClaimChannel(context.Context, *MsgClaimChannel) (*MsgClaimChannelResponse, error)
+	RegisterMerchant(context.Context, *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error)
+	UpdateMerchant(context.Context, *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error)
+	InstantCheckout(context.Context, *MsgInstantCheckout) (*MsgInstantCheckoutResponse, error)
+	PartialRefund(context.Context, *MsgPartialRefund) (*MsgPartialRefundResponse, error)
+	CreateMultiPartyEscrow(context.Context, *MsgCreateMultiPartyEscrow) (*MsgCreateMultiPartyEscrowResponse, error)
+	ApproveEscrowResolution(context.Context, *MsgApproveEscrowResolution) (*MsgApproveEscrowResolutionResponse, error)
+	CreateMilestoneEscrow(context.Context, *MsgCreateMilestoneEscrow) (*MsgCreateMilestoneEscrowResponse, error)
+	ReleaseMilestone(context.Context, *MsgReleaseMilestone) (*MsgReleaseMilestoneResponse, error)
+	RefundMilestone(context.Context, *MsgRefundMilestone) (*MsgRefundMilestoneResponse, error)
+	CreateSubscription(context.Context, *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error)
+	CancelSubscription(context.Context, *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error)
+	OpenBidirectionalChannel(context.Context, *MsgOpenBidirectionalChannel) (*MsgOpenBidirectionalChannelResponse, error)
+	FundBidirectionalChannel(context.Context, *MsgFundBidirectionalChannel) (*MsgFundBidirectionalChannelResponse, error)
+	CooperativeCloseChannel(context.Context, *MsgCooperativeCloseChannel) (*MsgCooperativeCloseChannelResponse, error)
+	InitiateChannelClose(context.Context, *MsgInitiateChannelClose) (*MsgInitiateChannelCloseResponse, error)
+	ChallengeChannelClose(context.Context, *MsgChallengeChannelClose) (*MsgChallengeChannelCloseResponse, error)
+	LockHTLC(context.Context, *MsgLockHTLC) (*MsgLockHTLCResponse, error)
+	ClaimHTLC(context.Context, *MsgClaimHTLC) (*MsgClaimHTLCResponse, error)
+	RefundHTLC(context.Context, *MsgRefundHTLC) (*MsgRefundHTLCResponse, error)
+	SetChannelRoutingFee(context.Context, *MsgSetChannelRoutingFee) (*MsgSetChannelRoutingFeeResponse, error)
+	FreezePayout(context.Context, *MsgFreezePayout) (*MsgFreezePayoutResponse, error)
+	UnfreezePayout(context.Context, *MsgUnfreezePayout) (*MsgUnfreezePayoutResponse, error)
+	CreateNettingCycle(context.Context, *MsgCreateNettingCycle) (*MsgCreateNettingCycleResponse, error)
+	SubmitObligation(context.Context, *MsgSubmitObligation) (*MsgSubmitObligationResponse, error)
+	SettleNettingCycle(context.Context, *MsgSettleNettingCycle) (*MsgSettleNettingCycleResponse, error)
+	SetSplitTemplate(context.Context, *MsgSetSplitTemplate) (*MsgSetSplitTemplateResponse, error)
+	CreateMandate(context.Context, *MsgCreateMandate) (*MsgCreateMandateResponse, error)
+	RevokeMandate(context.Context, *MsgRevokeMandate) (*MsgRevokeMandateResponse, error)
+	PullPayment(context.Context, *MsgPullPayment) (*MsgPullPaymentResponse, error)
+	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
+	WithdrawStream(context.Context, *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error)
+	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
+	TopUpStream(context.Context, *MsgTopUpStream) (*MsgTopUpStreamResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) InstantTransfer(ctx context.Context, req *MsgInstantTransfer) (*MsgInstantTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CreateEscrow(ctx context.Context, req *MsgCreateEscrow) (*MsgCreateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseEscrow(ctx context.Context, req *MsgReleaseEscrow) (*MsgReleaseEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (*UnimplementedMsgServer) RefundEscrow(ctx context.Context, req *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
+func (*UnimplementedMsgServer) CreateBatch(ctx context.Context, req *MsgCreateBatch) (*MsgCreateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
+}
+func (*UnimplementedMsgServer) SettleBatch(ctx context.Context, req *MsgSettleBatch) (*MsgSettleBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleBatch not implemented")
+}
+func (*UnimplementedMsgServer) OpenChannel(ctx context.Context, req *MsgOpenChannel) (*MsgOpenChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenChannel not implemented")
+}
+func (*UnimplementedMsgServer) CloseChannel(ctx context.Context, req *MsgCloseChannel) (*MsgCloseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseChannel not implemented")
+}
+func (*UnimplementedMsgServer) ClaimChannel(ctx context.Context, req *MsgClaimChannel) (*MsgClaimChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimChannel not implemented")
+}
+func (*UnimplementedMsgServer) RegisterMerchant(ctx context.Context, req *MsgRegisterMerchant) (*MsgRegisterMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMerchant not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMerchant(ctx context.Context, req *MsgUpdateMerchant) (*MsgUpdateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+}
+func (*UnimplementedMsgServer) InstantCheckout(ctx context.Context, req *MsgInstantCheckout) (*MsgInstantCheckoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantCheckout not implemented")
+}
+func (*UnimplementedMsgServer) PartialRefund(ctx context.Context, req *MsgPartialRefund) (*MsgPartialRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialRefund not implemented")
+}
+func (*UnimplementedMsgServer) CreateMultiPartyEscrow(ctx context.Context, req *MsgCreateMultiPartyEscrow) (*MsgCreateMultiPartyEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultiPartyEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ApproveEscrowResolution(ctx context.Context, req *MsgApproveEscrowResolution) (*MsgApproveEscrowResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveEscrowResolution not implemented")
+}
+func (*UnimplementedMsgServer) CreateMilestoneEscrow(ctx context.Context, req *MsgCreateMilestoneEscrow) (*MsgCreateMilestoneEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMilestoneEscrow not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseMilestone(ctx context.Context, req *MsgReleaseMilestone) (*MsgReleaseMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseMilestone not implemented")
+}
+func (*UnimplementedMsgServer) RefundMilestone(ctx context.Context, req *MsgRefundMilestone) (*MsgRefundMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundMilestone not implemented")
+}
+func (*UnimplementedMsgServer) CreateSubscription(ctx context.Context, req *MsgCreateSubscription) (*MsgCreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedMsgServer) CancelSubscription(ctx context.Context, req *MsgCancelSubscription) (*MsgCancelSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (*UnimplementedMsgServer) OpenBidirectionalChannel(ctx context.Context, req *MsgOpenBidirectionalChannel) (*MsgOpenBidirectionalChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenBidirectionalChannel not implemented")
+}
+func (*UnimplementedMsgServer) FundBidirectionalChannel(ctx context.Context, req *MsgFundBidirectionalChannel) (*MsgFundBidirectionalChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundBidirectionalChannel not implemented")
+}
+func (*UnimplementedMsgServer) CooperativeCloseChannel(ctx context.Context, req *MsgCooperativeCloseChannel) (*MsgCooperativeCloseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CooperativeCloseChannel not implemented")
+}
+func (*UnimplementedMsgServer) InitiateChannelClose(ctx context.Context, req *MsgInitiateChannelClose) (*MsgInitiateChannelCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateChannelClose not implemented")
+}
+func (*UnimplementedMsgServer) ChallengeChannelClose(ctx context.Context, req *MsgChallengeChannelClose) (*MsgChallengeChannelCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeChannelClose not implemented")
+}
+func (*UnimplementedMsgServer) LockHTLC(ctx context.Context, req *MsgLockHTLC) (*MsgLockHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockHTLC not implemented")
+}
+func (*UnimplementedMsgServer) ClaimHTLC(ctx context.Context, req *MsgClaimHTLC) (*MsgClaimHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHTLC not implemented")
+}
+func (*UnimplementedMsgServer) RefundHTLC(ctx context.Context, req *MsgRefundHTLC) (*MsgRefundHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundHTLC not implemented")
+}
+func (*UnimplementedMsgServer) SetChannelRoutingFee(ctx context.Context, req *MsgSetChannelRoutingFee) (*MsgSetChannelRoutingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelRoutingFee not implemented")
+}
+func (*UnimplementedMsgServer) FreezePayout(ctx context.Context, req *MsgFreezePayout) (*MsgFreezePayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezePayout not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezePayout(ctx context.Context, req *MsgUnfreezePayout) (*MsgUnfreezePayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezePayout not implemented")
+}
+func (*UnimplementedMsgServer) CreateNettingCycle(ctx context.Context, req *MsgCreateNettingCycle) (*MsgCreateNettingCycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNettingCycle not implemented")
+}
+func (*UnimplementedMsgServer) SubmitObligation(ctx context.Context, req *MsgSubmitObligation) (*MsgSubmitObligationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitObligation not implemented")
+}
+func (*UnimplementedMsgServer) SettleNettingCycle(ctx context.Context, req *MsgSettleNettingCycle) (*MsgSettleNettingCycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleNettingCycle not implemented")
+}
+func (*UnimplementedMsgServer) SetSplitTemplate(ctx context.Context, req *MsgSetSplitTemplate) (*MsgSetSplitTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTemplate not implemented")
+}
+func (*UnimplementedMsgServer) CreateMandate(ctx context.Context, req *MsgCreateMandate) (*MsgCreateMandateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMandate not implemented")
+}
+func (*UnimplementedMsgServer) RevokeMandate(ctx context.Context, req *MsgRevokeMandate) (*MsgRevokeMandateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMandate not implemented")
+}
+func (*UnimplementedMsgServer) PullPayment(ctx context.Context, req *MsgPullPayment) (*MsgPullPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullPayment not implemented")
+}
+func (*UnimplementedMsgServer) CreateStream(ctx context.Context, req *MsgCreateStream) (*MsgCreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawStream(ctx context.Context, req *MsgWithdrawStream) (*MsgWithdrawStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelStream(ctx context.Context, req *MsgCancelStream) (*MsgCancelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+}
+func (*UnimplementedMsgServer) TopUpStream(ctx context.Context, req *MsgTopUpStream) (*MsgTopUpStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpStream not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_InstantTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/InstantTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantTransfer(ctx, req.(*MsgInstantTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEscrow(ctx, req.(*MsgCreateEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ReleaseEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseEscrow(ctx, req.(*MsgReleaseEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/RefundEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBatch(ctx, req.(*MsgCreateBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/SettleBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleBatch(ctx, req.(*MsgSettleBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/OpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenChannel(ctx, req.(*MsgOpenChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CloseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseChannel(ctx, req.(*MsgCloseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ClaimChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimChannel(ctx, req.(*MsgClaimChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMerchant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/RegisterMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMerchant(ctx, req.(*MsgRegisterMerchant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMerchant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/UpdateMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMerchant(ctx, req.(*MsgUpdateMerchant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InstantCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantCheckout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/InstantCheckout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantCheckout(ctx, req.(*MsgInstantCheckout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PartialRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartialRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/PartialRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialRefund(ctx, req.(*MsgPartialRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMultiPartyEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMultiPartyEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMultiPartyEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateMultiPartyEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMultiPartyEscrow(ctx, req.(*MsgCreateMultiPartyEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveEscrowResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveEscrowResolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveEscrowResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ApproveEscrowResolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveEscrowResolution(ctx, req.(*MsgApproveEscrowResolution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMilestoneEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMilestoneEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMilestoneEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateMilestoneEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMilestoneEscrow(ctx, req.(*MsgCreateMilestoneEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ReleaseMilestone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseMilestone(ctx, req.(*MsgReleaseMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/RefundMilestone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundMilestone(ctx, req.(*MsgRefundMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubscription(ctx, req.(*MsgCreateSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CancelSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSubscription(ctx, req.(*MsgCancelSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenBidirectionalChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenBidirectionalChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenBidirectionalChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/OpenBidirectionalChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenBidirectionalChannel(ctx, req.(*MsgOpenBidirectionalChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundBidirectionalChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundBidirectionalChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundBidirectionalChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/FundBidirectionalChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundBidirectionalChannel(ctx, req.(*MsgFundBidirectionalChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CooperativeCloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCooperativeCloseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CooperativeCloseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CooperativeCloseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CooperativeCloseChannel(ctx, req.(*MsgCooperativeCloseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateChannelClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateChannelClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateChannelClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/InitiateChannelClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateChannelClose(ctx, req.(*MsgInitiateChannelClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChallengeChannelClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeChannelClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeChannelClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ChallengeChannelClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeChannelClose(ctx, req.(*MsgChallengeChannelClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/LockHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockHTLC(ctx, req.(*MsgLockHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/ClaimHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHTLC(ctx, req.(*MsgClaimHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundHTLC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/RefundHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundHTLC(ctx, req.(*MsgRefundHTLC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelRoutingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelRoutingFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelRoutingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/SetChannelRoutingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelRoutingFee(ctx, req.(*MsgSetChannelRoutingFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezePayout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/FreezePayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezePayout(ctx, req.(*MsgFreezePayout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezePayout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/UnfreezePayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezePayout(ctx, req.(*MsgUnfreezePayout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateNettingCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNettingCycle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNettingCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateNettingCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNettingCycle(ctx, req.(*MsgCreateNettingCycle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitObligation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitObligation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitObligation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/SubmitObligation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitObligation(ctx, req.(*MsgSubmitObligation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleNettingCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleNettingCycle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleNettingCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/SettleNettingCycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleNettingCycle(ctx, req.(*MsgSettleNettingCycle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSplitTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSplitTemplate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSplitTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/SetSplitTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSplitTemplate(ctx, req.(*MsgSetSplitTemplate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMandate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateMandate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMandate(ctx, req.(*MsgCreateMandate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeMandate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/RevokeMandate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeMandate(ctx, req.(*MsgRevokeMandate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PullPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPullPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PullPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/PullPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PullPayment(ctx, req.(*MsgPullPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CreateStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/WithdrawStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawStream(ctx, req.(*MsgWithdrawStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/CancelStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stateset.settlement.Msg/TopUpStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpStream(ctx, req.(*MsgTopUpStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stateset.settlement.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InstantTransfer",
+			Handler:    _Msg_InstantTransfer_Handler,
+		},
+		{
+			MethodName: "CreateEscrow",
+			Handler:    _Msg_CreateEscrow_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _Msg_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
+		{
+			MethodName: "CreateBatch",
+			Handler:    _Msg_CreateBatch_Handler,
+		},
+		{
+			MethodName: "SettleBatch",
+			Handler:    _Msg_SettleBatch_Handler,
+		},
+		{
+			MethodName: "OpenChannel",
+			Handler:    _Msg_OpenChannel_Handler,
+		},
+		{
+			MethodName: "CloseChannel",
+			Handler:    _Msg_CloseChannel_Handler,
+		},
+		{
+			MethodName: "ClaimChannel",
+			Handler:    _Msg_ClaimChannel_Handler,
+		},
+		{
+			MethodName: "RegisterMerchant",
+			Handler:    _Msg_RegisterMerchant_Handler,
+		},
+		{
+			MethodName: "UpdateMerchant",
+			Handler:    _Msg_UpdateMerchant_Handler,
+		},
+		{
+			MethodName: "InstantCheckout",
+			Handler:    _Msg_InstantCheckout_Handler,
+		},
+		{
+			MethodName: "PartialRefund",
+			Handler:    _Msg_PartialRefund_Handler,
+		},
+		{
+			MethodName: "CreateMultiPartyEscrow",
+			Handler:    _Msg_CreateMultiPartyEscrow_Handler,
+		},
+		{
+			MethodName: "ApproveEscrowResolution",
+			Handler:    _Msg_ApproveEscrowResolution_Handler,
+		},
+		{
+			MethodName: "CreateMilestoneEscrow",
+			Handler:    _Msg_CreateMilestoneEscrow_Handler,
+		},
+		{
+			MethodName: "ReleaseMilestone",
+			Handler:    _Msg_ReleaseMilestone_Handler,
+		},
+		{
+			MethodName: "RefundMilestone",
+			Handler:    _Msg_RefundMilestone_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Msg_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _Msg_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "OpenBidirectionalChannel",
+			Handler:    _Msg_OpenBidirectionalChannel_Handler,
+		},
+		{
+			MethodName: "FundBidirectionalChannel",
+			Handler:    _Msg_FundBidirectionalChannel_Handler,
+		},
+		{
+			MethodName: "CooperativeCloseChannel",
+			Handler:    _Msg_CooperativeCloseChannel_Handler,
+		},
+		{
+			MethodName: "InitiateChannelClose",
+			Handler:    _Msg_InitiateChannelClose_Handler,
+		},
+		{
+			MethodName: "ChallengeChannelClose",
+			Handler:    _Msg_ChallengeChannelClose_Handler,
+		},
+		{
+			MethodName: "LockHTLC",
+			Handler:    _Msg_LockHTLC_Handler,
+		},
+		{
+			MethodName: "ClaimHTLC",
+			Handler:    _Msg_ClaimHTLC_Handler,
+		},
+		{
+			MethodName: "RefundHTLC",
+			Handler:    _Msg_RefundHTLC_Handler,
+		},
+		{
+			MethodName: "SetChannelRoutingFee",
+			Handler:    _Msg_SetChannelRoutingFee_Handler,
+		},
+		{
+			MethodName: "FreezePayout",
+			Handler:    _Msg_FreezePayout_Handler,
+		},
+		{
+			MethodName: "UnfreezePayout",
+			Handler:    _Msg_UnfreezePayout_Handler,
+		},
+		{
+			MethodName: "CreateNettingCycle",
+			Handler:    _Msg_CreateNettingCycle_Handler,
+		},
+		{
+			MethodName: "SubmitObligation",
+			Handler:    _Msg_SubmitObligation_Handler,
+		},
+		{
+			MethodName: "SettleNettingCycle",
+			Handler:    _Msg_SettleNettingCycle_Handler,
+		},
+		{
+			MethodName: "SetSplitTemplate",
+			Handler:    _Msg_SetSplitTemplate_Handler,
+		},
+		{
+			MethodName: "CreateMandate",
+			Handler:    _Msg_CreateMandate_Handler,
+		},
+		{
+			MethodName: "RevokeMandate",
+			Handler:    _Msg_RevokeMandate_Handler,
+		},
+		{
+			MethodName: "PullPayment",
+			Handler:    _Msg_PullPayment_Handler,
+		},
+		{
+			MethodName: "CreateStream",
+			Handler:    _Msg_CreateStream_Handler,
+		},
+		{
+			MethodName: "WithdrawStream",
+			Handler:    _Msg_WithdrawStream_Handler,
+		},
+		{
+			MethodName: "CancelStream",
+			Handler:    _Msg_CancelStream_Handler,
+		},
+		{
+			MethodName: "TopUpStream",
+			Handler:    _Msg_TopUpStream_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stateset/settlement/tx.proto",
+}
+
+func (m *MsgInstantTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSlippageBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSlippageBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSlippageBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSlippageBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiresIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.References[iNdEx])
+			copy(dAtA[i:], m.References[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.References[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amounts[iNdEx].Size()
+				i -= size
+				if _, err := m.Amounts[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementIds) > 0 {
+		dAtA6 := make([]byte, len(m.SettlementIds)*10)
+		var j5 int
+		for _, num := range m.SettlementIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresInBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresInBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Closer) > 0 {
+		i -= len(m.Closer)
+		copy(dAtA[i:], m.Closer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Closer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FinalBalance.Size()
+		i -= size
+		if _, err := m.FinalBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingBalance.Size()
+		i -= size
+		if _, err := m.RemainingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AmountClaimed.Size()
+		i -= size
+		if _, err := m.AmountClaimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementDenom) > 0 {
+		i -= len(m.SettlementDenom)
+		copy(dAtA[i:], m.SettlementDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SettlementDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BatchMaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BatchMaxAge):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x5a
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SettlementDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTx(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x52
+	if len(m.WebhookUrl) > 0 {
+		i -= len(m.WebhookUrl)
+		copy(dAtA[i:], m.WebhookUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WebhookUrl)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.BatchThreshold.Size()
+		i -= size
+		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BatchEnabled {
+		i--
+		if m.BatchEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxSettlement.Size()
+		i -= size
+		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinSettlement.Size()
+		i -= size
+		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FeeRateBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRateBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMerchant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMerchant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMerchant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettlementDenom) > 0 {
+		i -= len(m.SettlementDenom)
+		copy(dAtA[i:], m.SettlementDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SettlementDenom)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.BatchMaxAge != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.BatchMaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.BatchMaxAge):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintTx(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SettlementDelay != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.SettlementDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.SettlementDelay):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintTx(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.WebhookUrl) > 0 {
+		i -= len(m.WebhookUrl)
+		copy(dAtA[i:], m.WebhookUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WebhookUrl)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IsActive != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.IsActive, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.IsActive):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintTx(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.BatchThreshold.Size()
+		i -= size
+		if _, err := m.BatchThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BatchEnabled != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdBoolMarshalTo(*m.BatchEnabled, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.BatchEnabled):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintTx(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MaxSettlement.Size()
+		i -= size
+		if _, err := m.MaxSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinSettlement.Size()
+		i -= size
+		if _, err := m.MinSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.FeeRateBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeRateBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMerchantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMerchantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMerchantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantCheckout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantCheckout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantCheckout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SplitName) > 0 {
+		i -= len(m.SplitName)
+		copy(dAtA[i:], m.SplitName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SplitName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxSlippageBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSlippageBps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UseEscrow {
+		i--
+		if m.UseEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OrderReference) > 0 {
+		i -= len(m.OrderReference)
+		copy(dAtA[i:], m.OrderReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantCheckoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantCheckoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantCheckoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransactionId) > 0 {
+		i -= len(m.TransactionId)
+		copy(dAtA[i:], m.TransactionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransactionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.RefundAmount.Size()
+		i -= size
+		if _, err := m.RefundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RefundedAmount.Size()
+		i -= size
+		if _, err := m.RefundedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultiPartyEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultiPartyEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultiPartyEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiresIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintTx(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arbiters[iNdEx])
+			copy(dAtA[i:], m.Arbiters[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Arbiters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMultiPartyEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMultiPartyEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMultiPartyEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintTx(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x12
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveEscrowResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveEscrowResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveEscrowResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RecipientAmount.Size()
+		i -= size
+		if _, err := m.RecipientAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveEscrowResolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveEscrowResolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveEscrowResolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolved {
+		i--
+		if m.Resolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Approvals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MilestoneInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MilestoneInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MilestoneInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n37, err37 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err37 != nil {
+		return 0, err37
+	}
+	i -= n37
+	i = encodeVarintTx(dAtA, i, uint64(n37))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMilestoneEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMilestoneEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMilestoneEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n39, err39 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiresIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn):])
+	if err39 != nil {
+		return 0, err39
+	}
+	i -= n39
+	i = encodeVarintTx(dAtA, i, uint64(n39))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMilestoneEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMilestoneEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMilestoneEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n40, err40 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err40 != nil {
+		return 0, err40
+	}
+	i -= n40
+	i = encodeVarintTx(dAtA, i, uint64(n40))
+	i--
+	dAtA[i] = 0x12
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Milestone != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetAmount.Size()
+		i -= size
+		if _, err := m.NetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Milestone != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n42, err42 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintTx(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxCycles != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCycles))
+		i--
+		dAtA[i] = 0x28
+	}
+	n43, err43 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintTx(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n45, err45 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextDue, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDue):])
+	if err45 != nil {
+		return 0, err45
+	}
+	i -= n45
+	i = encodeVarintTx(dAtA, i, uint64(n45))
+	i--
+	dAtA[i] = 0x12
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenBidirectionalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenBidirectionalChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenBidirectionalChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PartyB) > 0 {
+		i -= len(m.PartyB)
+		copy(dAtA[i:], m.PartyB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartyB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartyA) > 0 {
+		i -= len(m.PartyA)
+		copy(dAtA[i:], m.PartyA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartyA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenBidirectionalChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenBidirectionalChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenBidirectionalChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundBidirectionalChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundBidirectionalChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundBidirectionalChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PartyB) > 0 {
+		i -= len(m.PartyB)
+		copy(dAtA[i:], m.PartyB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartyB)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundBidirectionalChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundBidirectionalChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundBidirectionalChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCooperativeCloseChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCooperativeCloseChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCooperativeCloseChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCooperativeCloseChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCooperativeCloseChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCooperativeCloseChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateChannelClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateChannelClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateChannelClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateChannelCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateChannelCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateChannelCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeEndsHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeEndsHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeChannelClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeChannelClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeChannelClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeChannelCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeChannelCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeChannelCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockHTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockHTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockHTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncomingHtlcId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IncomingHtlcId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HtlcId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HtlcId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HtlcId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HtlcId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundHTLC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundHTLC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundHTLC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HtlcId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HtlcId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundHTLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundHTLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundHTLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelRoutingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelRoutingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelRoutingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoutingFeeBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoutingFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelRoutingFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelRoutingFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelRoutingFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezePayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezePayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezePayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezePayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezePayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezePayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n52, err52 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt):])
+	if err52 != nil {
+		return 0, err52
+	}
+	i -= n52
+	i = encodeVarintTx(dAtA, i, uint64(n52))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNettingCycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNettingCycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNettingCycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n53, err53 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err53 != nil {
+		return 0, err53
+	}
+	i -= n53
+	i = encodeVarintTx(dAtA, i, uint64(n53))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNettingCycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNettingCycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNettingCycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n54, err54 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosesAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosesAt):])
+	if err54 != nil {
+		return 0, err54
+	}
+	i -= n54
+	i = encodeVarintTx(dAtA, i, uint64(n54))
+	i--
+	dAtA[i] = 0x12
+	if m.CycleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CycleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitObligation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitObligation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitObligation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Creditor) > 0 {
+		i -= len(m.Creditor)
+		copy(dAtA[i:], m.Creditor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creditor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CycleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CycleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Debtor) > 0 {
+		i -= len(m.Debtor)
+		copy(dAtA[i:], m.Debtor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Debtor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitObligationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitObligationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitObligationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObligationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ObligationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleNettingCycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleNettingCycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleNettingCycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CycleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CycleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleNettingCycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleNettingCycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleNettingCycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSplitTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSplitTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSplitTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSplitTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSplitTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSplitTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMandate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMandate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMandate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n56, err56 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiresIn, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn):])
+	if err56 != nil {
+		return 0, err56
+	}
+	i -= n56
+	i = encodeVarintTx(dAtA, i, uint64(n56))
+	i--
+	dAtA[i] = 0x32
+	n57, err57 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err57 != nil {
+		return 0, err57
+	}
+	i -= n57
+	i = encodeVarintTx(dAtA, i, uint64(n57))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PeriodCap.Size()
+		i -= size
+		if _, err := m.PeriodCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PerChargeMax.Size()
+		i -= size
+		if _, err := m.PerChargeMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Customer) > 0 {
+		i -= len(m.Customer)
+		copy(dAtA[i:], m.Customer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Customer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMandateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMandateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMandateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MandateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MandateId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMandate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMandate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMandate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MandateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MandateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeMandateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeMandateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeMandateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPullPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPullPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPullPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MandateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MandateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPullPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPullPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPullPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PeriodRemaining.Size()
+		i -= size
+		if _, err := m.PeriodRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SettlementId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RatePerSecond.Size()
+		i -= size
+		if _, err := m.RatePerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PayerRefund.Size()
+		i -= size
+		if _, err := m.PayerRefund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RecipientAmount.Size()
+		i -= size
+		if _, err := m.RecipientAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n67, err67 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FundedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FundedUntil):])
+	if err67 != nil {
+		return 0, err67
+	}
+	i -= n67
+	i = encodeVarintTx(dAtA, i, uint64(n67))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgInstantTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSlippageBps != 0 {
+		n += 1 + sovTx(uint64(m.MaxSlippageBps))
+	}
+	return n
+}
+
+func (m *MsgInstantTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxSlippageBps != 0 {
+		n += 1 + sovTx(uint64(m.MaxSlippageBps))
+	}
+	return n
+}
+
+func (m *MsgCreateEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReleaseEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *MsgReleaseEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.References) > 0 {
+		for _, s := range m.References {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchId != 0 {
+		n += 1 + sovTx(uint64(m.BatchId))
+	}
+	if len(m.SettlementIds) > 0 {
+		l = 0
+		for _, e := range m.SettlementIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSettleBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BatchId != 0 {
+		n += 1 + sovTx(uint64(m.BatchId))
+	}
+	return n
+}
+
+func (m *MsgSettleBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TotalFees.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOpenChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiresInBlocks != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresInBlocks))
+	}
+	return n
+}
+
+func (m *MsgOpenChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgCloseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Closer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	return n
+}
+
+func (m *MsgCloseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterMerchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRateBps != 0 {
+		n += 1 + sovTx(uint64(m.FeeRateBps))
+	}
+	l = m.MinSettlement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSettlement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BatchEnabled {
+		n += 2
+	}
+	l = m.BatchThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.WebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SettlementDelay)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BatchMaxAge)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SettlementDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMerchant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeRateBps != 0 {
+		n += 1 + sovTx(uint64(m.FeeRateBps))
+	}
+	l = m.MinSettlement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSettlement.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BatchEnabled != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.BatchEnabled)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BatchThreshold.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.IsActive != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdBool(*m.IsActive)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WebhookUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementDelay != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.SettlementDelay)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BatchMaxAge != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.BatchMaxAge)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SettlementDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMerchantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInstantCheckout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.OrderReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UseEscrow {
+		n += 2
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSlippageBps != 0 {
+		n += 1 + sovTx(uint64(m.MaxSlippageBps))
+	}
+	l = len(m.SplitName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantCheckoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TransactionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPartialRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPartialRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMultiPartyEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Arbiters) > 0 {
+		for _, s := range m.Arbiters {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMultiPartyEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgApproveEscrowResolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RecipientAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgApproveEscrowResolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Approvals != 0 {
+		n += 1 + sovTx(uint64(m.Approvals))
+	}
+	if m.Resolved {
+		n += 2
+	}
+	return n
+}
+
+func (m *MilestoneInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMilestoneEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateMilestoneEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReleaseMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	if m.Milestone != 0 {
+		n += 1 + sovTx(uint64(m.Milestone))
+	}
+	return n
+}
+
+func (m *MsgReleaseMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefundMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	if m.Milestone != 0 {
+		n += 1 + sovTx(uint64(m.Milestone))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxCycles != 0 {
+		n += 1 + sovTx(uint64(m.MaxCycles))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDue)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenBidirectionalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartyA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ChallengePeriod != 0 {
+		n += 1 + sovTx(uint64(m.ChallengePeriod))
+	}
+	return n
+}
+
+func (m *MsgOpenBidirectionalChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	return n
+}
+
+func (m *MsgFundBidirectionalChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartyB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundBidirectionalChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCooperativeCloseChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCooperativeCloseChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateChannelClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInitiateChannelCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChallengeEndsHeight != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeEndsHeight))
+	}
+	return n
+}
+
+func (m *MsgChallengeChannelClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChallengeChannelCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLockHTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutBlocks))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	if m.IncomingHtlcId != 0 {
+		n += 1 + sovTx(uint64(m.IncomingHtlcId))
+	}
+	return n
+}
+
+func (m *MsgLockHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HtlcId != 0 {
+		n += 1 + sovTx(uint64(m.HtlcId))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutHeight))
+	}
+	return n
+}
+
+func (m *MsgClaimHTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HtlcId != 0 {
+		n += 1 + sovTx(uint64(m.HtlcId))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundHTLC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HtlcId != 0 {
+		n += 1 + sovTx(uint64(m.HtlcId))
+	}
+	return n
+}
+
+func (m *MsgRefundHTLCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetChannelRoutingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovTx(uint64(m.ChannelId))
+	}
+	if m.RoutingFeeBps != 0 {
+		n += 1 + sovTx(uint64(m.RoutingFeeBps))
+	}
+	return n
+}
+
+func (m *MsgSetChannelRoutingFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezePayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	return n
+}
+
+func (m *MsgUnfreezePayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNettingCycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNettingCycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CycleId != 0 {
+		n += 1 + sovTx(uint64(m.CycleId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosesAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitObligation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Debtor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CycleId != 0 {
+		n += 1 + sovTx(uint64(m.CycleId))
+	}
+	l = len(m.Creditor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitObligationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObligationId != 0 {
+		n += 1 + sovTx(uint64(m.ObligationId))
+	}
+	return n
+}
+
+func (m *MsgSettleNettingCycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CycleId != 0 {
+		n += 1 + sovTx(uint64(m.CycleId))
+	}
+	return n
+}
+
+func (m *MsgSettleNettingCycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSplitTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSplitTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMandate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Customer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PerChargeMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PeriodCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiresIn)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateMandateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MandateId != 0 {
+		n += 1 + sovTx(uint64(m.MandateId))
+	}
+	return n
+}
+
+func (m *MsgRevokeMandate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MandateId != 0 {
+		n += 1 + sovTx(uint64(m.MandateId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeMandateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPullPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MandateId != 0 {
+		n += 1 + sovTx(uint64(m.MandateId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPullPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettlementId != 0 {
+		n += 1 + sovTx(uint64(m.SettlementId))
+	}
+	l = m.PeriodRemaining.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RatePerSecond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecipientAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PayerRefund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.SettlementId != 0 {
-		n += 1 + sovTx(uint64(m.SettlementId))
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FundedUntil)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageBps", wireType)
+			}
+			m.MaxSlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlippageBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiresIn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageBps", wireType)
+			}
+			m.MaxSlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlippageBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SettlementIds = append(m.SettlementIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SettlementIds) == 0 {
+					m.SettlementIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SettlementIds = append(m.SettlementIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresInBlocks", wireType)
+			}
+			m.ExpiresInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresInBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Closer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterMerchant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
+			}
+			m.FeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SettlementDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BatchMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMerchant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
+			}
+			m.FeeRateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRateBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchEnabled == nil {
+				m.BatchEnabled = new(bool)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.BatchEnabled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IsActive == nil {
+				m.IsActive = new(bool)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.IsActive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SettlementDelay == nil {
+				m.SettlementDelay = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.SettlementDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchMaxAge == nil {
+				m.BatchMaxAge = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.BatchMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMerchantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMerchantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantCheckout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantCheckout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Customer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseEscrow = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, CheckoutItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippageBps", wireType)
+			}
+			m.MaxSlippageBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlippageBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SplitName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantCheckoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantCheckoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultiPartyEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultiPartyEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultiPartyEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiresIn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMultiPartyEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMultiPartyEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMultiPartyEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveEscrowResolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveEscrowResolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveEscrowResolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = EscrowResolution(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecipientAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveEscrowResolutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveEscrowResolutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveEscrowResolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MilestoneInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MilestoneInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MilestoneInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMilestoneEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMilestoneEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMilestoneEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, MilestoneInput{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiresIn, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMilestoneEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMilestoneEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMilestoneEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseMilestoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseMilestoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundMilestoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundMilestoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCycles", wireType)
+			}
+			m.MaxCycles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCycles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextDue, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenBidirectionalChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenBidirectionalChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenBidirectionalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePeriod", wireType)
+			}
+			m.ChallengePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenBidirectionalChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenBidirectionalChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenBidirectionalChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundBidirectionalChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundBidirectionalChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundBidirectionalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundBidirectionalChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundBidirectionalChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundBidirectionalChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCooperativeCloseChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCooperativeCloseChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCooperativeCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCooperativeCloseChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCooperativeCloseChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCooperativeCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateChannelClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateChannelClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateChannelClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.RefundAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgInitiateChannelCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateChannelCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateChannelCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeEndsHeight", wireType)
+			}
+			m.ChallengeEndsHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeEndsHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgChallengeChannelClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeChannelClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeChannelClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgPartialRefundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RefundedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RemainingAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
+func (m *MsgChallengeChannelCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChallengeChannelCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChallengeChannelCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgLockHTLC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3976,10 +19115,10 @@ func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockHTLC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockHTLC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4081,7 +19220,7 @@ func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4109,13 +19248,13 @@ func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
+			m.HashLock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4125,24 +19264,49 @@ func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingHtlcId", wireType)
+			}
+			m.IncomingHtlcId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncomingHtlcId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4164,7 +19328,7 @@ func (m *MsgInstantTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockHTLCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,17 +19351,17 @@ func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockHTLCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HtlcId", wireType)
 			}
-			m.SettlementId = 0
+			m.HtlcId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4207,16 +19371,16 @@ func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.HtlcId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4226,24 +19390,11 @@ func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimeoutHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4265,7 +19416,7 @@ func (m *MsgInstantTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHTLC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4288,45 +19439,13 @@ func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHTLC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHTLC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
@@ -4358,76 +19477,11 @@ func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HtlcId", wireType)
 			}
-			var stringLen uint64
+			m.HtlcId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4437,29 +19491,16 @@ func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HtlcId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4469,24 +19510,23 @@ func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiresIn, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Preimage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4509,7 +19549,7 @@ func (m *MsgCreateEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHTLCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4532,64 +19572,12 @@ func (m *MsgCreateEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHTLCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
-			}
-			m.SettlementId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4611,7 +19599,7 @@ func (m *MsgCreateEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundHTLC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4634,10 +19622,10 @@ func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReleaseEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundHTLC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReleaseEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundHTLC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4674,9 +19662,9 @@ func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HtlcId", wireType)
 			}
-			m.SettlementId = 0
+			m.HtlcId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4686,7 +19674,7 @@ func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.HtlcId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4712,7 +19700,7 @@ func (m *MsgReleaseEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReleaseEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundHTLCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4735,10 +19723,10 @@ func (m *MsgReleaseEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReleaseEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundHTLCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReleaseEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundHTLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4762,7 +19750,7 @@ func (m *MsgReleaseEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChannelRoutingFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4785,15 +19773,15 @@ func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChannelRoutingFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChannelRoutingFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4821,13 +19809,13 @@ func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.SettlementId = 0
+			m.ChannelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4837,16 +19825,16 @@ func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.ChannelId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingFeeBps", wireType)
 			}
-			var stringLen uint64
+			m.RoutingFeeBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4856,24 +19844,11 @@ func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RoutingFeeBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4895,7 +19870,7 @@ func (m *MsgRefundEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChannelRoutingFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4918,10 +19893,10 @@ func (m *MsgRefundEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChannelRoutingFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChannelRoutingFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4945,7 +19920,7 @@ func (m *MsgRefundEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezePayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4968,10 +19943,10 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezePayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezePayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5007,10 +19982,10 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
 			}
-			var stringLen uint64
+			m.SettlementId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5020,27 +19995,14 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SettlementId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5068,13 +20030,113 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezePayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezePayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezePayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezePayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezePayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezePayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5084,31 +20146,29 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
 			}
-			var stringLen uint64
+			m.SettlementId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5118,24 +20178,11 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SettlementId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5157,7 +20204,7 @@ func (m *MsgCreateBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezePayoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5180,17 +20227,17 @@ func (m *MsgCreateBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezePayoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezePayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAt", wireType)
 			}
-			m.BatchId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5200,87 +20247,25 @@ func (m *MsgCreateBatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SettlementIds = append(m.SettlementIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SettlementIds) == 0 {
-					m.SettlementIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SettlementIds = append(m.SettlementIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementIds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5302,7 +20287,7 @@ func (m *MsgCreateBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNettingCycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5325,15 +20310,15 @@ func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSettleBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNettingCycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSettleBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNettingCycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5361,13 +20346,13 @@ func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			m.BatchId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5377,11 +20362,89 @@ func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5403,7 +20466,7 @@ func (m *MsgSettleBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNettingCycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5426,50 +20489,17 @@ func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSettleBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNettingCycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSettleBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNettingCycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CycleId", wireType)
 			}
-			var msglen int
+			m.CycleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5479,28 +20509,14 @@ func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CycleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosesAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5527,7 +20543,7 @@ func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosesAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5552,7 +20568,7 @@ func (m *MsgSettleBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitObligation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5575,15 +20591,15 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitObligation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitObligation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5611,11 +20627,30 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Debtor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CycleId", wireType)
+			}
+			m.CycleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CycleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creditor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5643,11 +20678,11 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Creditor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5674,15 +20709,15 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresInBlocks", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			m.ExpiresInBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5692,11 +20727,24 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresInBlocks |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5718,7 +20766,7 @@ func (m *MsgOpenChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitObligationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5741,36 +20789,17 @@ func (m *MsgOpenChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitObligationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitObligationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			m.ChannelId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObligationId", wireType)
 			}
-			m.ExpiresAtHeight = 0
+			m.ObligationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5780,7 +20809,7 @@ func (m *MsgOpenChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				m.ObligationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5806,7 +20835,7 @@ func (m *MsgOpenChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleNettingCycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5829,15 +20858,15 @@ func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleNettingCycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleNettingCycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Closer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5865,13 +20894,13 @@ func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Closer = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CycleId", wireType)
 			}
-			m.ChannelId = 0
+			m.CycleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5881,7 +20910,7 @@ func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelId |= uint64(b&0x7F) << shift
+				m.CycleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5907,7 +20936,7 @@ func (m *MsgCloseChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleNettingCycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5930,15 +20959,15 @@ func (m *MsgCloseChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleNettingCycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleNettingCycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5965,7 +20994,8 @@ func (m *MsgCloseChannelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, NetTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5990,7 +21020,7 @@ func (m *MsgCloseChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSplitTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6013,15 +21043,15 @@ func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSplitTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSplitTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6049,30 +21079,11 @@ func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			m.ChannelId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6099,32 +21110,14 @@ func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, SplitRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6152,7 +21145,7 @@ func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6175,7 +21168,7 @@ func (m *MsgClaimChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSplitTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6198,78 +21191,12 @@ func (m *MsgClaimChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSplitTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSplitTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountClaimed", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AmountClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBalance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RemainingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6291,7 +21218,7 @@ func (m *MsgClaimChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMandate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6314,15 +21241,15 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterMerchant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMandate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMandate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6350,7 +21277,7 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Customer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6386,9 +21313,9 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerChargeMax", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6398,46 +21325,28 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.PerChargeMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
-			}
-			m.FeeRateBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeRateBps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6464,13 +21373,13 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6497,33 +21406,13 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BatchEnabled = bool(v != 0)
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6550,13 +21439,13 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiresIn, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6584,7 +21473,7 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			m.Reference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6607,7 +21496,7 @@ func (m *MsgRegisterMerchant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterMerchantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateMandateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6630,12 +21519,31 @@ func (m *MsgRegisterMerchantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterMerchantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateMandateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateMandateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MandateId", wireType)
+			}
+			m.MandateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MandateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6657,7 +21565,7 @@ func (m *MsgRegisterMerchantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeMandate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6680,15 +21588,15 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMerchant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeMandate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMerchant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeMandate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6716,13 +21624,13 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MandateId", wireType)
 			}
-			var stringLen uint64
+			m.MandateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6732,27 +21640,14 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MandateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6780,65 +21675,113 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateBps", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FeeRateBps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FeeRateBps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSettlement", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeMandateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeMandateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeMandateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPullPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPullPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPullPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6848,30 +21791,29 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchEnabled", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MandateId", wireType)
 			}
-			var msglen int
+			m.MandateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6881,31 +21823,14 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MandateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BatchEnabled == nil {
-				m.BatchEnabled = new(bool)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.BatchEnabled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6932,15 +21857,15 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BatchThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6950,31 +21875,27 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IsActive == nil {
-				m.IsActive = new(bool)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdBoolUnmarshal(m.IsActive, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7002,7 +21923,7 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookUrl = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7025,7 +21946,7 @@ func (m *MsgUpdateMerchant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMerchantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPullPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7048,12 +21969,64 @@ func (m *MsgUpdateMerchantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMerchantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPullPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMerchantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPullPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+			}
+			m.SettlementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7075,7 +22048,7 @@ func (m *MsgUpdateMerchantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7098,15 +22071,15 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantCheckout: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantCheckout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Customer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7134,11 +22107,11 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Customer = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7166,11 +22139,11 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Merchant = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7197,13 +22170,13 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7231,13 +22204,97 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderReference = string(dAtA[iNdEx:postIndex])
+			if err := m.RatePerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseEscrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var v int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7247,17 +22304,98 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseEscrow = bool(v != 0)
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7267,31 +22405,66 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CheckoutItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7301,23 +22474,24 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7340,7 +22514,7 @@ func (m *MsgInstantCheckout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7363,34 +22537,15 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInstantCheckoutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInstantCheckoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
-			}
-			m.SettlementId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7418,13 +22573,13 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7434,28 +22589,64 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7482,15 +22673,15 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecipientAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerRefund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7500,23 +22691,24 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransactionId = string(dAtA[iNdEx:postIndex])
+			if err := m.PayerRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7539,7 +22731,7 @@ func (m *MsgInstantCheckoutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7562,15 +22754,15 @@ func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPartialRefund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPartialRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7598,13 +22790,13 @@ func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.SettlementId = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7614,14 +22806,14 @@ func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementId |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7648,42 +22840,10 @@ func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7705,7 +22865,7 @@ func (m *MsgPartialRefund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPartialRefundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7728,48 +22888,15 @@ func (m *MsgPartialRefundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPartialRefundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPartialRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RefundedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7796,7 +22923,7 @@ func (m *MsgPartialRefundResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FundedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChannelClaimSignBytes returns the message a payment channel's sender signs
+// to authorize the recipient to claim amount with the given nonce:
+// channel_claim:{channelId}:{recipient}:{amount}:{nonce}
+func ChannelClaimSignBytes(channelId uint64, recipient string, amount sdk.Coin, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("channel_claim:%d:%s:%s:%d", channelId, recipient, amount.String(), nonce))
+}